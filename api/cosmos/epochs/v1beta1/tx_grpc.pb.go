@@ -0,0 +1,154 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/epochs/v1beta1/tx.proto
+
+package epochsv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_CreateEpoch_FullMethodName = "/cosmos.epochs.v1beta1.Msg/CreateEpoch"
+	Msg_DeleteEpoch_FullMethodName = "/cosmos.epochs.v1beta1.Msg/DeleteEpoch"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateEpoch defines a governance operation for creating a new epoch.
+	// The authority is defined in the keeper.
+	CreateEpoch(ctx context.Context, in *MsgCreateEpoch, opts ...grpc.CallOption) (*MsgCreateEpochResponse, error)
+	// DeleteEpoch defines a governance operation for deleting an epoch.
+	// The authority is defined in the keeper.
+	DeleteEpoch(ctx context.Context, in *MsgDeleteEpoch, opts ...grpc.CallOption) (*MsgDeleteEpochResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateEpoch(ctx context.Context, in *MsgCreateEpoch, opts ...grpc.CallOption) (*MsgCreateEpochResponse, error) {
+	out := new(MsgCreateEpochResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateEpoch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteEpoch(ctx context.Context, in *MsgDeleteEpoch, opts ...grpc.CallOption) (*MsgDeleteEpochResponse, error) {
+	out := new(MsgDeleteEpochResponse)
+	err := c.cc.Invoke(ctx, Msg_DeleteEpoch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// CreateEpoch defines a governance operation for creating a new epoch.
+	// The authority is defined in the keeper.
+	CreateEpoch(context.Context, *MsgCreateEpoch) (*MsgCreateEpochResponse, error)
+	// DeleteEpoch defines a governance operation for deleting an epoch.
+	// The authority is defined in the keeper.
+	DeleteEpoch(context.Context, *MsgDeleteEpoch) (*MsgDeleteEpochResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) CreateEpoch(context.Context, *MsgCreateEpoch) (*MsgCreateEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEpoch not implemented")
+}
+func (UnimplementedMsgServer) DeleteEpoch(context.Context, *MsgDeleteEpoch) (*MsgDeleteEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEpoch not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_CreateEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateEpoch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateEpoch(ctx, req.(*MsgCreateEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeleteEpoch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteEpoch(ctx, req.(*MsgDeleteEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.epochs.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateEpoch",
+			Handler:    _Msg_CreateEpoch_Handler,
+		},
+		{
+			MethodName: "DeleteEpoch",
+			Handler:    _Msg_DeleteEpoch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/epochs/v1beta1/tx.proto",
+}
@@ -19,15 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Service_Simulate_FullMethodName        = "/cosmos.tx.v1beta1.Service/Simulate"
-	Service_GetTx_FullMethodName           = "/cosmos.tx.v1beta1.Service/GetTx"
-	Service_BroadcastTx_FullMethodName     = "/cosmos.tx.v1beta1.Service/BroadcastTx"
-	Service_GetTxsEvent_FullMethodName     = "/cosmos.tx.v1beta1.Service/GetTxsEvent"
-	Service_GetBlockWithTxs_FullMethodName = "/cosmos.tx.v1beta1.Service/GetBlockWithTxs"
-	Service_TxDecode_FullMethodName        = "/cosmos.tx.v1beta1.Service/TxDecode"
-	Service_TxEncode_FullMethodName        = "/cosmos.tx.v1beta1.Service/TxEncode"
-	Service_TxEncodeAmino_FullMethodName   = "/cosmos.tx.v1beta1.Service/TxEncodeAmino"
-	Service_TxDecodeAmino_FullMethodName   = "/cosmos.tx.v1beta1.Service/TxDecodeAmino"
+	Service_Simulate_FullMethodName          = "/cosmos.tx.v1beta1.Service/Simulate"
+	Service_GetTx_FullMethodName             = "/cosmos.tx.v1beta1.Service/GetTx"
+	Service_BroadcastTx_FullMethodName       = "/cosmos.tx.v1beta1.Service/BroadcastTx"
+	Service_GetTxsEvent_FullMethodName       = "/cosmos.tx.v1beta1.Service/GetTxsEvent"
+	Service_GetBlockWithTxs_FullMethodName   = "/cosmos.tx.v1beta1.Service/GetBlockWithTxs"
+	Service_TxDecode_FullMethodName          = "/cosmos.tx.v1beta1.Service/TxDecode"
+	Service_TxEncode_FullMethodName          = "/cosmos.tx.v1beta1.Service/TxEncode"
+	Service_TxEncodeAmino_FullMethodName     = "/cosmos.tx.v1beta1.Service/TxEncodeAmino"
+	Service_TxDecodeAmino_FullMethodName     = "/cosmos.tx.v1beta1.Service/TxDecodeAmino"
+	Service_SimulateWithTrace_FullMethodName = "/cosmos.tx.v1beta1.Service/SimulateWithTrace"
+	Service_TraceTx_FullMethodName           = "/cosmos.tx.v1beta1.Service/TraceTx"
 )
 
 // ServiceClient is the client API for Service service.
@@ -62,6 +64,16 @@ type ServiceClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequest, opts ...grpc.CallOption) (*TxDecodeAminoResponse, error)
+	// SimulateWithTrace simulates executing a transaction on the state modified
+	// by the given overrides, and returns a trace of its execution. Unlike
+	// Simulate, the failure of the transaction is returned in the response,
+	// along with the trace of its execution up to the failure.
+	SimulateWithTrace(ctx context.Context, in *SimulateWithTraceRequest, opts ...grpc.CallOption) (*SimulateWithTraceResponse, error)
+	// TraceTx re-executes a committed transaction and returns a trace of its
+	// execution. The block of the transaction is replayed on the state of the
+	// previous height up to the transaction, which requires the node to hold that
+	// state, as archive nodes do.
+	TraceTx(ctx context.Context, in *TraceTxRequest, opts ...grpc.CallOption) (*TraceTxResponse, error)
 }
 
 type serviceClient struct {
@@ -153,6 +165,24 @@ func (c *serviceClient) TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequ
 	return out, nil
 }
 
+func (c *serviceClient) SimulateWithTrace(ctx context.Context, in *SimulateWithTraceRequest, opts ...grpc.CallOption) (*SimulateWithTraceResponse, error) {
+	out := new(SimulateWithTraceResponse)
+	err := c.cc.Invoke(ctx, Service_SimulateWithTrace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) TraceTx(ctx context.Context, in *TraceTxRequest, opts ...grpc.CallOption) (*TraceTxResponse, error) {
+	out := new(TraceTxResponse)
+	err := c.cc.Invoke(ctx, Service_TraceTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -185,6 +215,16 @@ type ServiceServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	TxDecodeAmino(context.Context, *TxDecodeAminoRequest) (*TxDecodeAminoResponse, error)
+	// SimulateWithTrace simulates executing a transaction on the state modified
+	// by the given overrides, and returns a trace of its execution. Unlike
+	// Simulate, the failure of the transaction is returned in the response,
+	// along with the trace of its execution up to the failure.
+	SimulateWithTrace(context.Context, *SimulateWithTraceRequest) (*SimulateWithTraceResponse, error)
+	// TraceTx re-executes a committed transaction and returns a trace of its
+	// execution. The block of the transaction is replayed on the state of the
+	// previous height up to the transaction, which requires the node to hold that
+	// state, as archive nodes do.
+	TraceTx(context.Context, *TraceTxRequest) (*TraceTxResponse, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -219,6 +259,12 @@ func (UnimplementedServiceServer) TxEncodeAmino(context.Context, *TxEncodeAminoR
 func (UnimplementedServiceServer) TxDecodeAmino(context.Context, *TxDecodeAminoRequest) (*TxDecodeAminoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TxDecodeAmino not implemented")
 }
+func (UnimplementedServiceServer) SimulateWithTrace(context.Context, *SimulateWithTraceRequest) (*SimulateWithTraceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateWithTrace not implemented")
+}
+func (UnimplementedServiceServer) TraceTx(context.Context, *TraceTxRequest) (*TraceTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -394,6 +440,42 @@ func _Service_TxDecodeAmino_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_SimulateWithTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateWithTraceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SimulateWithTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_SimulateWithTrace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SimulateWithTrace(ctx, req.(*SimulateWithTraceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).TraceTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_TraceTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).TraceTx(ctx, req.(*TraceTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -437,6 +519,14 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TxDecodeAmino",
 			Handler:    _Service_TxDecodeAmino_Handler,
 		},
+		{
+			MethodName: "SimulateWithTrace",
+			Handler:    _Service_SimulateWithTrace_Handler,
+		},
+		{
+			MethodName: "TraceTx",
+			Handler:    _Service_TraceTx_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/tx/v1beta1/service.proto",
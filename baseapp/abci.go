@@ -24,6 +24,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 // Supported ABCI Query prefixes and paths
@@ -146,6 +147,42 @@ func (app *BaseApp) Info(_ *abci.RequestInfo) (*abci.ResponseInfo, error) {
 	}, nil
 }
 
+// ReplayMempoolJournal inserts the transactions journaled by the mempool, if
+// journaled, back into the mempool through CheckTx. The journal is replayed
+// once, subsequent calls are no-ops.
+//
+// It must be called on startup once the state of the app is synced with
+// CometBFT, i.e. after the handshake, and before CometBFT starts to call
+// CheckTx. The start command calls it once the in-process CometBFT node is
+// created, applications running CometBFT out of process must call it
+// themselves.
+func (app *BaseApp) ReplayMempoolJournal() {
+	app.mempoolReplay.Do(app.replayMempoolJournal)
+}
+
+func (app *BaseApp) replayMempoolJournal() {
+	journaled, ok := app.mempool.(*mempool.JournaledMempool)
+	if !ok {
+		return
+	}
+
+	replayed, err := journaled.Replay(func(txBytes []byte) error {
+		res, err := app.CheckTx(&abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_New})
+		if err != nil {
+			return err
+		}
+		if res.Code != abci.CodeTypeOK {
+			return errors.New(res.Log)
+		}
+		return nil
+	})
+	if err != nil {
+		app.logger.Error("failed to replay mempool journal", "err", err)
+		return
+	}
+	app.logger.Info("replayed mempool journal", "txs", replayed, "mempool_txs", app.mempool.CountTx())
+}
+
 // Query implements the ABCI interface. It delegates to CommitMultiStore if it
 // implements Queryable.
 func (app *BaseApp) Query(_ context.Context, req *abci.RequestQuery) (resp *abci.ResponseQuery, err error) {
@@ -780,39 +817,9 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 	gasMeter = app.getBlockGasMeter(app.finalizeBlockState.Context())
 	app.finalizeBlockState.SetContext(app.finalizeBlockState.Context().WithBlockGasMeter(gasMeter))
 
-	// Iterate over all raw transactions in the proposal and attempt to execute
-	// them, gathering the execution results.
-	//
-	// NOTE: Not all raw transactions may adhere to the sdk.Tx interface, e.g.
-	// vote extensions, so skip those.
-	txResults := make([]*abci.ExecTxResult, 0, len(req.Txs))
-	for _, rawTx := range req.Txs {
-		var response *abci.ExecTxResult
-
-		if _, err := app.txDecoder(rawTx); err == nil {
-			response = app.deliverTx(rawTx)
-		} else {
-			// In the case where a transaction included in a block proposal is malformed,
-			// we still want to return a default response to comet. This is because comet
-			// expects a response for each transaction included in a block proposal.
-			response = sdkerrors.ResponseExecTxResultWithEvents(
-				sdkerrors.ErrTxDecode,
-				0,
-				0,
-				nil,
-				false,
-			)
-		}
-
-		// check after every tx if we should abort
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-			// continue
-		}
-
-		txResults = append(txResults, response)
+	txResults, err := app.executeTxs(ctx, req.Txs)
+	if err != nil {
+		return nil, err
 	}
 
 	if app.finalizeBlockState.ms.TracingEnabled() {
@@ -824,6 +831,10 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 		return nil, err
 	}
 
+	if err := app.scheduleStoreUpgrades(app.finalizeBlockState.Context()); err != nil {
+		return nil, err
+	}
+
 	// check after endBlock if we should abort, to avoid propagating the result
 	select {
 	case <-ctx.Done():
@@ -843,6 +854,55 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 	}, nil
 }
 
+// executeTxs iterates over all raw transactions in the proposal and attempts to
+// execute them, gathering the execution results. Transactions are executed in
+// parallel if enabled, see SetParallelExecution.
+//
+// NOTE: Not all raw transactions may adhere to the sdk.Tx interface, e.g.
+// vote extensions, so skip those.
+func (app *BaseApp) executeTxs(ctx context.Context, txs [][]byte) ([]*abci.ExecTxResult, error) {
+	if app.parallelExecWorkers > 0 && len(txs) > 1 && !app.finalizeBlockState.ms.TracingEnabled() {
+		return app.executeTxsParallel(ctx, txs)
+	}
+
+	txResults := make([]*abci.ExecTxResult, 0, len(txs))
+	for _, rawTx := range txs {
+		var response *abci.ExecTxResult
+
+		if _, err := app.txDecoder(rawTx); err == nil {
+			response = app.deliverTx(rawTx)
+		} else {
+			response = decodeFailureExecTxResult()
+		}
+
+		// check after every tx if we should abort
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+			// continue
+		}
+
+		txResults = append(txResults, response)
+	}
+
+	return txResults, nil
+}
+
+// decodeFailureExecTxResult returns the response for a transaction included in
+// a block proposal which is malformed. We still want to return a default
+// response to comet, as comet expects a response for each transaction included
+// in a block proposal.
+func decodeFailureExecTxResult() *abci.ExecTxResult {
+	return sdkerrors.ResponseExecTxResultWithEvents(
+		sdkerrors.ErrTxDecode,
+		0,
+		0,
+		nil,
+		false,
+	)
+}
+
 // FinalizeBlock will execute the block proposal provided by RequestFinalizeBlock.
 // Specifically, it will execute an application's BeginBlock (if defined), followed
 // by the transactions in the proposal, finally followed by the application's
@@ -1220,7 +1280,7 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 			)
 	}
 
-	cacheMS, err := qms.CacheMultiStoreWithVersion(height)
+	cacheMS, err := app.cacheMultiStoreWithVersion(height)
 	if err != nil {
 		return sdk.Context{},
 			errorsmod.Wrapf(
@@ -1250,6 +1310,25 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 	return ctx, nil
 }
 
+// cacheMultiStoreWithVersion branches the query multi-store at the given
+// height, falling back on the archive if the height is pruned from the
+// multi-store.
+func (app *BaseApp) cacheMultiStoreWithVersion(height int64) (storetypes.CacheMultiStore, error) {
+	if app.qms != nil {
+		return app.qms.CacheMultiStoreWithVersion(height)
+	}
+
+	cacheMS, err := app.cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		// the height may be pruned from the multistore, but held by the archive
+		if archiveMS, ok, archiveErr := app.archiveMultiStore(height); archiveErr == nil && ok {
+			return archiveMS, nil
+		}
+	}
+
+	return cacheMS, err
+}
+
 // GetBlockRetentionHeight returns the height for which all blocks below this height
 // are pruned from CometBFT. Given a commitment height and a non-zero local
 // minRetainBlocks configuration, the retentionHeight is the smallest height that
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -1389,6 +1390,50 @@ func TestPrecommiterCalledWithDeliverState(t *testing.T) {
 	require.Equal(t, true, wasPrecommiterCalled)
 }
 
+func TestABCI_MempoolJournal(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+	}
+	path := filepath.Join(t.TempDir(), "mempool.wal")
+
+	newSuite := func() (*BaseAppSuite, *mempool.JournaledMempool) {
+		pool, err := mempool.NewJournaledMempool(mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(5000)), path)
+		require.NoError(t, err)
+		suite := NewBaseAppSuite(t, anteOpt, baseapp.SetMempool(pool))
+		baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+		_, err = suite.baseApp.InitChain(&abci.RequestInitChain{
+			ConsensusParams: &cmtproto.ConsensusParams{},
+		})
+		require.NoError(t, err)
+		return suite, pool
+	}
+
+	suite, pool := newSuite()
+	for i := int64(0); i < 3; i++ {
+		txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, i, 1))
+		require.NoError(t, err)
+		res, err := suite.baseApp.CheckTx(&abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_New})
+		require.NoError(t, err)
+		require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	}
+	require.Equal(t, 3, pool.CountTx())
+	require.NoError(t, suite.baseApp.Close())
+
+	// the journaled txs are checked again in order and inserted back into the
+	// mempool when replayed, once
+	suite, pool = newSuite()
+	_, err := suite.baseApp.Info(&abci.RequestInfo{})
+	require.NoError(t, err)
+	require.Equal(t, 0, pool.CountTx())
+	for i := 0; i < 2; i++ {
+		suite.baseApp.ReplayMempoolJournal()
+		require.Equal(t, 3, pool.CountTx())
+	}
+	require.Equal(t, int64(3), getIntFromStore(t, getCheckStateCtx(suite.baseApp).KVStore(capKey1), anteKey))
+	require.NoError(t, suite.baseApp.Close())
+}
+
 func TestABCI_Proposal_HappyPath(t *testing.T) {
 	anteKey := []byte("ante-key")
 	pool := mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(5000))
@@ -11,6 +11,7 @@ import (
 	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
 	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	protoio "github.com/cosmos/gogoproto/io"
 	"github.com/cosmos/gogoproto/proto" //nolint: gci // ignore this line for this linter
 
@@ -212,16 +213,36 @@ type (
 		txVerifier       ProposalTxVerifier
 		txSelector       TxSelector
 		signerExtAdapter mempool.SignerExtractionAdapter
+		laneRules        mempool.LaneRules
 	}
+
+	// ProposalHandlerOption configures a DefaultProposalHandler.
+	ProposalHandlerOption func(*DefaultProposalHandler)
 )
 
-func NewDefaultProposalHandler(mp mempool.Mempool, txVerifier ProposalTxVerifier) *DefaultProposalHandler {
-	return &DefaultProposalHandler{
+func NewDefaultProposalHandler(mp mempool.Mempool, txVerifier ProposalTxVerifier, opts ...ProposalHandlerOption) *DefaultProposalHandler {
+	h := &DefaultProposalHandler{
 		mempool:          mp,
 		txVerifier:       txVerifier,
 		txSelector:       NewDefaultTxSelector(),
 		signerExtAdapter: mempool.NewDefaultSignerExtractionAdapter(),
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// WithLaneRules sets the lane rules of the chain, which the proposals must
+// follow: their transactions must be ordered by lane, each lane using at most
+// its share of the block space, the transactions matching no lane being in a
+// last lane without limit. The rules must be the same on all the validators,
+// independently of their mempools.
+func WithLaneRules(rules mempool.LaneRules) ProposalHandlerOption {
+	return func(h *DefaultProposalHandler) {
+		h.laneRules = rules
+	}
 }
 
 // SetTxSelector sets the TxSelector function on the DefaultProposalHandler.
@@ -279,77 +300,116 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 			return &abci.ResponsePrepareProposal{Txs: h.txSelector.SelectedTxs(ctx)}, nil
 		}
 
+		// the transactions are selected lane by lane, from the lanes of a lane
+		// mempool, the whole mempool being a single lane otherwise, each lane
+		// being limited to its share of the block space by the lane rules of the
+		// chain, or of the mempool if none
+		mempools := []mempool.Mempool{h.mempool}
+		rules := h.laneRules
+		if laneMempool := asLaneMempool(h.mempool); laneMempool != nil {
+			mempools = mempools[:0]
+			for _, lane := range laneMempool.Lanes() {
+				mempools = append(mempools, lane.Mempool)
+			}
+			if rules == nil {
+				rules = laneMempool.Rules()
+			}
+		}
+		lanes := newLaneUsage(rules, uint64(req.MaxTxBytes), maxBlockGas)
+
 		selectedTxsSignersSeqs := make(map[string]uint64)
 		var (
 			resError        error
 			selectedTxsNums int
 			invalidTxs      []sdk.Tx // invalid txs to be removed out of the loop to avoid dead lock
+			stop            bool
 		)
-		mempool.SelectBy(ctx, h.mempool, req.Txs, func(memTx sdk.Tx) bool {
-			signerData, err := h.signerExtAdapter.GetSigners(memTx)
-			if err != nil {
-				// propagate the error to the caller
-				resError = err
-				return false
-			}
+		for _, mp := range mempools {
+			mempool.SelectBy(ctx, mp, req.Txs, func(memTx sdk.Tx) bool {
+				signerData, err := h.signerExtAdapter.GetSigners(memTx)
+				if err != nil {
+					// propagate the error to the caller
+					resError = err
+					stop = true
+					return false
+				}
+
+				// If the signers aren't in selectedTxsSignersSeqs then we haven't seen them before
+				// so we add them and continue given that we don't need to check the sequence.
+				shouldAdd := true
+				txSignersSeqs := make(map[string]uint64)
+				for _, signer := range signerData {
+					seq, ok := selectedTxsSignersSeqs[signer.Signer.String()]
+					if !ok {
+						txSignersSeqs[signer.Signer.String()] = signer.Sequence
+						continue
+					}
 
-			// If the signers aren't in selectedTxsSignersSeqs then we haven't seen them before
-			// so we add them and continue given that we don't need to check the sequence.
-			shouldAdd := true
-			txSignersSeqs := make(map[string]uint64)
-			for _, signer := range signerData {
-				seq, ok := selectedTxsSignersSeqs[signer.Signer.String()]
-				if !ok {
+					// If we have seen this signer before in this block, we must make
+					// sure that the current sequence is seq+1; otherwise is invalid
+					// and we skip it.
+					if seq+1 != signer.Sequence {
+						shouldAdd = false
+						break
+					}
 					txSignersSeqs[signer.Signer.String()] = signer.Sequence
-					continue
 				}
-
-				// If we have seen this signer before in this block, we must make
-				// sure that the current sequence is seq+1; otherwise is invalid
-				// and we skip it.
-				if seq+1 != signer.Sequence {
-					shouldAdd = false
-					break
+				if !shouldAdd {
+					return true
 				}
-				txSignersSeqs[signer.Signer.String()] = signer.Sequence
-			}
-			if !shouldAdd {
-				return true
-			}
 
-			// NOTE: Since transaction verification was already executed in CheckTx,
-			// which calls mempool.Insert, in theory everything in the pool should be
-			// valid. But some mempool implementations may insert invalid txs, so we
-			// check again.
-			txBz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
-			if err != nil {
-				invalidTxs = append(invalidTxs, memTx)
-			} else {
-				stop := h.txSelector.SelectTxForProposal(ctx, uint64(req.MaxTxBytes), maxBlockGas, memTx, txBz)
-				if stop {
-					return false
-				}
+				// NOTE: Since transaction verification was already executed in CheckTx,
+				// which calls mempool.Insert, in theory everything in the pool should be
+				// valid. But some mempool implementations may insert invalid txs, so we
+				// check again.
+				txBz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
+				if err != nil {
+					invalidTxs = append(invalidTxs, memTx)
+				} else {
+					txSize := uint64(len(txBz))
+					// the transaction is skipped if it does not fit in the share of
+					// its lane
+					lane, fits := lanes.fits(ctx, memTx, txSize)
+					if fits {
+						stop = h.txSelector.SelectTxForProposal(ctx, uint64(req.MaxTxBytes), maxBlockGas, memTx, txBz)
+					}
 
-				txsLen := len(h.txSelector.SelectedTxs(ctx))
-				for sender, seq := range txSignersSeqs {
-					// If txsLen != selectedTxsNums is true, it means that we've
-					// added a new tx to the selected txs, so we need to update
-					// the sequence of the sender.
+					txsLen := len(h.txSelector.SelectedTxs(ctx))
 					if txsLen != selectedTxsNums {
-						selectedTxsSignersSeqs[sender] = seq
-					} else if _, ok := selectedTxsSignersSeqs[sender]; !ok {
-						// The transaction hasn't been added but it passed the
-						// verification, so we know that the sequence is correct.
-						// So we set this sender's sequence to seq-1, in order
-						// to avoid unnecessary calls to PrepareProposalVerifyTx.
-						selectedTxsSignersSeqs[sender] = seq - 1
+						lanes.add(lane, memTx, txSize)
+					}
+					for sender, seq := range txSignersSeqs {
+						// If txsLen != selectedTxsNums is true, it means that we've
+						// added a new tx to the selected txs, so we need to update
+						// the sequence of the sender.
+						if txsLen != selectedTxsNums {
+							selectedTxsSignersSeqs[sender] = seq
+						} else if _, ok := selectedTxsSignersSeqs[sender]; !ok {
+							// The transaction hasn't been added but it passed the
+							// verification, so we know that the sequence is correct.
+							// So we set this sender's sequence to seq-1, in order
+							// to avoid unnecessary calls to PrepareProposalVerifyTx.
+							selectedTxsSignersSeqs[sender] = seq - 1
+						}
+					}
+					selectedTxsNums = txsLen
+
+					if stop {
+						return false
+					}
+					// the next lane is selected once the share of the lane is used
+					if lanes.full() {
+						return false
 					}
 				}
-				selectedTxsNums = txsLen
-			}
 
-			return true
-		})
+				return true
+			})
+
+			if stop {
+				break
+			}
+		}
 
 		if resError != nil {
 			return nil, resError
@@ -393,6 +453,13 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 			maxBlockGas = b.MaxGas
 		}
 
+		// the transactions must follow the lane rules of the chain, regardless of
+		// the lanes of the mempool of the node
+		lanes := newLaneUsage(h.laneRules, uint64(cmttypes.MaxBlockSizeBytes), uint64(max(maxBlockGas, 0)))
+		if b := ctx.ConsensusParams().Block; b != nil && b.MaxBytes > 0 {
+			lanes.maxBlockBytes = uint64(b.MaxBytes)
+		}
+
 		for _, txBytes := range req.Txs {
 			tx, err := h.txVerifier.ProcessProposalVerifyTx(txBytes)
 			if err != nil {
@@ -400,21 +467,102 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 			}
 
 			if maxBlockGas > 0 {
-				gasTx, ok := tx.(GasTx)
-				if ok {
-					totalTxGas += gasTx.GetGas()
-				}
+				totalTxGas += gasLimit(tx)
 
 				if totalTxGas > uint64(maxBlockGas) {
 					return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
 				}
 			}
+
+			lane, fits := lanes.fits(ctx, tx, uint64(len(txBytes)))
+			if !fits {
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
+			lanes.add(lane, tx, uint64(len(txBytes)))
 		}
 
 		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
 	}
 }
 
+// gasLimit returns the gas limit of a transaction, 0 if it has none.
+func gasLimit(tx sdk.Tx) uint64 {
+	if gasTx, ok := tx.(GasTx); ok {
+		return gasTx.GetGas()
+	}
+	return 0
+}
+
+// asLaneMempool returns the lane mempool of a mempool, possibly journaled, nil
+// if it has no lanes.
+func asLaneMempool(mp mempool.Mempool) *mempool.LaneMempool {
+	if journaled, ok := mp.(*mempool.JournaledMempool); ok {
+		mp = journaled.Unwrap()
+	}
+	laneMempool, _ := mp.(*mempool.LaneMempool)
+	return laneMempool
+}
+
+// laneUsage tracks the block space used by the lanes of the transactions of a
+// proposal. The transactions matching no lane rule are in a last lane without
+// limit, as are all the transactions if there are no rules.
+type laneUsage struct {
+	rules         mempool.LaneRules
+	maxBlockBytes uint64
+	maxBlockGas   uint64
+	lane          int
+	bytes, gas    uint64
+}
+
+// newLaneUsage returns the usage of the lanes of the given rules, for the given
+// block limits, the block gas being unlimited if zero.
+func newLaneUsage(rules mempool.LaneRules, maxBlockBytes, maxBlockGas uint64) *laneUsage {
+	return &laneUsage{rules: rules, maxBlockBytes: maxBlockBytes, maxBlockGas: maxBlockGas}
+}
+
+// fits returns the lane of a transaction, and whether it belongs to a lane
+// which is not before the lane of the previous transaction and fits in the
+// share of its lane.
+func (u *laneUsage) fits(ctx context.Context, tx sdk.Tx, txSize uint64) (int, bool) {
+	i := u.rules.Index(ctx, tx)
+	if i < 0 {
+		i = len(u.rules)
+	}
+	if i < u.lane {
+		return i, false
+	}
+	if i == len(u.rules) {
+		return i, true
+	}
+
+	bytes, gas := txSize, gasLimit(tx)
+	if i == u.lane {
+		bytes, gas = bytes+u.bytes, gas+u.gas
+	}
+	rule := u.rules[i]
+	return i, bytes <= rule.Limit(u.maxBlockBytes) && (u.maxBlockGas == 0 || gas <= rule.Limit(u.maxBlockGas))
+}
+
+// add accounts for a transaction of the given lane added to the proposal.
+func (u *laneUsage) add(lane int, tx sdk.Tx, txSize uint64) {
+	if lane > u.lane {
+		u.lane, u.bytes, u.gas = lane, 0, 0
+	}
+	u.bytes += txSize
+	u.gas += gasLimit(tx)
+}
+
+// full returns whether the share of the block space of the current lane is
+// used.
+func (u *laneUsage) full() bool {
+	if u.lane >= len(u.rules) {
+		return false
+	}
+
+	rule := u.rules[u.lane]
+	return u.bytes >= rule.Limit(u.maxBlockBytes) || (u.maxBlockGas > 0 && u.gas >= rule.Limit(u.maxBlockGas))
+}
+
 // NoOpPrepareProposal defines a no-op PrepareProposal handler. It will always
 // return the transactions sent by the client's request.
 func NoOpPrepareProposal() sdk.PrepareProposalHandler {
@@ -2,6 +2,7 @@ package baseapp_test
 
 import (
 	"bytes"
+	"context"
 	"sort"
 	"testing"
 
@@ -20,6 +21,7 @@ import (
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
@@ -692,6 +694,126 @@ func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_PriorityNonceMempoolTxSe
 	}
 }
 
+func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_LaneMempool() {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	// the txs of a single character value are in the first lane, the others in
+	// the default lane
+	testTxs := []struct {
+		tx       sdk.Tx
+		priority int64
+		bz       []byte
+	}{
+		{tx: buildMsg(s.T(), txConfig, []byte(`0`), [][]byte{[]byte("secret1")}, []uint64{1}), priority: 10},
+		{tx: buildMsg(s.T(), txConfig, []byte(`1`), [][]byte{[]byte("secret2")}, []uint64{1}), priority: 8},
+		{tx: buildMsg(s.T(), txConfig, []byte(`22`), [][]byte{[]byte("secret3")}, []uint64{1}), priority: 10},
+		{tx: buildMsg(s.T(), txConfig, []byte(`33`), [][]byte{[]byte("secret4")}, []uint64{1}), priority: 8},
+	}
+	for i := range testTxs {
+		bz, err := txConfig.TxEncoder()(testTxs[i].tx)
+		s.Require().NoError(err)
+		testTxs[i].bz = bz
+	}
+	// the first lane can use the space of a single tx
+	maxTxBytes := int64(len(testTxs[0].bz) + len(testTxs[2].bz) + len(testTxs[3].bz))
+
+	firstLane := mempool.LaneRule{
+		Name: "first",
+		Match: func(_ context.Context, tx sdk.Tx) bool {
+			return len(tx.GetMsgs()[0].(*baseapptestutil.MsgKeyValue).Value) == 1
+		},
+		MaxBlockSpace: math.LegacyNewDecWithPrec(5, 1),
+	}
+	newLane := func() mempool.Mempool {
+		return mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      mempool.NewDefaultTxPriority(),
+			SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+		})
+	}
+	newMempool := func() *mempool.LaneMempool {
+		mp, err := mempool.NewLaneMempool(
+			mempool.Lane{LaneRule: firstLane, Mempool: newLane()},
+			mempool.Lane{LaneRule: mempool.LaneRule{Name: "default", MaxBlockSpace: math.LegacyOneDec()}, Mempool: newLane()},
+		)
+		s.Require().NoError(err)
+		return mp
+	}
+	// the rules of the chain only limit the first lane, the other txs being in
+	// a last lane without limit
+	rules, err := mempool.NewLaneRules(firstLane)
+	s.Require().NoError(err)
+
+	ctrl := gomock.NewController(s.T())
+	app := mock.NewMockProposalTxVerifier(ctrl)
+	for _, v := range testTxs {
+		app.EXPECT().PrepareProposalVerifyTx(v.tx).Return(v.bz, nil).AnyTimes()
+		app.EXPECT().ProcessProposalVerifyTx(v.bz).Return(v.tx, nil).AnyTimes()
+	}
+
+	// without its limit, the first lane would use the space of two txs
+	mp := newMempool()
+	req := &abci.RequestPrepareProposal{MaxTxBytes: maxTxBytes}
+	for _, v := range testTxs {
+		s.Require().NoError(mp.Insert(s.ctx.WithPriority(v.priority), v.tx))
+		req.Txs = append(req.Txs, v.bz)
+	}
+	ph := baseapp.NewDefaultProposalHandler(mp, app, baseapp.WithLaneRules(rules))
+	resp, err := ph.PrepareProposalHandler()(s.ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{testTxs[0].bz, testTxs[2].bz, testTxs[3].bz}, resp.Txs)
+
+	// the proposals are verified against the rules of the chain, whatever the
+	// mempool of the node
+	handlers := map[string]*baseapp.DefaultProposalHandler{
+		"lane mempool":  ph,
+		"other mempool": baseapp.NewDefaultProposalHandler(newLane(), app, baseapp.WithLaneRules(rules)),
+	}
+
+	testCases := map[string]struct {
+		txs    []int
+		status abci.ResponseProcessProposal_ProposalStatus
+	}{
+		"prepared proposal": {
+			txs:    []int{0, 2, 3},
+			status: abci.ResponseProcessProposal_ACCEPT,
+		},
+		"lanes out of order": {
+			txs:    []int{2, 0},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		"lane over its limit": {
+			txs:    []int{0, 1, 2},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+	}
+
+	ctx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxBytes: maxTxBytes},
+	})
+	for handlerName, ph := range handlers {
+		for name, tc := range testCases {
+			s.Run(handlerName+"/"+name, func() {
+				req := &abci.RequestProcessProposal{}
+				for _, i := range tc.txs {
+					req.Txs = append(req.Txs, testTxs[i].bz)
+				}
+				resp, err := ph.ProcessProposalHandler()(ctx, req)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.status, resp.Status)
+			})
+		}
+	}
+
+	// without rules, the lanes of the mempool of the node only shape its own
+	// proposals
+	procReq := &abci.RequestProcessProposal{Txs: [][]byte{testTxs[2].bz, testTxs[0].bz, testTxs[1].bz}}
+	procResp, err := baseapp.NewDefaultProposalHandler(newMempool(), app).ProcessProposalHandler()(ctx, procReq)
+	s.Require().NoError(err)
+	s.Require().Equal(abci.ResponseProcessProposal_ACCEPT, procResp.Status)
+}
+
 func marshalDelimitedFn(msg proto.Message) ([]byte, error) {
 	var buf bytes.Buffer
 	if err := protoio.NewDelimitedWriter(&buf).WriteMsg(msg); err != nil {
@@ -0,0 +1,142 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/storage"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ storetypes.ABCIListener = (*archiveListener)(nil)
+
+// archiveListener is the ABCIListener writing the change sets of the committed
+// blocks into the archive. The archive is append-only, it is never pruned, so
+// it serves the queries of any height since it was enabled, whatever the
+// pruning strategy of the IAVL trees is.
+type archiveListener struct {
+	app *BaseApp
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener.
+func (archiveListener) ListenFinalizeBlock(context.Context, abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener.
+func (l archiveListener) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	app := l.app
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	latest, err := app.archive.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case latest == height-1:
+		return app.archive.ApplyChangeset(height, changeSet)
+
+	case latest == 0:
+		// the state was restored from a state sync snapshot or the chain starts
+		// at a later initial height, the change sets do not carry the state
+		// before it: import the committed state instead
+		return app.importArchive(height)
+
+	default:
+		return fmt.Errorf("archive at height %d can not be written at height %d", latest, height)
+	}
+}
+
+// loadArchive reconciles the archive with the loaded state and registers its
+// listener. The state is imported into the archive if it is empty.
+func (app *BaseApp) loadArchive() error {
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return fmt.Errorf("archive requires a rootmulti.Store, got %T", app.cms)
+	}
+
+	// the archive listens to the writes of all the persistent stores
+	keys := make([]storetypes.StoreKey, 0)
+	for _, key := range rms.StoreKeysByName() {
+		if _, ok := key.(*storetypes.KVStoreKey); ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keys[i].Name() < keys[j].Name()
+	})
+	rms.AddListeners(keys)
+
+	latest, err := app.archive.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+
+	height := app.LastBlockHeight()
+	switch {
+	case latest == height:
+
+	case latest > height:
+		app.logger.Info("rolling back archive", "from", latest, "to", height)
+		if err := app.archive.Rollback(height); err != nil {
+			return err
+		}
+
+	case latest == 0:
+		if err := app.importArchive(height); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("archive at height %d is behind the application at height %d; remove it to import the state again", latest, height)
+	}
+
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, archiveListener{app: app})
+	return nil
+}
+
+// importArchive writes the committed state of the persistent stores into the
+// archive, as of the given height.
+func (app *BaseApp) importArchive(height int64) error {
+	app.logger.Info("importing state into archive", "height", height)
+
+	rms := app.cms.(*rootmulti.Store)
+	for name, key := range rms.StoreKeysByName() {
+		if _, ok := key.(*storetypes.KVStoreKey); !ok {
+			continue
+		}
+		if err := app.archive.ImportStore(name, height, rms.GetCommitKVStore(key)); err != nil {
+			return err
+		}
+	}
+
+	return app.archive.FinalizeImport(height)
+}
+
+// archiveMultiStore returns a cached multi-store serving the given height from
+// the archive, if it holds it.
+func (app *BaseApp) archiveMultiStore(height int64) (storetypes.CacheMultiStore, bool, error) {
+	if app.archive == nil {
+		return nil, false, nil
+	}
+
+	ok, err := app.archive.HasVersion(height)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	cacheMS, err := app.cms.(*rootmulti.Store).CacheMultiStoreFromStorage(app.archive, height)
+	return cacheMS, err == nil, err
+}
+
+// Archive returns the archive of the app, or nil if it is disabled.
+func (app *BaseApp) Archive() *storage.Database {
+	return app.archive
+}
@@ -0,0 +1,81 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/storage"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestArchive(t *testing.T) {
+	db, archiveDB := dbm.NewMemDB(), dbm.NewMemDB()
+	capKey := storetypes.NewKVStoreKey("key1")
+	key := []byte("key")
+
+	newApp := func() *baseapp.BaseApp {
+		app := baseapp.NewBaseApp(
+			t.Name(), log.NewTestLogger(t), db, nil,
+			baseapp.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningEverything)),
+			baseapp.SetArchive(storage.NewDatabase(archiveDB)),
+		)
+		app.MountStores(capKey)
+		app.SetPreBlocker(func(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+			ctx.KVStore(capKey).Set(key, []byte{byte(ctx.BlockHeight())})
+			return &sdk.ResponsePreBlock{}, nil
+		})
+		require.NoError(t, app.LoadLatestVersion())
+		return app
+	}
+
+	commitBlocks := func(app *baseapp.BaseApp, from, to int64) {
+		for height := from; height <= to; height++ {
+			_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+			require.NoError(t, err)
+			_, err = app.Commit()
+			require.NoError(t, err)
+		}
+	}
+
+	app := newApp()
+	commitBlocks(app, 1, 10)
+
+	// the pruned heights are served by the archive
+	_, err := app.CommitMultiStore().CacheMultiStoreWithVersion(3)
+	require.Error(t, err)
+	for height := int64(1); height <= 10; height++ {
+		ctx, err := app.CreateQueryContext(height, false)
+		require.NoError(t, err)
+		require.Equal(t, []byte{byte(height)}, ctx.KVStore(capKey).Get(key))
+	}
+
+	// the archive keeps being fed after a restart
+	app = newApp()
+	commitBlocks(app, 11, 12)
+	ctx, err := app.CreateQueryContext(5, false)
+	require.NoError(t, err)
+	require.Equal(t, []byte{5}, ctx.KVStore(capKey).Get(key))
+	ctx, err = app.CreateQueryContext(11, false)
+	require.NoError(t, err)
+	require.Equal(t, []byte{11}, ctx.KVStore(capKey).Get(key))
+
+	// heights missing from both the multistore and the archive can not be queried
+	archiveDB = dbm.NewMemDB()
+	app = newApp()
+	latest, err := app.Archive().GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(12), latest)
+	_, err = app.CreateQueryContext(5, false)
+	require.Error(t, err)
+	ctx, err = app.CreateQueryContext(12, false)
+	require.NoError(t, err)
+	require.Equal(t, []byte{12}, ctx.KVStore(capKey).Get(key))
+}
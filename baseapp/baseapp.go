@@ -3,9 +3,12 @@ package baseapp
 import (
 	"context"
 	"fmt"
+	"io"
 	"math"
 	"sort"
 	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/cockroachdb/errors"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -22,6 +25,7 @@ import (
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
 	"cosmossdk.io/store/snapshots"
+	"cosmossdk.io/store/storage"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp/oe"
@@ -77,6 +81,8 @@ type BaseApp struct {
 	anteHandler sdk.AnteHandler // ante handler for fee and auth
 	postHandler sdk.PostHandler // post handler, optional
 
+	stateOverrideHandler StateOverrideHandler // state overrides of the traced simulations, optional
+
 	initChainer        sdk.InitChainer                // ABCI InitChain handler
 	preBlocker         sdk.PreBlocker                 // logic to run before BeginBlocker
 	beginBlocker       sdk.BeginBlocker               // (legacy ABCI) BeginBlock handler
@@ -96,6 +102,14 @@ type BaseApp struct {
 	// manages snapshots, i.e. dumps of app state at certain intervals
 	snapshotManager *snapshots.Manager
 
+	// flat versioned state storage serving the reads of the IAVL stores, if
+	// enabled
+	stateStorage *storage.Database
+
+	// append-only state storage fed by the committed change sets, serving the
+	// queries of the heights pruned from the IAVL trees, if enabled
+	archive *storage.Database
+
 	// volatile states:
 	//
 	// - checkState is set on InitChain and reset on Commit
@@ -128,6 +142,10 @@ type BaseApp struct {
 	// application parameter store.
 	paramStore ParamStore
 
+	// storeUpgradeSchedule provides the store upgrades scheduled by governance,
+	// applied online to the multistore.
+	storeUpgradeSchedule StoreUpgradeSchedule
+
 	// queryGasLimit defines the maximum gas for queries; unbounded if 0.
 	queryGasLimit uint64
 
@@ -194,6 +212,19 @@ type BaseApp struct {
 	//
 	// SAFETY: it's safe to do if validators validate the total gas wanted in the `ProcessProposal`, which is the case in the default handler.
 	disableBlockGasMeter bool
+
+	// parallelExecWorkers is the number of workers executing the block
+	// transactions in parallel in FinalizeBlock, parallel execution is disabled
+	// if zero. This is experimental and must be enabled by developers.
+	parallelExecWorkers int
+
+	// parallelExecDisallowed holds the reasons why the components of the
+	// application do not support parallel execution.
+	parallelExecDisallowed []string
+
+	// mempoolReplay ensures the journal of the mempool, if journaled, is
+	// replayed once.
+	mempoolReplay *sync.Once
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -214,6 +245,7 @@ func NewBaseApp(
 		fauxMerkleMode:   false,
 		sigverifyTx:      true,
 		queryGasLimit:    math.MaxUint64,
+		mempoolReplay:    &sync.Once{},
 	}
 
 	for _, option := range options {
@@ -432,6 +464,16 @@ func (app *BaseApp) Init() error {
 		return errors.New("commit multi-store must not be nil")
 	}
 
+	if app.parallelExecWorkers > 0 && len(app.parallelExecDisallowed) > 0 {
+		return fmt.Errorf("parallel execution is not supported by the application: %s", strings.Join(app.parallelExecDisallowed, "; "))
+	}
+
+	if app.archive != nil {
+		if err := app.loadArchive(); err != nil {
+			return fmt.Errorf("failed to load archive: %w", err)
+		}
+	}
+
 	return app.cms.GetPruning().Validate()
 }
 
@@ -664,7 +706,14 @@ func (app *BaseApp) getContextForTx(mode execMode, txBytes []byte) sdk.Context {
 	if modeState == nil {
 		panic(fmt.Sprintf("state is nil for mode %v", mode))
 	}
-	ctx := modeState.Context().
+
+	return app.txContext(modeState.Context(), mode, txBytes)
+}
+
+// txContext decorates the given mode context with the tx w/ txBytes and other
+// memoized values.
+func (app *BaseApp) txContext(ctx sdk.Context, mode execMode, txBytes []byte) sdk.Context {
+	ctx = ctx.
 		WithTxBytes(txBytes).
 		WithGasMeter(storetypes.NewInfiniteGasMeter())
 	// WithVoteInfos(app.voteInfos) // TODO: identify if this is needed
@@ -753,7 +802,13 @@ func (app *BaseApp) beginBlock(_ *abci.RequestFinalizeBlock) (sdk.BeginBlock, er
 }
 
 func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
-	gInfo := sdk.GasInfo{}
+	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx)
+	return app.execTxResult(gInfo, result, anteEvents, err)
+}
+
+// execTxResult converts the outcome of executing a transaction in FinalizeBlock
+// into its ABCI response.
+func (app *BaseApp) execTxResult(gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) *abci.ExecTxResult {
 	resultStr := "successful"
 
 	var resp *abci.ExecTxResult
@@ -765,7 +820,6 @@ func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx)
 	if err != nil {
 		resultStr = "failed"
 		resp = sdkerrors.ResponseExecTxResultWithEvents(
@@ -823,13 +877,19 @@ func (app *BaseApp) endBlock(_ context.Context) (sdk.EndBlock, error) {
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes, app.mempool)
+}
+
+// runTxWithContext is runTx against the given tx context, inserting into or
+// removing from mp rather than the application mempool.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode execMode, txBytes []byte, mp mempool.Mempool) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
+	tracer := simulationTracerFromContext(ctx)
 
 	// only run the tx if there is block gas remaining
 	if mode == execModeFinalize && ctx.BlockGasMeter().IsOutOfGas() {
@@ -902,6 +962,7 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		// performance benefits, but it'll be more difficult to get right.
 		anteCtx, msCache = app.cacheTxContext(ctx, txBytes)
 		anteCtx = anteCtx.WithEventManager(sdk.NewEventManager())
+		tracer.startAnte()
 		newCtx, err := app.anteHandler(anteCtx, tx, mode == execModeSimulate)
 
 		if !newCtx.IsZero() {
@@ -918,11 +979,12 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 
 		// GasMeter expected to be set in AnteHandler
 		gasWanted = ctx.GasMeter().Limit()
+		tracer.endAnte(ctx.GasMeter().GasConsumed(), events.ToABCIEvents())
 
 		if err != nil {
 			if mode == execModeReCheck {
 				// if the ante handler fails on recheck, we want to remove the tx from the mempool
-				if mempoolErr := app.mempool.Remove(tx); mempoolErr != nil {
+				if mempoolErr := mp.Remove(tx); mempoolErr != nil {
 					return gInfo, nil, anteEvents, errors.Join(err, mempoolErr)
 				}
 			}
@@ -934,12 +996,12 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 	}
 
 	if mode == execModeCheck {
-		err = app.mempool.Insert(ctx, tx)
+		err = mp.Insert(ctx, tx)
 		if err != nil {
 			return gInfo, nil, anteEvents, err
 		}
 	} else if mode == execModeFinalize {
-		err = app.mempool.Remove(tx)
+		err = mp.Remove(tx)
 		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
 			return gInfo, nil, anteEvents,
 				fmt.Errorf("failed to remove tx from mempool: %w", err)
@@ -968,7 +1030,9 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		// Note that the state is still preserved.
 		postCtx := runMsgCtx.WithEventManager(sdk.NewEventManager())
 
+		tracer.startPost(postCtx.GasMeter().GasConsumed())
 		newCtx, errPostHandler := app.postHandler(postCtx, tx, mode == execModeSimulate, err == nil)
+		tracer.endPost(postCtx.GasMeter().GasConsumed())
 		if errPostHandler != nil {
 			return gInfo, nil, anteEvents, errors.Join(err, errPostHandler)
 		}
@@ -1005,6 +1069,7 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, msgsV2 []protov2.Message, mode execMode) (*sdk.Result, error) {
 	events := sdk.EmptyEvents()
 	var msgResponses []*codectypes.Any
+	tracer := simulationTracerFromContext(ctx)
 
 	// NOTE: GasWanted is determined by the AnteHandler and GasUsed by the GasMeter.
 	for i, msg := range msgs {
@@ -1018,14 +1083,17 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, msgsV2 []protov2.Me
 		}
 
 		// ADR 031 request type routing
+		tracer.startMsg(i, msg, ctx.GasMeter().GasConsumed())
 		msgResult, err := handler(ctx, msg)
 		if err != nil {
+			tracer.endMsg(ctx.GasMeter().GasConsumed(), nil, err)
 			return nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
 
 		// create message events
 		msgEvents, err := createEvents(app.cdc, msgResult.GetEvents(), msg, msgsV2[i])
 		if err != nil {
+			tracer.endMsg(ctx.GasMeter().GasConsumed(), nil, err)
 			return nil, errorsmod.Wrapf(err, "failed to create message events; message index: %d", i)
 		}
 
@@ -1039,6 +1107,7 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, msgsV2 []protov2.Me
 		}
 
 		events = events.AppendEvents(msgEvents)
+		tracer.endMsg(ctx.GasMeter().GasConsumed(), msgEvents.ToABCIEvents(), nil)
 
 		// Each individual sdk.Result that went through the MsgServiceRouter
 		// (which should represent 99% of the Msgs now, since everyone should
@@ -1169,5 +1238,39 @@ func (app *BaseApp) Close() error {
 		}
 	}
 
+	// Close app.stateStorage (opened by cosmos-sdk/server/util.go/GetStateStorage)
+	if app.stateStorage != nil {
+		app.logger.Info("Closing state_storage.db")
+		if err := app.stateStorage.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Close app.archive (opened by cosmos-sdk/server/util.go/GetArchive)
+	if app.archive != nil {
+		app.logger.Info("Closing archive.db")
+		if err := app.archive.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Close the journal of the mempool if journaled
+	if journaled, ok := app.mempool.(*mempool.JournaledMempool); ok {
+		app.logger.Info("Closing mempool journal")
+		if err := journaled.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Close the streaming listeners holding resources, such as the built-in
+	// file and sqlite listeners, once the writes they buffered are flushed
+	for _, listener := range app.streamingManager.ABCIListeners {
+		if closer, ok := listener.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
 	return errors.Join(errs...)
 }
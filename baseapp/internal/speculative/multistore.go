@@ -0,0 +1,194 @@
+package speculative
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"cosmossdk.io/store/cachekv"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var (
+	_ storetypes.CacheMultiStore = (*MultiStore)(nil)
+	_ storetypes.CacheMultiStore = (*branch)(nil)
+)
+
+// MultiStore is a branch of a shared parent multi-store used to execute a
+// single transaction speculatively. Reads falling through to the parent are
+// recorded and writes are buffered, so that the execution can later be
+// validated against, and applied to, the parent once all transactions ordered
+// before it have been committed.
+//
+// A MultiStore must only be used by a single goroutine, while any number of
+// them may share the same parent as long as they share the same lock and the
+// parent is not written to concurrently.
+type MultiStore struct {
+	*branch
+
+	mtx       *sync.Mutex
+	recorders map[storetypes.StoreKey]*recordingStore
+	// order holds the store keys in the order they were first accessed, which
+	// keeps validation and application deterministic.
+	order []storetypes.StoreKey
+}
+
+// NewMultiStore returns a speculative branch of parent. All parent reads are
+// serialized through mtx.
+func NewMultiStore(parent storetypes.MultiStore, mtx *sync.Mutex) *MultiStore {
+	ms := &MultiStore{
+		mtx:       mtx,
+		recorders: make(map[storetypes.StoreKey]*recordingStore),
+	}
+	ms.branch = newBranch(recordingMultiStore{ms: ms, parent: parent})
+
+	return ms
+}
+
+func (ms *MultiStore) recorder(parent storetypes.MultiStore, key storetypes.StoreKey) *recordingStore {
+	if s, ok := ms.recorders[key]; ok {
+		return s
+	}
+
+	s := newRecordingStore(ms.parentKVStore(parent, key), ms.mtx)
+	ms.recorders[key] = s
+	ms.order = append(ms.order, key)
+
+	return s
+}
+
+func (ms *MultiStore) parentKVStore(parent storetypes.MultiStore, key storetypes.StoreKey) storetypes.KVStore {
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+
+	return parent.GetKVStore(key)
+}
+
+// Validate reports whether every read performed by the speculative execution
+// would have observed the same result if it had been executed against base.
+// Write must be called beforehand.
+func (ms *MultiStore) Validate(base storetypes.MultiStore) bool {
+	for _, key := range ms.order {
+		if !ms.recorders[key].validate(base.GetKVStore(key)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Apply writes every buffered write to base. Write must be called beforehand.
+func (ms *MultiStore) Apply(base storetypes.MultiStore) {
+	for _, key := range ms.order {
+		ms.recorders[key].apply(base.GetKVStore(key))
+	}
+}
+
+// recordingMultiStore exposes the recording stores of a MultiStore as the
+// parent of its top level branch.
+type recordingMultiStore struct {
+	ms     *MultiStore
+	parent storetypes.MultiStore
+}
+
+func (r recordingMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return r.ms.recorder(r.parent, key)
+}
+
+// kvStoreGetter is the only capability a branch requires from its parent.
+type kvStoreGetter interface {
+	GetKVStore(storetypes.StoreKey) storetypes.KVStore
+}
+
+// branch is a multi-store branch which lazily branches the stores of its
+// parent as they are accessed.
+type branch struct {
+	parent kvStoreGetter
+	stores map[storetypes.StoreKey]storetypes.CacheKVStore
+	order  []storetypes.StoreKey
+}
+
+func newBranch(parent kvStoreGetter) *branch {
+	return &branch{
+		parent: parent,
+		stores: make(map[storetypes.StoreKey]storetypes.CacheKVStore),
+	}
+}
+
+// GetStoreType implements the Store interface.
+func (b *branch) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeMulti
+}
+
+// CacheWrap implements the CacheWrapper interface.
+func (b *branch) CacheWrap() storetypes.CacheWrap {
+	return b.CacheMultiStore()
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface. Tracing is not
+// supported on speculative branches.
+func (b *branch) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return b.CacheWrap()
+}
+
+// CacheMultiStore implements the MultiStore interface.
+func (b *branch) CacheMultiStore() storetypes.CacheMultiStore {
+	return newBranch(b)
+}
+
+// CacheMultiStoreWithVersion implements the MultiStore interface. It panics
+// as a branch cannot load previous versions.
+func (b *branch) CacheMultiStoreWithVersion(_ int64) (storetypes.CacheMultiStore, error) {
+	panic("cannot branch speculative multi-store with a version")
+}
+
+// GetStore implements the MultiStore interface.
+func (b *branch) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return b.GetKVStore(key)
+}
+
+// GetKVStore implements the MultiStore interface.
+func (b *branch) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	if key == nil {
+		panic(fmt.Sprintf("kv store with key %v has not been registered in stores", key))
+	}
+
+	if s, ok := b.stores[key]; ok {
+		return s
+	}
+
+	s := cachekv.NewStore(b.parent.GetKVStore(key))
+	b.stores[key] = s
+	b.order = append(b.order, key)
+
+	return s
+}
+
+// TracingEnabled implements the MultiStore interface.
+func (b *branch) TracingEnabled() bool {
+	return false
+}
+
+// SetTracer implements the MultiStore interface. Tracing is not supported on
+// speculative branches.
+func (b *branch) SetTracer(_ io.Writer) storetypes.MultiStore {
+	return b
+}
+
+// SetTracingContext implements the MultiStore interface. Tracing is not
+// supported on speculative branches.
+func (b *branch) SetTracingContext(_ storetypes.TraceContext) storetypes.MultiStore {
+	return b
+}
+
+// LatestVersion implements the MultiStore interface.
+func (b *branch) LatestVersion() int64 {
+	panic("cannot get latest version from branch cached multi-store")
+}
+
+// Write implements the CacheMultiStore interface.
+func (b *branch) Write() {
+	for _, key := range b.order {
+		b.stores[key].Write()
+	}
+}
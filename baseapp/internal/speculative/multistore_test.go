@@ -0,0 +1,116 @@
+package speculative
+
+import (
+	"sync"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/dbadapter"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var testKey = storetypes.NewKVStoreKey("test")
+
+// kvStores is a minimal parent for a branch.
+type kvStores map[storetypes.StoreKey]storetypes.KVStore
+
+func (s kvStores) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return s[key]
+}
+
+func newTestMultiStore() storetypes.CacheMultiStore {
+	return newBranch(kvStores{testKey: dbadapter.Store{DB: dbm.NewMemDB()}})
+}
+
+func TestMultiStoreApply(t *testing.T) {
+	base := newTestMultiStore()
+	base.GetKVStore(testKey).Set([]byte("a"), []byte("1"))
+
+	var mtx sync.Mutex
+	ms := NewMultiStore(base, &mtx)
+
+	branch := ms.CacheMultiStore()
+	require.Equal(t, []byte("1"), branch.GetKVStore(testKey).Get([]byte("a")))
+	branch.GetKVStore(testKey).Set([]byte("b"), []byte("2"))
+	branch.GetKVStore(testKey).Delete([]byte("a"))
+	branch.Write()
+
+	// writes are buffered until applied
+	require.Equal(t, []byte("1"), base.GetKVStore(testKey).Get([]byte("a")))
+	require.Nil(t, base.GetKVStore(testKey).Get([]byte("b")))
+
+	ms.Write()
+	require.True(t, ms.Validate(base))
+	ms.Apply(base)
+
+	require.Nil(t, base.GetKVStore(testKey).Get([]byte("a")))
+	require.Equal(t, []byte("2"), base.GetKVStore(testKey).Get([]byte("b")))
+}
+
+func TestMultiStoreValidate(t *testing.T) {
+	testCases := map[string]struct {
+		read  func(storetypes.KVStore)
+		write func(storetypes.KVStore)
+		valid bool
+	}{
+		"unrelated write": {
+			read:  func(s storetypes.KVStore) { s.Get([]byte("a")) },
+			write: func(s storetypes.KVStore) { s.Set([]byte("b"), []byte("1")) },
+			valid: true,
+		},
+		"same value write": {
+			read:  func(s storetypes.KVStore) { s.Get([]byte("a")) },
+			write: func(s storetypes.KVStore) { s.Set([]byte("a"), []byte("0")) },
+			valid: true,
+		},
+		"conflicting get": {
+			read:  func(s storetypes.KVStore) { s.Get([]byte("a")) },
+			write: func(s storetypes.KVStore) { s.Set([]byte("a"), []byte("1")) },
+		},
+		"conflicting has": {
+			read:  func(s storetypes.KVStore) { s.Has([]byte("b")) },
+			write: func(s storetypes.KVStore) { s.Set([]byte("b"), []byte("1")) },
+		},
+		"conflicting iteration": {
+			read: func(s storetypes.KVStore) {
+				it := s.Iterator(nil, nil)
+				for ; it.Valid(); it.Next() {
+				}
+				it.Close()
+			},
+			write: func(s storetypes.KVStore) { s.Set([]byte("b"), []byte("1")) },
+		},
+		"write past partial iteration": {
+			read: func(s storetypes.KVStore) {
+				it := s.Iterator(nil, nil)
+				it.Close()
+			},
+			write: func(s storetypes.KVStore) { s.Set([]byte("b"), []byte("1")) },
+			valid: true,
+		},
+		"conflicting reverse iteration": {
+			read: func(s storetypes.KVStore) {
+				it := s.ReverseIterator(nil, nil)
+				it.Close()
+			},
+			write: func(s storetypes.KVStore) { s.Set([]byte("b"), []byte("1")) },
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			base := newTestMultiStore()
+			base.GetKVStore(testKey).Set([]byte("a"), []byte("0"))
+
+			var mtx sync.Mutex
+			ms := NewMultiStore(base, &mtx)
+			tc.read(ms.GetKVStore(testKey))
+			ms.Write()
+
+			tc.write(base.GetKVStore(testKey))
+			require.Equal(t, tc.valid, ms.Validate(base))
+		})
+	}
+}
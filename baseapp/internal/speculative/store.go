@@ -0,0 +1,265 @@
+package speculative
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var _ storetypes.KVStore = (*recordingStore)(nil)
+
+// read is a single point read served by the parent store.
+type read struct {
+	key   []byte
+	value []byte
+	// exists is used for Has reads, for which no value is recorded.
+	exists bool
+	isHas  bool
+}
+
+// write is a single buffered write, a nil value denotes a deletion.
+type write struct {
+	key   []byte
+	value []byte
+}
+
+// recordingStore sits between a speculative branch and the shared parent
+// store. It records every read served by the parent, serializing parent
+// access with a lock shared by all speculative executions, and records writes
+// instead of forwarding them so that the parent is never mutated.
+type recordingStore struct {
+	parent storetypes.KVStore
+	mtx    *sync.Mutex
+
+	reads      []read
+	iterations []*recordingIterator
+	writes     []write
+}
+
+func newRecordingStore(parent storetypes.KVStore, mtx *sync.Mutex) *recordingStore {
+	return &recordingStore{parent: parent, mtx: mtx}
+}
+
+// GetStoreType implements the Store interface.
+func (s *recordingStore) GetStoreType() storetypes.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// Get implements the KVStore interface.
+func (s *recordingStore) Get(key []byte) []byte {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	value := s.parent.Get(key)
+	s.reads = append(s.reads, read{key: bytes.Clone(key), value: bytes.Clone(value), exists: value != nil})
+
+	return value
+}
+
+// Has implements the KVStore interface.
+func (s *recordingStore) Has(key []byte) bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	exists := s.parent.Has(key)
+	s.reads = append(s.reads, read{key: bytes.Clone(key), exists: exists, isHas: true})
+
+	return exists
+}
+
+// Set implements the KVStore interface. The write is only recorded.
+func (s *recordingStore) Set(key, value []byte) {
+	storetypes.AssertValidKey(key)
+	storetypes.AssertValidValue(value)
+	s.writes = append(s.writes, write{key: bytes.Clone(key), value: bytes.Clone(value)})
+}
+
+// Delete implements the KVStore interface. The deletion is only recorded.
+func (s *recordingStore) Delete(key []byte) {
+	storetypes.AssertValidKey(key)
+	s.writes = append(s.writes, write{key: bytes.Clone(key)})
+}
+
+// Iterator implements the KVStore interface.
+func (s *recordingStore) Iterator(start, end []byte) storetypes.Iterator {
+	return s.iterator(start, end, true)
+}
+
+// ReverseIterator implements the KVStore interface.
+func (s *recordingStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return s.iterator(start, end, false)
+}
+
+func (s *recordingStore) iterator(start, end []byte, ascending bool) storetypes.Iterator {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	var parent storetypes.Iterator
+	if ascending {
+		parent = s.parent.Iterator(start, end)
+	} else {
+		parent = s.parent.ReverseIterator(start, end)
+	}
+
+	it := &recordingIterator{
+		parent:    parent,
+		mtx:       s.mtx,
+		start:     bytes.Clone(start),
+		end:       bytes.Clone(end),
+		ascending: ascending,
+	}
+	it.record()
+	s.iterations = append(s.iterations, it)
+
+	return it
+}
+
+// CacheWrap implements the CacheWrapper interface.
+func (s *recordingStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+func (s *recordingStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// validate reports whether every read recorded by the store would observe the
+// same result against base.
+func (s *recordingStore) validate(base storetypes.KVStore) bool {
+	for _, r := range s.reads {
+		if r.isHas {
+			if base.Has(r.key) != r.exists {
+				return false
+			}
+			continue
+		}
+
+		value := base.Get(r.key)
+		if (value != nil) != r.exists || !bytes.Equal(value, r.value) {
+			return false
+		}
+	}
+
+	for _, it := range s.iterations {
+		if !it.validate(base) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// apply replays the recorded writes on base in the order they were made.
+func (s *recordingStore) apply(base storetypes.KVStore) {
+	for _, w := range s.writes {
+		if w.value == nil {
+			base.Delete(w.key)
+		} else {
+			base.Set(w.key, w.value)
+		}
+	}
+}
+
+var _ storetypes.Iterator = (*recordingIterator)(nil)
+
+// recordingIterator records every position reached on a parent iterator and
+// whether the iterator was seen exhausted.
+type recordingIterator struct {
+	parent storetypes.Iterator
+	mtx    *sync.Mutex
+
+	start, end []byte
+	ascending  bool
+
+	items     []write
+	exhausted bool
+}
+
+// record must be called with the lock held.
+func (it *recordingIterator) record() {
+	if !it.parent.Valid() {
+		it.exhausted = true
+		return
+	}
+
+	it.items = append(it.items, write{key: bytes.Clone(it.parent.Key()), value: bytes.Clone(it.parent.Value())})
+}
+
+// Domain implements the Iterator interface.
+func (it *recordingIterator) Domain() (start, end []byte) {
+	return it.start, it.end
+}
+
+// Valid implements the Iterator interface.
+func (it *recordingIterator) Valid() bool {
+	return !it.exhausted
+}
+
+// Next implements the Iterator interface.
+func (it *recordingIterator) Next() {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+
+	it.parent.Next()
+	it.record()
+}
+
+// Key implements the Iterator interface.
+func (it *recordingIterator) Key() []byte {
+	if it.exhausted {
+		panic("iterator is invalid")
+	}
+
+	return it.items[len(it.items)-1].key
+}
+
+// Value implements the Iterator interface.
+func (it *recordingIterator) Value() []byte {
+	if it.exhausted {
+		panic("iterator is invalid")
+	}
+
+	return it.items[len(it.items)-1].value
+}
+
+// Error implements the Iterator interface.
+func (it *recordingIterator) Error() error {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+
+	return it.parent.Error()
+}
+
+// Close implements the Iterator interface.
+func (it *recordingIterator) Close() error {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+
+	return it.parent.Close()
+}
+
+// validate reports whether iterating the same domain of base yields the same
+// positions that were observed, including exhaustion if it was observed.
+func (it *recordingIterator) validate(base storetypes.KVStore) bool {
+	var iter storetypes.Iterator
+	if it.ascending {
+		iter = base.Iterator(it.start, it.end)
+	} else {
+		iter = base.ReverseIterator(it.start, it.end)
+	}
+	defer iter.Close()
+
+	for _, item := range it.items {
+		if !iter.Valid() || !bytes.Equal(iter.Key(), item.key) || !bytes.Equal(iter.Value(), item.value) {
+			return false
+		}
+		iter.Next()
+	}
+
+	return !it.exhausted || !iter.Valid()
+}
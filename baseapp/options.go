@@ -4,13 +4,16 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"runtime"
 
 	dbm "github.com/cosmos/cosmos-db"
 
 	"cosmossdk.io/store/metrics"
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"cosmossdk.io/store/storage"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp/oe"
@@ -95,6 +98,18 @@ func SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOpti
 	return func(app *BaseApp) { app.SetSnapshot(snapshotStore, opts) }
 }
 
+// SetStateStorage sets the state storage serving the reads of the IAVL stores,
+// and its pruning strategy.
+func SetStateStorage(ss *storage.Database, pruningOpts pruningtypes.PruningOptions) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetStateStorage(ss, pruningOpts) }
+}
+
+// SetArchive sets the archive serving the queries of the heights pruned from
+// the IAVL trees.
+func SetArchive(archive *storage.Database) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetArchive(archive) }
+}
+
 // SetMempool sets the mempool on BaseApp.
 func SetMempool(mempool mempool.Mempool) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetMempool(mempool) }
@@ -117,6 +132,25 @@ func SetOptimisticExecution(opts ...func(*oe.OptimisticExecution)) func(*BaseApp
 	}
 }
 
+// SetParallelExecution enables the parallel execution of the block transactions
+// in FinalizeBlock with the given number of workers, or runtime.GOMAXPROCS(0)
+// workers if zero. Results are identical to sequential execution, given that
+// transaction execution only depends on state and not on the block gas meter.
+//
+// Transactions are executed speculatively, and their executions are discarded
+// or executed again if they conflict. The ante handler, the message handlers
+// and the post handler must hence neither read nor change anything but the
+// state of the stores, such as the hashes of the unordered transactions held
+// in memory. Components which do must call DisallowParallelExecution, the
+// application then fails to load if parallel execution is enabled.
+func SetParallelExecution(workers int) func(*BaseApp) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	return func(app *BaseApp) { app.parallelExecWorkers = workers }
+}
+
 // DisableBlockGasMeter disables the block gas meter.
 func DisableBlockGasMeter() func(*BaseApp) {
 	return func(app *BaseApp) { app.SetDisableBlockGasMeter(true) }
@@ -139,6 +173,16 @@ func (app *BaseApp) SetParamStore(ps ParamStore) {
 	app.paramStore = ps
 }
 
+// SetStoreUpgradeSchedule sets the schedule of the store upgrades applied online
+// on the BaseApp. It requires the multistore to be a rootmulti.Store.
+func (app *BaseApp) SetStoreUpgradeSchedule(s StoreUpgradeSchedule) {
+	if app.sealed {
+		panic("SetStoreUpgradeSchedule() on sealed BaseApp")
+	}
+
+	app.storeUpgradeSchedule = s
+}
+
 // SetVersion sets the application's version string.
 func (app *BaseApp) SetVersion(v string) {
 	if app.sealed {
@@ -236,6 +280,17 @@ func (app *BaseApp) SetPostHandler(ph sdk.PostHandler) {
 	app.postHandler = ph
 }
 
+// SetStateOverrideHandler sets the handler applying the state overrides of the
+// simulations run with SimulateWithTrace, other than the ones of the block
+// header. Without it, such overrides are rejected.
+func (app *BaseApp) SetStateOverrideHandler(h StateOverrideHandler) {
+	if app.sealed {
+		panic("SetStateOverrideHandler() on sealed BaseApp")
+	}
+
+	app.stateOverrideHandler = h
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
@@ -293,6 +348,46 @@ func (app *BaseApp) SetSnapshot(snapshotStore *snapshots.Store, opts snapshottyp
 	app.snapshotManager = snapshots.NewManager(snapshotStore, opts, app.cms, nil, app.logger)
 }
 
+// SetStateStorage sets the state storage of the multistore, which then serves the
+// reads of the IAVL stores and the queries of past heights, the IAVL trees being
+// only used for state commitment. It requires the multistore to be a
+// rootmulti.Store.
+func (app *BaseApp) SetStateStorage(ss *storage.Database, pruningOpts pruningtypes.PruningOptions) {
+	if app.sealed {
+		panic("SetStateStorage() on sealed BaseApp")
+	}
+
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		panic(fmt.Sprintf("state storage requires a rootmulti.Store, got %T", app.cms))
+	}
+
+	rms.SetStateStorage(ss)
+	rms.SetStateStoragePruning(pruningOpts)
+	app.stateStorage = ss
+}
+
+// SetArchive sets the archive of the app. The change sets of the committed
+// blocks are streamed into it, and the queries of the heights that can not be
+// loaded from the multistore, such as the ones pruned from the IAVL trees, are
+// served by it. The archive is never pruned; it holds every height since it was
+// enabled, the state being imported into it the first time the app is loaded
+// with it. It requires the multistore to be a rootmulti.Store.
+//
+// NOTE: the archive listens to the writes of all the persistent stores, so the
+// change sets passed to the other ABCIListeners include all of them too.
+func (app *BaseApp) SetArchive(archive *storage.Database) {
+	if app.sealed {
+		panic("SetArchive() on sealed BaseApp")
+	}
+
+	if _, ok := app.cms.(*rootmulti.Store); !ok {
+		panic(fmt.Sprintf("archive requires a rootmulti.Store, got %T", app.cms))
+	}
+
+	app.archive = archive
+}
+
 // SetInterfaceRegistry sets the InterfaceRegistry.
 func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 	app.interfaceRegistry = registry
@@ -378,6 +473,18 @@ func (app *BaseApp) SetDisableBlockGasMeter(disableBlockGasMeter bool) {
 	app.disableBlockGasMeter = disableBlockGasMeter
 }
 
+// DisallowParallelExecution records that a component of the application does
+// not support parallel execution for the given reason, e.g. because it holds
+// state in memory. Loading the application fails if parallel execution is
+// enabled, see SetParallelExecution.
+func (app *BaseApp) DisallowParallelExecution(reason string) {
+	if app.sealed {
+		panic("DisallowParallelExecution() on sealed BaseApp")
+	}
+
+	app.parallelExecDisallowed = append(app.parallelExecDisallowed, reason)
+}
+
 // SetMsgServiceRouter sets the MsgServiceRouter of a BaseApp.
 func (app *BaseApp) SetMsgServiceRouter(msgServiceRouter *MsgServiceRouter) {
 	app.msgServiceRouter = msgServiceRouter
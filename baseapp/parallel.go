@@ -0,0 +1,184 @@
+package baseapp
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/internal/speculative"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// speculativeTx is the outcome of executing a transaction against a
+// speculative branch of the FinalizeBlock state.
+type speculativeTx struct {
+	decodeFailed bool
+
+	ms           *speculative.MultiStore
+	blockGasUsed uint64
+	// removed holds the tx if execution reached the mempool removal step.
+	removed sdk.Tx
+
+	gInfo      sdk.GasInfo
+	result     *sdk.Result
+	anteEvents []abci.Event
+	err        error
+}
+
+// executeTxsParallel executes the block transactions in two phases, producing
+// the exact same results and state as executing them sequentially.
+//
+// First, every transaction is executed concurrently against its own
+// speculative branch of the state as of the end of BeginBlock, recording every
+// read it performs and buffering its writes. Then, in block order, each
+// speculative execution is validated: if every read it performed observes the
+// same result against the state produced by the transactions before it, its
+// writes are applied and its result is used as is. Otherwise, the transaction
+// is executed again sequentially against the current state.
+//
+// Transactions touching the same keys, e.g. paying fees to the same module
+// account, are thus effectively executed sequentially. State reads are
+// serialized, while all the remaining work, e.g. signature verification, is
+// parallelized.
+func (app *BaseApp) executeTxsParallel(ctx context.Context, txs [][]byte) ([]*abci.ExecTxResult, error) {
+	var (
+		specs = make([]*speculativeTx, len(txs))
+		jobs  = make(chan int)
+		mtx   sync.Mutex
+		wg    sync.WaitGroup
+	)
+
+	for w := 0; w < app.parallelExecWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				specs[i] = app.speculateTx(txs[i], &mtx)
+			}
+		}()
+	}
+
+	for i := range txs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	txResults := make([]*abci.ExecTxResult, 0, len(txs))
+	for i, rawTx := range txs {
+		txResults = append(txResults, app.commitSpeculativeTx(specs[i], rawTx))
+
+		// check after every tx if we should abort
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+			// continue
+		}
+	}
+
+	return txResults, nil
+}
+
+// speculateTx executes the transaction against a speculative branch of the
+// FinalizeBlock state, serializing reads of that state with mtx. It returns
+// nil if the execution could not be carried out, in which case the transaction
+// must be executed sequentially.
+func (app *BaseApp) speculateTx(txBytes []byte, mtx *sync.Mutex) (spec *speculativeTx) {
+	defer func() {
+		if r := recover(); r != nil {
+			app.logger.Debug("panic recovered in speculative tx execution", "err", r)
+			spec = nil
+		}
+	}()
+
+	if _, err := app.txDecoder(txBytes); err != nil {
+		return &speculativeTx{decodeFailed: true}
+	}
+
+	ms := speculative.NewMultiStore(app.finalizeBlockState.ms, mtx)
+	ctx := app.finalizeBlockState.Context().
+		WithMultiStore(ms).
+		WithEventManager(sdk.NewEventManager())
+
+	// Block gas consumption is checked against the actual block gas meter when
+	// the transaction is committed.
+	blockGasMeter := app.getBlockGasMeter(ctx)
+	ctx = ctx.WithBlockGasMeter(blockGasMeter)
+
+	mp := &speculativeMempool{}
+	gInfo, result, anteEvents, err := app.runTxWithContext(app.txContext(ctx, execModeFinalize, txBytes), execModeFinalize, txBytes, mp)
+
+	ms.Write()
+
+	return &speculativeTx{
+		ms:           ms,
+		blockGasUsed: blockGasMeter.GasConsumed(),
+		removed:      mp.removed,
+		gInfo:        gInfo,
+		result:       result,
+		anteEvents:   anteEvents,
+		err:          err,
+	}
+}
+
+// commitSpeculativeTx validates a speculative execution against the current
+// FinalizeBlock state and commits it, or executes the transaction again
+// sequentially if it is not valid.
+func (app *BaseApp) commitSpeculativeTx(spec *speculativeTx, txBytes []byte) *abci.ExecTxResult {
+	if spec == nil {
+		return app.deliverTx(txBytes)
+	}
+
+	if spec.decodeFailed {
+		return decodeFailureExecTxResult()
+	}
+
+	ms := app.finalizeBlockState.ms
+	blockGasMeter := app.finalizeBlockState.Context().BlockGasMeter()
+
+	// Sequential execution would have run out of block gas, let it produce the
+	// corresponding failure.
+	if blockGasMeter.IsOutOfGas() || spec.blockGasUsed > blockGasMeter.Limit()-blockGasMeter.GasConsumed() {
+		return app.deliverTx(txBytes)
+	}
+
+	if !spec.ms.Validate(ms) {
+		return app.deliverTx(txBytes)
+	}
+
+	// A mempool removal failure fails the transaction, have it surface through
+	// sequential execution.
+	if spec.removed != nil {
+		if err := app.mempool.Remove(spec.removed); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+			return app.deliverTx(txBytes)
+		}
+	}
+
+	spec.ms.Apply(ms)
+	blockGasMeter.ConsumeGas(spec.blockGasUsed, "block gas meter")
+
+	return app.execTxResult(spec.gInfo, spec.result, spec.anteEvents, spec.err)
+}
+
+var _ mempool.Mempool = (*speculativeMempool)(nil)
+
+// speculativeMempool stands in for the application mempool during speculative
+// execution, deferring the removal of the transaction until it is committed.
+type speculativeMempool struct {
+	removed sdk.Tx
+}
+
+func (mp *speculativeMempool) Insert(context.Context, sdk.Tx) error { return nil }
+
+func (mp *speculativeMempool) Select(context.Context, [][]byte) mempool.Iterator { return nil }
+
+func (mp *speculativeMempool) CountTx() int { return 0 }
+
+func (mp *speculativeMempool) Remove(tx sdk.Tx) error {
+	mp.removed = tx
+	return nil
+}
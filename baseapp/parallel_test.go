@@ -0,0 +1,209 @@
+package baseapp_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// parallelKeyValueImpl appends the message value to the value stored under the
+// message key, so that its outcome depends on the transactions executed
+// before it. A "count" value is replaced by the number of keys in the store
+// and a "fail" value fails the message.
+type parallelKeyValueImpl struct{}
+
+func (m parallelKeyValueImpl) Set(ctx context.Context, msg *baseapptestutil.MsgKeyValue) (*baseapptestutil.MsgCreateKeyValueResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := sdkCtx.KVStore(capKey2)
+
+	prev := store.Get(msg.Key)
+	sdkCtx.GasMeter().ConsumeGas(uint64(len(prev)), "previous value")
+
+	value := msg.Value
+	switch {
+	case bytes.Equal(value, []byte("fail")):
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+
+	case bytes.Equal(value, []byte("count")):
+		it := store.Iterator(nil, nil)
+		count := 0
+		for ; it.Valid(); it.Next() {
+			count++
+		}
+		if err := it.Close(); err != nil {
+			return nil, err
+		}
+		value = []byte(strconv.Itoa(count))
+	}
+
+	store.Set(msg.Key, append(prev, value...))
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		"key_value",
+		sdk.NewAttribute("key", string(msg.Key)),
+		sdk.NewAttribute("length", strconv.Itoa(len(prev)+len(value))),
+	))
+
+	return &baseapptestutil.MsgCreateKeyValueResponse{}, nil
+}
+
+func newParallelTestApp(t *testing.T, maxGas int64, opts ...func(*baseapp.BaseApp)) *baseapp.BaseApp {
+	t.Helper()
+
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			ctx = ctx.WithGasMeter(storetypes.NewGasMeter(100_000))
+			ctx.GasMeter().ConsumeGas(uint64(len(ctx.KVStore(capKey1).Get([]byte("ante")))), "ante")
+			return ctx, nil
+		})
+	}
+
+	suite := NewBaseAppSuite(t, append(opts, anteOpt)...)
+	baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), parallelKeyValueImpl{})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxGas: maxGas},
+		},
+	})
+	require.NoError(t, err)
+
+	return suite.baseApp
+}
+
+func newKeyValueTx(t *testing.T, keyValues ...string) []byte {
+	t.Helper()
+
+	suite := NewBaseAppSuite(t)
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	msgs := make([]sdk.Msg, 0, len(keyValues)/2)
+	for i := 0; i < len(keyValues); i += 2 {
+		msgs = append(msgs, &baseapptestutil.MsgKeyValue{Key: []byte(keyValues[i]), Value: []byte(keyValues[i+1]), Signer: addr.String()})
+	}
+
+	builder := suite.txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	setTxSignature(t, builder, 0)
+
+	txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	return txBytes
+}
+
+func TestParallelExecution(t *testing.T) {
+	var independent [][]byte
+	for i := 0; i < 20; i++ {
+		independent = append(independent, newKeyValueTx(t, fmt.Sprintf("key%d", i), "value"))
+	}
+
+	testCases := map[string]struct {
+		maxGas int64
+		blocks [][][]byte
+	}{
+		"independent transactions": {
+			maxGas: -1,
+			blocks: [][][]byte{independent, independent},
+		},
+		"conflicting transactions": {
+			maxGas: -1,
+			blocks: [][][]byte{
+				{
+					newKeyValueTx(t, "a", "1"),
+					newKeyValueTx(t, "b", "1"),
+					newKeyValueTx(t, "a", "2"),
+					newKeyValueTx(t, "c", "1", "a", "3"),
+					newKeyValueTx(t, "b", "2"),
+					newKeyValueTx(t, "a", "4"),
+				},
+				{
+					newKeyValueTx(t, "a", "5"),
+					newKeyValueTx(t, "d", "1"),
+				},
+			},
+		},
+		"iterating transactions": {
+			maxGas: -1,
+			blocks: [][][]byte{
+				{
+					newKeyValueTx(t, "count1", "count"),
+					newKeyValueTx(t, "x", "1"),
+					newKeyValueTx(t, "count2", "count"),
+					newKeyValueTx(t, "x", "2"),
+					newKeyValueTx(t, "count3", "count"),
+				},
+			},
+		},
+		"failing and malformed transactions": {
+			maxGas: -1,
+			blocks: [][][]byte{
+				{
+					newKeyValueTx(t, "a", "1"),
+					newKeyValueTx(t, "a", "fail"),
+					[]byte("malformed"),
+					newKeyValueTx(t, "b", "1", "a", "fail"),
+					newKeyValueTx(t, "a", "2"),
+				},
+			},
+		},
+		"block gas exhaustion": {
+			maxGas: 25_000,
+			blocks: [][][]byte{independent},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sequential := newParallelTestApp(t, tc.maxGas)
+			parallel := newParallelTestApp(t, tc.maxGas, baseapp.SetParallelExecution(4))
+
+			for i, txs := range tc.blocks {
+				req := &abci.RequestFinalizeBlock{Height: int64(i + 1), Txs: txs}
+
+				expected, err := sequential.FinalizeBlock(req)
+				require.NoError(t, err)
+				_, err = sequential.Commit()
+				require.NoError(t, err)
+
+				actual, err := parallel.FinalizeBlock(req)
+				require.NoError(t, err)
+				_, err = parallel.Commit()
+				require.NoError(t, err)
+
+				require.Equal(t, expected, actual)
+			}
+		})
+	}
+}
+
+func TestParallelExecutionDisallowed(t *testing.T) {
+	disallow := func(bapp *baseapp.BaseApp) {
+		bapp.DisallowParallelExecution("state held in memory")
+	}
+
+	app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), nil, baseapp.SetParallelExecution(4), disallow)
+	app.MountStores(capKey1)
+	require.ErrorContains(t, app.LoadLatestVersion(), "parallel execution is not supported by the application: state held in memory")
+
+	// sequential execution is not restricted
+	app = baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), nil, disallow)
+	app.MountStores(capKey1)
+	require.NoError(t, app.LoadLatestVersion())
+}
@@ -0,0 +1,314 @@
+package baseapp
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/tracekv"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// traceStoreNameKey is the TraceContext metadata key of the name of the traced
+// store.
+const traceStoreNameKey = "store_name"
+
+// StateOverrideHandler applies the state overrides of a simulation other than
+// the ones of the block header, such as the balances or the sequences of
+// accounts, to the branch of the state the transaction is simulated on.
+type StateOverrideHandler func(ctx sdk.Context, overrides *txtypes.StateOverrides) error
+
+// SimulateWithTrace executes a tx in simulate mode like Simulate, on the state
+// modified by the given overrides, and returns a trace of its execution: the gas
+// consumed and the events emitted by the ante handler, each message and the post
+// handler, and the operations on the KV stores. The trace is returned along with
+// the error of the tx if it fails.
+func (app *BaseApp) SimulateWithTrace(txBytes []byte, overrides *txtypes.StateOverrides) (sdk.GasInfo, *sdk.Result, *txtypes.SimulationTrace, error) {
+	ctx, err := app.applyStateOverrides(app.getContextForTx(execModeSimulate, txBytes), overrides)
+	if err != nil {
+		return sdk.GasInfo{}, nil, nil, err
+	}
+
+	tracer := newSimulationTracer()
+	gasInfo, result, _, err := app.runTxWithContext(tracer.tracedContext(ctx), execModeSimulate, txBytes, app.mempool)
+	tracer.finish(gasInfo, err)
+
+	return gasInfo, result, tracer.trace, err
+}
+
+// applyStateOverrides returns the simulation context with the overrides applied.
+func (app *BaseApp) applyStateOverrides(ctx sdk.Context, overrides *txtypes.StateOverrides) (sdk.Context, error) {
+	if overrides == nil {
+		return ctx, nil
+	}
+
+	if err := overrides.ValidateBasic(); err != nil {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	headerInfo := ctx.HeaderInfo()
+	if overrides.BlockHeight != 0 {
+		ctx = ctx.WithBlockHeight(overrides.BlockHeight)
+		headerInfo.Height = overrides.BlockHeight
+	}
+	if overrides.BlockTime != nil {
+		ctx = ctx.WithBlockTime(*overrides.BlockTime)
+		headerInfo.Time = *overrides.BlockTime
+	}
+	ctx = ctx.WithHeaderInfo(headerInfo)
+
+	if len(overrides.Balances) > 0 || len(overrides.Sequences) > 0 {
+		if app.stateOverrideHandler == nil {
+			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "state overrides are not supported by the application")
+		}
+
+		if err := app.stateOverrideHandler(ctx, overrides); err != nil {
+			return ctx, errorsmod.Wrap(err, "failed to apply state overrides")
+		}
+	}
+
+	// the gas consumed by the overrides is not accounted to the tx
+	return ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), nil
+}
+
+// simulationTracerFromContext returns the simulationTracer of the context, or
+// nil if the tx is not traced.
+func simulationTracerFromContext(ctx sdk.Context) *simulationTracer {
+	tracer, _ := sdk.DecoratorTracerFromContext(ctx).(*simulationTracer)
+	return tracer
+}
+
+// simulationTracer records the trace of a simulated or replayed tx. It is the
+// io.Writer of the tracekv stores of the tx, recording their operations in the
+// phase of the tx being executed, and the sdk.DecoratorTracer of its ante and
+// post handlers. Its methods are no-ops on a nil tracer.
+type simulationTracer struct {
+	trace *txtypes.SimulationTrace
+
+	phase    txtypes.TracePhase
+	msgIndex uint32
+	gasStart uint64
+	msgOpen  bool
+
+	// buf holds the trace operation being written by a tracekv store.
+	buf []byte
+	// done releases the traced context.
+	done func()
+}
+
+func newSimulationTracer() *simulationTracer {
+	return &simulationTracer{trace: &txtypes.SimulationTrace{}}
+}
+
+// tracedContext returns the context of a tx traced by the tracer, on a traced
+// branch of the multi-store of the given context. It is released by finish.
+func (t *simulationTracer) tracedContext(ctx sdk.Context) sdk.Context {
+	ctx, t.done = sdk.WithDecoratorTracer(ctx, t)
+	return ctx.WithMultiStore(tracedMultiStore{tracedParent: ctx.MultiStore().CacheMultiStore(), tracer: t})
+}
+
+// startAnte starts the ante handler phase.
+func (t *simulationTracer) startAnte() {
+	if t == nil {
+		return
+	}
+
+	t.phase = txtypes.TracePhase_TRACE_PHASE_ANTE
+}
+
+// endAnte ends the ante handler phase.
+func (t *simulationTracer) endAnte(gasUsed uint64, events []abci.Event) {
+	if t == nil {
+		return
+	}
+
+	t.trace.AnteGasUsed = gasUsed
+	t.trace.AnteEvents = events
+}
+
+// startMsg starts the phase of the message with the given index.
+func (t *simulationTracer) startMsg(index int, msg sdk.Msg, gasConsumed uint64) {
+	if t == nil {
+		return
+	}
+
+	t.phase = txtypes.TracePhase_TRACE_PHASE_MSG
+	t.msgIndex = uint32(index)
+	t.gasStart = gasConsumed
+	t.msgOpen = true
+	t.trace.Msgs = append(t.trace.Msgs, txtypes.MsgTrace{
+		MsgIndex: uint32(index),
+		TypeUrl:  sdk.MsgTypeURL(msg),
+	})
+}
+
+// endMsg ends the phase of the current message.
+func (t *simulationTracer) endMsg(gasConsumed uint64, events []abci.Event, err error) {
+	if t == nil || !t.msgOpen {
+		return
+	}
+
+	msgTrace := &t.trace.Msgs[len(t.trace.Msgs)-1]
+	msgTrace.GasUsed = gasConsumed - t.gasStart
+	msgTrace.Events = events
+	if err != nil {
+		msgTrace.Error = err.Error()
+	}
+	t.msgOpen = false
+}
+
+// startPost starts the post handler phase.
+func (t *simulationTracer) startPost(gasConsumed uint64) {
+	if t == nil {
+		return
+	}
+
+	t.phase = txtypes.TracePhase_TRACE_PHASE_POST
+	t.gasStart = gasConsumed
+}
+
+// endPost ends the post handler phase.
+func (t *simulationTracer) endPost(gasConsumed uint64) {
+	if t == nil {
+		return
+	}
+
+	t.trace.PostGasUsed = gasConsumed - t.gasStart
+}
+
+// TraceDecorator implements sdk.DecoratorTracer.
+func (t *simulationTracer) TraceDecorator(name string, gasUsed uint64) {
+	decoratorTrace := txtypes.DecoratorTrace{Name: name, GasUsed: gasUsed}
+	switch t.phase {
+	case txtypes.TracePhase_TRACE_PHASE_ANTE:
+		t.trace.AnteDecorators = append(t.trace.AnteDecorators, decoratorTrace)
+
+	case txtypes.TracePhase_TRACE_PHASE_POST:
+		t.trace.PostDecorators = append(t.trace.PostDecorators, decoratorTrace)
+	}
+}
+
+// finish releases the traced context and ends the message interrupted by a
+// panic, such as an out of gas one.
+func (t *simulationTracer) finish(gasInfo sdk.GasInfo, err error) {
+	if t.done != nil {
+		t.done()
+	}
+
+	if t.msgOpen && gasInfo.GasUsed >= t.gasStart {
+		t.endMsg(gasInfo.GasUsed, nil, err)
+	}
+}
+
+// Write implements io.Writer. It decodes the operations written by the tracekv
+// stores, one JSON object per line.
+func (t *simulationTracer) Write(p []byte) (int, error) {
+	t.buf = append(t.buf, p...)
+
+	for {
+		i := bytes.IndexByte(t.buf, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+
+		line := t.buf[:i]
+		t.buf = t.buf[i+1:]
+		if len(line) == 0 {
+			continue
+		}
+
+		if err := t.writeOperation(line); err != nil {
+			return 0, err
+		}
+	}
+}
+
+func (t *simulationTracer) writeOperation(line []byte) error {
+	var traceOp struct {
+		Operation string         `json:"operation"`
+		Key       string         `json:"key"`
+		Value     string         `json:"value"`
+		Metadata  map[string]any `json:"metadata"`
+	}
+	if err := json.Unmarshal(line, &traceOp); err != nil {
+		return fmt.Errorf("failed to decode trace operation: %w", err)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(traceOp.Key)
+	if err != nil {
+		return fmt.Errorf("failed to decode trace operation key: %w", err)
+	}
+	value, err := base64.StdEncoding.DecodeString(traceOp.Value)
+	if err != nil {
+		return fmt.Errorf("failed to decode trace operation value: %w", err)
+	}
+
+	op := txtypes.KVOperation{
+		Operation: traceOp.Operation,
+		Key:       key,
+		Value:     value,
+		Phase:     t.phase,
+	}
+	op.Store, _ = traceOp.Metadata[traceStoreNameKey].(string)
+	if t.phase == txtypes.TracePhase_TRACE_PHASE_MSG {
+		op.MsgIndex = t.msgIndex
+	}
+	t.trace.Operations = append(t.trace.Operations, op)
+
+	return nil
+}
+
+// tracedMultiStore traces the operations on the KV stores of a simulation with
+// tracekv stores writing to the simulationTracer. Its branches are traced too,
+// so that every operation is traced once, by the branch it is made on.
+type tracedMultiStore struct {
+	tracedParent
+
+	tracer io.Writer
+}
+
+// tracedParent is the branch of the multi-store traced by a tracedMultiStore.
+type tracedParent = storetypes.CacheMultiStore
+
+var _ storetypes.CacheMultiStore = tracedMultiStore{}
+
+// GetStore implements MultiStore.
+func (ms tracedMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return ms.GetKVStore(key)
+}
+
+// GetKVStore implements MultiStore.
+func (ms tracedMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return tracekv.NewStore(ms.tracedParent.GetKVStore(key), ms.tracer, storetypes.TraceContext{
+		traceStoreNameKey: key.Name(),
+	})
+}
+
+// CacheMultiStore implements MultiStore.
+func (ms tracedMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return tracedMultiStore{tracedParent: ms.tracedParent.CacheMultiStore(), tracer: ms.tracer}
+}
+
+// CacheWrap implements CacheWrapper.
+func (ms tracedMultiStore) CacheWrap() storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+// SetTracer implements MultiStore.
+func (ms tracedMultiStore) SetTracer(w io.Writer) storetypes.MultiStore {
+	return tracedMultiStore{tracedParent: ms.tracedParent.SetTracer(w).(storetypes.CacheMultiStore), tracer: ms.tracer}
+}
+
+// SetTracingContext implements MultiStore.
+func (ms tracedMultiStore) SetTracingContext(tc storetypes.TraceContext) storetypes.MultiStore {
+	return tracedMultiStore{tracedParent: ms.tracedParent.SetTracingContext(tc).(storetypes.CacheMultiStore), tracer: ms.tracer}
+}
@@ -0,0 +1,154 @@
+package baseapp_test
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestSimulateWithTrace(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+
+	var overrideCtx sdk.Context
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+		bapp.SetStateOverrideHandler(func(ctx sdk.Context, overrides *txtypes.StateOverrides) error {
+			overrideCtx = ctx
+			// the balances stand for the ante counter in this test
+			setIntOnStore(ctx.KVStore(capKey1), anteKey, overrides.Balances[0].Amount[0].Amount.Int64())
+			return nil
+		})
+	}
+	suite := NewBaseAppSuite(t, anteOpt)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	tx := newTxCounter(t, suite.txConfig, 0, 0, 1)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	gasInfo, result, trace, err := suite.baseApp.SimulateWithTrace(txBytes, nil)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.NotNil(t, trace)
+
+	// the gas of the messages is the counter gas and the gas of the KV operations
+	require.Len(t, trace.Msgs, 2)
+	for i, msgTrace := range trace.Msgs {
+		require.Equal(t, uint32(i), msgTrace.MsgIndex)
+		require.Equal(t, sdk.MsgTypeURL(&baseapptestutil.MsgCounter{}), msgTrace.TypeUrl)
+		require.Greater(t, msgTrace.GasUsed, uint64(5))
+		require.Empty(t, msgTrace.Error)
+		require.NotEmpty(t, msgTrace.Events)
+	}
+	require.Equal(t, gasInfo.GasUsed, trace.AnteGasUsed+trace.Msgs[0].GasUsed+trace.Msgs[1].GasUsed)
+	require.Equal(t, "ante_handler", trace.AnteEvents[0].Type)
+
+	// the counters are read then written, in the phase they are incremented in
+	var writes []txtypes.KVOperation
+	for _, op := range trace.Operations {
+		require.Equal(t, capKey1.Name(), op.Store)
+		if op.Operation == "write" {
+			writes = append(writes, op)
+		}
+	}
+	require.Len(t, writes, 3)
+	require.Equal(t, txtypes.TracePhase_TRACE_PHASE_ANTE, writes[0].Phase)
+	require.Equal(t, anteKey, writes[0].Key)
+	for i, op := range writes[1:] {
+		require.Equal(t, txtypes.TracePhase_TRACE_PHASE_MSG, op.Phase)
+		require.Equal(t, uint32(i), op.MsgIndex)
+		require.Equal(t, deliverKey, op.Key)
+	}
+	require.Equal(t, "read", trace.Operations[0].Operation)
+	require.Equal(t, anteKey, trace.Operations[0].Key)
+
+	// the simulation did not change the check state
+	store := getCheckStateCtx(suite.baseApp).KVStore(capKey1)
+	require.Equal(t, int64(0), getIntFromStore(t, store, anteKey))
+	require.Equal(t, int64(0), getIntFromStore(t, store, deliverKey))
+
+	// a failing message is traced up to its failure
+	builder := suite.txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgCounter{
+		Counter:       0,
+		FailOnHandler: true,
+		Signer:        tx.GetMsgs()[0].(*baseapptestutil.MsgCounter).Signer,
+	}))
+	builder.SetMemo(tx.GetMemo())
+	setTxSignature(t, builder, 0)
+	txBytes, err = suite.txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	_, result, trace, err = suite.baseApp.SimulateWithTrace(txBytes, nil)
+	require.ErrorContains(t, err, "message handler failure")
+	require.Nil(t, result)
+	require.Len(t, trace.Msgs, 1)
+	require.Contains(t, trace.Msgs[0].Error, "message handler failure")
+
+	// the tx of counter 5 succeeds once the ante counter is overridden
+	tx = newTxCounter(t, suite.txConfig, 5, 0)
+	txBytes, err = suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	blockTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	overrides := &txtypes.StateOverrides{
+		BlockHeight: 100,
+		BlockTime:   &blockTime,
+		Balances: []txtypes.BalanceOverride{
+			{Address: "cosmos1...", Amount: sdk.NewCoins(sdk.NewInt64Coin("counter", 5))},
+		},
+	}
+	_, result, _, err = suite.baseApp.SimulateWithTrace(txBytes, overrides)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, int64(100), overrideCtx.BlockHeight())
+	require.Equal(t, int64(100), overrideCtx.HeaderInfo().Height)
+	require.Equal(t, blockTime, overrideCtx.BlockTime())
+	require.Equal(t, blockTime, overrideCtx.HeaderInfo().Time)
+
+	store = getCheckStateCtx(suite.baseApp).KVStore(capKey1)
+	require.Equal(t, int64(0), getIntFromStore(t, store, anteKey))
+
+	// invalid overrides are rejected
+	overrides.BlockHeight = -1
+	_, _, trace, err = suite.baseApp.SimulateWithTrace(txBytes, overrides)
+	require.ErrorContains(t, err, "block height cannot be negative")
+	require.Nil(t, trace)
+}
+
+func TestSimulateWithTraceNoStateOverrideHandler(t *testing.T) {
+	suite := NewBaseAppSuite(t)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasMeterOnly{})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+
+	// the overrides of the block header are applied by baseapp
+	_, _, trace, err := suite.baseApp.SimulateWithTrace(txBytes, &txtypes.StateOverrides{BlockHeight: 10})
+	require.NoError(t, err)
+	require.Len(t, trace.Msgs, 1)
+
+	_, _, _, err = suite.baseApp.SimulateWithTrace(txBytes, &txtypes.StateOverrides{
+		Sequences: []txtypes.SequenceOverride{{Address: "cosmos1...", Sequence: 1}},
+	})
+	require.ErrorContains(t, err, "state overrides are not supported")
+}
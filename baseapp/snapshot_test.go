@@ -34,8 +34,8 @@ func TestABCI_ListSnapshots(t *testing.T) {
 	}
 
 	require.Equal(t, &abci.ResponseListSnapshots{Snapshots: []*abci.Snapshot{
-		{Height: 4, Format: snapshottypes.CurrentFormat, Chunks: 2},
-		{Height: 2, Format: snapshottypes.CurrentFormat, Chunks: 1},
+		{Height: 4, Format: snapshottypes.CurrentFormat, Chunks: 3},
+		{Height: 2, Format: snapshottypes.CurrentFormat, Chunks: 2},
 	}}, resp)
 }
 
@@ -53,7 +53,7 @@ func TestABCI_SnapshotWithPruning(t *testing.T) {
 				pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
 			},
 			expectedSnapshots: []*abci.Snapshot{
-				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 5},
+				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 6},
 			},
 		},
 		"prune everything with snapshot": {
@@ -65,7 +65,7 @@ func TestABCI_SnapshotWithPruning(t *testing.T) {
 				pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningEverything),
 			},
 			expectedSnapshots: []*abci.Snapshot{
-				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 5},
+				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 6},
 			},
 		},
 		"default pruning with snapshot": {
@@ -77,7 +77,7 @@ func TestABCI_SnapshotWithPruning(t *testing.T) {
 				pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningDefault),
 			},
 			expectedSnapshots: []*abci.Snapshot{
-				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 5},
+				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 6},
 			},
 		},
 		"custom": {
@@ -89,8 +89,8 @@ func TestABCI_SnapshotWithPruning(t *testing.T) {
 				pruningOpts:        pruningtypes.NewCustomPruningOptions(12, 12),
 			},
 			expectedSnapshots: []*abci.Snapshot{
-				{Height: 25, Format: snapshottypes.CurrentFormat, Chunks: 6},
-				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 5},
+				{Height: 25, Format: snapshottypes.CurrentFormat, Chunks: 7},
+				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 6},
 			},
 		},
 		"no snapshots": {
@@ -111,9 +111,9 @@ func TestABCI_SnapshotWithPruning(t *testing.T) {
 				pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
 			},
 			expectedSnapshots: []*abci.Snapshot{
-				{Height: 9, Format: snapshottypes.CurrentFormat, Chunks: 2},
-				{Height: 6, Format: snapshottypes.CurrentFormat, Chunks: 2},
-				{Height: 3, Format: snapshottypes.CurrentFormat, Chunks: 1},
+				{Height: 9, Format: snapshottypes.CurrentFormat, Chunks: 3},
+				{Height: 6, Format: snapshottypes.CurrentFormat, Chunks: 3},
+				{Height: 3, Format: snapshottypes.CurrentFormat, Chunks: 2},
 			},
 		},
 	}
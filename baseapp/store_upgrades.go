@@ -0,0 +1,66 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StoreUpgradeSchedule provides the renames and deletions of stores scheduled
+// by governance, applied online without restarting the node.
+type StoreUpgradeSchedule interface {
+	// StoreUpgradesAt returns the store upgrades scheduled at the given height,
+	// nil if none.
+	StoreUpgradesAt(ctx context.Context, height int64) (*storetypes.StoreUpgrades, error)
+}
+
+// ReleaseStores releases the mounted stores of the given keys, which are no
+// longer used by the modules, so that governance can delete them online. The
+// stores can no longer be accessed. It requires the multistore to be a
+// rootmulti.Store.
+func (app *BaseApp) ReleaseStores(keys ...storetypes.StoreKey) {
+	if app.sealed {
+		panic("ReleaseStores() on sealed BaseApp")
+	}
+
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		panic(fmt.Errorf("releasing stores requires a rootmulti.Store, got %T", app.cms))
+	}
+
+	for _, key := range keys {
+		rms.ReleaseStore(key)
+	}
+}
+
+// scheduleStoreUpgrades schedules the store upgrades of the block on the
+// multistore, which applies them once the writes of the block are done.
+func (app *BaseApp) scheduleStoreUpgrades(ctx sdk.Context) error {
+	if app.storeUpgradeSchedule == nil {
+		return nil
+	}
+
+	upgrades, err := app.storeUpgradeSchedule.StoreUpgradesAt(ctx, ctx.BlockHeight())
+	if err != nil || upgrades == nil {
+		return err
+	}
+
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return fmt.Errorf("store upgrades require a rootmulti.Store, got %T", app.cms)
+	}
+
+	// the upgrades are checked against the mounted stores, invalid ones are
+	// skipped by all the nodes rather than halting the chain
+	if err := rms.ScheduleStoreUpgrades(ctx.BlockHeight(), upgrades); err != nil {
+		app.logger.Error("skipping invalid store upgrades", "height", ctx.BlockHeight(), "err", err)
+		return nil
+	}
+
+	app.logger.Info("applying store upgrades", "height", ctx.BlockHeight(), "renamed", upgrades.Renamed, "deleted", upgrades.Deleted)
+	return nil
+}
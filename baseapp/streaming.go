@@ -2,12 +2,16 @@ package baseapp
 
 import (
 	"fmt"
+	"path/filepath"
 	"sort"
 	"strings"
 
 	"github.com/spf13/cast"
 
 	"cosmossdk.io/store/streaming"
+	"cosmossdk.io/store/streaming/file"
+	"cosmossdk.io/store/streaming/queue"
+	"cosmossdk.io/store/streaming/sqlite"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -20,6 +24,17 @@ const (
 	StreamingABCIPluginTomlKey        = "plugin"
 	StreamingABCIKeysTomlKey          = "keys"
 	StreamingABCIStopNodeOnErrTomlKey = "stop-node-on-err"
+
+	StreamingFileTomlKey            = "file"
+	StreamingSQLiteTomlKey          = "sqlite"
+	StreamingEnableTomlKey          = "enable"
+	StreamingBufferSizeTomlKey      = "buffer-size"
+	StreamingFileDirTomlKey         = "dir"
+	StreamingFileMaxFileSizeTomlKey = "max-file-size"
+	StreamingFileMaxFilesTomlKey    = "max-files"
+	StreamingFileFsyncTomlKey       = "fsync"
+	StreamingSQLitePathTomlKey      = "path"
+	StreamingSQLiteDriverTomlKey    = "driver"
 )
 
 // RegisterStreamingServices registers streaming services with the BaseApp.
@@ -41,9 +56,85 @@ func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, ke
 		}
 	}
 
+	return app.registerStreamingListeners(appOpts, keys)
+}
+
+// registerStreamingListeners registers the built-in streaming listeners which
+// are enabled.
+func (app *BaseApp) registerStreamingListeners(appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) error {
+	home := cast.ToString(appOpts.Get(flags.FlagHome))
+
+	if cast.ToBool(appOpts.Get(streamingOptKey(StreamingFileTomlKey, StreamingEnableTomlKey))) {
+		cfg := file.Config{
+			Dir:         streamingPath(home, cast.ToString(appOpts.Get(streamingOptKey(StreamingFileTomlKey, StreamingFileDirTomlKey))), "streaming"),
+			MaxFileSize: cast.ToInt64(appOpts.Get(streamingOptKey(StreamingFileTomlKey, StreamingFileMaxFileSizeTomlKey))),
+			MaxFiles:    cast.ToInt(appOpts.Get(streamingOptKey(StreamingFileTomlKey, StreamingFileMaxFilesTomlKey))),
+			Fsync:       cast.ToBool(appOpts.Get(streamingOptKey(StreamingFileTomlKey, StreamingFileFsyncTomlKey))),
+		}
+		listener, err := file.NewListener(cfg, app.streamingListenerOptions(appOpts, StreamingFileTomlKey, keys))
+		if err != nil {
+			return fmt.Errorf("failed to create file streaming listener: %w", err)
+		}
+		app.logger.Info("streaming blocks to files", "dir", cfg.Dir)
+		app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, listener)
+	}
+
+	if cast.ToBool(appOpts.Get(streamingOptKey(StreamingSQLiteTomlKey, StreamingEnableTomlKey))) {
+		cfg := sqlite.Config{
+			Path:   streamingPath(home, cast.ToString(appOpts.Get(streamingOptKey(StreamingSQLiteTomlKey, StreamingSQLitePathTomlKey))), "streaming.db"),
+			Driver: cast.ToString(appOpts.Get(streamingOptKey(StreamingSQLiteTomlKey, StreamingSQLiteDriverTomlKey))),
+		}
+		listener, err := sqlite.NewListener(cfg, app.streamingListenerOptions(appOpts, StreamingSQLiteTomlKey, keys))
+		if err != nil {
+			return fmt.Errorf("failed to create sqlite streaming listener: %w", err)
+		}
+		app.logger.Info("streaming blocks to sqlite", "path", cfg.Path)
+		app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, listener)
+	}
+
 	return nil
 }
 
+// streamingListenerOptions returns the options of a built-in streaming
+// listener, listening to the stores it exposes.
+func (app *BaseApp) streamingListenerOptions(
+	appOpts servertypes.AppOptions,
+	service string,
+	keys map[string]*storetypes.KVStoreKey,
+) queue.Options {
+	exposedKeys := exposeStoreKeysSorted(cast.ToStringSlice(appOpts.Get(streamingOptKey(service, StreamingABCIKeysTomlKey))), keys)
+	app.cms.AddListeners(exposedKeys)
+
+	names := make([]string, len(exposedKeys))
+	for i, key := range exposedKeys {
+		names[i] = key.Name()
+	}
+
+	return queue.Options{
+		BufferSize: cast.ToInt(appOpts.Get(streamingOptKey(service, StreamingBufferSizeTomlKey))),
+		// the other stores may be listened to by other services, their
+		// changes are filtered out
+		Keys:          names,
+		StopNodeOnErr: cast.ToBool(appOpts.Get(streamingOptKey(service, StreamingABCIStopNodeOnErrTomlKey))),
+	}
+}
+
+func streamingOptKey(service, key string) string {
+	return fmt.Sprintf("%s.%s.%s", StreamingTomlKey, service, key)
+}
+
+// streamingPath returns the path of a streaming sink, relative to the data
+// directory of the node home unless absolute.
+func streamingPath(home, path, defaultPath string) string {
+	if path == "" {
+		path = defaultPath
+	}
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(home, "data", path)
+}
+
 // registerStreamingPlugin registers streaming plugins with the BaseApp.
 func (app *BaseApp) registerStreamingPlugin(
 	appOpts servertypes.AppOptions,
@@ -71,12 +162,8 @@ func (app *BaseApp) registerABCIListenerPlugin(
 	exposeKeysStr := cast.ToStringSlice(appOpts.Get(keysKey))
 	exposedKeys := exposeStoreKeysSorted(exposeKeysStr, keys)
 	app.cms.AddListeners(exposedKeys)
-	app.SetStreamingManager(
-		storetypes.StreamingManager{
-			ABCIListeners: []storetypes.ABCIListener{abciListener},
-			StopNodeOnErr: stopNodeOnErr,
-		},
-	)
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, abciListener)
+	app.streamingManager.StopNodeOnErr = stopNodeOnErr
 }
 
 func exposeAll(list []string) bool {
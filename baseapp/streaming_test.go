@@ -3,16 +3,21 @@ package baseapp_test
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/store/streaming/file"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var _ storetypes.ABCIListener = (*MockABCIListener)(nil)
@@ -144,3 +149,60 @@ func Test_Ctx_with_StreamingManager(t *testing.T) {
 		suite.baseApp.Commit()
 	}
 }
+
+func TestRegisterStreamingServices_FileListener(t *testing.T) {
+	distOpt := func(bapp *baseapp.BaseApp) { bapp.MountStores(distKey1) }
+	beginBlockerOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetBeginBlocker(func(ctx sdk.Context) (sdk.BeginBlock, error) {
+			ctx.KVStore(distKey1).Set([]byte{byte(ctx.BlockHeight())}, []byte("value"))
+			ctx.KVStore(capKey1).Set([]byte{byte(ctx.BlockHeight())}, []byte("value"))
+			return sdk.BeginBlock{}, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, distOpt, beginBlockerOpt)
+
+	dir := t.TempDir()
+	appOpts := simtestutil.AppOptionsMap{
+		"streaming.file.enable":      true,
+		"streaming.file.keys":        []string{distKey1.Name()},
+		"streaming.file.dir":         dir,
+		"streaming.file.buffer-size": 10,
+	}
+	keys := map[string]*storetypes.KVStoreKey{capKey1.Name(): capKey1, distKey1.Name(): distKey1}
+	require.NoError(t, suite.baseApp.RegisterStreamingServices(appOpts, keys))
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{ConsensusParams: &tmproto.ConsensusParams{}})
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 2; height++ {
+		_, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+	// closing the app flushes the buffered records
+	require.NoError(t, suite.baseApp.Close())
+
+	files, err := file.Files(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	f, err := os.Open(files[0])
+	require.NoError(t, err)
+	defer f.Close()
+
+	reader := file.NewReader(f)
+	for height := int64(1); height <= 2; height++ {
+		record, err := reader.Next()
+		require.NoError(t, err)
+		require.Equal(t, height, record.GetFinalizeBlock().Req.Height)
+
+		record, err = reader.Next()
+		require.NoError(t, err)
+		commit := record.GetCommit()
+		require.Equal(t, height, commit.BlockHeight)
+		// only the changes of the exposed stores are written
+		require.Equal(t, []*storetypes.StoreKVPair{{StoreKey: distKey1.Name(), Key: []byte{byte(height)}, Value: []byte("value")}}, commit.ChangeSet)
+	}
+	_, err = reader.Next()
+	require.ErrorIs(t, err, io.EOF)
+}
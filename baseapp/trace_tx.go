@@ -0,0 +1,174 @@
+package baseapp
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	coreheader "cosmossdk.io/core/header"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// TraceTx re-executes the tx of the given index of a committed block and
+// returns a trace of its execution, like SimulateWithTrace. The block is
+// replayed on a branch of the state of the previous height up to the tx: the
+// PreBlocker and the BeginBlocker are run, then the txs before it are executed.
+// The state of the previous height must not be pruned, as on archive nodes, or
+// must be held by the archive. The replay is run with a context holding an
+// sdk.Replay: the components holding state outside of the stores must honour
+// it, as the PreBlocker of the application and the ante handler are run.
+//
+// The results of the txs of the block when it was committed, if given, keep
+// the replay from diverging: a tx which failed when committed but succeeds on
+// replay, e.g. as the duplicate of an unordered tx which expired since, is
+// discarded, and the tx is reported as failing if it is the traced one.
+//
+// The trace is nil if the block could not be replayed up to the tx, and the
+// returned error is the one of the tx otherwise.
+func (app *BaseApp) TraceTx(req *abci.RequestFinalizeBlock, txResults []*abci.ExecTxResult, txIndex int) (gInfo sdk.GasInfo, result *sdk.Result, trace *txtypes.SimulationTrace, err error) {
+	if txIndex < 0 || txIndex >= len(req.Txs) {
+		return sdk.GasInfo{}, nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid tx index %d in block of %d txs", txIndex, len(req.Txs))
+	}
+
+	if req.Height <= 1 || req.Height > app.LastBlockHeight() {
+		return sdk.GasInfo{}, nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidHeight, "cannot replay block %d (latest height: %d)", req.Height, app.LastBlockHeight())
+	}
+
+	txBytes := req.Txs[txIndex]
+	if _, err := app.txDecoder(txBytes); err != nil {
+		return sdk.GasInfo{}, nil, nil, err
+	}
+
+	ms, err := app.cacheMultiStoreWithVersion(req.Height - 1)
+	if err != nil {
+		return sdk.GasInfo{}, nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "failed to load state at height %d; %s", req.Height-1, err)
+	}
+
+	defer func() {
+		// the modules may panic replaying the block, as they do in FinalizeBlock
+		if r := recover(); r != nil {
+			gInfo, result, trace, err = sdk.GasInfo{}, nil, nil, fmt.Errorf("panic replaying block %d: %v", req.Height, r)
+		}
+	}()
+
+	ctx, err := app.replayBlock(ms, req, txResults, txIndex)
+	if err != nil {
+		return sdk.GasInfo{}, nil, nil, errorsmod.Wrapf(err, "failed to replay block %d", req.Height)
+	}
+
+	tracer := newSimulationTracer()
+	ctx = tracer.tracedContext(app.txContext(ctx, execModeFinalize, txBytes))
+	gInfo, result, _, err = app.runTxWithContext(ctx, execModeFinalize, txBytes, mempool.NoOpMempool{})
+	tracer.finish(gInfo, err)
+
+	if committed := txResult(txResults, txIndex); err == nil && committed != nil && !committed.IsOK() {
+		err = errorsmod.Wrapf(sdkerrors.ErrLogic, "the tx succeeds on replay but failed when committed: %s", committed.Log)
+	}
+
+	return gInfo, result, tracer.trace, err
+}
+
+// replayBlock executes the given block on the multi-store up to the tx of the
+// given index, like FinalizeBlock does, and returns the context to execute the
+// tx in. The txs which failed when committed according to the given results,
+// if any, but succeed on replay are discarded.
+func (app *BaseApp) replayBlock(ms storetypes.CacheMultiStore, req *abci.RequestFinalizeBlock, txResults []*abci.ExecTxResult, txIndex int) (sdk.Context, error) {
+	// the app hash of the block is the hash of the commit of the previous height
+	var appHash []byte
+	if rms, ok := app.cms.(*rootmulti.Store); ok {
+		if cInfo, err := rms.GetCommitInfo(req.Height - 1); err == nil {
+			appHash = cInfo.Hash()
+		}
+	}
+
+	header := cmtproto.Header{
+		ChainID:            app.chainID,
+		Height:             req.Height,
+		Time:               req.Time,
+		ProposerAddress:    req.ProposerAddress,
+		NextValidatorsHash: req.NextValidatorsHash,
+		AppHash:            appHash,
+	}
+
+	// the replay is not logged, so that it is not mistaken for the execution of
+	// a new block
+	ctx := sdk.NewContext(ms, header, false, log.NewNopLogger()).
+		WithHeaderHash(req.Hash).
+		WithHeaderInfo(coreheader.Info{
+			ChainID: app.chainID,
+			Height:  req.Height,
+			Time:    req.Time,
+			Hash:    req.Hash,
+			AppHash: appHash,
+		}).
+		WithVoteInfos(req.DecidedLastCommit.Votes).
+		WithExecMode(sdk.ExecModeFinalize).
+		WithCometInfo(cometInfo{
+			Misbehavior:     req.Misbehavior,
+			ValidatorsHash:  req.NextValidatorsHash,
+			ProposerAddress: req.ProposerAddress,
+			LastCommit:      req.DecidedLastCommit,
+		})
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+	ctx = ctx.WithBlockGasMeter(app.getBlockGasMeter(ctx))
+	// the components holding state in memory must not change it
+	ctx = sdk.WithReplay(ctx)
+
+	if app.preBlocker != nil {
+		preBlockCtx := ctx.WithEventManager(sdk.NewEventManager())
+		rsp, err := app.preBlocker(preBlockCtx, req)
+		if err != nil {
+			return ctx, err
+		}
+
+		if rsp.ConsensusParamsChanged {
+			ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+		}
+	}
+
+	if app.beginBlocker != nil {
+		if _, err := app.beginBlocker(ctx.WithEventManager(sdk.NewEventManager())); err != nil {
+			return ctx, err
+		}
+	}
+
+	ctx = ctx.WithBlockGasMeter(app.getBlockGasMeter(ctx))
+
+	for i, rawTx := range req.Txs[:txIndex] {
+		// the txs which are not sdk.Tx are skipped, like FinalizeBlock does
+		if _, err := app.txDecoder(rawTx); err != nil {
+			continue
+		}
+
+		// the txs are executed on the replayed block, whether they fail or not,
+		// on a branch which is discarded if the tx diverges from its execution
+		// when committed
+		txMs := ctx.MultiStore().CacheMultiStore()
+		txCtx := sdk.WithReplay(ctx.WithMultiStore(txMs))
+		_, _, _, err := app.runTxWithContext(app.txContext(txCtx, execModeFinalize, rawTx), execModeFinalize, rawTx, mempool.NoOpMempool{})
+		if committed := txResult(txResults, i); err == nil && committed != nil && !committed.IsOK() {
+			continue
+		}
+		txMs.Write()
+		sdk.ReplayFromContext(txCtx).Write()
+	}
+
+	return ctx, nil
+}
+
+// txResult returns the result of the tx of the given index, or nil if unknown.
+func txResult(txResults []*abci.ExecTxResult, txIndex int) *abci.ExecTxResult {
+	if txIndex >= len(txResults) {
+		return nil
+	}
+	return txResults[txIndex]
+}
@@ -0,0 +1,125 @@
+package baseapp_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestTraceTx(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+
+	var beginBlocks []time.Time
+	opt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+		bapp.SetBeginBlocker(func(ctx sdk.Context) (sdk.BeginBlock, error) {
+			beginBlocks = append(beginBlocks, ctx.BlockTime())
+			return sdk.BeginBlock{}, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, opt)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	nBlocks := 3
+	txPerHeight := 3
+	blocks := make([]*abci.RequestFinalizeBlock, nBlocks)
+	txResults := make([][]*abci.ExecTxResult, nBlocks)
+	for blockN := 0; blockN < nBlocks; blockN++ {
+		txs := [][]byte{}
+		for i := 0; i < txPerHeight; i++ {
+			counter := int64(blockN*txPerHeight + i)
+			txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, counter, counter))
+			require.NoError(t, err)
+
+			txs = append(txs, txBytes)
+		}
+
+		blocks[blockN] = &abci.RequestFinalizeBlock{
+			Height: int64(blockN) + 1,
+			Time:   time.Unix(int64(blockN)+1, 0).UTC(),
+			Txs:    txs,
+		}
+		res, err := suite.baseApp.FinalizeBlock(blocks[blockN])
+		require.NoError(t, err)
+		txResults[blockN] = res.TxResults
+
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	// the second tx of the second block is executed after the first one
+	beginBlocks = nil
+	gasInfo, result, trace, err := suite.baseApp.TraceTx(blocks[1], txResults[1], 1)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, []time.Time{blocks[1].Time}, beginBlocks)
+	require.Equal(t, gasInfo.GasUsed, trace.AnteGasUsed+trace.Msgs[0].GasUsed)
+	require.Len(t, trace.Msgs, 1)
+	require.Equal(t, sdk.MsgTypeURL(&baseapptestutil.MsgCounter{}), trace.Msgs[0].TypeUrl)
+
+	writes := traceWrites(trace)
+	require.Len(t, writes, 2)
+	require.Equal(t, txtypes.TracePhase_TRACE_PHASE_ANTE, writes[0].Phase)
+	require.Equal(t, anteKey, writes[0].Key)
+	require.Equal(t, int64(5), readVarint(t, writes[0].Value))
+	require.Equal(t, txtypes.TracePhase_TRACE_PHASE_MSG, writes[1].Phase)
+	require.Equal(t, deliverKey, writes[1].Key)
+	require.Equal(t, int64(5), readVarint(t, writes[1].Value))
+
+	// the replay did not change the state
+	require.Equal(t, int64(nBlocks), suite.baseApp.LastBlockHeight())
+	store := getCheckStateCtx(suite.baseApp).KVStore(capKey1)
+	require.Equal(t, int64(nBlocks*txPerHeight), getIntFromStore(t, store, anteKey))
+
+	// the state before the first block is not committed
+	_, _, trace, err = suite.baseApp.TraceTx(blocks[0], txResults[0], 0)
+	require.ErrorContains(t, err, "cannot replay block 1")
+	require.Nil(t, trace)
+
+	_, _, trace, err = suite.baseApp.TraceTx(blocks[1], txResults[1], txPerHeight)
+	require.ErrorContains(t, err, "invalid tx index")
+	require.Nil(t, trace)
+
+	// a tx which failed when committed but succeeds on replay is reported as
+	// failing
+	failed := []*abci.ExecTxResult{txResults[1][0], {Code: 1, Log: "committed failure"}}
+	_, _, trace, err = suite.baseApp.TraceTx(blocks[1], failed, 1)
+	require.ErrorContains(t, err, "the tx succeeds on replay but failed when committed: committed failure")
+	require.NotNil(t, trace)
+}
+
+func traceWrites(trace *txtypes.SimulationTrace) []txtypes.KVOperation {
+	var writes []txtypes.KVOperation
+	for _, op := range trace.Operations {
+		if op.Operation == "write" {
+			writes = append(writes, op)
+		}
+	}
+
+	return writes
+}
+
+func readVarint(t *testing.T, bz []byte) int64 {
+	t.Helper()
+
+	i, err := binary.ReadVarint(bytes.NewBuffer(bz))
+	require.NoError(t, err)
+
+	return i
+}
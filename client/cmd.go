@@ -3,7 +3,9 @@ package client
 import (
 	"context"
 	"crypto/tls"
+	"encoding/hex"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/cockroachdb/errors"
@@ -200,7 +202,50 @@ func readQueryCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context,
 		clientCtx = clientCtx.WithUseLedger(useLedger)
 	}
 
-	return ReadPersistentCommandFlags(clientCtx, flagSet)
+	clientCtx, err := ReadPersistentCommandFlags(clientCtx, flagSet)
+	if err != nil {
+		return clientCtx, err
+	}
+
+	if clientCtx.Verifier == nil || flagSet.Changed(flags.FlagVerify) {
+		verify, _ := flagSet.GetBool(flags.FlagVerify)
+		if !verify {
+			return clientCtx.WithVerifier(nil), nil
+		}
+
+		verifier, err := newLightVerifierFromFlags(clientCtx, flagSet)
+		if err != nil {
+			return clientCtx, err
+		}
+		clientCtx = clientCtx.WithVerifier(verifier)
+	}
+
+	return clientCtx, nil
+}
+
+// newLightVerifierFromFlags returns a LightVerifier of the headers served by
+// the node of the context, keeping the trusted headers in the home directory.
+func newLightVerifierFromFlags(clientCtx Context, flagSet *pflag.FlagSet) (*LightVerifier, error) {
+	if clientCtx.NodeURI == "" {
+		return nil, errors.New("a node is required to verify the queries")
+	}
+
+	trustHeight, _ := flagSet.GetInt64(flags.FlagTrustHeight)
+	trustHashStr, _ := flagSet.GetString(flags.FlagTrustHash)
+	trustHash, err := hex.DecodeString(trustHashStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trust hash: %w", err)
+	}
+	trustingPeriod, _ := flagSet.GetDuration(flags.FlagTrustingPeriod)
+	witnesses, _ := flagSet.GetStringSlice(flags.FlagWitnesses)
+
+	return NewLightVerifier(clientCtx.cmdContext(), clientCtx.ChainID, clientCtx.NodeURI, LightVerifierConfig{
+		Dir:            filepath.Join(clientCtx.HomeDir, "light"),
+		TrustHeight:    trustHeight,
+		TrustHash:      trustHash,
+		TrustingPeriod: trustingPeriod,
+		Witnesses:      witnesses,
+	})
 }
 
 // readTxCommandFlags returns an updated Context with fields set based on flags
@@ -60,6 +60,10 @@ type Context struct {
 	// IsAux is true when the signer is an auxiliary signer (e.g. the tipper).
 	IsAux bool
 
+	// Verifier, when set, verifies the proofs of the store queries against
+	// the app hashes it provides. The queries which carry no proof fail.
+	Verifier Verifier
+
 	// TODO: Deprecated (remove).
 	LegacyAmino *codec.LegacyAmino
 
@@ -138,6 +142,12 @@ func (ctx Context) WithHeight(height int64) Context {
 	return ctx
 }
 
+// WithVerifier returns a copy of the context with an updated Verifier.
+func (ctx Context) WithVerifier(verifier Verifier) Context {
+	ctx.Verifier = verifier
+	return ctx
+}
+
 // WithClient returns a copy of the context with an updated RPC client
 // instance.
 func (ctx Context) WithClient(client CometRPC) Context {
@@ -0,0 +1,301 @@
+package debug
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/spf13/cobra"
+
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	"cosmossdk.io/store/streaming/file"
+	storetypes "cosmossdk.io/store/types"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const flagAll = "all"
+
+// CompareChangesetsCmd returns a command comparing the changesets of the blocks
+// written by two nodes, to find the first key on which they diverged.
+func CompareChangesetsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compare-changesets <path1> <path2>",
+		Short: "Compare the changesets of the blocks of two nodes and show the first differing key",
+		Long: `Compare the changesets of the blocks of two nodes and show the first differing key.
+
+The paths are files or directories of files written by the file streaming listener
+(streaming.file in app.toml), or files written by "debug state export-changeset". The
+heights known by both sides are compared in order, up to the first one whose changesets
+or app hashes differ. Only files of the same kind should be compared: the streamed
+changesets hold all the writes of the blocks, including the ones which do not change
+the values, while the exported ones only hold the changes.`,
+		Example: `compare-changesets node1/data/streaming node2/data/streaming
+compare-changesets changeset1.pb changeset2.pb --all`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stores, _ := cmd.Flags().GetStringSlice(flagStore)
+			var blocks [2]map[int64]*blockChangeset
+			for i, path := range args {
+				var err error
+				if blocks[i], err = readChangesets(path, stores); err != nil {
+					return fmt.Errorf("failed to read %s: %w", path, err)
+				}
+			}
+
+			var heights []int64
+			for height := range blocks[0] {
+				if _, ok := blocks[1][height]; ok {
+					heights = append(heights, height)
+				}
+			}
+			if len(heights) == 0 {
+				return errors.New("the changesets have no height in common")
+			}
+			sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+
+			all, _ := cmd.Flags().GetBool(flagAll)
+			for _, height := range heights {
+				a, b := blocks[0][height], blocks[1][height]
+				diffs := compareChangesets(a, b)
+				appHashDiffers := a.appHash != nil && b.appHash != nil && !bytes.Equal(a.appHash, b.appHash)
+				if len(diffs) == 0 && !appHashDiffers {
+					continue
+				}
+
+				cmd.Printf("height %d differs: %d differing keys\n", height, len(diffs))
+				if appHashDiffers {
+					cmd.Printf("app hash: %X != %X\n", a.appHash, b.appHash)
+				}
+				if !all && len(diffs) > 1 {
+					diffs = diffs[:1]
+				}
+				for _, diff := range diffs {
+					cmd.Printf("store %s, key %X: %s != %s\n", diff.store, diff.key, formatChange(diff.a), formatChange(diff.b))
+				}
+				return nil
+			}
+
+			cmd.Printf("the changesets of the %d heights in common, from %d to %d, match\n", len(heights), heights[0], heights[len(heights)-1])
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSlice(flagStore, nil, "Only compare the changes of the stores")
+	cmd.Flags().Bool(flagAll, false, "Show all the differing keys of the first differing height")
+
+	return cmd
+}
+
+// changeKey is the key of a change of a changeset.
+type changeKey struct {
+	store, key string
+}
+
+// blockChangeset is the last change of each key of a block, with the app hash
+// of the block if known.
+type blockChangeset struct {
+	appHash   []byte
+	changes   map[changeKey]*storetypes.StoreKVPair
+	committed bool
+}
+
+// readChangesets reads the changesets of the committed blocks of a file, or of
+// the files of a directory, keeping the changes of the given stores if any.
+// A block written again, when replayed after a restart, replaces the previous
+// one.
+func readChangesets(path string, stores []string) (map[int64]*blockChangeset, error) {
+	paths := []string{path}
+	if info, err := os.Stat(path); err != nil {
+		return nil, err
+	} else if info.IsDir() {
+		if paths, err = file.Files(path); err != nil {
+			return nil, err
+		}
+	}
+
+	filtered := make(map[string]bool, len(stores))
+	for _, store := range stores {
+		filtered[store] = true
+	}
+
+	blocks := make(map[int64]*blockChangeset)
+	for _, path := range paths {
+		if err := readChangesetFile(path, blocks, filtered); err != nil {
+			return nil, err
+		}
+	}
+
+	for height, block := range blocks {
+		if !block.committed {
+			delete(blocks, height)
+		}
+	}
+	return blocks, nil
+}
+
+func readChangesetFile(path string, blocks map[int64]*blockChangeset, stores map[string]bool) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	reader := file.NewReader(f)
+	for {
+		record, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch r := record.Record.(type) {
+		case *streamingabci.Record_FinalizeBlock:
+			blocks[r.FinalizeBlock.Req.Height] = &blockChangeset{
+				appHash: r.FinalizeBlock.Res.AppHash,
+				changes: make(map[changeKey]*storetypes.StoreKVPair),
+			}
+
+		case *streamingabci.Record_Commit:
+			block, ok := blocks[r.Commit.BlockHeight]
+			if !ok || block.committed {
+				block = &blockChangeset{changes: make(map[changeKey]*storetypes.StoreKVPair)}
+				blocks[r.Commit.BlockHeight] = block
+			}
+			block.committed = true
+			for _, pair := range r.Commit.ChangeSet {
+				if len(stores) > 0 && !stores[pair.StoreKey] {
+					continue
+				}
+				block.changes[changeKey{pair.StoreKey, string(pair.Key)}] = pair
+			}
+		}
+	}
+}
+
+// changeDiff is a key changed differently by two changesets, a change being nil
+// if the key is not changed.
+type changeDiff struct {
+	store string
+	key   []byte
+	a, b  *storetypes.StoreKVPair
+}
+
+// compareChangesets returns the keys changed differently by two changesets,
+// sorted by store and key.
+func compareChangesets(a, b *blockChangeset) []changeDiff {
+	var diffs []changeDiff
+	for k, pairA := range a.changes {
+		pairB := b.changes[k]
+		if pairB == nil || pairA.Delete != pairB.Delete || !bytes.Equal(pairA.Value, pairB.Value) {
+			diffs = append(diffs, changeDiff{store: k.store, key: []byte(k.key), a: pairA, b: pairB})
+		}
+	}
+	for k, pairB := range b.changes {
+		if _, ok := a.changes[k]; !ok {
+			diffs = append(diffs, changeDiff{store: k.store, key: []byte(k.key), b: pairB})
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		if diffs[i].store != diffs[j].store {
+			return diffs[i].store < diffs[j].store
+		}
+		return bytes.Compare(diffs[i].key, diffs[j].key) < 0
+	})
+	return diffs
+}
+
+func formatChange(pair *storetypes.StoreKVPair) string {
+	switch {
+	case pair == nil:
+		return "unchanged"
+	case pair.Delete:
+		return "deleted"
+	case len(pair.Value) == 0:
+		return "empty"
+	default:
+		return fmt.Sprintf("%X", pair.Value)
+	}
+}
+
+func exportChangesetCmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-changeset <height> <file>",
+		Short: "Export the changes of the state committed by a block into a file",
+		Long: `Export the changes of the state committed by a block into a file, computed from the states
+of the height and of the previous one, which must both be kept by the node. The file holds a
+Commit record in the format of the file streaming listener, to be compared with the file of
+another node by "debug compare-changesets".`,
+		Example: "state export-changeset 100 changeset-100.pb",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil || height <= 0 {
+				return fmt.Errorf("invalid height %s", args[0])
+			}
+
+			reader, err := openStateReader(cmd, appCreator, defaultNodeHome, "")
+			if err != nil {
+				return err
+			}
+			defer reader.close()
+
+			to, err := reader.at(height)
+			if err != nil {
+				return err
+			}
+			// the state before the first block is empty
+			from := &stateView{reader: reader, info: &storetypes.CommitInfo{}}
+			if height > 1 {
+				if from, err = reader.at(height - 1); err != nil {
+					return err
+				}
+			}
+
+			var changeSet []*storetypes.StoreKVPair
+			for _, name := range diffStoreNames(from.info, to.info) {
+				err := mergeIterate(from.iterator(name), to.iterator(name), func(key, oldValue, newValue []byte) {
+					if !bytes.Equal(oldValue, newValue) {
+						changeSet = append(changeSet, &storetypes.StoreKVPair{
+							StoreKey: name,
+							Delete:   newValue == nil,
+							Key:      key,
+							Value:    newValue,
+						})
+					}
+				})
+				if err != nil {
+					return fmt.Errorf("failed to diff store %s: %w", name, err)
+				}
+			}
+
+			f, err := os.Create(args[1])
+			if err != nil {
+				return err
+			}
+			// the records are delimited as by the file streaming listener
+			err = protoio.NewDelimitedWriter(f).WriteMsg(&streamingabci.Record{
+				Record: &streamingabci.Record_Commit{
+					Commit: &streamingabci.ListenCommitRequest{BlockHeight: height, Res: &abci.ResponseCommit{}, ChangeSet: changeSet},
+				},
+			})
+			if err := errors.Join(err, f.Close()); err != nil {
+				return err
+			}
+
+			cmd.Printf("exported %d changes of height %d\n", len(changeSet), height)
+			return nil
+		},
+	}
+
+	return cmd
+}
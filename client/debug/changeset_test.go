@@ -0,0 +1,104 @@
+package debug
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/stretchr/testify/require"
+
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// writeChangesets writes the blocks of the given changesets as the file
+// streaming listener, starting at height 1.
+func writeChangesets(t *testing.T, path string, changeSets ...[]*storetypes.StoreKVPair) {
+	t.Helper()
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	w := protoio.NewDelimitedWriter(f)
+	for i, changeSet := range changeSets {
+		height := int64(i + 1)
+		require.NoError(t, w.WriteMsg(&streamingabci.Record{
+			Record: &streamingabci.Record_FinalizeBlock{FinalizeBlock: &streamingabci.ListenFinalizeBlockRequest{
+				Req: &abci.RequestFinalizeBlock{Height: height},
+				Res: &abci.ResponseFinalizeBlock{AppHash: []byte{byte(height)}},
+			}},
+		}))
+		require.NoError(t, w.WriteMsg(&streamingabci.Record{
+			Record: &streamingabci.Record_Commit{Commit: &streamingabci.ListenCommitRequest{
+				BlockHeight: height,
+				Res:         &abci.ResponseCommit{},
+				ChangeSet:   changeSet,
+			}},
+		}))
+	}
+}
+
+func TestCompareChangesets(t *testing.T) {
+	dir := t.TempDir()
+	block1 := []*storetypes.StoreKVPair{
+		{StoreKey: "bank", Key: []byte{1}, Value: []byte{1}},
+		{StoreKey: "acc", Key: []byte{1}, Value: []byte{1}},
+	}
+
+	// the last write of a key is compared
+	writeChangesets(t, filepath.Join(dir, "a.pb"), block1, []*storetypes.StoreKVPair{
+		{StoreKey: "bank", Key: []byte{2}, Value: []byte{1}},
+		{StoreKey: "bank", Key: []byte{2}, Value: []byte{2}},
+		{StoreKey: "bank", Key: []byte{3}, Delete: true},
+		{StoreKey: "staking", Key: []byte{1}, Value: []byte{1}},
+	})
+	writeChangesets(t, filepath.Join(dir, "b.pb"), block1, []*storetypes.StoreKVPair{
+		{StoreKey: "bank", Key: []byte{2}, Value: []byte{2}},
+		{StoreKey: "staking", Key: []byte{1}, Value: []byte{2}},
+		{StoreKey: "acc", Key: []byte{4}, Value: []byte{}},
+	})
+
+	run := func(args ...string) string {
+		t.Helper()
+		cmd := CompareChangesetsCmd()
+		out := new(bytes.Buffer)
+		cmd.SetOut(out)
+		cmd.SetArgs(args)
+		require.NoError(t, cmd.Execute())
+		return out.String()
+	}
+
+	require.Equal(t, "height 2 differs: 3 differing keys\nstore acc, key 04: unchanged != empty\n",
+		run(filepath.Join(dir, "a.pb"), filepath.Join(dir, "b.pb")))
+	require.Equal(t, `height 2 differs: 3 differing keys
+store acc, key 04: unchanged != empty
+store bank, key 03: deleted != unchanged
+store staking, key 01: 01 != 02
+`, run(filepath.Join(dir, "a.pb"), filepath.Join(dir, "b.pb"), "--all"))
+	require.Equal(t, "the changesets of the 2 heights in common, from 1 to 2, match\n",
+		run(filepath.Join(dir, "a.pb"), filepath.Join(dir, "b.pb"), "--store", "gov"))
+
+	// the files of a directory are read in order, a replayed block replacing
+	// the previous one
+	streamDir := filepath.Join(dir, "streaming")
+	require.NoError(t, os.Mkdir(streamDir, 0o755))
+	writeChangesets(t, filepath.Join(streamDir, fileName(1)), block1, block1)
+	writeChangesets(t, filepath.Join(streamDir, fileName(2)), block1[:1])
+	blocks, err := readChangesets(streamDir, nil)
+	require.NoError(t, err)
+	require.Len(t, blocks, 2)
+	require.Len(t, blocks[1].changes, 1)
+	require.Len(t, blocks[2].changes, 2)
+
+	require.Equal(t, "height 1 differs: 1 differing keys\nstore acc, key 01: 01 != unchanged\n",
+		run(filepath.Join(dir, "a.pb"), streamDir))
+}
+
+// fileName returns the name of a file written by the file streaming listener.
+func fileName(height int64) string {
+	return fmt.Sprintf("stream-%020d.pb", height)
+}
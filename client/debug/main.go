@@ -40,6 +40,8 @@ func Cmd() *cobra.Command {
 	cmd.AddCommand(AddrCmd())
 	cmd.AddCommand(RawBytesCmd())
 	cmd.AddCommand(PrefixesCmd())
+	cmd.AddCommand(CompareChangesetsCmd())
+	cmd.AddCommand(TraceTxCmd())
 
 	return cmd
 }
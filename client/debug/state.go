@@ -0,0 +1,586 @@
+package debug
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/mem"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagAppDBBackend = "app-db-backend"
+	flagHeight       = "height"
+	flagPrefix       = "prefix"
+	flagCollection   = "collection"
+	flagStore        = "store"
+	flagOtherHome    = "other-home"
+)
+
+// HasCollectionsSchemas is implemented by the applications exposing the
+// collections schemas of their modules, by store name, which are used to decode
+// the state read by the state commands.
+type HasCollectionsSchemas interface {
+	CollectionsSchemas() map[string]collections.Schema
+}
+
+// StateCmd returns the group command inspecting the committed state of the
+// application offline, the node must be stopped.
+func StateCmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "Inspect the committed state of the application stores at any height, the node must be stopped",
+		Long: `Inspect the committed state of the application stores at any height kept by the node,
+the node must be stopped.
+
+The records of the collections known by the application are decoded to JSON, the other
+records are shown as hex. Decoding loads the records of the collections in memory.
+
+Note: When the --app-db-backend flag is not specified, the default backend type is 'goleveldb'.
+Supported app-db-backend types include 'goleveldb', 'rocksdb', 'pebbledb'.`,
+		RunE: client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		listStoresCmd(appCreator, defaultNodeHome),
+		dumpStoreCmd(appCreator, defaultNodeHome),
+		diffStateCmd(appCreator, defaultNodeHome),
+		exportChangesetCmd(appCreator, defaultNodeHome),
+	)
+
+	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.PersistentFlags().String(flagAppDBBackend, "", "The type of database for application and snapshots databases")
+
+	return cmd
+}
+
+func listStoresCmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-stores",
+		Short:   "List the stores committed at a height with their hashes",
+		Example: "state list-stores --height 100",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			reader, err := openStateReader(cmd, appCreator, defaultNodeHome, "")
+			if err != nil {
+				return err
+			}
+			defer reader.close()
+
+			height, _ := cmd.Flags().GetInt64(flagHeight)
+			view, err := reader.at(height)
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "height %d, app hash %X\n", view.height, view.info.Hash())
+			fmt.Fprintln(w, "STORE\tVERSION\tHASH\tSCHEMA")
+			for _, info := range view.info.StoreInfos {
+				_, known := reader.schemas[info.Name]
+				fmt.Fprintf(w, "%s\t%d\t%X\t%t\n", info.Name, info.CommitId.Version, info.CommitId.Hash, known)
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "The height to inspect, the latest height by default")
+
+	return cmd
+}
+
+func dumpStoreCmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump <store>",
+		Short: "Dump the records of a store at a height as JSON lines",
+		Long: `Dump the records of a store at a height as JSON lines, in key order. The records of the
+collections known by the application are decoded, the others are shown as hex. The records
+can be filtered by collection or by key prefix.`,
+		Example: "state dump bank --height 100 --collection Balances",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reader, err := openStateReader(cmd, appCreator, defaultNodeHome, "")
+			if err != nil {
+				return err
+			}
+			defer reader.close()
+
+			height, _ := cmd.Flags().GetInt64(flagHeight)
+			view, err := reader.at(height)
+			if err != nil {
+				return err
+			}
+
+			hexPrefix, _ := cmd.Flags().GetString(flagPrefix)
+			prefix, err := hex.DecodeString(hexPrefix)
+			if err != nil {
+				return fmt.Errorf("invalid prefix: %w", err)
+			}
+			if len(prefix) == 0 {
+				prefix = nil
+			}
+			if name, _ := cmd.Flags().GetString(flagCollection); name != "" {
+				coll, err := reader.collection(args[0], name)
+				if err != nil {
+					return err
+				}
+				if !bytes.HasPrefix(prefix, coll.GetPrefix()) {
+					if len(prefix) > 0 && !bytes.HasPrefix(coll.GetPrefix(), prefix) {
+						return fmt.Errorf("the prefix %X is not part of collection %s", prefix, name)
+					}
+					prefix = coll.GetPrefix()
+				}
+			}
+
+			kv, err := view.kvStore(args[0])
+			if err != nil {
+				return err
+			}
+			decoded := view.decode(args[0], prefix)
+
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			itr := storetypes.KVStorePrefixIterator(kv, prefix)
+			defer itr.Close()
+			for ; itr.Valid(); itr.Next() {
+				if err := enc.Encode(newStateRecord(decoded, itr.Key(), itr.Value())); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "The height to inspect, the latest height by default")
+	cmd.Flags().String(flagPrefix, "", "Only dump the records whose keys start with the hex prefix")
+	cmd.Flags().String(flagCollection, "", "Only dump the records of the collection")
+
+	return cmd
+}
+
+func diffStateCmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff <height1> <height2>",
+		Short: "Output the records changed between two heights as JSON lines",
+		Long: `Output the records changed between two heights as JSON lines, store by store, the stores
+having the same hash at both heights being skipped. The records of the collections known by
+the application are decoded, the others are shown as hex.
+
+With --other-home, the second height is read from the database of another node, such as a
+node which computed a different app hash.`,
+		Example: `state diff 100 101 --store bank
+state diff 100 100 --other-home /path/to/other/node`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var heights [2]int64
+			for i, arg := range args {
+				height, err := strconv.ParseInt(arg, 10, 64)
+				if err != nil || height <= 0 {
+					return fmt.Errorf("invalid height %s", arg)
+				}
+				heights[i] = height
+			}
+
+			reader, err := openStateReader(cmd, appCreator, defaultNodeHome, "")
+			if err != nil {
+				return err
+			}
+			defer reader.close()
+
+			otherReader := reader
+			if otherHome, _ := cmd.Flags().GetString(flagOtherHome); otherHome != "" {
+				if filepath.Clean(otherHome) == filepath.Clean(reader.home) {
+					return fmt.Errorf("the other home must be the home directory of another node")
+				}
+				otherReader, err = openStateReader(cmd, appCreator, defaultNodeHome, otherHome)
+				if err != nil {
+					return err
+				}
+				defer otherReader.close()
+			}
+
+			from, err := reader.at(heights[0])
+			if err != nil {
+				return err
+			}
+			to, err := otherReader.at(heights[1])
+			if err != nil {
+				return err
+			}
+
+			storeNames, _ := cmd.Flags().GetStringSlice(flagStore)
+			if len(storeNames) == 0 {
+				storeNames = diffStoreNames(from.info, to.info)
+			}
+
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			for _, name := range storeNames {
+				if err := diffStore(enc, name, from, to); err != nil {
+					return fmt.Errorf("failed to diff store %s: %w", name, err)
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSlice(flagStore, nil, "Only diff the stores, all the stores with different hashes by default")
+	cmd.Flags().String(flagOtherHome, "", "Read the second height from the application home directory of another node")
+
+	return cmd
+}
+
+// diffStoreNames returns the names of the stores committed at either version
+// with different hashes, sorted.
+func diffStoreNames(from, to *storetypes.CommitInfo) []string {
+	hashes := make(map[string][]byte, len(from.StoreInfos))
+	for _, info := range from.StoreInfos {
+		hashes[info.Name] = info.CommitId.Hash
+	}
+
+	var names []string
+	for _, info := range to.StoreInfos {
+		hash, ok := hashes[info.Name]
+		delete(hashes, info.Name)
+		if !ok || !bytes.Equal(hash, info.CommitId.Hash) {
+			names = append(names, info.Name)
+		}
+	}
+	for name := range hashes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// stateChange is a record changed between two versions.
+type stateChange struct {
+	Store      string          `json:"store"`
+	Change     string          `json:"change"`
+	Collection string          `json:"collection,omitempty"`
+	RawKey     string          `json:"raw_key"`
+	Key        json.RawMessage `json:"key,omitempty"`
+	Old        json.RawMessage `json:"old,omitempty"`
+	New        json.RawMessage `json:"new,omitempty"`
+}
+
+// diffStore outputs the records of a store changed between two versions, a store
+// missing at a version being empty.
+func diffStore(enc *json.Encoder, name string, from, to *stateView) error {
+	type kvPair struct{ key, oldValue, newValue []byte }
+
+	// the changes are collected first, the collections being decoded only for the
+	// stores having some
+	var pairs []kvPair
+	err := mergeIterate(from.iterator(name), to.iterator(name), func(key, oldValue, newValue []byte) {
+		if !bytes.Equal(oldValue, newValue) {
+			pairs = append(pairs, kvPair{key, oldValue, newValue})
+		}
+	})
+	if err != nil || len(pairs) == 0 {
+		return err
+	}
+
+	fromDecoded, toDecoded := from.decode(name, nil), to.decode(name, nil)
+	for _, pair := range pairs {
+		change := stateChange{Store: name, Change: "changed", RawKey: hex.EncodeToString(pair.key)}
+		switch {
+		case pair.oldValue == nil:
+			change.Change = "added"
+		case pair.newValue == nil:
+			change.Change = "removed"
+		}
+		if pair.oldValue != nil {
+			record := newStateRecord(fromDecoded, pair.key, pair.oldValue)
+			change.Collection, change.Key, change.Old = record.Collection, record.Key, record.Value
+		}
+		if pair.newValue != nil {
+			record := newStateRecord(toDecoded, pair.key, pair.newValue)
+			change.Collection, change.Key, change.New = record.Collection, record.Key, record.Value
+		}
+		if err := enc.Encode(change); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeIterate walks two iterators in key order, calling fn with the values of
+// each key, nil if missing. The iterators are closed.
+func mergeIterate(a, b storetypes.Iterator, fn func(key, aValue, bValue []byte)) error {
+	for a.Valid() || b.Valid() {
+		cmp := 0
+		switch {
+		case !a.Valid():
+			cmp = 1
+		case !b.Valid():
+			cmp = -1
+		default:
+			cmp = bytes.Compare(a.Key(), b.Key())
+		}
+
+		switch {
+		case cmp < 0:
+			fn(a.Key(), a.Value(), nil)
+			a.Next()
+		case cmp > 0:
+			fn(b.Key(), nil, b.Value())
+			b.Next()
+		default:
+			fn(a.Key(), a.Value(), b.Value())
+			a.Next()
+			b.Next()
+		}
+	}
+
+	if err := a.Close(); err != nil {
+		b.Close()
+		return err
+	}
+	return b.Close()
+}
+
+// stateRecord is a record of a store, decoded if its collection is known, its
+// value being hex otherwise.
+type stateRecord struct {
+	Collection string          `json:"collection,omitempty"`
+	RawKey     string          `json:"raw_key"`
+	Key        json.RawMessage `json:"key,omitempty"`
+	Value      json.RawMessage `json:"value"`
+}
+
+func newStateRecord(decoded map[string]stateRecord, key, value []byte) stateRecord {
+	if record, ok := decoded[string(key)]; ok {
+		return record
+	}
+	hexValue, _ := json.Marshal(hex.EncodeToString(value))
+	return stateRecord{RawKey: hex.EncodeToString(key), Value: hexValue}
+}
+
+// stateReader reads the committed state of an application offline.
+type stateReader struct {
+	home    string
+	app     servertypes.Application
+	store   *rootmulti.Store
+	schemas map[string]collections.Schema
+	warnf   func(format string, args ...any)
+}
+
+// openStateReader creates the application over the database of a home
+// directory, the home flag by default.
+func openStateReader(cmd *cobra.Command, appCreator servertypes.AppCreator, defaultNodeHome, home string) (*stateReader, error) {
+	vp := viper.New()
+	if err := vp.BindPFlags(cmd.Flags()); err != nil {
+		return nil, err
+	}
+
+	if home == "" {
+		home = vp.GetString(flags.FlagHome)
+	}
+	if home == "" {
+		home = defaultNodeHome
+	}
+	vp.Set(flags.FlagHome, home)
+	// the state is only read
+	vp.Set(server.FlagPruning, pruningtypes.PruningOptionNothing)
+
+	db, err := dbm.NewDB("application", server.GetAppDBBackend(vp), filepath.Join(home, "data"))
+	if err != nil {
+		return nil, err
+	}
+
+	app := appCreator(log.NewNopLogger(), db, nil, vp)
+	store, ok := app.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		_ = app.Close()
+		return nil, fmt.Errorf("currently only support the inspection of rootmulti.Store type")
+	}
+
+	reader := &stateReader{
+		home:  home,
+		app:   app,
+		store: store,
+		warnf: func(format string, args ...any) { cmd.PrintErrf("warning: "+format+"\n", args...) },
+	}
+	if schemas, ok := app.(HasCollectionsSchemas); ok {
+		reader.schemas = schemas.CollectionsSchemas()
+	}
+	return reader, nil
+}
+
+func (r *stateReader) close() {
+	_ = r.app.Close()
+}
+
+// collection returns the collection of a store by name.
+func (r *stateReader) collection(storeName, name string) (collections.Collection, error) {
+	schema, ok := r.schemas[storeName]
+	if !ok {
+		return nil, fmt.Errorf("the collections of store %s are unknown", storeName)
+	}
+	for _, coll := range schema.ListCollections() {
+		if coll.GetName() == name {
+			return coll, nil
+		}
+	}
+	return nil, fmt.Errorf("store %s has no collection %s", storeName, name)
+}
+
+// at returns the state committed at a height, the latest one if 0.
+func (r *stateReader) at(height int64) (*stateView, error) {
+	if height == 0 {
+		height = r.store.LastCommitID().Version
+	}
+	info, err := r.store.GetCommitInfo(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load height %d: %w", height, err)
+	}
+	ms, err := r.store.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load height %d: %w", height, err)
+	}
+
+	return &stateView{
+		reader: r,
+		height: height,
+		info:   info,
+		ms:     ms,
+		ctx:    sdk.NewContext(ms, cmtproto.Header{Height: height}, false, log.NewNopLogger()),
+	}, nil
+}
+
+// stateView is the state committed at a height.
+type stateView struct {
+	reader *stateReader
+	height int64
+	info   *storetypes.CommitInfo
+	ms     storetypes.CacheMultiStore
+	ctx    sdk.Context
+}
+
+// kvStore returns a store of the version, which must be committed at the
+// version and mounted by the application.
+func (v *stateView) kvStore(name string) (storetypes.KVStore, error) {
+	if !v.committed(name) {
+		return nil, fmt.Errorf("store %s is not committed at height %d", name, v.height)
+	}
+	key, ok := v.reader.store.StoreKeysByName()[name]
+	if !ok {
+		return nil, fmt.Errorf("store %s is not mounted by the application", name)
+	}
+	return v.ms.GetKVStore(key), nil
+}
+
+func (v *stateView) committed(name string) bool {
+	for _, info := range v.info.StoreInfos {
+		if info.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// iterator iterates over a store of the version, which is empty if the store is
+// not committed at the version.
+func (v *stateView) iterator(name string) storetypes.Iterator {
+	kv, err := v.kvStore(name)
+	if err != nil {
+		return mem.NewStore().Iterator(nil, nil)
+	}
+	return kv.Iterator(nil, nil)
+}
+
+// decode returns the decoded records of the collections of a store overlapping
+// a key prefix, by raw key. The exported records of a collection are matched to
+// its raw records in key order, a collection which can not be exported or whose
+// records do not match is left undecoded.
+func (v *stateView) decode(name string, prefix []byte) map[string]stateRecord {
+	schema, ok := v.reader.schemas[name]
+	if !ok {
+		return nil
+	}
+	kv, err := v.kvStore(name)
+	if err != nil {
+		return nil
+	}
+
+	exports := make(map[string]*bytes.Buffer)
+	collectionsByName := make(map[string]collections.Collection)
+	for _, coll := range schema.ListCollections() {
+		if bytes.HasPrefix(prefix, coll.GetPrefix()) || bytes.HasPrefix(coll.GetPrefix(), prefix) {
+			exports[coll.GetName()] = new(bytes.Buffer)
+			collectionsByName[coll.GetName()] = coll
+		}
+	}
+
+	err = schema.ExportGenesis(v.ctx, func(field string) (io.WriteCloser, error) {
+		if buf, ok := exports[field]; ok {
+			return nopWriteCloser{buf}, nil
+		}
+		return nopWriteCloser{io.Discard}, nil
+	})
+	if err != nil {
+		v.reader.warnf("failed to decode the collections of store %s: %v", name, err)
+		return nil
+	}
+
+	decoded := make(map[string]stateRecord)
+	for collName, buf := range exports {
+		var entries []struct {
+			Key   json.RawMessage `json:"key"`
+			Value json.RawMessage `json:"value"`
+		}
+		if err := json.Unmarshal(buf.Bytes(), &entries); err != nil {
+			v.reader.warnf("failed to decode collection %s of store %s: %v", collName, name, err)
+			continue
+		}
+
+		var keys [][]byte
+		itr := storetypes.KVStorePrefixIterator(kv, collectionsByName[collName].GetPrefix())
+		for ; itr.Valid(); itr.Next() {
+			keys = append(keys, itr.Key())
+		}
+		err := itr.Close()
+		if err == nil && len(keys) != len(entries) {
+			err = fmt.Errorf("%d exported records for %d records", len(entries), len(keys))
+		}
+		if err != nil {
+			v.reader.warnf("failed to decode collection %s of store %s: %v", collName, name, err)
+			continue
+		}
+
+		for i, key := range keys {
+			decoded[string(key)] = stateRecord{
+				Collection: collName,
+				RawKey:     hex.EncodeToString(key),
+				Key:        entries[i].Key,
+				Value:      entries[i].Value,
+			}
+		}
+	}
+	return decoded
+}
+
+type nopWriteCloser struct{ io.Writer }
+
+func (nopWriteCloser) Close() error { return nil }
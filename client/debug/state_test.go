@@ -0,0 +1,214 @@
+package debug
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/gogoproto/grpc"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// stateTestApp is an application with a bank store, whose collections are
+// known, and an acc store of raw records.
+type stateTestApp struct {
+	*baseapp.BaseApp
+	bankKey, accKey *storetypes.KVStoreKey
+	schema          collections.Schema
+	balances        collections.Map[string, uint64]
+	params          collections.Item[string]
+}
+
+func newStateTestApp(t *testing.T, db dbm.DB) *stateTestApp {
+	t.Helper()
+	app := &stateTestApp{
+		BaseApp: baseapp.NewBaseApp("state", log.NewNopLogger(), db, nil),
+		bankKey: storetypes.NewKVStoreKey("bank"),
+		accKey:  storetypes.NewKVStoreKey("acc"),
+	}
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(app.bankKey))
+	app.balances = collections.NewMap(sb, collections.NewPrefix(0), "balances", collections.StringKey, collections.Uint64Value)
+	app.params = collections.NewItem(sb, collections.NewPrefix(1), "params", collections.StringValue)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+	app.schema = schema
+
+	app.MountStores(app.bankKey, app.accKey)
+	require.NoError(t, app.LoadLatestVersion())
+	return app
+}
+
+func (app *stateTestApp) CollectionsSchemas() map[string]collections.Schema {
+	return map[string]collections.Schema{"bank": app.schema}
+}
+
+func (app *stateTestApp) RegisterAPIRoutes(*api.Server, config.APIConfig)   {}
+func (app *stateTestApp) RegisterGRPCServer(grpc.Server)                    {}
+func (app *stateTestApp) RegisterTxService(client.Context)                  {}
+func (app *stateTestApp) RegisterTendermintService(client.Context)          {}
+func (app *stateTestApp) RegisterNodeService(client.Context, config.Config) {}
+
+// commit writes and commits a version of the state of the app.
+func (app *stateTestApp) commit(t *testing.T, write func(ctx sdk.Context)) {
+	t.Helper()
+	ms := app.CommitMultiStore().CacheMultiStore()
+	write(sdk.NewContext(ms, cmtproto.Header{}, false, log.NewNopLogger()))
+	ms.Write()
+	app.CommitMultiStore().Commit()
+}
+
+// newStateTestHome writes two versions of the state of the app to a home
+// directory.
+func newStateTestHome(t *testing.T) string {
+	t.Helper()
+	home := t.TempDir()
+	db, err := dbm.NewDB("application", dbm.GoLevelDBBackend, filepath.Join(home, "data"))
+	require.NoError(t, err)
+	app := newStateTestApp(t, db)
+
+	app.commit(t, func(ctx sdk.Context) {
+		require.NoError(t, app.balances.Set(ctx, "alice", 10))
+		require.NoError(t, app.params.Set(ctx, "p1"))
+		ctx.KVStore(app.bankKey).Set([]byte{0xff}, []byte{1})
+		ctx.KVStore(app.accKey).Set([]byte{1}, []byte{1})
+	})
+	app.commit(t, func(ctx sdk.Context) {
+		require.NoError(t, app.balances.Set(ctx, "alice", 20))
+		require.NoError(t, app.balances.Set(ctx, "bob", 5))
+		ctx.KVStore(app.bankKey).Delete([]byte{0xff})
+	})
+	require.NoError(t, app.Close())
+	return home
+}
+
+func TestStateCmd(t *testing.T) {
+	home := newStateTestHome(t)
+	appCreator := func(_ log.Logger, db dbm.DB, _ io.Writer, _ servertypes.AppOptions) servertypes.Application {
+		return newStateTestApp(t, db)
+	}
+
+	run := func(args ...string) (string, error) {
+		t.Helper()
+		cmd := StateCmd(appCreator, home)
+		out := new(bytes.Buffer)
+		cmd.SetOut(out)
+		cmd.SetErr(io.Discard)
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		return out.String(), err
+	}
+	records := func(out string) []map[string]any {
+		t.Helper()
+		var records []map[string]any
+		for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+			var record map[string]any
+			require.NoError(t, json.Unmarshal([]byte(line), &record))
+			records = append(records, record)
+		}
+		return records
+	}
+
+	// the stores are listed with their schema
+	out, err := run("list-stores", "--height", "1")
+	require.NoError(t, err)
+	require.Contains(t, out, "height 1, app hash")
+	require.Regexp(t, `(?m)^acc\s+1\s+[0-9A-F]+\s+false$`, out)
+	require.Regexp(t, `(?m)^bank\s+1\s+[0-9A-F]+\s+true$`, out)
+	out, err = run("list-stores")
+	require.NoError(t, err)
+	require.Contains(t, out, "height 2, app hash")
+	_, err = run("list-stores", "--height", "3")
+	require.ErrorContains(t, err, "failed to load height 3")
+
+	// the records of the collections are decoded, the others are shown as hex
+	out, err = run("dump", "bank", "--height", "1")
+	require.NoError(t, err)
+	require.Equal(t, []map[string]any{
+		{"collection": "balances", "raw_key": "00616c696365", "key": "alice", "value": "10"},
+		{"collection": "params", "raw_key": "01", "value": "p1"},
+		{"raw_key": "ff", "value": "01"},
+	}, withoutKey(records(out), "params"))
+	out, err = run("dump", "bank", "--collection", "balances")
+	require.NoError(t, err)
+	require.Equal(t, []map[string]any{
+		{"collection": "balances", "raw_key": "00616c696365", "key": "alice", "value": "20"},
+		{"collection": "balances", "raw_key": "00626f62", "key": "bob", "value": "5"},
+	}, records(out))
+	out, err = run("dump", "acc", "--prefix", "01")
+	require.NoError(t, err)
+	require.Equal(t, []map[string]any{{"raw_key": "01", "value": "01"}}, records(out))
+	_, err = run("dump", "bank", "--collection", "supply")
+	require.ErrorContains(t, err, "store bank has no collection supply")
+	_, err = run("dump", "acc", "--collection", "balances")
+	require.ErrorContains(t, err, "the collections of store acc are unknown")
+	_, err = run("dump", "bank", "--collection", "balances", "--prefix", "01")
+	require.ErrorContains(t, err, "is not part of collection balances")
+
+	// only the stores with different hashes are diffed, the records being
+	// decoded at either height
+	out, err = run("diff", "1", "2")
+	require.NoError(t, err)
+	require.Equal(t, []map[string]any{
+		{"store": "bank", "change": "changed", "collection": "balances", "raw_key": "00616c696365", "key": "alice", "old": "10", "new": "20"},
+		{"store": "bank", "change": "added", "collection": "balances", "raw_key": "00626f62", "key": "bob", "new": "5"},
+		{"store": "bank", "change": "removed", "raw_key": "ff", "old": "01"},
+	}, records(out))
+	out, err = run("diff", "2", "1", "--store", "acc")
+	require.NoError(t, err)
+	require.Empty(t, out)
+
+	// the second height is read from another node
+	otherHome := newStateTestHome(t)
+	out, err = run("diff", "2", "2", "--other-home", otherHome)
+	require.NoError(t, err)
+	require.Empty(t, out)
+	out, err = run("diff", "1", "2", "--other-home", otherHome, "--store", "bank")
+	require.NoError(t, err)
+	require.Len(t, records(out), 3)
+	_, err = run("diff", "1", "2", "--other-home", home)
+	require.ErrorContains(t, err, "the other home must be the home directory of another node")
+	_, err = run("diff", "0", "2")
+	require.ErrorContains(t, err, "invalid height 0")
+}
+
+// withoutKey removes the key of the records of a collection, whose encoding
+// is not part of the test.
+func withoutKey(records []map[string]any, collection string) []map[string]any {
+	for _, record := range records {
+		if record["collection"] == collection {
+			delete(record, "key")
+		}
+	}
+	return records
+}
+
+func TestDiffStoreNames(t *testing.T) {
+	from := &storetypes.CommitInfo{StoreInfos: []storetypes.StoreInfo{
+		{Name: "acc", CommitId: storetypes.CommitID{Hash: []byte{1}}},
+		{Name: "bank", CommitId: storetypes.CommitID{Hash: []byte{1}}},
+		{Name: "gov", CommitId: storetypes.CommitID{Hash: []byte{1}}},
+	}}
+	to := &storetypes.CommitInfo{StoreInfos: []storetypes.StoreInfo{
+		{Name: "staking", CommitId: storetypes.CommitID{Hash: []byte{1}}},
+		{Name: "bank", CommitId: storetypes.CommitID{Hash: []byte{2}}},
+		{Name: "acc", CommitId: storetypes.CommitID{Hash: []byte{1}}},
+	}}
+	require.Equal(t, []string{"bank", "gov", "staking"}, diffStoreNames(from, to))
+}
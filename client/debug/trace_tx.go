@@ -0,0 +1,45 @@
+package debug
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// TraceTxCmd returns a command tracing the execution of a committed tx.
+func TraceTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace-tx <hash>",
+		Short: "Re-execute a committed transaction and show a trace of its execution",
+		Long: `Re-execute a committed transaction and show a trace of its execution: the
+operations on the KV stores, the gas consumed by each ante and post decorator and by
+each message, and the events emitted.
+
+The node replays the block of the transaction on the state of the previous height, up
+to the transaction, so it must hold the state of the previous height, as archive nodes
+do. The replay does not change the state of the node.`,
+		Example: `trace-tx 5E6FC6F1E5B3F5A48C2B6FAA3F3BD2B1E8A0C4F1E43CA9CF1A0D6FC1C84D0B27 --node tcp://archive:26657`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := txtypes.NewServiceClient(clientCtx).TraceTx(cmd.Context(), &txtypes.TraceTxRequest{
+				Hash: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -3,6 +3,7 @@ package flags
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -74,6 +75,8 @@ const (
 	FlagOffset           = "offset"
 	FlagCountTotal       = "count-total"
 	FlagTimeoutHeight    = "timeout-height"
+	FlagUnordered        = "unordered"
+	FlagTimeoutDuration  = "timeout-duration"
 	FlagKeyAlgorithm     = "algo"
 	FlagKeyType          = "key-type"
 	FlagFeePayer         = "fee-payer"
@@ -82,6 +85,11 @@ const (
 	FlagTip              = "tip"
 	FlagAux              = "aux"
 	FlagInitHeight       = "initial-height"
+	FlagVerify           = "verify"
+	FlagTrustHeight      = "trust-height"
+	FlagTrustHash        = "trust-hash"
+	FlagTrustingPeriod   = "trusting-period"
+	FlagWitnesses        = "witnesses"
 	// FlagOutput is the flag to set the output format.
 	// This differs from FlagOutputDocument that is used to set the output file.
 	FlagOutput = "output"
@@ -108,6 +116,11 @@ func AddQueryFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().Bool(FlagGRPCInsecure, false, "allow gRPC over insecure channels, if not the server must use TLS")
 	cmd.Flags().Int64(FlagHeight, 0, "Use a specific height to query state at (this can error if the node is pruning state)")
 	cmd.Flags().StringP(FlagOutput, "o", "text", "Output format (text|json)")
+	cmd.Flags().Bool(FlagVerify, false, "Verify the proof of the queried state against a header verified by a light client, only the raw store key queries of the query store command can be verified, the other query commands failing")
+	cmd.Flags().Int64(FlagTrustHeight, 0, "Height of the header trusted by the light client, required on the first verified query")
+	cmd.Flags().String(FlagTrustHash, "", "Hex encoded hash of the header trusted by the light client, required with --trust-height")
+	cmd.Flags().Duration(FlagTrustingPeriod, 168*time.Hour, "Period during which a trusted header can be used to verify the next ones, which should be shorter than the unbonding period")
+	cmd.Flags().StringSlice(FlagWitnesses, nil, "RPC addresses of the nodes the headers are cross-checked with (defaults to the node itself, detecting no fork)")
 
 	// some base commands does not require chainID e.g `simd testnet` while subcommands do
 	// hence the flag should not be required for those commands
@@ -136,6 +149,8 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	f.BoolP(FlagSkipConfirmation, "y", false, "Skip tx broadcasting prompt confirmation")
 	f.String(FlagSignMode, "", "Choose sign mode (direct|amino-json|direct-aux|textual), this is an advanced feature")
 	f.Uint64(FlagTimeoutHeight, 0, "Set a block timeout height to prevent the tx from being committed past a certain height")
+	f.Bool(FlagUnordered, false, "Mark the tx as unordered, i.e. not bound to the sequence of its signers; requires --timeout-duration to be set")
+	f.Duration(FlagTimeoutDuration, 0, "Set a timeout duration, from now, after which the unordered tx will not be committed")
 	f.String(FlagFeePayer, "", "Fee payer pays fees for the transaction instead of deducting from the signer")
 	f.String(FlagFeeGranter, "", "Fee granter grants fees for the transaction")
 	f.String(FlagTip, "", "Tip is the amount that is going to be transferred to the fee payer on the target chain. This flag is only valid when used with --aux, and is ignored if the target chain didn't enable the TipDecorator")
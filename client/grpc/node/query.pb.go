@@ -253,11 +253,174 @@ func (m *StatusResponse) GetValidatorHash() []byte {
 	return nil
 }
 
+// StoreHashesRequest defines the request structure for the StoreHashes gRPC query.
+type StoreHashesRequest struct {
+	// height is the height of the hashes, the height of the query by default.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *StoreHashesRequest) Reset()         { *m = StoreHashesRequest{} }
+func (m *StoreHashesRequest) String() string { return proto.CompactTextString(m) }
+func (*StoreHashesRequest) ProtoMessage()    {}
+func (*StoreHashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{4}
+}
+func (m *StoreHashesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreHashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreHashesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreHashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreHashesRequest.Merge(m, src)
+}
+func (m *StoreHashesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreHashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreHashesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreHashesRequest proto.InternalMessageInfo
+
+func (m *StoreHashesRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// StoreHashesResponse defines the response structure for the StoreHashes gRPC query.
+type StoreHashesResponse struct {
+	Height  int64        `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	AppHash []byte       `protobuf:"bytes,2,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"`
+	Stores  []*StoreHash `protobuf:"bytes,3,rep,name=stores,proto3" json:"stores,omitempty"`
+}
+
+func (m *StoreHashesResponse) Reset()         { *m = StoreHashesResponse{} }
+func (m *StoreHashesResponse) String() string { return proto.CompactTextString(m) }
+func (*StoreHashesResponse) ProtoMessage()    {}
+func (*StoreHashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{5}
+}
+func (m *StoreHashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreHashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreHashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreHashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreHashesResponse.Merge(m, src)
+}
+func (m *StoreHashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreHashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreHashesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreHashesResponse proto.InternalMessageInfo
+
+func (m *StoreHashesResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *StoreHashesResponse) GetAppHash() []byte {
+	if m != nil {
+		return m.AppHash
+	}
+	return nil
+}
+
+func (m *StoreHashesResponse) GetStores() []*StoreHash {
+	if m != nil {
+		return m.Stores
+	}
+	return nil
+}
+
+// StoreHash is the commit hash of a store at a height.
+type StoreHash struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *StoreHash) Reset()         { *m = StoreHash{} }
+func (m *StoreHash) String() string { return proto.CompactTextString(m) }
+func (*StoreHash) ProtoMessage()    {}
+func (*StoreHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8324226a07064341, []int{6}
+}
+func (m *StoreHash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreHash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreHash.Merge(m, src)
+}
+func (m *StoreHash) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreHash proto.InternalMessageInfo
+
+func (m *StoreHash) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StoreHash) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ConfigRequest)(nil), "cosmos.base.node.v1beta1.ConfigRequest")
 	proto.RegisterType((*ConfigResponse)(nil), "cosmos.base.node.v1beta1.ConfigResponse")
 	proto.RegisterType((*StatusRequest)(nil), "cosmos.base.node.v1beta1.StatusRequest")
 	proto.RegisterType((*StatusResponse)(nil), "cosmos.base.node.v1beta1.StatusResponse")
+	proto.RegisterType((*StoreHashesRequest)(nil), "cosmos.base.node.v1beta1.StoreHashesRequest")
+	proto.RegisterType((*StoreHashesResponse)(nil), "cosmos.base.node.v1beta1.StoreHashesResponse")
+	proto.RegisterType((*StoreHash)(nil), "cosmos.base.node.v1beta1.StoreHash")
 }
 
 func init() {
@@ -265,40 +428,47 @@ func init() {
 }
 
 var fileDescriptor_8324226a07064341 = []byte{
-	// 522 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x97, 0x32, 0x3a, 0xe6, 0xb1, 0x8e, 0x79, 0x80, 0x4a, 0x85, 0xd2, 0xaa, 0x02, 0x51,
-	0x90, 0x66, 0x6b, 0xe5, 0xce, 0x61, 0x1c, 0x36, 0xc4, 0x05, 0xa5, 0x9c, 0xb8, 0x44, 0x6e, 0xfa,
-	0x96, 0x58, 0x4b, 0x6c, 0xcf, 0x76, 0x2a, 0x71, 0x45, 0xe2, 0x3e, 0x89, 0x13, 0xdf, 0x84, 0x8f,
-	0xc0, 0x71, 0x12, 0x17, 0x4e, 0x80, 0x5a, 0x3e, 0x08, 0x8a, 0x9d, 0x0c, 0xf5, 0xb0, 0xc1, 0x29,
-	0xf6, 0xff, 0xfd, 0xde, 0xcb, 0x7b, 0xef, 0x6f, 0xf4, 0x28, 0x91, 0xa6, 0x90, 0x86, 0x4e, 0x99,
-	0x01, 0x2a, 0xe4, 0x0c, 0xe8, 0xfc, 0x60, 0x0a, 0x96, 0x1d, 0xd0, 0xb3, 0x12, 0xf4, 0x7b, 0xa2,
-	0xb4, 0xb4, 0x12, 0x77, 0x3d, 0x45, 0x2a, 0x8a, 0x54, 0x14, 0xa9, 0xa9, 0xde, 0xc3, 0x54, 0xca,
-	0x34, 0x07, 0xca, 0x14, 0xa7, 0x4c, 0x08, 0x69, 0x99, 0xe5, 0x52, 0x18, 0x9f, 0xd7, 0xeb, 0xd7,
-	0x51, 0x77, 0x9b, 0x96, 0x27, 0xd4, 0xf2, 0x02, 0x8c, 0x65, 0x85, 0xaa, 0x81, 0xbb, 0xa9, 0x4c,
-	0xa5, 0x3b, 0xd2, 0xea, 0xe4, 0xd5, 0xe1, 0x0e, 0xda, 0x7e, 0x29, 0xc5, 0x09, 0x4f, 0x23, 0x38,
-	0x2b, 0xc1, 0xd8, 0xe1, 0x97, 0x00, 0x75, 0x1a, 0xc5, 0x28, 0x29, 0x0c, 0xe0, 0x67, 0x68, 0xb7,
-	0xe0, 0x82, 0x17, 0x65, 0x11, 0xa7, 0xcc, 0xc4, 0x4a, 0xf3, 0x04, 0xba, 0xc1, 0x20, 0x18, 0x6d,
-	0x46, 0x3b, 0x75, 0xe0, 0x88, 0x99, 0x37, 0x95, 0x8c, 0x09, 0xda, 0x53, 0xba, 0x14, 0x5c, 0xa4,
-	0xf1, 0x29, 0x80, 0x8a, 0x35, 0x24, 0x20, 0x6c, 0xb7, 0xe5, 0xe8, 0xdd, 0x3a, 0xf4, 0x1a, 0x40,
-	0x45, 0x2e, 0x80, 0x9f, 0xa2, 0x3b, 0x0d, 0xcf, 0x85, 0x05, 0x3d, 0x67, 0x79, 0xf7, 0x86, 0x2f,
-	0x5d, 0xeb, 0xaf, 0x6a, 0x19, 0xf7, 0xd1, 0x56, 0xc6, 0x72, 0x1b, 0x67, 0xc0, 0xd3, 0xcc, 0x76,
-	0xd7, 0x07, 0xc1, 0x68, 0x3d, 0x42, 0x95, 0x74, 0xec, 0x94, 0x6a, 0x96, 0x89, 0x65, 0xb6, 0x34,
-	0xcd, 0x2c, 0x3f, 0x02, 0xd4, 0x69, 0x94, 0x7a, 0x96, 0x31, 0xba, 0x07, 0x4c, 0xe7, 0x1c, 0x8c,
-	0x8d, 0x8d, 0x95, 0x1a, 0x9a, 0x72, 0x81, 0x2b, 0xb7, 0xd7, 0x04, 0x27, 0x55, 0xcc, 0xd7, 0xc5,
-	0xf7, 0x51, 0xbb, 0x86, 0x5a, 0x0e, 0xaa, 0x6f, 0xf8, 0x05, 0xda, 0xbc, 0x5c, 0xb2, 0x6b, 0x7a,
-	0x6b, 0xdc, 0x23, 0xde, 0x06, 0xd2, 0xd8, 0x40, 0xde, 0x36, 0xc4, 0xe1, 0xfa, 0xf9, 0xcf, 0x7e,
-	0x10, 0xfd, 0x4d, 0xc1, 0x0f, 0xd0, 0x2d, 0xa6, 0x54, 0x9c, 0x31, 0x93, 0xb9, 0x69, 0x6e, 0x47,
-	0x1b, 0x4c, 0xa9, 0x63, 0x66, 0x32, 0xfc, 0x18, 0x75, 0xe6, 0x2c, 0xe7, 0x33, 0x66, 0xa5, 0xf6,
-	0xc0, 0x4d, 0x07, 0x6c, 0x5f, 0xaa, 0x15, 0x36, 0xfe, 0xdc, 0x42, 0x1b, 0x13, 0xd0, 0xf3, 0x6a,
-	0xf3, 0x1f, 0x03, 0xd4, 0xf6, 0xc6, 0xe1, 0x27, 0xe4, 0xaa, 0x47, 0x44, 0x56, 0xcc, 0xee, 0x8d,
-	0xfe, 0x0d, 0xfa, 0xbd, 0x0d, 0x47, 0x1f, 0xbe, 0xfd, 0xfe, 0xd4, 0x1a, 0xe2, 0x01, 0xbd, 0xf2,
-	0x15, 0x27, 0xfe, 0xe7, 0x55, 0x1f, 0x7e, 0xe9, 0xd7, 0xf5, 0xb1, 0x62, 0xd4, 0x75, 0x7d, 0xac,
-	0xfa, 0xf7, 0x3f, 0x7d, 0x18, 0x97, 0x71, 0x78, 0xf4, 0x75, 0x11, 0x06, 0x17, 0x8b, 0x30, 0xf8,
-	0xb5, 0x08, 0x83, 0xf3, 0x65, 0xb8, 0x76, 0xb1, 0x0c, 0xd7, 0xbe, 0x2f, 0xc3, 0xb5, 0x77, 0xfb,
-	0x29, 0xb7, 0x59, 0x39, 0x25, 0x89, 0x2c, 0x9a, 0x2a, 0xfe, 0xb3, 0x6f, 0x66, 0xa7, 0x34, 0xc9,
-	0x39, 0x08, 0x4b, 0x53, 0xad, 0x12, 0x57, 0x77, 0xda, 0x76, 0x5e, 0x3e, 0xff, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x0c, 0x93, 0xa1, 0xea, 0xc0, 0x03, 0x00, 0x00,
+	// 626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc7, 0xe7, 0xb6, 0xbf, 0xee, 0x37, 0x97, 0x6d, 0xcc, 0x03, 0x54, 0x2a, 0xd4, 0x55, 0xe1,
+	0x5f, 0x40, 0x5b, 0xa2, 0x75, 0x47, 0x24, 0x0e, 0xe3, 0xb0, 0x21, 0x2e, 0x28, 0xe3, 0xc4, 0x25,
+	0x72, 0x33, 0x2f, 0xb1, 0x96, 0xd8, 0x5e, 0xec, 0x54, 0xe2, 0x8a, 0xb4, 0xfb, 0x24, 0x6e, 0xbc,
+	0x10, 0xc4, 0x4b, 0xe0, 0x38, 0x89, 0x0b, 0x27, 0x40, 0x1b, 0x2f, 0x04, 0xc5, 0x7f, 0xca, 0x7a,
+	0xe8, 0xd6, 0x53, 0x9c, 0xef, 0xf3, 0xf1, 0xf3, 0x7c, 0x1f, 0xfb, 0x31, 0x7c, 0x94, 0x70, 0x59,
+	0x70, 0x19, 0x8e, 0xb0, 0x24, 0x21, 0xe3, 0x87, 0x24, 0x1c, 0x6f, 0x8f, 0x88, 0xc2, 0xdb, 0xe1,
+	0x49, 0x45, 0xca, 0x0f, 0x81, 0x28, 0xb9, 0xe2, 0xa8, 0x6b, 0xa8, 0xa0, 0xa6, 0x82, 0x9a, 0x0a,
+	0x2c, 0xd5, 0x7b, 0x90, 0x72, 0x9e, 0xe6, 0x24, 0xc4, 0x82, 0x86, 0x98, 0x31, 0xae, 0xb0, 0xa2,
+	0x9c, 0x49, 0xb3, 0xaf, 0xb7, 0x61, 0xa3, 0xfa, 0x6f, 0x54, 0x1d, 0x85, 0x8a, 0x16, 0x44, 0x2a,
+	0x5c, 0x08, 0x0b, 0xdc, 0x49, 0x79, 0xca, 0xf5, 0x32, 0xac, 0x57, 0x46, 0xf5, 0x56, 0xe1, 0xf2,
+	0x2b, 0xce, 0x8e, 0x68, 0x1a, 0x91, 0x93, 0x8a, 0x48, 0xe5, 0x7d, 0x05, 0x70, 0xc5, 0x29, 0x52,
+	0x70, 0x26, 0x09, 0x7a, 0x0e, 0xd7, 0x0a, 0xca, 0x68, 0x51, 0x15, 0x71, 0x8a, 0x65, 0x2c, 0x4a,
+	0x9a, 0x90, 0x2e, 0x18, 0x00, 0x7f, 0x29, 0x5a, 0xb5, 0x81, 0x3d, 0x2c, 0xdf, 0xd6, 0x32, 0x0a,
+	0xe0, 0xba, 0x28, 0x2b, 0x46, 0x59, 0x1a, 0x1f, 0x13, 0x22, 0xe2, 0x92, 0x24, 0x84, 0xa9, 0x6e,
+	0x43, 0xd3, 0x6b, 0x36, 0xf4, 0x86, 0x10, 0x11, 0xe9, 0x00, 0x7a, 0x06, 0x6f, 0x3b, 0x9e, 0x32,
+	0x45, 0xca, 0x31, 0xce, 0xbb, 0x4d, 0x93, 0xda, 0xea, 0xaf, 0xad, 0x8c, 0x36, 0x60, 0x27, 0xc3,
+	0xb9, 0x8a, 0x33, 0x42, 0xd3, 0x4c, 0x75, 0x5b, 0x03, 0xe0, 0xb7, 0x22, 0x58, 0x4b, 0xfb, 0x5a,
+	0xa9, 0x7b, 0x39, 0x50, 0x58, 0x55, 0xd2, 0xf5, 0xf2, 0x13, 0xc0, 0x15, 0xa7, 0xd8, 0x5e, 0x86,
+	0xf0, 0x2e, 0xc1, 0x65, 0x4e, 0x89, 0x54, 0xb1, 0x54, 0xbc, 0x24, 0x2e, 0x1d, 0xd0, 0xe9, 0xd6,
+	0x5d, 0xf0, 0xa0, 0x8e, 0x99, 0xbc, 0xe8, 0x1e, 0x6c, 0x5b, 0xa8, 0xa1, 0x21, 0xfb, 0x87, 0x5e,
+	0xc2, 0xa5, 0xc9, 0x21, 0x6b, 0xd3, 0x9d, 0x61, 0x2f, 0x30, 0xd7, 0x10, 0xb8, 0x6b, 0x08, 0xde,
+	0x39, 0x62, 0xb7, 0x75, 0xf6, 0x6b, 0x03, 0x44, 0xff, 0xb6, 0xa0, 0xfb, 0xf0, 0x7f, 0x2c, 0x44,
+	0x9c, 0x61, 0x99, 0xe9, 0x6e, 0x6e, 0x45, 0x8b, 0x58, 0x88, 0x7d, 0x2c, 0x33, 0xf4, 0x18, 0xae,
+	0x8c, 0x71, 0x4e, 0x0f, 0xb1, 0xe2, 0xa5, 0x01, 0xfe, 0xd3, 0xc0, 0xf2, 0x44, 0xad, 0x31, 0x6f,
+	0x13, 0x22, 0x63, 0x14, 0xcb, 0x8c, 0xb8, 0xb6, 0xaf, 0xf8, 0xad, 0x9b, 0x6a, 0x3a, 0xbf, 0xde,
+	0x29, 0x80, 0xeb, 0x53, 0xb8, 0x3d, 0x93, 0x19, 0xfc, 0x94, 0xbf, 0xc6, 0xb4, 0xbf, 0x17, 0xb0,
+	0xad, 0x4f, 0x4f, 0x76, 0x9b, 0x83, 0xa6, 0xdf, 0x19, 0x3e, 0x0c, 0x66, 0x8d, 0x6d, 0x30, 0xa9,
+	0x18, 0xd9, 0x2d, 0xde, 0x0e, 0x5c, 0x9a, 0x88, 0x08, 0xc1, 0x16, 0xc3, 0x85, 0x9b, 0x27, 0xbd,
+	0xae, 0xb5, 0x2b, 0x45, 0xf5, 0x7a, 0xf8, 0xa5, 0x09, 0x17, 0x0f, 0x48, 0x39, 0xae, 0x87, 0xec,
+	0x14, 0xc0, 0xb6, 0x99, 0x51, 0xf4, 0x74, 0x76, 0xe1, 0xa9, 0xb9, 0xee, 0xf9, 0x37, 0x83, 0xe6,
+	0x38, 0x3c, 0xff, 0xe3, 0xf7, 0x3f, 0x9f, 0x1a, 0x1e, 0x1a, 0x84, 0x33, 0x1f, 0x6c, 0x62, 0x8a,
+	0xd7, 0x3e, 0xcc, 0x7c, 0x5d, 0xe7, 0x63, 0x6a, 0x26, 0x7b, 0xfe, 0xcd, 0xe0, 0xfc, 0x3e, 0xa4,
+	0x29, 0xfe, 0x19, 0xc0, 0xce, 0x95, 0x8b, 0x45, 0x9b, 0x73, 0xdc, 0xc6, 0x64, 0x5c, 0x7a, 0x5b,
+	0x73, 0xd2, 0xd6, 0x56, 0xa0, 0x6d, 0xf9, 0xe8, 0xc9, 0x75, 0xb6, 0xf4, 0xc3, 0xd2, 0xfb, 0x76,
+	0xf7, 0xbe, 0x5d, 0xf4, 0xc1, 0xf9, 0x45, 0x1f, 0xfc, 0xbe, 0xe8, 0x83, 0xb3, 0xcb, 0xfe, 0xc2,
+	0xf9, 0x65, 0x7f, 0xe1, 0xc7, 0x65, 0x7f, 0xe1, 0xfd, 0x56, 0x4a, 0x55, 0x56, 0x8d, 0x82, 0x84,
+	0x17, 0x2e, 0x97, 0xf9, 0x6c, 0xc9, 0xc3, 0xe3, 0x30, 0xc9, 0x29, 0x61, 0x2a, 0x4c, 0x4b, 0x91,
+	0xe8, 0xec, 0xa3, 0xb6, 0x7e, 0x53, 0x3b, 0x7f, 0x07, 0x00, 0x09, 0x3c, 0x38, 0xbd, 0x48, 0x05,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -317,6 +487,9 @@ type ServiceClient interface {
 	Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
 	// Status queries for the node status.
 	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	// StoreHashes queries the commit hashes of the stores at a height, from which
+	// the app hash is computed.
+	StoreHashes(ctx context.Context, in *StoreHashesRequest, opts ...grpc.CallOption) (*StoreHashesResponse, error)
 }
 
 type serviceClient struct {
@@ -345,12 +518,24 @@ func (c *serviceClient) Status(ctx context.Context, in *StatusRequest, opts ...g
 	return out, nil
 }
 
+func (c *serviceClient) StoreHashes(ctx context.Context, in *StoreHashesRequest, opts ...grpc.CallOption) (*StoreHashesResponse, error) {
+	out := new(StoreHashesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.node.v1beta1.Service/StoreHashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// Config queries for the operator configuration.
 	Config(context.Context, *ConfigRequest) (*ConfigResponse, error)
 	// Status queries for the node status.
 	Status(context.Context, *StatusRequest) (*StatusResponse, error)
+	// StoreHashes queries the commit hashes of the stores at a height, from which
+	// the app hash is computed.
+	StoreHashes(context.Context, *StoreHashesRequest) (*StoreHashesResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -363,6 +548,9 @@ func (*UnimplementedServiceServer) Config(ctx context.Context, req *ConfigReques
 func (*UnimplementedServiceServer) Status(ctx context.Context, req *StatusRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
 }
+func (*UnimplementedServiceServer) StoreHashes(ctx context.Context, req *StoreHashesRequest) (*StoreHashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreHashes not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -404,6 +592,24 @@ func _Service_Status_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_StoreHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreHashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).StoreHashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.node.v1beta1.Service/StoreHashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).StoreHashes(ctx, req.(*StoreHashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.base.node.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -416,6 +622,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Status",
 			Handler:    _Service_Status_Handler,
 		},
+		{
+			MethodName: "StoreHashes",
+			Handler:    _Service_StoreHashes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/node/v1beta1/query.proto",
@@ -573,6 +783,120 @@ func (m *StatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StoreHashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreHashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreHashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreHashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreHashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreHashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stores) > 0 {
+		for iNdEx := len(m.Stores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AppHash) > 0 {
+		i -= len(m.AppHash)
+		copy(dAtA[i:], m.AppHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AppHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -653,6 +977,57 @@ func (m *StatusResponse) Size() (n int) {
 	return n
 }
 
+func (m *StoreHashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *StoreHashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Stores) > 0 {
+		for _, e := range m.Stores {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoreHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1116,6 +1491,328 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StoreHashesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreHashesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreHashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreHashesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreHashesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreHashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stores = append(m.Stores, &StoreHash{})
+			if err := m.Stores[len(m.Stores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreHash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreHash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreHash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
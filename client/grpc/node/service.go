@@ -2,9 +2,14 @@ package node
 
 import (
 	context "context"
+	"sort"
 
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
@@ -12,8 +17,9 @@ import (
 )
 
 // RegisterNodeService registers the node gRPC service on the provided gRPC router.
-func RegisterNodeService(clientCtx client.Context, server gogogrpc.Server, cfg config.Config) {
-	RegisterServiceServer(server, NewQueryServer(clientCtx, cfg))
+// The store hashes are queried from the commit multi store of the application.
+func RegisterNodeService(clientCtx client.Context, server gogogrpc.Server, cfg config.Config, cms storetypes.CommitMultiStore) {
+	RegisterServiceServer(server, NewQueryServer(clientCtx, cfg, cms))
 }
 
 // RegisterGRPCGatewayRoutes mounts the node gRPC service's GRPC-gateway routes
@@ -27,12 +33,14 @@ var _ ServiceServer = queryServer{}
 type queryServer struct {
 	clientCtx client.Context
 	cfg       config.Config
+	cms       storetypes.CommitMultiStore
 }
 
-func NewQueryServer(clientCtx client.Context, cfg config.Config) ServiceServer {
+func NewQueryServer(clientCtx client.Context, cfg config.Config, cms storetypes.CommitMultiStore) ServiceServer {
 	return queryServer{
 		clientCtx: clientCtx,
 		cfg:       cfg,
+		cms:       cms,
 	}
 }
 
@@ -63,3 +71,39 @@ func (s queryServer) Status(ctx context.Context, _ *StatusRequest) (*StatusRespo
 		ValidatorHash: sdkCtx.BlockHeader().NextValidatorsHash,
 	}, nil
 }
+
+// commitInfoStore is a commit multi store keeping the commit infos of its
+// versions, such as the rootmulti.Store.
+type commitInfoStore interface {
+	GetCommitInfo(version int64) (*storetypes.CommitInfo, error)
+}
+
+func (s queryServer) StoreHashes(ctx context.Context, req *StoreHashesRequest) (*StoreHashesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	store, ok := s.cms.(commitInfoStore)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "the store hashes are not kept by %T", s.cms)
+	}
+
+	height := req.Height
+	if height == 0 {
+		height = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	}
+	info, err := store.GetCommitInfo(height)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no store hashes at height %d: %v", height, err)
+	}
+
+	stores := make([]*StoreHash, len(info.StoreInfos))
+	for i, storeInfo := range info.StoreInfos {
+		stores[i] = &StoreHash{Name: storeInfo.Name, Hash: storeInfo.CommitId.Hash}
+	}
+	sort.Slice(stores, func(i, j int) bool { return stores[i].Name < stores[j].Name })
+	return &StoreHashesResponse{
+		Height:  height,
+		AppHash: info.Hash(),
+		Stores:  stores,
+	}, nil
+}
@@ -3,8 +3,14 @@ package node
 import (
 	"testing"
 
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,7 +21,7 @@ func TestServiceServer_Config(t *testing.T) {
 	defaultCfg.PruningKeepRecent = "2000"
 	defaultCfg.PruningInterval = "10"
 	defaultCfg.HaltHeight = 100
-	svr := NewQueryServer(client.Context{}, *defaultCfg)
+	svr := NewQueryServer(client.Context{}, *defaultCfg, nil)
 	ctx := sdk.Context{}.WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 15)))
 
 	resp, err := svr.Config(ctx, &ConfigRequest{})
@@ -26,3 +32,35 @@ func TestServiceServer_Config(t *testing.T) {
 	require.Equal(t, defaultCfg.PruningInterval, resp.PruningInterval)
 	require.Equal(t, defaultCfg.HaltHeight, resp.HaltHeight)
 }
+
+func TestServiceServer_StoreHashes(t *testing.T) {
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	keys := storetypes.NewKVStoreKeys("bank", "acc")
+	for _, key := range keys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, cms.LoadLatestVersion())
+	cms.GetKVStore(keys["bank"]).Set([]byte("key"), []byte("value"))
+	commitID := cms.Commit()
+	cms.Commit()
+
+	svr := NewQueryServer(client.Context{}, *config.DefaultConfig(), cms)
+	ctx := sdk.Context{}.WithBlockHeight(2)
+
+	resp, err := svr.StoreHashes(ctx, &StoreHashesRequest{Height: 1})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), resp.Height)
+	require.Equal(t, commitID.Hash, resp.AppHash)
+	require.Len(t, resp.Stores, 2)
+	require.Equal(t, "acc", resp.Stores[0].Name)
+	require.Equal(t, "bank", resp.Stores[1].Name)
+	require.NotEqual(t, resp.Stores[0].Hash, resp.Stores[1].Hash)
+
+	// the height of the query by default
+	resp, err = svr.StoreHashes(ctx, &StoreHashesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), resp.Height)
+
+	_, err = svr.StoreHashes(ctx, &StoreHashesRequest{Height: 3})
+	require.ErrorContains(t, err, "no store hashes at height 3")
+}
@@ -56,6 +56,13 @@ func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, req, reply i
 		return err
 	}
 
+	// The responses of the gRPC queries are computed by the node, only the
+	// raw store key queries carry a proof: the query commands of the modules
+	// cannot be verified.
+	if ctx.Verifier != nil {
+		return errNotVerifiable(method)
+	}
+
 	if ctx.GRPCClient != nil {
 		// Case 2-1. Invoke grpc.
 		return ctx.GRPCClient.Invoke(grpcCtx, method, req, reply, opts...)
@@ -0,0 +1,109 @@
+package pruning
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/store/rootmulti"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+const FlagDryRun = "dry-run"
+
+// StoreCmd returns the group command managing the data of the root multi store
+// offline.
+func StoreCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store",
+		Short: "Manage the data of the application stores, the node must be stopped",
+	}
+	cmd.AddCommand(PruneOrphansCmd(defaultNodeHome))
+	return cmd
+}
+
+// PruneOrphansCmd deletes the data left by the stores deleted or renamed, and
+// reports the disk usage of each store.
+func PruneOrphansCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune-orphans",
+		Short: "Delete the data left by the deleted and renamed stores and report the disk usage of each store",
+		Long: `Delete the data left by the stores deleted or renamed, which are not part of the latest
+version, and report the disk usage of each store. The database is compacted afterwards if its
+backend supports it. The node must be stopped.
+
+The heights prior to the deletions or renames can no longer be queried once pruned.
+
+Note: When the --app-db-backend flag is not specified, the default backend type is 'goleveldb'.
+Supported app-db-backend types include 'goleveldb', 'rocksdb', 'pebbledb'.`,
+		Example: "store prune-orphans --dry-run",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			vp := viper.New()
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			home := vp.GetString(flags.FlagHome)
+			if home == "" {
+				home = defaultNodeHome
+			}
+
+			db, err := openDB(home, server.GetAppDBBackend(vp))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			usages, err := rootmulti.GetStoresUsage(db)
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "STORE\tKEYS\tBYTES\tORPHAN")
+			var orphans int
+			for _, usage := range usages {
+				fmt.Fprintf(w, "%s\t%d\t%d\t%t\n", usage.Name, usage.Keys, usage.Bytes, usage.Orphan)
+				if usage.Orphan {
+					orphans++
+				}
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+
+			if dryRun, _ := cmd.Flags().GetBool(FlagDryRun); dryRun || orphans == 0 {
+				cmd.Printf("%d orphan stores\n", orphans)
+				return nil
+			}
+
+			pruned, err := rootmulti.PruneOrphanStores(db)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("pruned the orphan stores %v\n", pruned)
+
+			// compaction reclaims the space of the deleted records
+			compacter, ok := db.(interface {
+				ForceCompact(start, limit []byte) error
+			})
+			if !ok {
+				cmd.Printf("the %T database does not support compaction, the space is reclaimed by its background compactions\n", db)
+				return nil
+			}
+			cmd.Println("compacting the database")
+			return compacter.ForceCompact(nil, nil)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagAppDBBackend, "", "The type of database for application and snapshots databases")
+	cmd.Flags().Bool(FlagDryRun, false, "Only report the disk usage of the stores")
+
+	return cmd
+}
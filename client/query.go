@@ -95,6 +95,26 @@ func (ctx Context) queryABCI(req abci.RequestQuery) (abci.ResponseQuery, error)
 		Prove:  req.Prove,
 	}
 
+	if ctx.Verifier != nil {
+		if !isQueryStoreWithProof(req.Path) {
+			return abci.ResponseQuery{}, errNotVerifiable(req.Path)
+		}
+		opts.Prove = true
+
+		if opts.Height == 0 {
+			// the app hash resulting from the latest block is only carried by
+			// the header of the next block, query the state of the previous one
+			status, err := node.Status(ctx.cmdContext())
+			if err != nil {
+				return abci.ResponseQuery{}, err
+			}
+			opts.Height = status.SyncInfo.LatestBlockHeight - 1
+			if opts.Height < 1 {
+				return abci.ResponseQuery{}, errors.New("no block to verify the query against yet")
+			}
+		}
+	}
+
 	result, err := node.ABCIQueryWithOptions(context.Background(), req.Path, req.Data, opts)
 	if err != nil {
 		return abci.ResponseQuery{}, err
@@ -105,10 +125,14 @@ func (ctx Context) queryABCI(req abci.RequestQuery) (abci.ResponseQuery, error)
 	}
 
 	// data from trusted node or subspace query doesn't need verification
-	if !opts.Prove || !isQueryStoreWithProof(req.Path) {
+	if ctx.Verifier == nil || !opts.Prove || !isQueryStoreWithProof(req.Path) {
 		return result.Response, nil
 	}
 
+	if err := ctx.verifyProof(req.Path, req.Data, opts.Height, result.Response); err != nil {
+		return abci.ResponseQuery{}, err
+	}
+
 	return result.Response, nil
 }
 
@@ -0,0 +1,132 @@
+package rpc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// storeQueryResult is the output of QueryStoreCmd.
+type storeQueryResult struct {
+	Height   int64  `json:"height"`
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	Found    bool   `json:"found"`
+	Verified bool   `json:"verified"`
+}
+
+// storeHashesResult is the output of QueryStoreHashesCmd, with hex hashes.
+type storeHashesResult struct {
+	Height  int64             `json:"height"`
+	AppHash string            `json:"app_hash"`
+	Stores  []storeHashResult `json:"stores"`
+}
+
+type storeHashResult struct {
+	Name string `json:"name"`
+	Hash string `json:"hash"`
+}
+
+// QueryStoreCmd returns a command to query the raw value of a key of a store,
+// which can be verified with the --verify flag.
+func QueryStoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store [store-name] [hex-key]",
+		Short: "Query the raw value of a key of a store",
+		Long: `Query the raw value of a key of a store, hex encoded.
+
+With --verify, the value, or its absence, is verified against the app hash of a header
+verified by a light client from a trusted header, so that the node does not need to be
+trusted. The trusted header is set with --trust-height and --trust-hash on the first
+verified query, and kept in the home directory. The other query commands, served by the
+gRPC queries of the modules, cannot be verified.`,
+		Example: fmt.Sprintf(`$ %[1]s query store bank 0255... --verify --trust-height 100 --trust-hash 5E1F...
+$ %[1]s query store bank 0255... --verify`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			key, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid key: %w", err)
+			}
+
+			res, err := clientCtx.QueryABCI(abci.RequestQuery{
+				Path:   fmt.Sprintf("/store/%s/key", args[0]),
+				Data:   key,
+				Height: clientCtx.Height,
+			})
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.Marshal(storeQueryResult{
+				Height:   res.Height,
+				Key:      hex.EncodeToString(key),
+				Value:    hex.EncodeToString(res.Value),
+				Found:    res.Value != nil,
+				Verified: clientCtx.Verifier != nil,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryStoreHashesCmd returns a command to query the commit hashes of the stores
+// at a height, in order to find the stores of two nodes which diverged.
+func QueryStoreHashesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-hashes",
+		Short: "Query the commit hashes of the stores at a height",
+		Long: `Query the commit hashes of the stores at a height, from which the app hash is computed.
+When two nodes compute different app hashes, the stores whose hashes differ are the ones
+which diverged.`,
+		Example: fmt.Sprintf("$ %s query store-hashes --height 100", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := node.NewServiceClient(clientCtx).StoreHashes(cmd.Context(), &node.StoreHashesRequest{Height: clientCtx.Height})
+			if err != nil {
+				return err
+			}
+
+			result := storeHashesResult{Height: res.Height, AppHash: hex.EncodeToString(res.AppHash)}
+			for _, store := range res.Stores {
+				result.Stores = append(result.Stores, storeHashResult{Name: store.Name, Hash: hex.EncodeToString(store.Hash)})
+			}
+			bz, err := json.Marshal(result)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
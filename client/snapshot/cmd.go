@@ -1,8 +1,12 @@
 package snapshot
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 
+	"cosmossdk.io/store/snapshots"
+
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
 
@@ -22,3 +26,15 @@ func Cmd(appCreator servertypes.AppCreator) *cobra.Command {
 	)
 	return cmd
 }
+
+// printProgress returns a progress function printing the number of chunks processed on the
+// error output of the command, overwriting the line each time.
+func printProgress(cmd *cobra.Command, action string) snapshots.ProgressFunc {
+	return func(done, total uint32) {
+		if total == 0 {
+			fmt.Fprintf(cmd.ErrOrStderr(), "\r%s %d chunks", action, done)
+			return
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "\r%s %d/%d chunks (%d%%)", action, done, total, uint64(done)*100/uint64(total))
+	}
+}
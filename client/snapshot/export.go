@@ -38,7 +38,9 @@ func ExportSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
 			cmd.Printf("Exporting snapshot for height %d\n", height)
 
 			sm := app.SnapshotManager()
+			sm.SetProgressFunc(printProgress(cmd, "Exported"))
 			snapshot, err := sm.Create(uint64(height))
+			cmd.PrintErrln()
 			if err != nil {
 				return err
 			}
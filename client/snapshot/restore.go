@@ -18,7 +18,7 @@ func RestoreSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "restore <height> <format>",
 		Short: "Restore app state from local snapshot",
-		Long:  "Restore app state from local snapshot",
+		Long:  "Restore app state from local snapshot. An interrupted restoration of the snapshot is resumed from the store it was restoring.",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := server.GetServerContextFromCmd(cmd)
@@ -41,7 +41,10 @@ func RestoreSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
 			app := appCreator(logger, db, nil, ctx.Viper)
 
 			sm := app.SnapshotManager()
-			return sm.RestoreLocalSnapshot(height, uint32(format))
+			sm.SetProgressFunc(printProgress(cmd, "Restored"))
+			err = sm.RestoreLocalSnapshot(height, uint32(format))
+			cmd.PrintErrln()
+			return err
 		},
 	}
 	return cmd
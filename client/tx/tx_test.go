@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -168,6 +169,24 @@ func TestBuildUnsignedTxWithWithExtensionOptions(t *testing.T) {
 	require.Equal(t, extOpts, txb.ExtOptions)
 }
 
+func TestBuildUnsignedTxUnordered(t *testing.T) {
+	txConfig, _ := newTestTxConfig()
+	msg := banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), nil)
+
+	txf := mockTxFactory(txConfig).WithUnordered(true)
+	_, err := txf.BuildUnsignedTx(msg)
+	require.Error(t, err)
+
+	timeout := time.Unix(1_700_000_000, 0).UTC()
+	tx, err := txf.WithTimeoutTimestamp(timeout).BuildUnsignedTx(msg)
+	require.NoError(t, err)
+
+	unorderedTx, ok := tx.GetTx().(sdk.TxWithUnordered)
+	require.True(t, ok)
+	require.True(t, unorderedTx.GetUnordered())
+	require.Equal(t, timeout, unorderedTx.GetTimeoutTimeStamp())
+}
+
 func TestMnemonicInMemo(t *testing.T) {
 	txConfig, cdc := newTestTxConfig()
 	kb, err := keyring.New(t.Name(), "test", t.TempDir(), nil, cdc)
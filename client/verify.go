@@ -0,0 +1,185 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/light"
+	lightdb "github.com/cometbft/cometbft/light/store/db"
+
+	"cosmossdk.io/store/rootmulti"
+)
+
+// lightDBName is the name of the database of the trusted headers.
+const lightDBName = "light"
+
+// Verifier provides the app hashes which the query proofs are verified
+// against.
+type Verifier interface {
+	// VerifiedAppHash returns the app hash resulting from the execution of the
+	// block at the given height, which is carried by the header of the next
+	// block. The header must be verified, a node being untrusted.
+	VerifiedAppHash(ctx context.Context, height int64) ([]byte, error)
+}
+
+// LightVerifierConfig defines the configuration of a LightVerifier.
+type LightVerifierConfig struct {
+	// Dir is the directory of the database of the trusted headers.
+	Dir string
+	// TrustHeight and TrustHash are the height and the hash of the header
+	// trusted to verify the next ones. They are only needed when the database
+	// holds no trusted header, or to trust another header.
+	TrustHeight int64
+	TrustHash   []byte
+	// TrustingPeriod is the period during which a trusted header can be used
+	// to verify the next ones, which should be shorter than the unbonding
+	// period.
+	TrustingPeriod time.Duration
+	// Witnesses are the RPC addresses of the nodes the headers of the primary
+	// node are cross-checked with. The primary node is its own witness if
+	// there are none, in which case a fork is not detected.
+	Witnesses []string
+}
+
+// LightVerifier is a Verifier verifying the headers with a CometBFT light
+// client, from a trusted header kept in a local database.
+type LightVerifier struct {
+	chainID string
+	primary string
+	cfg     LightVerifierConfig
+}
+
+var _ Verifier = (*LightVerifier)(nil)
+
+// NewLightVerifier returns a LightVerifier of the headers served by the
+// primary RPC address. The trusted header of the config, if any, is verified
+// and saved.
+func NewLightVerifier(ctx context.Context, chainID, primary string, cfg LightVerifierConfig) (*LightVerifier, error) {
+	if chainID == "" {
+		return nil, errors.New("the chain ID is required to verify the headers")
+	}
+	if len(cfg.Witnesses) == 0 {
+		cfg.Witnesses = []string{primary}
+	}
+
+	v := &LightVerifier{chainID: chainID, primary: primary, cfg: cfg}
+	if cfg.TrustHeight == 0 {
+		return v, nil
+	}
+
+	trustOptions := &light.TrustOptions{Period: cfg.TrustingPeriod, Height: cfg.TrustHeight, Hash: cfg.TrustHash}
+	if err := v.withClient(ctx, trustOptions, func(*light.Client) error { return nil }); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// VerifiedAppHash implements Verifier.
+func (v *LightVerifier) VerifiedAppHash(ctx context.Context, height int64) ([]byte, error) {
+	var appHash []byte
+	err := v.withClient(ctx, nil, func(c *light.Client) error {
+		block, err := c.VerifyLightBlockAtHeight(ctx, height+1, time.Now())
+		if err != nil {
+			return fmt.Errorf("failed to verify the header at height %d: %w", height+1, err)
+		}
+		appHash = block.AppHash
+		return nil
+	})
+	return appHash, err
+}
+
+// withClient runs fn with a light client backed by the database of the
+// trusted headers, trusting the header of the trust options if set.
+func (v *LightVerifier) withClient(ctx context.Context, trustOptions *light.TrustOptions, fn func(*light.Client) error) (err error) {
+	db, err := dbm.NewGoLevelDB(lightDBName, v.cfg.Dir)
+	if err != nil {
+		return fmt.Errorf("failed to open the trusted headers database: %w", err)
+	}
+	defer func() {
+		err = errors.Join(err, db.Close())
+	}()
+
+	trustedStore := lightdb.New(db, v.chainID)
+	if trustOptions == nil {
+		height, err := trustedStore.LastLightBlockHeight()
+		if err != nil {
+			return err
+		}
+		if height <= 0 {
+			return fmt.Errorf("no trusted header in %s, a header to trust must be set", filepath.Join(v.cfg.Dir, lightDBName+".db"))
+		}
+	}
+
+	var c *light.Client
+	if trustOptions != nil {
+		c, err = light.NewHTTPClient(ctx, v.chainID, *trustOptions, v.primary, v.cfg.Witnesses, trustedStore)
+	} else {
+		c, err = light.NewHTTPClientFromTrustedStore(v.chainID, v.cfg.TrustingPeriod, v.primary, v.cfg.Witnesses, trustedStore)
+	}
+	if err != nil {
+		return err
+	}
+
+	return fn(c)
+}
+
+// errNotVerifiable is returned for the queries which cannot be verified.
+func errNotVerifiable(path string) error {
+	return fmt.Errorf("the query %s cannot be verified, only the raw store key queries (/store/<store>/key), such as the ones of the query store command, carry a proof", path)
+}
+
+// verifyProof verifies the proof of the store key query of the given key at
+// the given height against the app hash resulting from the block at that
+// height, provided by the verifier of the context. The key and the height of
+// the response, served by an untrusted node, must be the requested ones.
+func (ctx Context) verifyProof(path string, key []byte, height int64, resp abci.ResponseQuery) error {
+	if resp.ProofOps == nil {
+		return errors.New("the response carries no proof")
+	}
+	if !bytes.Equal(resp.Key, key) {
+		return fmt.Errorf("the response is for the key %X instead of the queried key %X", resp.Key, key)
+	}
+	if resp.Height != height {
+		return fmt.Errorf("the response is for the height %d instead of the queried height %d", resp.Height, height)
+	}
+
+	appHash, err := ctx.Verifier.VerifiedAppHash(ctx.cmdContext(), height)
+	if err != nil {
+		return err
+	}
+
+	// the path is /store/<store>/key
+	storeName := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 3)[1]
+	kp := merkle.KeyPath{}
+	kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
+	kp = kp.AppendKey(key, merkle.KeyEncodingURL)
+
+	prt := rootmulti.DefaultProofRuntime()
+	if resp.Value == nil {
+		if err := prt.VerifyAbsence(resp.ProofOps, appHash, kp.String()); err != nil {
+			return fmt.Errorf("failed to verify the proof of absence: %w", err)
+		}
+		return nil
+	}
+
+	if err := prt.VerifyValue(resp.ProofOps, appHash, kp.String(), resp.Value); err != nil {
+		return fmt.Errorf("failed to verify the proof: %w", err)
+	}
+	return nil
+}
+
+// cmdContext returns the context.Context of the command, or a background one.
+func (ctx Context) cmdContext() context.Context {
+	if ctx.CmdContext != nil {
+		return ctx.CmdContext
+	}
+	return context.Background()
+}
@@ -0,0 +1,140 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpcclientmock "github.com/cometbft/cometbft/rpc/client/mock"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+// storeNode serves the store queries from a multistore, tampering with the
+// values if set, or lying by serving the given key or height instead of the
+// queried ones.
+type storeNode struct {
+	rpcclientmock.Client
+	rs        *rootmulti.Store
+	tamper    bool
+	lieKey    []byte
+	lieHeight int64
+}
+
+func (n storeNode) ABCIQueryWithOptions(_ context.Context, path string, data cmtbytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	if n.lieKey != nil {
+		data = n.lieKey
+	}
+	if n.lieHeight != 0 {
+		opts.Height = n.lieHeight
+	}
+
+	res, err := n.rs.Query(&storetypes.RequestQuery{
+		Path:   strings.TrimPrefix(path, "/store"),
+		Data:   data,
+		Height: opts.Height,
+		Prove:  opts.Prove,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if n.tamper && res.Value != nil {
+		res.Value = []byte("tampered")
+	}
+
+	return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{
+		Key:      res.Key,
+		Value:    res.Value,
+		ProofOps: res.ProofOps,
+		Height:   res.Height,
+	}}, nil
+}
+
+func (n storeNode) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: n.rs.LastCommitID().Version}}, nil
+}
+
+// appHashes is a Verifier returning the app hashes of the committed versions.
+type appHashes map[int64][]byte
+
+func (h appHashes) VerifiedAppHash(_ context.Context, height int64) ([]byte, error) {
+	appHash, ok := h[height]
+	if !ok {
+		return nil, fmt.Errorf("no header at height %d", height+1)
+	}
+	return appHash, nil
+}
+
+func TestQueryVerify(t *testing.T) {
+	key := storetypes.NewKVStoreKey("bank")
+	rs := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	rs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(storetypes.NewKVStoreKey("acc"), storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	hashes := appHashes{}
+	for version := 1; version <= 2; version++ {
+		rs.GetKVStore(key).Set([]byte("key"), []byte(fmt.Sprintf("value%d", version)))
+		rs.GetKVStore(key).Set([]byte("other"), []byte(fmt.Sprintf("other%d", version)))
+		commitID := rs.Commit()
+		hashes[commitID.Version] = commitID.Hash
+	}
+
+	query := func(ctx client.Context, key string, height int64) (abci.ResponseQuery, error) {
+		return ctx.QueryABCI(abci.RequestQuery{Path: "/store/bank/key", Data: []byte(key), Height: height})
+	}
+
+	ctx := client.Context{}.WithClient(storeNode{rs: rs}).WithVerifier(hashes)
+
+	res, err := query(ctx, "key", 1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), res.Value)
+
+	// the version of the previous block is queried by default, the header of
+	// the next block carrying its app hash
+	res, err = query(ctx, "key", 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.Height)
+
+	// the absence of a key is verified
+	res, err = query(ctx, "missing", 2)
+	require.NoError(t, err)
+	require.Nil(t, res.Value)
+
+	// a value not matching the app hash is rejected
+	_, err = query(ctx.WithClient(storeNode{rs: rs, tamper: true}), "key", 2)
+	require.ErrorContains(t, err, "failed to verify the proof")
+	_, err = query(ctx.WithVerifier(appHashes{2: hashes[1]}), "key", 2)
+	require.ErrorContains(t, err, "failed to verify the proof")
+
+	// a valid proof of another key or height is rejected
+	_, err = query(ctx.WithClient(storeNode{rs: rs, lieKey: []byte("other")}), "key", 2)
+	require.ErrorContains(t, err, "instead of the queried key")
+	_, err = query(ctx.WithClient(storeNode{rs: rs, lieKey: []byte("other")}), "missing", 2)
+	require.ErrorContains(t, err, "instead of the queried key")
+	_, err = query(ctx.WithClient(storeNode{rs: rs, lieHeight: 1}), "key", 2)
+	require.ErrorContains(t, err, "instead of the queried height")
+
+	// the verified app hash is required
+	_, err = query(ctx.WithVerifier(appHashes{1: hashes[1]}), "key", 2)
+	require.ErrorContains(t, err, "no header at height 3")
+
+	// the responses of the other queries carry no proof
+	_, err = ctx.QueryABCI(abci.RequestQuery{Path: "/cosmos.bank.v1beta1.Query/Balance"})
+	require.ErrorContains(t, err, "cannot be verified")
+	err = ctx.Invoke(context.Background(), "/testpb.Query/Echo", &testdata.EchoRequest{}, &testdata.EchoResponse{})
+	require.ErrorContains(t, err, "cannot be verified")
+}
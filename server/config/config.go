@@ -29,6 +29,14 @@ const (
 	// DefaultGRPCMaxSendMsgSize defines the default gRPC max message size in
 	// bytes the server can send.
 	DefaultGRPCMaxSendMsgSize = math.MaxInt32
+
+	// MempoolTypeSenderNonce defines the sender-nonce mempool, selecting the
+	// transactions of random senders in their nonce order.
+	MempoolTypeSenderNonce = "sender-nonce"
+
+	// MempoolTypePriorityNonce defines the priority-nonce mempool, selecting
+	// the transactions by priority and in their nonce order for each sender.
+	MempoolTypePriorityNonce = "priority-nonce"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -158,6 +166,40 @@ type StateSyncConfig struct {
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
 }
 
+// StateStorageConfig defines the state storage configuration.
+type StateStorageConfig struct {
+	// Enable serves the reads of the IAVL stores from a flat versioned state
+	// storage, the IAVL trees being only used for state commitment.
+	Enable bool `mapstructure:"enable"`
+
+	// Backend defines the database backend of the state storage. It defaults to
+	// the app-db-backend.
+	Backend string `mapstructure:"backend"`
+
+	// Pruning sets the pruning strategy of the state storage: default, nothing,
+	// everything, or custom.
+	Pruning string `mapstructure:"pruning"`
+
+	// PruningKeepRecent sets the number of recent versions kept, for the custom
+	// pruning strategy.
+	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
+
+	// PruningInterval sets the interval at which the state storage is pruned,
+	// for the custom pruning strategy.
+	PruningInterval string `mapstructure:"pruning-interval"`
+}
+
+// ArchiveConfig defines the archive configuration.
+type ArchiveConfig struct {
+	// Enable streams the committed state changes into an append-only archive,
+	// serving the gRPC queries of the heights pruned from the IAVL trees.
+	Enable bool `mapstructure:"enable"`
+
+	// Backend defines the database backend of the archive. It defaults to the
+	// app-db-backend.
+	Backend string `mapstructure:"backend"`
+}
+
 // MempoolConfig defines the configurations for the SDK built-in app-side mempool
 // implementations.
 type MempoolConfig struct {
@@ -166,13 +208,61 @@ type MempoolConfig struct {
 	// unbounded in how many txs it may contain, and a positive value indicates
 	// the maximum amount of txs it may contain.
 	MaxTxs int `mapstructure:"max-txs"`
+
+	// Type defines the mempool implementation, either sender-nonce or
+	// priority-nonce.
+	Type string `mapstructure:"type"`
+
+	// EvictOnFull defines if a transaction inserted into a full priority-nonce
+	// mempool evicts the transaction of lowest priority, if lower than its own.
+	EvictOnFull bool `mapstructure:"evict-on-full"`
+
+	// MaxTxsPerSender caps the number of transactions of a sender in the
+	// priority-nonce mempool, if greater than 0.
+	MaxTxsPerSender int `mapstructure:"max-txs-per-sender"`
+
+	// TxTTL is the number of blocks after which a transaction is evicted from
+	// the priority-nonce mempool, if greater than 0.
+	TxTTL int64 `mapstructure:"tx-ttl"`
+
+	// MinBumpPercent is the percentage by which the priority of a transaction
+	// must be greater than the one of the transaction of the same sender and
+	// nonce in the priority-nonce mempool to replace it, if greater than 0.
+	MinBumpPercent uint64 `mapstructure:"min-bump-percent"`
+
+	// Journal defines if the transactions of the mempool are journaled to
+	// data/mempool.wal, to be inserted back into the mempool after a restart.
+	Journal bool `mapstructure:"journal"`
+}
+
+// ValidateBasic returns an error if the mempool type is unknown or if options
+// of the priority-nonce mempool are set for the sender-nonce one.
+func (c MempoolConfig) ValidateBasic() error {
+	switch c.Type {
+	case "", MempoolTypeSenderNonce:
+		if c.EvictOnFull || c.MaxTxsPerSender != 0 || c.TxTTL != 0 || c.MinBumpPercent != 0 {
+			return sdkerrors.ErrAppConfig.Wrapf(
+				"evict-on-full, max-txs-per-sender, tx-ttl and min-bump-percent only apply to the '%s' mempool", MempoolTypePriorityNonce,
+			)
+		}
+	case MempoolTypePriorityNonce:
+		if c.MaxTxsPerSender < 0 || c.TxTTL < 0 {
+			return sdkerrors.ErrAppConfig.Wrap("max-txs-per-sender and tx-ttl of the mempool cannot be negative")
+		}
+	default:
+		return sdkerrors.ErrAppConfig.Wrapf("unknown mempool type '%s'", c.Type)
+	}
+
+	return nil
 }
 
 // State Streaming configuration
 type (
 	// StreamingConfig defines application configuration for external streaming services
 	StreamingConfig struct {
-		ABCI ABCIListenerConfig `mapstructure:"abci"`
+		ABCI   ABCIListenerConfig   `mapstructure:"abci"`
+		File   FileListenerConfig   `mapstructure:"file"`
+		SQLite SQLiteListenerConfig `mapstructure:"sqlite"`
 	}
 	// ABCIListenerConfig defines application configuration for ABCIListener streaming service
 	ABCIListenerConfig struct {
@@ -180,6 +270,28 @@ type (
 		Plugin        string   `mapstructure:"plugin"`
 		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
 	}
+	// FileListenerConfig defines application configuration for the built-in
+	// streaming listener writing into local files
+	FileListenerConfig struct {
+		Enable        bool     `mapstructure:"enable"`
+		Keys          []string `mapstructure:"keys"`
+		Dir           string   `mapstructure:"dir"`
+		MaxFileSize   int64    `mapstructure:"max-file-size"`
+		MaxFiles      int      `mapstructure:"max-files"`
+		Fsync         bool     `mapstructure:"fsync"`
+		BufferSize    int      `mapstructure:"buffer-size"`
+		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
+	}
+	// SQLiteListenerConfig defines application configuration for the built-in
+	// streaming listener writing into a SQLite database
+	SQLiteListenerConfig struct {
+		Enable        bool     `mapstructure:"enable"`
+		Keys          []string `mapstructure:"keys"`
+		Path          string   `mapstructure:"path"`
+		Driver        string   `mapstructure:"driver"`
+		BufferSize    int      `mapstructure:"buffer-size"`
+		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
+	}
 )
 
 // Config defines the server's top level configuration
@@ -187,13 +299,15 @@ type Config struct {
 	BaseConfig `mapstructure:",squash"`
 
 	// Telemetry defines the application telemetry configuration
-	Telemetry telemetry.Config `mapstructure:"telemetry"`
-	API       APIConfig        `mapstructure:"api"`
-	GRPC      GRPCConfig       `mapstructure:"grpc"`
-	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
-	StateSync StateSyncConfig  `mapstructure:"state-sync"`
-	Streaming StreamingConfig  `mapstructure:"streaming"`
-	Mempool   MempoolConfig    `mapstructure:"mempool"`
+	Telemetry    telemetry.Config   `mapstructure:"telemetry"`
+	API          APIConfig          `mapstructure:"api"`
+	GRPC         GRPCConfig         `mapstructure:"grpc"`
+	GRPCWeb      GRPCWebConfig      `mapstructure:"grpc-web"`
+	StateSync    StateSyncConfig    `mapstructure:"state-sync"`
+	StateStorage StateStorageConfig `mapstructure:"state-storage"`
+	Archive      ArchiveConfig      `mapstructure:"archive"`
+	Streaming    StreamingConfig    `mapstructure:"streaming"`
+	Mempool      MempoolConfig      `mapstructure:"mempool"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -256,14 +370,38 @@ func DefaultConfig() *Config {
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 		},
+		StateStorage: StateStorageConfig{
+			Enable:            false,
+			Pruning:           pruningtypes.PruningOptionNothing,
+			PruningKeepRecent: "0",
+			PruningInterval:   "0",
+		},
+		Archive: ArchiveConfig{
+			Enable: false,
+		},
 		Streaming: StreamingConfig{
 			ABCI: ABCIListenerConfig{
 				Keys:          []string{},
 				StopNodeOnErr: true,
 			},
+			File: FileListenerConfig{
+				Keys:          []string{"*"},
+				Dir:           "streaming",
+				MaxFileSize:   128 << 20,
+				BufferSize:    100,
+				StopNodeOnErr: true,
+			},
+			SQLite: SQLiteListenerConfig{
+				Keys:          []string{"*"},
+				Path:          "streaming.db",
+				Driver:        "sqlite",
+				BufferSize:    100,
+				StopNodeOnErr: true,
+			},
 		},
 		Mempool: MempoolConfig{
 			MaxTxs: -1,
+			Type:   MempoolTypeSenderNonce,
 		},
 	}
 }
@@ -287,6 +425,9 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	if err := c.Mempool.ValidateBasic(); err != nil {
+		return err
+	}
 
 	return nil
 }
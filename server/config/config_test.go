@@ -52,6 +52,22 @@ func TestStreamingConfig(t *testing.T) {
 				Plugin:        "plugin-A",
 				StopNodeOnErr: false,
 			},
+			File: FileListenerConfig{
+				Enable:        true,
+				Keys:          []string{"*"},
+				Dir:           "/streaming",
+				MaxFileSize:   1024,
+				MaxFiles:      3,
+				Fsync:         true,
+				BufferSize:    10,
+				StopNodeOnErr: true,
+			},
+			SQLite: SQLiteListenerConfig{
+				Keys:       []string{"three"},
+				Path:       "streaming.db",
+				Driver:     "sqlite3",
+				BufferSize: 20,
+			},
 		},
 	}
 
@@ -68,6 +84,13 @@ func TestStreamingConfig(t *testing.T) {
 		`keys = ["one", "two", ]`,
 		`plugin = "plugin-A"`,
 		`stop-node-on-err = false`,
+		`keys = ["*", ]`,
+		`dir = "/streaming"`,
+		`max-file-size = 1024`,
+		`max-files = 3`,
+		`fsync = true`,
+		`driver = "sqlite3"`,
+		`buffer-size = 20`,
 	}
 
 	for _, line := range expectedLines {
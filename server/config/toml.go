@@ -208,6 +208,48 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
+###############################################################################
+###                          State Storage Configuration                    ###
+###############################################################################
+
+# The state storage is a flat versioned database serving the reads of the IAVL
+# stores and the queries of past heights, the IAVL trees then being only used to
+# compute the app hash and the query proofs. It allows pruning the IAVL trees
+# aggressively while keeping the state history. It is populated from the IAVL
+# trees the first time the node starts with it.
+[state-storage]
+
+# enable defines if the state storage should be enabled.
+enable = {{ .StateStorage.Enable }}
+
+# backend defines the database backend of the state storage (defaults to app-db-backend).
+backend = "{{ .StateStorage.Backend }}"
+
+# pruning sets the pruning strategy of the state storage, independently of the
+# IAVL trees one: default|nothing|everything|custom.
+pruning = "{{ .StateStorage.Pruning }}"
+
+# These are applied if and only if the pruning strategy is custom.
+pruning-keep-recent = "{{ .StateStorage.PruningKeepRecent }}"
+pruning-interval = "{{ .StateStorage.PruningInterval }}"
+
+###############################################################################
+###                             Archive Configuration                       ###
+###############################################################################
+
+# The archive is an append-only database fed by the state changes of every
+# committed block. It is never pruned and serves the gRPC queries of any height
+# since it was enabled (using the x-cosmos-block-height header) once the height
+# is pruned from the IAVL trees, without keeping the trees unpruned. It is
+# populated with the latest state the first time the node starts with it.
+[archive]
+
+# enable defines if the archive should be enabled.
+enable = {{ .Archive.Enable }}
+
+# backend defines the database backend of the archive (defaults to app-db-backend).
+backend = "{{ .Archive.Backend }}"
+
 ###############################################################################
 ###                              State Streaming                            ###
 ###############################################################################
@@ -234,6 +276,59 @@ plugin = "{{ .Streaming.ABCI.Plugin }}"
 # stop-node-on-err specifies whether to stop the node on message delivery error.
 stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 
+# streaming.file specifies the configuration for the built-in listener writing
+# the blocks and their state changes into local files.
+[streaming.file]
+
+# enable defines if the file listener should be enabled.
+enable = {{ .Streaming.File.Enable }}
+
+# List of kv store keys to stream out, ["*"] to expose all keys.
+keys = [{{ range .Streaming.File.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# dir is the directory of the files, relative to the data directory unless absolute.
+dir = "{{ .Streaming.File.Dir }}"
+
+# max-file-size is the size in bytes from which a new file is started, 0 to never rotate.
+max-file-size = {{ .Streaming.File.MaxFileSize }}
+
+# max-files is the number of files kept on rotation, 0 to keep all of them.
+max-files = {{ .Streaming.File.MaxFiles }}
+
+# fsync defines if the files are synced to disk after every block.
+fsync = {{ .Streaming.File.Fsync }}
+
+# buffer-size is the number of messages buffered before the node waits for the
+# writes, 0 to write synchronously. Buffered messages are lost on a crash.
+buffer-size = {{ .Streaming.File.BufferSize }}
+
+# stop-node-on-err specifies whether to stop the node on write error.
+stop-node-on-err = {{ .Streaming.File.StopNodeOnErr }}
+
+# streaming.sqlite specifies the configuration for the built-in listener writing
+# the blocks, their transaction results, events and state changes into a SQLite
+# database. The application must link a database/sql driver for it.
+[streaming.sqlite]
+
+# enable defines if the sqlite listener should be enabled.
+enable = {{ .Streaming.SQLite.Enable }}
+
+# List of kv store keys to stream out, ["*"] to expose all keys.
+keys = [{{ range .Streaming.SQLite.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# path is the path of the database, relative to the data directory unless absolute.
+path = "{{ .Streaming.SQLite.Path }}"
+
+# driver is the name of the database/sql driver.
+driver = "{{ .Streaming.SQLite.Driver }}"
+
+# buffer-size is the number of messages buffered before the node waits for the
+# writes, 0 to write synchronously. Buffered messages are lost on a crash.
+buffer-size = {{ .Streaming.SQLite.BufferSize }}
+
+# stop-node-on-err specifies whether to stop the node on write error.
+stop-node-on-err = {{ .Streaming.SQLite.StopNodeOnErr }}
+
 ###############################################################################
 ###                         Mempool                                         ###
 ###############################################################################
@@ -246,6 +341,34 @@ stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
 max-txs = {{ .Mempool.MaxTxs }}
+
+# Type defines the mempool implementation:
+# - sender-nonce: the transactions of random senders are selected in their nonce order
+# - priority-nonce: the transactions are selected by priority and in their nonce order for each sender
+type = "{{ .Mempool.Type }}"
+
+# The following options only apply to the priority-nonce mempool.
+#
+# EvictOnFull defines if a transaction inserted into a full mempool evicts the transaction of lowest
+# priority, if lower than its own, along with the following transactions of its sender.
+evict-on-full = {{ .Mempool.EvictOnFull }}
+
+# MaxTxsPerSender caps the number of transactions of a sender in the mempool, if greater than 0.
+max-txs-per-sender = {{ .Mempool.MaxTxsPerSender }}
+
+# TxTTL is the number of blocks after which a transaction is evicted from the mempool, along with
+# the following transactions of its sender, if greater than 0.
+tx-ttl = {{ .Mempool.TxTTL }}
+
+# MinBumpPercent is the percentage by which the priority of a transaction must be greater than the one
+# of the transaction of the same sender and nonce to replace it, if greater than 0. The transactions
+# are not replaced if 0.
+min-bump-percent = {{ .Mempool.MinBumpPercent }}
+
+# Journal enables the journaling of the transactions inserted into and removed from the mempool
+# to data/mempool.wal. On startup, the journaled transactions are validated again through CheckTx
+# and inserted back into the mempool. The journal is ignored if the mempool is disabled.
+journal = {{ .Mempool.Journal }}
 `
 
 var configTemplate *template.Template
@@ -15,7 +15,18 @@ import (
 // PruningOptions. If a pruning strategy is provided, that will be parsed and
 // returned, otherwise, it is assumed custom pruning options are provided.
 func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningOptions, error) {
-	strategy := strings.ToLower(cast.ToString(appOpts.Get(FlagPruning)))
+	return getPruningOptions(appOpts, FlagPruning, FlagPruningKeepRecent, FlagPruningInterval)
+}
+
+// GetStateStoragePruningOptionsFromFlags parses command flags and returns the
+// PruningOptions of the state storage, as GetPruningOptionsFromFlags does for
+// the IAVL stores.
+func GetStateStoragePruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningOptions, error) {
+	return getPruningOptions(appOpts, FlagStateStoragePruning, FlagStateStoragePruningKeepRecent, FlagStateStoragePruningInterval)
+}
+
+func getPruningOptions(appOpts types.AppOptions, strategyFlag, keepRecentFlag, intervalFlag string) (pruningtypes.PruningOptions, error) {
+	strategy := strings.ToLower(cast.ToString(appOpts.Get(strategyFlag)))
 
 	switch strategy {
 	case pruningtypes.PruningOptionDefault, pruningtypes.PruningOptionNothing, pruningtypes.PruningOptionEverything:
@@ -23,8 +34,8 @@ func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningO
 
 	case pruningtypes.PruningOptionCustom:
 		opts := pruningtypes.NewCustomPruningOptions(
-			cast.ToUint64(appOpts.Get(FlagPruningKeepRecent)),
-			cast.ToUint64(appOpts.Get(FlagPruningInterval)),
+			cast.ToUint64(appOpts.Get(keepRecentFlag)),
+			cast.ToUint64(appOpts.Get(intervalFlag)),
 		)
 
 		if err := opts.Validate(); err != nil {
@@ -66,3 +66,20 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestGetStateStoragePruningOptionsFromFlags(t *testing.T) {
+	v := viper.New()
+	v.Set(FlagPruning, pruningtypes.PruningOptionNothing)
+	v.Set(FlagStateStoragePruning, pruningtypes.PruningOptionCustom)
+	v.Set(FlagStateStoragePruningKeepRecent, 100)
+	v.Set(FlagStateStoragePruningInterval, 10)
+
+	opts, err := GetStateStoragePruningOptionsFromFlags(v)
+	require.NoError(t, err)
+	require.Equal(t, pruningtypes.NewCustomPruningOptions(100, 10), opts)
+
+	// the IAVL stores pruning is not affected
+	opts, err = GetPruningOptionsFromFlags(v)
+	require.NoError(t, err)
+	require.Equal(t, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing), opts)
+}
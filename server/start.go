@@ -80,6 +80,17 @@ const (
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
 	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
 
+	// state storage-related flags
+	FlagStateStorageEnable            = "state-storage.enable"
+	FlagStateStorageBackend           = "state-storage.backend"
+	FlagStateStoragePruning           = "state-storage.pruning"
+	FlagStateStoragePruningKeepRecent = "state-storage.pruning-keep-recent"
+	FlagStateStoragePruningInterval   = "state-storage.pruning-interval"
+
+	// archive-related flags
+	FlagArchiveEnable  = "archive.enable"
+	FlagArchiveBackend = "archive.backend"
+
 	// api-related flags
 	FlagAPIEnable             = "api.enable"
 	FlagAPISwagger            = "api.swagger"
@@ -97,7 +108,13 @@ const (
 	flagGRPCWebEnable = "grpc-web.enable"
 
 	// mempool flags
-	FlagMempoolMaxTxs = "mempool.max-txs"
+	FlagMempoolMaxTxs          = "mempool.max-txs"
+	FlagMempoolType            = "mempool.type"
+	FlagMempoolEvictOnFull     = "mempool.evict-on-full"
+	FlagMempoolMaxTxsPerSender = "mempool.max-txs-per-sender"
+	FlagMempoolTxTTL           = "mempool.tx-ttl"
+	FlagMempoolMinBumpPercent  = "mempool.min-bump-percent"
+	FlagMempoolJournal         = "mempool.journal"
 
 	// testnet keys
 	KeyIsTestnet             = "is-testnet"
@@ -390,6 +407,11 @@ func startCmtNode(
 		return tmNode, cleanupFn, err
 	}
 
+	// the node replays the blocks the app is missing when created, so the
+	// journaled txs are checked against the synced state before CometBFT
+	// starts to call CheckTx
+	app.ReplayMempoolJournal()
+
 	if err := tmNode.Start(); err != nil {
 		return tmNode, cleanupFn, err
 	}
@@ -995,7 +1017,20 @@ func addStartNodeFlags(cmd *cobra.Command, opts StartCmdOptions) {
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().Bool(FlagStateStorageEnable, false, "Serve the IAVL stores reads and past heights queries from a flat versioned state storage")
+	cmd.Flags().String(FlagStateStorageBackend, "", "Database backend of the state storage (defaults to app-db-backend)")
+	cmd.Flags().String(FlagStateStoragePruning, pruningtypes.PruningOptionNothing, "State storage pruning strategy (default|nothing|everything|custom)")
+	cmd.Flags().Uint64(FlagStateStoragePruningKeepRecent, 0, "Number of recent heights to keep in the state storage (ignored if state storage pruning is not 'custom')")
+	cmd.Flags().Uint64(FlagStateStoragePruningInterval, 0, "Height interval at which the state storage is pruned (ignored if state storage pruning is not 'custom')")
+	cmd.Flags().Bool(FlagArchiveEnable, false, "Stream the committed state changes into an archive serving the queries of pruned heights")
+	cmd.Flags().String(FlagArchiveBackend, "", "Database backend of the archive (defaults to app-db-backend)")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
+	cmd.Flags().String(FlagMempoolType, serverconfig.MempoolTypeSenderNonce, "Type of the app-side mempool (sender-nonce|priority-nonce)")
+	cmd.Flags().Bool(FlagMempoolEvictOnFull, false, "Evict the transactions of lowest priority from the full priority-nonce mempool")
+	cmd.Flags().Int(FlagMempoolMaxTxsPerSender, 0, "Maximum number of transactions of a sender in the priority-nonce mempool (0 for unbounded)")
+	cmd.Flags().Int64(FlagMempoolTxTTL, 0, "Number of blocks after which a transaction is evicted from the priority-nonce mempool (0 to disable)")
+	cmd.Flags().Uint64(FlagMempoolMinBumpPercent, 0, "Minimum priority bump, in percent, replacing a transaction of the same nonce in the priority-nonce mempool (0 to disable)")
+	cmd.Flags().Bool(FlagMempoolJournal, false, "Journal the transactions of the app-side mempool to insert them back after a restart")
 	cmd.Flags().Duration(FlagShutdownGrace, 0*time.Second, "On Shutdown, duration to wait for resource clean up")
 
 	// support old flags name for backwards compatibility
@@ -59,6 +59,10 @@ type (
 		// Return the snapshot manager
 		SnapshotManager() *snapshots.Manager
 
+		// ReplayMempoolJournal is called in start cmd once the app is synced
+		// with CometBFT to insert the journaled txs back into the mempool.
+		ReplayMempoolJournal()
+
 		// Close is called in start cmd to gracefully cleanup resources.
 		// Must be safe to be called multiple times.
 		Close() error
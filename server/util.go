@@ -28,6 +28,7 @@ import (
 	"cosmossdk.io/store"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"cosmossdk.io/store/storage"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -540,16 +541,40 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotKeepRecent)),
 	)
 
+	baseappOptions := []func(*baseapp.BaseApp){}
+	if cast.ToBool(appOpts.Get(FlagStateStorageEnable)) {
+		stateStorage, err := GetStateStorage(appOpts)
+		if err != nil {
+			panic(err)
+		}
+
+		stateStoragePruningOpts, err := GetStateStoragePruningOptionsFromFlags(appOpts)
+		if err != nil {
+			panic(err)
+		}
+
+		baseappOptions = append(baseappOptions, baseapp.SetStateStorage(stateStorage, stateStoragePruningOpts))
+	}
+
+	if cast.ToBool(appOpts.Get(FlagArchiveEnable)) {
+		archive, err := GetArchive(appOpts)
+		if err != nil {
+			panic(err)
+		}
+
+		baseappOptions = append(baseappOptions, baseapp.SetArchive(archive))
+	}
+
 	defaultMempool := baseapp.SetMempool(mempool.NoOpMempool{})
 	if maxTxs := cast.ToInt(appOpts.Get(FlagMempoolMaxTxs)); maxTxs >= 0 {
-		defaultMempool = baseapp.SetMempool(
-			mempool.NewSenderNonceMempool(
-				mempool.SenderNonceMaxTxOpt(maxTxs),
-			),
-		)
+		mp, err := GetMempool(appOpts, maxTxs)
+		if err != nil {
+			panic(err)
+		}
+		defaultMempool = baseapp.SetMempool(mp)
 	}
 
-	return []func(*baseapp.BaseApp){
+	return append([]func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(FlagHaltHeight))),
@@ -564,7 +589,82 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		defaultMempool,
 		baseapp.SetChainID(chainID),
 		baseapp.SetQueryGasLimit(cast.ToUint64(appOpts.Get(FlagQueryGasLimit))),
+	}, baseappOptions...)
+}
+
+// GetMempool returns the mempool of the type set in the [mempool] section of the
+// app options, capped to maxTxs transactions and journaled if enabled.
+func GetMempool(appOpts types.AppOptions, maxTxs int) (mempool.Mempool, error) {
+	cfg := config.MempoolConfig{
+		MaxTxs:          maxTxs,
+		Type:            cast.ToString(appOpts.Get(FlagMempoolType)),
+		EvictOnFull:     cast.ToBool(appOpts.Get(FlagMempoolEvictOnFull)),
+		MaxTxsPerSender: cast.ToInt(appOpts.Get(FlagMempoolMaxTxsPerSender)),
+		TxTTL:           cast.ToInt64(appOpts.Get(FlagMempoolTxTTL)),
+		MinBumpPercent:  cast.ToUint64(appOpts.Get(FlagMempoolMinBumpPercent)),
+	}
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
 	}
+
+	var mp mempool.ExtMempool
+	switch cfg.Type {
+	case config.MempoolTypePriorityNonce:
+		priorityCfg := mempool.DefaultPriorityNonceMempoolConfig()
+		priorityCfg.MaxTx = cfg.MaxTxs
+		priorityCfg.EvictOnFull = cfg.EvictOnFull
+		priorityCfg.MaxTxPerSender = cfg.MaxTxsPerSender
+		priorityCfg.TxTTL = cfg.TxTTL
+		if cfg.MinBumpPercent > 0 {
+			priorityCfg.TxReplacement = mempool.MinBumpTxReplacement(cfg.MinBumpPercent)
+		}
+		mp = mempool.NewPriorityMempool(priorityCfg)
+	default:
+		mp = mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(cfg.MaxTxs))
+	}
+
+	if cast.ToBool(appOpts.Get(FlagMempoolJournal)) {
+		return GetMempoolJournal(appOpts, mp)
+	}
+	return mp, nil
+}
+
+// GetMempoolJournal returns a mempool journaling the transactions of a mempool
+// to the mempool.wal file of the data directory.
+func GetMempoolJournal(appOpts types.AppOptions, mp mempool.ExtMempool) (*mempool.JournaledMempool, error) {
+	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
+	return mempool.NewJournaledMempool(mp, filepath.Join(homeDir, "data", "mempool.wal"))
+}
+
+// GetStateStorage opens the state storage database in the data directory.
+func GetStateStorage(appOpts types.AppOptions) (*storage.Database, error) {
+	db, err := openStorageDB(appOpts, "state_storage", FlagStateStorageBackend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open state storage: %w", err)
+	}
+
+	return storage.NewDatabase(db), nil
+}
+
+// GetArchive opens the archive database in the data directory.
+func GetArchive(appOpts types.AppOptions) (*storage.Database, error) {
+	db, err := openStorageDB(appOpts, "archive", FlagArchiveBackend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive: %w", err)
+	}
+
+	return storage.NewDatabase(db), nil
+}
+
+func openStorageDB(appOpts types.AppOptions, name, backendFlag string) (dbm.DB, error) {
+	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
+
+	backend := GetAppDBBackend(appOpts)
+	if b := cast.ToString(appOpts.Get(backendFlag)); b != "" {
+		backend = dbm.BackendType(b)
+	}
+
+	return dbm.NewDB(name, backend, filepath.Join(homeDir, "data"))
 }
 
 func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/module/testutil"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
@@ -456,6 +457,47 @@ func TestEmptyMinGasPrices(t *testing.T) {
 	require.Errorf(t, err, sdkerrors.ErrAppConfig.Error())
 }
 
+func TestGetMempool(t *testing.T) {
+	v := viper.New()
+	mp, err := server.GetMempool(v, 10)
+	require.NoError(t, err)
+	require.IsType(t, &mempool.SenderNonceMempool{}, mp)
+
+	// the options of the priority-nonce mempool are rejected for the
+	// sender-nonce one
+	v.Set(server.FlagMempoolTxTTL, 5)
+	_, err = server.GetMempool(v, 10)
+	require.ErrorIs(t, err, sdkerrors.ErrAppConfig)
+
+	v.Set(server.FlagMempoolType, config.MempoolTypePriorityNonce)
+	v.Set(server.FlagMempoolEvictOnFull, true)
+	v.Set(server.FlagMempoolMaxTxsPerSender, 2)
+	v.Set(server.FlagMempoolMinBumpPercent, 10)
+	mp, err = server.GetMempool(v, 10)
+	require.NoError(t, err)
+	require.IsType(t, &mempool.PriorityNonceMempool[int64]{}, mp)
+
+	v.Set(server.FlagMempoolMaxTxsPerSender, -1)
+	_, err = server.GetMempool(v, 10)
+	require.ErrorIs(t, err, sdkerrors.ErrAppConfig)
+
+	v.Set(server.FlagMempoolType, "fifo")
+	_, err = server.GetMempool(v, 10)
+	require.ErrorIs(t, err, sdkerrors.ErrAppConfig)
+
+	v.Set(server.FlagMempoolType, config.MempoolTypeSenderNonce)
+	v.Set(server.FlagMempoolEvictOnFull, false)
+	v.Set(server.FlagMempoolMaxTxsPerSender, 0)
+	v.Set(server.FlagMempoolTxTTL, 0)
+	v.Set(server.FlagMempoolMinBumpPercent, 0)
+	v.Set(server.FlagMempoolJournal, true)
+	v.Set(flags.FlagHome, t.TempDir())
+	mp, err = server.GetMempool(v, 10)
+	require.NoError(t, err)
+	require.IsType(t, &mempool.JournaledMempool{}, mp)
+	require.NoError(t, mp.(*mempool.JournaledMempool).Close())
+}
+
 type mapGetter map[string]interface{}
 
 func (m mapGetter) Get(key string) interface{} {
@@ -7,12 +7,17 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante/unorderedtx"
+	feemarketante "github.com/cosmos/cosmos-sdk/x/feemarket/ante"
 )
 
 // HandlerOptions are the options required for constructing a default SDK AnteHandler.
 type HandlerOptions struct {
 	ante.HandlerOptions
 	CircuitKeeper circuitante.CircuitBreaker
+	// FeeMarketKeeper enables the x/feemarket base fee when set, replacing the
+	// x/auth fee deduction.
+	FeeMarketKeeper feemarketante.FeeMarketKeeper
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -31,19 +36,30 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errors.New("sign mode handler is required for ante builder")
 	}
 
+	var deductFeeDecorator sdk.AnteDecorator = ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker)
+	if options.FeeMarketKeeper != nil {
+		deductFeeDecorator = feemarketante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.FeeMarketKeeper, options.TxFeeChecker)
+	}
+
+	extensionOptionChecker := options.ExtensionOptionChecker
+	if options.UnorderedTxManager != nil {
+		extensionOptionChecker = ante.NewUnorderedTxExtensionOptionChecker(extensionOptionChecker)
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper),
-		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
+		ante.NewExtensionOptionsDecorator(extensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxTimeoutDuration, options.UnorderedTxManager),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		deductFeeDecorator,
 		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
-		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
-		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer).WithAccountAbstractionKeeper(options.AccountAbstractionKeeper),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler).WithAccountAbstractionKeeper(options.AccountAbstractionKeeper),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 	}
 
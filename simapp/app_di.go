@@ -3,9 +3,12 @@
 package simapp
 
 import (
+	"fmt"
 	"io"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cast"
 
 	clienthelpers "cosmossdk.io/client/v2/helpers"
 	"cosmossdk.io/depinject"
@@ -19,6 +22,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -27,22 +31,30 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	testdata_pulsar "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	accountskeeper "github.com/cosmos/cosmos-sdk/x/accounts/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante/unorderedtx"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	epochskeeper "github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	feemarketkeeper "github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	oraclekeeper "github.com/cosmos/cosmos-sdk/x/oracle/keeper"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	protocolpoolkeeper "github.com/cosmos/cosmos-sdk/x/protocolpool/keeper"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 )
@@ -83,6 +95,14 @@ type SimApp struct {
 	NFTKeeper             nftkeeper.Keeper
 	ConsensusParamsKeeper consensuskeeper.Keeper
 	CircuitKeeper         circuitkeeper.Keeper
+	EpochsKeeper          *epochskeeper.Keeper
+	ProtocolPoolKeeper    protocolpoolkeeper.Keeper
+	AccountsKeeper        accountskeeper.Keeper
+	FeeMarketKeeper       feemarketkeeper.Keeper
+	OracleKeeper          oraclekeeper.Keeper
+
+	// managers
+	UnorderedTxManager *unorderedtx.Manager
 
 	// simulation manager
 	sm *module.SimulationManager
@@ -184,6 +204,11 @@ func NewSimApp(
 		&app.NFTKeeper,
 		&app.ConsensusParamsKeeper,
 		&app.CircuitKeeper,
+		&app.EpochsKeeper,
+		&app.ProtocolPoolKeeper,
+		&app.AccountsKeeper,
+		&app.FeeMarketKeeper,
+		&app.OracleKeeper,
 	); err != nil {
 		panic(err)
 	}
@@ -214,12 +239,8 @@ func NewSimApp(
 	// }
 	// baseAppOptions = append(baseAppOptions, prepareOpt)
 
-	// create and set dummy vote extension handler
-	voteExtOp := func(bApp *baseapp.BaseApp) {
-		voteExtHandler := NewVoteExtensionHandler()
-		voteExtHandler.SetHandlers(bApp)
-	}
-	baseAppOptions = append(baseAppOptions, voteExtOp, baseapp.SetOptimisticExecution())
+	// the vote extension handlers of x/oracle are set once its keeper is created
+	baseAppOptions = append(baseAppOptions, baseapp.SetOptimisticExecution())
 
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
@@ -249,9 +270,22 @@ func NewSimApp(
 
 	app.sm.RegisterStoreDecoders()
 
+	// create the unordered tx manager, if unordered txs are enabled
+	app.setUnorderedTxManager(cast.ToString(appOpts.Get(flags.FlagHome)), appOpts)
+
 	// set custom ante handler
 	app.setAnteHandler(app.txConfig)
 
+	// set the state overrides of the simulations with trace, the balances being
+	// overridden by the implementation of the bank keeper, only known by its
+	// interface with dependency injection
+	app.SetStateOverrideHandler(authtx.NewStateOverrideHandler(app.AccountKeeper, app.BankKeeper.(bankkeeper.BaseKeeper)))
+
+	// set custom pre blocker and precommiter, tracking the block lifecycle in
+	// the unordered tx manager, the pre blocker being wrapped by x/oracle
+	app.setOracleHandlers()
+	app.SetPrecommiter(app.Precommiter)
+
 	// A custom InitChainer can be set if extra pre-init-genesis logic is required.
 	// By default, when using app wiring enabled module, this is not required.
 	// For instance, the upgrade module will set automatically the module version map in its init genesis thanks to app wiring.
@@ -267,6 +301,14 @@ func NewSimApp(
 		panic(err)
 	}
 
+	if loadLatest {
+		if app.UnorderedTxManager != nil {
+			if err := app.UnorderedTxManager.Start(app.LastBlockHeight()); err != nil {
+				panic(fmt.Errorf("failed to start unordered tx manager: %w", err))
+			}
+		}
+	}
+
 	return app
 }
 
@@ -276,13 +318,16 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 	anteHandler, err := NewAnteHandler(
 		HandlerOptions{
 			ante.HandlerOptions{
-				AccountKeeper:   app.AccountKeeper,
-				BankKeeper:      app.BankKeeper,
-				SignModeHandler: txConfig.SignModeHandler(),
-				FeegrantKeeper:  app.FeeGrantKeeper,
-				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+				AccountKeeper:            app.AccountKeeper,
+				BankKeeper:               app.BankKeeper,
+				SignModeHandler:          txConfig.SignModeHandler(),
+				FeegrantKeeper:           app.FeeGrantKeeper,
+				SigGasConsumer:           ante.DefaultSigVerificationGasConsumer,
+				UnorderedTxManager:       app.UnorderedTxManager,
+				AccountAbstractionKeeper: app.AccountsKeeper,
 			},
 			&app.CircuitKeeper,
+			app.FeeMarketKeeper,
 		},
 	)
 	if err != nil {
@@ -293,6 +338,40 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 	app.SetAnteHandler(anteHandler)
 }
 
+// PreBlocker application updates every pre block
+func (app *SimApp) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	// a replayed block must not discard the hashes of the block being executed
+	if app.UnorderedTxManager != nil && sdk.ReplayFromContext(ctx) == nil {
+		app.UnorderedTxManager.OnNewBlock(ctx.BlockHeight(), ctx.BlockTime())
+	}
+	return app.App.PreBlocker(ctx, req)
+}
+
+// Precommiter application updates every commit
+func (app *SimApp) Precommiter(ctx sdk.Context) {
+	app.App.Precommiter(ctx)
+
+	if app.UnorderedTxManager == nil {
+		return
+	}
+	if err := app.UnorderedTxManager.OnCommit(); err != nil {
+		panic(err)
+	}
+}
+
+// Close closes all necessary application resources.
+// It implements servertypes.Application.
+func (app *SimApp) Close() error {
+	if err := app.App.Close(); err != nil {
+		return err
+	}
+
+	if app.UnorderedTxManager == nil {
+		return nil
+	}
+	return app.UnorderedTxManager.Close()
+}
+
 // LegacyAmino returns SimApp's amino codec.
 //
 // NOTE: This is solely to be used for testing purposes as it may be desirable
@@ -1,12 +1,16 @@
 package simapp
 
 import (
+	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/golang/mock/gomock"
@@ -21,6 +25,11 @@ import (
 	"cosmossdk.io/x/upgrade"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
@@ -28,18 +37,28 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/accounts"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	group "github.com/cosmos/cosmos-sdk/x/group/module"
 	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/oracle"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
@@ -84,6 +103,19 @@ func TestSimAppExportAndBlockedAddrs(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
+func TestSimAppParallelExecution(t *testing.T) {
+	// the unordered tx manager does not support parallel execution
+	require.Panics(t, func() {
+		NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), baseapp.SetParallelExecution(2))
+	})
+
+	// which is supported once unordered txs are disabled
+	appOpts := simtestutil.AppOptionsMap{flags.FlagHome: t.TempDir(), FlagUnorderedTxs: false}
+	app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOpts, baseapp.SetParallelExecution(2))
+	require.Nil(t, app.UnorderedTxManager)
+	require.NoError(t, app.Close())
+}
+
 func TestRunMigrations(t *testing.T) {
 	db := dbm.NewMemDB()
 	logger := log.NewTestLogger(t)
@@ -211,6 +243,11 @@ func TestRunMigrations(t *testing.T) {
 					"evidence":     evidence.AppModule{}.ConsensusVersion(),
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
+					"epochs":       epochs.AppModule{}.ConsensusVersion(),
+					"protocolpool": protocolpool.AppModule{}.ConsensusVersion(),
+					"accounts":     accounts.AppModule{}.ConsensusVersion(),
+					"feemarket":    feemarket.AppModule{}.ConsensusVersion(),
+					"oracle":       oracle.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -358,3 +395,127 @@ func TestAddressCodecFactory(t *testing.T) {
 	_, ok = consAddressCodec.(customAddressCodec)
 	require.True(t, ok)
 }
+
+func TestTraceUnorderedTx(t *testing.T) {
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	acc := authtypes.NewBaseAccount(addr, priv.PubKey(), 0, 0)
+	balance := banktypes.Balance{Address: addr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000_000))}
+
+	app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()))
+	genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), app.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balance)
+	require.NoError(t, err)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	_, err = app.InitChain(&abci.RequestInitChain{
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	finalizeBlockAt := func(height int64, at time.Time, txs ...[]byte) (*abci.RequestFinalizeBlock, *abci.ResponseFinalizeBlock) {
+		req := &abci.RequestFinalizeBlock{
+			Height:             height,
+			Time:               at,
+			NextValidatorsHash: valSet.Hash(),
+			Txs:                txs,
+		}
+		res, err := app.FinalizeBlock(req)
+		require.NoError(t, err)
+		return req, res
+	}
+	finalizeBlock := func(height int64, txs ...[]byte) (*abci.RequestFinalizeBlock, *abci.ResponseFinalizeBlock) {
+		return finalizeBlockAt(height, blockTime.Add(time.Duration(height)*time.Second), txs...)
+	}
+
+	finalizeBlock(1)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	accNum := app.AccountKeeper.GetAccount(app.NewContext(true), addr).GetAccountNumber()
+	tx1 := newUnorderedSendTx(t, app, priv, accNum, blockTime.Add(time.Minute))
+	tx2 := newUnorderedSendTx(t, app, priv, accNum, blockTime.Add(2*time.Minute))
+
+	block2, res2 := finalizeBlock(2, tx1)
+	require.Equal(t, uint32(0), res2.TxResults[0].Code, res2.TxResults[0].Log)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	// the unordered tx is traced while the next block is executed, the replay
+	// neither reporting it as a duplicate of itself nor changing the tracked
+	// hashes
+	_, res := finalizeBlock(3, tx2)
+	require.Equal(t, uint32(0), res.TxResults[0].Code, res.TxResults[0].Log)
+
+	gasInfo, _, trace, err := app.TraceTx(block2, res2.TxResults, 0)
+	require.NoError(t, err)
+	require.NotNil(t, trace)
+	require.Equal(t, uint64(res2.TxResults[0].GasUsed), gasInfo.GasUsed)
+
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	require.True(t, app.UnorderedTxManager.Contains(sha256.Sum256(tx1)))
+	require.True(t, app.UnorderedTxManager.Contains(sha256.Sum256(tx2)))
+
+	// the duplicates of a previous block and of a tx of the same block fail
+	tx3 := newUnorderedSendTx(t, app, priv, accNum, blockTime.Add(3*time.Minute))
+	block4, res4 := finalizeBlock(4, tx1, tx3, tx3)
+	require.Contains(t, res4.TxResults[0].Log, "is duplicated")
+	require.Equal(t, uint32(0), res4.TxResults[1].Code, res4.TxResults[1].Log)
+	require.Contains(t, res4.TxResults[2].Log, "is duplicated")
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	// once the hash of the first tx expired, its duplicate succeeds on replay:
+	// it is discarded, as it failed when committed, and reported as failing if
+	// traced, while the duplicate of the same block is still rejected
+	finalizeBlockAt(5, blockTime.Add(2*time.Minute+30*time.Second))
+	_, err = app.Commit()
+	require.NoError(t, err)
+	require.False(t, app.UnorderedTxManager.Contains(sha256.Sum256(tx1)))
+
+	gasInfo, _, _, err = app.TraceTx(block4, res4.TxResults, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(res4.TxResults[1].GasUsed), gasInfo.GasUsed)
+
+	_, _, _, err = app.TraceTx(block4, res4.TxResults, 0)
+	require.ErrorContains(t, err, "the tx succeeds on replay but failed when committed")
+
+	_, _, _, err = app.TraceTx(block4, res4.TxResults, 2)
+	require.ErrorContains(t, err, "is duplicated")
+}
+
+func newUnorderedSendTx(t *testing.T, app *SimApp, priv cryptotypes.PrivKey, accNum uint64, timeout time.Time) []byte {
+	t.Helper()
+
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	txBuilder := app.TxConfig().NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))))
+	txBuilder.SetGasLimit(200_000)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)))
+
+	opt, err := codectypes.NewAnyWithValue(&txtypes.ExtensionOptionUnorderedTx{TimeoutTimestamp: timeout})
+	require.NoError(t, err)
+	txBuilder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(opt)
+
+	// the sequence of the signer is not checked
+	sig := signing.SignatureV2{PubKey: priv.PubKey(), Data: &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT}}
+	require.NoError(t, txBuilder.SetSignatures(sig))
+	signerData := authsigning.SignerData{Address: addr.String(), AccountNumber: accNum, PubKey: priv.PubKey()}
+	sig, err = clienttx.SignWithPrivKey(context.Background(), signing.SignMode_SIGN_MODE_DIRECT, signerData, txBuilder, priv, app.TxConfig(), 0)
+	require.NoError(t, err)
+	require.NoError(t, txBuilder.SetSignatures(sig))
+
+	txBytes, err := app.TxConfig().TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	return txBytes
+}
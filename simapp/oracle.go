@@ -0,0 +1,43 @@
+package simapp
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	oracleabci "github.com/cosmos/cosmos-sdk/x/oracle/abci"
+	oracleprovider "github.com/cosmos/cosmos-sdk/x/oracle/provider"
+	oracletypes "github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+// setOracleHandlers sets the ABCI handlers of x/oracle, and the pre blocker of
+// the app wrapped by the one of x/oracle. The validators report the prices of
+// a mock provider in their vote extensions, the proposals carry the extended
+// commit of the previous block, and the stake-weighted medians of the prices
+// are written before the pre blockers of the modules.
+//
+// NOTE: The mock provider is solely used for testing purposes, a production
+// application must fetch the prices from a real source.
+func (app *SimApp) setOracleHandlers() {
+	logger := app.Logger()
+
+	provider := oracleprovider.NewMockProvider(map[string]math.LegacyDec{
+		"ATOM/USD": math.LegacyNewDec(10),
+		"ETH/USD":  math.LegacyNewDec(3000),
+	})
+	voteExtHandler := oracleabci.NewVoteExtensionHandler(logger, app.OracleKeeper, provider, oracleabci.DefaultProviderTimeout)
+	app.SetExtendVoteHandler(voteExtHandler.ExtendVoteHandler())
+	app.SetVerifyVoteExtensionHandler(voteExtHandler.VerifyVoteExtensionHandler())
+
+	defaultProposalHandler := baseapp.NewDefaultProposalHandler(app.Mempool(), app)
+	proposalHandler := oracleabci.NewProposalHandler(
+		logger,
+		app.StakingKeeper,
+		defaultProposalHandler.PrepareProposalHandler(),
+		defaultProposalHandler.ProcessProposalHandler(),
+	)
+	app.SetPrepareProposal(proposalHandler.PrepareProposalHandler())
+	app.SetProcessProposal(proposalHandler.ProcessProposalHandler())
+
+	preBlockHandler := oracleabci.NewPreBlockHandler(app.OracleKeeper, oracletypes.StakeWeightedMedian())
+	app.SetPreBlocker(preBlockHandler.PreBlocker(app.PreBlocker))
+}
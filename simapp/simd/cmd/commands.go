@@ -55,10 +55,17 @@ func initAppConfig() (string, interface{}) {
 		CustomField string `mapstructure:"custom-field"`
 	}
 
+	// UnorderedTxsConfig enables unordered transactions, which must be set
+	// alike on every node of the network.
+	type UnorderedTxsConfig struct {
+		Enabled bool `mapstructure:"enabled"`
+	}
+
 	type CustomAppConfig struct {
 		serverconfig.Config `mapstructure:",squash"`
 
-		Custom CustomConfig `mapstructure:"custom"`
+		Custom       CustomConfig       `mapstructure:"custom"`
+		UnorderedTxs UnorderedTxsConfig `mapstructure:"unordered-txs"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -85,6 +92,9 @@ func initAppConfig() (string, interface{}) {
 		Custom: CustomConfig{
 			CustomField: "anything",
 		},
+		UnorderedTxs: UnorderedTxsConfig{
+			Enabled: true,
+		},
 	}
 
 	// The default SDK app template is defined in serverconfig.DefaultConfigTemplate.
@@ -94,7 +104,14 @@ func initAppConfig() (string, interface{}) {
 [custom]
 # That field will be parsed by server.InterceptConfigsPreRunHandler and held by viper.
 # Do not forget to add quotes around the value if it is a string.
-custom-field = "{{ .Custom.CustomField }}"`
+custom-field = "{{ .Custom.CustomField }}"
+
+[unordered-txs]
+# Whether unordered transactions, which are not bound to the sequence of their
+# signers, are accepted. It must be set alike on every node of the network. The
+# hashes of the unordered transactions being tracked in memory, parallel
+# execution is only supported if they are disabled.
+enabled = {{ .UnorderedTxs.Enabled }}`
 
 	return customAppTemplate, customAppConfig
 }
@@ -107,12 +124,16 @@ func initRootCmd(
 	cfg := sdk.GetConfig()
 	cfg.Seal()
 
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(debug.StateCmd(newApp, simapp.DefaultNodeHome))
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(basicManager, simapp.DefaultNodeHome),
 		NewTestnetCmd(basicManager, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		debugCmd,
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp, simapp.DefaultNodeHome),
+		pruning.StoreCmd(simapp.DefaultNodeHome),
 		snapshot.Cmd(newApp),
 	)
 
@@ -159,6 +180,8 @@ func queryCommand() *cobra.Command {
 		server.QueryBlocksCmd(),
 		authcmd.QueryTxCmd(),
 		server.QueryBlockResultsCmd(),
+		rpc.QueryStoreCmd(),
+		rpc.QueryStoreHashesCmd(),
 	)
 
 	return cmd
@@ -183,6 +206,7 @@ func txCommand() *cobra.Command {
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
 		authcmd.GetSimulateCmd(),
+		authcmd.GetSimulateTraceCmd(),
 	)
 
 	return cmd
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"os"
 
+	// the SQLite driver of the built-in sqlite streaming listener
+	_ "modernc.org/sqlite"
+
 	clientv2helpers "cosmossdk.io/client/v2/helpers"
 	"cosmossdk.io/simapp"
 	"cosmossdk.io/simapp/simd/cmd"
@@ -0,0 +1,43 @@
+package simapp
+
+import (
+	"cosmossdk.io/collections"
+	circuittypes "cosmossdk.io/x/circuit/types"
+	evidencetypes "cosmossdk.io/x/evidence/types"
+
+	accountstypes "github.com/cosmos/cosmos-sdk/x/accounts/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+)
+
+// CollectionsSchemas returns the collections schemas of the modules storing
+// their state with collections, by store name. It is used by the debug state
+// commands to decode the raw state.
+func (app *SimApp) CollectionsSchemas() map[string]collections.Schema {
+	schemas := map[string]collections.Schema{
+		authtypes.StoreKey:         app.AccountKeeper.Schema,
+		crisistypes.StoreKey:       app.CrisisKeeper.Schema,
+		minttypes.StoreKey:         app.MintKeeper.Schema,
+		distrtypes.StoreKey:        app.DistrKeeper.Schema,
+		govtypes.StoreKey:          app.GovKeeper.Schema,
+		evidencetypes.StoreKey:     app.EvidenceKeeper.Schema,
+		circuittypes.StoreKey:      app.CircuitKeeper.Schema,
+		epochstypes.StoreKey:       app.EpochsKeeper.Schema,
+		protocolpooltypes.StoreKey: app.ProtocolPoolKeeper.Schema,
+		accountstypes.StoreKey:     app.AccountsKeeper.Schema,
+		feemarkettypes.StoreKey:    app.FeeMarketKeeper.Schema,
+	}
+	// the bank keeper is only known by its interface with dependency injection
+	if bk, ok := any(app.BankKeeper).(bankkeeper.BaseKeeper); ok {
+		schemas[banktypes.StoreKey] = bk.Schema
+	}
+	return schemas
+}
@@ -0,0 +1,35 @@
+package simapp
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante/unorderedtx"
+)
+
+// FlagUnorderedTxs is the app option enabling unordered transactions, enabled
+// by default. It decides whether unordered transactions are valid, so every
+// node of the network must set it alike. As the unordered tx manager tracks
+// the hashes of the transactions in memory, parallel execution can only be
+// enabled if unordered transactions are disabled.
+const FlagUnorderedTxs = "unordered-txs.enabled"
+
+// setUnorderedTxManager creates the unordered tx manager if unordered txs are
+// enabled, disallowing parallel execution, and registers its snapshot
+// extension.
+func (app *SimApp) setUnorderedTxManager(homePath string, appOpts servertypes.AppOptions) {
+	if enabled := appOpts.Get(FlagUnorderedTxs); enabled != nil && !cast.ToBool(enabled) {
+		return
+	}
+
+	app.UnorderedTxManager = unorderedtx.NewManager(filepath.Join(homePath, "data"))
+	app.DisallowParallelExecution("the unordered tx manager tracks the tx hashes in memory")
+	if manager := app.SnapshotManager(); manager != nil {
+		if err := manager.RegisterExtensions(unorderedtx.NewSnapshotter(app.UnorderedTxManager, manager.GetInterval())); err != nil {
+			panic(fmt.Errorf("failed to register snapshot extension: %w", err))
+		}
+	}
+}
@@ -8,6 +8,11 @@ import (
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	"github.com/cosmos/cosmos-sdk/types/module"
+	accountstypes "github.com/cosmos/cosmos-sdk/x/accounts/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	oracletypes "github.com/cosmos/cosmos-sdk/x/oracle/types"
+	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
 )
 
 // UpgradeName defines the on-chain upgrade name for the sample SimApp upgrade
@@ -35,6 +40,11 @@ func (app SimApp) RegisterUpgradeHandlers() {
 		storeUpgrades := storetypes.StoreUpgrades{
 			Added: []string{
 				circuittypes.ModuleName,
+				epochstypes.ModuleName,
+				protocolpooltypes.ModuleName,
+				accountstypes.ModuleName,
+				feemarkettypes.ModuleName,
+				oracletypes.ModuleName,
 			},
 		}
 
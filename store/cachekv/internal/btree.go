@@ -52,6 +52,19 @@ func (bt BTree) Delete(key []byte) {
 	bt.tree.Delete(newItem(key, nil))
 }
 
+// Len returns the number of items in the tree.
+func (bt BTree) Len() int {
+	return bt.tree.Len()
+}
+
+// Scan calls fn for each item of the tree in ascending key order, until fn
+// returns false.
+func (bt BTree) Scan(fn func(key, value []byte) bool) {
+	bt.tree.Scan(func(i item) bool {
+		return fn(i.key, i.value)
+	})
+}
+
 func (bt BTree) Iterator(start, end []byte) (types.Iterator, error) {
 	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
 		return nil, errKeyEmpty
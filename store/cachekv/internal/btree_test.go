@@ -202,3 +202,22 @@ func int642Bytes(i int64) []byte {
 func bytes2Int64(buf []byte) int64 {
 	return int64(types.BigEndianToUint64(buf))
 }
+
+func TestLenScan(t *testing.T) {
+	db := NewBTree()
+	require.Equal(t, 0, db.Len())
+
+	for _, key := range []string{"c", "a", "b", "d"} {
+		db.Set([]byte(key), []byte(key))
+	}
+	db.Set([]byte("e"), nil)
+	require.Equal(t, 5, db.Len())
+
+	// the items are scanned in ascending order until fn returns false
+	var keys []string
+	db.Scan(func(key, value []byte) bool {
+		keys = append(keys, string(key))
+		return string(key) != "d"
+	})
+	require.Equal(t, []string{"a", "b", "c", "d"}, keys)
+}
@@ -3,15 +3,10 @@ package cachekv
 import (
 	"bytes"
 	"io"
-	"sort"
 	"sync"
 
-	dbm "github.com/cosmos/cosmos-db"
-
-	"cosmossdk.io/math"
 	"cosmossdk.io/store/cachekv/internal"
 	"cosmossdk.io/store/internal/conv"
-	"cosmossdk.io/store/internal/kv"
 	"cosmossdk.io/store/tracekv"
 	"cosmossdk.io/store/types"
 )
@@ -25,11 +20,10 @@ type cValue struct {
 
 // Store wraps an in-memory cache around an underlying types.KVStore.
 type Store struct {
-	mtx           sync.Mutex
-	cache         map[string]*cValue
-	unsortedCache map[string]struct{}
-	sortedCache   internal.BTree // always ascending sorted
-	parent        types.KVStore
+	mtx      sync.Mutex
+	cache    map[string]*cValue
+	writeSet internal.BTree // dirty entries, always ascending sorted
+	parent   types.KVStore
 }
 
 var _ types.CacheKVStore = (*Store)(nil)
@@ -37,10 +31,9 @@ var _ types.CacheKVStore = (*Store)(nil)
 // NewStore creates a new Store object
 func NewStore(parent types.KVStore) *Store {
 	return &Store{
-		cache:         make(map[string]*cValue),
-		unsortedCache: make(map[string]struct{}),
-		sortedCache:   internal.NewBTree(),
-		parent:        parent,
+		cache:    make(map[string]*cValue),
+		writeSet: internal.NewBTree(),
+		parent:   parent,
 	}
 }
 
@@ -96,11 +89,9 @@ func (store *Store) Delete(key []byte) {
 func (store *Store) resetCaches() {
 	if len(store.cache) > 100_000 {
 		// Cache is too large. We likely did something linear time
-		// (e.g. Epoch block, Genesis block, etc). Free the old caches from memory, and let them get re-allocated.
-		// TODO: In a future CacheKV redesign, such linear workloads should get into a different cache instantiation.
+		// (e.g. Epoch block, Genesis block, etc). Free the old cache from memory, and let it get re-allocated.
 		// 100_000 is arbitrarily chosen as it solved Osmosis' InitGenesis RAM problem.
 		store.cache = make(map[string]*cValue)
-		store.unsortedCache = make(map[string]struct{})
 	} else {
 		// Clear the cache using the map clearing idiom
 		// and not allocating fresh objects.
@@ -108,11 +99,8 @@ func (store *Store) resetCaches() {
 		for key := range store.cache {
 			delete(store.cache, key)
 		}
-		for key := range store.unsortedCache {
-			delete(store.unsortedCache, key)
-		}
 	}
-	store.sortedCache = internal.NewBTree()
+	store.writeSet = internal.NewBTree()
 }
 
 // Implements Cachetypes.KVStore.
@@ -120,45 +108,30 @@ func (store *Store) Write() {
 	store.mtx.Lock()
 	defer store.mtx.Unlock()
 
-	if len(store.cache) == 0 && len(store.unsortedCache) == 0 {
-		store.sortedCache = internal.NewBTree()
+	if store.writeSet.Len() == 0 {
+		store.resetCaches()
 		return
 	}
 
-	type cEntry struct {
-		key string
-		val *cValue
-	}
-
-	// We need a copy of all of the keys.
-	// Not the best. To reduce RAM pressure, we copy the values as well
-	// and clear out the old caches right after the copy.
-	sortedCache := make([]cEntry, 0, len(store.cache))
-
-	for key, dbValue := range store.cache {
-		if dbValue.dirty {
-			sortedCache = append(sortedCache, cEntry{key, dbValue})
-		}
-	}
+	// The write set is already sorted, writing it costs O(writes) whatever the
+	// number of cached reads is. To reduce RAM pressure, the caches are cleared
+	// right away and only the detached write set is kept until written.
+	writeSet := store.writeSet
 	store.resetCaches()
-	sort.Slice(sortedCache, func(i, j int) bool {
-		return sortedCache[i].key < sortedCache[j].key
-	})
 
 	// TODO: Consider allowing usage of Batch, which would allow the write to
 	// at least happen atomically.
-	for _, obj := range sortedCache {
-		// We use []byte(key) instead of conv.UnsafeStrToBytes because we cannot
-		// be sure if the underlying store might do a save with the byteslice or
-		// not. Once we get confirmation that .Delete is guaranteed not to
-		// save the byteslice, then we can assume only a read-only copy is sufficient.
-		if obj.val.value != nil {
+	writeSet.Scan(func(key, value []byte) bool {
+		// The keys were copied by setCacheValue and are never mutated, the
+		// parent may keep a reference to them.
+		if value != nil {
 			// It already exists in the parent, hence update it.
-			store.parent.Set([]byte(obj.key), obj.val.value)
+			store.parent.Set(key, value)
 		} else {
-			store.parent.Delete([]byte(obj.key))
+			store.parent.Delete(key)
 		}
-	}
+		return true
+	})
 }
 
 // CacheWrap implements CacheWrapper.
@@ -188,8 +161,9 @@ func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 	store.mtx.Lock()
 	defer store.mtx.Unlock()
 
-	store.dirtyItems(start, end)
-	isoSortedCache := store.sortedCache.Copy()
+	// The write set is kept sorted by every write, iterating over it only
+	// takes a copy-on-write snapshot isolating the iterator from later writes.
+	isoWriteSet := store.writeSet.Copy()
 
 	var (
 		err           error
@@ -198,10 +172,10 @@ func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 
 	if ascending {
 		parent = store.parent.Iterator(start, end)
-		cache, err = isoSortedCache.Iterator(start, end)
+		cache, err = isoWriteSet.Iterator(start, end)
 	} else {
 		parent = store.parent.ReverseIterator(start, end)
-		cache, err = isoSortedCache.ReverseIterator(start, end)
+		cache, err = isoWriteSet.ReverseIterator(start, end)
 	}
 	if err != nil {
 		panic(err)
@@ -210,199 +184,24 @@ func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 	return internal.NewCacheMergeIterator(parent, cache, ascending)
 }
 
-func findStartIndex(strL []string, startQ string) int {
-	// Modified binary search to find the very first element in >=startQ.
-	if len(strL) == 0 {
-		return -1
-	}
-
-	var left, right, mid int
-	right = len(strL) - 1
-	for left <= right {
-		mid = (left + right) >> 1
-		midStr := strL[mid]
-		if midStr == startQ {
-			// Handle condition where there might be multiple values equal to startQ.
-			// We are looking for the very first value < midStL, that i+1 will be the first
-			// element >= midStr.
-			for i := mid - 1; i >= 0; i-- {
-				if strL[i] != midStr {
-					return i + 1
-				}
-			}
-			return 0
-		}
-		if midStr < startQ {
-			left = mid + 1
-		} else { // midStrL > startQ
-			right = mid - 1
-		}
-	}
-	if left >= 0 && left < len(strL) && strL[left] >= startQ {
-		return left
-	}
-	return -1
-}
-
-func findEndIndex(strL []string, endQ string) int {
-	if len(strL) == 0 {
-		return -1
-	}
-
-	// Modified binary search to find the very first element <endQ.
-	var left, right, mid int
-	right = len(strL) - 1
-	for left <= right {
-		mid = (left + right) >> 1
-		midStr := strL[mid]
-		if midStr == endQ {
-			// Handle condition where there might be multiple values equal to startQ.
-			// We are looking for the very first value < midStL, that i+1 will be the first
-			// element >= midStr.
-			for i := mid - 1; i >= 0; i-- {
-				if strL[i] < midStr {
-					return i + 1
-				}
-			}
-			return 0
-		}
-		if midStr < endQ {
-			left = mid + 1
-		} else { // midStrL > startQ
-			right = mid - 1
-		}
-	}
-
-	// Binary search failed, now let's find a value less than endQ.
-	for i := right; i >= 0; i-- {
-		if strL[i] < endQ {
-			return i
-		}
-	}
-
-	return -1
-}
-
-type sortState int
-
-const (
-	stateUnsorted sortState = iota
-	stateAlreadySorted
-)
-
-const minSortSize = 1024
-
-// Constructs a slice of dirty items, to use w/ memIterator.
-func (store *Store) dirtyItems(start, end []byte) {
-	startStr, endStr := conv.UnsafeBytesToStr(start), conv.UnsafeBytesToStr(end)
-	if end != nil && startStr > endStr {
-		// Nothing to do here.
-		return
-	}
-
-	n := len(store.unsortedCache)
-	unsorted := make([]*kv.Pair, 0)
-	// If the unsortedCache is too big, its costs too much to determine
-	// whats in the subset we are concerned about.
-	// If you are interleaving iterator calls with writes, this can easily become an
-	// O(N^2) overhead.
-	// Even without that, too many range checks eventually becomes more expensive
-	// than just not having the cache.
-	if n < minSortSize {
-		for key := range store.unsortedCache {
-			// dbm.IsKeyInDomain is nil safe and returns true iff key is greater than start
-			if dbm.IsKeyInDomain(conv.UnsafeStrToBytes(key), start, end) {
-				cacheValue := store.cache[key]
-				unsorted = append(unsorted, &kv.Pair{Key: []byte(key), Value: cacheValue.value})
-			}
-		}
-		store.clearUnsortedCacheSubset(unsorted, stateUnsorted)
-		return
-	}
-
-	// Otherwise it is large so perform a modified binary search to find
-	// the target ranges for the keys that we should be looking for.
-	strL := make([]string, 0, n)
-	for key := range store.unsortedCache {
-		strL = append(strL, key)
-	}
-	sort.Strings(strL)
-
-	// Now find the values within the domain
-	//  [start, end)
-	startIndex := findStartIndex(strL, startStr)
-	if startIndex < 0 {
-		startIndex = 0
-	}
-
-	var endIndex int
-	if end == nil {
-		endIndex = len(strL) - 1
-	} else {
-		endIndex = findEndIndex(strL, endStr)
-	}
-	if endIndex < 0 {
-		endIndex = len(strL) - 1
-	}
-
-	// Since we spent cycles to sort the values, we should process and remove a reasonable amount
-	// ensure start to end is at least minSortSize in size
-	// if below minSortSize, expand it to cover additional values
-	// this amortizes the cost of processing elements across multiple calls
-	if endIndex-startIndex < minSortSize {
-		endIndex = math.Min(startIndex+minSortSize, len(strL)-1)
-		if endIndex-startIndex < minSortSize {
-			startIndex = math.Max(endIndex-minSortSize, 0)
-		}
-	}
-
-	kvL := make([]*kv.Pair, 0, 1+endIndex-startIndex)
-	for i := startIndex; i <= endIndex; i++ {
-		key := strL[i]
-		cacheValue := store.cache[key]
-		kvL = append(kvL, &kv.Pair{Key: []byte(key), Value: cacheValue.value})
-	}
-
-	// kvL was already sorted so pass it in as is.
-	store.clearUnsortedCacheSubset(kvL, stateAlreadySorted)
-}
-
-func (store *Store) clearUnsortedCacheSubset(unsorted []*kv.Pair, sortState sortState) {
-	n := len(store.unsortedCache)
-	if len(unsorted) == n { // This pattern allows the Go compiler to emit the map clearing idiom for the entire map.
-		for key := range store.unsortedCache {
-			delete(store.unsortedCache, key)
-		}
-	} else { // Otherwise, normally delete the unsorted keys from the map.
-		for _, kv := range unsorted {
-			delete(store.unsortedCache, conv.UnsafeBytesToStr(kv.Key))
-		}
-	}
-
-	if sortState == stateUnsorted {
-		sort.Slice(unsorted, func(i, j int) bool {
-			return bytes.Compare(unsorted[i].Key, unsorted[j].Key) < 0
-		})
-	}
-
-	for _, item := range unsorted {
-		// sortedCache is able to store `nil` value to represent deleted items.
-		store.sortedCache.Set(item.Key, item.Value)
-	}
-}
-
 //----------------------------------------
 // etc
 
 // Only entrypoint to mutate store.cache.
 // A `nil` value means a deletion.
 func (store *Store) setCacheValue(key, value []byte, dirty bool) {
+	if dirty {
+		// The dirty keys end up in the parent, which may keep a reference to
+		// them: copy them so that the caller can reuse its byte slice.
+		key = bytes.Clone(key)
+	}
 	keyStr := conv.UnsafeBytesToStr(key)
 	store.cache[keyStr] = &cValue{
 		value: value,
 		dirty: dirty,
 	}
 	if dirty {
-		store.unsortedCache[keyStr] = struct{}{}
+		// writeSet is able to store `nil` value to represent deleted items.
+		store.writeSet.Set(key, value)
 	}
 }
@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/store/cachekv"
 	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/types"
 )
 
 var sink interface{}
@@ -151,3 +152,148 @@ func BenchmarkSetKeySize32(b *testing.B) {
 func BenchmarkIteratorOnParentWith1MDeletes(b *testing.B) {
 	benchmarkIteratorOnParentWithManyDeletes(b, 1_000_000)
 }
+
+// Benchmark setting numKeys random keys and then iterating over 100 small
+// ranges, as done when a tx writes many entries and then walks over prefixes.
+func benchmarkSetThenIterate(b *testing.B, numKeys int) {
+	b.Helper()
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	value := randSlice(32)
+	keys := generateRandomKeys(32, numKeys)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		kvstore := cachekv.NewStore(mem)
+		for _, k := range keys {
+			kvstore.Set(k, value)
+		}
+		for _, start := range keys[:100] {
+			iter := kvstore.Iterator(start, nil)
+			for j := 0; j < 10 && iter.Valid(); j++ {
+				sink = iter.Key()
+				iter.Next()
+			}
+			iter.Close()
+		}
+	}
+}
+
+// Benchmark setting numKeys random keys, each one followed by an iteration
+// starting at it.
+func benchmarkInterleavedSetIterate(b *testing.B, numKeys int) {
+	b.Helper()
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	value := randSlice(32)
+	keys := generateRandomKeys(32, numKeys)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		kvstore := cachekv.NewStore(mem)
+		for _, k := range keys {
+			kvstore.Set(k, value)
+			iter := kvstore.Iterator(k, nil)
+			sink = iter.Key()
+			iter.Close()
+		}
+	}
+}
+
+// Benchmark writing numWrites dirty entries to a parent cache store, out of
+// a cache also holding ten times as many clean reads.
+func benchmarkWrite(b *testing.B, numWrites int) {
+	b.Helper()
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	value := randSlice(32)
+	reads := generateRandomKeys(32, numWrites*10)
+	for _, k := range reads {
+		mem.Set(k, value)
+	}
+	writes := generateRandomKeys(32, numWrites)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		kvstore := cachekv.NewStore(cachekv.NewStore(mem))
+		for _, k := range reads {
+			sink = kvstore.Get(k)
+		}
+		for _, k := range writes {
+			kvstore.Set(k, value)
+		}
+		b.StartTimer()
+
+		kvstore.Write()
+	}
+}
+
+// Benchmark branching depth nested stores, each one writing a key and then
+// being written back to its parent, as nested CacheContext calls do.
+func benchmarkNestedBranches(b *testing.B, depth int) {
+	b.Helper()
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	value := randSlice(32)
+	keys := generateRandomKeys(32, depth)
+	root := cachekv.NewStore(mem)
+	for _, k := range generateRandomKeys(32, 1000) {
+		root.Set(k, value)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		stores := make([]*cachekv.Store, depth)
+		var parent types.KVStore = root
+		for j := range stores {
+			stores[j] = cachekv.NewStore(parent)
+			stores[j].Set(keys[j], value)
+			sink = stores[j].Get(keys[0])
+			parent = stores[j]
+		}
+		for j := depth - 1; j >= 0; j-- {
+			stores[j].Write()
+		}
+	}
+}
+
+func BenchmarkSetThenIterate1K(b *testing.B) {
+	benchmarkSetThenIterate(b, 1_000)
+}
+
+func BenchmarkSetThenIterate10K(b *testing.B) {
+	benchmarkSetThenIterate(b, 10_000)
+}
+
+func BenchmarkSetThenIterate100K(b *testing.B) {
+	benchmarkSetThenIterate(b, 100_000)
+}
+
+func BenchmarkInterleavedSetIterate1K(b *testing.B) {
+	benchmarkInterleavedSetIterate(b, 1_000)
+}
+
+func BenchmarkInterleavedSetIterate10K(b *testing.B) {
+	benchmarkInterleavedSetIterate(b, 10_000)
+}
+
+func BenchmarkWrite1K(b *testing.B) {
+	benchmarkWrite(b, 1_000)
+}
+
+func BenchmarkWrite10K(b *testing.B) {
+	benchmarkWrite(b, 10_000)
+}
+
+func BenchmarkNestedBranches10(b *testing.B) {
+	benchmarkNestedBranches(b, 10)
+}
+
+func BenchmarkNestedBranches50(b *testing.B) {
+	benchmarkNestedBranches(b, 50)
+}
@@ -101,6 +101,34 @@ func TestCacheKVStoreNested(t *testing.T) {
 	require.Equal(t, valFmt(3), mem.Get(keyFmt(1)))
 }
 
+func TestCacheKVStoreReusedKeys(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	st := cachekv.NewStore(mem)
+
+	// the caller may reuse its key slice once the key is set
+	key := keyFmt(2)
+	st.Set(key, valFmt(2))
+	copy(key, keyFmt(1))
+	st.Set(key, valFmt(1))
+	copy(key, keyFmt(3))
+	st.Delete(key)
+
+	itr := st.Iterator(nil, nil)
+	for i := 1; i <= 2; i++ {
+		require.True(t, itr.Valid())
+		require.Equal(t, keyFmt(i), itr.Key())
+		require.Equal(t, valFmt(i), itr.Value())
+		itr.Next()
+	}
+	require.False(t, itr.Valid())
+	require.NoError(t, itr.Close())
+
+	st.Write()
+	require.Equal(t, valFmt(1), mem.Get(keyFmt(1)))
+	require.Equal(t, valFmt(2), mem.Get(keyFmt(2)))
+	require.Nil(t, mem.Get(keyFmt(3)))
+}
+
 func TestCacheKVIteratorBounds(t *testing.T) {
 	st := newCacheKVStore()
 
@@ -3,6 +3,7 @@ package cachemulti
 import (
 	"fmt"
 	"io"
+	"sync"
 
 	dbm "github.com/cosmos/cosmos-db"
 
@@ -23,10 +24,15 @@ const storeNameCtxKey = "store_name"
 // Implements MultiStore.
 // NOTE: a Store (and MultiStores in general) should never expose the
 // keys for the substores.
+//
+// The stores are branched lazily, on their first access, so that branching a
+// multi-store only costs the stores actually used by the branch.
 type Store struct {
-	db     types.CacheKVStore
-	stores map[types.StoreKey]types.CacheWrap
-	keys   map[string]types.StoreKey
+	db      types.CacheKVStore
+	parents parentStores
+	stores  map[types.StoreKey]types.CacheWrap
+	mtx     *sync.Mutex // guards stores
+	keys    map[string]types.StoreKey
 
 	traceWriter  io.Writer
 	traceContext types.TraceContext
@@ -34,51 +40,116 @@ type Store struct {
 
 var _ types.CacheMultiStore = Store{}
 
+// parentStores provides the stores branched by a Store.
+type parentStores interface {
+	// kvStore returns the store with the given key, or nil if the key is not
+	// registered.
+	kvStore(key types.StoreKey) types.KVStore
+}
+
+// storeMap provides the stores of a mapping of store keys to CacheWrapper
+// objects.
+type storeMap map[types.StoreKey]types.CacheWrapper
+
+func (m storeMap) kvStore(key types.StoreKey) types.KVStore {
+	store, ok := m[key]
+	if !ok {
+		return nil
+	}
+	return store.(types.KVStore)
+}
+
+// tracedStores traces the operations on the stores it provides.
+type tracedStores struct {
+	parents      parentStores
+	traceWriter  io.Writer
+	traceContext types.TraceContext
+}
+
+func (t tracedStores) kvStore(key types.StoreKey) types.KVStore {
+	store := t.parents.kvStore(key)
+	if store == nil {
+		return nil
+	}
+
+	tctx := t.traceContext.Clone().Merge(types.TraceContext{
+		storeNameCtxKey: key.Name(),
+	})
+	return tracekv.NewStore(store, t.traceWriter, tctx)
+}
+
 // NewFromKVStore creates a new Store object from a mapping of store keys to
 // CacheWrapper objects and a KVStore as the database. Each CacheWrapper store
-// is a branched store.
+// is branched on its first access.
 func NewFromKVStore(
 	store types.KVStore, stores map[types.StoreKey]types.CacheWrapper,
 	keys map[string]types.StoreKey, traceWriter io.Writer, traceContext types.TraceContext,
+) Store {
+	return newStore(store, storeMap(stores), keys, traceWriter, traceContext)
+}
+
+// NewStore creates a new Store object from a mapping of store keys to
+// CacheWrapper objects. Each CacheWrapper store is branched on its first access.
+func NewStore(
+	db dbm.DB, stores map[types.StoreKey]types.CacheWrapper, keys map[string]types.StoreKey,
+	traceWriter io.Writer, traceContext types.TraceContext,
+) Store {
+	return NewFromKVStore(dbadapter.Store{DB: db}, stores, keys, traceWriter, traceContext)
+}
+
+func newStore(
+	store types.KVStore, parents parentStores,
+	keys map[string]types.StoreKey, traceWriter io.Writer, traceContext types.TraceContext,
 ) Store {
 	cms := Store{
 		db:           cachekv.NewStore(store),
-		stores:       make(map[types.StoreKey]types.CacheWrap, len(stores)),
+		parents:      parents,
+		stores:       make(map[types.StoreKey]types.CacheWrap),
+		mtx:          &sync.Mutex{},
 		keys:         keys,
 		traceWriter:  traceWriter,
 		traceContext: traceContext,
 	}
 
-	for key, store := range stores {
-		if cms.TracingEnabled() {
-			tctx := cms.traceContext.Clone().Merge(types.TraceContext{
-				storeNameCtxKey: key.Name(),
-			})
-
-			store = tracekv.NewStore(store.(types.KVStore), cms.traceWriter, tctx)
+	// the branches are traced as set up when the multi-store is created, as
+	// if they were all branched right away
+	if cms.TracingEnabled() {
+		cms.parents = tracedStores{
+			parents:      parents,
+			traceWriter:  traceWriter,
+			traceContext: traceContext.Clone(),
 		}
-		cms.stores[key] = cachekv.NewStore(store.(types.KVStore))
 	}
 
 	return cms
 }
 
-// NewStore creates a new Store object from a mapping of store keys to
-// CacheWrapper objects. Each CacheWrapper store is a branched store.
-func NewStore(
-	db dbm.DB, stores map[types.StoreKey]types.CacheWrapper, keys map[string]types.StoreKey,
-	traceWriter io.Writer, traceContext types.TraceContext,
-) Store {
-	return NewFromKVStore(dbadapter.Store{DB: db}, stores, keys, traceWriter, traceContext)
+func newCacheMultiStoreFromCMS(cms Store) Store {
+	return newStore(cms.db, cms, nil, cms.traceWriter, cms.traceContext)
 }
 
-func newCacheMultiStoreFromCMS(cms Store) Store {
-	stores := make(map[types.StoreKey]types.CacheWrapper)
-	for k, v := range cms.stores {
-		stores[k] = v
+// kvStore returns the branch of the store with the given key, creating it on
+// its first access, or nil if the key is not registered.
+func (cms Store) kvStore(key types.StoreKey) types.KVStore {
+	if key == nil {
+		return nil
+	}
+
+	cms.mtx.Lock()
+	defer cms.mtx.Unlock()
+
+	if store, ok := cms.stores[key]; ok {
+		return store.(types.KVStore)
 	}
 
-	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext)
+	parent := cms.parents.kvStore(key)
+	if parent == nil {
+		return nil
+	}
+
+	store := cachekv.NewStore(parent)
+	cms.stores[key] = store
+	return store
 }
 
 // SetTracer sets the tracer for the MultiStore that the underlying
@@ -119,8 +190,11 @@ func (cms Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
 }
 
-// Write calls Write on each underlying store.
+// Write calls Write on each underlying store which has been branched.
 func (cms Store) Write() {
+	cms.mtx.Lock()
+	defer cms.mtx.Unlock()
+
 	cms.db.Write()
 	for _, store := range cms.stores {
 		store.Write()
@@ -153,18 +227,14 @@ func (cms Store) CacheMultiStoreWithVersion(_ int64) (types.CacheMultiStore, err
 
 // GetStore returns an underlying Store by key.
 func (cms Store) GetStore(key types.StoreKey) types.Store {
-	s := cms.stores[key]
-	if key == nil || s == nil {
-		panic(fmt.Sprintf("kv store with key %v has not been registered in stores", key))
-	}
-	return s.(types.Store)
+	return cms.GetKVStore(key)
 }
 
 // GetKVStore returns an underlying KVStore by key.
 func (cms Store) GetKVStore(key types.StoreKey) types.KVStore {
-	store := cms.stores[key]
-	if key == nil || store == nil {
+	store := cms.kvStore(key)
+	if store == nil {
 		panic(fmt.Sprintf("kv store with key %v has not been registered in stores", key))
 	}
-	return store.(types.KVStore)
+	return store
 }
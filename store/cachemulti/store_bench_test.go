@@ -0,0 +1,50 @@
+package cachemulti
+
+import (
+	"fmt"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/types"
+)
+
+// Benchmark branching a multi-store of numStores stores depth times, each
+// branch writing to a single store and then being written back, as nested
+// CacheContext calls do.
+func benchmarkNestedBranches(b *testing.B, numStores, depth int) {
+	b.Helper()
+	stores := make(map[types.StoreKey]types.CacheWrapper, numStores)
+	var key types.StoreKey
+	for i := 0; i < numStores; i++ {
+		key = types.NewKVStoreKey(fmt.Sprintf("store%d", i))
+		stores[key] = dbadapter.Store{DB: dbm.NewMemDB()}
+	}
+	cms := NewStore(dbm.NewMemDB(), stores, nil, nil, nil)
+	value := []byte("value")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		branches := make([]types.CacheMultiStore, depth)
+		var parent types.MultiStore = cms
+		for j := range branches {
+			branches[j] = parent.CacheMultiStore()
+			branches[j].GetKVStore(key).Set([]byte{byte(j)}, value)
+			parent = branches[j]
+		}
+		for j := depth - 1; j >= 0; j-- {
+			branches[j].Write()
+		}
+	}
+}
+
+func BenchmarkNestedBranches25Stores1(b *testing.B) {
+	benchmarkNestedBranches(b, 25, 1)
+}
+
+func BenchmarkNestedBranches25Stores10(b *testing.B) {
+	benchmarkNestedBranches(b, 25, 10)
+}
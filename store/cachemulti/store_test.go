@@ -2,17 +2,20 @@ package cachemulti
 
 import (
 	"fmt"
+	"sync"
 	"testing"
 
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/store/dbadapter"
 	"cosmossdk.io/store/types"
 )
 
 func TestStoreGetKVStore(t *testing.T) {
 	require := require.New(t)
 
-	s := Store{stores: map[types.StoreKey]types.CacheWrap{}}
+	s := NewStore(dbm.NewMemDB(), map[types.StoreKey]types.CacheWrapper{}, nil, nil, nil)
 	key := types.NewKVStoreKey("abc")
 	errMsg := fmt.Sprintf("kv store with key %v has not been registered in stores", key)
 
@@ -21,4 +24,68 @@ func TestStoreGetKVStore(t *testing.T) {
 
 	require.PanicsWithValue(errMsg,
 		func() { s.GetKVStore(key) })
+
+	require.PanicsWithValue(fmt.Sprintf("kv store with key %v has not been registered in stores", nil),
+		func() { s.GetKVStore(nil) })
+}
+
+func TestStoreLazyBranches(t *testing.T) {
+	require := require.New(t)
+
+	key1, key2 := types.NewKVStoreKey("store1"), types.NewKVStoreKey("store2")
+	store1, store2 := dbadapter.Store{DB: dbm.NewMemDB()}, dbadapter.Store{DB: dbm.NewMemDB()}
+	store2.Set([]byte("key"), []byte("value"))
+	s := NewStore(dbm.NewMemDB(), map[types.StoreKey]types.CacheWrapper{
+		key1: store1,
+		key2: store2,
+	}, nil, nil, nil)
+
+	// the stores are branched on their first access only
+	require.Empty(s.stores)
+	branch := s.CacheMultiStore().(Store)
+	require.Empty(s.stores)
+
+	branch.GetKVStore(key1).Set([]byte("key"), []byte("value"))
+	require.Len(branch.stores, 1)
+	require.Len(s.stores, 1)
+	require.Same(branch.GetKVStore(key1), branch.GetKVStore(key1))
+
+	// nested branches see the writes of their parents
+	nested := branch.CacheMultiStore()
+	require.Equal([]byte("value"), nested.GetKVStore(key1).Get([]byte("key")))
+	require.Equal([]byte("value"), nested.GetKVStore(key2).Get([]byte("key")))
+	nested.GetKVStore(key2).Delete([]byte("key"))
+
+	// the writes only reach the parents once written
+	require.Nil(store1.Get([]byte("key")))
+	nested.Write()
+	require.Nil(branch.GetKVStore(key2).Get([]byte("key")))
+	require.NotNil(store2.Get([]byte("key")))
+	branch.Write()
+	s.Write()
+	require.Equal([]byte("value"), store1.Get([]byte("key")))
+	require.Nil(store2.Get([]byte("key")))
+}
+
+func TestStoreConcurrentBranches(t *testing.T) {
+	keys := make(map[types.StoreKey]types.CacheWrapper)
+	for i := 0; i < 10; i++ {
+		keys[types.NewKVStoreKey(fmt.Sprintf("store%d", i))] = dbadapter.Store{DB: dbm.NewMemDB()}
+	}
+	s := NewStore(dbm.NewMemDB(), keys, nil, nil, nil)
+
+	var wg sync.WaitGroup
+	stores := make([][]types.KVStore, 4)
+	for i := range stores {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for key := range keys {
+				stores[i] = append(stores[i], s.GetKVStore(key))
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	require.Len(t, s.stores, len(keys))
 }
@@ -0,0 +1,91 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// storeDataPrefix is the prefix of the data of the stores, followed by the
+// store name and a slash.
+const storeDataPrefix = "s/k:"
+
+// StoreUsage is the disk usage of the data of a store.
+type StoreUsage struct {
+	Name string
+	// Keys is the number of records of the store.
+	Keys uint64
+	// Bytes is the size of the keys and values of the records, before
+	// compression.
+	Bytes uint64
+	// Orphan is set if the store is not part of the latest version, its data
+	// being left by a deleted or renamed store.
+	Orphan bool
+}
+
+// GetStoresUsage returns the disk usage of the stores whose data are held by
+// the database, sorted by name.
+func GetStoresUsage(db dbm.DB) ([]StoreUsage, error) {
+	version := GetLatestVersion(db)
+	if version == 0 {
+		return nil, fmt.Errorf("the database holds no version")
+	}
+	cInfo, err := getCommitInfo(db, version)
+	if err != nil {
+		return nil, err
+	}
+	committed := make(map[string]bool, len(cInfo.StoreInfos))
+	for _, info := range cInfo.StoreInfos {
+		committed[info.Name] = true
+	}
+
+	itr, err := dbm.IteratePrefix(db, []byte(storeDataPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	// the records of a store are contiguous, ordered by store name
+	var usages []StoreUsage
+	for ; itr.Valid(); itr.Next() {
+		key := itr.Key()[len(storeDataPrefix):]
+		i := bytes.IndexByte(key, '/')
+		if i < 0 {
+			continue
+		}
+		name := string(key[:i])
+		if len(usages) == 0 || usages[len(usages)-1].Name != name {
+			usages = append(usages, StoreUsage{Name: name, Orphan: !committed[name]})
+		}
+		usage := &usages[len(usages)-1]
+		usage.Keys++
+		usage.Bytes += uint64(len(itr.Key()) + len(itr.Value()))
+	}
+	return usages, itr.Error()
+}
+
+// PruneOrphanStores deletes the data of the stores which are not part of the
+// latest version, left by the stores deleted or renamed, and returns their
+// names. It must only be run while the database is not in use. The versions
+// prior to the deletions are no longer queryable afterwards.
+func PruneOrphanStores(db dbm.DB) ([]string, error) {
+	usages, err := GetStoresUsage(db)
+	if err != nil {
+		return nil, err
+	}
+
+	var pruned []string
+	for _, usage := range usages {
+		if !usage.Orphan {
+			continue
+		}
+		if err := wipeDB(dbm.NewPrefixDB(db, []byte(storeDataPrefix+usage.Name+"/"))); err != nil {
+			return pruned, errorsmod.Wrapf(err, "failed to prune store %s", usage.Name)
+		}
+		pruned = append(pruned, usage.Name)
+	}
+	return pruned, nil
+}
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"os"
+	"runtime"
 	"testing"
 
 	dbm "github.com/cosmos/cosmos-db"
@@ -215,7 +217,7 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 
 	streamReader, err := snapshots.NewStreamReader(chunks)
 	require.NoError(t, err)
-	nextItem, err := target.Restore(version, snapshottypes.CurrentFormat, streamReader)
+	nextItem, err := target.Restore(version, snapshottypes.FormatZlib, streamReader)
 	require.NoError(t, err)
 	require.Equal(t, *dummyExtensionItem.GetExtension(), *nextItem.GetExtension())
 
@@ -233,6 +235,76 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+func TestMultistoreSnapshot_Parallel(t *testing.T) {
+	// The stores are written concurrently, but the chunks must not depend on it.
+	source := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 5, 10000)
+	version := uint64(source.LastCommitID().Version)
+
+	create := func() *snapshottypes.Snapshot {
+		store, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+		require.NoError(t, err)
+		manager := snapshots.NewManager(store, snapshottypes.NewSnapshotOptions(1, 1), source, nil, log.NewNopLogger())
+		snapshot, err := manager.Create(version)
+		require.NoError(t, err)
+		return snapshot
+	}
+
+	snapshot := create()
+	require.Equal(t, snapshottypes.FormatZstd, snapshot.Format)
+	// every store starts a chunk
+	require.Greater(t, snapshot.Chunks, uint32(5))
+
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(1))
+	assert.Equal(t, snapshot, create())
+}
+
+func TestMultistoreSnapshotRestore_Resume(t *testing.T) {
+	source := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 3, 10000)
+	version := uint64(source.LastCommitID().Version)
+
+	store, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	manager := snapshots.NewManager(store, snapshottypes.NewSnapshotOptions(1, 1), source, nil, log.NewNopLogger())
+	snapshot, err := manager.Create(version)
+	require.NoError(t, err)
+
+	targetDB := dbm.NewMemDB()
+	newTarget := func() (*rootmulti.Store, *snapshots.Manager) {
+		target := rootmulti.NewStore(targetDB, log.NewNopLogger(), metrics.NewNoOpMetrics())
+		for _, key := range source.StoreKeysByName() {
+			target.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+		}
+		require.NoError(t, target.LoadLatestVersion())
+		return target, snapshots.NewManager(store, snapshottypes.NewSnapshotOptions(1, 1), target, nil, log.NewNopLogger())
+	}
+
+	// interrupt the restoration at the last chunk, in the last store
+	last := store.PathChunk(snapshot.Height, snapshot.Format, snapshot.Chunks-1)
+	chunk, err := os.ReadFile(last)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(last, []byte{1, 2, 3}, 0o600))
+
+	_, targetManager := newTarget()
+	require.Error(t, targetManager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+
+	// the restoration is resumed after the stores already restored
+	require.NoError(t, os.WriteFile(last, chunk, 0o600))
+	target, targetManager := newTarget()
+	require.EqualValues(t, 0, target.LastCommitID().Version)
+	var resumed []uint32
+	targetManager.SetProgressFunc(func(done, _ uint32) {
+		resumed = append(resumed, done)
+	})
+	require.NoError(t, targetManager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+	require.Greater(t, resumed[0], uint32(1))
+	require.Equal(t, snapshot.Chunks, resumed[len(resumed)-1])
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, key := range source.StoreKeysByName() {
+		assertStoresEqual(t, source.GetCommitKVStore(key), target.GetCommitKVStore(key), "store %q not equal", key.Name())
+	}
+}
+
 func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	b.Helper()
 	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
@@ -298,7 +370,7 @@ func benchmarkMultistoreSnapshotRestore(b *testing.B, stores uint8, storeKeys ui
 		}()
 		reader, err := snapshots.NewStreamReader(chunks)
 		require.NoError(b, err)
-		_, err = target.Restore(version, snapshottypes.CurrentFormat, reader)
+		_, err = target.Restore(version, snapshottypes.FormatZlib, reader)
 		require.NoError(b, err)
 		require.Equal(b, source.LastCommitID(), target.LastCommitID())
 	}
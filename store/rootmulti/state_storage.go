@@ -0,0 +1,276 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/internal/kv"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/storage"
+	"cosmossdk.io/store/tracekv"
+	"cosmossdk.io/store/types"
+)
+
+var _ types.CommitKVStore = (*stateStorageStore)(nil)
+
+// stateStorageStore wraps the commitment store of an IAVL store when the state
+// storage is enabled. Reads are served by the state storage at the last
+// committed version, overlaid with the uncommitted writes, so they never
+// traverse the tree; writes go to both the overlay and the commitment store,
+// which is only used to compute the hash and the proofs.
+type stateStorageStore struct {
+	types.CommitKVStore
+
+	db   *storage.Database
+	name string
+
+	mtx     sync.Mutex
+	overlay *cachekv.Store
+	changes map[string]*types.StoreKVPair
+}
+
+func newStateStorageStore(commitment types.CommitKVStore, db *storage.Database, name string, version int64) *stateStorageStore {
+	s := &stateStorageStore{
+		CommitKVStore: commitment,
+		db:            db,
+		name:          name,
+	}
+	s.reset(version)
+	return s
+}
+
+// reset drops the uncommitted writes and reads the state storage at the given
+// version.
+func (s *stateStorageStore) reset(version int64) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.overlay = cachekv.NewStore(storage.NewStore(s.db, s.name, version))
+	s.changes = make(map[string]*types.StoreKVPair)
+}
+
+// popChanges returns the writes since the last reset, sorted by key.
+func (s *stateStorageStore) popChanges() []*types.StoreKVPair {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	changes := make([]*types.StoreKVPair, 0, len(s.changes))
+	for _, pair := range s.changes {
+		changes = append(changes, pair)
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return bytes.Compare(changes[i].Key, changes[j].Key) < 0
+	})
+	s.changes = make(map[string]*types.StoreKVPair)
+
+	return changes
+}
+
+func (s *stateStorageStore) getOverlay() *cachekv.Store {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.overlay
+}
+
+// Get implements types.KVStore.
+func (s *stateStorageStore) Get(key []byte) []byte {
+	return s.getOverlay().Get(key)
+}
+
+// Has implements types.KVStore.
+func (s *stateStorageStore) Has(key []byte) bool {
+	return s.getOverlay().Has(key)
+}
+
+// Iterator implements types.KVStore.
+func (s *stateStorageStore) Iterator(start, end []byte) types.Iterator {
+	return s.getOverlay().Iterator(start, end)
+}
+
+// ReverseIterator implements types.KVStore.
+func (s *stateStorageStore) ReverseIterator(start, end []byte) types.Iterator {
+	return s.getOverlay().ReverseIterator(start, end)
+}
+
+// Set implements types.KVStore.
+func (s *stateStorageStore) Set(key, value []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.overlay.Set(key, value)
+	s.CommitKVStore.Set(key, value)
+	s.changes[string(key)] = &types.StoreKVPair{StoreKey: s.name, Key: key, Value: value}
+}
+
+// Delete implements types.KVStore.
+func (s *stateStorageStore) Delete(key []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.overlay.Delete(key)
+	s.CommitKVStore.Delete(key)
+	s.changes[string(key)] = &types.StoreKVPair{StoreKey: s.name, Key: key, Delete: true}
+}
+
+// CacheWrap implements types.CacheWrapper.
+func (s *stateStorageStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements types.CacheWrapper.
+func (s *stateStorageStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// SetStateStorage enables the state storage (SS) of the store, as outlined in
+// ADR-040 and ADR-065. When set, the reads of the IAVL stores are served by the
+// given flat versioned database and the IAVL trees are only used as state
+// commitment (SC), to compute the app hash and the query proofs. Queries of
+// past heights are served by the state storage as long as they are not pruned
+// from it, so the IAVL trees can be pruned aggressively.
+//
+// The state storage is populated from the IAVL trees the first time the store
+// is loaded with it. It must be set before LoadLatestVersion or LoadVersion is
+// called.
+func (rs *Store) SetStateStorage(db *storage.Database) {
+	rs.stateStorage = db
+}
+
+// SetStateStoragePruning sets the pruning strategy of the state storage,
+// independently of the one of the IAVL trees. Only the KeepRecent and Interval
+// options are used.
+func (rs *Store) SetStateStoragePruning(pruningOpts pruningtypes.PruningOptions) {
+	rs.stateStoragePruning = pruningOpts
+}
+
+// StateStorage returns the state storage of the store, or nil if it is disabled.
+func (rs *Store) StateStorage() *storage.Database {
+	return rs.stateStorage
+}
+
+// prepareStateStorage reconciles the state storage with the version being
+// loaded. It returns true if the state of the stores must be imported into it.
+func (rs *Store) prepareStateStorage(ver int64) (bool, error) {
+	latest, err := rs.stateStorage.GetLatestVersion()
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case latest == ver:
+		return false, nil
+
+	case latest > ver:
+		// the store was rolled back, or the node stopped between the state
+		// storage and the metadata writes of a commit
+		rs.logger.Info("rolling back state storage", "from", latest, "to", ver)
+		return false, rs.stateStorage.Rollback(ver)
+
+	case latest == 0:
+		rs.logger.Info("importing state into state storage", "version", ver)
+		return true, nil
+
+	default:
+		return false, fmt.Errorf("state storage at version %d is behind the commitment store at version %d; remove it to import the state again", latest, ver)
+	}
+}
+
+// commitStateStorage writes the changes of the committed version into the state
+// storage, and prunes it according to its pruning strategy.
+func (rs *Store) commitStateStorage(version int64) error {
+	var changeset []*types.StoreKVPair
+	var stores []*stateStorageStore
+	for _, key := range keysFromStoreKeyMap(rs.stores) {
+		if store, ok := rs.stores[key].(*stateStorageStore); ok {
+			changeset = append(changeset, store.popChanges()...)
+			stores = append(stores, store)
+		}
+	}
+
+	if err := rs.stateStorage.ApplyChangeset(version, changeset); err != nil {
+		return err
+	}
+	for _, store := range stores {
+		store.reset(version)
+	}
+
+	keepRecent, interval := int64(rs.stateStoragePruning.KeepRecent), int64(rs.stateStoragePruning.Interval)
+	if interval > 0 && version%interval == 0 && version > keepRecent {
+		// pruning walks the whole state storage, run it in the background
+		go func(pruneHeight int64) {
+			if err := rs.stateStorage.Prune(pruneHeight); err != nil {
+				rs.logger.Error("failed to prune state storage", "height", pruneHeight, "err", err)
+			}
+		}(version - keepRecent)
+	}
+
+	return nil
+}
+
+// queryStateStorage serves a query without proof from the state storage. It
+// returns false if the state storage does not hold the requested height.
+func (rs *Store) queryStateStorage(storeName, subpath string, req *types.RequestQuery) (*types.ResponseQuery, bool, error) {
+	height := req.Height
+	if height == 0 {
+		height = rs.lastCommitInfo.GetVersion()
+	}
+	ok, err := rs.stateStorage.HasVersion(height)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	res, err := queryKVStore(storage.NewStore(rs.stateStorage, storeName, height), subpath, req.Data)
+	if err != nil {
+		return nil, true, err
+	}
+	res.Height = height
+
+	return res, true, nil
+}
+
+// queryKVStore answers the "/key" and "/subspace" queries of the IAVL stores,
+// without proofs, from the given store.
+func queryKVStore(store types.KVStore, path string, data []byte) (*types.ResponseQuery, error) {
+	if len(data) == 0 {
+		return &types.ResponseQuery{}, errorsmod.Wrap(types.ErrTxDecode, "query cannot be zero length")
+	}
+
+	res := &types.ResponseQuery{}
+	switch path {
+	case "/key":
+		res.Key = data
+		res.Value = store.Get(data)
+
+	case "/subspace":
+		pairs := kv.Pairs{
+			Pairs: make([]kv.Pair, 0),
+		}
+
+		res.Key = data
+
+		iterator := types.KVStorePrefixIterator(store, data)
+		for ; iterator.Valid(); iterator.Next() {
+			pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
+		}
+		if err := iterator.Close(); err != nil {
+			return &types.ResponseQuery{}, err
+		}
+
+		bz, err := pairs.Marshal()
+		if err != nil {
+			return &types.ResponseQuery{}, err
+		}
+		res.Value = bz
+
+	default:
+		return &types.ResponseQuery{}, errorsmod.Wrapf(types.ErrUnknownRequest, "unexpected query path: %v", path)
+	}
+
+	return res, nil
+}
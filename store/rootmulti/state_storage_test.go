@@ -0,0 +1,162 @@
+package rootmulti
+
+import (
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/iavl"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/storage"
+	"cosmossdk.io/store/types"
+)
+
+func newMultiStoreWithStateStorage(db, ssDB dbm.DB, pruningOpts pruningtypes.PruningOptions) *Store {
+	ms := newMultiStoreWithMounts(db, pruningOpts)
+	ms.SetStateStorage(storage.NewDatabase(ssDB))
+	return ms
+}
+
+func TestStateStorage(t *testing.T) {
+	db, ssDB := dbm.NewMemDB(), dbm.NewMemDB()
+	ms := newMultiStoreWithStateStorage(db, ssDB, pruningtypes.NewPruningOptions(pruningtypes.PruningEverything))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	// the same writes on a store without state storage must give the same hashes
+	refMs := newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, refMs.LoadLatestVersion())
+
+	key := ms.keysByName["store1"]
+	require.IsType(t, &iavl.Store{}, ms.GetCommitKVStore(key))
+
+	k := []byte("key")
+	for i := int64(1); i <= 20; i++ {
+		for _, s := range []*Store{ms, refMs} {
+			store := s.GetKVStore(key)
+			store.Set(k, []byte{byte(i)})
+			if i%2 == 0 {
+				store.Delete([]byte{byte(i - 1)})
+			} else {
+				store.Set([]byte{byte(i)}, []byte{byte(i)})
+			}
+
+			// writes are readable before being committed
+			require.Equal(t, []byte{byte(i)}, store.Get(k))
+		}
+		require.Equal(t, refMs.WorkingHash(), ms.WorkingHash())
+		require.Equal(t, refMs.Commit(), ms.Commit())
+	}
+
+	latest, err := ms.StateStorage().GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(20), latest)
+
+	// the IAVL versions are pruned, but the state storage serves any version
+	_, err = ms.GetCommitKVStore(key).(*iavl.Store).GetImmutable(2)
+	require.Error(t, err)
+
+	for _, version := range []int64{2, 11, 20} {
+		cms, err := ms.CacheMultiStoreWithVersion(version)
+		require.NoError(t, err)
+		require.Equal(t, []byte{byte(version)}, cms.GetKVStore(key).Get(k))
+
+		refCms, err := refMs.CacheMultiStoreWithVersion(version)
+		require.NoError(t, err)
+
+		itr, refItr := cms.GetKVStore(key).Iterator(nil, nil), refCms.GetKVStore(key).Iterator(nil, nil)
+		for ; refItr.Valid(); refItr.Next() {
+			require.True(t, itr.Valid())
+			require.Equal(t, refItr.Key(), itr.Key())
+			require.Equal(t, refItr.Value(), itr.Value())
+			itr.Next()
+		}
+		require.False(t, itr.Valid())
+		require.NoError(t, itr.Close())
+		require.NoError(t, refItr.Close())
+
+		// queries without proof are served too
+		res, err := ms.Query(&types.RequestQuery{Path: "/store1/key", Data: k, Height: version})
+		require.NoError(t, err)
+		require.Equal(t, []byte{byte(version)}, res.Value)
+		require.Equal(t, version, res.Height)
+	}
+
+	// queries with proof still need the IAVL version
+	_, err = ms.Query(&types.RequestQuery{Path: "/store1/key", Data: k, Height: 2, Prove: true})
+	require.Error(t, err)
+	res, err := ms.Query(&types.RequestQuery{Path: "/store1/key", Data: k, Height: 20, Prove: true})
+	require.NoError(t, err)
+	require.Equal(t, []byte{byte(20)}, res.Value)
+	require.NotNil(t, res.ProofOps)
+}
+
+func TestStateStorageImportAndRollback(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	key := ms.keysByName["store2"]
+	for i := byte(1); i <= 3; i++ {
+		ms.GetKVStore(key).Set([]byte{i}, []byte{i})
+		ms.Commit()
+	}
+
+	// enabling the state storage on an existing store imports its latest state
+	ssDB := dbm.NewMemDB()
+	ms = newMultiStoreWithStateStorage(db, ssDB, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ss := ms.StateStorage()
+	value, err := ss.Get("store2", 3, []byte{1})
+	require.NoError(t, err)
+	require.Equal(t, []byte{1}, value)
+	require.Equal(t, []byte{3}, ms.GetKVStore(key).Get([]byte{3}))
+
+	// the versions before the imported one are not held
+	ok, err := ss.HasVersion(2)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ms.GetKVStore(key).Set([]byte{4}, []byte{4})
+	ms.Commit()
+	ms.GetKVStore(key).Set([]byte{5}, []byte{5})
+	ms.Commit()
+
+	// rolling back the store rolls back the state storage
+	require.NoError(t, ms.RollbackToVersion(4))
+	latest, err := ss.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(4), latest)
+	require.Nil(t, ms.GetKVStore(key).Get([]byte{5}))
+	require.Equal(t, []byte{4}, ms.GetKVStore(key).Get([]byte{4}))
+
+	// a state storage behind the commitment store can not be loaded
+	require.NoError(t, ss.SetLatestVersion(2))
+	ms = newMultiStoreWithStateStorage(db, ssDB, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.Error(t, ms.LoadLatestVersion())
+}
+
+func TestStateStoragePruning(t *testing.T) {
+	ms := newMultiStoreWithStateStorage(dbm.NewMemDB(), dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStateStoragePruning(pruningtypes.NewCustomPruningOptions(2, 5))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	key := ms.keysByName["store1"]
+	for i := byte(1); i <= 5; i++ {
+		ms.GetKVStore(key).Set([]byte("key"), []byte{i})
+		ms.Commit()
+	}
+
+	ss := ms.StateStorage()
+	require.Eventually(t, func() bool {
+		earliest, err := ss.GetEarliestVersion()
+		return err == nil && earliest == 3
+	}, time.Second, 10*time.Millisecond)
+
+	// pruned versions are served by the IAVL trees
+	cms, err := ms.CacheMultiStoreWithVersion(2)
+	require.NoError(t, err)
+	require.Equal(t, []byte{2}, cms.GetKVStore(key).Get([]byte("key")))
+}
@@ -27,6 +27,7 @@ import (
 	"cosmossdk.io/store/pruning"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"cosmossdk.io/store/storage"
 	"cosmossdk.io/store/tracekv"
 	"cosmossdk.io/store/transient"
 	"cosmossdk.io/store/types"
@@ -35,10 +36,14 @@ import (
 const (
 	latestVersionKey = "s/latest"
 	commitInfoKeyFmt = "s/%d" // s/<version>
+	restoringKey     = "s/restoring"
 )
 
 const iavlDisablefastNodeDefault = false
 
+// wipeBatchSize is the number of records deleted at once when wiping a store.
+const wipeBatchSize = 10_000
+
 // keysFromStoreKeyMap returns a slice of keys for the provided map lexically sorted by StoreKey.Name()
 func keysFromStoreKeyMap[V any](m map[types.StoreKey]V) []types.StoreKey {
 	keys := make([]types.StoreKey, 0, len(m))
@@ -74,11 +79,24 @@ type Store struct {
 	listeners           map[types.StoreKey]*types.MemoryListener
 	metrics             metrics.StoreMetrics
 	commitHeader        cmtproto.Header
+	stateStorage        *storage.Database
+	stateStoragePruning pruningtypes.PruningOptions
+
+	// aliases resolves the keys of the renamed stores to the new ones.
+	aliases           map[types.StoreKey]types.StoreKey
+	scheduledUpgrades map[int64]*types.StoreUpgrades
+	// released holds the keys of the stores no longer used by the modules,
+	// which can be deleted online.
+	released map[types.StoreKey]bool
+	// pendingMarkers records the upgrades applied online with the next commit.
+	pendingMarkers map[string][]byte
 }
 
 var (
 	_ types.CommitMultiStore = (*Store)(nil)
 	_ types.Queryable        = (*Store)(nil)
+
+	_ snapshottypes.ParallelSnapshotter = (*Store)(nil)
 )
 
 // NewStore returns a reference to a new Store object with the provided DB. The
@@ -96,6 +114,10 @@ func NewStore(db dbm.DB, logger log.Logger, metricGatherer metrics.StoreMetrics)
 		keysByName:          make(map[string]types.StoreKey),
 		listeners:           make(map[types.StoreKey]*types.MemoryListener),
 		removalMap:          make(map[types.StoreKey]bool),
+		aliases:             make(map[types.StoreKey]types.StoreKey),
+		released:            make(map[types.StoreKey]bool),
+		scheduledUpgrades:   make(map[int64]*types.StoreUpgrades),
+		pendingMarkers:      make(map[string][]byte),
 		pruningManager:      pruning.NewManager(db, logger),
 		metrics:             metricGatherer,
 	}
@@ -161,6 +183,8 @@ func (rs *Store) GetCommitStore(key types.StoreKey) types.CommitStore {
 // GetCommitKVStore returns a mounted CommitKVStore for a given StoreKey. If the
 // store is wrapped in an inter-block cache, it will be unwrapped before returning.
 func (rs *Store) GetCommitKVStore(key types.StoreKey) types.CommitKVStore {
+	key = rs.resolveKey(key)
+
 	// If the Store has an inter-block cache, first attempt to lookup and unwrap
 	// the underlying CommitKVStore by StoreKey. If it does not exist, fallback to
 	// the main mapping of CommitKVStores.
@@ -170,6 +194,11 @@ func (rs *Store) GetCommitKVStore(key types.StoreKey) types.CommitKVStore {
 		}
 	}
 
+	// If the state storage is enabled, unwrap the commitment store.
+	if store, ok := rs.stores[key].(*stateStorageStore); ok {
+		return store.CommitKVStore
+	}
+
 	return rs.stores[key]
 }
 
@@ -220,6 +249,27 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		}
 	}
 
+	if ver == 0 {
+		if err := rs.wipeRestoringStore(); err != nil {
+			return errorsmod.Wrap(err, "failed to wipe store of an interrupted snapshot restoration")
+		}
+	}
+
+	// reconcile the state storage with the loaded version, if enabled
+	var importStateStorage bool
+	if rs.stateStorage != nil {
+		var err error
+		importStateStorage, err = rs.prepareStateStorage(ver)
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to load state storage")
+		}
+	}
+
+	// resolve the stores renamed or deleted online
+	if err := rs.applyStoreMarkers(upgrades); err != nil {
+		return errorsmod.Wrap(err, "failed to load the store upgrades applied online")
+	}
+
 	// load each Store (note this doesn't panic on unmounted keys now)
 	newStores := make(map[types.StoreKey]types.CommitKVStore)
 
@@ -255,6 +305,15 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 			return errorsmod.Wrap(err, "failed to load store")
 		}
 
+		if rs.stateStorage != nil && storeParams.typ == types.StoreTypeIAVL {
+			if importStateStorage {
+				if err := rs.stateStorage.ImportStore(key.Name(), ver, store); err != nil {
+					return errorsmod.Wrapf(err, "failed to import store %s into state storage", key.Name())
+				}
+			}
+			store = newStateStorageStore(store, rs.stateStorage, key.Name(), ver)
+		}
+
 		newStores[key] = store
 
 		// If it was deleted, remove all data
@@ -274,6 +333,14 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 			if err != nil {
 				return errorsmod.Wrapf(err, "failed to load old store %s", oldName)
 			}
+			if rs.stateStorage != nil && oldParams.typ == types.StoreTypeIAVL {
+				if importStateStorage {
+					if err := rs.stateStorage.ImportStore(oldName, ver, oldStore); err != nil {
+						return errorsmod.Wrapf(err, "failed to import store %s into state storage", oldName)
+					}
+				}
+				oldStore = newStateStorageStore(oldStore, rs.stateStorage, oldName, ver)
+			}
 
 			// move all data
 			if err := moveKVStoreData(oldStore.(types.KVStore), store.(types.KVStore)); err != nil {
@@ -287,6 +354,12 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		}
 	}
 
+	if importStateStorage {
+		if err := rs.stateStorage.FinalizeImport(ver); err != nil {
+			return errorsmod.Wrap(err, "failed to import state storage")
+		}
+	}
+
 	rs.lastCommitInfo = cInfo
 	rs.stores = newStores
 
@@ -325,6 +398,39 @@ func deleteKVStore(kv types.KVStore) error {
 	return nil
 }
 
+// wipeDB deletes all the records of a database, in batches of wipeBatchSize.
+func wipeDB(db dbm.DB) error {
+	for {
+		itr, err := db.Iterator(nil, nil)
+		if err != nil {
+			return err
+		}
+		var keys [][]byte
+		for ; itr.Valid() && len(keys) < wipeBatchSize; itr.Next() {
+			keys = append(keys, itr.Key())
+		}
+		if err := itr.Close(); err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+
+		batch := db.NewBatch()
+		for _, key := range keys {
+			if err := batch.Delete(key); err != nil {
+				_ = batch.Close()
+				return err
+			}
+		}
+		err = batch.WriteSync()
+		_ = batch.Close()
+		if err != nil {
+			return err
+		}
+	}
+}
+
 // we simulate move by a copy and delete
 func moveKVStoreData(oldDB, newDB types.KVStore) error {
 	// we read from one and write to another
@@ -398,16 +504,17 @@ func (rs *Store) TracingEnabled() bool {
 // AddListeners adds a listener for the KVStore belonging to the provided StoreKey
 func (rs *Store) AddListeners(keys []types.StoreKey) {
 	for i := range keys {
-		listener := rs.listeners[keys[i]]
+		key := rs.resolveKey(keys[i])
+		listener := rs.listeners[key]
 		if listener == nil {
-			rs.listeners[keys[i]] = types.NewMemoryListener()
+			rs.listeners[key] = types.NewMemoryListener()
 		}
 	}
 }
 
 // ListeningEnabled returns if listening is enabled for a specific KVStore
 func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
-	if ls, ok := rs.listeners[key]; ok {
+	if ls, ok := rs.listeners[rs.resolveKey(key)]; ok {
 		return ls != nil
 	}
 	return false
@@ -460,27 +567,21 @@ func (rs *Store) LastCommitID() types.CommitID {
 
 // Commit implements Committer/CommitStore.
 func (rs *Store) Commit() types.CommitID {
-	var previousHeight, version int64
-	if rs.lastCommitInfo.GetVersion() == 0 && rs.initialVersion > 1 {
-		// This case means that no commit has been made in the store, we
-		// start from initialVersion.
-		version = rs.initialVersion
-	} else {
-		// This case can means two things:
-		// - either there was already a previous commit in the store, in which
-		// case we increment the version from there,
-		// - or there was no previous commit, and initial version was not set,
-		// in which case we start at version 1.
-		previousHeight = rs.lastCommitInfo.GetVersion()
-		version = previousHeight + 1
-	}
+	version := rs.workingVersion()
 
 	if rs.commitHeader.Height != version {
 		rs.logger.Debug("commit header and version mismatch", "header_height", rs.commitHeader.Height, "version", version)
 	}
 
+	rs.applyScheduledUpgrades(version)
 	rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap)
 	rs.lastCommitInfo.Timestamp = rs.commitHeader.Time
+
+	if rs.stateStorage != nil {
+		if err := rs.commitStateStorage(version); err != nil {
+			panic(fmt.Errorf("error on state storage write %w", err))
+		}
+	}
 	defer rs.flushMetadata(rs.db, version, rs.lastCommitInfo)
 
 	// remove remnants of removed stores
@@ -489,6 +590,7 @@ func (rs *Store) Commit() types.CommitID {
 			delete(rs.stores, sk)
 			delete(rs.storesParams, sk)
 			delete(rs.keysByName, sk.Name())
+			delete(rs.released, sk)
 		}
 	}
 
@@ -508,9 +610,27 @@ func (rs *Store) Commit() types.CommitID {
 	}
 }
 
+// workingVersion returns the version being written, to be committed next.
+func (rs *Store) workingVersion() int64 {
+	if rs.lastCommitInfo.GetVersion() == 0 && rs.initialVersion > 1 {
+		// This case means that no commit has been made in the store, we
+		// start from initialVersion.
+		return rs.initialVersion
+	}
+
+	// This case can means two things:
+	// - either there was already a previous commit in the store, in which
+	// case we increment the version from there,
+	// - or there was no previous commit, and initial version was not set,
+	// in which case we start at version 1.
+	return rs.lastCommitInfo.GetVersion() + 1
+}
+
 // WorkingHash returns the current hash of the store.
 // it will be used to get the current app hash before commit.
 func (rs *Store) WorkingHash() []byte {
+	rs.applyScheduledUpgrades(rs.workingVersion())
+
 	storeInfos := make([]types.StoreInfo, 0, len(rs.stores))
 	storeKeys := keysFromStoreKeyMap(rs.stores)
 
@@ -554,6 +674,9 @@ func (rs *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.Cac
 func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range rs.stores {
+		if rs.released[k] {
+			continue
+		}
 		store := types.KVStore(v)
 		// Wire the listenkv.Store to allow listeners to observe the writes from the cache store,
 		// set same listeners on cache store will observe duplicated writes.
@@ -562,6 +685,7 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 		}
 		stores[k] = store
 	}
+	rs.addAliases(stores)
 	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.getTracingContext())
 }
 
@@ -570,6 +694,16 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 // any store cannot be loaded. This should only be used for querying and
 // iterating at past heights.
 func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	// serve the version from the state storage if it holds it
+	var fromStateStorage bool
+	if rs.stateStorage != nil {
+		var err error
+		fromStateStorage, err = rs.stateStorage.HasVersion(version)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
 	var commitInfo *types.CommitInfo
 	storeInfos := map[string]bool{}
@@ -577,6 +711,11 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 		var cacheStore types.KVStore
 		switch store.GetStoreType() {
 		case types.StoreTypeIAVL:
+			if fromStateStorage {
+				cacheStore = storage.NewStore(rs.stateStorage, key.Name(), version)
+				break
+			}
+
 			// If the store is wrapped with an inter-block cache, we must first unwrap
 			// it to get the underlying IAVL store.
 			store = rs.GetCommitKVStore(key)
@@ -621,6 +760,42 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 
 		cachedStores[key] = cacheStore
 	}
+	rs.addAliases(cachedStores)
+
+	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.getTracingContext()), nil
+}
+
+// CacheMultiStoreFromStorage is like CacheMultiStoreWithVersion, but serves
+// the persistent stores at the given version from the given state storage, such
+// as an archive holding versions pruned from the IAVL trees. An error is returned
+// if the state storage does not hold the version.
+func (rs *Store) CacheMultiStoreFromStorage(db *storage.Database, version int64) (types.CacheMultiStore, error) {
+	ok, err := db.HasVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("version %d does not exist in the state storage", version)
+	}
+
+	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
+	for key, store := range rs.stores {
+		var cacheStore types.KVStore
+		switch store.GetStoreType() {
+		case types.StoreTypeIAVL, types.StoreTypeDB:
+			cacheStore = storage.NewStore(db, key.Name(), version)
+
+		default:
+			cacheStore = store
+		}
+
+		if rs.ListeningEnabled(key) {
+			cacheStore = listenkv.NewStore(cacheStore, key, rs.listeners[key])
+		}
+
+		cachedStores[key] = cacheStore
+	}
+	rs.addAliases(cachedStores)
 
 	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.getTracingContext()), nil
 }
@@ -647,10 +822,14 @@ func (rs *Store) GetStore(key types.StoreKey) types.Store {
 // NOTE: The returned KVStore may be wrapped in an inter-block cache if it is
 // set on the root store.
 func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
+	key = rs.resolveKey(key)
 	s := rs.stores[key]
 	if s == nil {
 		panic(fmt.Sprintf("store does not exist for key: %s", key.Name()))
 	}
+	if rs.released[key] {
+		panic(fmt.Sprintf("store of key %s is released", key.Name()))
+	}
 	store := types.KVStore(s)
 
 	if rs.TracingEnabled() {
@@ -733,6 +912,15 @@ func (rs *Store) Query(req *types.RequestQuery) (*types.ResponseQuery, error) {
 		return &types.ResponseQuery{}, errorsmod.Wrapf(types.ErrUnknownRequest, "no such store: %s", storeName)
 	}
 
+	// queries without proof are served by the state storage, if it holds the
+	// requested height
+	if rs.stateStorage != nil && !req.Prove && store.GetStoreType() == types.StoreTypeIAVL {
+		res, ok, err := rs.queryStateStorage(storeName, subpath, req)
+		if ok || err != nil {
+			return res, err
+		}
+	}
+
 	queryable, ok := store.(types.Queryable)
 	if !ok {
 		return &types.ResponseQuery{}, errorsmod.Wrapf(types.ErrUnknownRequest, "store %s (type %T) doesn't support queries", storeName, store)
@@ -814,11 +1002,28 @@ func parsePath(path string) (storeName, subpath string, err error) {
 // given format changes (at the byte level), the snapshot format must be bumped - see
 // TestMultistoreSnapshot_Checksum test.
 func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	stores, err := rs.SnapshotStores(height)
+	if err != nil {
+		return err
+	}
+
+	for _, store := range stores {
+		if err := store(protoWriter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SnapshotStores implements snapshottypes.ParallelSnapshotter. It returns the
+// export of each store, in the order of Snapshot.
+func (rs *Store) SnapshotStores(height uint64) ([]func(protoWriter protoio.Writer) error, error) {
 	if height == 0 {
-		return errorsmod.Wrap(types.ErrLogic, "cannot snapshot height 0")
+		return nil, errorsmod.Wrap(types.ErrLogic, "cannot snapshot height 0")
 	}
 	if height > uint64(GetLatestVersion(rs.db)) {
-		return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot future height %v", height)
+		return nil, errorsmod.Wrapf(types.ErrLogic, "cannot snapshot future height %v", height)
 	}
 
 	// Collect stores to snapshot (only IAVL stores are supported)
@@ -836,7 +1041,7 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 			// Non-persisted stores shouldn't be snapshotted
 			continue
 		default:
-			return errorsmod.Wrapf(types.ErrLogic,
+			return nil, errorsmod.Wrapf(types.ErrLogic,
 				"don't know how to snapshot store %q of type %T", key.Name(), store)
 		}
 	}
@@ -848,18 +1053,19 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
 	// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
 	// are demarcated by new SnapshotStore items.
+	exports := make([]func(protoWriter protoio.Writer) error, 0, len(stores))
 	for _, store := range stores {
-		rs.logger.Debug("starting snapshot", "store", store.name, "height", height)
-		exporter, err := store.Export(int64(height))
-		if err != nil {
-			rs.logger.Error("snapshot failed; exporter error", "store", store.name, "err", err)
-			return err
-		}
-
-		err = func() error {
+		store := store
+		exports = append(exports, func(protoWriter protoio.Writer) error {
+			rs.logger.Debug("starting snapshot", "store", store.name, "height", height)
+			exporter, err := store.Export(int64(height))
+			if err != nil {
+				rs.logger.Error("snapshot failed; exporter error", "store", store.name, "err", err)
+				return err
+			}
 			defer exporter.Close()
 
-			err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
 				Item: &snapshottypes.SnapshotItem_Store{
 					Store: &snapshottypes.SnapshotStoreItem{
 						Name: store.name,
@@ -897,14 +1103,10 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 			}
 
 			return nil
-		}()
-
-		if err != nil {
-			return err
-		}
+		})
 	}
 
-	return nil
+	return exports, nil
 }
 
 // Restore implements snapshottypes.Snapshotter.
@@ -935,10 +1137,13 @@ loop:
 					return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL commit failed")
 				}
 				importer.Close()
+				if err := rs.restoredStore(); err != nil {
+					return snapshottypes.SnapshotItem{}, err
+				}
 			}
-			store, ok := rs.GetStoreByName(item.Store.Name).(*iavl.Store)
-			if !ok || store == nil {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "cannot import into non-IAVL store %q", item.Store.Name)
+			store, err := rs.restoreStore(item.Store.Name)
+			if err != nil {
+				return snapshottypes.SnapshotItem{}, err
 			}
 			importer, err = store.Import(int64(height))
 			if err != nil {
@@ -987,22 +1192,94 @@ loop:
 			return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL commit failed")
 		}
 		importer.Close()
+		if err := rs.restoredStore(); err != nil {
+			return snapshottypes.SnapshotItem{}, err
+		}
 	}
 
 	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
 	return snapshotItem, rs.LoadLatestVersion()
 }
 
-func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
-	var db dbm.DB
+// restoreStore returns the IAVL store a snapshot restores the given store into.
+// As long as no version of the multistore is committed, the data left in the
+// store by an interrupted restoration is wiped, and the store is marked as being
+// restored until restoredStore is called.
+func (rs *Store) restoreStore(name string) (*iavl.Store, error) {
+	store, ok := rs.GetStoreByName(name).(*iavl.Store)
+	if !ok || store == nil {
+		return nil, errorsmod.Wrapf(types.ErrLogic, "cannot import into non-IAVL store %q", name)
+	}
+	if GetLatestVersion(rs.db) != 0 {
+		return store, nil
+	}
 
+	restoring, err := rs.db.Get([]byte(restoringKey))
+	if err != nil {
+		return nil, err
+	}
+	if string(restoring) == name || store.LastCommitID().Version != 0 {
+		key := rs.keysByName[name]
+		if err := rs.wipeStore(key); err != nil {
+			return nil, err
+		}
+		wiped, err := iavl.LoadStore(rs.storeDB(rs.storesParams[key]), rs.logger, key, types.CommitID{}, rs.iavlCacheSize, rs.iavlDisableFastNode, rs.metrics)
+		if err != nil {
+			return nil, err
+		}
+		store = wiped.(*iavl.Store)
+		rs.stores[key] = store
+	}
+
+	if err := rs.db.SetSync([]byte(restoringKey), []byte(name)); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// restoredStore clears the mark of the store being restored, once its import is
+// committed.
+func (rs *Store) restoredStore() error {
+	return rs.db.DeleteSync([]byte(restoringKey))
+}
+
+// wipeRestoringStore wipes the store marked as being restored, if any. The data
+// an interrupted import left in it can not be loaded.
+func (rs *Store) wipeRestoringStore() error {
+	restoring, err := rs.db.Get([]byte(restoringKey))
+	if err != nil || restoring == nil {
+		return err
+	}
+	if key, ok := rs.keysByName[string(restoring)]; ok {
+		if err := rs.wipeStore(key); err != nil {
+			return err
+		}
+	}
+	return rs.restoredStore()
+}
+
+// wipeStore deletes all the data of a store.
+func (rs *Store) wipeStore(key types.StoreKey) error {
+	rs.logger.Info("wiping store data of an interrupted snapshot restoration", "store", key.Name())
+	if err := wipeDB(rs.storeDB(rs.storesParams[key])); err != nil {
+		return errorsmod.Wrapf(err, "failed to wipe store %s", key.Name())
+	}
+	return nil
+}
+
+// storeDB returns the database of a store.
+func (rs *Store) storeDB(params storeParams) dbm.DB {
 	if params.db != nil {
-		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
-	} else {
-		prefix := "s/k:" + params.key.Name() + "/"
-		db = dbm.NewPrefixDB(rs.db, []byte(prefix))
+		return dbm.NewPrefixDB(params.db, []byte("s/_/"))
 	}
 
+	prefix := "s/k:" + params.key.Name() + "/"
+	return dbm.NewPrefixDB(rs.db, []byte(prefix))
+}
+
+func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
+	db := rs.storeDB(params)
+
 	switch params.typ {
 	case types.StoreTypeMulti:
 		panic("recursive MultiStores not yet supported")
@@ -1105,9 +1382,14 @@ func (rs *Store) SetCommitHeader(h cmtproto.Header) {
 // will return an error if no CommitInfo exists, we fail to unmarshal the record
 // or if we cannot retrieve the object from the DB.
 func (rs *Store) GetCommitInfo(ver int64) (*types.CommitInfo, error) {
+	return getCommitInfo(rs.db, ver)
+}
+
+// getCommitInfo gets the commit info of a version from the database.
+func getCommitInfo(db dbm.DB, ver int64) (*types.CommitInfo, error) {
 	cInfoKey := fmt.Sprintf(commitInfoKeyFmt, ver)
 
-	bz, err := rs.db.Get([]byte(cInfoKey))
+	bz, err := db.Get([]byte(cInfoKey))
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to get commit info")
 	} else if bz == nil {
@@ -1137,9 +1419,16 @@ func (rs *Store) flushMetadata(db dbm.DB, version int64, cInfo *types.CommitInfo
 
 	flushLatestVersion(batch, version)
 
+	for key, value := range rs.pendingMarkers {
+		if err := batch.Set([]byte(key), value); err != nil {
+			panic(err)
+		}
+	}
+
 	if err := batch.WriteSync(); err != nil {
 		panic(fmt.Errorf("error on batch write %w", err))
 	}
+	rs.pendingMarkers = make(map[string][]byte)
 	rs.logger.Debug("flushing metadata finished", "height", version)
 }
 
@@ -0,0 +1,344 @@
+package rootmulti
+
+import (
+	"fmt"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/listenkv"
+	"cosmossdk.io/store/types"
+)
+
+const (
+	renamedStorePrefix = "s/renamed/" // s/renamed/<old name> -> <new name>
+	removedStorePrefix = "s/removed/" // s/removed/<name>
+)
+
+// maxRenamedStoreEntries is the maximum number of entries of a store renamed
+// online, as it is copied while computing the working hash of the version:
+// copying 100,000 entries to an IAVL store takes a few seconds.
+var maxRenamedStoreEntries = 100_000
+
+// ReleaseStore marks the store of a mounted key as no longer used by the
+// modules, which is required to delete it online. The store is still loaded
+// and committed until deleted, but it can no longer be accessed with the key,
+// nor through the branches of the multistore, so that a module still using it
+// fails right away rather than once it is deleted.
+func (rs *Store) ReleaseStore(key types.StoreKey) {
+	if _, ok := rs.storesParams[key]; !ok {
+		panic(fmt.Sprintf("store of key %s is not mounted", key.Name()))
+	}
+	rs.released[key] = true
+}
+
+// ScheduleStoreUpgrades schedules the renames and deletions of stores at the
+// given height, without restarting the node. They are applied when the working
+// hash of the version is computed, once all the writes of the block are done:
+//   - a deleted store is no longer committed, its data is left on disk and can
+//     be reclaimed offline by PruneOrphanStores.
+//   - a renamed store is copied to a new store committed under the new name, the
+//     keys mounted with the old name keep working, resolving to the new store.
+//
+// The upgrades are recorded in the database when the version is committed, so
+// that the mounted keys are resolved the same way when loading the next
+// versions. A node state synced from a later snapshot must mount the new names.
+// Scheduling empty upgrades cancels the upgrades of the height. Stores can only
+// be added by loading a version with store upgrades.
+//
+// Only the stores released with ReleaseStore can be deleted, so that no module
+// uses them once deleted. As a renamed store is copied synchronously, delaying
+// the commit of the version, stores of more than 100,000 entries can not be
+// renamed online.
+func (rs *Store) ScheduleStoreUpgrades(height int64, upgrades *types.StoreUpgrades) error {
+	if upgrades == nil || (len(upgrades.Renamed) == 0 && len(upgrades.Deleted) == 0 && len(upgrades.Added) == 0) {
+		delete(rs.scheduledUpgrades, height)
+		return nil
+	}
+	if height <= rs.LastCommitID().Version {
+		return fmt.Errorf("store upgrades must be scheduled after the latest version %d, got %d", rs.LastCommitID().Version, height)
+	}
+	if err := rs.validateStoreUpgrades(upgrades); err != nil {
+		return err
+	}
+	for _, rename := range upgrades.Renamed {
+		if err := rs.checkRenamedStoreSize(rename.OldKey); err != nil {
+			return err
+		}
+	}
+
+	rs.scheduledUpgrades[height] = upgrades
+	return nil
+}
+
+// checkRenamedStoreSize checks that a store is small enough to be renamed
+// online.
+func (rs *Store) checkRenamedStoreSize(name string) error {
+	itr := rs.stores[rs.keysByName[name]].Iterator(nil, nil)
+	defer itr.Close()
+
+	entries := 0
+	for ; itr.Valid(); itr.Next() {
+		if entries++; entries > maxRenamedStoreEntries {
+			return fmt.Errorf("store %s has more than %d entries, it can not be renamed online", name, maxRenamedStoreEntries)
+		}
+	}
+	return itr.Error()
+}
+
+// validateStoreUpgrades checks that the upgrades only rename existing
+// persistent stores and delete released ones, each at most once.
+func (rs *Store) validateStoreUpgrades(upgrades *types.StoreUpgrades) error {
+	if len(upgrades.Added) > 0 {
+		return fmt.Errorf("stores can not be added online, %v must be added by a store loader", upgrades.Added)
+	}
+
+	upgraded := make(map[string]bool)
+	checkStore := func(name string) error {
+		if upgraded[name] {
+			return fmt.Errorf("store %s is upgraded more than once", name)
+		}
+		upgraded[name] = true
+
+		key, ok := rs.keysByName[name]
+		if !ok || rs.removalMap[key] {
+			return fmt.Errorf("store %s does not exist", name)
+		}
+		if typ := rs.storesParams[key].typ; typ != types.StoreTypeIAVL {
+			return fmt.Errorf("store %s of type %s can not be upgraded, only IAVL stores can", name, typ)
+		}
+		return nil
+	}
+
+	for _, rename := range upgrades.Renamed {
+		if err := checkStore(rename.OldKey); err != nil {
+			return err
+		}
+		if rs.released[rs.keysByName[rename.OldKey]] {
+			return fmt.Errorf("store %s is released, it can only be deleted", rename.OldKey)
+		}
+		if _, ok := rs.keysByName[rename.NewKey]; ok || upgraded[rename.NewKey] || rename.NewKey == "" {
+			return fmt.Errorf("store %s can not be renamed to %q, which is taken or invalid", rename.OldKey, rename.NewKey)
+		}
+		// the names of the stores renamed or deleted before are kept to resolve
+		// their keys, they can not be reused online
+		reused, err := rs.storeNameUpgraded(rename.NewKey)
+		if err != nil {
+			return err
+		}
+		if reused {
+			return fmt.Errorf("store %s can not be renamed to %s, a store renamed or deleted before", rename.OldKey, rename.NewKey)
+		}
+		upgraded[rename.NewKey] = true
+	}
+	for _, name := range upgrades.Deleted {
+		if err := checkStore(name); err != nil {
+			return err
+		}
+		// a store still used by a module would make it fail once deleted
+		if !rs.released[rs.keysByName[name]] {
+			return fmt.Errorf("store %s is still in use, its key must be released to delete it", name)
+		}
+	}
+	return nil
+}
+
+// storeNameUpgraded returns whether a store of the name was renamed or deleted
+// online.
+func (rs *Store) storeNameUpgraded(name string) (bool, error) {
+	for _, prefix := range []string{renamedStorePrefix, removedStorePrefix} {
+		if _, ok := rs.pendingMarkers[prefix+name]; ok {
+			return true, nil
+		}
+		ok, err := rs.db.Has([]byte(prefix + name))
+		if ok || err != nil {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+// applyScheduledUpgrades applies the store upgrades scheduled at the version,
+// if any. It must be called once the writes of the version are done, and before
+// its hash is computed.
+func (rs *Store) applyScheduledUpgrades(version int64) {
+	upgrades, ok := rs.scheduledUpgrades[version]
+	if !ok {
+		return
+	}
+	delete(rs.scheduledUpgrades, version)
+
+	// the stores may have changed since the upgrades were scheduled
+	if err := rs.validateStoreUpgrades(upgrades); err != nil {
+		panic(fmt.Errorf("invalid store upgrades at version %d: %w", version, err))
+	}
+
+	for _, rename := range upgrades.Renamed {
+		if err := rs.renameStore(version, rename.OldKey, rename.NewKey); err != nil {
+			panic(errorsmod.Wrapf(err, "failed to rename store %s to %s", rename.OldKey, rename.NewKey))
+		}
+	}
+	for _, name := range upgrades.Deleted {
+		rs.logger.Info("deleting store", "store", name, "version", version)
+		rs.removalMap[rs.keysByName[name]] = true
+		rs.pendingMarkers[removedStorePrefix+name] = []byte{}
+	}
+}
+
+// renameStore copies the data of a store to a new store created under the new
+// name at the version, and resolves the keys of the old store to the new one.
+// The copy is bounded by the size checked when the rename is scheduled.
+func (rs *Store) renameStore(version int64, oldName, newName string) error {
+	rs.logger.Info("renaming store", "store", oldName, "name", newName, "version", version)
+
+	oldKey := rs.keysByName[oldName]
+	newKey := types.NewKVStoreKey(newName)
+	params := newStoreParams(newKey, rs.storesParams[oldKey].db, types.StoreTypeIAVL, uint64(version))
+
+	store, err := rs.loadCommitStoreFromParams(newKey, types.CommitID{}, params)
+	if err != nil {
+		return err
+	}
+	if rs.stateStorage != nil {
+		store = newStateStorageStore(store, rs.stateStorage, newName, version-1)
+	}
+
+	oldStore := rs.stores[oldKey]
+	rs.stores[newKey] = store
+	rs.storesParams[newKey] = params
+	rs.keysByName[newKey.Name()] = newKey
+	rs.removalMap[oldKey] = true
+	rs.aliasStore(oldKey, newKey)
+	rs.pendingMarkers[renamedStorePrefix+oldName] = []byte(newName)
+
+	// copy without deleting, the old data is left for PruneOrphanStores, the
+	// copy being streamed as the writes of the new store
+	kv := types.KVStore(store)
+	if rs.ListeningEnabled(newKey) {
+		kv = listenkv.NewStore(kv, newKey, rs.listeners[newKey])
+	}
+	itr := oldStore.Iterator(nil, nil)
+	for ; itr.Valid(); itr.Next() {
+		kv.Set(itr.Key(), itr.Value())
+	}
+	return itr.Close()
+}
+
+// aliasStore resolves a key, and the keys resolved to it, to the store of
+// another key, moving its listener.
+func (rs *Store) aliasStore(key, target types.StoreKey) {
+	for alias, aliased := range rs.aliases {
+		if aliased == key {
+			rs.aliases[alias] = target
+		}
+	}
+	rs.aliases[key] = target
+
+	if listener, ok := rs.listeners[key]; ok {
+		rs.listeners[target] = listener
+		delete(rs.listeners, key)
+	}
+}
+
+// resolveKey returns the key of the store a key resolves to, the key itself
+// unless its store was renamed.
+func (rs *Store) resolveKey(key types.StoreKey) types.StoreKey {
+	if target, ok := rs.aliases[key]; ok {
+		return target
+	}
+	return key
+}
+
+// addAliases adds the keys resolving to the stores of a branch.
+func (rs *Store) addAliases(stores map[types.StoreKey]types.CacheWrapper) {
+	for alias, target := range rs.aliases {
+		if store, ok := stores[target]; ok {
+			stores[alias] = store
+		}
+	}
+}
+
+// storeMarkers holds the upgrades of the stores applied online.
+type storeMarkers struct {
+	renamed map[string]string
+	removed map[string]bool
+}
+
+// loadStoreMarkers loads the upgrades of the stores applied online.
+func loadStoreMarkers(db dbm.DB) (storeMarkers, error) {
+	markers := storeMarkers{renamed: make(map[string]string), removed: make(map[string]bool)}
+	for prefix, fn := range map[string]func(name string, value []byte){
+		renamedStorePrefix: func(name string, value []byte) { markers.renamed[name] = string(value) },
+		removedStorePrefix: func(name string, _ []byte) { markers.removed[name] = true },
+	} {
+		itr, err := dbm.IteratePrefix(db, []byte(prefix))
+		if err != nil {
+			return markers, err
+		}
+		for ; itr.Valid(); itr.Next() {
+			fn(string(itr.Key()[len(prefix):]), itr.Value())
+		}
+		if err := itr.Close(); err != nil {
+			return markers, err
+		}
+	}
+	return markers, nil
+}
+
+// resolve returns the current name of a store, following its renames, and
+// whether it was deleted.
+func (m storeMarkers) resolve(name string) (string, bool) {
+	for seen := 0; seen <= len(m.renamed); seen++ {
+		newName, ok := m.renamed[name]
+		if !ok {
+			return name, m.removed[name]
+		}
+		name = newName
+	}
+	panic(fmt.Sprintf("cycle in the renames of store %s", name))
+}
+
+// applyStoreMarkers resolves the mounted keys of the stores renamed or deleted
+// online before loading a version. A store added by the upgrades is a new one,
+// its former upgrades are cleared.
+func (rs *Store) applyStoreMarkers(upgrades *types.StoreUpgrades) error {
+	markers, err := loadStoreMarkers(rs.db)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keysFromStoreKeyMap(rs.storesParams) {
+		name := key.Name()
+		if upgrades.IsAdded(name) {
+			if err := rs.db.Delete([]byte(renamedStorePrefix + name)); err != nil {
+				return err
+			}
+			if err := rs.db.Delete([]byte(removedStorePrefix + name)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		newName, removed := markers.resolve(name)
+		if newName == name && !removed {
+			continue
+		}
+
+		params := rs.storesParams[key]
+		delete(rs.storesParams, key)
+		delete(rs.keysByName, name)
+		if removed {
+			rs.logger.Info("store was deleted, not loading it", "store", name, "name", newName)
+			continue
+		}
+
+		if _, ok := rs.keysByName[newName]; ok {
+			return fmt.Errorf("store %s was renamed to %s, which is mounted as well", name, newName)
+		}
+		newKey := types.NewKVStoreKey(newName)
+		rs.storesParams[newKey] = newStoreParams(newKey, params.db, params.typ, 0)
+		rs.keysByName[newName] = newKey
+		rs.aliasStore(key, newKey)
+	}
+	return nil
+}
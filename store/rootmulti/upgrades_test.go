@@ -0,0 +1,193 @@
+package rootmulti
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/types"
+)
+
+func TestScheduleStoreUpgrades(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, store.LoadLatestVersion())
+
+	k1, v1 := []byte("first"), []byte("store")
+	store.GetKVStore(testStoreKey1).Set(k1, v1)
+	store.GetKVStore(testStoreKey3).Set([]byte("third"), []byte("dropped"))
+	store.Commit()
+
+	upgrades := &types.StoreUpgrades{
+		Renamed: []types.StoreRename{{OldKey: "store1", NewKey: "renamed1"}},
+		Deleted: []string{"store3"},
+	}
+
+	// invalid upgrades are rejected
+	require.ErrorContains(t, store.ScheduleStoreUpgrades(1, upgrades), "after the latest version")
+	require.ErrorContains(t, store.ScheduleStoreUpgrades(2, &types.StoreUpgrades{Added: []string{"store4"}}), "can not be added")
+	require.ErrorContains(t, store.ScheduleStoreUpgrades(2, &types.StoreUpgrades{Deleted: []string{"store4"}}), "does not exist")
+	require.ErrorContains(t, store.ScheduleStoreUpgrades(2, &types.StoreUpgrades{
+		Renamed: []types.StoreRename{{OldKey: "store1", NewKey: "store2"}},
+	}), "taken")
+	require.ErrorContains(t, store.ScheduleStoreUpgrades(2, &types.StoreUpgrades{
+		Renamed: []types.StoreRename{{OldKey: "store1", NewKey: "renamed1"}},
+		Deleted: []string{"store1"},
+	}), "more than once")
+
+	// a store still used by the modules can not be deleted
+	require.ErrorContains(t, store.ScheduleStoreUpgrades(2, upgrades), "still in use")
+
+	// once released, it can no longer be accessed, nor renamed
+	store.ReleaseStore(testStoreKey3)
+	require.Panics(t, func() { store.GetKVStore(testStoreKey3) })
+	require.Panics(t, func() { store.CacheMultiStore().GetKVStore(testStoreKey3) })
+	require.ErrorContains(t, store.ScheduleStoreUpgrades(2, &types.StoreUpgrades{
+		Renamed: []types.StoreRename{{OldKey: "store3", NewKey: "renamed3"}},
+	}), "can only be deleted")
+
+	require.NoError(t, store.ScheduleStoreUpgrades(2, upgrades))
+
+	// the writes of the block are copied
+	k2, v2 := []byte("second"), []byte("block")
+	store.GetKVStore(testStoreKey1).Set(k2, v2)
+
+	workingHash := store.WorkingHash()
+	commitID := store.Commit()
+	require.Equal(t, workingHash, commitID.Hash)
+
+	ci, err := store.GetCommitInfo(2)
+	require.NoError(t, err)
+	require.Len(t, ci.StoreInfos, 2)
+	checkContains(t, ci.StoreInfos, []string{"renamed1", "store2"})
+	require.Equal(t, int64(2), store.GetStoreByName("renamed1").(types.CommitKVStore).LastCommitID().Version)
+	require.Nil(t, store.GetStoreByName("store1"))
+
+	// the old key resolves to the renamed store
+	checkRenamed := func(store *Store, key types.StoreKey) {
+		t.Helper()
+		require.Equal(t, v1, store.GetKVStore(key).Get(k1))
+		require.Equal(t, v2, store.GetKVStore(key).Get(k2))
+		require.Equal(t, v1, store.CacheMultiStore().GetKVStore(key).Get(k1))
+		require.Equal(t, store.GetStoreByName("renamed1"), store.GetCommitKVStore(key))
+	}
+	checkRenamed(store, testStoreKey1)
+	require.Panics(t, func() { store.GetKVStore(testStoreKey3) })
+
+	// the keys of the old stores are resolved when loading the next versions
+	store = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, store.LoadLatestVersion())
+	require.Equal(t, commitID, store.LastCommitID())
+	checkRenamed(store, testStoreKey1)
+	require.Panics(t, func() { store.GetKVStore(testStoreKey3) })
+	store.GetKVStore(testStoreKey1).Set(k1, v2)
+	commitID = store.Commit()
+
+	// the new name can be mounted instead
+	newKey := types.NewKVStoreKey("renamed1")
+	store = NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	store.MountStoreWithDB(newKey, types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(testStoreKey2, types.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+	require.Equal(t, commitID, store.LastCommitID())
+	require.Equal(t, v2, store.GetKVStore(newKey).Get(k1))
+
+	// the names of the old stores can not be reused online
+	require.ErrorContains(t, store.ScheduleStoreUpgrades(4, &types.StoreUpgrades{
+		Renamed: []types.StoreRename{{OldKey: "store2", NewKey: "store3"}},
+	}), "renamed or deleted before")
+
+	// the data of the old stores is left until pruned
+	usages, err := GetStoresUsage(db)
+	require.NoError(t, err)
+	var orphans []string
+	for _, usage := range usages {
+		require.NotZero(t, usage.Keys)
+		if usage.Orphan {
+			orphans = append(orphans, usage.Name)
+		}
+	}
+	require.Equal(t, []string{"store1", "store3"}, orphans)
+
+	pruned, err := PruneOrphanStores(db)
+	require.NoError(t, err)
+	require.Equal(t, []string{"store1", "store3"}, pruned)
+	usages, err = GetStoresUsage(db)
+	require.NoError(t, err)
+	require.Len(t, usages, 2)
+
+	store = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, store.LoadLatestVersion())
+	require.Equal(t, v2, store.GetKVStore(testStoreKey1).Get(k1))
+}
+
+func TestScheduleStoreUpgradesListeners(t *testing.T) {
+	store := newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	store.AddListeners([]types.StoreKey{testStoreKey1})
+	require.NoError(t, store.LoadLatestVersion())
+	store.GetKVStore(testStoreKey1).Set([]byte("key"), []byte("value"))
+	store.Commit()
+	store.PopStateCache()
+
+	require.NoError(t, store.ScheduleStoreUpgrades(2, &types.StoreUpgrades{
+		Renamed: []types.StoreRename{{OldKey: "store1", NewKey: "renamed1"}},
+	}))
+	store.Commit()
+
+	// the copy of the data is streamed under the new name
+	require.Equal(t, []*types.StoreKVPair{{
+		StoreKey: "renamed1",
+		Key:      []byte("key"),
+		Value:    []byte("value"),
+	}}, store.PopStateCache())
+
+	// as the writes through the old key
+	require.True(t, store.ListeningEnabled(testStoreKey1))
+	store.GetKVStore(testStoreKey1).Set([]byte("key"), []byte("value2"))
+	require.Equal(t, []*types.StoreKVPair{{
+		StoreKey: "renamed1",
+		Key:      []byte("key"),
+		Value:    []byte("value2"),
+	}}, store.PopStateCache())
+}
+
+func TestScheduleStoreUpgradesRenamedStoreSize(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the rename of a large store in short mode")
+	}
+
+	// a store of the maximum size, in the order of the size of the balances of
+	// a live chain
+	entries := maxRenamedStoreEntries
+
+	store := newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, store.LoadLatestVersion())
+	kv := store.GetKVStore(testStoreKey1)
+	for i := 0; i < entries; i++ {
+		kv.Set([]byte(fmt.Sprintf("balances/%08d", i)), []byte(fmt.Sprintf("%d", i)))
+	}
+	store.Commit()
+
+	// the stores above the limit can not be renamed
+	defer func(limit int) { maxRenamedStoreEntries = limit }(maxRenamedStoreEntries)
+	maxRenamedStoreEntries = entries - 1
+	upgrades := &types.StoreUpgrades{Renamed: []types.StoreRename{{OldKey: "store1", NewKey: "renamed1"}}}
+	require.ErrorContains(t, store.ScheduleStoreUpgrades(2, upgrades), "entries, it can not be renamed online")
+
+	maxRenamedStoreEntries = entries
+	require.NoError(t, store.ScheduleStoreUpgrades(2, upgrades))
+
+	start := time.Now()
+	store.Commit()
+	t.Logf("renamed a store of %d entries in %s", entries, time.Since(start))
+
+	kv = store.GetKVStore(testStoreKey1)
+	require.Equal(t, []byte("0"), kv.Get([]byte("balances/00000000")))
+	require.Equal(t, []byte(fmt.Sprintf("%d", entries-1)), kv.Get([]byte(fmt.Sprintf("balances/%08d", entries-1))))
+}
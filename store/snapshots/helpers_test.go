@@ -13,6 +13,7 @@ import (
 
 	db "github.com/cosmos/cosmos-db"
 	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/require"
 
 	errorsmod "cosmossdk.io/errors"
@@ -106,6 +107,45 @@ func snapshotItems(items [][]byte, ext snapshottypes.ExtensionSnapshotter) [][]b
 	return chunks
 }
 
+// zstdSnapshotItems serialize a array of bytes as SnapshotItem_ExtensionPayload in the FormatZstd
+// section of the multistore, followed by the section of the extension, and return the chunks.
+func zstdSnapshotItems(items [][]byte, ext snapshottypes.ExtensionSnapshotter) [][]byte {
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	if err != nil {
+		panic(err)
+	}
+	defer encoder.Close()
+
+	var chunks [][]byte
+	emit := func(chunk []byte) error {
+		chunks = append(chunks, chunk)
+		return nil
+	}
+
+	chunkWriter := snapshots.NewZstdChunkWriter(encoder, emit)
+	for _, item := range items {
+		_ = snapshottypes.WriteExtensionPayload(chunkWriter, item)
+	}
+	_ = chunkWriter.Close()
+
+	chunkWriter = snapshots.NewZstdChunkWriter(encoder, emit)
+	// write extension metadata
+	_ = chunkWriter.WriteMsg(&snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Extension{
+			Extension: &snapshottypes.SnapshotExtensionMeta{
+				Name:   ext.SnapshotName(),
+				Format: ext.SnapshotFormat(),
+			},
+		},
+	})
+	_ = ext.SnapshotExtension(0, func(payload []byte) error {
+		return snapshottypes.WriteExtensionPayload(chunkWriter, payload)
+	})
+	_ = chunkWriter.Close()
+
+	return chunks
+}
+
 type mockSnapshotter struct {
 	items            [][]byte
 	prunedHeights    map[int64]struct{}
@@ -8,8 +8,15 @@ import (
 	"io"
 	"math"
 	"os"
+	"path/filepath"
+	"runtime"
 	"sort"
+	"strconv"
 	"sync"
+	"sync/atomic"
+
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/klauspost/compress/zstd"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -38,6 +45,8 @@ type Manager struct {
 	// multistore is the store from which snapshots are taken.
 	multistore types.Snapshotter
 	logger     log.Logger
+	// progress, if set, is notified of the chunks processed by snapshot and restore operations.
+	progress ProgressFunc
 
 	mtx               sync.Mutex
 	operation         operation
@@ -47,6 +56,10 @@ type Manager struct {
 	restoreChunkIndex uint32
 }
 
+// ProgressFunc is notified of the number of chunks processed by a snapshot or restore
+// operation. The total is 0 when it is not known yet, i.e. while a snapshot is being created.
+type ProgressFunc func(done, total uint32)
+
 // operation represents a Manager operation. Only one operation can be in progress at a time.
 type operation string
 
@@ -102,6 +115,11 @@ func (m *Manager) RegisterExtensions(extensions ...types.ExtensionSnapshotter) e
 	return nil
 }
 
+// SetProgressFunc sets the function notified of the progress of the snapshot and restore operations.
+func (m *Manager) SetProgressFunc(progress ProgressFunc) {
+	m.progress = progress
+}
+
 // begin starts an operation, or errors if one is in progress. It manages the mutex itself.
 func (m *Manager) begin(op operation) error {
 	m.mtx.Lock()
@@ -185,49 +203,229 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 	ch := make(chan io.ReadCloser)
 	go m.createSnapshot(height, ch)
 
-	return m.store.Save(height, types.CurrentFormat, ch)
+	return m.store.Save(height, types.CurrentFormat, m.trackProgress(ch, 0, 0))
+}
+
+// snapshotSection writes a section of a snapshot. Sections are chunked independently, so
+// that they can be produced in parallel while the chunks stay the same on every node.
+type snapshotSection func(protoWriter protoio.Writer) error
+
+// sectionResult is the outcome of the production of a section: the paths of its chunks.
+type sectionResult struct {
+	chunks []string
+	err    error
 }
 
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
 // the produced chunks are written to the channel.
+//
+// Every store of the multistore, if it is a types.ParallelSnapshotter, and the extensions make
+// a section. Sections are compressed into temporary chunk files concurrently, and their chunks
+// are passed in order to the channel.
 func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
-	streamWriter := NewStreamWriter(ch)
-	if streamWriter == nil {
+	defer close(ch)
+
+	sections, err := m.snapshotSections(height)
+	if err != nil {
+		sendChunkError(ch, err)
+		return
+	}
+
+	concurrency := runtime.GOMAXPROCS(0)
+	encoder, err := newZstdEncoder(concurrency)
+	if err != nil {
+		sendChunkError(ch, errorsmod.Wrap(err, "zstd failure"))
+		return
+	}
+	defer encoder.Close()
+
+	dir, err := os.MkdirTemp(m.store.dir, "tmp-"+strconv.FormatUint(height, 10)+"-")
+	if err != nil {
+		sendChunkError(ch, errorsmod.Wrap(err, "failed to create temporary snapshot directory"))
 		return
 	}
-	defer func() {
-		if err := streamWriter.Close(); err != nil {
-			streamWriter.CloseWithError(err)
+	defer os.RemoveAll(dir)
+
+	// produce the sections in order, at most concurrency at once
+	var aborted atomic.Bool
+	results := make([]chan sectionResult, len(sections))
+	for i := range results {
+		results[i] = make(chan sectionResult, 1)
+	}
+	go func() {
+		sem := make(chan struct{}, concurrency)
+		for i, section := range sections {
+			sem <- struct{}{}
+			if aborted.Load() {
+				<-sem
+				results[i] <- sectionResult{err: errAborted}
+				continue
+			}
+			go func(i int, section snapshotSection) {
+				defer func() { <-sem }()
+				chunks, err := writeSnapshotSection(dir, i, encoder, section)
+				results[i] <- sectionResult{chunks: chunks, err: err}
+			}(i, section)
 		}
 	}()
 
-	if err := m.multistore.Snapshot(height, streamWriter); err != nil {
-		streamWriter.CloseWithError(err)
-		return
+	var wg sync.WaitGroup
+	for i := range sections {
+		if err := sendSectionChunks(ch, <-results[i], &wg); err != nil {
+			aborted.Store(true)
+			sendChunkError(ch, err)
+			// wait for the sections in flight before removing their files
+			for _, result := range results[i+1:] {
+				<-result
+			}
+			break
+		}
 	}
-	for _, name := range m.sortedExtensionNames() {
-		extension := m.extensions[name]
-		// write extension metadata
-		err := streamWriter.WriteMsg(&types.SnapshotItem{
-			Item: &types.SnapshotItem_Extension{
-				Extension: &types.SnapshotExtensionMeta{
-					Name:   name,
-					Format: extension.SnapshotFormat(),
-				},
-			},
-		})
+	// the chunks must be closed before their directory is removed
+	wg.Wait()
+}
+
+// errAborted is the error of the snapshot sections skipped after a section failed.
+var errAborted = errors.New("snapshot aborted")
+
+// snapshotSections returns the sections of the snapshot at the given height.
+func (m *Manager) snapshotSections(height uint64) ([]snapshotSection, error) {
+	var sections []snapshotSection
+	if multistore, ok := m.multistore.(types.ParallelSnapshotter); ok {
+		stores, err := multistore.SnapshotStores(height)
 		if err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return nil, err
 		}
-		payloadWriter := func(payload []byte) error {
-			return types.WriteExtensionPayload(streamWriter, payload)
+		for _, store := range stores {
+			sections = append(sections, store)
 		}
-		if err := extension.SnapshotExtension(height, payloadWriter); err != nil {
-			streamWriter.CloseWithError(err)
-			return
+	} else {
+		sections = append(sections, func(protoWriter protoio.Writer) error {
+			return m.multistore.Snapshot(height, protoWriter)
+		})
+	}
+
+	return append(sections, func(protoWriter protoio.Writer) error {
+		for _, name := range m.sortedExtensionNames() {
+			extension := m.extensions[name]
+			// write extension metadata
+			err := protoWriter.WriteMsg(&types.SnapshotItem{
+				Item: &types.SnapshotItem_Extension{
+					Extension: &types.SnapshotExtensionMeta{
+						Name:   name,
+						Format: extension.SnapshotFormat(),
+					},
+				},
+			})
+			if err != nil {
+				return err
+			}
+			payloadWriter := func(payload []byte) error {
+				return types.WriteExtensionPayload(protoWriter, payload)
+			}
+			if err := extension.SnapshotExtension(height, payloadWriter); err != nil {
+				return err
+			}
 		}
+		return nil
+	}), nil
+}
+
+// writeSnapshotSection writes the chunks of a section into files of the given directory,
+// returning their paths.
+func writeSnapshotSection(dir string, index int, encoder *zstd.Encoder, section snapshotSection) ([]string, error) {
+	var chunks []string
+	chunkWriter := NewZstdChunkWriter(encoder, func(chunk []byte) error {
+		path := filepath.Join(dir, fmt.Sprintf("%d-%d", index, len(chunks)))
+		if err := os.WriteFile(path, chunk, 0o600); err != nil {
+			return errorsmod.Wrapf(err, "failed to write temporary snapshot chunk %q", path)
+		}
+		chunks = append(chunks, path)
+		return nil
+	})
+	if err := section(chunkWriter); err != nil {
+		return chunks, err
 	}
+	return chunks, chunkWriter.Close()
+}
+
+// sendSectionChunks passes the chunks of a produced section to the channel.
+func sendSectionChunks(ch chan<- io.ReadCloser, result sectionResult, wg *sync.WaitGroup) error {
+	if result.err != nil {
+		return result.err
+	}
+	for _, path := range result.chunks {
+		file, err := os.Open(path)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to open temporary snapshot chunk %q", path)
+		}
+		wg.Add(1)
+		ch <- &tempChunk{File: file, done: wg.Done}
+	}
+	return nil
+}
+
+// tempChunk is a chunk read from a temporary file, which is removed once the chunk is closed.
+type tempChunk struct {
+	*os.File
+	once sync.Once
+	done func()
+}
+
+// Close implements io.Closer interface
+func (c *tempChunk) Close() error {
+	err := os.ErrClosed
+	c.once.Do(func() {
+		err = c.File.Close()
+		_ = os.Remove(c.File.Name())
+		c.done()
+	})
+	return err
+}
+
+// sendChunkError passes an error to the consumer of the chunks, as a chunk failing to be read.
+func sendChunkError(ch chan<- io.ReadCloser, err error) {
+	pr, pw := io.Pipe()
+	_ = pw.CloseWithError(err)
+	ch <- pr
+}
+
+// progressChunk is a chunk notifying the progress of the operation once it is closed.
+type progressChunk struct {
+	io.ReadCloser
+	once sync.Once
+	done func()
+}
+
+// Close implements io.Closer interface
+func (c *progressChunk) Close() error {
+	err := c.ReadCloser.Close()
+	c.once.Do(c.done)
+	return err
+}
+
+// trackProgress returns the given chunks, notifying the progress function as they are
+// processed, if any. The counting starts from the given number of chunks already processed.
+func (m *Manager) trackProgress(chunks <-chan io.ReadCloser, done, total uint32) <-chan io.ReadCloser {
+	if m.progress == nil {
+		return chunks
+	}
+
+	progress := m.progress
+	tracked := make(chan io.ReadCloser)
+	go func() {
+		defer close(tracked)
+		var mtx sync.Mutex
+		for chunk := range chunks {
+			tracked <- &progressChunk{ReadCloser: chunk, done: func() {
+				mtx.Lock()
+				defer mtx.Unlock()
+				done++
+				progress(done, total)
+			}}
+		}
+	}()
+	return tracked
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
@@ -275,7 +473,7 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	if !types.IsSupportedFormat(snapshot.Format) {
 		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
 	if snapshot.Height == 0 {
@@ -300,10 +498,19 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 		return errorsmod.Wrapf(err, "failed to create snapshot directory %q", dir)
 	}
 
-	chChunks := m.loadChunkStream(snapshot.Height, snapshot.Format, chChunkIDs)
+	// resume an interrupted restoration of the same snapshot, its chunks are fed
+	// again but the ones already restored are skipped
+	resumeFrom, err := m.restoreProgress(snapshot)
+	if err != nil {
+		m.endLocked()
+		return err
+	}
+
+	chChunks := m.loadChunkStream(snapshot.Height, snapshot.Format, chChunkIDs, resumeFrom)
+	chChunks = m.trackProgress(chChunks, resumeFrom, snapshot.Chunks)
 
 	go func() {
-		err := m.doRestoreSnapshot(snapshot, chChunks)
+		err := m.doRestoreSnapshot(snapshot, chChunks, resumeFrom)
 		chDone <- restoreDone{
 			complete: err == nil,
 			err:      err,
@@ -318,12 +525,32 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	return nil
 }
 
-func (m *Manager) loadChunkStream(height uint64, format uint32, chunkIDs <-chan uint32) <-chan io.ReadCloser {
+// restoreProgress returns the first chunk to restore of the snapshot, after the ones restored by
+// an interrupted restoration.
+func (m *Manager) restoreProgress(snapshot types.Snapshot) (uint32, error) {
+	if snapshot.Format != types.FormatZstd {
+		return 0, nil
+	}
+	resumeFrom, err := m.store.getRestoreProgress(&snapshot)
+	if err != nil {
+		return 0, err
+	}
+	if resumeFrom > 0 {
+		m.logger.Info("resuming snapshot restoration", "height", snapshot.Height, "format", snapshot.Format, "chunk", resumeFrom)
+	}
+	return resumeFrom, nil
+}
+
+// loadChunkStream loads the chunks of the given IDs, skipping the ones before resumeFrom.
+func (m *Manager) loadChunkStream(height uint64, format uint32, chunkIDs <-chan uint32, resumeFrom uint32) <-chan io.ReadCloser {
 	chunks := make(chan io.ReadCloser, chunkBufferSize)
 	go func() {
 		defer close(chunks)
 
 		for chunkID := range chunkIDs {
+			if chunkID < resumeFrom {
+				continue
+			}
 			chunk, err := m.store.loadChunkFile(height, format, chunkID)
 			if err != nil {
 				m.logger.Error("load chunk file failed", "height", height, "format", format, "chunk", chunkID, "err", err)
@@ -337,16 +564,32 @@ func (m *Manager) loadChunkStream(height uint64, format uint32, chunkIDs <-chan
 }
 
 // doRestoreSnapshot do the heavy work of snapshot restoration after preliminary checks on request have passed.
-func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
+// The chunks start at the given index, which is not 0 when an interrupted restoration is resumed.
+func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser, resumeFrom uint32) error {
 	dir := m.store.pathSnapshot(snapshot.Height, snapshot.Format)
 	if err := os.MkdirAll(dir, 0o750); err != nil {
 		return errorsmod.Wrapf(err, "failed to create snapshot directory %q", dir)
 	}
 
 	var nextItem types.SnapshotItem
-	streamReader, err := NewStreamReader(chChunks)
-	if err != nil {
-		return err
+	var streamReader protoio.ReadCloser
+	switch snapshot.Format {
+	case types.FormatZstd:
+		// record the progress of the restoration each time a section of the
+		// snapshot is started, the chunks before it are fully restored
+		reader, err := NewZstdStreamReader(chChunks, resumeFrom, func(chunk uint32) error {
+			return m.store.saveRestoreProgress(&snapshot, chunk)
+		})
+		if err != nil {
+			return err
+		}
+		streamReader = reader
+	default:
+		reader, err := NewStreamReader(chChunks)
+		if err != nil {
+			return err
+		}
+		streamReader = reader
 	}
 	defer streamReader.Close()
 
@@ -363,7 +606,7 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return payload.Payload, nil
 	}
 
-	nextItem, err = m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	nextItem, err := m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
 	if err != nil {
 		return errorsmod.Wrap(err, "multistore restore")
 	}
@@ -393,7 +636,7 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 			return errorsmod.Wrapf(err, "extension %s don't exhausted payload stream", metadata.Name)
 		}
 	}
-	return nil
+	return m.store.deleteRestoreProgress(snapshot.Height, snapshot.Format)
 }
 
 // RestoreChunk adds a chunk to an active snapshot restoration, mirroring ABCI ApplySnapshotChunk.
@@ -460,9 +703,10 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	return false, nil
 }
 
-// RestoreLocalSnapshot restores app state from a local snapshot.
+// RestoreLocalSnapshot restores app state from a local snapshot. An interrupted restoration of
+// the snapshot is resumed.
 func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
-	snapshot, ch, err := m.store.Load(height, format)
+	snapshot, err := m.store.Get(height, format)
 	if err != nil {
 		return err
 	}
@@ -480,7 +724,13 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 	}
 	defer m.endLocked()
 
-	return m.doRestoreSnapshot(*snapshot, ch)
+	resumeFrom, err := m.restoreProgress(*snapshot)
+	if err != nil {
+		return err
+	}
+	ch := m.trackProgress(m.store.loadChunks(snapshot, resumeFrom), resumeFrom, snapshot.Chunks)
+
+	return m.doRestoreSnapshot(*snapshot, ch, resumeFrom)
 }
 
 // sortedExtensionNames sort extension names for deterministic iteration.
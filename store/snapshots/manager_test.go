@@ -73,7 +73,7 @@ func TestManager_Take(t *testing.T) {
 	}
 	extSnapshotter := newExtSnapshotter(10)
 
-	expectChunks := snapshotItems(items, extSnapshotter)
+	expectChunks := zstdSnapshotItems(items, extSnapshotter)
 	manager := snapshots.NewManager(store, opts, snapshotter, nil, log.NewNopLogger())
 	err := manager.RegisterExtensions(extSnapshotter)
 	require.NoError(t, err)
@@ -97,8 +97,8 @@ func TestManager_Take(t *testing.T) {
 	assert.Equal(t, &types.Snapshot{
 		Height: 5,
 		Format: snapshotter.SnapshotFormat(),
-		Chunks: 1,
-		Hash:   []uint8{0xc5, 0xf7, 0xfe, 0xea, 0xd3, 0x4d, 0x3e, 0x87, 0xff, 0x41, 0xa2, 0x27, 0xfa, 0xcb, 0x38, 0x17, 0xa, 0x5, 0xeb, 0x27, 0x4e, 0x16, 0x5e, 0xf3, 0xb2, 0x8b, 0x47, 0xd1, 0xe6, 0x94, 0x7e, 0x8b},
+		Chunks: 2,
+		Hash:   hash(expectChunks),
 		Metadata: types.Metadata{
 			ChunkHashes: checksums(expectChunks),
 		},
@@ -165,13 +165,13 @@ func TestManager_Restore(t *testing.T) {
 	require.ErrorIs(t, err, types.ErrUnknownFormat)
 
 	// Restore errors on no chunks
-	err = manager.Restore(types.Snapshot{Height: 3, Format: types.CurrentFormat, Hash: []byte{1, 2, 3}})
+	err = manager.Restore(types.Snapshot{Height: 3, Format: types.FormatZlib, Hash: []byte{1, 2, 3}})
 	require.Error(t, err)
 
 	// Restore errors on chunk and chunkhashes mismatch
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
-		Format:   types.CurrentFormat,
+		Format:   types.FormatZlib,
 		Hash:     []byte{1, 2, 3},
 		Chunks:   4,
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
@@ -181,7 +181,7 @@ func TestManager_Restore(t *testing.T) {
 	// Starting a restore works
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
-		Format:   types.CurrentFormat,
+		Format:   types.FormatZlib,
 		Hash:     []byte{1, 2, 3},
 		Chunks:   1,
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
@@ -221,12 +221,12 @@ func TestManager_Restore(t *testing.T) {
 	require.NoError(t, err)
 	snapshot := snapshots[0]
 	require.Equal(t, uint64(3), snapshot.Height)
-	require.Equal(t, types.CurrentFormat, snapshot.Format)
+	require.Equal(t, types.FormatZlib, snapshot.Format)
 
 	// Starting a new restore should fail now, because the target already has contents.
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
-		Format:   types.CurrentFormat,
+		Format:   types.FormatZlib,
 		Hash:     []byte{1, 2, 3},
 		Chunks:   3,
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
@@ -239,7 +239,7 @@ func TestManager_Restore(t *testing.T) {
 	target.items = nil
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
-		Format:   types.CurrentFormat,
+		Format:   types.FormatZlib,
 		Hash:     []byte{1, 2, 3},
 		Chunks:   1,
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
@@ -256,3 +256,58 @@ func TestManager_TakeError(t *testing.T) {
 	_, err = manager.Create(1)
 	require.Error(t, err)
 }
+
+func TestManager_Progress(t *testing.T) {
+	items := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+	type progress struct{ done, total uint32 }
+
+	// the chunks of a snapshot being created are counted, their total is not known yet
+	var created []progress
+	snapshotter := &mockSnapshotter{
+		items:         items,
+		prunedHeights: make(map[int64]struct{}),
+	}
+	manager := snapshots.NewManager(setupStore(t), opts, snapshotter, nil, log.NewNopLogger())
+	require.NoError(t, manager.RegisterExtensions(newExtSnapshotter(10)))
+	manager.SetProgressFunc(func(done, total uint32) {
+		created = append(created, progress{done, total})
+	})
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, snapshot.Chunks)
+	assert.Equal(t, []progress{{1, 0}, {2, 0}}, created)
+
+	// the chunks of a snapshot being restored are counted against their total
+	var restored []progress
+	target := &mockSnapshotter{
+		prunedHeights: make(map[int64]struct{}),
+	}
+	extSnapshotter := newExtSnapshotter(0)
+	manager = snapshots.NewManager(setupStore(t), opts, target, nil, log.NewNopLogger())
+	require.NoError(t, manager.RegisterExtensions(extSnapshotter))
+	manager.SetProgressFunc(func(done, total uint32) {
+		restored = append(restored, progress{done, total})
+	})
+
+	chunks := zstdSnapshotItems(items, newExtSnapshotter(10))
+	err = manager.Restore(types.Snapshot{
+		Height:   3,
+		Format:   types.FormatZstd,
+		Hash:     hash(chunks),
+		Chunks:   uint32(len(chunks)),
+		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
+	})
+	require.NoError(t, err)
+	for _, chunk := range chunks {
+		_, err := manager.RestoreChunk(chunk)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, items, target.items)
+	assert.Equal(t, 10, len(extSnapshotter.state))
+	assert.Equal(t, []progress{{1, 2}, {2, 2}}, restored)
+}
@@ -1,6 +1,7 @@
 package snapshots
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"hash"
@@ -22,6 +23,8 @@ import (
 const (
 	// keyPrefixSnapshot is the prefix for snapshot database keys
 	keyPrefixSnapshot byte = 0x01
+	// keyPrefixRestoreProgress is the prefix for the progress of snapshot restorations
+	keyPrefixRestoreProgress byte = 0x02
 )
 
 // Store is a snapshot store, containing snapshot metadata and binary chunks.
@@ -64,6 +67,9 @@ func (s *Store) Delete(height uint64, format uint32) error {
 		return errors.Wrapf(err, "failed to delete snapshot for height %v format %v",
 			height, format)
 	}
+	if err := s.deleteRestoreProgress(height, format); err != nil {
+		return err
+	}
 	err = os.RemoveAll(s.pathSnapshot(height, format))
 	return errors.Wrapf(err, "failed to delete snapshot chunks for height %v format %v",
 		height, format)
@@ -139,10 +145,17 @@ func (s *Store) Load(height uint64, format uint32) (*types.Snapshot, <-chan io.R
 		return nil, nil, err
 	}
 
+	return snapshot, s.loadChunks(snapshot, 0), nil
+}
+
+// loadChunks loads the binary chunks of a snapshot, starting at the given one. The chunks must be
+// consumed and closed.
+func (s *Store) loadChunks(snapshot *types.Snapshot, from uint32) <-chan io.ReadCloser {
+	height, format := snapshot.Height, snapshot.Format
 	ch := make(chan io.ReadCloser)
 	go func() {
 		defer close(ch)
-		for i := uint32(0); i < snapshot.Chunks; i++ {
+		for i := from; i < snapshot.Chunks; i++ {
 			pr, pw := io.Pipe()
 			ch <- pr
 			chunk, err := s.loadChunkFile(height, format, i)
@@ -161,7 +174,7 @@ func (s *Store) Load(height uint64, format uint32) (*types.Snapshot, <-chan io.R
 		}
 	}()
 
-	return snapshot, ch, nil
+	return ch
 }
 
 // LoadChunk loads a chunk from disk, or returns nil if it does not exist. The caller must call
@@ -331,6 +344,40 @@ func (s *Store) saveSnapshot(snapshot *types.Snapshot) error {
 	return errors.Wrap(err, "failed to store snapshot")
 }
 
+// getRestoreProgress returns the index of the first chunk not restored yet by an interrupted
+// restoration of the snapshot, or 0 if there is none.
+func (s *Store) getRestoreProgress(snapshot *types.Snapshot) (uint32, error) {
+	bz, err := s.db.Get(encodeRestoreProgressKey(snapshot.Height, snapshot.Format))
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to fetch restore progress for height %v format %v",
+			snapshot.Height, snapshot.Format)
+	}
+	if len(bz) < 4 {
+		return 0, nil
+	}
+	// the progress of another snapshot of the same height and format does not apply
+	if !bytes.Equal(bz[4:], snapshot.Hash) {
+		return 0, nil
+	}
+	return binary.BigEndian.Uint32(bz[:4]), nil
+}
+
+// saveRestoreProgress records that the chunks of the snapshot before the given one are restored.
+func (s *Store) saveRestoreProgress(snapshot *types.Snapshot, chunk uint32) error {
+	bz := make([]byte, 4, 4+len(snapshot.Hash))
+	binary.BigEndian.PutUint32(bz, chunk)
+	bz = append(bz, snapshot.Hash...)
+	err := s.db.SetSync(encodeRestoreProgressKey(snapshot.Height, snapshot.Format), bz)
+	return errors.Wrap(err, "failed to store restore progress")
+}
+
+// deleteRestoreProgress deletes the progress of the restoration of a snapshot.
+func (s *Store) deleteRestoreProgress(height uint64, format uint32) error {
+	err := s.db.DeleteSync(encodeRestoreProgressKey(height, format))
+	return errors.Wrapf(err, "failed to delete restore progress for height %v format %v",
+		height, format)
+}
+
 // pathHeight generates the path to a height, containing multiple snapshot formats.
 func (s *Store) pathHeight(height uint64) string {
 	return filepath.Join(s.dir, strconv.FormatUint(height, 10))
@@ -367,3 +414,10 @@ func encodeKey(height uint64, format uint32) []byte {
 	binary.BigEndian.PutUint32(k[9:], format)
 	return k
 }
+
+// encodeRestoreProgressKey encodes a restore progress key.
+func encodeRestoreProgressKey(height uint64, format uint32) []byte {
+	k := encodeKey(height, format)
+	k[0] = keyPrefixRestoreProgress
+	return k
+}
@@ -2,13 +2,17 @@ package snapshots
 
 import (
 	"bufio"
+	"bytes"
 	"compress/zlib"
+	"encoding/binary"
 	"io"
 
 	protoio "github.com/cosmos/gogoproto/io"
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/klauspost/compress/zstd"
 
 	"cosmossdk.io/errors"
+	"cosmossdk.io/store/snapshots/types"
 )
 
 const (
@@ -17,6 +21,11 @@ const (
 	snapshotBufferSize = int(snapshotChunkSize)
 	// Do not change compression level without new snapshot format (must be uniform across nodes)
 	snapshotCompressionLevel = 7
+	// Do not change zstd compression level without new snapshot format (must be uniform across nodes)
+	snapshotZstdLevel = zstd.SpeedDefault
+	// snapshotZstdMaxChunkSize is the maximum decompressed size of a FormatZstd chunk, which
+	// holds up to snapshotChunkSize bytes of items plus the item crossing that size.
+	snapshotZstdMaxChunkSize = snapshotChunkSize + uint64(snapshotMaxItemSize) + binary.MaxVarintLen64
 )
 
 // StreamWriter set up a stream pipeline to serialize snapshot nodes:
@@ -111,3 +120,165 @@ func (sr *StreamReader) Close() error {
 	}
 	return err
 }
+
+// newZstdEncoder returns the encoder of the FormatZstd chunks, which can compress up to
+// concurrency chunks at once.
+func newZstdEncoder(concurrency int) (*zstd.Encoder, error) {
+	return zstd.NewWriter(nil, zstd.WithEncoderLevel(snapshotZstdLevel), zstd.WithEncoderConcurrency(concurrency))
+}
+
+// ZstdChunkWriter serializes snapshot items into FormatZstd chunks:
+// Exported Items -> delimited Protobuf -> buffer -> zstd frame -> emitted chunk
+//
+// A chunk is emitted once the buffered items reach the chunk size, so that every
+// chunk holds whole items and can be decoded on its own.
+type ZstdChunkWriter struct {
+	encoder     *zstd.Encoder
+	emit        func(chunk []byte) error
+	buf         bytes.Buffer
+	protoWriter protoio.Writer
+}
+
+// NewZstdChunkWriter returns a writer passing the chunks it produces to emit.
+func NewZstdChunkWriter(encoder *zstd.Encoder, emit func(chunk []byte) error) *ZstdChunkWriter {
+	w := &ZstdChunkWriter{
+		encoder: encoder,
+		emit:    emit,
+	}
+	w.protoWriter = protoio.NewDelimitedWriter(&w.buf)
+	return w
+}
+
+// WriteMsg implements protoio.Writer interface
+func (w *ZstdChunkWriter) WriteMsg(msg proto.Message) error {
+	if err := w.protoWriter.WriteMsg(msg); err != nil {
+		return err
+	}
+	if uint64(w.buf.Len()) >= snapshotChunkSize {
+		return w.flush()
+	}
+	return nil
+}
+
+// Close emits the buffered items, if any, as the last chunk.
+func (w *ZstdChunkWriter) Close() error {
+	return w.flush()
+}
+
+func (w *ZstdChunkWriter) flush() error {
+	if w.buf.Len() == 0 {
+		return nil
+	}
+	chunk := w.encoder.EncodeAll(w.buf.Bytes(), nil)
+	w.buf.Reset()
+	return w.emit(chunk)
+}
+
+// ZstdStreamReader reads the snapshot items of FormatZstd chunks:
+// chan io.ReadCloser -> zstd frame -> delimited Protobuf -> ExportNode
+//
+// As the chunks are independent, the stream can start at any chunk starting a
+// new store. The section callback is notified when the items of all the chunks
+// before a chunk starting a store or the extensions have been consumed, that is
+// when the caller asks for the item following the first one of that chunk.
+type ZstdStreamReader struct {
+	chunks  <-chan io.ReadCloser
+	decoder *zstd.Decoder
+	section func(chunk uint32) error
+
+	next         uint32 // index of the next chunk
+	current      uint32 // index of the chunk being read
+	first        bool   // whether the next item is the first one of the current chunk
+	reader       protoio.Reader
+	pendingStart *uint32
+}
+
+// NewZstdStreamReader returns a reader of the given chunks, the first of which has the given
+// index. The section callback may be nil.
+func NewZstdStreamReader(chunks <-chan io.ReadCloser, firstChunk uint32, section func(chunk uint32) error) (*ZstdStreamReader, error) {
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(snapshotZstdMaxChunkSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "zstd failure")
+	}
+	return &ZstdStreamReader{
+		chunks:  chunks,
+		decoder: decoder,
+		section: section,
+		next:    firstChunk,
+	}, nil
+}
+
+// ReadMsg implements protoio.Reader interface
+func (r *ZstdStreamReader) ReadMsg(msg proto.Message) error {
+	if r.pendingStart != nil {
+		start := *r.pendingStart
+		r.pendingStart = nil
+		if r.section != nil {
+			if err := r.section(start); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		if r.reader == nil {
+			if err := r.nextChunk(); err != nil {
+				return err
+			}
+		}
+
+		err := r.reader.ReadMsg(msg)
+		if err == io.EOF {
+			if r.first {
+				return errors.Wrapf(types.ErrInvalidMetadata, "snapshot chunk %d is empty", r.current)
+			}
+			r.reader = nil
+			continue
+		}
+		if err != nil {
+			return errors.Wrapf(err, "snapshot chunk %d", r.current)
+		}
+
+		if r.first {
+			r.first = false
+			if item, ok := msg.(*types.SnapshotItem); ok && (item.GetStore() != nil || item.GetExtension() != nil) {
+				start := r.current
+				r.pendingStart = &start
+			}
+		}
+		return nil
+	}
+}
+
+// nextChunk decodes the next chunk.
+func (r *ZstdStreamReader) nextChunk() error {
+	chunk, ok := <-r.chunks
+	if !ok {
+		return io.EOF
+	}
+	bz, err := io.ReadAll(chunk)
+	if closeErr := chunk.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	data, err := r.decoder.DecodeAll(bz, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decode snapshot chunk %d", r.next)
+	}
+
+	r.reader = protoio.NewDelimitedReader(bytes.NewReader(data), snapshotMaxItemSize)
+	r.current = r.next
+	r.next++
+	r.first = true
+	return nil
+}
+
+// Close implements io.Closer interface
+func (r *ZstdStreamReader) Close() error {
+	r.decoder.Close()
+	DrainChunks(r.chunks)
+	return nil
+}
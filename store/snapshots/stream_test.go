@@ -0,0 +1,112 @@
+package snapshots_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+)
+
+func storeItem(name string) *snapshottypes.SnapshotItem {
+	return &snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Store{
+			Store: &snapshottypes.SnapshotStoreItem{Name: name},
+		},
+	}
+}
+
+func payloadItem(payload []byte) *snapshottypes.SnapshotItem {
+	return &snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_ExtensionPayload{
+			ExtensionPayload: &snapshottypes.SnapshotExtensionPayload{Payload: payload},
+		},
+	}
+}
+
+func TestZstdStream(t *testing.T) {
+	encoder, err := zstd.NewWriter(nil)
+	require.NoError(t, err)
+	defer encoder.Close()
+
+	var chunks [][]byte
+	emit := func(chunk []byte) error {
+		chunks = append(chunks, chunk)
+		return nil
+	}
+
+	// the first section overflows the 10MB chunk size, the second one does not
+	items := []*snapshottypes.SnapshotItem{
+		storeItem("a"),
+		payloadItem(bytes.Repeat([]byte{1}, 4e6)),
+		payloadItem(bytes.Repeat([]byte{2}, 4e6)),
+		payloadItem(bytes.Repeat([]byte{3}, 4e6)),
+		payloadItem([]byte{4}),
+		storeItem("b"),
+		payloadItem([]byte{5}),
+	}
+	chunkWriter := snapshots.NewZstdChunkWriter(encoder, emit)
+	for _, item := range items[:5] {
+		require.NoError(t, chunkWriter.WriteMsg(item))
+	}
+	require.NoError(t, chunkWriter.Close())
+	chunkWriter = snapshots.NewZstdChunkWriter(encoder, emit)
+	for _, item := range items[5:] {
+		require.NoError(t, chunkWriter.WriteMsg(item))
+	}
+	require.NoError(t, chunkWriter.Close())
+	require.Len(t, chunks, 3)
+
+	readItems := func(reader *snapshots.ZstdStreamReader) []*snapshottypes.SnapshotItem {
+		var read []*snapshottypes.SnapshotItem
+		for {
+			item := &snapshottypes.SnapshotItem{}
+			err := reader.ReadMsg(item)
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			read = append(read, item)
+		}
+		require.NoError(t, reader.Close())
+		return read
+	}
+
+	// the sections are notified once their first item is consumed
+	var sections []uint32
+	reader, err := snapshots.NewZstdStreamReader(makeChunks(chunks), 0, func(chunk uint32) error {
+		sections = append(sections, chunk)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, items, readItems(reader))
+	assert.Equal(t, []uint32{0, 2}, sections)
+
+	// the stream can start at a section
+	sections = nil
+	reader, err = snapshots.NewZstdStreamReader(makeChunks(chunks[2:]), 2, func(chunk uint32) error {
+		sections = append(sections, chunk)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, items[5:], readItems(reader))
+	assert.Equal(t, []uint32{2}, sections)
+
+	// empty chunks are invalid
+	reader, err = snapshots.NewZstdStreamReader(makeChunks([][]byte{encoder.EncodeAll(nil, nil)}), 0, nil)
+	require.NoError(t, err)
+	err = reader.ReadMsg(&snapshottypes.SnapshotItem{})
+	require.ErrorIs(t, err, snapshottypes.ErrInvalidMetadata)
+	require.NoError(t, reader.Close())
+
+	// corrupted chunks fail to be decoded
+	reader, err = snapshots.NewZstdStreamReader(makeChunks([][]byte{{1, 2, 3}}), 0, nil)
+	require.NoError(t, err)
+	require.Error(t, reader.ReadMsg(&snapshottypes.SnapshotItem{}))
+	require.NoError(t, reader.Close())
+}
@@ -1,6 +1,23 @@
 package types
 
+const (
+	// FormatZlib is the snapshot format where the snapshot items are written as a
+	// single zlib stream, split into chunks.
+	FormatZlib uint32 = 3
+
+	// FormatZstd is the snapshot format where every chunk is an independent zstd
+	// frame holding whole snapshot items, and every store starts a new chunk. The
+	// stores can then be written concurrently, and a restore can be resumed from
+	// the first chunk of the store being restored.
+	FormatZstd uint32 = 4
+)
+
 // CurrentFormat is the currently used format for snapshots. Snapshots using the same format
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
-const CurrentFormat uint32 = 3
+const CurrentFormat = FormatZstd
+
+// IsSupportedFormat returns true if snapshots of the given format can be restored.
+func IsSupportedFormat(format uint32) bool {
+	return format == FormatZlib || format == FormatZstd
+}
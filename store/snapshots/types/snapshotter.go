@@ -26,6 +26,16 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// ParallelSnapshotter is implemented by the Snapshotters able to write each of
+// their stores independently, so that the stores of a FormatZstd snapshot are
+// written concurrently.
+type ParallelSnapshotter interface {
+	// SnapshotStores returns the functions writing the snapshot items of each
+	// store, in snapshot order. Writing all of them in that order must be
+	// equivalent to Snapshot, but they may be run concurrently.
+	SnapshotStores(height uint64) ([]func(protoWriter protoio.Writer) error, error)
+}
+
 // ExtensionPayloadReader read extension payloads,
 // it returns io.EOF when reached either end of stream or the extension boundaries.
 type ExtensionPayloadReader = func() ([]byte, error)
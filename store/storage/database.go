@@ -0,0 +1,479 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/types"
+)
+
+const (
+	latestVersionKey   = "s/latest"
+	earliestVersionKey = "s/earliest"
+	storePrefixFmt     = "s/k:"
+
+	versionSize = 8
+
+	// importBatchSize is the number of entries written at once by ImportStore.
+	importBatchSize = 10_000
+
+	valueTombstone byte = 0
+	valueSet       byte = 1
+)
+
+// ErrVersionPruned is returned when reading a version older than the earliest
+// version retained by the state storage.
+var ErrVersionPruned = errors.New("version is pruned from state storage")
+
+// Database is a flat, versioned key-value state storage backed by a dbm.DB, as
+// outlined in ADR-040 and ADR-065. Every write is stored under its key suffixed
+// with the version it was made at, so any retained version of a key is read
+// with a single seek instead of a tree traversal.
+//
+// Keys are laid out as:
+//
+//	s/k:<encoded store name><encoded key><inverted big-endian version> -> <marker><value>
+//
+// where the encoding escapes 0x00 bytes and appends a 0x00 0x01 terminator,
+// which preserves the ordering of the keys and makes them prefix-free. The
+// versions are inverted so that the versions of a key sort from the newest one,
+// the version of a key visible at version v being the first one found seeking
+// to v. The marker byte flags deletions, so that a key deleted at version v can
+// still be read at older versions.
+type Database struct {
+	db dbm.DB
+
+	// earliest caches the earliest version, -1 until loaded.
+	earliest atomic.Int64
+
+	pruneMtx sync.Mutex
+}
+
+// NewDatabase returns a state storage writing to the given database.
+func NewDatabase(db dbm.DB) *Database {
+	d := &Database{db: db}
+	d.earliest.Store(-1)
+	return d
+}
+
+// GetLatestVersion returns the latest version written to the state storage, or
+// 0 if nothing was written yet.
+func (d *Database) GetLatestVersion() (int64, error) {
+	return d.getVersion(latestVersionKey)
+}
+
+// GetEarliestVersion returns the earliest version that can be read from the
+// state storage. It is 0 until the state storage is pruned.
+func (d *Database) GetEarliestVersion() (int64, error) {
+	if earliest := d.earliest.Load(); earliest >= 0 {
+		return earliest, nil
+	}
+
+	earliest, err := d.getVersion(earliestVersionKey)
+	if err != nil {
+		return 0, err
+	}
+	// a concurrent prune may have stored a later version meanwhile
+	d.earliest.CompareAndSwap(-1, earliest)
+	return d.earliest.Load(), nil
+}
+
+// SetLatestVersion sets the latest version of the state storage.
+func (d *Database) SetLatestVersion(version int64) error {
+	return d.db.SetSync([]byte(latestVersionKey), encodeVersion(version))
+}
+
+// HasVersion returns true if the given version can be read from the state
+// storage.
+func (d *Database) HasVersion(version int64) (bool, error) {
+	latest, err := d.GetLatestVersion()
+	if err != nil {
+		return false, err
+	}
+	earliest, err := d.GetEarliestVersion()
+	if err != nil {
+		return false, err
+	}
+	return version > 0 && version >= earliest && version <= latest, nil
+}
+
+// Get returns the value of the key in the given store at the given version, or
+// nil if the key did not exist at that version.
+func (d *Database) Get(storeKey string, version int64, key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, errors.New("key is empty")
+	}
+	if err := d.checkVersion(version); err != nil {
+		return nil, err
+	}
+
+	// the first version of the key at or before the version is the visible one
+	prefix := append(storePrefix(storeKey), encodeKey(key)...)
+	start := append(bytes.Clone(prefix), encodeKeyVersion(version)...)
+	itr, err := d.db.Iterator(start, types.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	if !itr.Valid() {
+		return nil, itr.Error()
+	}
+
+	value := itr.Value()
+	if len(value) == 0 || value[0] == valueTombstone {
+		return nil, nil
+	}
+	return bytes.Clone(value[1:]), nil
+}
+
+// Has returns true if the key existed in the given store at the given version.
+func (d *Database) Has(storeKey string, version int64, key []byte) (bool, error) {
+	value, err := d.Get(storeKey, version, key)
+	return value != nil, err
+}
+
+// Iterator returns an iterator over the domain [start, end) of the given store
+// at the given version, in ascending key order.
+func (d *Database) Iterator(storeKey string, version int64, start, end []byte) (types.Iterator, error) {
+	return d.newIterator(storeKey, version, start, end, false)
+}
+
+// ReverseIterator returns an iterator over the domain [start, end) of the given
+// store at the given version, in descending key order.
+func (d *Database) ReverseIterator(storeKey string, version int64, start, end []byte) (types.Iterator, error) {
+	return d.newIterator(storeKey, version, start, end, true)
+}
+
+func (d *Database) newIterator(storeKey string, version int64, start, end []byte, reverse bool) (types.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, errors.New("key is empty")
+	}
+	if err := d.checkVersion(version); err != nil {
+		return nil, err
+	}
+
+	prefix := storePrefix(storeKey)
+	dbStart := prefix
+	if start != nil {
+		dbStart = append(bytes.Clone(prefix), encodeKey(start)...)
+	}
+	dbEnd := types.PrefixEndBytes(prefix)
+	if end != nil {
+		dbEnd = append(bytes.Clone(prefix), encodeKey(end)...)
+	}
+
+	var (
+		itr dbm.Iterator
+		err error
+	)
+	if reverse {
+		itr, err = d.db.ReverseIterator(dbStart, dbEnd)
+	} else {
+		itr, err = d.db.Iterator(dbStart, dbEnd)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return newVersionedIterator(itr, len(prefix), version, start, end, reverse), nil
+}
+
+// ApplyChangeset writes the given changes at the given version and sets it as
+// the latest version, atomically.
+func (d *Database) ApplyChangeset(version int64, changeset []*types.StoreKVPair) error {
+	batch := d.db.NewBatch()
+	defer batch.Close()
+
+	if err := writeChangeset(batch, version, changeset); err != nil {
+		return err
+	}
+	if err := batch.Set([]byte(latestVersionKey), encodeVersion(version)); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+// Import writes the given changes at the given version, without updating the
+// latest version. It is used to populate the state storage from an existing
+// state, FinalizeImport must be called once the import is complete.
+func (d *Database) Import(version int64, changeset []*types.StoreKVPair) error {
+	batch := d.db.NewBatch()
+	defer batch.Close()
+
+	if err := writeChangeset(batch, version, changeset); err != nil {
+		return err
+	}
+
+	return batch.Write()
+}
+
+// FinalizeImport completes an import at the given version, setting it as both
+// the earliest and the latest version, as the versions before the imported
+// state can not be read.
+func (d *Database) FinalizeImport(version int64) error {
+	batch := d.db.NewBatch()
+	defer batch.Close()
+
+	if err := batch.Set([]byte(earliestVersionKey), encodeVersion(version)); err != nil {
+		return err
+	}
+	if err := batch.Set([]byte(latestVersionKey), encodeVersion(version)); err != nil {
+		return err
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+	d.earliest.Store(version)
+	return nil
+}
+
+// ImportStore writes the content of the given store at the given version, in
+// batches, without updating the latest version. See Import.
+func (d *Database) ImportStore(storeKey string, version int64, store types.KVStore) error {
+	itr := store.Iterator(nil, nil)
+	defer itr.Close()
+
+	batch := make([]*types.StoreKVPair, 0, importBatchSize)
+	for ; itr.Valid(); itr.Next() {
+		batch = append(batch, &types.StoreKVPair{StoreKey: storeKey, Key: itr.Key(), Value: itr.Value()})
+		if len(batch) == importBatchSize {
+			if err := d.Import(version, batch); err != nil {
+				return err
+			}
+			batch = batch[:0]
+		}
+	}
+	if err := itr.Error(); err != nil {
+		return err
+	}
+
+	return d.Import(version, batch)
+}
+
+// Prune removes the versions of the keys which are not needed to read any
+// version greater than or equal to the given one. Versions older than it can
+// not be read anymore once Prune is called.
+func (d *Database) Prune(version int64) error {
+	d.pruneMtx.Lock()
+	defer d.pruneMtx.Unlock()
+
+	earliest, err := d.GetEarliestVersion()
+	if err != nil {
+		return err
+	}
+	if version <= earliest {
+		return nil
+	}
+
+	// mark the versions as pruned first, so that no reader observes a
+	// partially pruned version
+	if err := d.db.SetSync([]byte(earliestVersionKey), encodeVersion(version)); err != nil {
+		return err
+	}
+	d.earliest.Store(version)
+
+	return d.deleteEntries(func(entries []entry) []entry {
+		// keep the last version at or below the pruning height, which is the one
+		// visible at that height, unless it is a deletion
+		visible := -1
+		for i, e := range entries {
+			if e.version > version {
+				break
+			}
+			visible = i
+		}
+		if visible >= 0 && !entries[visible].tombstone {
+			return entries[:visible]
+		}
+		return entries[:visible+1]
+	})
+}
+
+// Rollback removes every version greater than the given one, and sets it as the
+// latest version. The earliest version is left as is, rolling back before it
+// leaves no version to read.
+func (d *Database) Rollback(version int64) error {
+	d.pruneMtx.Lock()
+	defer d.pruneMtx.Unlock()
+
+	if err := d.deleteEntries(func(entries []entry) []entry {
+		for i, e := range entries {
+			if e.version > version {
+				return entries[i:]
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return d.SetLatestVersion(version)
+}
+
+// Close closes the underlying database, after waiting for a running prune.
+func (d *Database) Close() error {
+	d.pruneMtx.Lock()
+	defer d.pruneMtx.Unlock()
+
+	return d.db.Close()
+}
+
+func (d *Database) checkVersion(version int64) error {
+	if version < 0 {
+		return fmt.Errorf("invalid version %d", version)
+	}
+	earliest, err := d.GetEarliestVersion()
+	if err != nil {
+		return err
+	}
+	if version < earliest {
+		return fmt.Errorf("%w: %d < %d", ErrVersionPruned, version, earliest)
+	}
+	return nil
+}
+
+func (d *Database) getVersion(key string) (int64, error) {
+	bz, err := d.db.Get([]byte(key))
+	if err != nil {
+		return 0, err
+	}
+	if bz == nil {
+		return 0, nil
+	}
+	return decodeVersion(bz), nil
+}
+
+// entry is a stored version of a key.
+type entry struct {
+	dbKey     []byte
+	version   int64
+	tombstone bool
+}
+
+// deleteEntries walks every stored key and deletes the versions returned by
+// obsolete, which is called with all the versions of a key in ascending order.
+func (d *Database) deleteEntries(obsolete func(entries []entry) []entry) error {
+	itr, err := d.db.Iterator([]byte(storePrefixFmt), types.PrefixEndBytes([]byte(storePrefixFmt)))
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+
+	batch := d.db.NewBatch()
+	defer batch.Close()
+
+	var entries []entry
+	flush := func() error {
+		// the versions of a key are stored from the newest one
+		slices.Reverse(entries)
+		for _, e := range obsolete(entries) {
+			if err := batch.Delete(e.dbKey); err != nil {
+				return err
+			}
+		}
+		entries = entries[:0]
+		return nil
+	}
+
+	for ; itr.Valid(); itr.Next() {
+		key, value := itr.Key(), itr.Value()
+		if len(entries) > 0 && !bytes.Equal(entries[0].dbKey[:len(entries[0].dbKey)-versionSize], key[:len(key)-versionSize]) {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+		entries = append(entries, entry{
+			dbKey:     bytes.Clone(key),
+			version:   decodeKeyVersion(key[len(key)-versionSize:]),
+			tombstone: len(value) == 0 || value[0] == valueTombstone,
+		})
+	}
+	if err := itr.Error(); err != nil {
+		return err
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+func writeChangeset(batch dbm.Batch, version int64, changeset []*types.StoreKVPair) error {
+	for _, pair := range changeset {
+		key := append(storePrefix(pair.StoreKey), encodeKey(pair.Key)...)
+		key = append(key, encodeKeyVersion(version)...)
+
+		var value []byte
+		if pair.Delete {
+			value = []byte{valueTombstone}
+		} else {
+			value = make([]byte, 0, len(pair.Value)+1)
+			value = append(value, valueSet)
+			value = append(value, pair.Value...)
+		}
+
+		if err := batch.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func storePrefix(storeKey string) []byte {
+	return append([]byte(storePrefixFmt), encodeKey([]byte(storeKey))...)
+}
+
+// encodeKey escapes the 0x00 bytes of the key as 0x00 0xFF and terminates it
+// with 0x00 0x01, so that encoded keys sort like the keys and no encoded key is
+// a prefix of another one.
+func encodeKey(key []byte) []byte {
+	bz := make([]byte, 0, len(key)+2)
+	for _, b := range key {
+		if b == 0x00 {
+			bz = append(bz, 0x00, 0xFF)
+			continue
+		}
+		bz = append(bz, b)
+	}
+	return append(bz, 0x00, 0x01)
+}
+
+// decodeKey reverts encodeKey. It expects a well-formed encoded key.
+func decodeKey(bz []byte) []byte {
+	key := make([]byte, 0, len(bz)-2)
+	for i := 0; i < len(bz)-2; i++ {
+		key = append(key, bz[i])
+		if bz[i] == 0x00 {
+			i++
+		}
+	}
+	return key
+}
+
+func encodeVersion(version int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(version))
+}
+
+func decodeVersion(bz []byte) int64 {
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// encodeKeyVersion encodes the version suffixing a key, inverted so that the
+// versions of a key sort in descending order.
+func encodeKeyVersion(version int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, ^uint64(version))
+}
+
+func decodeKeyVersion(bz []byte) int64 {
+	return int64(^binary.BigEndian.Uint64(bz))
+}
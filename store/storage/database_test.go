@@ -0,0 +1,266 @@
+package storage
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/types"
+)
+
+func set(storeKey, key, value string) *types.StoreKVPair {
+	return &types.StoreKVPair{StoreKey: storeKey, Key: []byte(key), Value: []byte(value)}
+}
+
+func del(storeKey, key string) *types.StoreKVPair {
+	return &types.StoreKVPair{StoreKey: storeKey, Key: []byte(key), Delete: true}
+}
+
+func newTestDatabase(t *testing.T) *Database {
+	t.Helper()
+	db := NewDatabase(dbm.NewMemDB())
+
+	// version 1: a=1 b=1 c=1 in store1, a=x in store2
+	require.NoError(t, db.ApplyChangeset(1, []*types.StoreKVPair{
+		set("store1", "a", "1"), set("store1", "b", "1"), set("store1", "c", "1"), set("store2", "a", "x"),
+	}))
+	// version 2: b=2, c deleted
+	require.NoError(t, db.ApplyChangeset(2, []*types.StoreKVPair{
+		set("store1", "b", "2"), del("store1", "c"),
+	}))
+	// version 3: nothing
+	require.NoError(t, db.ApplyChangeset(3, nil))
+	// version 4: c=4, a deleted, a\x00 set
+	require.NoError(t, db.ApplyChangeset(4, []*types.StoreKVPair{
+		set("store1", "c", "4"), del("store1", "a"), set("store1", "a\x00", "4"),
+	}))
+	return db
+}
+
+func collect(t *testing.T, itr types.Iterator) []string {
+	t.Helper()
+	defer itr.Close()
+
+	var res []string
+	for ; itr.Valid(); itr.Next() {
+		res = append(res, string(itr.Key())+"="+string(itr.Value()))
+	}
+	require.NoError(t, itr.Error())
+	return res
+}
+
+func TestDatabaseGet(t *testing.T) {
+	db := newTestDatabase(t)
+
+	latest, err := db.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(4), latest)
+
+	testCases := []struct {
+		version int64
+		key     string
+		expect  []byte
+	}{
+		{1, "a", []byte("1")},
+		{1, "b", []byte("1")},
+		{1, "c", []byte("1")},
+		{2, "b", []byte("2")},
+		{2, "c", nil},
+		{3, "b", []byte("2")},
+		{3, "c", nil},
+		{4, "a", nil},
+		{4, "a\x00", []byte("4")},
+		{3, "a\x00", nil},
+		{4, "c", []byte("4")},
+		{4, "d", nil},
+	}
+	for _, tc := range testCases {
+		value, err := db.Get("store1", tc.version, []byte(tc.key))
+		require.NoError(t, err)
+		require.Equal(t, tc.expect, value, "key %q at version %d", tc.key, tc.version)
+	}
+
+	value, err := db.Get("store2", 4, []byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("x"), value)
+}
+
+func TestDatabaseIterator(t *testing.T) {
+	db := newTestDatabase(t)
+
+	itr, err := db.Iterator("store1", 1, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a=1", "b=1", "c=1"}, collect(t, itr))
+
+	itr, err = db.Iterator("store1", 3, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a=1", "b=2"}, collect(t, itr))
+
+	itr, err = db.Iterator("store1", 4, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a\x00=4", "b=2", "c=4"}, collect(t, itr))
+
+	itr, err = db.ReverseIterator("store1", 4, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"c=4", "b=2", "a\x00=4"}, collect(t, itr))
+
+	itr, err = db.ReverseIterator("store1", 1, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"c=1", "b=1", "a=1"}, collect(t, itr))
+
+	itr, err = db.Iterator("store1", 4, []byte("a\x00"), []byte("c"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"a\x00=4", "b=2"}, collect(t, itr))
+
+	itr, err = db.ReverseIterator("store1", 1, []byte("b"), nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"c=1", "b=1"}, collect(t, itr))
+
+	itr, err = db.Iterator("store2", 4, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a=x"}, collect(t, itr))
+
+	_, err = db.Iterator("store1", 4, []byte{}, nil)
+	require.Error(t, err)
+}
+
+func TestDatabasePrune(t *testing.T) {
+	db := newTestDatabase(t)
+
+	require.NoError(t, db.Prune(3))
+
+	earliest, err := db.GetEarliestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), earliest)
+
+	ok, err := db.HasVersion(2)
+	require.NoError(t, err)
+	require.False(t, ok)
+	_, err = db.Get("store1", 2, []byte("b"))
+	require.ErrorIs(t, err, ErrVersionPruned)
+
+	itr, err := db.Iterator("store1", 3, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a=1", "b=2"}, collect(t, itr))
+
+	itr, err = db.Iterator("store1", 4, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a\x00=4", "b=2", "c=4"}, collect(t, itr))
+
+	// only the versions visible at version 3 or later are left
+	raw, err := db.db.Iterator([]byte(storePrefixFmt), types.PrefixEndBytes([]byte(storePrefixFmt)))
+	require.NoError(t, err)
+	count := 0
+	for ; raw.Valid(); raw.Next() {
+		count++
+	}
+	require.NoError(t, raw.Close())
+	// store1: a@1, a@4 (deletion), a\x00@4, b@2, c@4; store2: a@1
+	require.Equal(t, 6, count)
+
+	// the earliest version is loaded from the database when reopened
+	reopened := NewDatabase(db.db)
+	earliest, err = reopened.GetEarliestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), earliest)
+	_, err = reopened.Get("store1", 2, []byte("b"))
+	require.ErrorIs(t, err, ErrVersionPruned)
+	require.NoError(t, reopened.Prune(4))
+	_, err = reopened.Get("store1", 3, []byte("b"))
+	require.ErrorIs(t, err, ErrVersionPruned)
+}
+
+func TestDatabaseRollback(t *testing.T) {
+	db := newTestDatabase(t)
+
+	require.NoError(t, db.Rollback(2))
+
+	latest, err := db.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), latest)
+
+	itr, err := db.Iterator("store1", 4, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a=1", "b=2"}, collect(t, itr))
+}
+
+func TestEncodeKeyVersion(t *testing.T) {
+	versions := []int64{0, 1, 2, 255, 256, math.MaxInt64}
+	for i, version := range versions {
+		require.Equal(t, version, decodeKeyVersion(encodeKeyVersion(version)))
+		if i > 0 {
+			require.Greater(t, string(encodeKeyVersion(versions[i-1])), string(encodeKeyVersion(version)))
+		}
+	}
+}
+
+func TestEncodeKey(t *testing.T) {
+	keys := [][]byte{{0x00}, {0x00, 0x00}, {0x00, 0x01}, {0x01}, []byte("a"), []byte("a\x00"), []byte("a\x00b"), []byte("ab")}
+	for i, key := range keys {
+		require.Equal(t, key, decodeKey(encodeKey(key)))
+		if i > 0 {
+			require.Less(t, string(encodeKey(keys[i-1])), string(encodeKey(key)))
+		}
+	}
+}
+
+// BenchmarkGet compares the point reads of the state storage to the ones of an
+// IAVL store, at the latest version and at a past one, over the same writes.
+func BenchmarkGet(b *testing.B) {
+	const (
+		numKeys     = 10_000
+		numVersions = 10
+		storeKey    = "bank"
+	)
+
+	dir := b.TempDir()
+	ssDB, err := dbm.NewGoLevelDB("state_storage", dir, nil)
+	require.NoError(b, err)
+	ss := NewDatabase(ssDB)
+	defer ss.Close()
+	iavlDB, err := dbm.NewGoLevelDB("iavl", dir, nil)
+	require.NoError(b, err)
+	defer iavlDB.Close()
+	tree, err := iavl.LoadStore(iavlDB, log.NewNopLogger(), types.NewKVStoreKey(storeKey), types.CommitID{}, iavl.DefaultIAVLCacheSize, false, metrics.NewNoOpMetrics())
+	require.NoError(b, err)
+
+	// every version rewrites a tenth of the keys
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key%08d", i)) }
+	for version := int64(1); version <= numVersions; version++ {
+		var changeset []*types.StoreKVPair
+		for i := 0; i < numKeys; i++ {
+			if version > 1 && i%numVersions != int(version-1) {
+				continue
+			}
+			value := []byte(fmt.Sprintf("value%d-%d", i, version))
+			changeset = append(changeset, &types.StoreKVPair{StoreKey: storeKey, Key: key(i), Value: value})
+			tree.Set(key(i), value)
+		}
+		require.NoError(b, ss.ApplyChangeset(version, changeset))
+		tree.Commit()
+	}
+
+	for _, version := range []int64{numVersions, numVersions / 2} {
+		immutable, err := tree.(*iavl.Store).GetImmutable(version)
+		require.NoError(b, err)
+
+		b.Run(fmt.Sprintf("state-storage/version=%d", version), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := ss.Get(storeKey, version, key(i%numKeys)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("iavl/version=%d", version), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				immutable.Get(key(i % numKeys))
+			}
+		})
+	}
+}
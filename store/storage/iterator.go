@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"bytes"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/types"
+)
+
+var _ types.Iterator = (*versionedIterator)(nil)
+
+// versionedIterator iterates over the keys of a store as they were at a given
+// version. The underlying iterator yields every stored version of each key,
+// which are grouped by key to only keep the last one written at or before the
+// iterator version, skipping the keys deleted at that version.
+type versionedIterator struct {
+	source    dbm.Iterator
+	prefixLen int
+	version   int64
+	start     []byte
+	end       []byte
+	reverse   bool
+
+	valid bool
+	key   []byte
+	value []byte
+}
+
+func newVersionedIterator(source dbm.Iterator, prefixLen int, version int64, start, end []byte, reverse bool) *versionedIterator {
+	itr := &versionedIterator{
+		source:    source,
+		prefixLen: prefixLen,
+		version:   version,
+		start:     start,
+		end:       end,
+		reverse:   reverse,
+	}
+	itr.advance()
+	return itr
+}
+
+// Domain implements types.Iterator.
+func (itr *versionedIterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+// Valid implements types.Iterator.
+func (itr *versionedIterator) Valid() bool {
+	return itr.valid
+}
+
+// Next implements types.Iterator.
+func (itr *versionedIterator) Next() {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+	itr.advance()
+}
+
+// Key implements types.Iterator.
+func (itr *versionedIterator) Key() []byte {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+	return itr.key
+}
+
+// Value implements types.Iterator.
+func (itr *versionedIterator) Value() []byte {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+	return itr.value
+}
+
+// Error implements types.Iterator.
+func (itr *versionedIterator) Error() error {
+	return itr.source.Error()
+}
+
+// Close implements types.Iterator.
+func (itr *versionedIterator) Close() error {
+	return itr.source.Close()
+}
+
+// split returns the encoded key and the version of the current source entry.
+func (itr *versionedIterator) split() ([]byte, int64) {
+	key := itr.source.Key()[itr.prefixLen:]
+	return key[:len(key)-versionSize], decodeKeyVersion(key[len(key)-versionSize:])
+}
+
+// advance moves to the next key visible at the iterator version.
+func (itr *versionedIterator) advance() {
+	for itr.source.Valid() {
+		encodedKey, _ := itr.split()
+		encodedKey = bytes.Clone(encodedKey)
+
+		// walk all the versions of the key, keeping the one visible at the
+		// iterator version; versions are descending, or ascending in reverse
+		var value []byte
+		found := false
+		for ; itr.source.Valid(); itr.source.Next() {
+			key, version := itr.split()
+			if !bytes.Equal(key, encodedKey) {
+				break
+			}
+			if version > itr.version || (found && !itr.reverse) {
+				continue
+			}
+			value, found = bytes.Clone(itr.source.Value()), true
+		}
+
+		if found && len(value) > 0 && value[0] != valueTombstone {
+			itr.valid = true
+			itr.key = decodeKey(encodedKey)
+			itr.value = value[1:]
+			return
+		}
+	}
+
+	itr.valid = false
+	itr.key, itr.value = nil, nil
+}
@@ -0,0 +1,96 @@
+package storage
+
+import (
+	"io"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	"cosmossdk.io/store/types"
+)
+
+var _ types.KVStore = Store{}
+
+// Store is a read-only KVStore serving a store of the state storage at a fixed
+// version. Writes panic, it must be branched to be written to.
+type Store struct {
+	db       *Database
+	storeKey string
+	version  int64
+}
+
+// NewStore returns a read-only view of the given store at the given version.
+func NewStore(db *Database, storeKey string, version int64) Store {
+	return Store{
+		db:       db,
+		storeKey: storeKey,
+		version:  version,
+	}
+}
+
+// Version returns the version the store is read at.
+func (s Store) Version() int64 {
+	return s.version
+}
+
+// Get implements types.KVStore, panicking on error.
+func (s Store) Get(key []byte) []byte {
+	types.AssertValidKey(key)
+	value, err := s.db.Get(s.storeKey, s.version, key)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// Has implements types.KVStore, panicking on error.
+func (s Store) Has(key []byte) bool {
+	types.AssertValidKey(key)
+	ok, err := s.db.Has(s.storeKey, s.version, key)
+	if err != nil {
+		panic(err)
+	}
+	return ok
+}
+
+// Set implements types.KVStore; it panics as the store is read-only.
+func (s Store) Set(_, _ []byte) {
+	panic("cannot write to a state storage store")
+}
+
+// Delete implements types.KVStore; it panics as the store is read-only.
+func (s Store) Delete(_ []byte) {
+	panic("cannot delete from a state storage store")
+}
+
+// Iterator implements types.KVStore, panicking on error.
+func (s Store) Iterator(start, end []byte) types.Iterator {
+	itr, err := s.db.Iterator(s.storeKey, s.version, start, end)
+	if err != nil {
+		panic(err)
+	}
+	return itr
+}
+
+// ReverseIterator implements types.KVStore, panicking on error.
+func (s Store) ReverseIterator(start, end []byte) types.Iterator {
+	itr, err := s.db.ReverseIterator(s.storeKey, s.version, start, end)
+	if err != nil {
+		panic(err)
+	}
+	return itr
+}
+
+// GetStoreType implements types.Store.
+func (Store) GetStoreType() types.StoreType {
+	return types.StoreTypeDB
+}
+
+// CacheWrap implements types.CacheWrapper.
+func (s Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements types.CacheWrapper.
+func (s Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
@@ -0,0 +1,218 @@
+// Package file implements a streaming listener writing the ABCI messages and
+// the state changes of the blocks into local files.
+package file
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/cosmos/gogoproto/proto"
+
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	"cosmossdk.io/store/streaming/queue"
+	storetypes "cosmossdk.io/store/types"
+)
+
+const (
+	filePrefix = "stream-"
+	fileSuffix = ".pb"
+
+	// maxRecordSize is the maximum size of a record read by a Reader.
+	maxRecordSize = 1 << 30
+)
+
+// Config is the configuration of a file listener.
+type Config struct {
+	// Dir is the directory of the files.
+	Dir string
+	// MaxFileSize is the size of a file, in bytes, from which the next block
+	// is written into a new file. The files are never rotated if it is zero.
+	MaxFileSize int64
+	// MaxFiles is the number of files kept, the oldest ones being removed on
+	// rotation. All the files are kept if it is zero.
+	MaxFiles int
+	// Fsync syncs the file to disk once the records of each block are written.
+	Fsync bool
+}
+
+// NewListener returns a listener writing length-prefixed abci.Record messages
+// into files of the given directory. The records of a block are never split
+// across files, a file being named after the height of its first block.
+func NewListener(cfg Config, opts queue.Options) (*queue.Listener, error) {
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return queue.NewListener(&sink{cfg: cfg}, opts), nil
+}
+
+// sink writes the records into the current file, rotating it once it grows
+// over the maximum size.
+type sink struct {
+	cfg Config
+
+	file   *os.File
+	writer *bufio.Writer
+	size   int64
+}
+
+// FinalizeBlock implements queue.Sink.
+func (s *sink) FinalizeBlock(req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	return s.write(req.Height, &streamingabci.Record{
+		Record: &streamingabci.Record_FinalizeBlock{
+			FinalizeBlock: &streamingabci.ListenFinalizeBlockRequest{Req: &req, Res: &res},
+		},
+	})
+}
+
+// Commit implements queue.Sink.
+func (s *sink) Commit(height int64, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	err := s.write(height, &streamingabci.Record{
+		Record: &streamingabci.Record_Commit{
+			Commit: &streamingabci.ListenCommitRequest{BlockHeight: height, Res: &res, ChangeSet: changeSet},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := s.writer.Flush(); err != nil {
+		return err
+	}
+	if s.cfg.Fsync {
+		if err := s.file.Sync(); err != nil {
+			return err
+		}
+	}
+
+	if s.cfg.MaxFileSize > 0 && s.size >= s.cfg.MaxFileSize {
+		return s.rotate()
+	}
+	return nil
+}
+
+// write writes a record into the current file, creating it if needed.
+func (s *sink) write(height int64, record *streamingabci.Record) error {
+	if s.file == nil {
+		if err := s.open(height); err != nil {
+			return err
+		}
+	}
+
+	bz, err := proto.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	var prefix [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(prefix[:], uint64(len(bz)))
+	if _, err := s.writer.Write(prefix[:n]); err != nil {
+		return err
+	}
+	if _, err := s.writer.Write(bz); err != nil {
+		return err
+	}
+
+	s.size += int64(n + len(bz))
+	return nil
+}
+
+// open creates the file starting at the given height. A file left by a block
+// which was not committed is overwritten.
+func (s *sink) open(height int64) error {
+	file, err := os.OpenFile(filepath.Join(s.cfg.Dir, fileName(height)), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+
+	s.file, s.writer, s.size = file, bufio.NewWriter(file), 0
+	return nil
+}
+
+// rotate closes the current file and removes the oldest files over the
+// maximum number of files.
+func (s *sink) rotate() error {
+	if err := s.Close(); err != nil {
+		return err
+	}
+	if s.cfg.MaxFiles <= 0 {
+		return nil
+	}
+
+	files, err := Files(s.cfg.Dir)
+	if err != nil {
+		return err
+	}
+	for len(files) > s.cfg.MaxFiles {
+		if err := os.Remove(files[0]); err != nil {
+			return err
+		}
+		files = files[1:]
+	}
+	return nil
+}
+
+// Close implements queue.Sink.
+func (s *sink) Close() error {
+	if s.file == nil {
+		return nil
+	}
+
+	err := errors.Join(s.writer.Flush(), s.file.Close())
+	s.file, s.writer = nil, nil
+	return err
+}
+
+func fileName(height int64) string {
+	return fmt.Sprintf("%s%020d%s", filePrefix, height, fileSuffix)
+}
+
+// Files returns the paths of the files written by a listener in the given
+// directory, in the order of their blocks.
+func Files(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.Type().IsRegular() && strings.HasPrefix(name, filePrefix) && strings.HasSuffix(name, fileSuffix) {
+			files = append(files, filepath.Join(dir, name))
+		}
+	}
+	// the heights are zero padded, the names sort as the heights
+	sort.Strings(files)
+	return files, nil
+}
+
+// Reader reads the records of a file written by a listener.
+type Reader struct {
+	reader protoio.ReadCloser
+}
+
+// NewReader returns a Reader reading the records from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{reader: protoio.NewDelimitedReader(r, maxRecordSize)}
+}
+
+// Next returns the next record, or io.EOF once all of them were read. The
+// FinalizeBlock record of a block which was not committed, if the node stopped
+// before writing the Commit record, is not followed by its Commit record.
+func (r *Reader) Next() (*streamingabci.Record, error) {
+	record := &streamingabci.Record{}
+	if err := r.reader.ReadMsg(record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
@@ -0,0 +1,117 @@
+package file_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	"cosmossdk.io/store/streaming/file"
+	"cosmossdk.io/store/streaming/queue"
+	storetypes "cosmossdk.io/store/types"
+)
+
+type blockContext struct {
+	context.Context
+	height int64
+}
+
+func (c blockContext) BlockHeight() int64 { return c.height }
+func (c blockContext) Logger() log.Logger { return log.NewNopLogger() }
+func (c blockContext) StreamingManager() storetypes.StreamingManager {
+	return storetypes.StreamingManager{}
+}
+
+func writeBlocks(t *testing.T, l storetypes.ABCIListener, from, to int64) {
+	t.Helper()
+	for height := from; height <= to; height++ {
+		req := abci.RequestFinalizeBlock{Height: height, Txs: [][]byte{{byte(height)}}}
+		res := abci.ResponseFinalizeBlock{AppHash: []byte{byte(height)}}
+		require.NoError(t, l.ListenFinalizeBlock(context.Background(), req, res))
+
+		changeSet := []*storetypes.StoreKVPair{{StoreKey: "bank", Key: []byte{byte(height)}, Value: make([]byte, 100)}}
+		ctx := blockContext{Context: context.Background(), height: height}
+		require.NoError(t, l.ListenCommit(ctx, abci.ResponseCommit{}, changeSet))
+	}
+}
+
+// readHeights returns the heights of the records of the file.
+func readHeights(t *testing.T, path string) []int64 {
+	t.Helper()
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var heights []int64
+	reader := file.NewReader(f)
+	for {
+		record, err := reader.Next()
+		if err == io.EOF {
+			return heights
+		}
+		require.NoError(t, err)
+
+		switch r := record.Record.(type) {
+		case *streamingabci.Record_FinalizeBlock:
+			require.Equal(t, []byte{byte(r.FinalizeBlock.Req.Height)}, r.FinalizeBlock.Res.AppHash)
+			heights = append(heights, r.FinalizeBlock.Req.Height)
+		case *streamingabci.Record_Commit:
+			require.Len(t, r.Commit.ChangeSet, 1)
+			require.Equal(t, []byte{byte(r.Commit.BlockHeight)}, r.Commit.ChangeSet[0].Key)
+			heights = append(heights, r.Commit.BlockHeight)
+		}
+	}
+}
+
+func TestListener(t *testing.T) {
+	dir := t.TempDir()
+	l, err := file.NewListener(file.Config{Dir: dir}, queue.Options{BufferSize: 10})
+	require.NoError(t, err)
+	writeBlocks(t, l, 1, 3)
+	require.NoError(t, l.Close())
+
+	files, err := file.Files(dir)
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(dir, "stream-00000000000000000001.pb")}, files)
+	require.Equal(t, []int64{1, 1, 2, 2, 3, 3}, readHeights(t, files[0]))
+}
+
+func TestListenerRotation(t *testing.T) {
+	dir := t.TempDir()
+	cfg := file.Config{Dir: dir, MaxFileSize: 300, MaxFiles: 3, Fsync: true}
+	l, err := file.NewListener(cfg, queue.Options{})
+	require.NoError(t, err)
+
+	// every file holds two blocks, the oldest files are removed
+	writeBlocks(t, l, 1, 10)
+	require.NoError(t, l.Close())
+
+	files, err := file.Files(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	require.Equal(t, "stream-00000000000000000005.pb", filepath.Base(files[0]))
+	require.Equal(t, []int64{5, 5, 6, 6}, readHeights(t, files[0]))
+	require.Equal(t, []int64{9, 9, 10, 10}, readHeights(t, files[2]))
+
+	// a block which was not committed is written again after a restart
+	l, err = file.NewListener(cfg, queue.Options{})
+	require.NoError(t, err)
+	require.NoError(t, l.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{Height: 11}, abci.ResponseFinalizeBlock{}))
+	require.NoError(t, l.Close())
+
+	l, err = file.NewListener(cfg, queue.Options{})
+	require.NoError(t, err)
+	writeBlocks(t, l, 11, 11)
+	require.NoError(t, l.Close())
+
+	files, err = file.Files(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 4)
+	require.Equal(t, []int64{11, 11}, readHeights(t, files[3]))
+}
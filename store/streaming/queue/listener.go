@@ -0,0 +1,199 @@
+// Package queue implements the buffering of the built-in streaming listeners:
+// the records are handed over to a Sink written by a goroutine of its own, so
+// that a slow sink only slows the node down once the buffer is full.
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// Sink writes the records of a Listener. Its methods are called in the order of
+// the records, from a single goroutine.
+type Sink interface {
+	// FinalizeBlock writes the FinalizeBlock messages of a block.
+	FinalizeBlock(req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error
+	// Commit writes the Commit messages and the state changes of a block,
+	// following its FinalizeBlock messages.
+	Commit(height int64, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error
+	// Close flushes and releases the sink.
+	Close() error
+}
+
+// Options are the options of a Listener.
+type Options struct {
+	// BufferSize is the number of records buffered before the listener blocks
+	// the node until the sink catches up. With a zero buffer size, the records
+	// are written synchronously.
+	BufferSize int
+	// Keys are the names of the stores whose changes are written, all of them
+	// if empty.
+	Keys []string
+	// StopNodeOnErr halts the node when the sink fails, instead of logging
+	// the error and dropping the following records.
+	StopNodeOnErr bool
+}
+
+var _ storetypes.ABCIListener = (*Listener)(nil)
+
+// Listener is an ABCIListener writing its records into a Sink. Once the sink
+// failed, the listener keeps returning its error and writes nothing more.
+type Listener struct {
+	sink          Sink
+	keys          map[string]struct{}
+	stopNodeOnErr bool
+
+	records chan func() error
+	done    chan struct{}
+
+	// sendMtx guards the sends on records and the sink against Close
+	sendMtx sync.RWMutex
+	closed  bool
+
+	mtx sync.Mutex // guards err
+	err error
+}
+
+// NewListener returns a Listener writing into the given sink.
+func NewListener(sink Sink, opts Options) *Listener {
+	l := &Listener{
+		sink:          sink,
+		stopNodeOnErr: opts.StopNodeOnErr,
+		done:          make(chan struct{}),
+	}
+	if len(opts.Keys) > 0 {
+		l.keys = make(map[string]struct{}, len(opts.Keys))
+		for _, key := range opts.Keys {
+			l.keys[key] = struct{}{}
+		}
+	}
+
+	if opts.BufferSize > 0 {
+		l.records = make(chan func() error, opts.BufferSize)
+		go l.run()
+	} else {
+		close(l.done)
+	}
+
+	return l
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener.
+func (l *Listener) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	return l.write(func() error {
+		return l.sink.FinalizeBlock(req, res)
+	})
+}
+
+// ListenCommit implements storetypes.ABCIListener. It expects the context to
+// be a storetypes.Context, to get the height of the block.
+func (l *Listener) ListenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	height := ctx.(storetypes.Context).BlockHeight()
+	changeSet = l.filter(changeSet)
+	return l.write(func() error {
+		return l.sink.Commit(height, res, changeSet)
+	})
+}
+
+// filter returns the changes of the listened stores.
+func (l *Listener) filter(changeSet []*storetypes.StoreKVPair) []*storetypes.StoreKVPair {
+	if l.keys == nil {
+		return changeSet
+	}
+
+	filtered := make([]*storetypes.StoreKVPair, 0, len(changeSet))
+	for _, pair := range changeSet {
+		if _, ok := l.keys[pair.StoreKey]; ok {
+			filtered = append(filtered, pair)
+		}
+	}
+	return filtered
+}
+
+// write writes the record, or queues it if the listener is buffered, blocking
+// while the buffer is full.
+func (l *Listener) write(record func() error) error {
+	if err := l.Err(); err != nil {
+		return l.fail(err)
+	}
+
+	l.sendMtx.RLock()
+	defer l.sendMtx.RUnlock()
+
+	switch {
+	case l.closed:
+		return errors.New("streaming listener is closed")
+	case l.records == nil:
+		if err := record(); err != nil {
+			l.setErr(err)
+			return l.fail(err)
+		}
+	default:
+		l.records <- record
+	}
+	return nil
+}
+
+// run writes the queued records until the queue is closed.
+func (l *Listener) run() {
+	defer close(l.done)
+
+	for record := range l.records {
+		if l.Err() != nil {
+			continue
+		}
+		if err := record(); err != nil {
+			l.setErr(err)
+		}
+	}
+}
+
+func (l *Listener) setErr(err error) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if l.err == nil {
+		l.err = err
+	}
+}
+
+// fail halts the node if configured to, or returns the error to be logged.
+func (l *Listener) fail(err error) error {
+	err = fmt.Errorf("streaming listener failed: %w", err)
+	if l.stopNodeOnErr {
+		panic(err)
+	}
+	return err
+}
+
+// Err returns the error of the sink, if it failed.
+func (l *Listener) Err() error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.err
+}
+
+// Close waits for the queued records to be written and closes the sink.
+func (l *Listener) Close() error {
+	l.sendMtx.Lock()
+	if l.closed {
+		l.sendMtx.Unlock()
+		return nil
+	}
+	l.closed = true
+	if l.records != nil {
+		close(l.records)
+	}
+	l.sendMtx.Unlock()
+
+	<-l.done
+	if err := l.sink.Close(); err != nil {
+		return err
+	}
+	return l.Err()
+}
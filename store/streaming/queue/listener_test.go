@@ -0,0 +1,143 @@
+package queue_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/streaming/queue"
+	storetypes "cosmossdk.io/store/types"
+)
+
+type blockContext struct {
+	context.Context
+	height int64
+}
+
+func (c blockContext) BlockHeight() int64 { return c.height }
+func (c blockContext) Logger() log.Logger { return log.NewNopLogger() }
+func (c blockContext) StreamingManager() storetypes.StreamingManager {
+	return storetypes.StreamingManager{}
+}
+
+func newContext(height int64) blockContext {
+	return blockContext{Context: context.Background(), height: height}
+}
+
+// mockSink records the heights written, waiting for the gate to be opened if
+// any, and fails at the given height.
+type mockSink struct {
+	mtx      sync.Mutex
+	heights  []int64
+	changes  [][]*storetypes.StoreKVPair
+	gate     chan struct{}
+	failAt   int64
+	isClosed bool
+}
+
+func (s *mockSink) FinalizeBlock(req abci.RequestFinalizeBlock, _ abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+func (s *mockSink) Commit(height int64, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	if s.gate != nil {
+		<-s.gate
+	}
+	if height == s.failAt {
+		return errors.New("sink failure")
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.heights = append(s.heights, height)
+	s.changes = append(s.changes, changeSet)
+	return nil
+}
+
+func (s *mockSink) Close() error {
+	s.isClosed = true
+	return nil
+}
+
+func (s *mockSink) written() []int64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return append([]int64(nil), s.heights...)
+}
+
+func commit(l *queue.Listener, height int64, changeSet ...*storetypes.StoreKVPair) error {
+	if err := l.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{Height: height}, abci.ResponseFinalizeBlock{}); err != nil {
+		return err
+	}
+	return l.ListenCommit(newContext(height), abci.ResponseCommit{}, changeSet)
+}
+
+func TestListenerSync(t *testing.T) {
+	sink := &mockSink{failAt: 3}
+	l := queue.NewListener(sink, queue.Options{Keys: []string{"bank"}})
+
+	bank := &storetypes.StoreKVPair{StoreKey: "bank", Key: []byte{1}}
+	acc := &storetypes.StoreKVPair{StoreKey: "acc", Key: []byte{2}}
+	require.NoError(t, commit(l, 1, bank, acc))
+	require.NoError(t, commit(l, 2))
+	require.Equal(t, []int64{1, 2}, sink.written())
+	// only the changes of the listened stores are written
+	require.Equal(t, []*storetypes.StoreKVPair{bank}, sink.changes[0])
+
+	// the failure is returned right away and then on every record
+	require.ErrorContains(t, commit(l, 3), "sink failure")
+	require.ErrorContains(t, commit(l, 4), "sink failure")
+	require.Equal(t, []int64{1, 2}, sink.written())
+
+	require.ErrorContains(t, l.Close(), "sink failure")
+	require.True(t, sink.isClosed)
+}
+
+func TestListenerBackpressure(t *testing.T) {
+	sink := &mockSink{gate: make(chan struct{})}
+	l := queue.NewListener(sink, queue.Options{BufferSize: 4})
+
+	// the first block is being written, the next ones fill the buffer
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, commit(l, height))
+	}
+
+	// the node is blocked until the sink catches up
+	written := make(chan struct{})
+	go func() {
+		require.NoError(t, commit(l, 4))
+		close(written)
+	}()
+	select {
+	case <-written:
+		t.Fatal("the listener did not block on a full buffer")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(sink.gate)
+	<-written
+	require.NoError(t, l.Close())
+	require.Equal(t, []int64{1, 2, 3, 4}, sink.written())
+	require.True(t, sink.isClosed)
+
+	require.Error(t, commit(l, 5))
+}
+
+func TestListenerStopNodeOnErr(t *testing.T) {
+	sink := &mockSink{failAt: 2}
+	l := queue.NewListener(sink, queue.Options{BufferSize: 10, StopNodeOnErr: true})
+
+	require.NoError(t, commit(l, 1))
+	require.NoError(t, commit(l, 2))
+	require.Eventually(t, func() bool { return l.Err() != nil }, time.Second, time.Millisecond)
+
+	// the node halts on the next record, the following ones are dropped
+	require.PanicsWithError(t, "streaming listener failed: sink failure", func() { _ = commit(l, 3) })
+	require.Equal(t, []int64{1}, sink.written())
+}
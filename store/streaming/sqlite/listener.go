@@ -0,0 +1,246 @@
+// Package sqlite implements a streaming listener writing the blocks, their
+// transaction results, their events and their state changes into a SQLite
+// database.
+//
+// The listener uses database/sql: the application must link a SQLite driver,
+// such as modernc.org/sqlite registering the "sqlite" driver.
+package sqlite
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/store/streaming/queue"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// DefaultDriver is the name of the database/sql driver used by default.
+const DefaultDriver = "sqlite"
+
+// schema creates the tables written by the listener. The events of the block
+// have no transaction index.
+const schema = `
+CREATE TABLE IF NOT EXISTS blocks (
+	height   INTEGER PRIMARY KEY,
+	time     TEXT NOT NULL,
+	hash     BLOB NOT NULL,
+	proposer BLOB NOT NULL,
+	app_hash BLOB NOT NULL
+);
+CREATE TABLE IF NOT EXISTS txs (
+	height     INTEGER NOT NULL,
+	tx_index   INTEGER NOT NULL,
+	hash       BLOB NOT NULL,
+	code       INTEGER NOT NULL,
+	codespace  TEXT NOT NULL,
+	log        TEXT NOT NULL,
+	gas_wanted INTEGER NOT NULL,
+	gas_used   INTEGER NOT NULL,
+	PRIMARY KEY (height, tx_index)
+);
+CREATE INDEX IF NOT EXISTS txs_hash ON txs (hash);
+CREATE TABLE IF NOT EXISTS events (
+	id          INTEGER PRIMARY KEY,
+	height      INTEGER NOT NULL,
+	tx_index    INTEGER,
+	event_index INTEGER NOT NULL,
+	type        TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS events_height ON events (height, tx_index, event_index);
+CREATE INDEX IF NOT EXISTS events_type ON events (type, height);
+CREATE TABLE IF NOT EXISTS event_attributes (
+	event_id   INTEGER NOT NULL REFERENCES events (id),
+	attr_index INTEGER NOT NULL,
+	key        TEXT NOT NULL,
+	value      TEXT NOT NULL,
+	PRIMARY KEY (event_id, attr_index)
+);
+CREATE INDEX IF NOT EXISTS event_attributes_key ON event_attributes (key, value);
+CREATE TABLE IF NOT EXISTS kv_changes (
+	height       INTEGER NOT NULL,
+	change_index INTEGER NOT NULL,
+	store        TEXT NOT NULL,
+	key          BLOB NOT NULL,
+	value        BLOB,
+	deleted      INTEGER NOT NULL,
+	PRIMARY KEY (height, change_index)
+);
+CREATE INDEX IF NOT EXISTS kv_changes_key ON kv_changes (store, key, height);
+`
+
+// Config is the configuration of a SQLite listener.
+type Config struct {
+	// Path is the path of the database file.
+	Path string
+	// Driver is the name of the database/sql driver, DefaultDriver if empty.
+	Driver string
+}
+
+// NewListener returns a listener writing into the SQLite database at the
+// given path, creating its tables if needed. Each block is written in a single
+// transaction once committed; a block written again, when replayed after a
+// restart, replaces the rows of its previous write.
+func NewListener(cfg Config, opts queue.Options) (*queue.Listener, error) {
+	driver := cfg.Driver
+	if driver == "" {
+		driver = DefaultDriver
+	}
+
+	db, err := sql.Open(driver, cfg.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open streaming database, the application must link a %q database/sql driver: %w", driver, err)
+	}
+	// the writes are serialized by the listener
+	db.SetMaxOpenConns(1)
+
+	for _, stmt := range []string{"PRAGMA journal_mode = WAL", schema} {
+		if _, err := db.Exec(stmt); err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to set up streaming database: %w", err), db.Close())
+		}
+	}
+
+	return queue.NewListener(&sink{db: db}, opts), nil
+}
+
+// sink writes a block into the database once its Commit record follows its
+// FinalizeBlock record.
+type sink struct {
+	db *sql.DB
+
+	req *abci.RequestFinalizeBlock
+	res *abci.ResponseFinalizeBlock
+}
+
+// FinalizeBlock implements queue.Sink.
+func (s *sink) FinalizeBlock(req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	s.req, s.res = &req, &res
+	return nil
+}
+
+// Commit implements queue.Sink.
+func (s *sink) Commit(height int64, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	req, finalizeRes := s.req, s.res
+	s.req, s.res = nil, nil
+	if req == nil || req.Height != height {
+		return fmt.Errorf("no FinalizeBlock record for the block %d", height)
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := writeBlock(tx, req, finalizeRes, changeSet); err != nil {
+		return errors.Join(fmt.Errorf("failed to write block %d: %w", height, err), tx.Rollback())
+	}
+	return tx.Commit()
+}
+
+// writeBlock writes the rows of a block, replacing the ones of a previous
+// write of the block.
+func writeBlock(tx *sql.Tx, req *abci.RequestFinalizeBlock, res *abci.ResponseFinalizeBlock, changeSet []*storetypes.StoreKVPair) error {
+	height := req.Height
+	if _, err := tx.Exec("DELETE FROM event_attributes WHERE event_id IN (SELECT id FROM events WHERE height = ?)", height); err != nil {
+		return err
+	}
+	for _, table := range []string{"blocks", "txs", "events", "kv_changes"} {
+		if _, err := tx.Exec("DELETE FROM "+table+" WHERE height = ?", height); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.Exec(
+		"INSERT INTO blocks (height, time, hash, proposer, app_hash) VALUES (?, ?, ?, ?, ?)",
+		height, req.Time.UTC().Format(time.RFC3339Nano), nonNil(req.Hash), nonNil(req.ProposerAddress), nonNil(res.AppHash),
+	); err != nil {
+		return err
+	}
+
+	insertTx, err := tx.Prepare("INSERT INTO txs (height, tx_index, hash, code, codespace, log, gas_wanted, gas_used) VALUES (?, ?, ?, ?, ?, ?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer insertTx.Close()
+	for i, txRes := range res.TxResults {
+		var hash []byte
+		if i < len(req.Txs) {
+			sum := sha256.Sum256(req.Txs[i])
+			hash = sum[:]
+		}
+		if _, err := insertTx.Exec(height, i, nonNil(hash), txRes.Code, txRes.Codespace, txRes.Log, txRes.GasWanted, txRes.GasUsed); err != nil {
+			return err
+		}
+	}
+
+	insertEvent, err := tx.Prepare("INSERT INTO events (height, tx_index, event_index, type) VALUES (?, ?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer insertEvent.Close()
+	insertAttribute, err := tx.Prepare("INSERT INTO event_attributes (event_id, attr_index, key, value) VALUES (?, ?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer insertAttribute.Close()
+	writeEvents := func(txIndex sql.NullInt64, events []abci.Event) error {
+		for i, event := range events {
+			result, err := insertEvent.Exec(height, txIndex, i, event.Type)
+			if err != nil {
+				return err
+			}
+			id, err := result.LastInsertId()
+			if err != nil {
+				return err
+			}
+			for j, attr := range event.Attributes {
+				if _, err := insertAttribute.Exec(id, j, attr.Key, attr.Value); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := writeEvents(sql.NullInt64{}, res.Events); err != nil {
+		return err
+	}
+	for i, txRes := range res.TxResults {
+		if err := writeEvents(sql.NullInt64{Int64: int64(i), Valid: true}, txRes.Events); err != nil {
+			return err
+		}
+	}
+
+	insertChange, err := tx.Prepare("INSERT INTO kv_changes (height, change_index, store, key, value, deleted) VALUES (?, ?, ?, ?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer insertChange.Close()
+	for i, pair := range changeSet {
+		var value []byte
+		if !pair.Delete {
+			value = nonNil(pair.Value)
+		}
+		if _, err := insertChange.Exec(height, i, pair.StoreKey, pair.Key, value, pair.Delete); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nonNil returns an empty slice for a nil one, which the drivers would write
+// as NULL.
+func nonNil(bz []byte) []byte {
+	if bz == nil {
+		return []byte{}
+	}
+	return bz
+}
+
+// Close implements queue.Sink.
+func (s *sink) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,101 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/streaming/queue"
+	"cosmossdk.io/store/streaming/sqlite"
+	storetypes "cosmossdk.io/store/types"
+)
+
+type blockContext struct {
+	context.Context
+	height int64
+}
+
+func (c blockContext) BlockHeight() int64 { return c.height }
+func (c blockContext) Logger() log.Logger { return log.NewNopLogger() }
+func (c blockContext) StreamingManager() storetypes.StreamingManager {
+	return storetypes.StreamingManager{}
+}
+
+func writeBlock(t *testing.T, l storetypes.ABCIListener, height int64, code uint32) {
+	t.Helper()
+	req := abci.RequestFinalizeBlock{
+		Height: height,
+		Time:   time.Unix(height, 0),
+		Hash:   []byte{byte(height)},
+		Txs:    [][]byte{{1}, {2}},
+	}
+	attr := abci.EventAttribute{Key: "height", Value: "1"}
+	res := abci.ResponseFinalizeBlock{
+		Events: []abci.Event{{Type: "begin", Attributes: []abci.EventAttribute{attr}}},
+		TxResults: []*abci.ExecTxResult{
+			{Code: code, GasUsed: 10, Events: []abci.Event{{Type: "transfer", Attributes: []abci.EventAttribute{attr, attr}}}},
+			{Codespace: "sdk", Code: 5},
+		},
+		AppHash: []byte{byte(height)},
+	}
+	require.NoError(t, l.ListenFinalizeBlock(context.Background(), req, res))
+
+	changeSet := []*storetypes.StoreKVPair{
+		{StoreKey: "bank", Key: []byte{byte(height)}, Value: []byte{1}},
+		{StoreKey: "acc", Key: []byte{2}, Delete: true},
+	}
+	ctx := blockContext{Context: context.Background(), height: height}
+	require.NoError(t, l.ListenCommit(ctx, abci.ResponseCommit{}, changeSet))
+}
+
+func count(t *testing.T, db *sql.DB, query string, args ...any) int {
+	t.Helper()
+	var n int
+	require.NoError(t, db.QueryRow(query, args...).Scan(&n))
+	return n
+}
+
+func TestListener(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "streaming.db")
+	l, err := sqlite.NewListener(sqlite.Config{Path: path}, queue.Options{BufferSize: 10, Keys: []string{"bank"}})
+	require.NoError(t, err)
+	writeBlock(t, l, 1, 0)
+	writeBlock(t, l, 2, 0)
+	// the block replayed after a restart replaces its rows
+	writeBlock(t, l, 2, 1)
+	require.NoError(t, l.Close())
+
+	db, err := sql.Open("sqlite", path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.Equal(t, 2, count(t, db, "SELECT COUNT(*) FROM blocks"))
+	require.Equal(t, 4, count(t, db, "SELECT COUNT(*) FROM txs"))
+	require.Equal(t, 1, count(t, db, "SELECT code FROM txs WHERE height = 2 AND tx_index = 0"))
+	require.Equal(t, 1, count(t, db, "SELECT COUNT(*) FROM txs WHERE codespace = 'sdk' AND height = 1"))
+
+	// the block events have no transaction index
+	require.Equal(t, 2, count(t, db, "SELECT COUNT(*) FROM events WHERE tx_index IS NULL"))
+	require.Equal(t, 2, count(t, db, "SELECT COUNT(*) FROM events WHERE type = 'transfer' AND tx_index = 0"))
+	require.Equal(t, 6, count(t, db, "SELECT COUNT(*) FROM event_attributes"))
+
+	// only the changes of the listened stores are written
+	require.Equal(t, 2, count(t, db, "SELECT COUNT(*) FROM kv_changes WHERE store = 'bank' AND deleted = 0"))
+	require.Equal(t, 0, count(t, db, "SELECT COUNT(*) FROM kv_changes WHERE store = 'acc'"))
+
+	var txHash []byte
+	require.NoError(t, db.QueryRow("SELECT hash FROM txs WHERE height = 1 AND tx_index = 0").Scan(&txHash))
+	require.Len(t, txHash, 32)
+}
+
+func TestListenerUnknownDriver(t *testing.T) {
+	_, err := sqlite.NewListener(sqlite.Config{Path: filepath.Join(t.TempDir(), "streaming.db"), Driver: "unknown"}, queue.Options{})
+	require.ErrorContains(t, err, `must link a "unknown" database/sql driver`)
+}
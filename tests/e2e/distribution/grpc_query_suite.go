@@ -14,6 +14,7 @@ import (
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
 )
 
 type GRPCQueryTestSuite struct {
@@ -463,7 +464,7 @@ func (s *GRPCQueryTestSuite) TestQueryValidatorCommunityPoolGRPC() {
 	val := s.network.Validators[0]
 	baseURL := val.APIAddress
 
-	communityPool, err := sdk.ParseDecCoins("0.4stake")
+	communityPool, err := sdk.ParseCoinsNormalized("0stake")
 	s.Require().NoError(err)
 
 	testCases := []struct {
@@ -475,14 +476,14 @@ func (s *GRPCQueryTestSuite) TestQueryValidatorCommunityPoolGRPC() {
 		expected proto.Message
 	}{
 		{
-			"gRPC request params with wrong validator address",
-			fmt.Sprintf("%s/cosmos/distribution/v1beta1/community_pool", baseURL),
+			"community pool held by x/protocolpool",
+			fmt.Sprintf("%s/cosmos/protocolpool/v1/community_pool", baseURL),
 			map[string]string{
 				grpctypes.GRPCBlockHeightHeader: "2",
 			},
 			false,
-			&types.QueryCommunityPoolResponse{},
-			&types.QueryCommunityPoolResponse{
+			&protocolpooltypes.QueryCommunityPoolResponse{},
+			&protocolpooltypes.QueryCommunityPoolResponse{
 				Pool: communityPool,
 			},
 		},
@@ -0,0 +1,223 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestTokenizeAndRedeemShares(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	bondDenom, err := f.stakingKeeper.BondDenom(ctx)
+	assert.NilError(t, err)
+
+	addrs, valAddrs, _ := createValidators(t, f, []int64{9, 8, 7})
+	delAddr, owner, holder := addrs[2], addrs[3], addrs[4]
+
+	delTokens := f.stakingKeeper.TokensFromConsensusPower(ctx, 10)
+	_, err = msgServer.Delegate(ctx, types.NewMsgDelegate(delAddr.String(), valAddrs[0].String(), sdk.NewCoin(bondDenom, delTokens)))
+	assert.NilError(t, err)
+
+	// tokenize half of the delegation
+	tokenizeAmount := delTokens.QuoRaw(2)
+	res, err := msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(delAddr.String(), valAddrs[0].String(), sdk.NewCoin(bondDenom, tokenizeAmount), owner.String()))
+	assert.NilError(t, err)
+
+	shareDenom := strings.ToLower(valAddrs[0].String()) + "/1"
+	assert.DeepEqual(t, sdk.NewCoin(shareDenom, tokenizeAmount), res.Amount)
+	assert.DeepEqual(t, res.Amount, f.bankKeeper.GetBalance(ctx, delAddr, shareDenom))
+
+	record, err := f.stakingKeeper.GetTokenizeShareRecordByDenom(ctx, shareDenom)
+	assert.NilError(t, err)
+	assert.Equal(t, owner.String(), record.Owner)
+	assert.Equal(t, valAddrs[0].String(), record.Validator)
+
+	delegation, err := f.stakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddrs[0])
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(tokenizeAmount), delegation.Shares)
+
+	delegation, err = f.stakingKeeper.GetDelegation(ctx, delAddr, valAddrs[0])
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(delTokens.Sub(tokenizeAmount)), delegation.Shares)
+
+	totalLiquidStaked, err := f.stakingKeeper.GetTotalLiquidStakedTokens(ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, tokenizeAmount, totalLiquidStaked)
+
+	validator, err := f.stakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(tokenizeAmount), validator.LiquidShares)
+
+	// the share tokens are transferable and redeemable by their holder
+	assert.NilError(t, f.bankKeeper.SendCoins(ctx, delAddr, holder, sdk.NewCoins(res.Amount)))
+
+	redeemAmount := tokenizeAmount.QuoRaw(2)
+	_, err = msgServer.RedeemTokensForShares(ctx, types.NewMsgRedeemTokensForShares(holder.String(), sdk.NewCoin(shareDenom, redeemAmount)))
+	assert.NilError(t, err)
+
+	delegation, err = f.stakingKeeper.GetDelegation(ctx, holder, valAddrs[0])
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(redeemAmount), delegation.Shares)
+
+	_, err = f.stakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	assert.NilError(t, err)
+
+	// redeeming the last share tokens removes the record
+	_, err = msgServer.RedeemTokensForShares(ctx, types.NewMsgRedeemTokensForShares(holder.String(), sdk.NewCoin(shareDenom, tokenizeAmount.Sub(redeemAmount))))
+	assert.NilError(t, err)
+
+	_, err = f.stakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	assert.ErrorIs(t, err, types.ErrTokenizeShareRecordNotExists)
+	assert.Assert(t, f.bankKeeper.GetSupply(ctx, shareDenom).IsZero())
+
+	totalLiquidStaked, err = f.stakingKeeper.GetTotalLiquidStakedTokens(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, totalLiquidStaked.IsZero())
+
+	validator, err = f.stakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.NilError(t, err)
+	assert.Assert(t, validator.LiquidShares.IsZero())
+}
+
+func TestTransferTokenizeShareRecord(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	bondDenom, err := f.stakingKeeper.BondDenom(ctx)
+	assert.NilError(t, err)
+
+	addrs, valAddrs, _ := createValidators(t, f, []int64{9, 8, 7})
+
+	_, err = msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(addrs[0].String(), valAddrs[0].String(), sdk.NewInt64Coin(bondDenom, 1000), addrs[0].String()))
+	assert.NilError(t, err)
+
+	_, err = msgServer.TransferTokenizeShareRecord(ctx, types.NewMsgTransferTokenizeShareRecord(1, addrs[1].String(), addrs[2].String()))
+	assert.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+
+	_, err = msgServer.TransferTokenizeShareRecord(ctx, types.NewMsgTransferTokenizeShareRecord(1, addrs[0].String(), addrs[2].String()))
+	assert.NilError(t, err)
+
+	records, err := f.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrs[0])
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(records))
+
+	records, err = f.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrs[2])
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(records))
+	assert.Equal(t, addrs[2].String(), records[0].Owner)
+}
+
+func TestLiquidStakingCaps(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	bondDenom, err := f.stakingKeeper.BondDenom(ctx)
+	assert.NilError(t, err)
+
+	addrs, valAddrs, _ := createValidators(t, f, []int64{9, 8, 7})
+	tokens := f.stakingKeeper.TokensFromConsensusPower(ctx, 1)
+	tokenize := types.NewMsgTokenizeShares(addrs[0].String(), valAddrs[0].String(), sdk.NewCoin(bondDenom, tokens), addrs[0].String())
+
+	// global cap
+	params, err := f.stakingKeeper.GetParams(ctx)
+	assert.NilError(t, err)
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(1, 2)
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+
+	_, err = msgServer.TokenizeShares(ctx, tokenize)
+	assert.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+
+	// validator cap
+	params.GlobalLiquidStakingCap = math.LegacyOneDec()
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(1, 2)
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+
+	_, err = msgServer.TokenizeShares(ctx, tokenize)
+	assert.ErrorIs(t, err, types.ErrValidatorLiquidStakingCapExceeded)
+
+	// validator bond cap
+	params.ValidatorLiquidStakingCap = math.LegacyOneDec()
+	params.ValidatorBondFactor = math.LegacyNewDec(1)
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+
+	_, err = msgServer.TokenizeShares(ctx, tokenize)
+	assert.ErrorIs(t, err, types.ErrInsufficientValidatorBondShares)
+
+	// a validator bond allows for as many liquid shares as the factor
+	_, err = msgServer.Delegate(ctx, types.NewMsgDelegate(addrs[1].String(), valAddrs[0].String(), sdk.NewCoin(bondDenom, tokens)))
+	assert.NilError(t, err)
+	_, err = msgServer.ValidatorBond(ctx, types.NewMsgValidatorBond(addrs[1].String(), valAddrs[0].String()))
+	assert.NilError(t, err)
+
+	validator, err := f.stakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(tokens), validator.ValidatorBondShares)
+
+	_, err = msgServer.TokenizeShares(ctx, tokenize)
+	assert.NilError(t, err)
+
+	// validator bond delegations cannot be tokenized, nor unbonded below the
+	// validator bond backing the liquid shares
+	_, err = msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(addrs[1].String(), valAddrs[0].String(), sdk.NewCoin(bondDenom, tokens), addrs[1].String()))
+	assert.ErrorIs(t, err, types.ErrValidatorBondNotAllowedForTokenizeShare)
+
+	_, err = msgServer.Undelegate(ctx, types.NewMsgUndelegate(addrs[1].String(), valAddrs[0].String(), sdk.NewCoin(bondDenom, math.OneInt())))
+	assert.ErrorIs(t, err, types.ErrInsufficientValidatorBondShares)
+
+	// delegating more to the validator bond increases it
+	_, err = msgServer.Delegate(ctx, types.NewMsgDelegate(addrs[1].String(), valAddrs[0].String(), sdk.NewCoin(bondDenom, tokens)))
+	assert.NilError(t, err)
+
+	validator, err = f.stakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(tokens.MulRaw(2)), validator.ValidatorBondShares)
+
+	_, err = msgServer.Undelegate(ctx, types.NewMsgUndelegate(addrs[1].String(), valAddrs[0].String(), sdk.NewCoin(bondDenom, tokens)))
+	assert.NilError(t, err)
+
+	validator, err = f.stakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(tokens), validator.ValidatorBondShares)
+}
+
+func TestSlashLiquidStakedTokens(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	bondDenom, err := f.stakingKeeper.BondDenom(ctx)
+	assert.NilError(t, err)
+
+	addrs, valAddrs, vals := createValidators(t, f, []int64{9, 8, 7})
+	tokens := f.stakingKeeper.TokensFromConsensusPower(ctx, 3)
+
+	_, err = msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(addrs[0].String(), valAddrs[0].String(), sdk.NewCoin(bondDenom, tokens), addrs[0].String()))
+	assert.NilError(t, err)
+
+	consAddr, err := vals[0].GetConsAddr()
+	assert.NilError(t, err)
+
+	// a third of the validator tokens are liquid staked
+	_, err = f.stakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 9, math.LegacyNewDecWithPrec(1, 1))
+	assert.NilError(t, err)
+
+	totalLiquidStaked, err := f.stakingKeeper.GetTotalLiquidStakedTokens(ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, tokens.Sub(tokens.QuoRaw(10)), totalLiquidStaked)
+}
@@ -242,3 +242,32 @@ func (s *contextTestSuite) TestUnwrapSDKContext() {
 	sdkCtx2 = types.UnwrapSDKContext(ctx)
 	s.Require().Equal(sdkCtx, sdkCtx2)
 }
+
+func (s *contextTestSuite) TestReplay() {
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey(s.T().Name()), storetypes.NewTransientStoreKey("transient_"+s.T().Name()))
+	s.Require().Nil(types.ReplayFromContext(ctx))
+	s.Require().Nil(types.ReplayFromContext(types.Context{}))
+
+	ctx = types.WithReplay(ctx)
+	replay := types.ReplayFromContext(ctx)
+	s.Require().NotNil(replay)
+	s.Require().Nil(replay.Value("key"))
+
+	// the replay is shared by the contexts derived from the replay context
+	types.ReplayFromContext(ctx.WithBlockHeight(2)).SetValue("key", 1)
+	s.Require().Equal(1, replay.Value("key"))
+
+	// a branch reads the values of its replay, which only sees the values of
+	// the branch once written
+	branch := types.ReplayFromContext(types.WithReplay(ctx))
+	s.Require().Equal(1, branch.Value("key"))
+	branch.SetValue("key", 2)
+	branch.SetValue("other", 3)
+	s.Require().Equal(2, branch.Value("key"))
+	s.Require().Equal(1, replay.Value("key"))
+	s.Require().Nil(replay.Value("other"))
+
+	branch.Write()
+	s.Require().Equal(2, replay.Value("key"))
+	s.Require().Equal(3, replay.Value("other"))
+}
@@ -96,7 +96,7 @@ var (
 	// ErrInvalidType defines an error an invalid type.
 	ErrInvalidType = errorsmod.Register(RootCodespace, 29, "invalid type")
 
-	// ErrTxTimeoutHeight defines an error for when a tx is rejected out due to an
+	// ErrTxTimeoutHeight defines an error for when a tx is rejected due to an
 	// explicitly set timeout height.
 	ErrTxTimeoutHeight = errorsmod.Register(RootCodespace, 30, "tx timeout height")
 
@@ -140,6 +140,10 @@ var (
 	// supplied.
 	ErrInvalidGasLimit = errorsmod.Register(RootCodespace, 41, "invalid gas limit")
 
+	// ErrTxTimeout defines an error for when a tx is rejected due to an
+	// explicitly set timeout timestamp.
+	ErrTxTimeout = errorsmod.Register(RootCodespace, 42, "tx timeout")
+
 	// ErrPanic should only be set when we recovering from a panic
 	ErrPanic = errorsmod.ErrPanic
 )
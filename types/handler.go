@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
 // AnteHandler authenticates transactions, before their internal messages are handled.
 // If newCtx.IsZero(), ctx is used instead.
 type AnteHandler func(ctx Context, tx Tx, simulate bool) (newCtx Context, err error)
@@ -49,6 +55,35 @@ func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
 		}
 	}
 
+	return func(ctx Context, tx Tx, simulate bool) (Context, error) {
+		if tracer := DecoratorTracerFromContext(ctx); tracer != nil {
+			return traceAnteDecorators(tracer, chain)(ctx, tx, simulate)
+		}
+
+		return handlerChain[0](ctx, tx, simulate)
+	}
+}
+
+// traceAnteDecorators chains AnteDecorators like ChainAnteDecorators, reporting
+// the gas consumed by each of them to the tracer.
+func traceAnteDecorators(tracer DecoratorTracer, chain []AnteDecorator) AnteHandler {
+	handlerChain := make([]AnteHandler, len(chain)+1)
+	handlerChain[len(chain)] = func(ctx Context, tx Tx, simulate bool) (Context, error) {
+		return ctx, nil
+	}
+	for i := 0; i < len(chain); i++ {
+		ii := i
+		handlerChain[ii] = func(ctx Context, tx Tx, simulate bool) (Context, error) {
+			trace := newDecoratorTrace(tracer, chain[ii], ctx)
+			defer trace.end(ctx)
+
+			return chain[ii].AnteHandle(ctx, tx, simulate, func(ctx Context, tx Tx, simulate bool) (Context, error) {
+				trace.end(ctx)
+				return handlerChain[ii+1](ctx, tx, simulate)
+			})
+		}
+	}
+
 	return handlerChain[0]
 }
 
@@ -76,9 +111,114 @@ func ChainPostDecorators(chain ...PostDecorator) PostHandler {
 			return chain[ii].PostHandle(ctx, tx, simulate, success, handlerChain[ii+1])
 		}
 	}
+
+	return func(ctx Context, tx Tx, simulate, success bool) (Context, error) {
+		if tracer := DecoratorTracerFromContext(ctx); tracer != nil {
+			return tracePostDecorators(tracer, chain)(ctx, tx, simulate, success)
+		}
+
+		return handlerChain[0](ctx, tx, simulate, success)
+	}
+}
+
+// tracePostDecorators chains PostDecorators like ChainPostDecorators, reporting
+// the gas consumed by each of them to the tracer.
+func tracePostDecorators(tracer DecoratorTracer, chain []PostDecorator) PostHandler {
+	handlerChain := make([]PostHandler, len(chain)+1)
+	handlerChain[len(chain)] = func(ctx Context, tx Tx, simulate, success bool) (Context, error) {
+		return ctx, nil
+	}
+	for i := 0; i < len(chain); i++ {
+		ii := i
+		handlerChain[ii] = func(ctx Context, tx Tx, simulate, success bool) (Context, error) {
+			trace := newDecoratorTrace(tracer, chain[ii], ctx)
+			defer trace.end(ctx)
+
+			return chain[ii].PostHandle(ctx, tx, simulate, success, func(ctx Context, tx Tx, simulate, success bool) (Context, error) {
+				trace.end(ctx)
+				return handlerChain[ii+1](ctx, tx, simulate, success)
+			})
+		}
+	}
+
 	return handlerChain[0]
 }
 
+// DecoratorTracer is notified of the gas consumed by each decorator of the
+// handlers chained with ChainAnteDecorators and ChainPostDecorators, when it is
+// set in the context of the transaction with WithDecoratorTracer.
+type DecoratorTracer interface {
+	// TraceDecorator is called when the decorator of the given name calls the
+	// next one in the chain, or returns without calling it, with the gas it
+	// consumed.
+	TraceDecorator(name string, gasUsed uint64)
+}
+
+type decoratorTracerKey struct{}
+
+// decoratorTracers is the number of contexts tracing the decorators in use, so
+// that the tracer is only looked up in the context of the transactions while
+// decorators are traced.
+var decoratorTracers atomic.Int64
+
+// WithDecoratorTracer returns a context tracing the decorators of the ante and
+// post handlers with the given tracer, and the function to call once the
+// context is no longer used.
+func WithDecoratorTracer(ctx Context, tracer DecoratorTracer) (Context, func()) {
+	decoratorTracers.Add(1)
+
+	var once sync.Once
+	return ctx.WithValue(decoratorTracerKey{}, tracer), func() {
+		once.Do(func() { decoratorTracers.Add(-1) })
+	}
+}
+
+// DecoratorTracerFromContext returns the DecoratorTracer set in the context with
+// WithDecoratorTracer, or nil if the decorators are not traced.
+func DecoratorTracerFromContext(ctx Context) DecoratorTracer {
+	if decoratorTracers.Load() == 0 || ctx.Context() == nil {
+		return nil
+	}
+
+	tracer, _ := ctx.Value(decoratorTracerKey{}).(DecoratorTracer)
+	return tracer
+}
+
+// decoratorTrace measures the gas consumed by a decorator until it calls the
+// next one in the chain or returns.
+type decoratorTrace struct {
+	tracer   DecoratorTracer
+	name     string
+	gasStart uint64
+	ended    bool
+}
+
+func newDecoratorTrace(tracer DecoratorTracer, decorator any, ctx Context) *decoratorTrace {
+	return &decoratorTrace{
+		tracer:   tracer,
+		name:     fmt.Sprintf("%T", decorator),
+		gasStart: ctx.GasMeter().GasConsumed(),
+	}
+}
+
+// end reports the gas consumed by the decorator on the gas meter of the given
+// context, once. The gas meter may have been replaced by the decorator, in
+// which case only the gas consumed on the new one is reported.
+func (t *decoratorTrace) end(ctx Context) {
+	if t.ended {
+		return
+	}
+	t.ended = true
+
+	var gasUsed uint64
+	if gasConsumed := ctx.GasMeter().GasConsumed(); gasConsumed >= t.gasStart {
+		gasUsed = gasConsumed - t.gasStart
+	} else {
+		gasUsed = gasConsumed
+	}
+	t.tracer.TraceDecorator(t.name, gasUsed)
+}
+
 // Terminator AnteDecorator will get added to the chain to simplify decorator code
 // Don't need to check if next == nil further up the chain
 //
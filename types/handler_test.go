@@ -1,11 +1,15 @@
 package types_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -64,3 +68,65 @@ func TestChainPostDecorators(t *testing.T) {
 	)(ctx, tx, true, true)
 	require.NoError(t, err)
 }
+
+// gasDecorator is an ante and post decorator consuming its amount of gas.
+type gasDecorator uint64
+
+func (d gasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx.GasMeter().ConsumeGas(uint64(d), "gas decorator")
+	return next(ctx, tx, simulate)
+}
+
+func (d gasDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	ctx.GasMeter().ConsumeGas(uint64(d), "gas decorator")
+	return next(ctx, tx, simulate, success)
+}
+
+// failingDecorator is an ante decorator consuming gas then failing.
+type failingDecorator struct{}
+
+func (failingDecorator) AnteHandle(ctx sdk.Context, _ sdk.Tx, _ bool, _ sdk.AnteHandler) (sdk.Context, error) {
+	ctx.GasMeter().ConsumeGas(7, "failing decorator")
+	return ctx, errors.New("failure")
+}
+
+type decoratorTraces struct {
+	names []string
+	gas   []uint64
+}
+
+func (t *decoratorTraces) TraceDecorator(name string, gasUsed uint64) {
+	t.names = append(t.names, name)
+	t.gas = append(t.gas, gasUsed)
+}
+
+func TestChainDecoratorsTracer(t *testing.T) {
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test")).
+		WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	// the decorators are not traced without a tracer
+	anteHandler := sdk.ChainAnteDecorators(gasDecorator(10), gasDecorator(20), failingDecorator{}, gasDecorator(40))
+	_, err := anteHandler(ctx, nil, false)
+	require.EqualError(t, err, "failure")
+	require.Equal(t, uint64(37), ctx.GasMeter().GasConsumed())
+
+	// the gas of each decorator excludes the gas of the ones it wraps
+	tracer := &decoratorTraces{}
+	tracedCtx, done := sdk.WithDecoratorTracer(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), tracer)
+	_, err = anteHandler(tracedCtx, nil, false)
+	require.EqualError(t, err, "failure")
+	require.Equal(t, []string{"types_test.gasDecorator", "types_test.gasDecorator", "types_test.failingDecorator"}, tracer.names)
+	require.Equal(t, []uint64{10, 20, 7}, tracer.gas)
+
+	// the tracer is only looked up until the context is done with
+	done()
+	require.Nil(t, sdk.DecoratorTracerFromContext(tracedCtx))
+
+	tracer = &decoratorTraces{}
+	tracedCtx, done = sdk.WithDecoratorTracer(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), tracer)
+	defer done()
+	_, err = sdk.ChainPostDecorators(gasDecorator(5), gasDecorator(15))(tracedCtx, nil, false, true)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5, 15}, tracer.gas)
+}
@@ -0,0 +1,377 @@
+package mempool
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ ExtMempool = (*JournaledMempool)(nil)
+
+// DefaultJournalCompactThreshold is the default number of records from which
+// the journal of a JournaledMempool is compacted once most of its records are
+// obsolete.
+const DefaultJournalCompactThreshold = 10_000
+
+// maxJournalRecordSize bounds the size of the records read from a journal, a
+// larger size meaning that the record is corrupted.
+const maxJournalRecordSize = 128 << 20
+
+const (
+	journalInsert byte = iota + 1
+	journalRemove
+)
+
+// JournaledMempool is a mempool journaling the transactions inserted into and
+// removed from another mempool to a file, so that they can be replayed after a
+// restart. A transaction is journaled with its bytes, taken from the context
+// of Insert, and identified by its first signer and nonce as in the mempools
+// of this package.
+//
+// The records are written to the file without being synced, so they survive a
+// crash of the process but not necessarily of the host. The journal is
+// rewritten with the transactions of the mempool once most of its records are
+// obsolete.
+type JournaledMempool struct {
+	mtx             sync.Mutex
+	mempool         ExtMempool
+	path            string
+	file            *os.File
+	signerExtractor SignerExtractionAdapter
+	// txs are the bytes of the journaled transactions not removed, and order
+	// their order of insertion.
+	txs              map[txKey][]byte
+	order            []txKey
+	records          int
+	compactThreshold int
+	// removeErr is the first failure to journal a removal, returned by Close.
+	removeErr error
+}
+
+type JournalOptions func(*JournaledMempool)
+
+// JournalCompactThresholdOpt sets the number of records from which the journal
+// is compacted once most of its records are obsolete.
+func JournalCompactThresholdOpt(threshold int) JournalOptions {
+	return func(mp *JournaledMempool) {
+		mp.compactThreshold = threshold
+	}
+}
+
+// JournalSignerExtractorOpt sets the adapter extracting the signers identifying
+// the transactions.
+func JournalSignerExtractorOpt(signerExtractor SignerExtractionAdapter) JournalOptions {
+	return func(mp *JournaledMempool) {
+		mp.signerExtractor = signerExtractor
+	}
+}
+
+// NewJournaledMempool returns a mempool journaling the transactions of a
+// mempool to the file at path, which is created if it does not exist. The
+// transactions already journaled are inserted by Replay.
+func NewJournaledMempool(mempool ExtMempool, path string, opts ...JournalOptions) (*JournaledMempool, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open mempool journal: %w", err)
+	}
+
+	mp := &JournaledMempool{
+		mempool:          mempool,
+		path:             path,
+		file:             file,
+		signerExtractor:  NewDefaultSignerExtractionAdapter(),
+		txs:              make(map[txKey][]byte),
+		compactThreshold: DefaultJournalCompactThreshold,
+	}
+	for _, opt := range opts {
+		opt(mp)
+	}
+
+	return mp, nil
+}
+
+// Unwrap returns the journaled mempool.
+func (mp *JournaledMempool) Unwrap() ExtMempool {
+	return mp.mempool
+}
+
+// Insert inserts a transaction into the mempool and journals it with the bytes
+// held by the context, as set by CheckTx. A transaction is not journaled if the
+// context holds no transaction bytes or if it has no signer.
+func (mp *JournaledMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if err := mp.mempool.Insert(ctx, tx); err != nil {
+		return err
+	}
+
+	sdkCtx, ok := ctx.(sdk.Context)
+	if !ok || len(sdkCtx.TxBytes()) == 0 {
+		return nil
+	}
+	key, ok := mp.txKey(tx)
+	if !ok {
+		return nil
+	}
+
+	if _, ok := mp.txs[key]; !ok {
+		mp.order = append(mp.order, key)
+	}
+	mp.txs[key] = sdkCtx.TxBytes()
+	return mp.write(journalInsert, key, sdkCtx.TxBytes())
+}
+
+// Select returns an iterator over the transactions of the mempool.
+func (mp *JournaledMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	return mp.mempool.Select(ctx, txs)
+}
+
+// SelectBy calls callback on the transactions of the mempool until it returns
+// false.
+func (mp *JournaledMempool) SelectBy(ctx context.Context, txs [][]byte, callback func(sdk.Tx) bool) {
+	mp.mempool.SelectBy(ctx, txs, callback)
+}
+
+// CountTx returns the number of transactions of the mempool.
+func (mp *JournaledMempool) CountTx() int {
+	return mp.mempool.CountTx()
+}
+
+// Remove removes a transaction from the mempool and journals its removal. A
+// failure to journal the removal is returned by Close rather than by Remove, as
+// it would fail the transaction of the block removing it.
+func (mp *JournaledMempool) Remove(tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if err := mp.mempool.Remove(tx); err != nil {
+		return err
+	}
+
+	key, ok := mp.txKey(tx)
+	if !ok {
+		return nil
+	}
+	if _, ok := mp.txs[key]; !ok {
+		return nil
+	}
+	delete(mp.txs, key)
+	if err := mp.write(journalRemove, key, nil); err != nil && mp.removeErr == nil {
+		mp.removeErr = err
+	}
+	return nil
+}
+
+// Replay inserts the transactions of the journal not removed, in their order
+// of insertion, through checkTx, which is expected to validate them and insert
+// the valid ones into the mempool as CheckTx does. The journal is then
+// compacted, so that the transactions failing checkTx are dropped. It returns
+// the number of replayed transactions.
+func (mp *JournaledMempool) Replay(checkTx func(txBytes []byte) error) (int, error) {
+	mp.mtx.Lock()
+	txs, err := mp.readJournal()
+	// the transactions are journaled again when inserted by checkTx
+	mp.txs, mp.order = make(map[txKey][]byte), nil
+	mp.mtx.Unlock()
+	if err != nil {
+		return 0, err
+	}
+
+	replayed := 0
+	for _, txBytes := range txs {
+		if err := checkTx(txBytes); err == nil {
+			replayed++
+		}
+	}
+
+	return replayed, mp.Compact()
+}
+
+// Compact rewrites the journal with the journaled transactions still in the
+// mempool.
+func (mp *JournaledMempool) Compact() error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	return mp.compact()
+}
+
+// Close closes the journal.
+func (mp *JournaledMempool) Close() error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	return errors.Join(mp.removeErr, mp.file.Close())
+}
+
+func (mp *JournaledMempool) txKey(tx sdk.Tx) (txKey, bool) {
+	sigs, err := mp.signerExtractor.GetSigners(tx)
+	if err != nil || len(sigs) == 0 {
+		return txKey{}, false
+	}
+	nonce, err := txNonce(tx, sigs[0].Sequence)
+	if err != nil {
+		return txKey{}, false
+	}
+	return txKey{address: sigs[0].Signer.String(), nonce: nonce}, true
+}
+
+// write appends a record to the journal, compacting it once most of its records
+// are obsolete.
+func (mp *JournaledMempool) write(op byte, key txKey, txBytes []byte) error {
+	if _, err := mp.file.Write(encodeJournalRecord(op, key, txBytes)); err != nil {
+		return fmt.Errorf("failed to write mempool journal: %w", err)
+	}
+	mp.records++
+
+	if mp.records >= mp.compactThreshold && mp.records > 2*len(mp.txs) {
+		return mp.compact()
+	}
+	return nil
+}
+
+func (mp *JournaledMempool) compact() error {
+	// the transactions evicted by the mempool without being removed are
+	// dropped
+	inMempool := make(map[txKey]bool, len(mp.txs))
+	mp.mempool.SelectBy(context.Background(), nil, func(tx sdk.Tx) bool {
+		if key, ok := mp.txKey(tx); ok {
+			inMempool[key] = true
+		}
+		return true
+	})
+
+	tmpPath := mp.path + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to compact mempool journal: %w", err)
+	}
+	w := bufio.NewWriter(tmp)
+	order := make([]txKey, 0, len(mp.txs))
+	for _, key := range mp.order {
+		txBytes, ok := mp.txs[key]
+		if !ok {
+			continue
+		}
+		if !inMempool[key] {
+			delete(mp.txs, key)
+			continue
+		}
+		order = append(order, key)
+		if _, err := w.Write(encodeJournalRecord(journalInsert, key, txBytes)); err != nil {
+			return errors.Join(fmt.Errorf("failed to compact mempool journal: %w", err), tmp.Close())
+		}
+	}
+	if err := errors.Join(w.Flush(), tmp.Sync(), tmp.Close()); err != nil {
+		return fmt.Errorf("failed to compact mempool journal: %w", err)
+	}
+	if err := os.Rename(tmpPath, mp.path); err != nil {
+		return fmt.Errorf("failed to compact mempool journal: %w", err)
+	}
+
+	file, err := os.OpenFile(mp.path, os.O_RDWR|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open mempool journal: %w", err)
+	}
+	_ = mp.file.Close()
+	mp.file, mp.order, mp.records = file, order, len(order)
+	return nil
+}
+
+// readJournal returns the bytes of the journaled transactions not removed, in
+// their order of insertion. The records following a truncated or corrupted
+// record, written when the process crashed, are ignored.
+func (mp *JournaledMempool) readJournal() ([][]byte, error) {
+	if _, err := mp.file.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to read mempool journal: %w", err)
+	}
+
+	txs := make(map[txKey][]byte)
+	var order []txKey
+	r := bufio.NewReader(mp.file)
+	for {
+		op, key, txBytes, err := decodeJournalRecord(r)
+		if err != nil {
+			break
+		}
+		switch op {
+		case journalInsert:
+			if _, ok := txs[key]; !ok {
+				order = append(order, key)
+			}
+			txs[key] = txBytes
+		case journalRemove:
+			delete(txs, key)
+		}
+	}
+
+	result := make([][]byte, 0, len(txs))
+	for _, key := range order {
+		if txBytes, ok := txs[key]; ok {
+			result = append(result, txBytes)
+			// a transaction removed and inserted again is replayed once
+			delete(txs, key)
+		}
+	}
+	return result, nil
+}
+
+// encodeJournalRecord encodes a record as its length, its operation, signer,
+// nonce and transaction bytes, and its checksum.
+func encodeJournalRecord(op byte, key txKey, txBytes []byte) []byte {
+	payload := []byte{op}
+	payload = binary.AppendUvarint(payload, uint64(len(key.address)))
+	payload = append(payload, key.address...)
+	payload = binary.AppendUvarint(payload, key.nonce)
+	payload = append(payload, txBytes...)
+
+	record := binary.AppendUvarint(nil, uint64(len(payload)))
+	record = append(record, payload...)
+	return binary.BigEndian.AppendUint32(record, crc32.ChecksumIEEE(payload))
+}
+
+func decodeJournalRecord(r *bufio.Reader) (op byte, key txKey, txBytes []byte, err error) {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, txKey{}, nil, err
+	}
+	if size > maxJournalRecordSize {
+		return 0, txKey{}, nil, errors.New("invalid mempool journal record size")
+	}
+	record := make([]byte, size+4)
+	if _, err := io.ReadFull(r, record); err != nil {
+		return 0, txKey{}, nil, err
+	}
+	payload := record[:size]
+	if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(record[size:]) {
+		return 0, txKey{}, nil, errors.New("invalid mempool journal record checksum")
+	}
+
+	if len(payload) == 0 {
+		return 0, txKey{}, nil, errors.New("empty mempool journal record")
+	}
+	op, payload = payload[0], payload[1:]
+	addressLen, n := binary.Uvarint(payload)
+	if n <= 0 || uint64(len(payload)-n) < addressLen {
+		return 0, txKey{}, nil, errors.New("invalid mempool journal record")
+	}
+	key.address, payload = string(payload[n:n+int(addressLen)]), payload[n+int(addressLen):]
+	key.nonce, n = binary.Uvarint(payload)
+	if n <= 0 {
+		return 0, txKey{}, nil, errors.New("invalid mempool journal record")
+	}
+	return op, key, payload[n:], nil
+}
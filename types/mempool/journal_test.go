@@ -0,0 +1,125 @@
+package mempool_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestJournaledMempool(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	path := filepath.Join(t.TempDir(), "data", "mempool.wal")
+
+	txs := make(map[string]testTx)
+	for i := 0; i < 4; i++ {
+		tx := testTx{id: i, nonce: uint64(i), address: accounts[i%2].Address}
+		txs[fmt.Sprintf("tx%d", i)] = tx
+	}
+	newMempool := func() *mempool.JournaledMempool {
+		mp, err := mempool.NewJournaledMempool(mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(0)), path)
+		require.NoError(t, err)
+		return mp
+	}
+	// checkTx inserts the valid txs into the mempool as CheckTx does, tx2
+	// being invalid
+	checkTx := func(mp *mempool.JournaledMempool, replayed *[]string) func([]byte) error {
+		return func(txBytes []byte) error {
+			*replayed = append(*replayed, string(txBytes))
+			if string(txBytes) == "tx2" {
+				return errors.New("invalid tx")
+			}
+			return mp.Insert(ctx.WithTxBytes(txBytes), txs[string(txBytes)])
+		}
+	}
+
+	mp := newMempool()
+	for i := 0; i < 4; i++ {
+		name := fmt.Sprintf("tx%d", i)
+		require.NoError(t, mp.Insert(ctx.WithTxBytes([]byte(name)), txs[name]))
+	}
+	// a tx inserted without its bytes is not journaled
+	require.NoError(t, mp.Insert(ctx, testTx{id: 4, nonce: 4, address: accounts[0].Address}))
+	require.NoError(t, mp.Remove(txs["tx1"]))
+	require.ErrorIs(t, mp.Remove(txs["tx1"]), mempool.ErrTxNotFound)
+	require.Equal(t, 4, mp.CountTx())
+	require.NoError(t, mp.Close())
+
+	// the txs not removed are replayed in order after a restart, the invalid
+	// ones being dropped from the journal
+	mp = newMempool()
+	var replayed []string
+	n, err := mp.Replay(checkTx(mp, &replayed))
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Equal(t, []string{"tx0", "tx2", "tx3"}, replayed)
+	require.Equal(t, 2, mp.CountTx())
+	require.NoError(t, mp.Close())
+
+	// a truncated record written by a crash is ignored
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o600)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{100, 1, 2})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	mp = newMempool()
+	replayed = nil
+	n, err = mp.Replay(checkTx(mp, &replayed))
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Equal(t, []string{"tx0", "tx3"}, replayed)
+
+	// the txs evicted by the mempool are dropped on compaction
+	require.NoError(t, mp.Unwrap().Remove(txs["tx0"]))
+	require.NoError(t, mp.Compact())
+	require.NoError(t, mp.Close())
+
+	mp = newMempool()
+	replayed = nil
+	_, err = mp.Replay(checkTx(mp, &replayed))
+	require.NoError(t, err)
+	require.Equal(t, []string{"tx3"}, replayed)
+	require.NoError(t, mp.Close())
+}
+
+func TestJournaledMempoolCompaction(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	path := filepath.Join(t.TempDir(), "mempool.wal")
+
+	mp, err := mempool.NewJournaledMempool(
+		mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(0)), path,
+		mempool.JournalCompactThresholdOpt(10),
+	)
+	require.NoError(t, err)
+
+	tx := testTx{address: accounts[0].Address}
+	txBytes := make([]byte, 100)
+	require.NoError(t, mp.Insert(ctx.WithTxBytes(txBytes), tx))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	recordSize := info.Size()
+
+	// the journal is compacted once most of its records are obsolete
+	for i := 0; i < 100; i++ {
+		require.NoError(t, mp.Insert(ctx.WithTxBytes(txBytes), testTx{nonce: 1, address: accounts[0].Address}))
+		require.NoError(t, mp.Remove(testTx{nonce: 1, address: accounts[0].Address}))
+	}
+	info, err = os.Stat(path)
+	require.NoError(t, err)
+	require.Less(t, info.Size(), 10*recordSize)
+	require.NoError(t, mp.Close())
+}
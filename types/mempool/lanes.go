@@ -0,0 +1,227 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var ErrNoLane = errors.New("no lane matches the tx")
+
+var _ ExtMempool = (*LaneMempool)(nil)
+
+// LaneRule defines the transactions of a lane, and the share of the block
+// space they can use.
+type LaneRule struct {
+	// Name identifies the lane.
+	Name string
+	// Match returns whether a transaction belongs to the lane, from its
+	// contents only, as it is used to verify the proposals. A nil Match matches
+	// all the transactions.
+	Match func(ctx context.Context, tx sdk.Tx) bool
+	// MaxBlockSpace is the maximum share of the bytes, and of the gas if the
+	// block gas is limited, used by the transactions of the lane in a block,
+	// greater than 0 and at most 1.
+	MaxBlockSpace math.LegacyDec
+}
+
+// Limit returns the share of a block limit the transactions of the lane can
+// use.
+func (r LaneRule) Limit(limit uint64) uint64 {
+	return math.LegacyNewDecFromInt(math.NewIntFromUint64(limit)).Mul(r.MaxBlockSpace).TruncateInt().Uint64()
+}
+
+// LaneRules are the rules of the lanes of the blocks, in their order in the
+// blocks, a transaction belonging to the first lane matching it. Verifying the
+// proposals against them, they are part of the configuration of the chain,
+// which must be the same on all the validators, unlike the mempools.
+type LaneRules []LaneRule
+
+// NewLaneRules returns the given rules, in their order in the blocks, once
+// validated.
+func NewLaneRules(rules ...LaneRule) (LaneRules, error) {
+	names := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		if rule.Name == "" || names[rule.Name] {
+			return nil, fmt.Errorf("lane name %q is empty or duplicated", rule.Name)
+		}
+		names[rule.Name] = true
+
+		if rule.MaxBlockSpace.IsNil() || !rule.MaxBlockSpace.IsPositive() || rule.MaxBlockSpace.GT(math.LegacyOneDec()) {
+			return nil, fmt.Errorf("lane %s max block space must be greater than 0 and at most 1, got %s", rule.Name, rule.MaxBlockSpace)
+		}
+	}
+
+	return rules, nil
+}
+
+// Index returns the index of the lane of a transaction, -1 if no lane matches
+// it.
+func (rules LaneRules) Index(ctx context.Context, tx sdk.Tx) int {
+	for i, rule := range rules {
+		if rule.Match == nil || rule.Match(ctx, tx) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Lane is a mempool holding the transactions matched by the rule of the lane.
+type Lane struct {
+	LaneRule
+	// Mempool holds the transactions of the lane.
+	Mempool Mempool
+}
+
+// LaneMempool is a mempool composed of lanes, a transaction being held by the
+// first lane matching it. The transactions are selected lane by lane, in the
+// order of the lanes, each lane being limited to its share of the block space
+// by the DefaultProposalHandler, unless it is given the lane rules of the
+// chain. Reserving a share of the block space to some transactions is done by
+// limiting the share of the other lanes.
+type LaneMempool struct {
+	lanes []Lane
+	rules LaneRules
+}
+
+// NewLaneMempool returns a mempool composed of the given lanes, in their
+// order of selection.
+func NewLaneMempool(lanes ...Lane) (*LaneMempool, error) {
+	if len(lanes) == 0 {
+		return nil, errors.New("a lane mempool requires at least one lane")
+	}
+
+	rules := make([]LaneRule, len(lanes))
+	for i, lane := range lanes {
+		if lane.Mempool == nil {
+			return nil, fmt.Errorf("lane %s has no mempool", lane.Name)
+		}
+		rules[i] = lane.LaneRule
+	}
+
+	laneRules, err := NewLaneRules(rules...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LaneMempool{lanes: lanes, rules: laneRules}, nil
+}
+
+// MatchMsgTypes returns a Lane.Match function matching the transactions whose
+// messages all are of the given type URLs.
+func MatchMsgTypes(msgTypeURLs ...string) func(context.Context, sdk.Tx) bool {
+	types := make(map[string]bool, len(msgTypeURLs))
+	for _, typeURL := range msgTypeURLs {
+		types[typeURL] = true
+	}
+
+	return func(_ context.Context, tx sdk.Tx) bool {
+		msgs := tx.GetMsgs()
+		for _, msg := range msgs {
+			if !types[sdk.MsgTypeURL(msg)] {
+				return false
+			}
+		}
+		return len(msgs) > 0
+	}
+}
+
+// Lanes returns the lanes of the mempool, in their order of selection.
+func (mp *LaneMempool) Lanes() []Lane {
+	return mp.lanes
+}
+
+// Rules returns the rules of the lanes of the mempool.
+func (mp *LaneMempool) Rules() LaneRules {
+	return mp.rules
+}
+
+// LaneIndex returns the index of the lane of a transaction, -1 if no lane
+// matches it.
+func (mp *LaneMempool) LaneIndex(ctx context.Context, tx sdk.Tx) int {
+	return mp.rules.Index(ctx, tx)
+}
+
+// Insert inserts a transaction into its lane, failing with ErrNoLane if no lane
+// matches it.
+func (mp *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	i := mp.LaneIndex(ctx, tx)
+	if i < 0 {
+		return ErrNoLane
+	}
+	return mp.lanes[i].Mempool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the transactions of the lanes, in the order
+// of the lanes.
+func (mp *LaneMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	iter := &laneIterator{mp: mp, ctx: ctx, txs: txs, lane: -1}
+	return iter.advance()
+}
+
+// SelectBy calls callback on the transactions of the lanes, in the order of the
+// lanes, until it returns false.
+func (mp *LaneMempool) SelectBy(ctx context.Context, txs [][]byte, callback func(sdk.Tx) bool) {
+	for _, lane := range mp.lanes {
+		stopped := false
+		SelectBy(ctx, lane.Mempool, txs, func(tx sdk.Tx) bool {
+			stopped = !callback(tx)
+			return !stopped
+		})
+		if stopped {
+			return
+		}
+	}
+}
+
+// CountTx returns the number of transactions of all the lanes.
+func (mp *LaneMempool) CountTx() int {
+	count := 0
+	for _, lane := range mp.lanes {
+		count += lane.Mempool.CountTx()
+	}
+	return count
+}
+
+// Remove removes a transaction from its lane.
+func (mp *LaneMempool) Remove(tx sdk.Tx) error {
+	i := mp.LaneIndex(context.Background(), tx)
+	if i < 0 {
+		return ErrTxNotFound
+	}
+	return mp.lanes[i].Mempool.Remove(tx)
+}
+
+// laneIterator iterates over the transactions of the lanes of a mempool.
+type laneIterator struct {
+	mp   *LaneMempool
+	ctx  context.Context
+	txs  [][]byte
+	lane int
+	iter Iterator
+}
+
+func (i *laneIterator) Next() Iterator {
+	i.iter = i.iter.Next()
+	return i.advance()
+}
+
+func (i *laneIterator) Tx() sdk.Tx {
+	return i.iter.Tx()
+}
+
+// advance moves to the next non-empty lane once the current one is exhausted.
+func (i *laneIterator) advance() Iterator {
+	for i.iter == nil && i.lane+1 < len(i.mp.lanes) {
+		i.lane++
+		i.iter = i.mp.lanes[i.lane].Mempool.Select(i.ctx, i.txs)
+	}
+	if i.iter == nil {
+		return nil
+	}
+	return i
+}
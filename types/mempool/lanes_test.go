@@ -0,0 +1,151 @@
+package mempool_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// matchIDBelow returns a lane match function matching the test txs whose id is
+// below max.
+func matchIDBelow(max int) func(context.Context, sdk.Tx) bool {
+	return func(_ context.Context, tx sdk.Tx) bool {
+		testTx, ok := tx.(testTx)
+		return ok && testTx.id < max
+	}
+}
+
+func TestNewLaneMempool(t *testing.T) {
+	half := math.LegacyNewDecWithPrec(5, 1)
+	lane := func(name string, maxBlockSpace math.LegacyDec) mempool.Lane {
+		return mempool.Lane{
+			LaneRule: mempool.LaneRule{Name: name, MaxBlockSpace: maxBlockSpace},
+			Mempool:  mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(0)),
+		}
+	}
+
+	_, err := mempool.NewLaneMempool()
+	require.Error(t, err)
+	_, err = mempool.NewLaneMempool(lane("", half))
+	require.Error(t, err)
+	_, err = mempool.NewLaneMempool(lane("a", half), lane("a", half))
+	require.Error(t, err)
+	_, err = mempool.NewLaneMempool(mempool.Lane{LaneRule: mempool.LaneRule{Name: "a", MaxBlockSpace: half}})
+	require.Error(t, err)
+	_, err = mempool.NewLaneMempool(lane("a", math.LegacyDec{}))
+	require.Error(t, err)
+	_, err = mempool.NewLaneMempool(lane("a", math.LegacyZeroDec()))
+	require.Error(t, err)
+	_, err = mempool.NewLaneMempool(lane("a", math.LegacyNewDec(2)))
+	require.Error(t, err)
+
+	mp, err := mempool.NewLaneMempool(lane("a", half), lane("b", math.LegacyOneDec()))
+	require.NoError(t, err)
+	require.Len(t, mp.Lanes(), 2)
+	require.Equal(t, uint64(50), mp.Lanes()[0].Limit(101))
+	require.Equal(t, []string{"a", "b"}, []string{mp.Rules()[0].Name, mp.Rules()[1].Name})
+}
+
+func TestNewLaneRules(t *testing.T) {
+	half := math.LegacyNewDecWithPrec(5, 1)
+
+	_, err := mempool.NewLaneRules(mempool.LaneRule{Name: "a", MaxBlockSpace: half}, mempool.LaneRule{Name: "a", MaxBlockSpace: half})
+	require.Error(t, err)
+	_, err = mempool.NewLaneRules(mempool.LaneRule{Name: "a"})
+	require.Error(t, err)
+
+	rules, err := mempool.NewLaneRules(
+		mempool.LaneRule{Name: "a", Match: matchIDBelow(2), MaxBlockSpace: half},
+		mempool.LaneRule{Name: "b", Match: matchIDBelow(4), MaxBlockSpace: math.LegacyOneDec()},
+	)
+	require.NoError(t, err)
+
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	require.Equal(t, 0, rules.Index(ctx, testTx{id: 1}))
+	require.Equal(t, 1, rules.Index(ctx, testTx{id: 3}))
+	require.Equal(t, -1, rules.Index(ctx, testTx{id: 4}))
+}
+
+func TestLaneMempool(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+
+	mp, err := mempool.NewLaneMempool(
+		mempool.Lane{
+			LaneRule: mempool.LaneRule{Name: "first", Match: matchIDBelow(2), MaxBlockSpace: math.LegacyNewDecWithPrec(2, 1)},
+			Mempool:  mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(0)),
+		},
+		mempool.Lane{
+			LaneRule: mempool.LaneRule{Name: "second", Match: matchIDBelow(4), MaxBlockSpace: math.LegacyOneDec()},
+			Mempool:  mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(0)),
+		},
+	)
+	require.NoError(t, err)
+
+	// empty mempool behavior
+	require.Equal(t, 0, mp.CountTx())
+	require.Nil(t, mp.Select(ctx, nil))
+
+	// a tx matched by no lane is rejected
+	require.ErrorIs(t, mp.Insert(ctx, testTx{id: 4, address: accounts[0].Address}), mempool.ErrNoLane)
+	require.Equal(t, -1, mp.LaneIndex(ctx, testTx{id: 4}))
+
+	// the txs are held by their first matching lane, and selected in the
+	// order of the lanes
+	txs := []testTx{
+		{id: 3, nonce: 0, address: accounts[0].Address},
+		{id: 0, nonce: 0, address: accounts[1].Address},
+		{id: 2, nonce: 1, address: accounts[0].Address},
+		{id: 1, nonce: 1, address: accounts[1].Address},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+	require.Equal(t, 4, mp.CountTx())
+	require.Equal(t, 2, mp.Lanes()[0].Mempool.CountTx())
+	require.Equal(t, 0, mp.LaneIndex(ctx, txs[1]))
+	require.Equal(t, 1, mp.LaneIndex(ctx, txs[0]))
+
+	var ids []int
+	for _, tx := range fetchTxs(mp.Select(ctx, nil), 10) {
+		ids = append(ids, tx.(testTx).id)
+	}
+	require.Equal(t, []int{0, 1, 3, 2}, ids)
+
+	// the selection stops when the callback returns false, including in the
+	// middle of a lane
+	ids = nil
+	mp.SelectBy(ctx, nil, func(tx sdk.Tx) bool {
+		ids = append(ids, tx.(testTx).id)
+		return len(ids) < 3
+	})
+	require.Equal(t, []int{0, 1, 3}, ids)
+
+	// the txs are removed from their lane
+	require.NoError(t, mp.Remove(txs[1]))
+	require.NoError(t, mp.Remove(txs[0]))
+	require.ErrorIs(t, mp.Remove(txs[0]), mempool.ErrTxNotFound)
+	require.ErrorIs(t, mp.Remove(testTx{id: 4, address: accounts[0].Address}), mempool.ErrTxNotFound)
+	require.Equal(t, 2, mp.CountTx())
+}
+
+func TestMatchMsgTypes(t *testing.T) {
+	tx, err := unmarshalTx(msgWithdrawDelegatorReward)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.True(t, mempool.MatchMsgTypes("/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward")(ctx, tx))
+	require.False(t, mempool.MatchMsgTypes("/cosmos.gov.v1.MsgVote")(ctx, tx))
+	// a tx without messages is not matched
+	require.False(t, mempool.MatchMsgTypes("/cosmos.gov.v1.MsgVote")(ctx, testTx{}))
+}
@@ -49,8 +49,29 @@ type Iterator interface {
 var (
 	ErrTxNotFound           = errors.New("tx not found in mempool")
 	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
+	// ErrMempoolSenderTxMaxCapacity is returned when a sender reached the
+	// maximum number of transactions it can have in the mempool.
+	ErrMempoolSenderTxMaxCapacity = errors.New("sender reached max tx capacity")
 )
 
+// txNonce returns the nonce a transaction is indexed with given the sequence
+// of its first signer. Unordered transactions are not bound to the sequence of
+// their signers and are indexed by their timeout timestamp instead, so that
+// several of them may be pending for the same signer.
+func txNonce(tx sdk.Tx, sequence uint64) (uint64, error) {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	if !ok || !unorderedTx.GetUnordered() {
+		return sequence, nil
+	}
+
+	timestamp := unorderedTx.GetTimeoutTimeStamp().UnixNano()
+	if timestamp < 0 {
+		return 0, errors.New("invalid timeout timestamp value")
+	}
+
+	return uint64(timestamp), nil
+}
+
 // SelectBy is compatible with old interface to avoid breaking api.
 // In v0.52+, this function is removed and SelectBy is merged into Mempool interface.
 func SelectBy(ctx context.Context, mempool Mempool, txs [][]byte, callback func(sdk.Tx) bool) {
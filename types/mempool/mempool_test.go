@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/require"
@@ -52,6 +53,8 @@ type testTx struct {
 	address  sdk.AccAddress
 	// useful for debugging
 	strAddress string
+	unordered  bool
+	timeout    *time.Time
 }
 
 func (tx testTx) GetSigners() ([][]byte, error) { panic("not implemented") }
@@ -71,11 +74,21 @@ func (tx testTx) GetSignaturesV2() (res []txsigning.SignatureV2, err error) {
 var (
 	_ sdk.Tx                  = (*testTx)(nil)
 	_ signing.SigVerifiableTx = (*testTx)(nil)
+	_ sdk.TxWithUnordered     = (*testTx)(nil)
 	_ cryptotypes.PubKey      = (*testPubKey)(nil)
 )
 
 func (tx testTx) GetMsgs() []sdk.Msg { return nil }
 
+func (tx testTx) GetUnordered() bool { return tx.unordered }
+
+func (tx testTx) GetTimeoutTimeStamp() time.Time {
+	if tx.timeout == nil {
+		return time.Time{}
+	}
+	return *tx.timeout
+}
+
 func (tx testTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
 
 func (tx testTx) ValidateBasic() error { return nil }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"math/big"
 	"sync"
 
 	"github.com/huandu/skiplist"
@@ -36,10 +37,26 @@ type (
 		// - if MaxTx == 0, there is no cap on the number of transactions in the mempool
 		// - if MaxTx > 0, the mempool will cap the number of transactions it stores,
 		//   and will prioritize transactions by their priority and sender-nonce
-		//   (sequence number) when evicting transactions.
+		//   (sequence number) when evicting transactions if EvictOnFull is set.
 		// - if MaxTx < 0, `Insert` is a no-op.
 		MaxTx int
 
+		// EvictOnFull defines if a transaction inserted into a full mempool evicts
+		// the transaction of lowest priority, if lower than its own, along with
+		// the following transactions of its sender, rather than failing with
+		// ErrMempoolTxMaxCapacity.
+		EvictOnFull bool
+
+		// MaxTxPerSender caps the number of transactions of a sender, inserting
+		// more failing with ErrMempoolSenderTxMaxCapacity, if greater than 0.
+		MaxTxPerSender int
+
+		// TxTTL is the number of blocks after which a transaction is evicted from
+		// the mempool, along with the following transactions of its sender, if
+		// greater than 0. The height of the blocks is taken from the contexts of
+		// Insert and Select.
+		TxTTL int64
+
 		// SignerExtractor is an implementation which retrieves signer data from a sdk.Tx
 		SignerExtractor SignerExtractionAdapter
 	}
@@ -58,6 +75,19 @@ type (
 		senderIndices  map[string]*skiplist.SkipList
 		scores         map[txMeta[C]]txMeta[C]
 		cfg            PriorityNonceMempoolConfig[C]
+
+		// height is the latest block height known from the contexts, heights
+		// the heights at which the transactions were inserted and expiries the
+		// transactions in their order of insertion, if TxTTL is set.
+		height   int64
+		heights  map[txKey]int64
+		expiries []txExpiry
+	}
+
+	// txExpiry is a transaction inserted at a height.
+	txExpiry struct {
+		key    txKey
+		height int64
 	}
 
 	// PriorityNonceIterator defines an iterator that is used for mempool iteration
@@ -169,11 +199,30 @@ func NewPriorityMempool[C comparable](cfg PriorityNonceMempoolConfig[C]) *Priori
 		senderIndices:  make(map[string]*skiplist.SkipList),
 		scores:         make(map[txMeta[C]]txMeta[C]),
 		cfg:            cfg,
+		heights:        make(map[txKey]int64),
 	}
 
 	return mp
 }
 
+// MinBumpTxReplacement returns a TxReplacement rule replacing a transaction by a
+// transaction with the same nonce only if its priority is greater by at least
+// minBumpPercent percent.
+func MinBumpTxReplacement(minBumpPercent uint64) func(op, np int64, oTx, nTx sdk.Tx) bool {
+	return func(op, np int64, _, _ sdk.Tx) bool {
+		if np <= op {
+			return false
+		}
+		if op <= 0 {
+			return true
+		}
+
+		// np >= op * (100 + minBumpPercent) / 100, without overflowing
+		minPriority := new(big.Int).Mul(big.NewInt(op), new(big.Int).SetUint64(100+minBumpPercent))
+		return new(big.Int).Mul(big.NewInt(np), big.NewInt(100)).Cmp(minPriority) >= 0
+	}
+}
+
 // DefaultPriorityMempool returns a priorityNonceMempool with no options.
 func DefaultPriorityMempool() *PriorityNonceMempool[int64] {
 	return NewPriorityMempool(DefaultPriorityNonceMempoolConfig())
@@ -204,12 +253,15 @@ func (mp *PriorityNonceMempool[C]) NextSenderTx(sender string) sdk.Tx {
 func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
-	if mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx {
-		return ErrMempoolTxMaxCapacity
-	} else if mp.cfg.MaxTx < 0 {
+	if mp.cfg.MaxTx < 0 {
 		return nil
 	}
 
+	mp.expire(ctx)
+	if mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx && !mp.cfg.EvictOnFull {
+		return ErrMempoolTxMaxCapacity
+	}
+
 	sigs, err := mp.cfg.SignerExtractor.GetSigners(tx)
 	if err != nil {
 		return err
@@ -221,9 +273,24 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	sig := sigs[0]
 	sender := sig.Signer.String()
 	priority := mp.cfg.TxPriority.GetTxPriority(ctx, tx)
-	nonce := sig.Sequence
+	nonce, err := txNonce(tx, sig.Sequence)
+	if err != nil {
+		return err
+	}
 	key := txMeta[C]{nonce: nonce, priority: priority, sender: sender}
 
+	sk := txMeta[C]{nonce: nonce, sender: sender}
+	if _, txExists := mp.scores[sk]; !txExists {
+		if senderIndex, ok := mp.senderIndices[sender]; ok && mp.cfg.MaxTxPerSender > 0 && senderIndex.Len() >= mp.cfg.MaxTxPerSender {
+			return ErrMempoolSenderTxMaxCapacity
+		}
+		if mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx {
+			if err := mp.evict(sender, nonce, priority); err != nil {
+				return err
+			}
+		}
+	}
+
 	senderIndex, ok := mp.senderIndices[sender]
 	if !ok {
 		senderIndex = skiplist.New(skiplist.LessThanFunc(func(a, b any) int {
@@ -241,7 +308,6 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	//
 	// This O(log n) remove operation is rare and only happens when a tx's priority
 	// changes.
-	sk := txMeta[C]{nonce: nonce, sender: sender}
 	if oldScore, txExists := mp.scores[sk]; txExists {
 		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, senderIndex.Get(key).Value.(sdk.Tx), tx) {
 			return fmt.Errorf(
@@ -271,9 +337,48 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	mp.scores[sk] = txMeta[C]{priority: priority}
 	mp.priorityIndex.Set(key, tx)
 
+	if mp.cfg.TxTTL > 0 {
+		mp.heights[txKey{address: sender, nonce: nonce}] = mp.height
+		mp.expiries = append(mp.expiries, txExpiry{key: txKey{address: sender, nonce: nonce}, height: mp.height})
+	}
+
 	return nil
 }
 
+// evict evicts the transaction of lowest priority, along with the following
+// transactions of its sender, to insert a transaction of a higher priority. The
+// transactions of the sender of the inserted transaction preceding it are not
+// evicted.
+func (mp *PriorityNonceMempool[C]) evict(sender string, nonce uint64, priority C) error {
+	lowest := mp.priorityIndex.Back().Key().(txMeta[C])
+	if mp.cfg.TxPriority.Compare(lowest.priority, priority) >= 0 || (lowest.sender == sender && lowest.nonce < nonce) {
+		return ErrMempoolTxMaxCapacity
+	}
+
+	return mp.removeFrom(lowest.sender, lowest.nonce)
+}
+
+// expire evicts the transactions inserted more than TxTTL blocks before the
+// height of the context, along with the following transactions of their
+// senders.
+func (mp *PriorityNonceMempool[C]) expire(ctx context.Context) {
+	if mp.cfg.TxTTL <= 0 {
+		return
+	}
+	if sdkCtx, ok := ctx.(sdk.Context); ok && sdkCtx.BlockHeight() > mp.height {
+		mp.height = sdkCtx.BlockHeight()
+	}
+
+	for len(mp.expiries) > 0 && mp.expiries[0].height+mp.cfg.TxTTL < mp.height {
+		expiry := mp.expiries[0]
+		mp.expiries = mp.expiries[1:]
+		// the transaction may have been removed, or inserted again since
+		if height, ok := mp.heights[expiry.key]; ok && height == expiry.height {
+			_ = mp.removeFrom(expiry.key.address, expiry.key.nonce)
+		}
+	}
+}
+
 func (i *PriorityNonceIterator[C]) iteratePriority() Iterator {
 	// beginning of priority iteration
 	if i.priorityNode == nil {
@@ -343,7 +448,8 @@ func (i *PriorityNonceIterator[C]) Tx() sdk.Tx {
 
 // Select returns a set of transactions from the mempool, ordered by priority
 // and sender-nonce in O(n) time. The passed in list of transactions are ignored.
-// This is a readonly operation, the mempool is not modified.
+// This is a readonly operation, the mempool is not modified, except for the
+// eviction of the expired transactions if TxTTL is set.
 //
 // The maxBytes parameter defines the maximum number of bytes of transactions to
 // return.
@@ -356,7 +462,8 @@ func (mp *PriorityNonceMempool[C]) Select(ctx context.Context, txs [][]byte) Ite
 	return mp.doSelect(ctx, txs)
 }
 
-func (mp *PriorityNonceMempool[C]) doSelect(_ context.Context, _ [][]byte) Iterator {
+func (mp *PriorityNonceMempool[C]) doSelect(ctx context.Context, _ [][]byte) Iterator {
+	mp.expire(ctx)
 	if mp.priorityIndex.Len() == 0 {
 		return nil
 	}
@@ -456,8 +563,51 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 
 	sig := sigs[0]
 	sender := sig.Signer.String()
-	nonce := sig.Sequence
+	nonce, err := txNonce(tx, sig.Sequence)
+	if err != nil {
+		return err
+	}
+
+	return mp.remove(sender, nonce)
+}
+
+// removeFrom removes the transaction of a sender with the given nonce, and the
+// transactions of the sender with greater nonces, which cannot be included
+// without it, unless it is unordered.
+func (mp *PriorityNonceMempool[C]) removeFrom(sender string, nonce uint64) error {
+	senderTxs, ok := mp.senderIndices[sender]
+	if !ok {
+		return fmt.Errorf("sender %s not found", sender)
+	}
+	element := senderTxs.Get(txMeta[C]{nonce: nonce})
+	if element == nil {
+		return ErrTxNotFound
+	}
+
+	nonces := []uint64{nonce}
+	if !isUnordered(element.Value.(sdk.Tx)) {
+		for next := element.Next(); next != nil; next = next.Next() {
+			if !isUnordered(next.Value.(sdk.Tx)) {
+				nonces = append(nonces, next.Key().(txMeta[C]).nonce)
+			}
+		}
+	}
+	for _, nonce := range nonces {
+		if err := mp.remove(sender, nonce); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isUnordered returns whether a transaction is unordered, and so not bound to
+// the sequence of its signers.
+func isUnordered(tx sdk.Tx) bool {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	return ok && unorderedTx.GetUnordered()
+}
 
+func (mp *PriorityNonceMempool[C]) remove(sender string, nonce uint64) error {
 	scoreKey := txMeta[C]{nonce: nonce, sender: sender}
 	score, ok := mp.scores[scoreKey]
 	if !ok {
@@ -474,6 +624,7 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	senderTxs.Remove(tk)
 	delete(mp.scores, scoreKey)
 	mp.priorityCounts[score.priority]--
+	delete(mp.heights, txKey{address: sender, nonce: nonce})
 
 	return nil
 }
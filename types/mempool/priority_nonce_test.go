@@ -976,3 +976,161 @@ func TestNextSenderTx_TxReplacement(t *testing.T) {
 	iter := mp.Select(ctx, nil)
 	require.Equal(t, txs[3], iter.Tx())
 }
+
+func TestPriorityNonceMempool_UnorderedTx(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	sa := accounts[0].Address
+
+	mp := mempool.DefaultPriorityMempool()
+
+	now := time.Now()
+	oneHour := now.Add(1 * time.Hour)
+	thirtyMin := now.Add(30 * time.Minute)
+	twoHours := now.Add(2 * time.Hour)
+	fifteenMin := now.Add(15 * time.Minute)
+
+	txs := []testTx{
+		{id: 1, priority: 0, address: sa, timeout: &thirtyMin, unordered: true},
+		{id: 2, priority: 0, address: sa, timeout: &oneHour, unordered: true},
+		{id: 0, priority: 0, address: sa, timeout: &fifteenMin, unordered: true},
+		{id: 3, priority: 0, address: sa, timeout: &twoHours, unordered: true},
+	}
+
+	for _, tx := range txs {
+		c := ctx.WithPriority(tx.priority)
+		require.NoError(t, mp.Insert(c, tx))
+	}
+
+	require.Equal(t, 4, mp.CountTx())
+
+	orderedTxs := fetchTxs(mp.Select(ctx, nil), 100000)
+	require.Equal(t, len(txs), len(orderedTxs))
+
+	// unordered txs of a sender are ordered by timeout
+	for i, tx := range orderedTxs {
+		require.Equal(t, i, tx.(testTx).id)
+	}
+
+	for _, tx := range txs {
+		require.NoError(t, mp.Remove(tx))
+	}
+
+	require.Equal(t, 0, mp.CountTx())
+}
+
+func TestPriorityNonceMempool_EvictOnFull(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa, sb, sc := accounts[0].Address, accounts[1].Address, accounts[2].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:  mempool.NewDefaultTxPriority(),
+			MaxTx:       4,
+			EvictOnFull: true,
+		},
+	)
+	txs := []testTx{
+		{id: 0, priority: 20, nonce: 1, address: sa},
+		{id: 1, priority: 10, nonce: 1, address: sb},
+		{id: 2, priority: 30, nonce: 2, address: sb},
+		{id: 3, priority: 15, nonce: 2, address: sa},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+
+	// a tx of a priority not greater than the lowest one is rejected
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(10), testTx{id: 4, priority: 10, nonce: 1, address: sc}), mempool.ErrMempoolTxMaxCapacity)
+	// the txs of a sender preceding the inserted tx are not evicted
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(50), testTx{id: 4, priority: 50, nonce: 3, address: sb}), mempool.ErrMempoolTxMaxCapacity)
+
+	// the tx of lowest priority is evicted along with the following txs of its
+	// sender
+	require.NoError(t, mp.Insert(ctx.WithPriority(50), testTx{id: 4, priority: 50, nonce: 1, address: sc}))
+	require.Equal(t, 3, mp.CountTx())
+	var ids []int
+	for _, tx := range fetchTxs(mp.Select(ctx, nil), 10) {
+		ids = append(ids, tx.(testTx).id)
+	}
+	require.Equal(t, []int{4, 0, 3}, ids)
+
+	// a replacement does not evict any tx
+	require.NoError(t, mp.Insert(ctx.WithPriority(50), testTx{id: 5, priority: 1, nonce: 1, address: sc}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(16), testTx{id: 6, priority: 16, nonce: 1, address: sb}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(1), testTx{id: 7, priority: 1, nonce: 1, address: sc}))
+	require.Equal(t, 4, mp.CountTx())
+}
+
+func TestPriorityNonceMempool_MaxTxPerSender(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:     mempool.NewDefaultTxPriority(),
+			MaxTxPerSender: 2,
+		},
+	)
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 1, address: sa}))
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 2, address: sa}))
+	require.ErrorIs(t, mp.Insert(ctx, testTx{nonce: 3, address: sa}), mempool.ErrMempoolSenderTxMaxCapacity)
+	// a tx of a sender at capacity can still be replaced
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), testTx{nonce: 2, address: sa}))
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 1, address: sb}))
+	require.Equal(t, 3, mp.CountTx())
+
+	require.NoError(t, mp.Remove(testTx{nonce: 2, address: sa}))
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 3, address: sa}))
+}
+
+func TestPriorityNonceMempool_TxTTL(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa, sb := accounts[0].Address, accounts[1].Address
+	fifteenMin := time.Now().Add(15 * time.Minute)
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority: mempool.NewDefaultTxPriority(),
+			TxTTL:      2,
+		},
+	)
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(10), testTx{id: 0, nonce: 1, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(10), testTx{id: 1, timeout: &fifteenMin, unordered: true, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(11), testTx{id: 3, nonce: 1, address: sb}))
+	// a tx inserted again expires from its last insertion
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(11), testTx{id: 4, nonce: 1, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(12), testTx{id: 2, nonce: 2, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(12), testTx{id: 5, nonce: 1, address: sb}))
+
+	// the unordered tx expires alone
+	require.Equal(t, 3, len(fetchTxs(mp.Select(ctx.WithBlockHeight(13), nil), 10)))
+
+	// the txs of a sender following an expired tx expire with it
+	ids := []int{}
+	for _, tx := range fetchTxs(mp.Select(ctx.WithBlockHeight(14), nil), 10) {
+		ids = append(ids, tx.(testTx).id)
+	}
+	require.Equal(t, []int{5}, ids)
+	require.Equal(t, 1, mp.CountTx())
+
+	// the height is kept from the latest context
+	require.Len(t, fetchTxs(mp.Select(context.Background(), nil), 10), 1)
+	require.Equal(t, 1, mp.CountTx())
+	require.Nil(t, mp.Select(ctx.WithBlockHeight(15), nil))
+	require.Equal(t, 0, mp.CountTx())
+}
+
+func TestMinBumpTxReplacement(t *testing.T) {
+	replacement := mempool.MinBumpTxReplacement(10)
+	require.False(t, replacement(100, 100, nil, nil))
+	require.False(t, replacement(100, 109, nil, nil))
+	require.True(t, replacement(100, 110, nil, nil))
+	require.True(t, replacement(0, 1, nil, nil))
+	require.False(t, replacement(-5, -5, nil, nil))
+	require.False(t, replacement(math.MaxInt64-1, math.MaxInt64, nil, nil))
+	require.True(t, mempool.MinBumpTxReplacement(0)(math.MaxInt64-1, math.MaxInt64, nil, nil))
+}
@@ -137,7 +137,10 @@ func (snm *SenderNonceMempool) Insert(_ context.Context, tx sdk.Tx) error {
 
 	sig := sigs[0]
 	sender := sdk.AccAddress(sig.PubKey.Address()).String()
-	nonce := sig.Sequence
+	nonce, err := txNonce(tx, sig.Sequence)
+	if err != nil {
+		return err
+	}
 
 	senderTxs, found := snm.senders[sender]
 	if !found {
@@ -225,7 +228,10 @@ func (snm *SenderNonceMempool) Remove(tx sdk.Tx) error {
 
 	sig := sigs[0]
 	sender := sdk.AccAddress(sig.PubKey.Address()).String()
-	nonce := sig.Sequence
+	nonce, err := txNonce(tx, sig.Sequence)
+	if err != nil {
+		return err
+	}
 
 	senderTxs, found := snm.senders[sender]
 	if !found {
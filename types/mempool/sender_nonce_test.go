@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/require"
@@ -193,3 +194,36 @@ func (s *MempoolTestSuite) TestTxNotFoundOnSender() {
 	err = mp.Remove(tx)
 	require.Equal(t, mempool.ErrTxNotFound, err)
 }
+
+func (s *MempoolTestSuite) TestUnorderedTx() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	mp := mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(5000))
+
+	now := time.Now()
+	oneHour := now.Add(1 * time.Hour)
+	thirtyMin := now.Add(30 * time.Minute)
+
+	txs := []testTx{
+		{id: 1, nonce: 0, address: accounts[0].Address, timeout: &oneHour, unordered: true},
+		{id: 0, nonce: 0, address: accounts[0].Address, timeout: &thirtyMin, unordered: true},
+	}
+
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+	require.Equal(t, 2, mp.CountTx())
+
+	// unordered txs sharing the same sequence are ordered by timeout
+	orderedTxs := fetchTxs(mp.Select(ctx, nil), 1000)
+	require.Len(t, orderedTxs, 2)
+	for i, tx := range orderedTxs {
+		require.Equal(t, i, tx.(testTx).id)
+	}
+
+	for _, tx := range txs {
+		require.NoError(t, mp.Remove(tx))
+	}
+	require.Equal(t, 0, mp.CountTx())
+}
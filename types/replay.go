@@ -0,0 +1,67 @@
+package types
+
+// replayKey is the context key of the Replay of a committed block.
+type replayKey struct{}
+
+// Replay is the replay of a committed block on a branch of the state which is
+// discarded, e.g. to trace one of its transactions. While replaying, the
+// components holding state outside of the stores, which is not versioned,
+// must neither change it nor rely on it being the state of the replayed block:
+// they can keep the state of the replay in the Replay instead.
+//
+// Like the stores, a Replay can be branched, so that the values set while
+// executing a transaction are discarded along with its writes.
+type Replay struct {
+	parent *Replay
+	values map[any]any
+}
+
+// WithReplay returns a context replaying a committed block, with a new Replay.
+// If the context already replays a block, the new Replay is a branch of its
+// Replay, whose values are set on it by Write.
+func WithReplay(ctx Context) Context {
+	return ctx.WithValue(replayKey{}, &Replay{
+		parent: ReplayFromContext(ctx),
+		values: make(map[any]any),
+	})
+}
+
+// ReplayFromContext returns the Replay of the context, or nil if the context
+// does not replay a committed block.
+func ReplayFromContext(ctx Context) *Replay {
+	if ctx.Context() == nil {
+		return nil
+	}
+
+	replay, _ := ctx.Value(replayKey{}).(*Replay)
+	return replay
+}
+
+// Value returns the value of the replay of the given key, or nil if none was
+// set with SetValue.
+func (r *Replay) Value(key any) any {
+	for ; r != nil; r = r.parent {
+		if value, ok := r.values[key]; ok {
+			return value
+		}
+	}
+
+	return nil
+}
+
+// SetValue sets the value of the replay of the given key.
+func (r *Replay) SetValue(key, value any) {
+	r.values[key] = value
+}
+
+// Write sets the values of a branch on the Replay it was branched from.
+func (r *Replay) Write() {
+	if r.parent == nil {
+		return
+	}
+
+	for key, value := range r.values {
+		r.parent.values[key] = value
+	}
+	clear(r.values)
+}
@@ -1,6 +1,8 @@
 package tx
 
 import (
+	"github.com/cosmos/gogoproto/proto"
+
 	"github.com/cosmos/cosmos-sdk/codec/types"
 )
 
@@ -19,3 +21,26 @@ func unpackTxExtensionOptionsI(unpacker types.AnyUnpacker, anys []*types.Any) er
 
 	return nil
 }
+
+// GetUnorderedTxOption returns the ExtensionOptionUnorderedTx found among the
+// given extension options, if any.
+func GetUnorderedTxOption(anys []*types.Any) (*ExtensionOptionUnorderedTx, error) {
+	for _, any := range anys {
+		if any.TypeUrl != "/"+proto.MessageName(&ExtensionOptionUnorderedTx{}) {
+			continue
+		}
+
+		if opt, ok := any.GetCachedValue().(*ExtensionOptionUnorderedTx); ok {
+			return opt, nil
+		}
+
+		opt := &ExtensionOptionUnorderedTx{}
+		if err := opt.Unmarshal(any.Value); err != nil {
+			return nil, err
+		}
+
+		return opt, nil
+	}
+
+	return nil, nil
+}
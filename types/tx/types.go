@@ -233,4 +233,34 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.HasMsgs)(nil), &Tx{})
 
 	registry.RegisterInterface("cosmos.tx.v1beta1.TxExtensionOptionI", (*TxExtensionOptionI)(nil))
+	registry.RegisterImplementations((*TxExtensionOptionI)(nil), &ExtensionOptionUnorderedTx{})
+}
+
+// ValidateBasic performs stateless validation of the state overrides.
+func (o *StateOverrides) ValidateBasic() error {
+	if o.BlockHeight < 0 {
+		return fmt.Errorf("block height cannot be negative: %d", o.BlockHeight)
+	}
+
+	seen := make(map[string]bool, len(o.Balances))
+	for _, balance := range o.Balances {
+		if seen[balance.Address] {
+			return fmt.Errorf("duplicate balance override for %s", balance.Address)
+		}
+		seen[balance.Address] = true
+
+		if err := balance.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid balance override for %s: %w", balance.Address, err)
+		}
+	}
+
+	seen = make(map[string]bool, len(o.Sequences))
+	for _, sequence := range o.Sequences {
+		if seen[sequence.Address] {
+			return fmt.Errorf("duplicate sequence override for %s", sequence.Address)
+		}
+		seen[sequence.Address] = true
+	}
+
+	return nil
 }
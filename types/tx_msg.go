@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	fmt "fmt"
 	strings "strings"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 	protov2 "google.golang.org/protobuf/proto"
@@ -79,6 +80,16 @@ type (
 		GetTimeoutHeight() uint64
 	}
 
+	// TxWithUnordered extends the Tx interface by allowing a transaction to be
+	// marked as unordered, i.e. not bound to the sequence of its signers but
+	// to a timeout timestamp instead.
+	TxWithUnordered interface {
+		Tx
+
+		GetUnordered() bool
+		GetTimeoutTimeStamp() time.Time
+	}
+
 	// HasValidateBasic defines a type that has a ValidateBasic method.
 	// ValidateBasic is deprecated and now facultative.
 	// Prefer validating messages directly in the msg server.
@@ -0,0 +1,58 @@
+// Package accountstd exports the types and functions needed to implement
+// x/accounts account types.
+//
+// An account type is a state machine: it defines its own state through the
+// collections schema builder it is given on creation, and the handlers of the
+// messages it is initialized with, the messages executed against it and the
+// queries it answers. Accounts handling the account abstraction MsgAuthenticate
+// message can sign transactions.
+package accountstd
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// Interface is the interface implemented by the account types.
+type Interface interface {
+	// RegisterInitHandler registers the handler initializing a new account.
+	RegisterInitHandler(builder *InitBuilder)
+	// RegisterExecuteHandlers registers the handlers of the messages executed
+	// against an account.
+	RegisterExecuteHandlers(builder *ExecuteBuilder)
+	// RegisterQueryHandlers registers the handlers of the queries of an
+	// account.
+	RegisterQueryHandlers(builder *QueryBuilder)
+}
+
+// Dependencies are the dependencies given to an account type on creation.
+type Dependencies struct {
+	// SchemaBuilder defines the state of the account type. The collections it
+	// builds are scoped to the account the handlers run against.
+	SchemaBuilder *collections.SchemaBuilder
+	AddressCodec  address.Codec
+	Codec         codec.Codec
+}
+
+// AccountCreatorFunc creates an account type from its dependencies.
+type AccountCreatorFunc = func(deps Dependencies) (Interface, error)
+
+// DepinjectAccount registers an account type with the accounts module.
+type DepinjectAccount struct {
+	Name        string
+	MakeAccount AccountCreatorFunc
+}
+
+// IsManyPerContainerType implements the depinject.ManyPerContainerType interface.
+func (DepinjectAccount) IsManyPerContainerType() {}
+
+// AddAccount returns the registration of the account type created by
+// constructor under the given name.
+func AddAccount(name string, constructor AccountCreatorFunc) DepinjectAccount {
+	return DepinjectAccount{
+		Name:        name,
+		MakeAccount: constructor,
+	}
+}
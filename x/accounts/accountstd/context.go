@@ -0,0 +1,117 @@
+package accountstd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/core/store"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/accounts/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ModuleExecFunc executes a module message on behalf of the given sender.
+type ModuleExecFunc = func(ctx context.Context, sender []byte, msg proto.Message) (proto.Message, error)
+
+type contextKey struct{}
+
+// contextValue holds the information of the account the handlers run against.
+type contextValue struct {
+	store         store.KVStore
+	accountNumber uint64
+	whoami        []byte
+	sender        []byte
+	funds         sdk.Coins
+	execModule    ModuleExecFunc
+}
+
+// MakeAccountContext returns the context the handlers of an account run with.
+// The store is the state of the account, and the sender is the account on
+// whose behalf the handler runs, or nil for queries.
+func MakeAccountContext(
+	ctx context.Context,
+	accountStore store.KVStore,
+	accountNumber uint64,
+	accountAddr, sender []byte,
+	funds sdk.Coins,
+	execModule ModuleExecFunc,
+) context.Context {
+	return context.WithValue(ctx, contextKey{}, contextValue{
+		store:         accountStore,
+		accountNumber: accountNumber,
+		whoami:        accountAddr,
+		sender:        sender,
+		funds:         funds,
+		execModule:    execModule,
+	})
+}
+
+func getContextValue(ctx context.Context) contextValue {
+	v, ok := ctx.Value(contextKey{}).(contextValue)
+	if !ok {
+		panic("context is not an account context")
+	}
+	return v
+}
+
+// StoreService is the store service of the account types schema builders,
+// opening the state of the account the handlers run against.
+var StoreService store.KVStoreService = accountStoreService{}
+
+type accountStoreService struct{}
+
+func (accountStoreService) OpenKVStore(ctx context.Context) store.KVStore {
+	return getContextValue(ctx).store
+}
+
+// Whoami returns the address of the account the handler runs against.
+func Whoami(ctx context.Context) []byte {
+	return getContextValue(ctx).whoami
+}
+
+// Sender returns the address of the sender of the message handled by the
+// account. It is nil for queries.
+func Sender(ctx context.Context) []byte {
+	return getContextValue(ctx).sender
+}
+
+// SenderIsAccountsModule returns true if the message handled by the account
+// was sent by the accounts module itself, as is the case for MsgAuthenticate.
+func SenderIsAccountsModule(ctx context.Context) bool {
+	return bytes.Equal(Sender(ctx), authtypes.NewModuleAddress(types.ModuleName))
+}
+
+// Funds returns the funds sent to the account along with the message.
+func Funds(ctx context.Context) sdk.Coins {
+	return getContextValue(ctx).funds
+}
+
+// AccountNumber returns the number of the account the handler runs against.
+func AccountNumber(ctx context.Context) uint64 {
+	return getContextValue(ctx).accountNumber
+}
+
+// ExecModule executes a module message on behalf of the account, which must be
+// the signer of the message.
+func ExecModule[Resp any, ProtoResp ProtoMsg[Resp]](ctx context.Context, msg proto.Message) (ProtoResp, error) {
+	v := getContextValue(ctx)
+	if v.execModule == nil {
+		return nil, fmt.Errorf("cannot execute module messages from an account query")
+	}
+
+	resp, err := v.execModule(ctx, v.whoami, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	concrete, ok := resp.(ProtoResp)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response %T to module message %s", resp, proto.MessageName(msg))
+	}
+
+	return concrete, nil
+}
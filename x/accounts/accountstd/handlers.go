@@ -0,0 +1,202 @@
+package accountstd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/accounts/types"
+)
+
+// ProtoMsg is the constraint of the messages handled by the accounts, whose
+// pointer type must be a protobuf message.
+type ProtoMsg[T any] interface {
+	*T
+	proto.Message
+}
+
+// handler is a message handler of an account, decoding its message from an
+// Any and encoding its response to an Any.
+type handler struct {
+	request  proto.Message
+	response proto.Message
+	handle   func(ctx context.Context, msg *codectypes.Any) (*codectypes.Any, error)
+}
+
+func newHandler[
+	Req any, ProtoReq ProtoMsg[Req], Resp any, ProtoResp ProtoMsg[Resp],
+](fn func(ctx context.Context, req ProtoReq) (ProtoResp, error),
+) (string, handler) {
+	name := proto.MessageName(ProtoReq(new(Req)))
+	return name, handler{
+		request:  ProtoReq(new(Req)),
+		response: ProtoResp(new(Resp)),
+		handle: func(ctx context.Context, msg *codectypes.Any) (*codectypes.Any, error) {
+			if msg == nil || msg.TypeUrl != "/"+name {
+				return nil, errorsmod.Wrapf(types.ErrInvalidMessage, "expected message %s", name)
+			}
+
+			req := ProtoReq(new(Req))
+			if err := proto.Unmarshal(msg.Value, req); err != nil {
+				return nil, errorsmod.Wrap(types.ErrInvalidMessage, err.Error())
+			}
+
+			resp, err := fn(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			return codectypes.NewAnyWithValue(resp)
+		},
+	}
+}
+
+// InitBuilder collects the init handler of an account type.
+type InitBuilder struct {
+	handler *handler
+	err     error
+}
+
+// RegisterInitHandler registers the handler initializing a new account.
+func RegisterInitHandler[
+	Req any, ProtoReq ProtoMsg[Req], Resp any, ProtoResp ProtoMsg[Resp],
+](b *InitBuilder, fn func(ctx context.Context, req ProtoReq) (ProtoResp, error),
+) {
+	if b.handler != nil {
+		b.err = fmt.Errorf("init handler already registered")
+		return
+	}
+
+	_, h := newHandler(fn)
+	b.handler = &h
+}
+
+// ExecuteBuilder collects the execute handlers of an account type.
+type ExecuteBuilder struct {
+	handlers map[string]handler
+	err      error
+}
+
+// RegisterExecuteHandler registers the handler of a message executed against
+// an account.
+func RegisterExecuteHandler[
+	Req any, ProtoReq ProtoMsg[Req], Resp any, ProtoResp ProtoMsg[Resp],
+](b *ExecuteBuilder, fn func(ctx context.Context, req ProtoReq) (ProtoResp, error),
+) {
+	name, h := newHandler(fn)
+	if _, ok := b.handlers[name]; ok {
+		b.err = fmt.Errorf("execute handler already registered for message %s", name)
+		return
+	}
+	b.handlers[name] = h
+}
+
+// QueryBuilder collects the query handlers of an account type.
+type QueryBuilder struct {
+	handlers map[string]handler
+	err      error
+}
+
+// RegisterQueryHandler registers the handler of a query of an account.
+func RegisterQueryHandler[
+	Req any, ProtoReq ProtoMsg[Req], Resp any, ProtoResp ProtoMsg[Resp],
+](b *QueryBuilder, fn func(ctx context.Context, req ProtoReq) (ProtoResp, error),
+) {
+	name, h := newHandler(fn)
+	if _, ok := b.handlers[name]; ok {
+		b.err = fmt.Errorf("query handler already registered for message %s", name)
+		return
+	}
+	b.handlers[name] = h
+}
+
+// Implementation routes the messages of an account type to its handlers.
+type Implementation struct {
+	init    handler
+	execute map[string]handler
+	query   map[string]handler
+}
+
+// NewImplementation collects the handlers registered by an account type.
+func NewImplementation(account Interface) (Implementation, error) {
+	initBuilder := &InitBuilder{}
+	account.RegisterInitHandler(initBuilder)
+	if initBuilder.err != nil {
+		return Implementation{}, initBuilder.err
+	}
+	if initBuilder.handler == nil {
+		return Implementation{}, fmt.Errorf("no init handler registered")
+	}
+
+	executeBuilder := &ExecuteBuilder{handlers: make(map[string]handler)}
+	account.RegisterExecuteHandlers(executeBuilder)
+	if executeBuilder.err != nil {
+		return Implementation{}, executeBuilder.err
+	}
+
+	queryBuilder := &QueryBuilder{handlers: make(map[string]handler)}
+	account.RegisterQueryHandlers(queryBuilder)
+	if queryBuilder.err != nil {
+		return Implementation{}, queryBuilder.err
+	}
+
+	return Implementation{
+		init:    *initBuilder.handler,
+		execute: executeBuilder.handlers,
+		query:   queryBuilder.handlers,
+	}, nil
+}
+
+// Init runs the init handler of the account type.
+func (i Implementation) Init(ctx context.Context, msg *codectypes.Any) (*codectypes.Any, error) {
+	return i.init.handle(ctx, msg)
+}
+
+// Execute runs the execute handler of the given message.
+func (i Implementation) Execute(ctx context.Context, msg *codectypes.Any) (*codectypes.Any, error) {
+	h, ok := i.execute[messageName(msg)]
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMessage, "no execute handler for message %s", messageName(msg))
+	}
+	return h.handle(ctx, msg)
+}
+
+// Query runs the query handler of the given message.
+func (i Implementation) Query(ctx context.Context, msg *codectypes.Any) (*codectypes.Any, error) {
+	h, ok := i.query[messageName(msg)]
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMessage, "no query handler for message %s", messageName(msg))
+	}
+	return h.handle(ctx, msg)
+}
+
+// HasExecuteHandler returns true if the account type handles the given message.
+func (i Implementation) HasExecuteHandler(name string) bool {
+	_, ok := i.execute[name]
+	return ok
+}
+
+// Messages returns the request and response messages handled by the account
+// type, to be registered in the interface registry.
+func (i Implementation) Messages() []proto.Message {
+	msgs := []proto.Message{i.init.request, i.init.response}
+	for _, h := range i.execute {
+		msgs = append(msgs, h.request, h.response)
+	}
+	for _, h := range i.query {
+		msgs = append(msgs, h.request, h.response)
+	}
+	return msgs
+}
+
+func messageName(msg *codectypes.Any) string {
+	if msg == nil {
+		return ""
+	}
+	return strings.TrimPrefix(msg.TypeUrl, "/")
+}
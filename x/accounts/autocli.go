@@ -0,0 +1,37 @@
+package accounts
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "cosmos.accounts.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "AccountQuery",
+					Skip:      true, // custom command taking the request as JSON
+				},
+				{
+					RpcMethod:      "AccountType",
+					Use:            "account-type [address]",
+					Short:          "Query the type of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "AccountNumber",
+					Use:            "account-number [address]",
+					Short:          "Query the number of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+			},
+			EnhanceCustomCommand: true,
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              "cosmos.accounts.v1.Msg",
+			EnhanceCustomCommand: false, // custom commands taking the messages as JSON
+		},
+	}
+}
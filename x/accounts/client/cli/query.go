@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/x/accounts/types"
+)
+
+// GetQueryCmd returns the accounts module's query commands. The other queries
+// are generated by autocli.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the accounts module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(NewAccountQueryCmd())
+
+	return queryCmd
+}
+
+// NewAccountQueryCmd returns a CLI command handler for querying an account.
+func NewAccountQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-query [target] [json-request]",
+		Short: "Run a query against an account",
+		Long: `Run a query against an account. The request is given as JSON, along with its type, e.g.:
+
+$ <appd> query accounts account-query cosmos1... '{"@type":"/cosmos.accounts.defaults.base.v1.QueryPubKey"}'`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req, err := parseMessage(clientCtx.Codec, args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).AccountQuery(cmd.Context(), &types.AccountQueryRequest{
+				Target:  args[0],
+				Request: req,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
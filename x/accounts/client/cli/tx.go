@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/accounts/types"
+)
+
+// Transaction command flags
+const (
+	FlagFunds = "funds"
+)
+
+// GetTxCmd returns the accounts module's transaction commands.
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Accounts transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewInitCmd(),
+		NewExecuteCmd(),
+	)
+
+	return txCmd
+}
+
+// NewInitCmd returns a CLI command handler for creating a MsgInit transaction.
+func NewInitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init [account-type] [json-message]",
+		Short: "Create a new account of the given type",
+		Long: `Create a new account of the given type, initialized with the given message.
+The message is given as JSON, along with its type, e.g.:
+
+$ <appd> tx accounts init base '{"@type":"/cosmos.accounts.defaults.base.v1.MsgInit","pub_key":{...}}' --from mykey`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseMessage(clientCtx.Codec, args[1])
+			if err != nil {
+				return err
+			}
+
+			funds, err := parseFunds(cmd)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &types.MsgInit{
+				Sender:      clientCtx.GetFromAddress().String(),
+				AccountType: args[0],
+				Message:     msg,
+				Funds:       funds,
+			})
+		},
+	}
+
+	cmd.Flags().String(FlagFunds, "", "Coins to send to the account on creation")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewExecuteCmd returns a CLI command handler for creating a MsgExecute
+// transaction.
+func NewExecuteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute [target] [json-message]",
+		Short: "Execute a message against an account",
+		Long: `Execute a message against an account. The message is given as JSON, along with its type, e.g.:
+
+$ <appd> tx accounts execute cosmos1... '{"@type":"/cosmos.accounts.defaults.base.v1.MsgSwapPubKey","new_pub_key":{...}}' --from mykey`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseMessage(clientCtx.Codec, args[1])
+			if err != nil {
+				return err
+			}
+
+			funds, err := parseFunds(cmd)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &types.MsgExecute{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Target:  args[0],
+				Message: msg,
+				Funds:   funds,
+			})
+		},
+	}
+
+	cmd.Flags().String(FlagFunds, "", "Coins to send to the account along with the message")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseMessage parses an account message given as JSON, along with its type.
+func parseMessage(cdc codec.Codec, msgJSON string) (*codectypes.Any, error) {
+	var msg codectypes.Any
+	if err := cdc.UnmarshalJSON([]byte(msgJSON), &msg); err != nil {
+		return nil, fmt.Errorf("failed to parse message: %w", err)
+	}
+	return &msg, nil
+}
+
+func parseFunds(cmd *cobra.Command) (sdk.Coins, error) {
+	fundsStr, err := cmd.Flags().GetString(FlagFunds)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.ParseCoinsNormalized(fundsStr)
+}
@@ -0,0 +1,180 @@
+// Package base implements the base x/accounts account type, authenticated by
+// a public key like an x/auth account, whose key can be swapped.
+package base
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/accounts/accountstd"
+	basev1 "github.com/cosmos/cosmos-sdk/x/accounts/defaults/base/v1"
+	account_abstractionv1 "github.com/cosmos/cosmos-sdk/x/accounts/interfaces/account_abstraction/v1"
+)
+
+const (
+	// Type is the name under which the base account type is registered.
+	Type = "base"
+
+	// SigVerifyCost is the gas consumed to verify a signature, matching the
+	// default x/auth cost of a secp256k1 signature.
+	SigVerifyCost = 1000
+)
+
+// PubKeyPrefix is the prefix of the public key in the account state.
+var PubKeyPrefix = collections.NewPrefix(0)
+
+var _ accountstd.Interface = (*Account)(nil)
+
+// Account is the base account type.
+type Account struct {
+	cdc    codec.Codec
+	PubKey collections.Item[codectypes.Any]
+}
+
+// NewAccount creates the base account type.
+func NewAccount(deps accountstd.Dependencies) (accountstd.Interface, error) {
+	return Account{
+		cdc:    deps.Codec,
+		PubKey: collections.NewItem(deps.SchemaBuilder, PubKeyPrefix, "pub_key", codec.CollValue[codectypes.Any](deps.Codec)),
+	}, nil
+}
+
+// ProvideAccount registers the base account type in an app wired with
+// depinject.
+func ProvideAccount() accountstd.DepinjectAccount {
+	return accountstd.AddAccount(Type, NewAccount)
+}
+
+// Init initializes the account with its public key.
+func (a Account) Init(ctx context.Context, msg *basev1.MsgInit) (*basev1.MsgInitResponse, error) {
+	if err := a.setPubKey(ctx, msg.PubKey); err != nil {
+		return nil, err
+	}
+	return &basev1.MsgInitResponse{}, nil
+}
+
+// SwapPubKey swaps the public key of the account.
+func (a Account) SwapPubKey(ctx context.Context, msg *basev1.MsgSwapPubKey) (*basev1.MsgSwapPubKeyResponse, error) {
+	if !sdk.AccAddress(accountstd.Sender(ctx)).Equals(sdk.AccAddress(accountstd.Whoami(ctx))) {
+		return nil, errors.New("unauthorized: only the account itself can swap its public key")
+	}
+
+	if err := a.setPubKey(ctx, msg.NewPubKey); err != nil {
+		return nil, err
+	}
+	return &basev1.MsgSwapPubKeyResponse{}, nil
+}
+
+// Authenticate verifies the SIGN_MODE_DIRECT signature of the account on a
+// transaction.
+func (a Account) Authenticate(ctx context.Context, msg *account_abstractionv1.MsgAuthenticate) (*account_abstractionv1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only the accounts module can authenticate")
+	}
+
+	if msg.RawTx == nil || msg.Tx == nil || msg.Tx.AuthInfo == nil {
+		return nil, errors.New("missing transaction")
+	}
+
+	idx := int(msg.SignerIndex)
+	if idx >= len(msg.RawTx.Signatures) || idx >= len(msg.Tx.AuthInfo.SignerInfos) {
+		return nil, fmt.Errorf("no signature at index %d", idx)
+	}
+
+	modeInfo := msg.Tx.AuthInfo.SignerInfos[idx].ModeInfo.GetSingle()
+	if modeInfo == nil || modeInfo.Mode != signing.SignMode_SIGN_MODE_DIRECT {
+		return nil, errors.New("only SIGN_MODE_DIRECT is supported")
+	}
+
+	pubKey, err := a.getPubKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.GasMeter().ConsumeGas(SigVerifyCost, "ante verify: base account")
+
+	// as for x/auth accounts, the account number is not part of the genesis
+	// transactions sign bytes
+	var accNum uint64
+	if sdkCtx.BlockHeight() != 0 {
+		accNum = accountstd.AccountNumber(ctx)
+	}
+
+	signDoc := txtypes.SignDoc{
+		BodyBytes:     msg.RawTx.BodyBytes,
+		AuthInfoBytes: msg.RawTx.AuthInfoBytes,
+		ChainId:       sdkCtx.ChainID(),
+		AccountNumber: accNum,
+	}
+	signBytes, err := signDoc.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	if !pubKey.VerifySignature(signBytes, msg.RawTx.Signatures[idx]) {
+		return nil, fmt.Errorf("signature verification failed; please verify account number (%d) and chain-id (%s)", accNum, sdkCtx.ChainID())
+	}
+
+	return &account_abstractionv1.MsgAuthenticateResponse{}, nil
+}
+
+// QueryPubKey returns the public key of the account.
+func (a Account) QueryPubKey(ctx context.Context, _ *basev1.QueryPubKey) (*basev1.QueryPubKeyResponse, error) {
+	pubKey, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &basev1.QueryPubKeyResponse{PubKey: &pubKey}, nil
+}
+
+func (a Account) setPubKey(ctx context.Context, pubKey *codectypes.Any) error {
+	if pubKey == nil {
+		return errors.New("public key cannot be empty")
+	}
+
+	var pk cryptotypes.PubKey
+	if err := a.cdc.UnpackAny(pubKey, &pk); err != nil {
+		return fmt.Errorf("invalid public key: %w", err)
+	}
+
+	return a.PubKey.Set(ctx, *pubKey)
+}
+
+func (a Account) getPubKey(ctx context.Context) (cryptotypes.PubKey, error) {
+	pubKey, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pk cryptotypes.PubKey
+	if err := a.cdc.UnpackAny(&pubKey, &pk); err != nil {
+		return nil, err
+	}
+	return pk, nil
+}
+
+// RegisterInitHandler implements accountstd.Interface.
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+// RegisterExecuteHandlers implements accountstd.Interface.
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate)
+}
+
+// RegisterQueryHandlers implements accountstd.Interface.
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QueryPubKey)
+}
@@ -0,0 +1,125 @@
+package base_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/accounts"
+	"github.com/cosmos/cosmos-sdk/x/accounts/accountstd"
+	"github.com/cosmos/cosmos-sdk/x/accounts/defaults/base"
+	basev1 "github.com/cosmos/cosmos-sdk/x/accounts/defaults/base/v1"
+	account_abstractionv1 "github.com/cosmos/cosmos-sdk/x/accounts/interfaces/account_abstraction/v1"
+	"github.com/cosmos/cosmos-sdk/x/accounts/keeper"
+	accountstestutil "github.com/cosmos/cosmos-sdk/x/accounts/testutil"
+	"github.com/cosmos/cosmos-sdk/x/accounts/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+func TestAuthenticate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(accounts.AppModuleBasic{}, bank.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 10}).WithChainID("test-chain")
+
+	ctrl := gomock.NewController(t)
+	authKeeper := accountstestutil.NewMockAccountKeeper(ctrl)
+	authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	authKeeper.EXPECT().NextAccountNumber(gomock.Any()).Return(uint64(42))
+	authKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).Return(false)
+	authKeeper.EXPECT().SetAccount(gomock.Any(), gomock.Any())
+
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), authKeeper, accountstestutil.NewMockBankKeeper(ctrl), nil,
+		accountstd.AddAccount(base.Type, base.NewAccount))
+
+	sender := sdk.AccAddress("sender______________")
+	priv := secp256k1.GenPrivKey()
+	pubKey, err := codectypes.NewAnyWithValue(priv.PubKey())
+	require.NoError(t, err)
+	addr, _, err := k.Init(ctx, base.Type, sender, mustAny(t, &basev1.MsgInit{PubKey: pubKey}), nil)
+	require.NoError(t, err)
+
+	signTx := func(accNum uint64, chainID string) (*txtypes.TxRaw, *txtypes.Tx) {
+		builder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(testdata.NewTestMsg(addr)))
+		builder.SetGasLimit(testdata.NewTestGasLimit())
+
+		sig := signing.SignatureV2{Data: &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT}}
+		require.NoError(t, builder.SetSignatures(sig))
+		bz, err := encCfg.TxConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		var rawTx txtypes.TxRaw
+		require.NoError(t, rawTx.Unmarshal(bz))
+
+		signDoc := txtypes.SignDoc{BodyBytes: rawTx.BodyBytes, AuthInfoBytes: rawTx.AuthInfoBytes, ChainId: chainID, AccountNumber: accNum}
+		signBytes, err := signDoc.Marshal()
+		require.NoError(t, err)
+		sig.Data.(*signing.SingleSignatureData).Signature, err = priv.Sign(signBytes)
+		require.NoError(t, err)
+		require.NoError(t, builder.SetSignatures(sig))
+
+		bz, err = encCfg.TxConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		rawTx = txtypes.TxRaw{}
+		require.NoError(t, rawTx.Unmarshal(bz))
+		protoTx := builder.(interface{ GetProtoTx() *txtypes.Tx }).GetProtoTx()
+		return &rawTx, protoTx
+	}
+
+	rawTx, protoTx := signTx(42, "test-chain")
+	require.NoError(t, k.AuthenticateAccount(ctx, addr, 0, rawTx, protoTx))
+
+	rawTx, protoTx = signTx(41, "test-chain")
+	require.ErrorIs(t, k.AuthenticateAccount(ctx, addr, 0, rawTx, protoTx), types.ErrAuthentication)
+
+	rawTx, protoTx = signTx(42, "other-chain")
+	require.ErrorIs(t, k.AuthenticateAccount(ctx, addr, 0, rawTx, protoTx), types.ErrAuthentication)
+
+	// only the accounts module can send MsgAuthenticate
+	rawTx, protoTx = signTx(42, "test-chain")
+	_, err = k.Execute(ctx, addr, addr, mustAny(t, &account_abstractionv1.MsgAuthenticate{RawTx: rawTx, Tx: protoTx}), nil)
+	require.ErrorContains(t, err, "only the accounts module can authenticate")
+
+	// the account can swap its public key
+	newPriv := secp256k1.GenPrivKey()
+	newPubKey, err := codectypes.NewAnyWithValue(newPriv.PubKey())
+	require.NoError(t, err)
+	swapMsg := mustAny(t, &basev1.MsgSwapPubKey{NewPubKey: newPubKey})
+	_, err = k.Execute(ctx, addr, sender, swapMsg, nil)
+	require.Error(t, err)
+	_, err = k.Execute(ctx, addr, addr, swapMsg, nil)
+	require.NoError(t, err)
+
+	rawTx, protoTx = signTx(42, "test-chain")
+	require.ErrorIs(t, k.AuthenticateAccount(ctx, addr, 0, rawTx, protoTx), types.ErrAuthentication)
+	priv = newPriv
+	rawTx, protoTx = signTx(42, "test-chain")
+	require.NoError(t, k.AuthenticateAccount(ctx, addr, 0, rawTx, protoTx))
+
+	queryResp, err := k.Query(ctx, addr, mustAny(t, &basev1.QueryPubKey{}))
+	require.NoError(t, err)
+	var pubKeyResp basev1.QueryPubKeyResponse
+	require.NoError(t, pubKeyResp.Unmarshal(queryResp.Value))
+	require.Equal(t, newPubKey.Value, pubKeyResp.PubKey.Value)
+}
+
+func mustAny(t *testing.T, msg sdk.Msg) *codectypes.Any {
+	t.Helper()
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+	return msgAny
+}
@@ -0,0 +1,554 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/accounts/interfaces/account_abstraction/v1/interface.proto
+
+package account_abstractionv1
+
+import (
+	fmt "fmt"
+	tx "github.com/cosmos/cosmos-sdk/types/tx"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgAuthenticate is sent by the accounts module to an account signing a
+// transaction, which must authenticate the signer or return an error.
+type MsgAuthenticate struct {
+	// raw_tx is the raw transaction being authenticated.
+	RawTx *tx.TxRaw `protobuf:"bytes,1,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	// tx is the decoded transaction being authenticated.
+	Tx *tx.Tx `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	// signer_index is the index of the account in the signers of the transaction.
+	SignerIndex uint32 `protobuf:"varint,3,opt,name=signer_index,json=signerIndex,proto3" json:"signer_index,omitempty"`
+}
+
+func (m *MsgAuthenticate) Reset()         { *m = MsgAuthenticate{} }
+func (m *MsgAuthenticate) String() string { return proto.CompactTextString(m) }
+func (*MsgAuthenticate) ProtoMessage()    {}
+func (*MsgAuthenticate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56b360422260e9d1, []int{0}
+}
+func (m *MsgAuthenticate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAuthenticate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAuthenticate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAuthenticate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAuthenticate.Merge(m, src)
+}
+func (m *MsgAuthenticate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAuthenticate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAuthenticate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAuthenticate proto.InternalMessageInfo
+
+func (m *MsgAuthenticate) GetRawTx() *tx.TxRaw {
+	if m != nil {
+		return m.RawTx
+	}
+	return nil
+}
+
+func (m *MsgAuthenticate) GetTx() *tx.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *MsgAuthenticate) GetSignerIndex() uint32 {
+	if m != nil {
+		return m.SignerIndex
+	}
+	return 0
+}
+
+// MsgAuthenticateResponse is the response to MsgAuthenticate.
+type MsgAuthenticateResponse struct {
+}
+
+func (m *MsgAuthenticateResponse) Reset()         { *m = MsgAuthenticateResponse{} }
+func (m *MsgAuthenticateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAuthenticateResponse) ProtoMessage()    {}
+func (*MsgAuthenticateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56b360422260e9d1, []int{1}
+}
+func (m *MsgAuthenticateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAuthenticateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAuthenticateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAuthenticateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAuthenticateResponse.Merge(m, src)
+}
+func (m *MsgAuthenticateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAuthenticateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAuthenticateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAuthenticateResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAuthenticate)(nil), "cosmos.accounts.interfaces.account_abstraction.v1.MsgAuthenticate")
+	proto.RegisterType((*MsgAuthenticateResponse)(nil), "cosmos.accounts.interfaces.account_abstraction.v1.MsgAuthenticateResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/accounts/interfaces/account_abstraction/v1/interface.proto", fileDescriptor_56b360422260e9d1)
+}
+
+var fileDescriptor_56b360422260e9d1 = []byte{
+	// 284 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x90, 0x31, 0x4b, 0x03, 0x31,
+	0x18, 0x86, 0x9b, 0x8a, 0x1d, 0x52, 0x45, 0x38, 0x10, 0xcf, 0x0e, 0xa1, 0x16, 0x84, 0x2e, 0x26,
+	0x44, 0x47, 0xa7, 0xba, 0x39, 0xb8, 0x1c, 0x9d, 0x5c, 0x8e, 0x5c, 0x1a, 0xdb, 0x20, 0x4d, 0x4a,
+	0xf2, 0xdd, 0x5d, 0xfc, 0x0f, 0x0e, 0xfe, 0x2c, 0xc7, 0x8e, 0x8e, 0x72, 0xf7, 0x47, 0xc4, 0xde,
+	0x69, 0x41, 0x6e, 0x71, 0x0a, 0x3c, 0xbc, 0x3c, 0x6f, 0xbe, 0x17, 0xcf, 0xa4, 0xf5, 0x6b, 0xeb,
+	0x99, 0x90, 0xd2, 0xe6, 0x06, 0x3c, 0xd3, 0x06, 0x94, 0x7b, 0x12, 0x52, 0xfd, 0xb2, 0x54, 0x64,
+	0x1e, 0x9c, 0x90, 0xa0, 0xad, 0x61, 0x05, 0xdf, 0x27, 0xe8, 0xc6, 0x59, 0xb0, 0x11, 0x6f, 0x14,
+	0xf4, 0x47, 0x41, 0xf7, 0x0a, 0xda, 0xa1, 0xa0, 0x05, 0x1f, 0x8d, 0xda, 0x56, 0x08, 0xac, 0xe0,
+	0x99, 0x02, 0xc1, 0x19, 0x84, 0x46, 0x37, 0x79, 0x45, 0xf8, 0xe4, 0xc1, 0x2f, 0x67, 0x39, 0xac,
+	0x94, 0x01, 0x2d, 0x05, 0xa8, 0x88, 0xe1, 0x81, 0x13, 0x65, 0x0a, 0x21, 0x46, 0x63, 0x34, 0x1d,
+	0x5e, 0xc7, 0xb4, 0xed, 0x84, 0x40, 0x5b, 0x01, 0x9d, 0x87, 0x44, 0x94, 0xc9, 0xa1, 0x13, 0xe5,
+	0x3c, 0x44, 0x97, 0xb8, 0x0f, 0x21, 0xee, 0xef, 0xc2, 0xa7, 0xdd, 0xe1, 0x3e, 0x84, 0xe8, 0x02,
+	0x1f, 0x79, 0xbd, 0x34, 0xca, 0xa5, 0xda, 0x2c, 0x54, 0x88, 0x0f, 0xc6, 0x68, 0x7a, 0x9c, 0x0c,
+	0x1b, 0x76, 0xff, 0x8d, 0x26, 0xe7, 0xf8, 0xec, 0xcf, 0x6f, 0x12, 0xe5, 0x37, 0xd6, 0x78, 0x75,
+	0xf7, 0xf2, 0x5e, 0x11, 0xb4, 0xad, 0x08, 0xfa, 0xac, 0x08, 0x7a, 0xab, 0x49, 0x6f, 0x5b, 0x93,
+	0xde, 0x47, 0x4d, 0x7a, 0x8f, 0xe9, 0x52, 0xc3, 0x2a, 0xcf, 0xa8, 0xb4, 0x6b, 0xd6, 0x9e, 0xda,
+	0x3c, 0x57, 0x7e, 0xf1, 0xcc, 0xc2, 0x3f, 0xd6, 0xbe, 0xed, 0xc0, 0x05, 0xcf, 0x06, 0xbb, 0xad,
+	0x6e, 0xbe, 0x06, 0x00, 0xd8, 0x77, 0x81, 0x22, 0xbf, 0x01, 0x00, 0x00,
+}
+
+func (m *MsgAuthenticate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAuthenticate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAuthenticate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignerIndex != 0 {
+		i = encodeVarintInterface(dAtA, i, uint64(m.SignerIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInterface(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RawTx != nil {
+		{
+			size, err := m.RawTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInterface(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAuthenticateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAuthenticateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAuthenticateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInterface(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInterface(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAuthenticate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RawTx != nil {
+		l = m.RawTx.Size()
+		n += 1 + l + sovInterface(uint64(l))
+	}
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovInterface(uint64(l))
+	}
+	if m.SignerIndex != 0 {
+		n += 1 + sovInterface(uint64(m.SignerIndex))
+	}
+	return n
+}
+
+func (m *MsgAuthenticateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovInterface(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInterface(x uint64) (n int) {
+	return sovInterface(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAuthenticate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterface
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAuthenticate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAuthenticate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterface
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawTx == nil {
+				m.RawTx = &tx.TxRaw{}
+			}
+			if err := m.RawTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterface
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &tx.Tx{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerIndex", wireType)
+			}
+			m.SignerIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterface(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAuthenticateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterface
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAuthenticateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAuthenticateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterface(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInterface(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInterface
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInterface
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInterface
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInterface
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInterface        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInterface          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInterface = fmt.Errorf("proto: unexpected end of group")
+)
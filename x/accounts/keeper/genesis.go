@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/accounts/types"
+)
+
+// InitGenesis sets the accounts of the genesis state along with their state.
+// Their x/auth records are imported by the x/auth genesis.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	for _, acc := range data.Accounts {
+		addr, err := k.authKeeper.AddressCodec().StringToBytes(acc.Address)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := k.accounts[acc.AccountType]; !ok {
+			return errorsmod.Wrap(types.ErrAccountTypeNotFound, acc.AccountType)
+		}
+
+		if err := k.AccountTypes.Set(ctx, addr, acc.AccountType); err != nil {
+			return err
+		}
+		if err := k.AccountNumbers.Set(ctx, addr, acc.AccountNumber); err != nil {
+			return err
+		}
+
+		store := k.accountStore(ctx, acc.AccountNumber)
+		for _, kv := range acc.State {
+			if err := store.Set(kv.Key, kv.Value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	accounts := []types.GenesisAccount{}
+	err := k.AccountTypes.Walk(ctx, nil, func(addr sdk.AccAddress, accountType string) (bool, error) {
+		accNum, err := k.AccountNumbers.Get(ctx, addr)
+		if err != nil {
+			return true, err
+		}
+
+		addrStr, err := k.authKeeper.AddressCodec().BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+
+		accounts = append(accounts, types.GenesisAccount{
+			Address:       addrStr,
+			AccountType:   accountType,
+			AccountNumber: accNum,
+			State:         k.exportAccountState(ctx, accNum),
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(accounts), nil
+}
+
+func (k Keeper) exportAccountState(ctx context.Context, accNum uint64) []types.KVPair {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.AccountStatePrefix(accNum))
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	state := []types.KVPair{}
+	for ; iterator.Valid(); iterator.Next() {
+		state = append(state, types.KVPair{Key: iterator.Key(), Value: iterator.Value()})
+	}
+
+	return state
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/x/accounts/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/accounts QueryServer interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// AccountQuery runs a query against an account.
+func (q queryServer) AccountQuery(ctx context.Context, req *types.AccountQueryRequest) (*types.AccountQueryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	target, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Target)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid target address: %s", err)
+	}
+
+	if req.Request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty account query request")
+	}
+
+	resp, err := q.k.Query(ctx, target, req.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AccountQueryResponse{Response: resp}, nil
+}
+
+// AccountType returns the type of an account.
+func (q queryServer) AccountType(ctx context.Context, req *types.AccountTypeRequest) (*types.AccountTypeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	accountType, err := q.k.AccountTypes.Get(ctx, addr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "account %s not found", req.Address)
+		}
+		return nil, err
+	}
+
+	return &types.AccountTypeResponse{AccountType: accountType}, nil
+}
+
+// AccountNumber returns the number of an account.
+func (q queryServer) AccountNumber(ctx context.Context, req *types.AccountNumberRequest) (*types.AccountNumberResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	number, err := q.k.AccountNumbers.Get(ctx, addr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "account %s not found", req.Address)
+		}
+		return nil, err
+	}
+
+	return &types.AccountNumberResponse{Number: number}, nil
+}
@@ -0,0 +1,325 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/accounts/accountstd"
+	account_abstractionv1 "github.com/cosmos/cosmos-sdk/x/accounts/interfaces/account_abstraction/v1"
+	"github.com/cosmos/cosmos-sdk/x/accounts/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Keeper of the accounts store
+type Keeper struct {
+	storeService corestore.KVStoreService
+	cdc          codec.Codec
+	authKeeper   types.AccountKeeper
+	bankKeeper   types.BankKeeper
+	router       baseapp.MessageRouter
+
+	// accounts are the registered account types, by name
+	accounts map[string]accountstd.Implementation
+
+	Schema         collections.Schema
+	AccountTypes   collections.Map[sdk.AccAddress, string]
+	AccountNumbers collections.Map[sdk.AccAddress, uint64]
+}
+
+// NewKeeper creates a new accounts Keeper instance, with the given account
+// types.
+func NewKeeper(
+	cdc codec.Codec,
+	storeService corestore.KVStoreService,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	router baseapp.MessageRouter,
+	accounts ...accountstd.DepinjectAccount,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		storeService:   storeService,
+		cdc:            cdc,
+		authKeeper:     ak,
+		bankKeeper:     bk,
+		router:         router,
+		accounts:       make(map[string]accountstd.Implementation, len(accounts)),
+		AccountTypes:   collections.NewMap(sb, types.AccountTypesKey, "account_types", sdk.AccAddressKey, collections.StringValue),
+		AccountNumbers: collections.NewMap(sb, types.AccountNumbersKey, "account_numbers", sdk.AccAddressKey, collections.Uint64Value),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	for _, acc := range accounts {
+		if _, ok := k.accounts[acc.Name]; ok {
+			panic(fmt.Sprintf("account type %s registered twice", acc.Name))
+		}
+
+		impl, err := newImplementation(acc, ak, cdc)
+		if err != nil {
+			panic(fmt.Sprintf("failed to register account type %s: %s", acc.Name, err))
+		}
+		k.accounts[acc.Name] = impl
+	}
+
+	return k
+}
+
+func newImplementation(acc accountstd.DepinjectAccount, ak types.AccountKeeper, cdc codec.Codec) (accountstd.Implementation, error) {
+	sb := collections.NewSchemaBuilder(accountstd.StoreService)
+	account, err := acc.MakeAccount(accountstd.Dependencies{
+		SchemaBuilder: sb,
+		AddressCodec:  ak.AddressCodec(),
+		Codec:         cdc,
+	})
+	if err != nil {
+		return accountstd.Implementation{}, err
+	}
+
+	if _, err := sb.Build(); err != nil {
+		return accountstd.Implementation{}, err
+	}
+
+	return accountstd.NewImplementation(account)
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With(log.ModuleKey, "x/"+types.ModuleName)
+}
+
+// RegisterInterfaces registers the messages handled by the account types with
+// the interface registry, so that they can be packed in the x/accounts
+// messages.
+func (k Keeper) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	for _, impl := range k.accounts {
+		registry.RegisterImplementations((*proto.Message)(nil), impl.Messages()...)
+	}
+}
+
+// Init creates a new account of the given type, initialized with the given
+// message. The funds are sent from the sender to the new account beforehand.
+func (k Keeper) Init(
+	ctx context.Context,
+	accountType string,
+	sender []byte,
+	msg *codectypes.Any,
+	funds sdk.Coins,
+) ([]byte, *codectypes.Any, error) {
+	impl, ok := k.accounts[accountType]
+	if !ok {
+		return nil, nil, errorsmod.Wrap(types.ErrAccountTypeNotFound, accountType)
+	}
+
+	// the accounts are recorded in x/auth too, sharing its account numbers,
+	// so that they can sign transactions and pay fees
+	accNum := k.authKeeper.NextAccountNumber(ctx)
+	addr := makeAddress(accNum)
+	if k.authKeeper.HasAccount(ctx, addr) {
+		return nil, nil, errorsmod.Wrapf(types.ErrAccountExists, "address %s", sdk.AccAddress(addr))
+	}
+	k.authKeeper.SetAccount(ctx, authtypes.NewBaseAccount(addr, nil, accNum, 0))
+
+	if err := k.AccountTypes.Set(ctx, addr, accountType); err != nil {
+		return nil, nil, err
+	}
+	if err := k.AccountNumbers.Set(ctx, addr, accNum); err != nil {
+		return nil, nil, err
+	}
+
+	if err := k.sendFunds(ctx, sender, addr, funds); err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := impl.Init(k.makeAccountContext(ctx, accNum, addr, sender, funds), msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	addrStr, err := k.authKeeper.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAccountCreation,
+			sdk.NewAttribute(types.AttributeKeyAddress, addrStr),
+			sdk.NewAttribute(types.AttributeKeyAccountType, accountType),
+		),
+	)
+
+	return addr, resp, nil
+}
+
+// Execute executes a message against an account on behalf of the sender. The
+// funds are sent from the sender to the account beforehand.
+func (k Keeper) Execute(
+	ctx context.Context,
+	target, sender []byte,
+	msg *codectypes.Any,
+	funds sdk.Coins,
+) (*codectypes.Any, error) {
+	impl, accNum, err := k.getAccount(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.sendFunds(ctx, sender, target, funds); err != nil {
+		return nil, err
+	}
+
+	return impl.Execute(k.makeAccountContext(ctx, accNum, target, sender, funds), msg)
+}
+
+// Query runs a query against an account.
+func (k Keeper) Query(ctx context.Context, target []byte, req *codectypes.Any) (*codectypes.Any, error) {
+	impl, accNum, err := k.getAccount(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	// queries cannot write to the account state nor execute module messages
+	sdkCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	accCtx := accountstd.MakeAccountContext(sdkCtx, k.accountStore(sdkCtx, accNum), accNum, target, nil, nil, nil)
+	return impl.Query(accCtx, req)
+}
+
+// IsAccountsModuleAccount returns true if the address is an x/accounts
+// account.
+func (k Keeper) IsAccountsModuleAccount(ctx context.Context, addr []byte) bool {
+	has, err := k.AccountTypes.Has(ctx, addr)
+	return err == nil && has
+}
+
+// AuthenticateAccount authenticates the account signing a transaction, by
+// executing the account abstraction MsgAuthenticate against it.
+func (k Keeper) AuthenticateAccount(ctx context.Context, signer []byte, signerIndex uint32, rawTx *txtypes.TxRaw, protoTx *txtypes.Tx) error {
+	impl, _, err := k.getAccount(ctx, signer)
+	if err != nil {
+		return err
+	}
+
+	msg := &account_abstractionv1.MsgAuthenticate{
+		RawTx:       rawTx,
+		Tx:          protoTx,
+		SignerIndex: signerIndex,
+	}
+	if !impl.HasExecuteHandler(proto.MessageName(msg)) {
+		return errorsmod.Wrapf(types.ErrAuthentication, "account %s does not support authentication", sdk.AccAddress(signer))
+	}
+
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+
+	if _, err := k.Execute(ctx, signer, authtypes.NewModuleAddress(types.ModuleName), msgAny, nil); err != nil {
+		return errorsmod.Wrap(types.ErrAuthentication, err.Error())
+	}
+
+	return nil
+}
+
+func (k Keeper) getAccount(ctx context.Context, addr []byte) (accountstd.Implementation, uint64, error) {
+	accountType, err := k.AccountTypes.Get(ctx, addr)
+	if err != nil {
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			return accountstd.Implementation{}, 0, errorsmod.Wrapf(types.ErrAccountNotFound, "address %s", sdk.AccAddress(addr))
+		}
+		return accountstd.Implementation{}, 0, err
+	}
+
+	impl, ok := k.accounts[accountType]
+	if !ok {
+		return accountstd.Implementation{}, 0, errorsmod.Wrap(types.ErrAccountTypeNotFound, accountType)
+	}
+
+	accNum, err := k.AccountNumbers.Get(ctx, addr)
+	if err != nil {
+		return accountstd.Implementation{}, 0, err
+	}
+
+	return impl, accNum, nil
+}
+
+func (k Keeper) sendFunds(ctx context.Context, from, to []byte, funds sdk.Coins) error {
+	if funds.IsZero() {
+		return nil
+	}
+	return k.bankKeeper.SendCoins(ctx, from, to, funds)
+}
+
+func (k Keeper) makeAccountContext(ctx context.Context, accNum uint64, addr, sender []byte, funds sdk.Coins) context.Context {
+	return accountstd.MakeAccountContext(ctx, k.accountStore(ctx, accNum), accNum, addr, sender, funds, k.execModule)
+}
+
+// accountStore returns the store holding the state of the given account.
+func (k Keeper) accountStore(ctx context.Context, accNum uint64) corestore.KVStore {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return coreKVStore{prefix.NewStore(store, types.AccountStatePrefix(accNum))}
+}
+
+// execModule executes a module message on behalf of an account, which must be
+// the only signer of the message.
+func (k Keeper) execModule(ctx context.Context, sender []byte, msg proto.Message) (proto.Message, error) {
+	signers, _, err := k.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return nil, err
+	}
+	if len(signers) != 1 || !sdk.AccAddress(signers[0]).Equals(sdk.AccAddress(sender)) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account %s is not the signer of %s", sdk.AccAddress(sender), proto.MessageName(msg))
+	}
+
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	res, err := handler(sdkCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx.EventManager().EmitEvents(res.GetEvents())
+
+	if len(res.MsgResponses) != 1 {
+		return nil, fmt.Errorf("expected one response to %s, got %d", proto.MessageName(msg), len(res.MsgResponses))
+	}
+
+	resp, err := k.cdc.InterfaceRegistry().Resolve(res.MsgResponses[0].TypeUrl)
+	if err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(res.MsgResponses[0].Value, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// makeAddress derives the address of an account from its number.
+func makeAddress(accNum uint64) sdk.AccAddress {
+	return address.Module(types.ModuleName, sdk.Uint64ToBigEndian(accNum))
+}
@@ -0,0 +1,320 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/accounts"
+	"github.com/cosmos/cosmos-sdk/x/accounts/accountstd"
+	"github.com/cosmos/cosmos-sdk/x/accounts/keeper"
+	accountstestutil "github.com/cosmos/cosmos-sdk/x/accounts/testutil"
+	"github.com/cosmos/cosmos-sdk/x/accounts/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// counterAccount is an account type holding a counter, which can send coins
+// through the bank module.
+type counterAccount struct {
+	Counter collections.Item[uint64]
+}
+
+func newCounterAccount(deps accountstd.Dependencies) (accountstd.Interface, error) {
+	return counterAccount{
+		Counter: collections.NewItem(deps.SchemaBuilder, collections.NewPrefix(0), "counter", collections.Uint64Value),
+	}, nil
+}
+
+func (a counterAccount) Init(ctx context.Context, msg *gogotypes.UInt64Value) (*gogotypes.Empty, error) {
+	return &gogotypes.Empty{}, a.Counter.Set(ctx, msg.Value)
+}
+
+func (a counterAccount) Increment(ctx context.Context, msg *gogotypes.UInt64Value) (*gogotypes.UInt64Value, error) {
+	counter, err := a.Counter.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	counter += msg.Value
+	return &gogotypes.UInt64Value{Value: counter}, a.Counter.Set(ctx, counter)
+}
+
+func (a counterAccount) Send(ctx context.Context, msg *banktypes.MsgSend) (*banktypes.MsgSendResponse, error) {
+	return accountstd.ExecModule[banktypes.MsgSendResponse](ctx, msg)
+}
+
+func (a counterAccount) Get(ctx context.Context, _ *gogotypes.Empty) (*gogotypes.UInt64Value, error) {
+	counter, err := a.Counter.Get(ctx)
+	return &gogotypes.UInt64Value{Value: counter}, err
+}
+
+func (a counterAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a counterAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.Increment)
+	accountstd.RegisterExecuteHandler(builder, a.Send)
+}
+
+func (a counterAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.Get)
+}
+
+// fakeRouter records the messages executed by the accounts.
+type fakeRouter struct {
+	handled []sdk.Msg
+}
+
+func (r *fakeRouter) Handler(sdk.Msg) baseapp.MsgServiceHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		r.handled = append(r.handled, msg)
+		return sdk.WrapServiceResult(ctx, &banktypes.MsgSendResponse{}, nil)
+	}
+}
+
+func (r *fakeRouter) HandlerByTypeURL(string) baseapp.MsgServiceHandler {
+	return r.Handler(nil)
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	authKeeper  *accountstestutil.MockAccountKeeper
+	bankKeeper  *accountstestutil.MockBankKeeper
+	router      *fakeRouter
+	queryServer types.QueryServer
+	msgServer   types.MsgServer
+	addrs       []sdk.AccAddress
+	nextAccNum  uint64
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(accounts.AppModuleBasic{}, bank.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 10})
+
+	ctrl := gomock.NewController(s.T())
+	s.authKeeper = accountstestutil.NewMockAccountKeeper(ctrl)
+	s.authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	s.authKeeper.EXPECT().NextAccountNumber(gomock.Any()).DoAndReturn(func(context.Context) uint64 {
+		s.nextAccNum++
+		return s.nextAccNum
+	}).AnyTimes()
+	s.authKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+	s.authKeeper.EXPECT().SetAccount(gomock.Any(), gomock.Any()).AnyTimes()
+	s.bankKeeper = accountstestutil.NewMockBankKeeper(ctrl)
+
+	s.router = &fakeRouter{}
+	s.keeper = keeper.NewKeeper(encCfg.Codec, storeService, s.authKeeper, s.bankKeeper, s.router,
+		accountstd.AddAccount("counter", newCounterAccount))
+	s.keeper.RegisterInterfaces(encCfg.InterfaceRegistry)
+	s.queryServer = keeper.NewQueryServerImpl(s.keeper)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.addrs = simtestutil.CreateIncrementalAccounts(2)
+	s.nextAccNum = 0
+}
+
+func (s *KeeperTestSuite) packAny(msg sdk.Msg) *codectypes.Any {
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	s.Require().NoError(err)
+	return msgAny
+}
+
+// initCounter creates a counter account with the given initial value.
+func (s *KeeperTestSuite) initCounter(value uint64) sdk.AccAddress {
+	resp, err := s.msgServer.Init(s.ctx, &types.MsgInit{
+		Sender:      s.addrs[0].String(),
+		AccountType: "counter",
+		Message:     s.packAny(&gogotypes.UInt64Value{Value: value}),
+	})
+	s.Require().NoError(err)
+	return sdk.MustAccAddressFromBech32(resp.AccountAddress)
+}
+
+func (s *KeeperTestSuite) TestInit() {
+	require := s.Require()
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), s.addrs[0], gomock.Any(), funds).Return(nil)
+	resp, err := s.msgServer.Init(s.ctx, &types.MsgInit{
+		Sender:      s.addrs[0].String(),
+		AccountType: "counter",
+		Message:     s.packAny(&gogotypes.UInt64Value{Value: 5}),
+		Funds:       funds,
+	})
+	require.NoError(err)
+	require.Equal("/google.protobuf.Empty", resp.Response.TypeUrl)
+
+	typeResp, err := s.queryServer.AccountType(s.ctx, &types.AccountTypeRequest{Address: resp.AccountAddress})
+	require.NoError(err)
+	require.Equal("counter", typeResp.AccountType)
+
+	numResp, err := s.queryServer.AccountNumber(s.ctx, &types.AccountNumberRequest{Address: resp.AccountAddress})
+	require.NoError(err)
+	require.Equal(uint64(1), numResp.Number)
+
+	addr := sdk.MustAccAddressFromBech32(resp.AccountAddress)
+	require.True(s.keeper.IsAccountsModuleAccount(s.ctx, addr))
+	require.False(s.keeper.IsAccountsModuleAccount(s.ctx, s.addrs[0]))
+
+	// unknown account type
+	_, err = s.msgServer.Init(s.ctx, &types.MsgInit{
+		Sender:      s.addrs[0].String(),
+		AccountType: "unknown",
+		Message:     s.packAny(&gogotypes.UInt64Value{Value: 5}),
+	})
+	require.ErrorIs(err, types.ErrAccountTypeNotFound)
+
+	// wrong init message
+	_, err = s.msgServer.Init(s.ctx, &types.MsgInit{
+		Sender:      s.addrs[0].String(),
+		AccountType: "counter",
+		Message:     s.packAny(&gogotypes.Empty{}),
+	})
+	require.ErrorIs(err, types.ErrInvalidMessage)
+}
+
+func (s *KeeperTestSuite) TestExecuteAndQuery() {
+	require := s.Require()
+	addr := s.initCounter(5)
+
+	resp, err := s.msgServer.Execute(s.ctx, &types.MsgExecute{
+		Sender:  s.addrs[1].String(),
+		Target:  addr.String(),
+		Message: s.packAny(&gogotypes.UInt64Value{Value: 3}),
+	})
+	require.NoError(err)
+	var counter gogotypes.UInt64Value
+	require.NoError(counter.Unmarshal(resp.Response.Value))
+	require.Equal(uint64(8), counter.Value)
+
+	queryResp, err := s.queryServer.AccountQuery(s.ctx, &types.AccountQueryRequest{
+		Target:  addr.String(),
+		Request: s.packAny(&gogotypes.Empty{}),
+	})
+	require.NoError(err)
+	require.NoError(counter.Unmarshal(queryResp.Response.Value))
+	require.Equal(uint64(8), counter.Value)
+
+	// the state of each account is isolated
+	other := s.initCounter(1)
+	queryResp, err = s.queryServer.AccountQuery(s.ctx, &types.AccountQueryRequest{
+		Target:  other.String(),
+		Request: s.packAny(&gogotypes.Empty{}),
+	})
+	require.NoError(err)
+	require.NoError(counter.Unmarshal(queryResp.Response.Value))
+	require.Equal(uint64(1), counter.Value)
+
+	// no handler for the message
+	_, err = s.msgServer.Execute(s.ctx, &types.MsgExecute{
+		Sender:  s.addrs[1].String(),
+		Target:  addr.String(),
+		Message: s.packAny(&gogotypes.Empty{}),
+	})
+	require.ErrorIs(err, types.ErrInvalidMessage)
+
+	// unknown account
+	_, err = s.msgServer.Execute(s.ctx, &types.MsgExecute{
+		Sender:  s.addrs[1].String(),
+		Target:  s.addrs[0].String(),
+		Message: s.packAny(&gogotypes.UInt64Value{Value: 3}),
+	})
+	require.ErrorIs(err, types.ErrAccountNotFound)
+}
+
+func (s *KeeperTestSuite) TestExecModule() {
+	require := s.Require()
+	addr := s.initCounter(0)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	// the account can only send messages it is the signer of
+	_, err := s.msgServer.Execute(s.ctx, &types.MsgExecute{
+		Sender:  s.addrs[0].String(),
+		Target:  addr.String(),
+		Message: s.packAny(banktypes.NewMsgSend(s.addrs[0], s.addrs[1], coins)),
+	})
+	require.Error(err)
+	require.Empty(s.router.handled)
+
+	msg := banktypes.NewMsgSend(addr, s.addrs[1], coins)
+	resp, err := s.msgServer.Execute(s.ctx, &types.MsgExecute{
+		Sender:  s.addrs[0].String(),
+		Target:  addr.String(),
+		Message: s.packAny(msg),
+	})
+	require.NoError(err)
+	require.Equal("/cosmos.bank.v1beta1.MsgSendResponse", resp.Response.TypeUrl)
+	require.Len(s.router.handled, 1)
+	require.Equal(msg, s.router.handled[0])
+}
+
+func (s *KeeperTestSuite) TestAuthenticateAccount() {
+	require := s.Require()
+	addr := s.initCounter(0)
+
+	// the counter account does not handle MsgAuthenticate
+	err := s.keeper.AuthenticateAccount(s.ctx, addr, 0, nil, nil)
+	require.ErrorIs(err, types.ErrAuthentication)
+
+	err = s.keeper.AuthenticateAccount(s.ctx, s.addrs[0], 0, nil, nil)
+	require.ErrorIs(err, types.ErrAccountNotFound)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	require := s.Require()
+	first := s.initCounter(5)
+	second := s.initCounter(7)
+
+	gs, err := s.keeper.ExportGenesis(s.ctx)
+	require.NoError(err)
+	require.Len(gs.Accounts, 2)
+	require.NoError(gs.Validate())
+
+	s.SetupTest()
+	require.NoError(s.keeper.InitGenesis(s.ctx, gs))
+
+	for addr, expected := range map[string]uint64{first.String(): 5, second.String(): 7} {
+		resp, err := s.queryServer.AccountQuery(s.ctx, &types.AccountQueryRequest{
+			Target:  addr,
+			Request: s.packAny(&gogotypes.Empty{}),
+		})
+		require.NoError(err)
+		var counter gogotypes.UInt64Value
+		require.NoError(counter.Unmarshal(resp.Response.Value))
+		require.Equal(expected, counter.Value)
+	}
+
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	require.NoError(err)
+	require.Equal(gs, exported)
+
+	// unknown account type
+	gs.Accounts[0].AccountType = "unknown"
+	s.SetupTest()
+	require.ErrorIs(s.keeper.InitGenesis(s.ctx, gs), types.ErrAccountTypeNotFound)
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/accounts/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/accounts MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// Init creates a new account.
+func (k msgServer) Init(ctx context.Context, msg *types.MsgInit) (*types.MsgInitResponse, error) {
+	sender, err := k.authKeeper.AddressCodec().StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if msg.Message == nil {
+		return nil, errors.Wrap(types.ErrInvalidMessage, "init message cannot be empty")
+	}
+
+	if !msg.Funds.IsValid() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrap(msg.Funds.String())
+	}
+
+	addr, resp, err := k.Keeper.Init(ctx, msg.AccountType, sender, msg.Message, msg.Funds)
+	if err != nil {
+		return nil, err
+	}
+
+	addrStr, err := k.authKeeper.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgInitResponse{AccountAddress: addrStr, Response: resp}, nil
+}
+
+// Execute executes a message against an account.
+func (k msgServer) Execute(ctx context.Context, msg *types.MsgExecute) (*types.MsgExecuteResponse, error) {
+	sender, err := k.authKeeper.AddressCodec().StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	target, err := k.authKeeper.AddressCodec().StringToBytes(msg.Target)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid target address: %s", err)
+	}
+
+	if msg.Message == nil {
+		return nil, errors.Wrap(types.ErrInvalidMessage, "execute message cannot be empty")
+	}
+
+	if !msg.Funds.IsValid() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrap(msg.Funds.String())
+	}
+
+	resp, err := k.Keeper.Execute(ctx, target, sender, msg.Message, msg.Funds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecuteResponse{Response: resp}, nil
+}
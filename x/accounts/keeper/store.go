@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	corestore "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// coreKVStore adapts a store to the core store interface, in which the account
+// types collections are built.
+type coreKVStore struct {
+	store storetypes.KVStore
+}
+
+func (s coreKVStore) Get(key []byte) ([]byte, error) {
+	return s.store.Get(key), nil
+}
+
+func (s coreKVStore) Has(key []byte) (bool, error) {
+	return s.store.Has(key), nil
+}
+
+func (s coreKVStore) Set(key, value []byte) error {
+	s.store.Set(key, value)
+	return nil
+}
+
+func (s coreKVStore) Delete(key []byte) error {
+	s.store.Delete(key)
+	return nil
+}
+
+func (s coreKVStore) Iterator(start, end []byte) (corestore.Iterator, error) {
+	return s.store.Iterator(start, end), nil
+}
+
+func (s coreKVStore) ReverseIterator(start, end []byte) (corestore.Iterator, error) {
+	return s.store.ReverseIterator(start, end), nil
+}
@@ -0,0 +1,12 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/accounts module sentinel errors
+var (
+	ErrAccountTypeNotFound = errors.Register(ModuleName, 2, "account type not found")
+	ErrAccountNotFound     = errors.Register(ModuleName, 3, "account not found")
+	ErrAccountExists       = errors.Register(ModuleName, 4, "account already exists")
+	ErrInvalidMessage      = errors.Register(ModuleName, 5, "invalid account message")
+	ErrAuthentication      = errors.Register(ModuleName, 6, "account authentication failed")
+)
@@ -0,0 +1,9 @@
+package types
+
+// accounts module event types
+const (
+	EventTypeAccountCreation = "account_creation"
+
+	AttributeKeyAddress     = "address"
+	AttributeKeyAccountType = "account_type"
+)
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper defines the expected x/auth keeper, in which the x/accounts
+// accounts are also recorded so that they can sign and pay fees.
+type AccountKeeper interface {
+	AddressCodec() address.Codec
+	NextAccountNumber(ctx context.Context) uint64
+	HasAccount(ctx context.Context, addr sdk.AccAddress) bool
+	SetAccount(ctx context.Context, acc sdk.AccountI)
+}
+
+// BankKeeper defines the expected bank keeper, used to send funds to the
+// accounts on init and execution.
+type BankKeeper interface {
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new genesis state for the accounts module.
+func NewGenesisState(accounts []GenesisAccount) *GenesisState {
+	return &GenesisState{
+		Accounts: accounts,
+	}
+}
+
+// DefaultGenesis returns the default genesis state, without any account.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState([]GenesisAccount{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	addresses := make(map[string]bool, len(gs.Accounts))
+	numbers := make(map[uint64]bool, len(gs.Accounts))
+	for _, acc := range gs.Accounts {
+		if acc.Address == "" {
+			return fmt.Errorf("account address cannot be empty")
+		}
+		if acc.AccountType == "" {
+			return fmt.Errorf("type of account %s cannot be empty", acc.Address)
+		}
+		if addresses[acc.Address] {
+			return fmt.Errorf("duplicate account %s", acc.Address)
+		}
+		if numbers[acc.AccountNumber] {
+			return fmt.Errorf("duplicate account number %d", acc.AccountNumber)
+		}
+		addresses[acc.Address] = true
+		numbers[acc.AccountNumber] = true
+	}
+
+	return nil
+}
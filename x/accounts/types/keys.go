@@ -0,0 +1,28 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "accounts"
+
+	// StoreKey defines the primary module store key. It is prefixed so that it
+	// does not collide with the "acc" store key of x/auth.
+	StoreKey = "_" + ModuleName
+)
+
+var (
+	AccountTypesKey   = collections.NewPrefix(0) // key for the account types, by address
+	AccountNumbersKey = collections.NewPrefix(1) // key for the account numbers, by address
+	AccountsStateKey  = collections.NewPrefix(2) // key prefix of the accounts state, by account number
+)
+
+// AccountStatePrefix returns the store prefix of the state of the account with
+// the given number.
+func AccountStatePrefix(accountNumber uint64) []byte {
+	return append(AccountsStateKey.Bytes(), sdk.Uint64ToBigEndian(accountNumber)...)
+}
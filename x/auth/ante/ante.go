@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante/unorderedtx"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -20,6 +21,12 @@ type HandlerOptions struct {
 	SignModeHandler        *txsigning.HandlerMap
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker           TxFeeChecker
+	// UnorderedTxManager enables unordered transactions when set, tracking
+	// their hashes for replay protection.
+	UnorderedTxManager *unorderedtx.Manager
+	// AccountAbstractionKeeper enables x/accounts accounts to sign
+	// transactions when set, authenticating them instead of their public key.
+	AccountAbstractionKeeper AccountAbstractionKeeper
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -38,18 +45,24 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	extensionOptionChecker := options.ExtensionOptionChecker
+	if options.UnorderedTxManager != nil {
+		extensionOptionChecker = NewUnorderedTxExtensionOptionChecker(extensionOptionChecker)
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
-		NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
+		NewExtensionOptionsDecorator(extensionOptionChecker),
 		NewValidateBasicDecorator(),
 		NewTxTimeoutHeightDecorator(),
+		NewUnorderedTxDecorator(unorderedtx.DefaultMaxTimeoutDuration, options.UnorderedTxManager),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
 		NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(options.AccountKeeper),
-		NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
-		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer).WithAccountAbstractionKeeper(options.AccountAbstractionKeeper),
+		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler).WithAccountAbstractionKeeper(options.AccountAbstractionKeeper),
 		NewIncrementSequenceDecorator(options.AccountKeeper),
 	}
 
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/core/address"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -23,3 +24,10 @@ type AccountKeeper interface {
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// AccountAbstractionKeeper defines the expected x/accounts keeper, authenticating
+// the signers which are x/accounts accounts instead of public keys.
+type AccountAbstractionKeeper interface {
+	IsAccountsModuleAccount(ctx context.Context, addr []byte) bool
+	AuthenticateAccount(ctx context.Context, signer []byte, signerIndex uint32, rawTx *txtypes.TxRaw, protoTx *txtypes.Tx) error
+}
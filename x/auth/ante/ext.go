@@ -4,6 +4,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 type HasExtensionOptionsTx interface {
@@ -20,6 +21,22 @@ func rejectExtensionOption(*codectypes.Any) bool {
 	return false
 }
 
+// NewUnorderedTxExtensionOptionChecker returns an ExtensionOptionChecker
+// accepting the ExtensionOptionUnorderedTx extension option, and delegating
+// the check of any other extension option to the given checker. The
+// UnorderedTxDecorator must be part of the AnteHandler chain whenever
+// unordered transactions are accepted.
+func NewUnorderedTxExtensionOptionChecker(checker ExtensionOptionChecker) ExtensionOptionChecker {
+	if checker == nil {
+		checker = rejectExtensionOption
+	}
+
+	typeURL := codectypes.MsgTypeURL(&tx.ExtensionOptionUnorderedTx{})
+	return func(any *codectypes.Any) bool {
+		return any.TypeUrl == typeURL || checker(any)
+	}
+}
+
 // RejectExtensionOptionsDecorator is an AnteDecorator that rejects all extension
 // options which can optionally be included in protobuf transactions. Users that
 // need extension options should create a custom AnteHandler chain that handles
@@ -21,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -148,6 +149,7 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 type SigGasConsumeDecorator struct {
 	ak             AccountKeeper
 	sigGasConsumer SignatureVerificationGasConsumer
+	aaKeeper       AccountAbstractionKeeper
 }
 
 func NewSigGasConsumeDecorator(ak AccountKeeper, sigGasConsumer SignatureVerificationGasConsumer) SigGasConsumeDecorator {
@@ -161,6 +163,14 @@ func NewSigGasConsumeDecorator(ak AccountKeeper, sigGasConsumer SignatureVerific
 	}
 }
 
+// WithAccountAbstractionKeeper returns a copy of the decorator not consuming
+// signature gas for the x/accounts signers, whose authentication consumes its
+// own gas.
+func (sgcd SigGasConsumeDecorator) WithAccountAbstractionKeeper(aaKeeper AccountAbstractionKeeper) SigGasConsumeDecorator {
+	sgcd.aaKeeper = aaKeeper
+	return sgcd
+}
+
 func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	sigTx, ok := tx.(authsigning.SigVerifiableTx)
 	if !ok {
@@ -181,6 +191,10 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	}
 
 	for i, sig := range sigs {
+		if isAccountsModuleAccount(ctx, sgcd.aaKeeper, signers[i]) {
+			continue
+		}
+
 		signerAcc, err := GetSignerAcc(ctx, sgcd.ak, signers[i])
 		if err != nil {
 			return ctx, err
@@ -220,6 +234,7 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 type SigVerificationDecorator struct {
 	ak              AccountKeeper
 	signModeHandler *txsigning.HandlerMap
+	aaKeeper        AccountAbstractionKeeper
 }
 
 func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler *txsigning.HandlerMap) SigVerificationDecorator {
@@ -229,6 +244,14 @@ func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler *txsigning.Ha
 	}
 }
 
+// WithAccountAbstractionKeeper returns a copy of the decorator authenticating
+// the x/accounts signers through the given keeper, instead of verifying their
+// signature against a public key.
+func (svd SigVerificationDecorator) WithAccountAbstractionKeeper(aaKeeper AccountAbstractionKeeper) SigVerificationDecorator {
+	svd.aaKeeper = aaKeeper
+	return svd
+}
+
 // OnlyLegacyAminoSigners checks SignatureData to see if all
 // signers are using SIGN_MODE_LEGACY_AMINO_JSON. If this is the case
 // then the corresponding SignatureV2 struct will not have account sequence
@@ -273,20 +296,31 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signers), len(sigs))
 	}
 
+	// Unordered transactions are not bound to the sequence of their signers,
+	// replay protection is provided by the UnorderedTxDecorator instead.
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	isUnordered := ok && unorderedTx.GetUnordered()
+
 	for i, sig := range sigs {
 		acc, err := GetSignerAcc(ctx, svd.ak, signers[i])
 		if err != nil {
 			return ctx, err
 		}
 
+		// x/accounts signers are authenticated by the account itself
+		isAbstracted := isAccountsModuleAccount(ctx, svd.aaKeeper, signers[i])
+
 		// retrieve pubkey
 		pubKey := acc.GetPubKey()
-		if !simulate && pubKey == nil {
+		if !simulate && pubKey == nil && !isAbstracted {
 			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
 
 		// Check account sequence number.
-		if sig.Sequence != acc.GetSequence() {
+		sequence := acc.GetSequence()
+		if isUnordered {
+			sequence = sig.Sequence
+		} else if sig.Sequence != sequence {
 			return ctx, errorsmod.Wrapf(
 				sdkerrors.ErrWrongSequence,
 				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
@@ -303,13 +337,20 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 
 		// no need to verify signatures on recheck tx
 		if !simulate && !ctx.IsReCheckTx() && ctx.IsSigverifyTx() {
+			if isAbstracted {
+				if err := svd.authenticateAccount(ctx, tx, signers[i], i); err != nil {
+					return ctx, errorsmod.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+				}
+				continue
+			}
+
 			anyPk, _ := codectypes.NewAnyWithValue(pubKey)
 
 			signerData := txsigning.SignerData{
 				Address:       acc.GetAddress().String(),
 				ChainID:       chainID,
 				AccountNumber: accNum,
-				Sequence:      acc.GetSequence(),
+				Sequence:      sequence,
 				PubKey: &anypb.Any{
 					TypeUrl: anyPk.TypeUrl,
 					Value:   anyPk.Value,
@@ -339,6 +380,35 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	return next(ctx, tx, simulate)
 }
 
+// authenticateAccount authenticates an x/accounts signer by running the
+// authentication logic of the account against the transaction.
+func (svd SigVerificationDecorator) authenticateAccount(ctx sdk.Context, tx sdk.Tx, signer []byte, signerIndex int) error {
+	protoTxProvider, ok := tx.(interface{ GetProtoTx() *txtypes.Tx })
+	if !ok {
+		return fmt.Errorf("expected tx to implement GetProtoTx, got %T", tx)
+	}
+	adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
+	}
+
+	protoTx := protoTxProvider.GetProtoTx()
+	txData := adaptableTx.GetSigningTxData()
+	rawTx := &txtypes.TxRaw{
+		BodyBytes:     txData.BodyBytes,
+		AuthInfoBytes: txData.AuthInfoBytes,
+		Signatures:    protoTx.Signatures,
+	}
+
+	return svd.aaKeeper.AuthenticateAccount(ctx, signer, uint32(signerIndex), rawTx, protoTx)
+}
+
+// isAccountsModuleAccount returns true if the signer is an x/accounts account,
+// when the account abstraction is enabled.
+func isAccountsModuleAccount(ctx sdk.Context, aaKeeper AccountAbstractionKeeper, signer []byte) bool {
+	return aaKeeper != nil && aaKeeper.IsAccountsModuleAccount(ctx, signer)
+}
+
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
 // Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
 // there is need to execute IncrementSequenceDecorator on RecheckTx since
@@ -364,6 +434,11 @@ func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
 	}
 
+	// unordered transactions do not increment the sequence of their signers
+	if unorderedTx, ok := tx.(sdk.TxWithUnordered); ok && unorderedTx.GetUnordered() {
+		return next(ctx, tx, simulate)
+	}
+
 	// increment sequence of all signers
 	signers, err := sigTx.GetSigners()
 	if err != nil {
@@ -1,6 +1,8 @@
 package ante_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -18,8 +20,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	antetestutil "github.com/cosmos/cosmos-sdk/x/auth/ante/testutil"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 	authsign "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -359,3 +364,66 @@ func TestIncrementSequenceDecorator(t *testing.T) {
 		require.Equal(t, tc.expectedSeq, suite.accountKeeper.GetAccount(suite.ctx, addr).GetSequence())
 	}
 }
+
+func TestSigVerificationAccountAbstraction(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	ctrl := gomock.NewController(t)
+
+	// an x/accounts account is recorded in x/auth without public key
+	addr := sdk.AccAddress("aa_account__________")
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{
+		Data: &signing.SingleSignatureData{
+			SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
+			Signature: []byte("signature"),
+		},
+		Sequence: acc.GetSequence(),
+	}))
+	tx := suite.txBuilder.GetTx()
+
+	testCases := []struct {
+		name        string
+		authErr     error
+		withAA      bool
+		expectedErr error
+	}{
+		{"authenticated by the account", nil, true, nil},
+		{"rejected by the account", errors.New("invalid signature"), true, sdkerrors.ErrUnauthorized},
+		{"account abstraction disabled", nil, false, sdkerrors.ErrInvalidPubKey},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var aaKeeper ante.AccountAbstractionKeeper
+			if tc.withAA {
+				mock := antetestutil.NewMockAccountAbstractionKeeper(ctrl)
+				mock.EXPECT().IsAccountsModuleAccount(gomock.Any(), []byte(addr)).Return(true).AnyTimes()
+				mock.EXPECT().AuthenticateAccount(gomock.Any(), []byte(addr), uint32(0), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ []byte, _ uint32, rawTx *txtypes.TxRaw, protoTx *txtypes.Tx) error {
+						require.Equal(t, [][]byte{[]byte("signature")}, rawTx.Signatures)
+						require.Len(t, protoTx.AuthInfo.SignerInfos, 1)
+						return tc.authErr
+					})
+				aaKeeper = mock
+			}
+
+			antehandler := sdk.ChainAnteDecorators(
+				ante.NewSetPubKeyDecorator(suite.accountKeeper),
+				ante.NewSigGasConsumeDecorator(suite.accountKeeper, nil).WithAccountAbstractionKeeper(aaKeeper),
+				ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler()).WithAccountAbstractionKeeper(aaKeeper),
+			)
+
+			_, err := antehandler(suite.ctx, tx, false)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
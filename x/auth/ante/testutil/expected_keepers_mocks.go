@@ -10,6 +10,7 @@ import (
 
 	address "cosmossdk.io/core/address"
 	types "github.com/cosmos/cosmos-sdk/types"
+	tx "github.com/cosmos/cosmos-sdk/types/tx"
 	types0 "github.com/cosmos/cosmos-sdk/x/auth/types"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -141,3 +142,54 @@ func (mr *MockFeegrantKeeperMockRecorder) UseGrantedFees(ctx, granter, grantee,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseGrantedFees", reflect.TypeOf((*MockFeegrantKeeper)(nil).UseGrantedFees), ctx, granter, grantee, fee, msgs)
 }
+
+// MockAccountAbstractionKeeper is a mock of AccountAbstractionKeeper interface.
+type MockAccountAbstractionKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountAbstractionKeeperMockRecorder
+}
+
+// MockAccountAbstractionKeeperMockRecorder is the mock recorder for MockAccountAbstractionKeeper.
+type MockAccountAbstractionKeeperMockRecorder struct {
+	mock *MockAccountAbstractionKeeper
+}
+
+// NewMockAccountAbstractionKeeper creates a new mock instance.
+func NewMockAccountAbstractionKeeper(ctrl *gomock.Controller) *MockAccountAbstractionKeeper {
+	mock := &MockAccountAbstractionKeeper{ctrl: ctrl}
+	mock.recorder = &MockAccountAbstractionKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountAbstractionKeeper) EXPECT() *MockAccountAbstractionKeeperMockRecorder {
+	return m.recorder
+}
+
+// AuthenticateAccount mocks base method.
+func (m *MockAccountAbstractionKeeper) AuthenticateAccount(ctx context.Context, signer []byte, signerIndex uint32, rawTx *tx.TxRaw, protoTx *tx.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateAccount", ctx, signer, signerIndex, rawTx, protoTx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AuthenticateAccount indicates an expected call of AuthenticateAccount.
+func (mr *MockAccountAbstractionKeeperMockRecorder) AuthenticateAccount(ctx, signer, signerIndex, rawTx, protoTx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAccount", reflect.TypeOf((*MockAccountAbstractionKeeper)(nil).AuthenticateAccount), ctx, signer, signerIndex, rawTx, protoTx)
+}
+
+// IsAccountsModuleAccount mocks base method.
+func (m *MockAccountAbstractionKeeper) IsAccountsModuleAccount(ctx context.Context, addr []byte) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAccountsModuleAccount", ctx, addr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsAccountsModuleAccount indicates an expected call of IsAccountsModuleAccount.
+func (mr *MockAccountAbstractionKeeperMockRecorder) IsAccountsModuleAccount(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAccountsModuleAccount", reflect.TypeOf((*MockAccountAbstractionKeeper)(nil).IsAccountsModuleAccount), ctx, addr)
+}
@@ -0,0 +1,108 @@
+package ante
+
+import (
+	"crypto/sha256"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante/unorderedtx"
+)
+
+// UnorderedTxDecorator defines an AnteHandler decorator that is responsible
+// for checking if a transaction is intended to be unordered and, if so,
+// evaluates the transaction accordingly. An unordered transaction is neither
+// checked against nor increments the sequence of its signers, which allows
+// fire-and-forget transaction broadcasting, removing the necessity of
+// ordering on the sender side.
+//
+// The transaction sender must set the ExtensionOptionUnorderedTx extension
+// option, along with its timeout timestamp. The decorator rejects the
+// transaction if it is a duplicate of a transaction included in a block which
+// did not time out yet, and tracks it until its timeout once it is included
+// in a block.
+//
+// The UnorderedTxDecorator should be placed as early as possible in the
+// AnteHandler chain, so that duplicates are rejected before any expensive
+// check is performed.
+type UnorderedTxDecorator struct {
+	// maxTimeoutDuration defines the maximum duration an unordered transaction
+	// can be valid for.
+	maxTimeoutDuration time.Duration
+	txManager          *unorderedtx.Manager
+}
+
+// replayedTxHashKey is the key of the sdk.Replay values of the hashes of the
+// unordered transactions included in a replayed block.
+type replayedTxHashKey unorderedtx.TxHash
+
+func NewUnorderedTxDecorator(maxTimeoutDuration time.Duration, m *unorderedtx.Manager) *UnorderedTxDecorator {
+	return &UnorderedTxDecorator{
+		maxTimeoutDuration: maxTimeoutDuration,
+		txManager:          m,
+	}
+}
+
+func (d *UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	if !ok || !unorderedTx.GetUnordered() {
+		// If the transaction does not implement unordered capabilities or has
+		// the unordered value as false, we bypass.
+		return next(ctx, tx, simulate)
+	}
+
+	if d.txManager == nil {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrNotSupported, "unordered transactions are not supported")
+	}
+
+	timeoutTimestamp := unorderedTx.GetTimeoutTimeStamp()
+	if timeoutTimestamp.IsZero() || timeoutTimestamp.Unix() == 0 {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unordered transaction must have timeout_timestamp set")
+	}
+
+	blockTime := ctx.BlockTime()
+	if timeoutTimestamp.Before(blockTime) {
+		return ctx, errorsmod.Wrapf(
+			sdkerrors.ErrTxTimeout, "block time: %s, timeout timestamp: %s", blockTime, timeoutTimestamp,
+		)
+	}
+
+	if timeoutTimestamp.After(blockTime.Add(d.maxTimeoutDuration)) {
+		return ctx, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "unordered tx ttl exceeds %s", d.maxTimeoutDuration,
+		)
+	}
+
+	txHash := sha256.Sum256(ctx.TxBytes())
+
+	// check for duplicates; a replayed block is checked against the blocks
+	// committed before it and its own transactions, which are tracked by the
+	// replay only
+	replay := sdk.ReplayFromContext(ctx)
+	duplicated := d.txManager.Contains(txHash)
+	if replay != nil {
+		duplicated = d.txManager.ContainsBefore(txHash, ctx.BlockHeight()) ||
+			replay.Value(replayedTxHashKey(txHash)) != nil
+	}
+	if duplicated {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "tx %X is duplicated", txHash)
+	}
+
+	newCtx, err := next(ctx, tx, simulate)
+	if err != nil {
+		return newCtx, err
+	}
+
+	// a transaction failing the ante handler may be included again
+	if ctx.ExecMode() == sdk.ExecModeFinalize {
+		if replay != nil {
+			replay.SetValue(replayedTxHashKey(txHash), true)
+		} else {
+			d.txManager.Add(txHash, timeoutTimestamp, ctx.BlockHeight())
+		}
+	}
+
+	return newCtx, nil
+}
@@ -0,0 +1,222 @@
+package ante_test
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante/unorderedtx"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+const testMaxTimeoutDuration = 10 * time.Minute
+
+func TestUnorderedTxDecorator(t *testing.T) {
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+
+	testCases := map[string]struct {
+		unordered bool
+		timeout   time.Time
+		seen      bool
+		execMode  sdk.ExecMode
+		replay    bool
+		height    int64
+		expErr    error
+		expSeen   bool
+	}{
+		"ordered transaction": {
+			execMode: sdk.ExecModeFinalize,
+		},
+		"missing timeout": {
+			unordered: true,
+			execMode:  sdk.ExecModeFinalize,
+			expErr:    sdkerrors.ErrInvalidRequest,
+		},
+		"timeout already passed": {
+			unordered: true,
+			timeout:   blockTime.Add(-time.Second),
+			execMode:  sdk.ExecModeFinalize,
+			expErr:    sdkerrors.ErrTxTimeout,
+		},
+		"timeout exceeding max duration": {
+			unordered: true,
+			timeout:   blockTime.Add(testMaxTimeoutDuration + time.Second),
+			execMode:  sdk.ExecModeFinalize,
+			expErr:    sdkerrors.ErrInvalidRequest,
+		},
+		"duplicate transaction": {
+			unordered: true,
+			timeout:   blockTime.Add(time.Minute),
+			seen:      true,
+			execMode:  sdk.ExecModeFinalize,
+			expErr:    sdkerrors.ErrInvalidRequest,
+			expSeen:   true,
+		},
+		"valid transaction in check mode": {
+			unordered: true,
+			timeout:   blockTime.Add(time.Minute),
+			execMode:  sdk.ExecModeCheck,
+		},
+		"valid transaction in finalize mode": {
+			unordered: true,
+			timeout:   blockTime.Add(testMaxTimeoutDuration),
+			execMode:  sdk.ExecModeFinalize,
+			expSeen:   true,
+		},
+		"replayed transaction of the replayed block": {
+			unordered: true,
+			timeout:   blockTime.Add(time.Minute),
+			seen:      true,
+			execMode:  sdk.ExecModeFinalize,
+			replay:    true,
+			height:    1,
+			expSeen:   true,
+		},
+		"replayed duplicate of a previous block": {
+			unordered: true,
+			timeout:   blockTime.Add(time.Minute),
+			seen:      true,
+			execMode:  sdk.ExecModeFinalize,
+			replay:    true,
+			height:    2,
+			expErr:    sdkerrors.ErrInvalidRequest,
+			expSeen:   true,
+		},
+		"replayed transaction is not tracked": {
+			unordered: true,
+			timeout:   blockTime.Add(time.Minute),
+			execMode:  sdk.ExecModeFinalize,
+			replay:    true,
+			height:    1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			suite := SetupTestSuite(t, false)
+
+			txm := unorderedtx.NewManager(t.TempDir())
+			require.NoError(t, txm.Start(0))
+			t.Cleanup(func() { require.NoError(t, txm.Close()) })
+
+			txBytes := newUnorderedTestTx(t, suite, tc.unordered, tc.timeout)
+			theTx, err := suite.clientCtx.TxConfig.TxDecoder()(txBytes)
+			require.NoError(t, err)
+
+			txHash := sha256.Sum256(txBytes)
+			if tc.seen {
+				txm.OnNewBlock(1, blockTime)
+				txm.Add(txHash, tc.timeout, 1)
+				require.NoError(t, txm.OnCommit())
+			}
+
+			ctx := suite.ctx.WithBlockTime(blockTime).WithTxBytes(txBytes).WithExecMode(tc.execMode)
+			if tc.replay {
+				ctx = sdk.WithReplay(ctx)
+			}
+			if tc.height != 0 {
+				ctx = ctx.WithBlockHeight(tc.height)
+			}
+			anteHandler := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(testMaxTimeoutDuration, txm))
+
+			_, err = anteHandler(ctx, theTx, false)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expSeen, txm.Contains(txHash))
+		})
+	}
+}
+
+func TestUnorderedTxDecoratorReplayDuplicate(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+
+	txm := unorderedtx.NewManager(t.TempDir())
+	require.NoError(t, txm.Start(0))
+	t.Cleanup(func() { require.NoError(t, txm.Close()) })
+
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	txBytes := newUnorderedTestTx(t, suite, true, blockTime.Add(time.Minute))
+	theTx, err := suite.clientCtx.TxConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+
+	anteHandler := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(testMaxTimeoutDuration, txm))
+	ctx := sdk.WithReplay(suite.ctx.WithBlockTime(blockTime).WithBlockHeight(1).WithTxBytes(txBytes).WithExecMode(sdk.ExecModeFinalize))
+
+	// the transaction of a discarded branch of the replay is not tracked
+	_, err = anteHandler(sdk.WithReplay(ctx), theTx, false)
+	require.NoError(t, err)
+
+	// the duplicate of a transaction of the replayed block is rejected, without
+	// the hash being tracked by the manager
+	branch := sdk.WithReplay(ctx)
+	_, err = anteHandler(branch, theTx, false)
+	require.NoError(t, err)
+	sdk.ReplayFromContext(branch).Write()
+
+	_, err = anteHandler(sdk.WithReplay(ctx), theTx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.False(t, txm.Contains(sha256.Sum256(txBytes)))
+}
+
+func TestUnorderedTxSequence(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	accs := suite.CreateTestAccounts(1)
+
+	// the sequence is neither checked nor incremented
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	require.NoError(t, suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	setUnorderedTxOption(t, suite.txBuilder.(authtx.ExtensionOptionsTxBuilder), suite.ctx.BlockTime().Add(time.Minute))
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{accs[0].acc.GetAccountNumber()}, []uint64{7}
+	tx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewSetPubKeyDecorator(suite.accountKeeper),
+		ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler()),
+		ante.NewIncrementSequenceDecorator(suite.accountKeeper),
+	)
+
+	_, err = anteHandler(suite.ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), suite.accountKeeper.GetAccount(suite.ctx, accs[0].acc.GetAddress()).GetSequence())
+}
+
+func newUnorderedTestTx(t *testing.T, suite *AnteTestSuite, unordered bool, timeout time.Time) []byte {
+	t.Helper()
+
+	txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg()))
+
+	if unordered {
+		setUnorderedTxOption(t, txBuilder.(authtx.ExtensionOptionsTxBuilder), timeout)
+	}
+
+	txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	return txBytes
+}
+
+func setUnorderedTxOption(t *testing.T, txBuilder authtx.ExtensionOptionsTxBuilder, timeout time.Time) {
+	t.Helper()
+
+	opt, err := codectypes.NewAnyWithValue(&txtypes.ExtensionOptionUnorderedTx{TimeoutTimestamp: timeout})
+	require.NoError(t, err)
+	txBuilder.SetExtensionOptions(opt)
+}
@@ -0,0 +1,463 @@
+package unorderedtx
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMaxTimeoutDuration defines the default maximum duration an
+	// unordered transaction can be valid for, i.e. the maximum difference
+	// between its timeout timestamp and the block time.
+	DefaultMaxTimeoutDuration = 10 * time.Minute
+
+	dirName  = "unordered_txs"
+	fileName = "data"
+
+	// entrySize is the size of an encoded entry: the transaction hash,
+	// followed by its timeout timestamp in nanoseconds and the height of the
+	// block which included it.
+	entrySize = sha256.Size + 8 + 8
+
+	// minCompactionSize is the number of journaled entries below which the
+	// journal is never compacted.
+	minCompactionSize = 10_000
+
+	// maxSnapshotCaptures is the number of hash sets captured for snapshots
+	// which are kept until exported.
+	maxSnapshotCaptures = 2
+)
+
+// TxHash defines a transaction hash type alias, which is a fixed array of 32
+// bytes.
+type TxHash [sha256.Size]byte
+
+type entry struct {
+	timeout time.Time
+	height  int64
+}
+
+// Manager contains the hashes of the unordered transactions included in
+// blocks, which are used for duplicate checking, and expires them as block
+// production progresses.
+//
+// The hashes are not part of the application state. Instead, they are
+// journaled to a file of the data directory as blocks are committed, and
+// exported to and restored from state sync snapshots through a Snapshotter.
+// The hashes added while executing a block are only tracked for good once the
+// block is committed, so that an execution which is discarded, e.g. an aborted
+// optimistic execution, has no effect. Every node must however track the exact
+// same hashes, which requires blocks to be executed sequentially: applications
+// using the Manager must call BaseApp.DisallowParallelExecution, so that they
+// fail to load if parallel execution is enabled.
+//
+// The hashes are expired when the block is committed rather than in EndBlock,
+// as the hashes added: EndBlock is part of the execution, which may be
+// discarded. As snapshots are taken asynchronously once a block is committed,
+// the hashes of the snapshot heights are captured when they are committed.
+//
+// Replayed blocks, whose context holds an sdk.Replay, must not change the
+// tracked hashes: OnNewBlock must not be called for them, and duplicates are
+// checked with ContainsBefore, along with the hashes added during the replay,
+// which are kept in the sdk.Replay.
+type Manager struct {
+	// dataDir defines the directory to store unexpired unordered transactions
+	dataDir string
+
+	mu sync.RWMutex
+	// txHashes defines a map from tx hash -> timeout timestamp and inclusion
+	// height of the transactions included in committed blocks, used for
+	// duplicate checking and replay protection.
+	txHashes map[TxHash]entry
+	// pending holds the hashes added while executing the current block.
+	pending map[TxHash]entry
+	// height and blockTime are the height and the time of the block being
+	// executed.
+	height    int64
+	blockTime time.Time
+	// committedHeight is the height of the last committed block.
+	committedHeight int64
+	// snapshotInterval is the interval of the heights whose hashes are
+	// captured in snapshots when committed, none if zero.
+	snapshotInterval uint64
+	// snapshots holds the encoded hashes captured for the snapshot heights,
+	// sorted, until exported.
+	snapshots map[int64][]byte
+	// journal is the file the hashes are appended to as blocks are committed.
+	journal *os.File
+	// journaled is the number of entries written to the journal since it was
+	// last compacted.
+	journaled int
+}
+
+// NewManager returns a Manager persisting unordered transaction hashes in the
+// given data directory. Start must be called before it is used.
+func NewManager(dataDir string) *Manager {
+	path := filepath.Join(dataDir, dirName)
+	if err := os.MkdirAll(path, os.ModePerm); err != nil {
+		panic(fmt.Errorf("failed to create unordered txs directory: %w", err))
+	}
+
+	return &Manager{
+		dataDir:  path,
+		txHashes:  make(map[TxHash]entry),
+		pending:   make(map[TxHash]entry),
+		snapshots: make(map[int64][]byte),
+	}
+}
+
+// Start loads the persisted hashes and opens the journal. Hashes included
+// after lastHeight, i.e. in blocks which were never committed, are discarded.
+// It must be called once the application state has been loaded and before
+// any block is executed.
+func (m *Manager) Start(lastHeight int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	bz, err := os.ReadFile(filepath.Join(m.dataDir, fileName))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to read unordered txs file: %w", err)
+	}
+
+	// a partially written trailing entry may only result from a crash while
+	// journaling a block which was hence never committed
+	bz = bz[:len(bz)-len(bz)%entrySize]
+
+	err = decodeEntries(bz, func(txHash TxHash, e entry) {
+		if e.height <= lastHeight {
+			m.txHashes[txHash] = e
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	// The journal is left as is, discarded entries are only dropped by the
+	// next compaction. Should the node crash before that, they are discarded
+	// again or, once their block is committed again, journaled again.
+	m.journal, err = os.OpenFile(filepath.Join(m.dataDir, fileName), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open unordered txs file: %w", err)
+	}
+
+	// drop the partially written trailing entry, if any
+	if err := m.journal.Truncate(int64(len(bz))); err != nil {
+		return fmt.Errorf("failed to truncate unordered txs file: %w", err)
+	}
+	m.journaled = len(bz) / entrySize
+	m.committedHeight = lastHeight
+
+	return nil
+}
+
+// Close flushes the hashes to the data directory and closes the journal.
+func (m *Manager) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.journal == nil {
+		return nil
+	}
+
+	if err := m.compact(); err != nil {
+		return err
+	}
+
+	err := m.journal.Close()
+	m.journal = nil
+
+	return err
+}
+
+// Contains returns whether the given transaction hash is tracked, i.e. whether
+// the transaction was included in a committed block or in the block being
+// executed.
+func (m *Manager) Contains(hash TxHash) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if _, ok := m.pending[hash]; ok {
+		return true
+	}
+
+	_, ok := m.txHashes[hash]
+	return ok
+}
+
+// ContainsBefore returns whether the given transaction hash was included in a
+// committed block below the given height, and did not expire since. It checks
+// duplicates when a block is replayed, the hashes of the blocks committed since
+// being tracked.
+func (m *Manager) ContainsBefore(hash TxHash, height int64) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	e, ok := m.txHashes[hash]
+	return ok && e.height < height
+}
+
+// Size returns the number of tracked transaction hashes.
+func (m *Manager) Size() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return len(m.txHashes) + len(m.pending)
+}
+
+// Add tracks the given transaction hash, included in the block being executed
+// at the given height, until its timeout timestamp.
+func (m *Manager) Add(txHash TxHash, timeout time.Time, height int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.txHashes[txHash]; ok {
+		return
+	}
+
+	m.pending[txHash] = entry{timeout: timeout, height: height}
+}
+
+// OnNewBlock must be called whenever the execution of a block starts, i.e. in
+// PreBlock, with its height and time. It discards the hashes added by any
+// previous execution which was not committed.
+func (m *Manager) OnNewBlock(height int64, blockTime time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.pending = make(map[TxHash]entry)
+	m.height = height
+	m.blockTime = blockTime
+}
+
+// OnCommit must be called whenever a block is committed, i.e. in Precommit. It
+// expires the hashes of the transactions which timed out before the block time
+// and persists the hashes added during the block.
+func (m *Manager) OnCommit() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.journal == nil {
+		return errors.New("unordered tx manager is not started")
+	}
+
+	for txHash, e := range m.txHashes {
+		if e.timeout.Before(m.blockTime) {
+			delete(m.txHashes, txHash)
+		}
+	}
+
+	var buf bytes.Buffer
+	for txHash, e := range m.pending {
+		if !e.timeout.Before(m.blockTime) {
+			m.txHashes[txHash] = e
+			encodeEntry(&buf, txHash, e)
+			m.journaled++
+		}
+	}
+	m.pending = make(map[TxHash]entry)
+	m.committedHeight = m.height
+
+	if m.snapshotInterval > 0 && uint64(m.height)%m.snapshotInterval == 0 {
+		m.captureSnapshot()
+	}
+
+	if m.journaled > minCompactionSize && m.journaled > 2*len(m.txHashes) {
+		return m.compact()
+	}
+
+	if _, err := m.journal.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("failed to journal unordered txs: %w", err)
+	}
+
+	return m.journal.Sync()
+}
+
+// compact atomically replaces the journal with the tracked hashes. The caller
+// must hold the write lock.
+func (m *Manager) compact() error {
+	path := filepath.Join(m.dataDir, fileName)
+	tmpPath := path + ".tmp"
+
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return fmt.Errorf("failed to create unordered txs file: %w", err)
+	}
+
+	var buf bytes.Buffer
+	for txHash, e := range m.txHashes {
+		encodeEntry(&buf, txHash, e)
+	}
+
+	if _, err = f.Write(buf.Bytes()); err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write unordered txs file: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to write unordered txs file: %w", err)
+	}
+
+	if m.journal != nil {
+		if err := m.journal.Close(); err != nil {
+			return err
+		}
+	}
+
+	m.journal, err = os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open unordered txs file: %w", err)
+	}
+
+	m.journaled = len(m.txHashes)
+
+	return nil
+}
+
+// setSnapshotInterval sets the interval of the snapshot heights, whose hashes
+// are captured when committed.
+func (m *Manager) setSnapshotInterval(interval uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.snapshotInterval = interval
+}
+
+// captureSnapshot captures the tracked hashes for the snapshot of the last
+// committed height, dropping the oldest captures which were not exported. The
+// caller must hold the write lock.
+func (m *Manager) captureSnapshot() {
+	m.snapshots[m.committedHeight] = m.encodeSorted()
+
+	for len(m.snapshots) > maxSnapshotCaptures {
+		oldest := m.committedHeight
+		for height := range m.snapshots {
+			oldest = min(oldest, height)
+		}
+		delete(m.snapshots, oldest)
+	}
+}
+
+// encodeSorted returns the encoded tracked hashes, in a deterministic order. The
+// caller must hold the lock.
+func (m *Manager) encodeSorted() []byte {
+	txHashes := make([]TxHash, 0, len(m.txHashes))
+	for txHash := range m.txHashes {
+		txHashes = append(txHashes, txHash)
+	}
+	sort.Slice(txHashes, func(i, j int) bool {
+		return bytes.Compare(txHashes[i][:], txHashes[j][:]) < 0
+	})
+
+	var buf bytes.Buffer
+	buf.Grow(len(txHashes) * entrySize)
+	for _, txHash := range txHashes {
+		encodeEntry(&buf, txHash, m.txHashes[txHash])
+	}
+
+	return buf.Bytes()
+}
+
+// exportSnapshot writes the hashes tracked once the given height was committed,
+// in a deterministic order, in batches of the given size. They must have been
+// captured when the height was committed, unless it is the last committed one:
+// the hashes expired since are lost otherwise.
+func (m *Manager) exportSnapshot(height uint64, batchSize int, writer func([]byte) error) error {
+	m.mu.Lock()
+	bz, ok := m.snapshots[int64(height)]
+	if ok {
+		delete(m.snapshots, int64(height))
+	} else if int64(height) == m.committedHeight {
+		bz, ok = m.encodeSorted(), true
+	}
+	m.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("the unordered txs of height %d were not captured when committed", height)
+	}
+
+	for len(bz) > 0 {
+		n := min(len(bz), batchSize*entrySize)
+		if err := writer(bz[:n]); err != nil {
+			return err
+		}
+		bz = bz[n:]
+	}
+
+	return nil
+}
+
+// restoreSnapshot replaces the tracked hashes with the ones of the given height
+// read from the given payload reader until io.EOF, and persists them.
+func (m *Manager) restoreSnapshot(height uint64, reader func() ([]byte, error)) error {
+	txHashes := make(map[TxHash]entry)
+	for {
+		payload, err := reader()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if len(payload)%entrySize != 0 {
+			return fmt.Errorf("invalid unordered txs snapshot payload length: %d", len(payload))
+		}
+
+		err = decodeEntries(payload, func(txHash TxHash, e entry) {
+			txHashes[txHash] = e
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.txHashes = txHashes
+	m.committedHeight = int64(height)
+
+	return m.compact()
+}
+
+func encodeEntry(buf *bytes.Buffer, txHash TxHash, e entry) {
+	var bz [16]byte
+	binary.BigEndian.PutUint64(bz[:8], uint64(e.timeout.UnixNano()))
+	binary.BigEndian.PutUint64(bz[8:], uint64(e.height))
+
+	buf.Write(txHash[:])
+	buf.Write(bz[:])
+}
+
+func decodeEntries(bz []byte, fn func(TxHash, entry)) error {
+	if len(bz)%entrySize != 0 {
+		return fmt.Errorf("invalid unordered txs data length: %d", len(bz))
+	}
+
+	for len(bz) > 0 {
+		var txHash TxHash
+		copy(txHash[:], bz[:sha256.Size])
+
+		fn(txHash, entry{
+			timeout: time.Unix(0, int64(binary.BigEndian.Uint64(bz[sha256.Size:]))),
+			height:  int64(binary.BigEndian.Uint64(bz[sha256.Size+8:])),
+		})
+
+		bz = bz[entrySize:]
+	}
+
+	return nil
+}
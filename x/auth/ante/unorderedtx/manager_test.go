@@ -0,0 +1,99 @@
+package unorderedtx_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/ante/unorderedtx"
+)
+
+func TestManager(t *testing.T) {
+	dataDir := t.TempDir()
+	now := time.Now()
+
+	txm := unorderedtx.NewManager(dataDir)
+	require.NoError(t, txm.Start(0))
+
+	txm.OnNewBlock(1, now)
+	txm.Add([32]byte{0x01}, now.Add(time.Minute), 1)
+	txm.Add([32]byte{0x02}, now.Add(2*time.Minute), 1)
+	require.True(t, txm.Contains([32]byte{0x01}))
+	require.False(t, txm.ContainsBefore([32]byte{0x01}, 2))
+	require.NoError(t, txm.OnCommit())
+	require.Equal(t, 2, txm.Size())
+
+	// a replay of the block does not see its own hashes
+	require.True(t, txm.ContainsBefore([32]byte{0x01}, 2))
+	require.False(t, txm.ContainsBefore([32]byte{0x01}, 1))
+
+	// expired hashes are purged once the block is committed
+	txm.OnNewBlock(2, now.Add(time.Minute + time.Second))
+	require.Equal(t, 2, txm.Size())
+	require.NoError(t, txm.OnCommit())
+	require.Equal(t, 1, txm.Size())
+	require.False(t, txm.Contains([32]byte{0x01}))
+	require.True(t, txm.Contains([32]byte{0x02}))
+
+	// hashes are restored on restart
+	require.NoError(t, txm.Close())
+	txm = unorderedtx.NewManager(dataDir)
+	require.NoError(t, txm.Start(2))
+	require.Equal(t, 1, txm.Size())
+	require.True(t, txm.Contains([32]byte{0x02}))
+	require.NoError(t, txm.Close())
+}
+
+func TestManagerDiscardedExecution(t *testing.T) {
+	now := time.Now()
+
+	txm := unorderedtx.NewManager(t.TempDir())
+	require.NoError(t, txm.Start(0))
+	defer func() { require.NoError(t, txm.Close()) }()
+
+	txm.OnNewBlock(1, now)
+	txm.Add([32]byte{0x01}, now.Add(time.Minute), 1)
+	require.True(t, txm.Contains([32]byte{0x01}))
+
+	// the block is executed again, e.g. after an aborted optimistic execution
+	txm.OnNewBlock(1, now)
+	require.False(t, txm.Contains([32]byte{0x01}))
+	txm.Add([32]byte{0x02}, now.Add(time.Minute), 1)
+	require.NoError(t, txm.OnCommit())
+
+	require.Equal(t, 1, txm.Size())
+	require.True(t, txm.Contains([32]byte{0x02}))
+}
+
+func TestManagerCrashRecovery(t *testing.T) {
+	dataDir := t.TempDir()
+	now := time.Now()
+
+	txm := unorderedtx.NewManager(dataDir)
+	require.NoError(t, txm.Start(0))
+
+	txm.OnNewBlock(1, now)
+	txm.Add([32]byte{0x01}, now.Add(time.Minute), 1)
+	require.NoError(t, txm.OnCommit())
+	txm.OnNewBlock(2, now)
+	txm.Add([32]byte{0x02}, now.Add(time.Minute), 2)
+	require.NoError(t, txm.OnCommit())
+
+	// simulate a crash while journaling a block
+	f, err := os.OpenFile(filepath.Join(dataDir, "unordered_txs", "data"), os.O_WRONLY|os.O_APPEND, 0o600)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0x03, 0x03})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	// hashes of blocks which were not committed are discarded
+	txm = unorderedtx.NewManager(dataDir)
+	require.NoError(t, txm.Start(1))
+	require.Equal(t, 1, txm.Size())
+	require.True(t, txm.Contains([32]byte{0x01}))
+	require.False(t, txm.Contains([32]byte{0x02}))
+	require.NoError(t, txm.Close())
+}
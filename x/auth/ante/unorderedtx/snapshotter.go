@@ -0,0 +1,65 @@
+package unorderedtx
+
+import (
+	snapshot "cosmossdk.io/store/snapshots/types"
+)
+
+const (
+	// SnapshotFormat defines the snapshot format of exported unordered
+	// transactions. No protobuf envelope, no metadata.
+	SnapshotFormat = 1
+
+	// SnapshotName defines the snapshot name of exported unordered
+	// transactions.
+	SnapshotName = "unordered_txs"
+
+	// snapshotBatchSize is the number of entries written per snapshot payload.
+	snapshotBatchSize = 1024
+)
+
+var _ snapshot.ExtensionSnapshotter = &Snapshotter{}
+
+// Snapshotter exports and restores the transaction hashes tracked by a Manager
+// as a state sync snapshot extension.
+type Snapshotter struct {
+	m *Manager
+}
+
+// NewSnapshotter returns a Snapshotter of the given Manager, for snapshots taken
+// at the given interval of heights, i.e. the one of the snapshot manager it is
+// registered with. The Manager captures its hashes when the snapshot heights
+// are committed, as the snapshots are taken asynchronously.
+func NewSnapshotter(m *Manager, interval uint64) *Snapshotter {
+	m.setSnapshotInterval(interval)
+	return &Snapshotter{m: m}
+}
+
+// SnapshotName implements the ExtensionSnapshotter interface.
+func (s *Snapshotter) SnapshotName() string {
+	return SnapshotName
+}
+
+// SnapshotFormat implements the ExtensionSnapshotter interface.
+func (s *Snapshotter) SnapshotFormat() uint32 {
+	return SnapshotFormat
+}
+
+// SupportedFormats implements the ExtensionSnapshotter interface.
+func (s *Snapshotter) SupportedFormats() []uint32 {
+	return []uint32{SnapshotFormat}
+}
+
+// SnapshotExtension implements the ExtensionSnapshotter interface. The hashes
+// tracked once the snapshot height was committed are exported.
+func (s *Snapshotter) SnapshotExtension(height uint64, payloadWriter snapshot.ExtensionPayloadWriter) error {
+	return s.m.exportSnapshot(height, snapshotBatchSize, payloadWriter)
+}
+
+// RestoreExtension implements the ExtensionSnapshotter interface.
+func (s *Snapshotter) RestoreExtension(height uint64, format uint32, payloadReader snapshot.ExtensionPayloadReader) error {
+	if format != SnapshotFormat {
+		return snapshot.ErrUnknownFormat
+	}
+
+	return s.m.restoreSnapshot(height, payloadReader)
+}
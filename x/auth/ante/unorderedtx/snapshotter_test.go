@@ -0,0 +1,85 @@
+package unorderedtx_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/ante/unorderedtx"
+)
+
+func TestSnapshotter(t *testing.T) {
+	now := time.Now()
+
+	txm := unorderedtx.NewManager(t.TempDir())
+	require.NoError(t, txm.Start(0))
+	defer func() { require.NoError(t, txm.Close()) }()
+
+	// the hashes are captured at every height
+	s := unorderedtx.NewSnapshotter(txm, 1)
+
+	for height := int64(1); height <= 2; height++ {
+		txm.OnNewBlock(height, now)
+		for i := 0; i < 1000; i++ {
+			txm.Add([32]byte{byte(height), byte(i), byte(i >> 8)}, now.Add(time.Minute), height)
+		}
+		require.NoError(t, txm.OnCommit())
+	}
+
+	// all the hashes expire at the third height, before the snapshot of the
+	// second one is taken, which is however not affected
+	txm.OnNewBlock(3, now.Add(2*time.Minute))
+	require.NoError(t, txm.OnCommit())
+	require.Equal(t, 0, txm.Size())
+
+	var payloads [][]byte
+	err := s.SnapshotExtension(2, func(payload []byte) error {
+		payloads = append(payloads, append([]byte(nil), payload...))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, payloads, 2)
+
+	// the oldest captures are dropped
+	err = s.SnapshotExtension(1, func([]byte) error { return nil })
+	require.ErrorContains(t, err, "were not captured")
+
+	txm2 := unorderedtx.NewManager(t.TempDir())
+	require.NoError(t, txm2.Start(0))
+	defer func() { require.NoError(t, txm2.Close()) }()
+
+	// restoring an unknown format fails
+	s2 := unorderedtx.NewSnapshotter(txm2, 1)
+	err = s2.RestoreExtension(2, unorderedtx.SnapshotFormat+1, func() ([]byte, error) { return nil, io.EOF })
+	require.Error(t, err)
+
+	err = s2.RestoreExtension(2, unorderedtx.SnapshotFormat, func() ([]byte, error) {
+		if len(payloads) == 0 {
+			return nil, io.EOF
+		}
+		payload := payloads[0]
+		payloads = payloads[1:]
+		return payload, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2000, txm2.Size())
+	require.True(t, txm2.Contains([32]byte{0x01}))
+	require.True(t, txm2.Contains([32]byte{0x02}))
+
+	// the last committed height, the restored one, can be exported without a
+	// capture
+	var restored [][]byte
+	err = unorderedtx.NewSnapshotter(txm2, 0).SnapshotExtension(2, func(payload []byte) error {
+		restored = append(restored, payload)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, restored, 2)
+
+	// restored hashes are purged once expired
+	txm2.OnNewBlock(3, now.Add(time.Hour))
+	require.NoError(t, txm2.OnCommit())
+	require.Equal(t, 0, txm2.Size())
+}
@@ -1,16 +1,29 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 )
 
+const (
+	flagOverrideBalance  = "override-balance"
+	flagOverrideSequence = "override-sequence"
+	flagOverrideHeight   = "override-height"
+	flagOverrideTime     = "override-time"
+)
+
 // GetSimulateCmd returns a command that simulates whether a transaction will be
 // successful.
 func GetSimulateCmd() *cobra.Command {
@@ -99,3 +112,157 @@ necessary for simulation.
 
 	return cmd
 }
+
+// GetSimulateTraceCmd returns a command that simulates a transaction on the
+// state modified by overrides, and prints the trace of its execution.
+func GetSimulateTraceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-trace /path/to/unsigned-tx.json --from keyname",
+		Short: "Simulate a transaction on an overridden state and trace its execution",
+		Long: strings.TrimSpace(`Simulate a transaction like the simulate command, on the state
+modified by the given overrides, and print the trace of its execution: the gas
+consumed and the events emitted by the ante handler, each message and the post
+handler, and the reads and writes of the KV stores. If the transaction fails,
+the error is printed along with the trace of its execution up to the failure.
+
+The overrides only apply to the simulation:
+
+--override-balance=<address>=<coins>  sets the balance of an account for the
+                                      given denoms, creating the account
+--override-sequence=<address>=<seq>   sets the sequence of an account, creating
+                                      the account
+--override-height=<height>            sets the height of the block
+--override-time=<RFC3339 time>        sets the time of the block
+
+The signer account does not need to exist if its balance or sequence is
+overridden, and the transaction is simulated with the overridden sequence of
+the signer. For instance, to prepare a transaction of an account yet to be
+funded:
+
+$ <appd> tx simulate-trace tx.json --from mykey --override-balance=cosmos1...=1000stake
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			overrides, err := parseStateOverrides(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress().String()
+			signerOverridden := false
+			for _, override := range overrides.Balances {
+				if override.Address == from {
+					signerOverridden = true
+				}
+			}
+			for _, override := range overrides.Sequences {
+				if override.Address == from {
+					signerOverridden = true
+					txf = txf.WithSequence(override.Sequence)
+				}
+			}
+
+			// the signer account may only exist once overridden
+			if err := clientCtx.AccountRetriever.EnsureExists(clientCtx, clientCtx.GetFromAddress()); err == nil || !signerOverridden {
+				if txf, err = txf.Prepare(clientCtx); err != nil {
+					return err
+				}
+			}
+
+			stdTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			txBytes, err := txf.BuildSimTx(stdTx.GetMsgs()...)
+			if err != nil {
+				return err
+			}
+
+			res, err := txtypes.NewServiceClient(clientCtx).SimulateWithTrace(cmd.Context(), &txtypes.SimulateWithTraceRequest{
+				TxBytes:   txBytes,
+				Overrides: overrides,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringArray(flagOverrideBalance, nil, "Override the balance of an account, as <address>=<coins>")
+	cmd.Flags().StringArray(flagOverrideSequence, nil, "Override the sequence of an account, as <address>=<sequence>")
+	cmd.Flags().Int64(flagOverrideHeight, 0, "Override the height of the block")
+	cmd.Flags().String(flagOverrideTime, "", "Override the time of the block, in RFC3339 format")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseStateOverrides returns the state overrides set by the flags.
+func parseStateOverrides(fs *pflag.FlagSet) (*txtypes.StateOverrides, error) {
+	overrides := &txtypes.StateOverrides{}
+
+	balances, err := fs.GetStringArray(flagOverrideBalance)
+	if err != nil {
+		return nil, err
+	}
+	for _, balance := range balances {
+		addr, amount, ok := strings.Cut(balance, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid balance override %s, expected <address>=<coins>", balance)
+		}
+
+		coins, err := sdk.ParseCoinsNormalized(amount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid balance override %s: %w", balance, err)
+		}
+		overrides.Balances = append(overrides.Balances, txtypes.BalanceOverride{Address: addr, Amount: coins})
+	}
+
+	sequences, err := fs.GetStringArray(flagOverrideSequence)
+	if err != nil {
+		return nil, err
+	}
+	for _, sequence := range sequences {
+		addr, seq, ok := strings.Cut(sequence, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid sequence override %s, expected <address>=<sequence>", sequence)
+		}
+
+		n, err := strconv.ParseUint(seq, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sequence override %s: %w", sequence, err)
+		}
+		overrides.Sequences = append(overrides.Sequences, txtypes.SequenceOverride{Address: addr, Sequence: n})
+	}
+
+	if overrides.BlockHeight, err = fs.GetInt64(flagOverrideHeight); err != nil {
+		return nil, err
+	}
+
+	blockTime, err := fs.GetString(flagOverrideTime)
+	if err != nil {
+		return nil, err
+	}
+	if blockTime != "" {
+		t, err := time.Parse(time.RFC3339, blockTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time override %s: %w", blockTime, err)
+		}
+		overrides.BlockTime = &t
+	}
+
+	return overrides, overrides.ValidateBasic()
+}
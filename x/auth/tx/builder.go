@@ -3,6 +3,7 @@ package tx
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 	protov2 "google.golang.org/protobuf/proto"
@@ -44,6 +45,7 @@ type wrapper struct {
 
 var (
 	_ authsigning.Tx             = &wrapper{}
+	_ sdk.TxWithUnordered        = &wrapper{}
 	_ client.TxBuilder           = &wrapper{}
 	_ ante.HasExtensionOptionsTx = &wrapper{}
 	_ ExtensionOptionsTxBuilder  = &wrapper{}
@@ -221,6 +223,24 @@ func (w *wrapper) GetTimeoutHeight() uint64 {
 	return w.tx.Body.TimeoutHeight
 }
 
+// GetUnordered returns whether the transaction is unordered, i.e. whether it
+// carries an ExtensionOptionUnorderedTx.
+func (w *wrapper) GetUnordered() bool {
+	opt, err := tx.GetUnorderedTxOption(w.tx.Body.ExtensionOptions)
+	return err == nil && opt != nil
+}
+
+// GetTimeoutTimeStamp returns the transaction's timeout timestamp (if
+// unordered).
+func (w *wrapper) GetTimeoutTimeStamp() time.Time {
+	opt, err := tx.GetUnorderedTxOption(w.tx.Body.ExtensionOptions)
+	if err != nil || opt == nil {
+		return time.Time{}
+	}
+
+	return opt.TimeoutTimestamp
+}
+
 func (w *wrapper) GetSignaturesV2() ([]signing.SignatureV2, error) {
 	signerInfos := w.tx.AuthInfo.SignerInfos
 	sigs := w.tx.Signatures
@@ -24,18 +24,26 @@ import (
 // baseAppSimulateFn is the signature of the Baseapp#Simulate function.
 type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
 
+// baseAppSimulateWithTraceFn is the signature of the Baseapp#SimulateWithTrace
+// function.
+type baseAppSimulateWithTraceFn func(txBytes []byte, overrides *txtypes.StateOverrides) (sdk.GasInfo, *sdk.Result, *txtypes.SimulationTrace, error)
+
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
 	clientCtx         client.Context
 	simulate          baseAppSimulateFn
+	simulateWithTrace baseAppSimulateWithTraceFn
+	traceTx           baseAppTraceTxFn
 	interfaceRegistry codectypes.InterfaceRegistry
 }
 
 // NewTxServer creates a new Tx service server.
-func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
+func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, simulateWithTrace baseAppSimulateWithTraceFn, traceTx baseAppTraceTxFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
 	return txServer{
 		clientCtx:         clientCtx,
 		simulate:          simulate,
+		simulateWithTrace: simulateWithTrace,
+		traceTx:           traceTx,
 		interfaceRegistry: interfaceRegistry,
 	}
 }
@@ -106,6 +114,44 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 	}, nil
 }
 
+// SimulateWithTrace implements the ServiceServer.SimulateWithTrace RPC method.
+func (s txServer) SimulateWithTrace(ctx context.Context, req *txtypes.SimulateWithTraceRequest) (*txtypes.SimulateWithTraceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid empty tx")
+	}
+
+	if req.TxBytes == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty txBytes is not allowed")
+	}
+
+	if s.simulateWithTrace == nil {
+		return nil, status.Error(codes.Unimplemented, "simulation with trace is not supported")
+	}
+
+	if req.Overrides != nil {
+		if err := req.Overrides.ValidateBasic(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid overrides; %v", err)
+		}
+	}
+
+	gasInfo, result, trace, err := s.simulateWithTrace(req.TxBytes, req.Overrides)
+	if trace == nil {
+		// the simulation did not run, the overrides could not be applied
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	res := &txtypes.SimulateWithTraceResponse{
+		GasInfo: &gasInfo,
+		Result:  result,
+		Trace:   trace,
+	}
+	if err != nil {
+		res.Error = err.Error()
+	}
+
+	return res, nil
+}
+
 // GetTx implements the ServiceServer.GetTx RPC method.
 func (s txServer) GetTx(ctx context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
 	if req == nil {
@@ -309,11 +355,13 @@ func RegisterTxService(
 	qrt gogogrpc.Server,
 	clientCtx client.Context,
 	simulateFn baseAppSimulateFn,
+	simulateWithTraceFn baseAppSimulateWithTraceFn,
+	traceTxFn baseAppTraceTxFn,
 	interfaceRegistry codectypes.InterfaceRegistry,
 ) {
 	txtypes.RegisterServiceServer(
 		qrt,
-		NewTxServer(clientCtx, simulateFn, interfaceRegistry),
+		NewTxServer(clientCtx, simulateFn, simulateWithTraceFn, traceTxFn, interfaceRegistry),
 	)
 }
 
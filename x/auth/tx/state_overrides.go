@@ -0,0 +1,63 @@
+package tx
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// AccountOverrideKeeper defines the account keeper overriding the sequences of
+// the accounts in the simulations.
+type AccountOverrideKeeper interface {
+	AddressCodec() address.Codec
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	NewAccountWithAddress(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	SetAccount(ctx context.Context, acc sdk.AccountI)
+}
+
+// BalanceOverrideKeeper defines the bank keeper overriding the balances of the
+// accounts in the simulations.
+type BalanceOverrideKeeper interface {
+	OverrideBalance(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// NewStateOverrideHandler returns the baseapp.StateOverrideHandler overriding
+// the sequences of the accounts with the account keeper, and their balances
+// with the bank keeper.
+func NewStateOverrideHandler(ak AccountOverrideKeeper, bk BalanceOverrideKeeper) baseapp.StateOverrideHandler {
+	return func(ctx sdk.Context, overrides *txtypes.StateOverrides) error {
+		for _, override := range overrides.Sequences {
+			addr, err := ak.AddressCodec().StringToBytes(override.Address)
+			if err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("invalid sequence override address %s: %s", override.Address, err)
+			}
+
+			acc := ak.GetAccount(ctx, addr)
+			if acc == nil {
+				acc = ak.NewAccountWithAddress(ctx, addr)
+			}
+			if err := acc.SetSequence(override.Sequence); err != nil {
+				return err
+			}
+			ak.SetAccount(ctx, acc)
+		}
+
+		for _, override := range overrides.Balances {
+			addr, err := ak.AddressCodec().StringToBytes(override.Address)
+			if err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("invalid balance override address %s: %s", override.Address, err)
+			}
+
+			if err := bk.OverrideBalance(ctx, addr, override.Amount); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,165 @@
+package tx
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// baseAppTraceTxFn is the signature of the Baseapp#TraceTx function.
+type baseAppTraceTxFn func(req *abci.RequestFinalizeBlock, txResults []*abci.ExecTxResult, txIndex int) (sdk.GasInfo, *sdk.Result, *txtypes.SimulationTrace, error)
+
+// validatorsPerPage is the page size of the validators queried to CometBFT,
+// the maximum it allows.
+const validatorsPerPage = 100
+
+// TraceTx implements the ServiceServer.TraceTx RPC method.
+func (s txServer) TraceTx(ctx context.Context, req *txtypes.TraceTxRequest) (*txtypes.TraceTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if len(req.Hash) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "tx hash cannot be empty")
+	}
+
+	if s.traceTx == nil {
+		return nil, status.Error(codes.Unimplemented, "tx trace is not supported")
+	}
+
+	hash, err := hex.DecodeString(req.Hash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash; %v", err)
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	resTx, err := node.Tx(ctx, hash, false)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, status.Errorf(codes.NotFound, "tx not found: %s", req.Hash)
+		}
+
+		return nil, err
+	}
+
+	block, err := finalizeBlockRequest(ctx, node, resTx.Height)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get block %d; %v", resTx.Height, err)
+	}
+
+	// the results of the txs when the block was committed keep the replay from
+	// diverging from the committed execution
+	blockResults, err := node.BlockResults(ctx, &resTx.Height)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get the results of block %d; %v", resTx.Height, err)
+	}
+
+	gasInfo, result, trace, err := s.traceTx(block, blockResults.TxsResults, int(resTx.Index))
+	if trace == nil {
+		// the tx was not executed, the block could not be replayed up to it
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	res := &txtypes.TraceTxResponse{
+		Height:  resTx.Height,
+		Index:   resTx.Index,
+		GasInfo: &gasInfo,
+		Result:  result,
+		Trace:   trace,
+	}
+	if err != nil {
+		res.Error = err.Error()
+	}
+
+	return res, nil
+}
+
+// finalizeBlockRequest rebuilds the FinalizeBlock request CometBFT made for the
+// block of the given height.
+func finalizeBlockRequest(ctx context.Context, node client.CometRPC, height int64) (*abci.RequestFinalizeBlock, error) {
+	resBlock, err := node.Block(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+	block := resBlock.Block
+
+	var misbehavior []abci.Misbehavior
+	for _, ev := range block.Evidence.Evidence {
+		misbehavior = append(misbehavior, ev.ABCI()...)
+	}
+
+	lastCommit, err := decidedLastCommit(ctx, node, block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &abci.RequestFinalizeBlock{
+		Txs:                block.Txs.ToSliceOfBytes(),
+		DecidedLastCommit:  lastCommit,
+		Misbehavior:        misbehavior,
+		Hash:               resBlock.BlockID.Hash,
+		Height:             block.Height,
+		Time:               block.Time,
+		NextValidatorsHash: block.NextValidatorsHash,
+		ProposerAddress:    block.ProposerAddress,
+	}, nil
+}
+
+// decidedLastCommit returns the votes of the validators of the previous height
+// on the previous block, in the order of the validator set, as CometBFT does.
+func decidedLastCommit(ctx context.Context, node client.CometRPC, block *cmttypes.Block) (abci.CommitInfo, error) {
+	// the first block has no last commit
+	if block.LastCommit == nil || len(block.LastCommit.Signatures) == 0 {
+		return abci.CommitInfo{}, nil
+	}
+
+	var (
+		height     = block.Height - 1
+		perPage    = validatorsPerPage
+		validators []*cmttypes.Validator
+	)
+	for page := 1; ; page++ {
+		res, err := node.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return abci.CommitInfo{}, err
+		}
+
+		validators = append(validators, res.Validators...)
+		if len(validators) >= res.Total || len(res.Validators) == 0 {
+			break
+		}
+	}
+
+	signatures := block.LastCommit.Signatures
+	if len(signatures) != len(validators) {
+		return abci.CommitInfo{}, fmt.Errorf("commit of height %d has %d signatures for %d validators", height, len(signatures), len(validators))
+	}
+
+	votes := make([]abci.VoteInfo, len(validators))
+	for i, val := range validators {
+		votes[i] = abci.VoteInfo{
+			Validator:   abci.Validator{Address: val.Address, Power: val.VotingPower},
+			BlockIdFlag: cmtproto.BlockIDFlag(signatures[i].BlockIDFlag),
+		}
+	}
+
+	return abci.CommitInfo{
+		Round: block.LastCommit.Round,
+		Votes: votes,
+	}, nil
+}
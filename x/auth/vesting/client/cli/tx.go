@@ -20,7 +20,10 @@ import (
 
 // Transaction command flags
 const (
-	FlagDelayed = "delayed"
+	FlagDelayed   = "delayed"
+	FlagStartTime = "start-time"
+	FlagMerge     = "merge"
+	FlagDest      = "dest"
 )
 
 // GetTxCmd returns vesting module's transaction commands.
@@ -37,6 +40,8 @@ func GetTxCmd(ac address.Codec) *cobra.Command {
 		NewMsgCreateVestingAccountCmd(ac),
 		NewMsgCreatePermanentLockedAccountCmd(ac),
 		NewMsgCreatePeriodicVestingAccountCmd(ac),
+		NewMsgCreateClawbackVestingAccountCmd(ac),
+		NewMsgClawbackCmd(ac),
 	)
 
 	return txCmd
@@ -213,3 +218,93 @@ func NewMsgCreatePeriodicVestingAccountCmd(ac address.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for creating a
+// MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address] [amount] [end_time]",
+		Short: "Create a new clawback vesting account, or add a grant to an existing one.",
+		Long: `Create a new clawback vesting account funded with an allocation of tokens vesting
+linearly until end_time. The funder can later claw back the unvested tokens. The vesting
+starts at the committed block's time, unless the '--start-time' flag is set. With the
+'--merge' flag, the grant is added to an existing clawback vesting account of the same
+funder. The times must be provided as UNIX epoch timestamps.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			toAddr, err := ac.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			if args[1] == "" {
+				return errors.New("amount is empty")
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			startTime, _ := cmd.Flags().GetInt64(FlagStartTime)
+			merge, _ := cmd.Flags().GetBool(FlagMerge)
+
+			msg := types.NewMsgCreateClawbackVestingAccount(clientCtx.GetFromAddress(), toAddr, amount, startTime, endTime, merge)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartTime, 0, "Start of the vesting as UNIX epoch timestamp, defaults to the block time")
+	cmd.Flags().Bool(FlagMerge, false, "Add the grant to an existing clawback vesting account if true")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgClawbackCmd returns a CLI command handler for creating a MsgClawback
+// transaction.
+func NewMsgClawbackCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [account_address]",
+		Short: "Claw back the unvested tokens of a clawback vesting account.",
+		Long: `Return the unvested tokens of a clawback vesting account to its funder, or to
+the address given with the '--dest' flag. Unvested tokens which are delegated are
+unbonded, and received by the destination at the end of the unbonding period.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			accountAddr, err := ac.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			var destAddr []byte
+			if dest, _ := cmd.Flags().GetString(FlagDest); dest != "" {
+				destAddr, err = ac.StringToBytes(dest)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), accountAddr, destAddr)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDest, "", "Address receiving the unvested tokens, defaults to the funder")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
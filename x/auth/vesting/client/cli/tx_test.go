@@ -276,3 +276,146 @@ func (s *CLITestSuite) TestNewMsgCreatePeriodicVestingAccountCmd() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestNewMsgCreateClawbackVestingAccountCmd() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 2)
+	cmd := cli.NewMsgCreateClawbackVestingAccountCmd(address.NewBech32Codec("cosmos"))
+	cmd.SetOutput(io.Discard)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", sdkmath.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, accounts[0].Address),
+	}
+
+	t := time.Date(2033, time.April, 1, 12, 34, 56, 789, time.UTC).Unix()
+	amount := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10)))
+
+	testCases := []struct {
+		name      string
+		to        string
+		amount    string
+		endTime   string
+		extraArgs []string
+		expectErr bool
+	}{
+		{
+			"valid transaction",
+			accounts[1].Address.String(),
+			amount.String(),
+			fmt.Sprint(t),
+			extraArgs,
+			false,
+		},
+		{
+			"valid merge with start time",
+			accounts[1].Address.String(),
+			amount.String(),
+			fmt.Sprint(t),
+			append([]string{fmt.Sprintf("--%s=%d", cli.FlagStartTime, t-1000), fmt.Sprintf("--%s=true", cli.FlagMerge)}, extraArgs...),
+			false,
+		},
+		{
+			"invalid to address",
+			"invalid",
+			amount.String(),
+			fmt.Sprint(t),
+			extraArgs,
+			true,
+		},
+		{
+			"invalid end time",
+			accounts[1].Address.String(),
+			amount.String(),
+			"never",
+			extraArgs,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(append([]string{tc.to, tc.amount, tc.endTime}, tc.extraArgs...))
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			err := cmd.Execute()
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestNewMsgClawbackCmd() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 3)
+	cmd := cli.NewMsgClawbackCmd(address.NewBech32Codec("cosmos"))
+	cmd.SetOutput(io.Discard)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", sdkmath.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, accounts[0].Address),
+	}
+
+	testCases := []struct {
+		name      string
+		account   string
+		extraArgs []string
+		expectErr bool
+	}{
+		{
+			"valid transaction",
+			accounts[1].Address.String(),
+			extraArgs,
+			false,
+		},
+		{
+			"valid transaction with destination",
+			accounts[1].Address.String(),
+			append([]string{fmt.Sprintf("--%s=%s", cli.FlagDest, accounts[2].Address)}, extraArgs...),
+			false,
+		},
+		{
+			"invalid account address",
+			"invalid",
+			extraArgs,
+			true,
+		},
+		{
+			"invalid destination address",
+			accounts[1].Address.String(),
+			append([]string{fmt.Sprintf("--%s=invalid", cli.FlagDest)}, extraArgs...),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(append([]string{tc.account}, tc.extraArgs...))
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			err := cmd.Execute()
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
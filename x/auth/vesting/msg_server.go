@@ -2,28 +2,32 @@ package vesting
 
 import (
 	"context"
+	"math"
 
 	"github.com/hashicorp/go-metrics"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+	types.StakingKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk}
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -226,6 +230,247 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
 }
 
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	funder, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.FunderAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'funder' address: %s", err)
+	}
+
+	to, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'to' address: %s", err)
+	}
+
+	if err := validateAmount(msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	startTime := msg.StartTime
+	if startTime == 0 {
+		startTime = ctx.BlockTime().Unix()
+	}
+
+	if startTime < 0 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid start time of %d", msg.StartTime)
+	}
+
+	if msg.EndTime < startTime {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid end time of %d, must not be before start time %d", msg.EndTime, startTime)
+	}
+
+	if err := s.BankKeeper.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	if s.BankKeeper.BlockedAddr(to) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	grant := types.NewVestingGrant(startTime, msg.EndTime, msg.Amount.Sort())
+
+	var vestingAccount *types.ClawbackVestingAccount
+	acc := s.AccountKeeper.GetAccount(ctx, to)
+	switch {
+	case msg.Merge:
+		if acc == nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "account %s does not exist", msg.ToAddress)
+		}
+
+		var ok bool
+		vestingAccount, ok = acc.(*types.ClawbackVestingAccount)
+		if !ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.ToAddress)
+		}
+
+		if vestingAccount.FunderAddress != msg.FunderAddress {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account %s was not funded by %s", msg.ToAddress, msg.FunderAddress)
+		}
+
+		vestingAccount.AddGrant(grant)
+
+	case acc != nil:
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+
+	default:
+		baseAccount := authtypes.NewBaseAccountWithAddress(to)
+		baseAccount = s.AccountKeeper.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+		vestingAccount, err = types.NewClawbackVestingAccount(baseAccount, funder, types.VestingGrants{grant})
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		defer telemetry.IncrCounter(1, "new", "account")
+	}
+
+	s.AccountKeeper.SetAccount(ctx, vestingAccount)
+
+	defer func() {
+		for _, a := range msg.Amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_clawback_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	if err = s.BankKeeper.SendCoins(ctx, funder, to, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	funder, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.FunderAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'funder' address: %s", err)
+	}
+
+	addr, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.AccountAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'account' address: %s", err)
+	}
+
+	dest := funder
+	if msg.DestAddress != "" {
+		dest, err = s.AccountKeeper.AddressCodec().StringToBytes(msg.DestAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'dest' address: %s", err)
+		}
+	}
+
+	if s.BankKeeper.BlockedAddr(dest) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", sdk.AccAddress(dest))
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	acc := s.AccountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "account %s does not exist", msg.AccountAddress)
+	}
+
+	vestingAccount, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.AccountAddress)
+	}
+
+	if vestingAccount.FunderAddress != msg.FunderAddress {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account %s was not funded by %s", msg.AccountAddress, msg.FunderAddress)
+	}
+
+	// the unbonding delegations created for the destination would be tracked as
+	// undelegations of its own delegations
+	if _, ok := s.AccountKeeper.GetAccount(ctx, dest).(exported.VestingAccount); ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "clawed back coins cannot be sent to vesting account %s", sdk.AccAddress(dest))
+	}
+
+	unvested := vestingAccount.Clawback(ctx.BlockTime())
+	if unvested.IsZero() {
+		return &types.MsgClawbackResponse{}, nil
+	}
+
+	// the unvested coins are first taken from the account balance, then from
+	// its delegations
+	fromBalance := unvested.Min(s.BankKeeper.GetAllBalances(ctx, addr))
+	clawedBack := fromBalance
+
+	if toUnbond := unvested.Sub(fromBalance...); !toUnbond.IsZero() {
+		unbonded, err := s.clawbackDelegations(ctx, addr, dest, toUnbond)
+		if err != nil {
+			return nil, err
+		}
+
+		if !unbonded.IsZero() {
+			vestingAccount.TrackUndelegation(unbonded)
+			clawedBack = clawedBack.Add(unbonded...)
+		}
+	}
+
+	s.AccountKeeper.SetAccount(ctx, vestingAccount)
+
+	if !fromBalance.IsZero() {
+		if err := s.BankKeeper.SendCoins(ctx, addr, dest, fromBalance); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgClawbackResponse{ClawedBack: clawedBack}, nil
+}
+
+// clawbackDelegations unbonds up to amount from the delegations of delAddr to
+// the benefit of dest, and returns the unbonded coins. It fails if amount can
+// not be unbonded while delAddr is unbonding tokens, which can not be clawed
+// back. Any other shortfall is due to slashing or rounding and is ignored.
+func (s msgServer) clawbackDelegations(ctx context.Context, delAddr, dest sdk.AccAddress, amount sdk.Coins) (sdk.Coins, error) {
+	bondDenom, err := s.StakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the bond denom can be delegated
+	if !amount.IsZero() && (len(amount) > 1 || amount[0].Denom != bondDenom) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "account %s does not hold its unvested coins %s", delAddr, amount)
+	}
+
+	delegations, err := s.StakingKeeper.GetDelegatorDelegations(ctx, delAddr, math.MaxUint16)
+	if err != nil {
+		return nil, err
+	}
+
+	toUnbond := amount.AmountOf(bondDenom)
+	unbonded := sdkmath.ZeroInt()
+	for _, delegation := range delegations {
+		if unbonded.GTE(toUnbond) {
+			break
+		}
+
+		valAddr, err := s.StakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		validator, err := s.StakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		shares := delegation.Shares
+		if validator.TokensFromShares(shares).TruncateInt().GT(toUnbond.Sub(unbonded)) {
+			shares, err = validator.SharesFromTokens(toUnbond.Sub(unbonded))
+			if err != nil {
+				return nil, err
+			}
+			shares = sdkmath.LegacyMinDec(shares, delegation.Shares)
+		}
+
+		_, amt, err := s.StakingKeeper.UndelegateTo(ctx, delAddr, dest, valAddr, shares)
+		if err != nil {
+			return nil, err
+		}
+		unbonded = unbonded.Add(amt)
+	}
+
+	if unbonded.LT(toUnbond) {
+		unbonding, err := s.StakingKeeper.GetDelegatorUnbonding(ctx, delAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		if unbonding.IsPositive() {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is unbonding unvested coins, retry once they are unbonded", delAddr)
+		}
+	}
+
+	if unbonded.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+	return sdk.NewCoins(sdk.NewCoin(bondDenom, unbonded)), nil
+}
+
 func validateAmount(amount sdk.Coins) error {
 	if !amount.IsValid() {
 		return sdkerrors.ErrInvalidCoins.Wrap(amount.String())
@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	vestingtestutil "github.com/cosmos/cosmos-sdk/x/auth/vesting/testutil"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var (
@@ -39,6 +40,7 @@ type VestingTestSuite struct {
 	ctx           sdk.Context
 	accountKeeper authkeeper.AccountKeeper
 	bankKeeper    *vestingtestutil.MockBankKeeper
+	stakingKeeper *vestingtestutil.MockStakingKeeper
 	msgServer     vestingtypes.MsgServer
 }
 
@@ -53,6 +55,7 @@ func (s *VestingTestSuite) SetupTest() {
 
 	ctrl := gomock.NewController(s.T())
 	s.bankKeeper = vestingtestutil.NewMockBankKeeper(ctrl)
+	s.stakingKeeper = vestingtestutil.NewMockStakingKeeper(ctrl)
 	s.accountKeeper = authkeeper.NewAccountKeeper(
 		encCfg.Codec,
 		storeService,
@@ -65,7 +68,7 @@ func (s *VestingTestSuite) SetupTest() {
 
 	vestingtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
 	authtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
-	s.msgServer = vesting.NewMsgServerImpl(s.accountKeeper, s.bankKeeper)
+	s.msgServer = vesting.NewMsgServerImpl(s.accountKeeper, s.bankKeeper, s.stakingKeeper)
 }
 
 func (s *VestingTestSuite) TestCreateVestingAccount() {
@@ -474,6 +477,275 @@ func (s *VestingTestSuite) TestCreatePeriodicVestingAccount() {
 	}
 }
 
+func (s *VestingTestSuite) TestCreateClawbackVestingAccount() {
+	now := s.ctx.BlockTime().Unix()
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		input     *vestingtypes.MsgCreateClawbackVestingAccount
+		expErr    bool
+		expErrMsg string
+		postRun   func()
+	}{
+		{
+			name:      "empty funder address",
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount([]byte{}, to1Addr, sdk.Coins{fooCoin}, now, now+100, false),
+			expErr:    true,
+			expErrMsg: "invalid 'funder' address",
+		},
+		{
+			name:      "empty to address",
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, []byte{}, sdk.Coins{fooCoin}, now, now+100, false),
+			expErr:    true,
+			expErrMsg: "invalid 'to' address",
+		},
+		{
+			name:      "invalid coins",
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}}, now, now+100, false),
+			expErr:    true,
+			expErrMsg: "-1stake: invalid coins",
+		},
+		{
+			name:      "end time before start time",
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, sdk.Coins{fooCoin}, now, now-1, false),
+			expErr:    true,
+			expErrMsg: "invalid end time",
+		},
+		{
+			name: "create for existing account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+				toAcc := s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr)
+				s.accountKeeper.SetAccount(s.ctx, toAcc)
+			},
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, sdk.Coins{fooCoin}, now, now+100, false),
+			expErr:    true,
+			expErrMsg: "already exists",
+		},
+		{
+			name: "merge into a non clawback vesting account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+			},
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, sdk.Coins{fooCoin}, now, now+100, true),
+			expErr:    true,
+			expErrMsg: "not a clawback vesting account",
+		},
+		{
+			name: "merge into a missing account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+			},
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to2Addr, sdk.Coins{fooCoin}, now, now+100, true),
+			expErr:    true,
+			expErrMsg: "does not exist",
+		},
+		{
+			name: "create a valid clawback vesting account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to2Addr, sdk.Coins{fooCoin}).Return(nil)
+			},
+			input: vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to2Addr, sdk.Coins{fooCoin}, 0, now+100, false),
+			postRun: func() {
+				acc, ok := s.accountKeeper.GetAccount(s.ctx, to2Addr).(*vestingtypes.ClawbackVestingAccount)
+				s.Require().True(ok)
+				s.Require().Equal(fromAddr.String(), acc.FunderAddress)
+				s.Require().Equal(now, acc.GetStartTime())
+				s.Require().Equal(now+100, acc.GetEndTime())
+				s.Require().Equal(sdk.Coins{fooCoin}, acc.GetOriginalVesting())
+			},
+		},
+		{
+			name: "merge with another funder",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+			},
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(to3Addr, to2Addr, sdk.Coins{fooCoin}, now, now+100, true),
+			expErr:    true,
+			expErrMsg: "was not funded by",
+		},
+		{
+			name: "merge a grant",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), periodCoin).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to2Addr, sdk.Coins{periodCoin}).Return(nil)
+			},
+			input: vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to2Addr, sdk.Coins{periodCoin}, now+50, now+200, true),
+			postRun: func() {
+				acc, ok := s.accountKeeper.GetAccount(s.ctx, to2Addr).(*vestingtypes.ClawbackVestingAccount)
+				s.Require().True(ok)
+				s.Require().Len(acc.Grants, 2)
+				s.Require().Equal(now, acc.GetStartTime())
+				s.Require().Equal(now+200, acc.GetEndTime())
+				s.Require().Equal(sdk.Coins{fooCoin.Add(periodCoin)}, acc.GetOriginalVesting())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			_, err := s.msgServer.CreateClawbackVestingAccount(s.ctx, tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				if tc.postRun != nil {
+					tc.postRun()
+				}
+			}
+		})
+	}
+}
+
+func (s *VestingTestSuite) TestClawback() {
+	now := s.ctx.BlockTime().Unix()
+	valAddr := sdk.ValAddress([]byte("val1_________________"))
+	valCodec := authcodec.NewBech32Codec("cosmosvaloper")
+	valStr, err := valCodec.BytesToString(valAddr)
+	s.Require().NoError(err)
+	stake := func(amt int64) sdk.Coin { return sdk.NewInt64Coin("stake", amt) }
+
+	// setAccount creates a clawback vesting account of 100stake funded by
+	// fromAddr, vesting linearly over 100 seconds, 40 of which elapsed.
+	setAccount := func(addr sdk.AccAddress) *vestingtypes.ClawbackVestingAccount {
+		baseAcc := s.accountKeeper.NewAccountWithAddress(s.ctx, addr).(*authtypes.BaseAccount)
+		acc, err := vestingtypes.NewClawbackVestingAccount(baseAcc, fromAddr, vestingtypes.VestingGrants{
+			vestingtypes.NewVestingGrant(now-40, now+60, sdk.NewCoins(stake(100))),
+		})
+		s.Require().NoError(err)
+		s.accountKeeper.SetAccount(s.ctx, acc)
+		return acc
+	}
+
+	testCases := []struct {
+		name          string
+		preRun        func() *vestingtypes.MsgClawback
+		expErr        bool
+		expErrMsg     string
+		expClawedBack sdk.Coins
+		postRun       func()
+	}{
+		{
+			name: "invalid account address",
+			preRun: func() *vestingtypes.MsgClawback {
+				return vestingtypes.NewMsgClawback(fromAddr, []byte{}, nil)
+			},
+			expErr:    true,
+			expErrMsg: "invalid 'account' address",
+		},
+		{
+			name: "not a clawback vesting account",
+			preRun: func() *vestingtypes.MsgClawback {
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+				s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr))
+				return vestingtypes.NewMsgClawback(fromAddr, to1Addr, nil)
+			},
+			expErr:    true,
+			expErrMsg: "not a clawback vesting account",
+		},
+		{
+			name: "not the funder",
+			preRun: func() *vestingtypes.MsgClawback {
+				s.bankKeeper.EXPECT().BlockedAddr(to3Addr).Return(false)
+				setAccount(to2Addr)
+				return vestingtypes.NewMsgClawback(to3Addr, to2Addr, nil)
+			},
+			expErr:    true,
+			expErrMsg: "was not funded by",
+		},
+		{
+			name: "claw back from the balance",
+			preRun: func() *vestingtypes.MsgClawback {
+				s.bankKeeper.EXPECT().BlockedAddr(to3Addr).Return(false)
+				s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), to2Addr).Return(sdk.NewCoins(stake(100)))
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), to2Addr, to3Addr, sdk.NewCoins(stake(60))).Return(nil)
+				return vestingtypes.NewMsgClawback(fromAddr, to2Addr, to3Addr)
+			},
+			expClawedBack: sdk.NewCoins(stake(60)),
+			postRun: func() {
+				acc := s.accountKeeper.GetAccount(s.ctx, to2Addr).(*vestingtypes.ClawbackVestingAccount)
+				s.Require().Equal(sdk.NewCoins(stake(40)), acc.GetOriginalVesting())
+				s.Require().Equal(now, acc.GetEndTime())
+				s.Require().True(acc.LockedCoins(s.ctx.BlockTime()).IsZero())
+			},
+		},
+		{
+			name: "claw back from delegations",
+			preRun: func() *vestingtypes.MsgClawback {
+				addr := sdk.AccAddress([]byte("to4__________________"))
+				acc := setAccount(addr)
+				acc.TrackDelegation(s.ctx.BlockTime(), sdk.NewCoins(stake(100)), sdk.NewCoins(stake(90)))
+				s.accountKeeper.SetAccount(s.ctx, acc)
+
+				validator := stakingtypes.Validator{OperatorAddress: valStr, Tokens: math.NewInt(1000), DelegatorShares: math.LegacyNewDec(1000)}
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+				s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), addr).Return(sdk.NewCoins(stake(10)))
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), addr, fromAddr, sdk.NewCoins(stake(10))).Return(nil)
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("stake", nil)
+				s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valCodec)
+				s.stakingKeeper.EXPECT().GetDelegatorDelegations(gomock.Any(), addr, gomock.Any()).Return([]stakingtypes.Delegation{
+					stakingtypes.NewDelegation(addr.String(), valStr, math.LegacyNewDec(90)),
+				}, nil)
+				s.stakingKeeper.EXPECT().GetValidator(gomock.Any(), valAddr).Return(validator, nil)
+				s.stakingKeeper.EXPECT().UndelegateTo(gomock.Any(), addr, fromAddr, valAddr, math.LegacyNewDec(50)).Return(time.Time{}, math.NewInt(50), nil)
+				return vestingtypes.NewMsgClawback(fromAddr, addr, nil)
+			},
+			expClawedBack: sdk.NewCoins(stake(60)),
+			postRun: func() {
+				acc := s.accountKeeper.GetAccount(s.ctx, sdk.AccAddress([]byte("to4__________________"))).(*vestingtypes.ClawbackVestingAccount)
+				s.Require().Equal(sdk.NewCoins(stake(40)), acc.GetDelegatedFree())
+				s.Require().True(acc.GetDelegatedVesting().IsZero())
+			},
+		},
+		{
+			name: "unvested coins unbonding",
+			preRun: func() *vestingtypes.MsgClawback {
+				addr := sdk.AccAddress([]byte("to5__________________"))
+				acc := setAccount(addr)
+				acc.TrackDelegation(s.ctx.BlockTime(), sdk.NewCoins(stake(100)), sdk.NewCoins(stake(100)))
+				s.accountKeeper.SetAccount(s.ctx, acc)
+
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+				s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), addr).Return(sdk.NewCoins())
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("stake", nil)
+				s.stakingKeeper.EXPECT().GetDelegatorDelegations(gomock.Any(), addr, gomock.Any()).Return(nil, nil)
+				s.stakingKeeper.EXPECT().GetDelegatorUnbonding(gomock.Any(), addr).Return(math.NewInt(100), nil)
+				return vestingtypes.NewMsgClawback(fromAddr, addr, nil)
+			},
+			expErr:    true,
+			expErrMsg: "is unbonding unvested coins",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.msgServer.Clawback(s.ctx, tc.preRun())
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expClawedBack, res.ClawedBack)
+				if tc.postRun != nil {
+					tc.postRun()
+				}
+			}
+		})
+	}
+}
+
 func TestVestingTestSuite(t *testing.T) {
 	suite.Run(t, new(VestingTestSuite))
 }
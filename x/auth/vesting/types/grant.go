@@ -0,0 +1,122 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VestingGrants stores all the grants of a ClawbackVestingAccount
+type VestingGrants []VestingGrant
+
+// NewVestingGrant returns a grant of amount vesting linearly from startTime to
+// endTime.
+func NewVestingGrant(startTime, endTime int64, amount sdk.Coins) VestingGrant {
+	return VestingGrant{
+		StartTime: startTime,
+		EndTime:   endTime,
+		Amount:    amount,
+	}
+}
+
+// VestedCoins returns the coins of the grant vested at blockTime.
+func (g VestingGrant) VestedCoins(blockTime time.Time) sdk.Coins {
+	if blockTime.Unix() >= g.EndTime {
+		return g.Amount
+	} else if blockTime.Unix() <= g.StartTime {
+		return nil
+	}
+
+	// calculate the vesting scalar
+	x := blockTime.Unix() - g.StartTime
+	y := g.EndTime - g.StartTime
+	s := math.LegacyNewDec(x).Quo(math.LegacyNewDec(y))
+
+	var vestedCoins sdk.Coins
+	for _, c := range g.Amount {
+		vestedAmt := math.LegacyNewDecFromInt(c.Amount).Mul(s).RoundInt()
+		if vestedAmt.IsPositive() {
+			vestedCoins = append(vestedCoins, sdk.NewCoin(c.Denom, vestedAmt))
+		}
+	}
+
+	return vestedCoins
+}
+
+// Validate checks the grant times and amount.
+func (g VestingGrant) Validate() error {
+	if g.StartTime < 0 {
+		return fmt.Errorf("start time cannot be negative: %d", g.StartTime)
+	}
+	if g.EndTime < g.StartTime {
+		return fmt.Errorf("end time %d cannot be before start time %d", g.EndTime, g.StartTime)
+	}
+	if !g.Amount.IsValid() || !g.Amount.IsAllPositive() {
+		return fmt.Errorf("invalid coins: %s", g.Amount.String())
+	}
+
+	return nil
+}
+
+// VestedCoins returns the sum of the coins of the grants vested at blockTime.
+func (gs VestingGrants) VestedCoins(blockTime time.Time) sdk.Coins {
+	vestedCoins := sdk.NewCoins()
+	for _, g := range gs {
+		vestedCoins = vestedCoins.Add(g.VestedCoins(blockTime)...)
+	}
+	return vestedCoins
+}
+
+// TotalAmount returns the sum of the coins of the grants.
+func (gs VestingGrants) TotalAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, g := range gs {
+		total = total.Add(g.Amount...)
+	}
+	return total
+}
+
+// StartTime returns the earliest start time of the grants, or zero if there
+// are none.
+func (gs VestingGrants) StartTime() int64 {
+	var startTime int64
+	for i, g := range gs {
+		if i == 0 || g.StartTime < startTime {
+			startTime = g.StartTime
+		}
+	}
+	return startTime
+}
+
+// EndTime returns the latest end time of the grants, or zero if there are none.
+func (gs VestingGrants) EndTime() int64 {
+	var endTime int64
+	for _, g := range gs {
+		if g.EndTime > endTime {
+			endTime = g.EndTime
+		}
+	}
+	return endTime
+}
+
+// Vested returns the grants reduced to their coins vested at blockTime, ending
+// at blockTime at the latest. Grants with no vested coins are dropped.
+func (gs VestingGrants) Vested(blockTime time.Time) VestingGrants {
+	var vested VestingGrants
+	for _, g := range gs {
+		amount := g.VestedCoins(blockTime)
+		if amount.IsZero() {
+			continue
+		}
+
+		endTime := g.EndTime
+		if blockTime.Unix() < endTime {
+			endTime = blockTime.Unix()
+		}
+		vested = append(vested, NewVestingGrant(g.StartTime, endTime, amount))
+	}
+	return vested
+}
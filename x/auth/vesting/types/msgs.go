@@ -8,6 +8,8 @@ var (
 	_ sdk.Msg = &MsgCreateVestingAccount{}
 	_ sdk.Msg = &MsgCreatePermanentLockedAccount{}
 	_ sdk.Msg = &MsgCreatePeriodicVestingAccount{}
+	_ sdk.Msg = &MsgCreateClawbackVestingAccount{}
+	_ sdk.Msg = &MsgClawback{}
 )
 
 // NewMsgCreateVestingAccount returns a reference to a new MsgCreateVestingAccount.
@@ -39,3 +41,30 @@ func NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr sdk.AccAddress, startTi
 		VestingPeriods: periods,
 	}
 }
+
+// NewMsgCreateClawbackVestingAccount returns a reference to a new MsgCreateClawbackVestingAccount.
+func NewMsgCreateClawbackVestingAccount(funderAddr, toAddr sdk.AccAddress, amount sdk.Coins, startTime, endTime int64, merge bool) *MsgCreateClawbackVestingAccount {
+	return &MsgCreateClawbackVestingAccount{
+		FunderAddress: funderAddr.String(),
+		ToAddress:     toAddr.String(),
+		Amount:        amount,
+		StartTime:     startTime,
+		EndTime:       endTime,
+		Merge:         merge,
+	}
+}
+
+// NewMsgClawback returns a reference to a new MsgClawback. An empty destAddr
+// returns the clawed back coins to the funder.
+func NewMsgClawback(funderAddr, accountAddr, destAddr sdk.AccAddress) *MsgClawback {
+	var dest string
+	if len(destAddr) != 0 {
+		dest = destAddr.String()
+	}
+
+	return &MsgClawback{
+		FunderAddress:  funderAddr.String(),
+		AccountAddress: accountAddr.String(),
+		DestAddress:    dest,
+	}
+}
@@ -289,6 +289,114 @@ func (m *PermanentLockedAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PermanentLockedAccount proto.InternalMessageInfo
 
+// VestingGrant defines an amount of coins vesting linearly from start_time to
+// end_time. A grant with equal start and end times vests all its coins at once.
+type VestingGrant struct {
+	// Vesting start time, as unix timestamp (in seconds).
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Vesting end time, as unix timestamp (in seconds).
+	EndTime int64                                    `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *VestingGrant) Reset()         { *m = VestingGrant{} }
+func (m *VestingGrant) String() string { return proto.CompactTextString(m) }
+func (*VestingGrant) ProtoMessage()    {}
+func (*VestingGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e80273ca606d6e, []int{6}
+}
+func (m *VestingGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingGrant.Merge(m, src)
+}
+func (m *VestingGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingGrant proto.InternalMessageInfo
+
+func (m *VestingGrant) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *VestingGrant) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *VestingGrant) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// ClawbackVestingAccount implements the VestingAccount interface. It vests the
+// sum of its grants, each vesting linearly, and its funder can add grants to it
+// and claw back its unvested coins.
+type ClawbackVestingAccount struct {
+	*BaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account,omitempty"`
+	// funder_address is the address which funded the account and can claw back
+	// its unvested coins.
+	FunderAddress string         `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	Grants        []VestingGrant `protobuf:"bytes,3,rep,name=grants,proto3" json:"grants"`
+}
+
+func (m *ClawbackVestingAccount) Reset()         { *m = ClawbackVestingAccount{} }
+func (m *ClawbackVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*ClawbackVestingAccount) ProtoMessage()    {}
+func (*ClawbackVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e80273ca606d6e, []int{7}
+}
+func (m *ClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClawbackVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClawbackVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClawbackVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClawbackVestingAccount.Merge(m, src)
+}
+func (m *ClawbackVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClawbackVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClawbackVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClawbackVestingAccount proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*BaseVestingAccount)(nil), "cosmos.vesting.v1beta1.BaseVestingAccount")
 	proto.RegisterType((*ContinuousVestingAccount)(nil), "cosmos.vesting.v1beta1.ContinuousVestingAccount")
@@ -296,6 +404,8 @@ func init() {
 	proto.RegisterType((*Period)(nil), "cosmos.vesting.v1beta1.Period")
 	proto.RegisterType((*PeriodicVestingAccount)(nil), "cosmos.vesting.v1beta1.PeriodicVestingAccount")
 	proto.RegisterType((*PermanentLockedAccount)(nil), "cosmos.vesting.v1beta1.PermanentLockedAccount")
+	proto.RegisterType((*VestingGrant)(nil), "cosmos.vesting.v1beta1.VestingGrant")
+	proto.RegisterType((*ClawbackVestingAccount)(nil), "cosmos.vesting.v1beta1.ClawbackVestingAccount")
 }
 
 func init() {
@@ -303,45 +413,51 @@ func init() {
 }
 
 var fileDescriptor_89e80273ca606d6e = []byte{
-	// 605 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4d, 0x6b, 0x13, 0x41,
-	0x18, 0xce, 0x34, 0x35, 0xda, 0x69, 0xed, 0xc7, 0x52, 0x43, 0x5a, 0xe8, 0x26, 0xae, 0x22, 0x31,
-	0xe8, 0x2e, 0xa9, 0xb7, 0xdc, 0x4c, 0xa5, 0x20, 0x7a, 0x90, 0x20, 0x1e, 0xbc, 0x84, 0xd9, 0xdd,
-	0x71, 0x33, 0x34, 0x3b, 0x13, 0x76, 0x26, 0xc5, 0xfc, 0x83, 0x22, 0x22, 0x9e, 0xed, 0xc5, 0x93,
-	0x14, 0x4f, 0xf9, 0x19, 0x05, 0x11, 0x72, 0xf4, 0x54, 0x25, 0x39, 0xe4, 0x6f, 0xc8, 0xce, 0xcc,
-	0x6e, 0x43, 0x3e, 0x3c, 0x06, 0x2f, 0xc9, 0xbe, 0x1f, 0xf3, 0x3e, 0xcf, 0xf3, 0xe6, 0xd9, 0x0c,
-	0xbc, 0xef, 0x31, 0x1e, 0x32, 0xee, 0x9c, 0x62, 0x2e, 0x08, 0x0d, 0x9c, 0xd3, 0xaa, 0x8b, 0x05,
-	0xaa, 0x26, 0xb1, 0xdd, 0x89, 0x98, 0x60, 0x46, 0x5e, 0x75, 0xd9, 0x49, 0x56, 0x77, 0xed, 0xef,
-	0xa0, 0x90, 0x50, 0xe6, 0xc8, 0x4f, 0xd5, 0xba, 0xbf, 0x1b, 0xb0, 0x80, 0xc9, 0x47, 0x27, 0x7e,
-	0xd2, 0x59, 0x53, 0xc3, 0xb8, 0x88, 0xe3, 0x14, 0xc3, 0x63, 0x84, 0x4e, 0xd5, 0x51, 0x57, 0xb4,
-	0xd2, 0x7a, 0x1c, 0xa8, 0xba, 0xf5, 0x73, 0x15, 0x1a, 0x75, 0xc4, 0xf1, 0x1b, 0x45, 0xe0, 0xa9,
-	0xe7, 0xb1, 0x2e, 0x15, 0xc6, 0x73, 0xb8, 0x11, 0x4f, 0x6c, 0x22, 0x15, 0x17, 0x40, 0x09, 0x94,
-	0xd7, 0x0f, 0x4b, 0xb6, 0xa6, 0x2b, 0x07, 0xe8, 0x69, 0x76, 0x7c, 0x5c, 0x9f, 0xab, 0xaf, 0x0e,
-	0xae, 0x8a, 0xa0, 0xb1, 0xee, 0x5e, 0xa7, 0x8c, 0x8f, 0x00, 0x6e, 0xb3, 0x88, 0x04, 0x84, 0xa2,
-	0x76, 0x53, 0xeb, 0x2c, 0xac, 0x94, 0xb2, 0xe5, 0xf5, 0xc3, 0xbd, 0x64, 0x5e, 0xdc, 0x9f, 0xce,
-	0x3b, 0x62, 0x84, 0xd6, 0x8f, 0x2f, 0xaf, 0x8a, 0x99, 0xef, 0xbf, 0x8b, 0xe5, 0x80, 0x88, 0x56,
-	0xd7, 0xb5, 0x3d, 0x16, 0x3a, 0x5a, 0x8a, 0xfa, 0x7a, 0xcc, 0xfd, 0x13, 0x47, 0xf4, 0x3a, 0x98,
-	0xcb, 0x03, 0xfc, 0xcb, 0xb8, 0x5f, 0xd9, 0x68, 0xe3, 0x00, 0x79, 0xbd, 0x66, 0xac, 0x9f, 0x5f,
-	0x8c, 0xfb, 0x15, 0xd0, 0xd8, 0x4a, 0xa0, 0xb5, 0x40, 0xe3, 0x0c, 0xc0, 0x4d, 0x1f, 0xc7, 0x8d,
-	0x02, 0xfb, 0xcd, 0x77, 0x11, 0xc6, 0x85, 0xec, 0xb2, 0xc8, 0xdc, 0x4e, 0x81, 0x8f, 0x23, 0x8c,
-	0x8d, 0x4f, 0x00, 0xee, 0x5c, 0x53, 0x49, 0x56, 0xb3, 0xba, 0x2c, 0x36, 0xdb, 0x29, 0x76, 0xb2,
-	0x9b, 0x3d, 0x78, 0x0b, 0x53, 0xbf, 0x29, 0x48, 0x88, 0x0b, 0x37, 0x4a, 0xa0, 0x9c, 0x6d, 0xdc,
-	0xc4, 0xd4, 0x7f, 0x4d, 0x42, 0x5c, 0x7b, 0x70, 0xf6, 0xb5, 0x98, 0xf9, 0x30, 0xee, 0x57, 0x0e,
-	0x26, 0x30, 0x66, 0x8d, 0x63, 0xfd, 0x00, 0xb0, 0x70, 0xc4, 0xa8, 0x20, 0xb4, 0xcb, 0xba, 0x7c,
-	0xca, 0x55, 0x2e, 0xdc, 0x95, 0xae, 0xd2, 0x52, 0xa7, 0xdc, 0x55, 0xb1, 0xe7, 0xbf, 0x0c, 0xf6,
-	0x2c, 0x8c, 0xf6, 0x99, 0xe1, 0xce, 0x3a, 0xf7, 0x00, 0x42, 0x2e, 0x50, 0x24, 0x94, 0x8a, 0x15,
-	0xa9, 0x62, 0x4d, 0x66, 0xa4, 0x8e, 0x47, 0x89, 0x8e, 0x7b, 0x13, 0x3a, 0x16, 0x11, 0xb6, 0xbe,
-	0x01, 0x78, 0xe7, 0x19, 0x6e, 0xa3, 0x5e, 0xba, 0xa3, 0x25, 0x4a, 0xa9, 0x3d, 0x4c, 0xb8, 0x96,
-	0x26, 0xb8, 0xce, 0xa5, 0x63, 0x9d, 0x03, 0x98, 0x7b, 0x85, 0x23, 0xc2, 0x7c, 0x23, 0x0f, 0x73,
-	0x6d, 0x4c, 0x03, 0xd1, 0x92, 0x5c, 0xb2, 0x0d, 0x1d, 0x19, 0x3d, 0x98, 0x43, 0xa1, 0xe4, 0xb8,
-	0xb4, 0x97, 0x4f, 0x03, 0x5a, 0xe7, 0x2b, 0x30, 0xaf, 0xd8, 0x11, 0xef, 0xbf, 0xb3, 0x84, 0xd1,
-	0x80, 0x5b, 0x09, 0x7a, 0x47, 0x92, 0xe4, 0xfa, 0x1f, 0xc1, 0x5c, 0x84, 0xae, 0xb4, 0xd4, 0xd7,
-	0xe2, 0x35, 0x29, 0xa5, 0x9b, 0xba, 0x45, 0x55, 0x78, 0xad, 0x92, 0xfc, 0x74, 0x77, 0x27, 0x16,
-	0x36, 0x7f, 0x05, 0xd6, 0x05, 0x90, 0xdb, 0x09, 0x11, 0xc5, 0x54, 0xbc, 0x64, 0xde, 0x09, 0xf6,
-	0x97, 0xe9, 0xb2, 0x45, 0x54, 0xe7, 0xf0, 0xa9, 0xbf, 0xb8, 0x1c, 0x9a, 0x60, 0x30, 0x34, 0xc1,
-	0x9f, 0xa1, 0x09, 0x3e, 0x8f, 0xcc, 0xcc, 0x60, 0x64, 0x66, 0x7e, 0x8d, 0xcc, 0xcc, 0xdb, 0xea,
-	0x3f, 0xad, 0xf2, 0x5e, 0xdf, 0x3f, 0xfa, 0x2e, 0x94, 0xce, 0x71, 0x73, 0xf2, 0x06, 0x7a, 0xf2,
-	0x37, 0x00, 0x00, 0xff, 0xff, 0x47, 0xcb, 0xcd, 0xc9, 0x2a, 0x07, 0x00, 0x00,
+	// 692 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4d, 0x6f, 0xd3, 0x3e,
+	0x18, 0xaf, 0xdb, 0xfd, 0xfb, 0x67, 0xde, 0x7b, 0x34, 0xaa, 0x6e, 0xd2, 0xd2, 0x12, 0x06, 0x2a,
+	0x15, 0xa4, 0xda, 0xb8, 0xed, 0xb6, 0x0e, 0x6d, 0x42, 0x70, 0x40, 0x15, 0xe2, 0xc0, 0xa5, 0x72,
+	0x92, 0x67, 0x59, 0xb4, 0xc6, 0x9e, 0x62, 0x77, 0xd0, 0x6f, 0x30, 0x21, 0x84, 0x38, 0x22, 0x76,
+	0xe1, 0x84, 0x26, 0x4e, 0xfb, 0x0e, 0x5c, 0x26, 0x21, 0xa4, 0x1d, 0x39, 0x0d, 0xb4, 0x1d, 0xf6,
+	0x35, 0x50, 0x6c, 0xa7, 0xcb, 0xfa, 0xc2, 0xb1, 0x70, 0x69, 0xe3, 0xc7, 0x8f, 0xfd, 0x7b, 0xf1,
+	0x2f, 0x91, 0xf1, 0xb2, 0xcb, 0x78, 0xc8, 0x78, 0x6d, 0x1f, 0xb8, 0x08, 0xa8, 0x5f, 0xdb, 0x5f,
+	0x71, 0x40, 0x90, 0x95, 0x64, 0x6c, 0xef, 0x45, 0x4c, 0x30, 0xa3, 0xa0, 0xba, 0xec, 0xa4, 0xaa,
+	0xbb, 0x16, 0xe7, 0x48, 0x18, 0x50, 0x56, 0x93, 0xbf, 0xaa, 0x75, 0x71, 0xde, 0x67, 0x3e, 0x93,
+	0x8f, 0xb5, 0xf8, 0x49, 0x57, 0x4d, 0x0d, 0xe3, 0x10, 0x0e, 0x5d, 0x0c, 0x97, 0x05, 0xb4, 0x67,
+	0x9e, 0xb4, 0xc5, 0x4e, 0x77, 0x3e, 0x1e, 0xa8, 0x79, 0xeb, 0xfb, 0x18, 0x36, 0xea, 0x84, 0xc3,
+	0x0b, 0x45, 0x60, 0xdd, 0x75, 0x59, 0x9b, 0x0a, 0xe3, 0x31, 0x9e, 0x8c, 0x77, 0x6c, 0x12, 0x35,
+	0x2e, 0xa2, 0x32, 0xaa, 0x4c, 0xac, 0x96, 0x6d, 0x4d, 0x57, 0x6e, 0xa0, 0x77, 0xb3, 0xe3, 0xe5,
+	0x7a, 0x5d, 0x7d, 0xec, 0xf4, 0xac, 0x84, 0x1a, 0x13, 0xce, 0x55, 0xc9, 0x78, 0x8b, 0xf0, 0x2c,
+	0x8b, 0x02, 0x3f, 0xa0, 0xa4, 0xd5, 0xd4, 0x3a, 0x8b, 0xd9, 0x72, 0xae, 0x32, 0xb1, 0xba, 0x90,
+	0xec, 0x17, 0xf7, 0x77, 0xf7, 0xdb, 0x60, 0x01, 0xad, 0x6f, 0x9e, 0x9c, 0x95, 0x32, 0x5f, 0x7e,
+	0x96, 0x2a, 0x7e, 0x20, 0x76, 0xda, 0x8e, 0xed, 0xb2, 0xb0, 0xa6, 0xa5, 0xa8, 0xbf, 0x07, 0xdc,
+	0xdb, 0xad, 0x89, 0xce, 0x1e, 0x70, 0xb9, 0x80, 0x7f, 0xbc, 0x3c, 0xae, 0x4e, 0xb6, 0xc0, 0x27,
+	0x6e, 0xa7, 0x19, 0xeb, 0xe7, 0x47, 0x97, 0xc7, 0x55, 0xd4, 0x98, 0x49, 0xa0, 0xb5, 0x40, 0xe3,
+	0x00, 0xe1, 0x69, 0x0f, 0xe2, 0x46, 0x01, 0x5e, 0x73, 0x3b, 0x02, 0x28, 0xe6, 0x46, 0x45, 0x66,
+	0xaa, 0x0b, 0xbc, 0x19, 0x01, 0x18, 0xef, 0x10, 0x9e, 0xbb, 0xa2, 0x92, 0x58, 0x33, 0x36, 0x2a,
+	0x36, 0xb3, 0x5d, 0xec, 0xc4, 0x9b, 0x05, 0x7c, 0x03, 0xa8, 0xd7, 0x14, 0x41, 0x08, 0xc5, 0xff,
+	0xca, 0xa8, 0x92, 0x6b, 0xfc, 0x0f, 0xd4, 0x7b, 0x1e, 0x84, 0xb0, 0x76, 0xf7, 0xe0, 0x53, 0x29,
+	0xf3, 0xe6, 0xf2, 0xb8, 0xba, 0x94, 0xc2, 0xe8, 0x0f, 0x8e, 0xf5, 0x0d, 0xe1, 0xe2, 0x06, 0xa3,
+	0x22, 0xa0, 0x6d, 0xd6, 0xe6, 0x3d, 0xa9, 0x72, 0xf0, 0xbc, 0x4c, 0x95, 0x96, 0xda, 0x93, 0xae,
+	0xaa, 0x3d, 0xf8, 0x65, 0xb0, 0xfb, 0x61, 0x74, 0xce, 0x0c, 0xa7, 0x3f, 0xb9, 0x4b, 0x18, 0x73,
+	0x41, 0x22, 0xa1, 0x54, 0x64, 0xa5, 0x8a, 0x71, 0x59, 0x91, 0x3a, 0xee, 0x27, 0x3a, 0x6e, 0xa7,
+	0x74, 0x0c, 0x23, 0x6c, 0x7d, 0x46, 0xf8, 0xe6, 0x23, 0x68, 0x91, 0x0e, 0x78, 0xd7, 0x67, 0x46,
+	0x21, 0x65, 0xed, 0x5e, 0xc2, 0xb5, 0x9c, 0xe2, 0x3a, 0x90, 0x8e, 0x75, 0x88, 0x70, 0xfe, 0x19,
+	0x44, 0x01, 0xf3, 0x8c, 0x02, 0xce, 0xb7, 0x80, 0xfa, 0x62, 0x47, 0x72, 0xc9, 0x35, 0xf4, 0xc8,
+	0xe8, 0xe0, 0x3c, 0x09, 0x25, 0xc7, 0x91, 0xbd, 0x7c, 0x1a, 0xd0, 0x3a, 0xcc, 0xe2, 0x82, 0x62,
+	0x17, 0xb8, 0xff, 0x5c, 0x24, 0x8c, 0x06, 0x9e, 0x49, 0xd0, 0xf7, 0x24, 0x49, 0xae, 0xbf, 0x08,
+	0xe6, 0x30, 0x74, 0xa5, 0xa5, 0x3e, 0x1e, 0xdb, 0xa4, 0x94, 0x4e, 0xeb, 0x16, 0x35, 0xc3, 0xd7,
+	0xaa, 0xc9, 0xd1, 0xdd, 0x4a, 0x19, 0x36, 0xd8, 0x02, 0xeb, 0x08, 0x49, 0x77, 0x42, 0x42, 0x81,
+	0x8a, 0xa7, 0xcc, 0xdd, 0x05, 0x6f, 0x94, 0x29, 0x1b, 0x46, 0x75, 0x00, 0x1f, 0xeb, 0x2b, 0xc2,
+	0x93, 0x7a, 0xf9, 0x56, 0x44, 0xfa, 0xac, 0x45, 0xbd, 0xd6, 0xa6, 0x3f, 0x28, 0xd9, 0x6b, 0x1f,
+	0x94, 0x54, 0x1c, 0x73, 0xa3, 0x8e, 0xe3, 0x87, 0x2c, 0x2e, 0x6c, 0xb4, 0xc8, 0x2b, 0x87, 0xb8,
+	0xbb, 0x7f, 0x21, 0x8e, 0x77, 0xf0, 0xf4, 0x76, 0x9b, 0x7a, 0x10, 0x35, 0x89, 0xe7, 0x45, 0xc0,
+	0xb9, 0xb4, 0x66, 0xbc, 0x31, 0xa5, 0xaa, 0xeb, 0xaa, 0x68, 0x6c, 0xe1, 0xbc, 0x1f, 0x7b, 0x9c,
+	0xa4, 0x71, 0x79, 0x18, 0x78, 0xfa, 0x40, 0xd2, 0x99, 0xd4, 0xcb, 0x07, 0x1f, 0xf0, 0x60, 0xfd,
+	0xf5, 0x27, 0x27, 0xe7, 0x26, 0x3a, 0x3d, 0x37, 0xd1, 0xaf, 0x73, 0x13, 0xbd, 0xbf, 0x30, 0x33,
+	0xa7, 0x17, 0x66, 0xe6, 0xc7, 0x85, 0x99, 0x79, 0xb9, 0xf2, 0x47, 0xf3, 0x5f, 0xeb, 0x0b, 0x86,
+	0xbe, 0xec, 0xc8, 0xb3, 0x70, 0xf2, 0xf2, 0x8a, 0xf1, 0xf0, 0xf7, 0x00, 0x63, 0x2c, 0x89, 0xb3,
+	0x0b, 0x09, 0x00, 0x00,
 }
 
 func (m *BaseVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -632,6 +748,109 @@ func (m *PermanentLockedAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *VestingGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseVestingAccount != nil {
+		{
+			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVesting(v)
 	base := offset
@@ -759,6 +978,50 @@ func (m *PermanentLockedAccount) Size() (n int) {
 	return n
 }
 
+func (m *VestingGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovVesting(uint64(m.EndTime))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovVesting(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1491,6 +1754,280 @@ func (m *PermanentLockedAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VestingGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, VestingGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVesting(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
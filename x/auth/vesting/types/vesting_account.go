@@ -17,6 +17,7 @@ var (
 	_ sdk.AccountI                = (*BaseVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
 )
 
@@ -518,3 +519,131 @@ func (plva PermanentLockedAccount) Validate() error {
 
 	return plva.BaseVestingAccount.Validate()
 }
+
+// Clawback Vesting Account
+
+var (
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount funded by
+// funder with the given grants.
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, grants VestingGrants) (*ClawbackVestingAccount, error) {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:      baseAcc,
+		OriginalVesting:  grants.TotalAmount(),
+		DelegatedFree:    sdk.NewCoins(),
+		DelegatedVesting: sdk.NewCoins(),
+		EndTime:          grants.EndTime(),
+	}
+
+	clawbackVestingAccount := &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+		Grants:             grants,
+	}
+
+	return clawbackVestingAccount, clawbackVestingAccount.Validate()
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned.
+func (cva ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	vestedCoins := VestingGrants(cva.Grants).VestedCoins(blockTime)
+	if vestedCoins.IsZero() {
+		return nil
+	}
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (cva ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (cva ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return cva.BaseVestingAccount.LockedCoinsFromVesting(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (cva *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	cva.BaseVestingAccount.TrackDelegation(balance, cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the earliest start time of the grants of a clawback
+// vesting account.
+func (cva ClawbackVestingAccount) GetStartTime() int64 {
+	return VestingGrants(cva.Grants).StartTime()
+}
+
+// GetFunderAddress returns the address which funded the account.
+func (cva ClawbackVestingAccount) GetFunderAddress() string {
+	return cva.FunderAddress
+}
+
+// GetGrants returns the grants of a clawback vesting account.
+func (cva ClawbackVestingAccount) GetGrants() VestingGrants {
+	return cva.Grants
+}
+
+// AddGrant merges a new grant into the vesting schedule of the account.
+func (cva *ClawbackVestingAccount) AddGrant(grant VestingGrant) {
+	cva.Grants = append(cva.Grants, grant)
+	cva.OriginalVesting = cva.OriginalVesting.Add(grant.Amount...)
+	cva.EndTime = VestingGrants(cva.Grants).EndTime()
+}
+
+// Clawback reduces the vesting schedule of the account to the coins vested at
+// blockTime and returns the unvested coins, which are no longer locked. The
+// delegated vesting coins are tracked as delegated free coins from then on.
+//
+// It is the callers responsibility to transfer the returned coins out of the
+// account.
+func (cva *ClawbackVestingAccount) Clawback(blockTime time.Time) sdk.Coins {
+	unvested := cva.GetVestingCoins(blockTime)
+
+	cva.Grants = VestingGrants(cva.Grants).Vested(blockTime)
+	cva.OriginalVesting = VestingGrants(cva.Grants).TotalAmount()
+	cva.EndTime = VestingGrants(cva.Grants).EndTime()
+	cva.DelegatedFree = cva.DelegatedFree.Add(cva.DelegatedVesting...)
+	cva.DelegatedVesting = sdk.NewCoins()
+
+	return unvested
+}
+
+// Validate checks for errors on the account fields
+func (cva ClawbackVestingAccount) Validate() error {
+	if cva.FunderAddress == "" {
+		return errors.New("funder address cannot be empty")
+	}
+
+	for i, g := range cva.Grants {
+		if err := g.Validate(); err != nil {
+			return fmt.Errorf("grant #%d: %w", i, err)
+		}
+	}
+
+	grants := VestingGrants(cva.Grants)
+	if !grants.TotalAmount().Equal(cva.OriginalVesting) {
+		return fmt.Errorf("original vesting coins (%v) does not match the sum of all coins in grants (%v)", cva.OriginalVesting, grants.TotalAmount())
+	}
+	if grants.EndTime() != cva.EndTime {
+		return errors.New("vesting end time does not match the end time of the grants")
+	}
+
+	// every unvested coin may have been clawed back
+	if len(cva.Grants) == 0 {
+		if !cva.DelegatedVesting.IsZero() {
+			return errors.New("delegated vesting amount cannot be greater than original vesting amount")
+		}
+		return cva.BaseAccount.Validate()
+	}
+
+	return cva.BaseVestingAccount.Validate()
+}
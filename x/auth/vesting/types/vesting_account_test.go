@@ -796,6 +796,70 @@ func TestTrackUndelegationPermLockedVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, plva.DelegatedVesting)
 }
 
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	bacc, origCoins := initBaseAccount()
+	funder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	cva, err := types.NewClawbackVestingAccount(bacc, funder, types.VestingGrants{
+		types.NewVestingGrant(now.Unix(), now.Add(24*time.Hour).Unix(), origCoins),
+	})
+	require.NoError(t, err)
+
+	// require no coins vested in the very beginning of the vesting schedule
+	require.Nil(t, cva.GetVestedCoins(now))
+
+	// require 50% of coins vested half way through the schedule
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, cva.GetVestedCoins(now.Add(12*time.Hour)))
+
+	// merge a grant vesting all at once after 6 hours
+	cva.AddGrant(types.NewVestingGrant(now.Unix(), now.Add(6*time.Hour).Unix(), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}))
+	require.Equal(t, now.Add(24*time.Hour).Unix(), cva.GetEndTime())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 200)}, cva.GetOriginalVesting())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 150)}, cva.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, cva.GetOriginalVesting(), cva.GetVestedCoins(now.Add(48*time.Hour)))
+	require.NoError(t, cva.Validate())
+}
+
+func TestClawbackClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	bacc, origCoins := initBaseAccount()
+	funder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	cva, err := types.NewClawbackVestingAccount(bacc, funder, types.VestingGrants{
+		types.NewVestingGrant(now.Unix(), endTime.Unix(), origCoins),
+		types.NewVestingGrant(now.Add(12*time.Hour).Unix(), endTime.Unix(), origCoins),
+	})
+	require.NoError(t, err)
+
+	// delegate 150stake, 100 of which are vesting
+	cva.TrackDelegation(now.Add(6*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 200)}, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 150)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 150)}, cva.GetDelegatedVesting())
+
+	// claw back half way through the first grant, before the second one starts
+	unvested := cva.Clawback(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1500), sdk.NewInt64Coin(stakeDenom, 150)}, unvested)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, cva.GetOriginalVesting())
+	require.Len(t, cva.Grants, 1)
+	require.Equal(t, now.Add(12*time.Hour).Unix(), cva.GetEndTime())
+	require.True(t, cva.LockedCoins(now.Add(12*time.Hour)).IsZero())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 150)}, cva.GetDelegatedFree())
+	require.True(t, cva.GetDelegatedVesting().IsZero())
+	require.NoError(t, cva.Validate())
+
+	// claw back before anything vested
+	bacc, origCoins = initBaseAccount()
+	cva, err = types.NewClawbackVestingAccount(bacc, funder, types.VestingGrants{
+		types.NewVestingGrant(now.Unix(), endTime.Unix(), origCoins),
+	})
+	require.NoError(t, err)
+	require.Equal(t, origCoins, cva.Clawback(now))
+	require.Empty(t, cva.Grants)
+	require.True(t, cva.GetOriginalVesting().IsZero())
+	require.NoError(t, cva.Validate())
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -412,6 +412,32 @@ func (k BaseKeeper) BurnCoins(ctx context.Context, moduleName string, amounts sd
 	return nil
 }
 
+// OverrideBalance sets the balance of an account for the denoms of amt,
+// adjusting their supply, and creates the account if it does not exist. It is
+// meant for the state overrides of simulations, and must only be called on a
+// branch of the state which is discarded.
+func (k BaseKeeper) OverrideBalance(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	if !amt.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	if !k.ak.HasAccount(ctx, addr) {
+		k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, addr))
+	}
+
+	for _, coin := range amt {
+		balance := k.GetBalance(ctx, addr, coin.Denom)
+		if err := k.setBalance(ctx, addr, coin); err != nil {
+			return err
+		}
+
+		supply := k.GetSupply(ctx, coin.Denom)
+		k.setSupply(ctx, supply.Sub(balance).Add(coin))
+	}
+
+	return nil
+}
+
 // setSupply sets the supply for the given coin
 func (k BaseKeeper) setSupply(ctx context.Context, coin sdk.Coin) {
 	// Bank invariants and IBC requires to remove zero coins.
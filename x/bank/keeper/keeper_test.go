@@ -605,6 +605,37 @@ func (suite *KeeperTestSuite) TestSupply_BurnCoins() {
 	require.Equal(supplyAfterInflation.Sub(initCoins...), supplyAfterBurn)
 }
 
+func (suite *KeeperTestSuite) TestOverrideBalance() {
+	ctx := suite.ctx
+	require := suite.Require()
+	authKeeper, keeper := suite.authKeeper, suite.bankKeeper
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, keeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+	initialSupply, _, err := keeper.GetPaginatedTotalSupply(ctx, &query.PageRequest{})
+	require.NoError(err)
+
+	// the balance of the denoms overridden is set, the other ones are kept
+	authKeeper.EXPECT().HasAccount(ctx, accAddrs[0]).Return(true)
+	require.NoError(keeper.OverrideBalance(ctx, accAddrs[0], sdk.NewCoins(newFooCoin(30))))
+	require.Equal(sdk.NewCoins(newFooCoin(30), newBarCoin(50)), keeper.GetAllBalances(ctx, accAddrs[0]))
+
+	// the account is created if it does not exist
+	acc1 := authtypes.NewBaseAccountWithAddress(accAddrs[1])
+	authKeeper.EXPECT().HasAccount(ctx, accAddrs[1]).Return(false)
+	authKeeper.EXPECT().NewAccountWithAddress(ctx, accAddrs[1]).Return(acc1)
+	authKeeper.EXPECT().SetAccount(ctx, acc1)
+	require.NoError(keeper.OverrideBalance(ctx, accAddrs[1], sdk.NewCoins(newBarCoin(10))))
+	require.Equal(sdk.NewCoins(newBarCoin(10)), keeper.GetAllBalances(ctx, accAddrs[1]))
+
+	// the supply follows the balances
+	totalSupply, _, err := keeper.GetPaginatedTotalSupply(ctx, &query.PageRequest{})
+	require.NoError(err)
+	require.Equal(initialSupply.Sub(newFooCoin(70)).Add(newBarCoin(10)), totalSupply)
+
+	require.Error(keeper.OverrideBalance(ctx, accAddrs[0], sdk.Coins{sdk.Coin{Denom: fooDenom, Amount: math.NewInt(-10)}}))
+}
+
 func (suite *KeeperTestSuite) TestSendCoinsNewAccount() {
 	ctx := suite.ctx
 	require := suite.Require()
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -12,6 +14,7 @@ import (
 	"cosmossdk.io/core/event"
 	storetypes "cosmossdk.io/core/store"
 	"cosmossdk.io/errors"
+	storeupgrades "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -28,6 +31,8 @@ type Keeper struct {
 
 	authority   string
 	ParamsStore collections.Item[cmtproto.ConsensusParams]
+	// StoreUpgradesStore holds the store upgrades scheduled by height.
+	StoreUpgradesStore collections.Map[int64, types.StoreUpgrades]
 }
 
 var _ exported.ConsensusParamSetter = Keeper{}.ParamsStore
@@ -39,6 +44,9 @@ func NewKeeper(cdc codec.BinaryCodec, storeService storetypes.KVStoreService, au
 		authority:    authority,
 		event:        em,
 		ParamsStore:  collections.NewItem(sb, collections.NewPrefix("Consensus"), "params", codec.CollValue[cmtproto.ConsensusParams](cdc)),
+		StoreUpgradesStore: collections.NewMap(
+			sb, collections.NewPrefix("StoreUpgrades"), "store_upgrades", collections.Int64Key, codec.CollValue[types.StoreUpgrades](cdc),
+		),
 	}
 }
 
@@ -46,6 +54,20 @@ func (k *Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// StoreUpgradesAt returns the store upgrades scheduled at the given height, nil
+// if none. It implements baseapp.StoreUpgradeSchedule.
+func (k Keeper) StoreUpgradesAt(ctx context.Context, height int64) (*storeupgrades.StoreUpgrades, error) {
+	upgrades, err := k.StoreUpgradesStore.Get(ctx, height)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return upgrades.ToStoreUpgrades(), nil
+}
+
 // Querier
 
 var _ types.QueryServer = Keeper{}
@@ -60,6 +82,20 @@ func (k Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types
 	return &types.QueryParamsResponse{Params: &params}, nil
 }
 
+// StoreUpgrades queries the store upgrades scheduled by governance
+func (k Keeper) StoreUpgrades(ctx context.Context, _ *types.QueryStoreUpgradesRequest) (*types.QueryStoreUpgradesResponse, error) {
+	var scheduled []types.ScheduledStoreUpgrades
+	err := k.StoreUpgradesStore.Walk(ctx, nil, func(height int64, upgrades types.StoreUpgrades) (bool, error) {
+		scheduled = append(scheduled, types.ScheduledStoreUpgrades{Height: height, Upgrades: upgrades})
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStoreUpgradesResponse{Scheduled: scheduled}, nil
+}
+
 // MsgServer
 
 var _ types.MsgServer = Keeper{}
@@ -112,3 +148,38 @@ func (k Keeper) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (k Keeper) ScheduleStoreUpgrades(ctx context.Context, msg *types.MsgScheduleStoreUpgrades) (*types.MsgScheduleStoreUpgradesResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Upgrades.Validate(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if msg.Height <= sdkCtx.BlockHeight() {
+		return nil, fmt.Errorf("store upgrades must be scheduled after the current height %d, got %d", sdkCtx.BlockHeight(), msg.Height)
+	}
+
+	// empty upgrades cancel the ones scheduled
+	if msg.Upgrades.IsEmpty() {
+		if err := k.StoreUpgradesStore.Remove(ctx, msg.Height); err != nil {
+			return nil, err
+		}
+	} else if err := k.StoreUpgradesStore.Set(ctx, msg.Height, msg.Upgrades); err != nil {
+		return nil, err
+	}
+
+	if err := k.event.EventManager(ctx).EmitKV(
+		ctx,
+		"schedule_store_upgrades",
+		event.Attribute{Key: "authority", Value: msg.Authority},
+		event.Attribute{Key: "height", Value: strconv.FormatInt(msg.Height, 10)},
+		event.Attribute{Key: "upgrades", Value: msg.Upgrades.String()}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleStoreUpgradesResponse{}, nil
+}
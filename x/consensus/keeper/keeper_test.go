@@ -315,3 +315,70 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestScheduleStoreUpgrades() {
+	upgrades := types.StoreUpgrades{
+		Renamed: []types.StoreRename{{OldKey: "old", NewKey: "new"}},
+		Deleted: []string{"deleted"},
+	}
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgScheduleStoreUpgrades
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			input:     &types.MsgScheduleStoreUpgrades{Authority: "invalid", Height: 10, Upgrades: upgrades},
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:      "current height",
+			input:     &types.MsgScheduleStoreUpgrades{Authority: s.consensusParamsKeeper.GetAuthority(), Height: 5, Upgrades: upgrades},
+			expErrMsg: "after the current height",
+		},
+		{
+			name: "store upgraded twice",
+			input: &types.MsgScheduleStoreUpgrades{Authority: s.consensusParamsKeeper.GetAuthority(), Height: 10, Upgrades: types.StoreUpgrades{
+				Renamed: []types.StoreRename{{OldKey: "old", NewKey: "new"}},
+				Deleted: []string{"old"},
+			}},
+			expErrMsg: "upgraded more than once",
+		},
+		{
+			name:  "valid upgrades",
+			input: &types.MsgScheduleStoreUpgrades{Authority: s.consensusParamsKeeper.GetAuthority(), Height: 10, Upgrades: upgrades},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			_, err := s.consensusParamsKeeper.ScheduleStoreUpgrades(s.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			res, err := s.queryClient.StoreUpgrades(s.ctx, &types.QueryStoreUpgradesRequest{})
+			s.Require().NoError(err)
+			s.Require().Equal([]types.ScheduledStoreUpgrades{{Height: 10, Upgrades: upgrades}}, res.Scheduled)
+
+			scheduled, err := s.consensusParamsKeeper.StoreUpgradesAt(s.ctx, 10)
+			s.Require().NoError(err)
+			s.Require().Equal([]string{"deleted"}, scheduled.Deleted)
+			s.Require().Equal("old", scheduled.RenamedFrom("new"))
+
+			// empty upgrades cancel the scheduled ones
+			_, err = s.consensusParamsKeeper.ScheduleStoreUpgrades(s.ctx, &types.MsgScheduleStoreUpgrades{
+				Authority: s.consensusParamsKeeper.GetAuthority(),
+				Height:    10,
+			})
+			s.Require().NoError(err)
+			scheduled, err = s.consensusParamsKeeper.StoreUpgradesAt(s.ctx, 10)
+			s.Require().NoError(err)
+			s.Require().Nil(scheduled)
+		})
+	}
+}
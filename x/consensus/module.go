@@ -125,6 +125,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	m := NewAppModule(in.Cdc, k)
 	baseappOpt := func(app *baseapp.BaseApp) {
 		app.SetParamStore(k.ParamsStore)
+		app.SetStoreUpgradeSchedule(k)
 	}
 
 	return ModuleOutputs{
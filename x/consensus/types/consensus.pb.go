@@ -0,0 +1,830 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/consensus/v1/consensus.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StoreUpgrades defines the renames and deletions of the module stores applied
+// online, without a coordinated software upgrade.
+type StoreUpgrades struct {
+	// renamed are the stores renamed, the keys mounted with the old names keep
+	// working, resolving to the renamed stores.
+	Renamed []StoreRename `protobuf:"bytes,1,rep,name=renamed,proto3" json:"renamed"`
+	// deleted are the stores no longer committed.
+	Deleted []string `protobuf:"bytes,2,rep,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (m *StoreUpgrades) Reset()         { *m = StoreUpgrades{} }
+func (m *StoreUpgrades) String() string { return proto.CompactTextString(m) }
+func (*StoreUpgrades) ProtoMessage()    {}
+func (*StoreUpgrades) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ed86dd7d42fb61b, []int{0}
+}
+func (m *StoreUpgrades) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreUpgrades) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreUpgrades.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreUpgrades) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreUpgrades.Merge(m, src)
+}
+func (m *StoreUpgrades) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreUpgrades) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreUpgrades.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreUpgrades proto.InternalMessageInfo
+
+func (m *StoreUpgrades) GetRenamed() []StoreRename {
+	if m != nil {
+		return m.Renamed
+	}
+	return nil
+}
+
+func (m *StoreUpgrades) GetDeleted() []string {
+	if m != nil {
+		return m.Deleted
+	}
+	return nil
+}
+
+// StoreRename defines the rename of a store.
+type StoreRename struct {
+	OldKey string `protobuf:"bytes,1,opt,name=old_key,json=oldKey,proto3" json:"old_key,omitempty"`
+	NewKey string `protobuf:"bytes,2,opt,name=new_key,json=newKey,proto3" json:"new_key,omitempty"`
+}
+
+func (m *StoreRename) Reset()         { *m = StoreRename{} }
+func (m *StoreRename) String() string { return proto.CompactTextString(m) }
+func (*StoreRename) ProtoMessage()    {}
+func (*StoreRename) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ed86dd7d42fb61b, []int{1}
+}
+func (m *StoreRename) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreRename) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreRename.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreRename) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreRename.Merge(m, src)
+}
+func (m *StoreRename) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreRename) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreRename.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreRename proto.InternalMessageInfo
+
+func (m *StoreRename) GetOldKey() string {
+	if m != nil {
+		return m.OldKey
+	}
+	return ""
+}
+
+func (m *StoreRename) GetNewKey() string {
+	if m != nil {
+		return m.NewKey
+	}
+	return ""
+}
+
+// ScheduledStoreUpgrades defines the store upgrades scheduled at a height.
+type ScheduledStoreUpgrades struct {
+	Height   int64         `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Upgrades StoreUpgrades `protobuf:"bytes,2,opt,name=upgrades,proto3" json:"upgrades"`
+}
+
+func (m *ScheduledStoreUpgrades) Reset()         { *m = ScheduledStoreUpgrades{} }
+func (m *ScheduledStoreUpgrades) String() string { return proto.CompactTextString(m) }
+func (*ScheduledStoreUpgrades) ProtoMessage()    {}
+func (*ScheduledStoreUpgrades) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ed86dd7d42fb61b, []int{2}
+}
+func (m *ScheduledStoreUpgrades) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledStoreUpgrades) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledStoreUpgrades.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledStoreUpgrades) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledStoreUpgrades.Merge(m, src)
+}
+func (m *ScheduledStoreUpgrades) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledStoreUpgrades) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledStoreUpgrades.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledStoreUpgrades proto.InternalMessageInfo
+
+func (m *ScheduledStoreUpgrades) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ScheduledStoreUpgrades) GetUpgrades() StoreUpgrades {
+	if m != nil {
+		return m.Upgrades
+	}
+	return StoreUpgrades{}
+}
+
+func init() {
+	proto.RegisterType((*StoreUpgrades)(nil), "cosmos.consensus.v1.StoreUpgrades")
+	proto.RegisterType((*StoreRename)(nil), "cosmos.consensus.v1.StoreRename")
+	proto.RegisterType((*ScheduledStoreUpgrades)(nil), "cosmos.consensus.v1.ScheduledStoreUpgrades")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/consensus/v1/consensus.proto", fileDescriptor_7ed86dd7d42fb61b)
+}
+
+var fileDescriptor_7ed86dd7d42fb61b = []byte{
+	// 326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xcd, 0x4a, 0xf3, 0x40,
+	0x14, 0xcd, 0xb4, 0xd0, 0x7e, 0x9d, 0xf2, 0x2d, 0x8c, 0x52, 0x43, 0x17, 0x31, 0xc4, 0x4d, 0x11,
+	0x4c, 0x68, 0x7d, 0x00, 0xa1, 0x20, 0x28, 0xdd, 0xa5, 0xb8, 0x71, 0x23, 0x6d, 0xe6, 0x92, 0x94,
+	0x26, 0x73, 0x43, 0x66, 0xd2, 0x1a, 0x7c, 0x09, 0x1f, 0xc3, 0xa5, 0x8f, 0xd1, 0x65, 0x97, 0xae,
+	0x44, 0xda, 0x85, 0xaf, 0x21, 0x9d, 0xf4, 0x27, 0x82, 0x6e, 0x92, 0x39, 0xf7, 0xfc, 0xcc, 0x5c,
+	0x0e, 0x3d, 0xf7, 0x51, 0xc4, 0x28, 0x5c, 0x1f, 0xb9, 0x00, 0x2e, 0x32, 0xe1, 0xce, 0xba, 0x07,
+	0xe0, 0x24, 0x29, 0x4a, 0xd4, 0x8f, 0x0b, 0x91, 0x73, 0x98, 0xcf, 0xba, 0xed, 0x93, 0x00, 0x03,
+	0x54, 0xbc, 0xbb, 0x39, 0x15, 0xd2, 0xf6, 0xd1, 0x28, 0x9e, 0x70, 0x74, 0xd5, 0xb7, 0x18, 0xd9,
+	0x09, 0xfd, 0x3f, 0x94, 0x98, 0xc2, 0x7d, 0x12, 0xa4, 0x23, 0x06, 0x42, 0xbf, 0xa1, 0xf5, 0x14,
+	0xf8, 0x28, 0x06, 0x66, 0x10, 0xab, 0xda, 0x69, 0xf6, 0x2c, 0xe7, 0x97, 0x0b, 0x1c, 0x65, 0xf2,
+	0x94, 0xb0, 0xdf, 0x58, 0x7c, 0x9c, 0x69, 0xaf, 0x5f, 0x6f, 0x17, 0xc4, 0xdb, 0x79, 0x75, 0x83,
+	0xd6, 0x19, 0x44, 0x20, 0x81, 0x19, 0x15, 0xab, 0xda, 0x69, 0x78, 0x3b, 0x68, 0x5f, 0xd3, 0x66,
+	0xc9, 0xac, 0x9f, 0xd2, 0x3a, 0x46, 0xec, 0x71, 0x0a, 0xb9, 0x41, 0x2c, 0xd2, 0x69, 0x78, 0x35,
+	0x8c, 0xd8, 0x00, 0xf2, 0x0d, 0xc1, 0x61, 0xae, 0x88, 0x4a, 0x41, 0x70, 0x98, 0x0f, 0x20, 0xb7,
+	0x9f, 0x69, 0x6b, 0xe8, 0x87, 0xc0, 0xb2, 0x08, 0xd8, 0xcf, 0xb7, 0xb7, 0x68, 0x2d, 0x84, 0x49,
+	0x10, 0x4a, 0x15, 0x55, 0xf5, 0xb6, 0x48, 0xbf, 0xa3, 0xff, 0xb2, 0xad, 0x46, 0x65, 0x35, 0x7b,
+	0xf6, 0xdf, 0x4b, 0xed, 0xd2, 0xca, 0x6b, 0xed, 0xed, 0xfd, 0xdb, 0xc5, 0xca, 0x24, 0xcb, 0x95,
+	0x49, 0x3e, 0x57, 0x26, 0x79, 0x59, 0x9b, 0xda, 0x72, 0x6d, 0x6a, 0xef, 0x6b, 0x53, 0x7b, 0x70,
+	0x82, 0x89, 0x0c, 0xb3, 0xb1, 0xe3, 0x63, 0xec, 0xee, 0x7b, 0xdb, 0xfc, 0x2e, 0x05, 0x9b, 0xba,
+	0x4f, 0xa5, 0x12, 0x65, 0x9e, 0x80, 0x18, 0xd7, 0x54, 0x01, 0x57, 0xdf, 0x03, 0x00, 0xa8, 0xfa,
+	0x2b, 0xe6, 0xe5, 0x01, 0x00, 0x00,
+}
+
+func (m *StoreUpgrades) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreUpgrades) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreUpgrades) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deleted) > 0 {
+		for iNdEx := len(m.Deleted) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Deleted[iNdEx])
+			copy(dAtA[i:], m.Deleted[iNdEx])
+			i = encodeVarintConsensus(dAtA, i, uint64(len(m.Deleted[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Renamed) > 0 {
+		for iNdEx := len(m.Renamed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Renamed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConsensus(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreRename) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreRename) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreRename) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewKey) > 0 {
+		i -= len(m.NewKey)
+		copy(dAtA[i:], m.NewKey)
+		i = encodeVarintConsensus(dAtA, i, uint64(len(m.NewKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldKey) > 0 {
+		i -= len(m.OldKey)
+		copy(dAtA[i:], m.OldKey)
+		i = encodeVarintConsensus(dAtA, i, uint64(len(m.OldKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledStoreUpgrades) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledStoreUpgrades) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledStoreUpgrades) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Upgrades.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintConsensus(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintConsensus(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintConsensus(dAtA []byte, offset int, v uint64) int {
+	offset -= sovConsensus(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StoreUpgrades) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Renamed) > 0 {
+		for _, e := range m.Renamed {
+			l = e.Size()
+			n += 1 + l + sovConsensus(uint64(l))
+		}
+	}
+	if len(m.Deleted) > 0 {
+		for _, s := range m.Deleted {
+			l = len(s)
+			n += 1 + l + sovConsensus(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoreRename) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldKey)
+	if l > 0 {
+		n += 1 + l + sovConsensus(uint64(l))
+	}
+	l = len(m.NewKey)
+	if l > 0 {
+		n += 1 + l + sovConsensus(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledStoreUpgrades) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovConsensus(uint64(m.Height))
+	}
+	l = m.Upgrades.Size()
+	n += 1 + l + sovConsensus(uint64(l))
+	return n
+}
+
+func sovConsensus(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozConsensus(x uint64) (n int) {
+	return sovConsensus(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StoreUpgrades) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreUpgrades: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreUpgrades: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Renamed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Renamed = append(m.Renamed, StoreRename{})
+			if err := m.Renamed[len(m.Renamed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deleted = append(m.Deleted, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreRename) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreRename: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreRename: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledStoreUpgrades) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledStoreUpgrades: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledStoreUpgrades: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Upgrades.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipConsensus(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowConsensus
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthConsensus
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupConsensus
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthConsensus
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthConsensus        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowConsensus          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupConsensus = fmt.Errorf("proto: unexpected end of group")
+)
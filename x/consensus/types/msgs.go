@@ -2,14 +2,20 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgScheduleStoreUpgrades{}
+)
 
 func (msg MsgUpdateParams) ToProtoConsensusParams() (cmtproto.ConsensusParams, error) {
 	if msg.Evidence == nil || msg.Block == nil || msg.Validator == nil {
@@ -40,3 +46,46 @@ func (msg MsgUpdateParams) ToProtoConsensusParams() (cmtproto.ConsensusParams, e
 
 	return cp, nil
 }
+
+// Validate checks that the store upgrades name each store at most once.
+func (u StoreUpgrades) Validate() error {
+	names := make(map[string]bool)
+	checkName := func(name string) error {
+		if name == "" {
+			return errors.New("store name cannot be empty")
+		}
+		if names[name] {
+			return fmt.Errorf("store %s is upgraded more than once", name)
+		}
+		names[name] = true
+		return nil
+	}
+	for _, rename := range u.Renamed {
+		if err := checkName(rename.OldKey); err != nil {
+			return err
+		}
+		if err := checkName(rename.NewKey); err != nil {
+			return err
+		}
+	}
+	for _, name := range u.Deleted {
+		if err := checkName(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsEmpty returns whether the store upgrades neither rename nor delete a store.
+func (u StoreUpgrades) IsEmpty() bool {
+	return len(u.Renamed) == 0 && len(u.Deleted) == 0
+}
+
+// ToStoreUpgrades converts the store upgrades to the ones of the multistore.
+func (u StoreUpgrades) ToStoreUpgrades() *storetypes.StoreUpgrades {
+	upgrades := &storetypes.StoreUpgrades{Deleted: u.Deleted}
+	for _, rename := range u.Renamed {
+		upgrades.Renamed = append(upgrades.Renamed, storetypes.StoreRename{OldKey: rename.OldKey, NewKey: rename.NewKey})
+	}
+	return upgrades
+}
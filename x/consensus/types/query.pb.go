@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/cometbft/cometbft/proto/tendermint/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -114,33 +115,126 @@ func (m *QueryParamsResponse) GetParams() *types.ConsensusParams {
 	return nil
 }
 
+// QueryStoreUpgradesRequest defines the request type for querying the scheduled store upgrades.
+type QueryStoreUpgradesRequest struct {
+}
+
+func (m *QueryStoreUpgradesRequest) Reset()         { *m = QueryStoreUpgradesRequest{} }
+func (m *QueryStoreUpgradesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStoreUpgradesRequest) ProtoMessage()    {}
+func (*QueryStoreUpgradesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf54d1e5df04cee9, []int{2}
+}
+func (m *QueryStoreUpgradesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoreUpgradesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoreUpgradesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStoreUpgradesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoreUpgradesRequest.Merge(m, src)
+}
+func (m *QueryStoreUpgradesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoreUpgradesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoreUpgradesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStoreUpgradesRequest proto.InternalMessageInfo
+
+// QueryStoreUpgradesResponse defines the response type for querying the scheduled store upgrades.
+type QueryStoreUpgradesResponse struct {
+	// scheduled are the store upgrades scheduled, including the applied ones,
+	// ordered by height.
+	Scheduled []ScheduledStoreUpgrades `protobuf:"bytes,1,rep,name=scheduled,proto3" json:"scheduled"`
+}
+
+func (m *QueryStoreUpgradesResponse) Reset()         { *m = QueryStoreUpgradesResponse{} }
+func (m *QueryStoreUpgradesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStoreUpgradesResponse) ProtoMessage()    {}
+func (*QueryStoreUpgradesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf54d1e5df04cee9, []int{3}
+}
+func (m *QueryStoreUpgradesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoreUpgradesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoreUpgradesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStoreUpgradesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoreUpgradesResponse.Merge(m, src)
+}
+func (m *QueryStoreUpgradesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoreUpgradesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoreUpgradesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStoreUpgradesResponse proto.InternalMessageInfo
+
+func (m *QueryStoreUpgradesResponse) GetScheduled() []ScheduledStoreUpgrades {
+	if m != nil {
+		return m.Scheduled
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.consensus.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.consensus.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryStoreUpgradesRequest)(nil), "cosmos.consensus.v1.QueryStoreUpgradesRequest")
+	proto.RegisterType((*QueryStoreUpgradesResponse)(nil), "cosmos.consensus.v1.QueryStoreUpgradesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/consensus/v1/query.proto", fileDescriptor_bf54d1e5df04cee9) }
 
 var fileDescriptor_bf54d1e5df04cee9 = []byte{
-	// 279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0xce, 0xcf, 0x2b, 0x4e, 0xcd, 0x2b, 0x2e, 0x2d, 0xd6, 0x2f, 0x33, 0xd4,
-	0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x83, 0x2b, 0xd0, 0x2b, 0x33, 0x94, 0x92, 0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x4f, 0x2c,
-	0xc8, 0xd4, 0x4f, 0xcc, 0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0x86, 0x68, 0x91,
-	0x92, 0x2d, 0x49, 0xcd, 0x4b, 0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0xa9, 0x2c, 0x48,
-	0x2d, 0xd6, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0x4a, 0x2b, 0x89, 0x70, 0x09, 0x05, 0x82, 0x2c,
-	0x08, 0x00, 0x0b, 0x06, 0xa5, 0x16, 0x96, 0xa6, 0x16, 0x97, 0x28, 0x05, 0x70, 0x09, 0xa3, 0x88,
-	0x16, 0x17, 0x80, 0x2c, 0x14, 0xb2, 0xe4, 0x62, 0x83, 0x68, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0,
-	0x36, 0x52, 0xd4, 0x43, 0x18, 0xae, 0x07, 0x36, 0x5c, 0xcf, 0x19, 0xe6, 0x32, 0xa8, 0x56, 0xa8,
-	0x06, 0xa3, 0x2e, 0x46, 0x2e, 0x56, 0xb0, 0x91, 0x42, 0x0d, 0x8c, 0x5c, 0x6c, 0x10, 0x49, 0x21,
-	0x75, 0x3d, 0x2c, 0xfe, 0xd1, 0xc3, 0x74, 0x8f, 0x94, 0x06, 0x61, 0x85, 0x10, 0x27, 0x2a, 0x29,
-	0x37, 0x5d, 0x7e, 0x32, 0x99, 0x49, 0x56, 0x48, 0x5a, 0x1f, 0x5b, 0x58, 0x42, 0x1c, 0xe3, 0xe4,
-	0x71, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c,
-	0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x7a, 0xe9, 0x99, 0x25, 0x19,
-	0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0x08, 0x03, 0x40, 0x94, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x05,
-	0x92, 0x69, 0x60, 0xef, 0x26, 0xb1, 0x81, 0x43, 0xd1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x1b,
-	0xa2, 0x35, 0xa1, 0xba, 0x01, 0x00, 0x00,
+	// 394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x4e, 0xe2, 0x40,
+	0x1c, 0xc6, 0x3b, 0xec, 0x2e, 0xc9, 0x0e, 0xd9, 0xcb, 0xc0, 0x81, 0x2d, 0x4b, 0x61, 0x4b, 0x8c,
+	0x24, 0xc4, 0x99, 0x80, 0x27, 0xaf, 0x7a, 0xf1, 0x26, 0x42, 0xbc, 0x78, 0x31, 0xa5, 0x9d, 0x94,
+	0x46, 0xda, 0x29, 0x9d, 0x29, 0x91, 0x9b, 0xf1, 0x09, 0x4c, 0x3c, 0xfb, 0x02, 0x3e, 0x09, 0x47,
+	0x12, 0x2f, 0x9e, 0x8c, 0x01, 0x1f, 0xc4, 0x74, 0xda, 0x52, 0x89, 0x35, 0x7a, 0xea, 0x64, 0xe6,
+	0xfb, 0x7f, 0xdf, 0x2f, 0x5f, 0xff, 0xb0, 0x61, 0x32, 0xee, 0x32, 0x4e, 0x4c, 0xe6, 0x71, 0xea,
+	0xf1, 0x90, 0x93, 0x59, 0x97, 0x4c, 0x43, 0x1a, 0xcc, 0xb1, 0x1f, 0x30, 0xc1, 0x50, 0x39, 0x16,
+	0xe0, 0x8d, 0x00, 0xcf, 0xba, 0xea, 0x3f, 0x9b, 0x31, 0x7b, 0x42, 0x89, 0xe1, 0x3b, 0xc4, 0xf0,
+	0x3c, 0x26, 0x0c, 0xe1, 0x30, 0x8f, 0xc7, 0x23, 0x6a, 0x5d, 0x50, 0xcf, 0xa2, 0x81, 0xeb, 0x78,
+	0x82, 0x88, 0xb9, 0x4f, 0x39, 0xf1, 0x8d, 0xc0, 0x70, 0xd3, 0xe7, 0x8a, 0xcd, 0x6c, 0x26, 0x8f,
+	0x24, 0x3a, 0x25, 0xb7, 0xad, 0x3c, 0x90, 0x2c, 0x54, 0x8a, 0xf4, 0x0a, 0x44, 0xa7, 0x11, 0x5b,
+	0x5f, 0xfa, 0x0d, 0xe8, 0x34, 0xa4, 0x5c, 0xe8, 0x7d, 0x58, 0xde, 0xba, 0xe5, 0x7e, 0x34, 0x86,
+	0x0e, 0x60, 0x31, 0xce, 0xad, 0x82, 0x26, 0x68, 0x97, 0x7a, 0xff, 0x71, 0xc6, 0x85, 0x25, 0x17,
+	0x3e, 0x4a, 0xfd, 0x93, 0xd1, 0x64, 0x40, 0xaf, 0xc1, 0xbf, 0xd2, 0x71, 0x28, 0x58, 0x40, 0xcf,
+	0x7c, 0x3b, 0x30, 0x2c, 0xba, 0x89, 0x73, 0xa1, 0x9a, 0xf7, 0x98, 0xa4, 0x9e, 0xc0, 0xdf, 0xdc,
+	0x1c, 0x53, 0x2b, 0x9c, 0x50, 0xab, 0x0a, 0x9a, 0x3f, 0xda, 0xa5, 0x5e, 0x07, 0xe7, 0x74, 0x88,
+	0x87, 0xa9, 0x6a, 0xcb, 0xe7, 0xf0, 0xe7, 0xe2, 0xb9, 0xa1, 0x0c, 0x32, 0x8f, 0xde, 0x43, 0x01,
+	0xfe, 0x92, 0x79, 0xe8, 0x1a, 0xc0, 0x62, 0x0c, 0x8a, 0x76, 0x73, 0x2d, 0x3f, 0x76, 0xa3, 0xb6,
+	0xbf, 0x16, 0xc6, 0xe0, 0x7a, 0xeb, 0xe6, 0xf1, 0xf5, 0xae, 0x50, 0x47, 0x35, 0x92, 0xf7, 0x27,
+	0xe2, 0x62, 0xd0, 0x3d, 0x80, 0x7f, 0xb6, 0x78, 0x11, 0xfe, 0x3c, 0x20, 0xaf, 0x3d, 0x95, 0x7c,
+	0x5b, 0x9f, 0x70, 0x75, 0x24, 0xd7, 0x0e, 0x6a, 0xe5, 0x72, 0xf1, 0x68, 0xe6, 0x22, 0x4c, 0xdb,
+	0x3b, 0x5e, 0xac, 0x34, 0xb0, 0x5c, 0x69, 0xe0, 0x65, 0xa5, 0x81, 0xdb, 0xb5, 0xa6, 0x2c, 0xd7,
+	0x9a, 0xf2, 0xb4, 0xd6, 0x94, 0x73, 0x6c, 0x3b, 0x62, 0x1c, 0x8e, 0xb0, 0xc9, 0xdc, 0xcc, 0x28,
+	0xfa, 0xec, 0x71, 0xeb, 0x92, 0x5c, 0xbd, 0x73, 0x95, 0xab, 0x31, 0x2a, 0xca, 0x8d, 0xdb, 0x7f,
+	0x1b, 0x00, 0xb5, 0x3c, 0x17, 0x82, 0x21, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -157,6 +251,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries the parameters of x/consensus module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// StoreUpgrades queries the store upgrades scheduled by governance.
+	StoreUpgrades(ctx context.Context, in *QueryStoreUpgradesRequest, opts ...grpc.CallOption) (*QueryStoreUpgradesResponse, error)
 }
 
 type queryClient struct {
@@ -176,10 +272,21 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) StoreUpgrades(ctx context.Context, in *QueryStoreUpgradesRequest, opts ...grpc.CallOption) (*QueryStoreUpgradesResponse, error) {
+	out := new(QueryStoreUpgradesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.consensus.v1.Query/StoreUpgrades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/consensus module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// StoreUpgrades queries the store upgrades scheduled by governance.
+	StoreUpgrades(context.Context, *QueryStoreUpgradesRequest) (*QueryStoreUpgradesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -189,6 +296,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) StoreUpgrades(ctx context.Context, req *QueryStoreUpgradesRequest) (*QueryStoreUpgradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreUpgrades not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -212,6 +322,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StoreUpgrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStoreUpgradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StoreUpgrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.consensus.v1.Query/StoreUpgrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StoreUpgrades(ctx, req.(*QueryStoreUpgradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.consensus.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -220,6 +348,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "StoreUpgrades",
+			Handler:    _Query_StoreUpgrades_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/consensus/v1/query.proto",
@@ -283,6 +415,66 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryStoreUpgradesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoreUpgradesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoreUpgradesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoreUpgradesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoreUpgradesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoreUpgradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Scheduled) > 0 {
+		for iNdEx := len(m.Scheduled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scheduled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -316,6 +508,30 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryStoreUpgradesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStoreUpgradesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Scheduled) > 0 {
+		for _, e := range m.Scheduled {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -458,6 +674,140 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryStoreUpgradesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoreUpgradesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoreUpgradesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStoreUpgradesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoreUpgradesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoreUpgradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scheduled = append(m.Scheduled, ScheduledStoreUpgrades{})
+			if err := m.Scheduled[len(m.Scheduled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
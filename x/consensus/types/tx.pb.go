@@ -10,6 +10,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -153,42 +154,153 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgScheduleStoreUpgrades is the Msg/ScheduleStoreUpgrades request type.
+type MsgScheduleStoreUpgrades struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// height is the height of the block after which the upgrades are applied.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// upgrades defines the stores to rename and delete.
+	Upgrades StoreUpgrades `protobuf:"bytes,3,opt,name=upgrades,proto3" json:"upgrades"`
+}
+
+func (m *MsgScheduleStoreUpgrades) Reset()         { *m = MsgScheduleStoreUpgrades{} }
+func (m *MsgScheduleStoreUpgrades) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleStoreUpgrades) ProtoMessage()    {}
+func (*MsgScheduleStoreUpgrades) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2135c60575ab504d, []int{2}
+}
+func (m *MsgScheduleStoreUpgrades) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleStoreUpgrades) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleStoreUpgrades.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleStoreUpgrades) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleStoreUpgrades.Merge(m, src)
+}
+func (m *MsgScheduleStoreUpgrades) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleStoreUpgrades) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleStoreUpgrades.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleStoreUpgrades proto.InternalMessageInfo
+
+func (m *MsgScheduleStoreUpgrades) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgScheduleStoreUpgrades) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MsgScheduleStoreUpgrades) GetUpgrades() StoreUpgrades {
+	if m != nil {
+		return m.Upgrades
+	}
+	return StoreUpgrades{}
+}
+
+// MsgScheduleStoreUpgradesResponse defines the response structure for executing a
+// MsgScheduleStoreUpgrades message.
+type MsgScheduleStoreUpgradesResponse struct {
+}
+
+func (m *MsgScheduleStoreUpgradesResponse) Reset()         { *m = MsgScheduleStoreUpgradesResponse{} }
+func (m *MsgScheduleStoreUpgradesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleStoreUpgradesResponse) ProtoMessage()    {}
+func (*MsgScheduleStoreUpgradesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2135c60575ab504d, []int{3}
+}
+func (m *MsgScheduleStoreUpgradesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleStoreUpgradesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleStoreUpgradesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleStoreUpgradesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleStoreUpgradesResponse.Merge(m, src)
+}
+func (m *MsgScheduleStoreUpgradesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleStoreUpgradesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleStoreUpgradesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleStoreUpgradesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.consensus.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.consensus.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgScheduleStoreUpgrades)(nil), "cosmos.consensus.v1.MsgScheduleStoreUpgrades")
+	proto.RegisterType((*MsgScheduleStoreUpgradesResponse)(nil), "cosmos.consensus.v1.MsgScheduleStoreUpgradesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/consensus/v1/tx.proto", fileDescriptor_2135c60575ab504d) }
 
 var fileDescriptor_2135c60575ab504d = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0xcb, 0x12, 0x41,
-	0x1c, 0xc7, 0x5d, 0xff, 0x44, 0x4e, 0x41, 0xb4, 0x05, 0xae, 0x8b, 0x2e, 0x26, 0x11, 0x22, 0x39,
-	0x93, 0x0a, 0x41, 0x11, 0x84, 0x1b, 0x41, 0x1d, 0x84, 0x30, 0xea, 0xd0, 0x25, 0x66, 0x77, 0x87,
-	0x75, 0xd0, 0xdd, 0x59, 0x76, 0xc6, 0x45, 0x6f, 0xd1, 0xb1, 0x53, 0x2f, 0xc5, 0x43, 0x2f, 0xa2,
-	0xa3, 0x74, 0xea, 0xf8, 0xa0, 0x07, 0xe1, 0x79, 0x15, 0x0f, 0xce, 0x8e, 0xee, 0xf3, 0xa8, 0x87,
-	0xe7, 0x22, 0xb8, 0xdf, 0xcf, 0x67, 0xbe, 0xcc, 0x6f, 0x7e, 0xa0, 0xe6, 0x32, 0x1e, 0x30, 0x8e,
-	0x5c, 0x16, 0x72, 0x12, 0xf2, 0x19, 0x47, 0x49, 0x17, 0x89, 0x39, 0x8c, 0x62, 0x26, 0x98, 0xfe,
-	0x28, 0x4d, 0xe1, 0x21, 0x85, 0x49, 0xd7, 0x7c, 0x88, 0x03, 0x1a, 0x32, 0x24, 0x7f, 0x53, 0xce,
-	0xac, 0xa6, 0xdc, 0x77, 0xf9, 0x0f, 0x29, 0x29, 0x8d, 0x2a, 0xaa, 0x20, 0xe0, 0xfe, 0xee, 0xe8,
-	0x80, 0xfb, 0x2a, 0xa8, 0x0b, 0x12, 0x7a, 0x24, 0x0e, 0x68, 0x28, 0x90, 0x58, 0x44, 0x84, 0xa3,
-	0x08, 0xc7, 0x38, 0x50, 0x5e, 0xf3, 0x32, 0x0f, 0x1e, 0x0c, 0xb9, 0xff, 0x25, 0xf2, 0xb0, 0x20,
-	0x9f, 0x64, 0xa2, 0xbf, 0x04, 0x65, 0x3c, 0x13, 0x63, 0x16, 0x53, 0xb1, 0x30, 0xb4, 0x86, 0xd6,
-	0x2a, 0xdb, 0xc6, 0xbf, 0x3f, 0x9d, 0xc7, 0xaa, 0x70, 0xe0, 0x79, 0x31, 0xe1, 0xfc, 0xb3, 0x88,
-	0x69, 0xe8, 0x8f, 0x32, 0x54, 0xef, 0x83, 0x92, 0x33, 0x65, 0xee, 0xc4, 0xc8, 0x37, 0xb4, 0xd6,
-	0xbd, 0x5e, 0x1d, 0x66, 0xd5, 0x50, 0x56, 0x43, 0x7b, 0x17, 0xa7, 0x2d, 0xa3, 0x94, 0xd5, 0xdf,
-	0x80, 0xbb, 0x24, 0xa1, 0x1e, 0x09, 0x5d, 0x62, 0x14, 0xa4, 0xd7, 0x38, 0xf5, 0xde, 0x2b, 0x42,
-	0xa9, 0x07, 0x43, 0x7f, 0x0b, 0xca, 0x09, 0x9e, 0x52, 0x0f, 0x0b, 0x16, 0x1b, 0x45, 0xa9, 0x3f,
-	0x39, 0xd5, 0xbf, 0xee, 0x11, 0xe5, 0x67, 0x8e, 0xfe, 0x02, 0x14, 0xb1, 0xe3, 0x52, 0xa3, 0x24,
-	0xdd, 0xda, 0xa9, 0x3b, 0xb0, 0xdf, 0x7d, 0x54, 0x9a, 0x24, 0x5f, 0xbf, 0xfa, 0xb9, 0x5d, 0xb6,
-	0xb3, 0x5b, 0xff, 0xda, 0x2e, 0xdb, 0xcf, 0xd2, 0xc9, 0x74, 0xb8, 0x37, 0x41, 0xf3, 0x6b, 0x6f,
-	0x7c, 0x34, 0xd8, 0x66, 0x15, 0x54, 0x8e, 0x3e, 0x8d, 0x08, 0x8f, 0x76, 0x78, 0x2f, 0x02, 0x85,
-	0x21, 0xf7, 0x75, 0x07, 0xdc, 0xbf, 0xf1, 0x14, 0x4f, 0xe1, 0x99, 0xd5, 0x80, 0x47, 0x87, 0x98,
-	0xcf, 0x6f, 0x43, 0xed, 0xab, 0xcc, 0xd2, 0x8f, 0xed, 0xb2, 0xad, 0xd9, 0x1f, 0xfe, 0xae, 0x2d,
-	0x6d, 0xb5, 0xb6, 0xb4, 0x8b, 0xb5, 0xa5, 0xfd, 0xde, 0x58, 0xb9, 0xd5, 0xc6, 0xca, 0xfd, 0xdf,
-	0x58, 0xb9, 0x6f, 0xd0, 0xa7, 0x62, 0x3c, 0x73, 0xa0, 0xcb, 0x02, 0x74, 0xd8, 0xdb, 0xb3, 0x17,
-	0x94, 0x23, 0x72, 0xee, 0xc8, 0x55, 0xea, 0x5f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x27, 0xbf,
-	0x79, 0xe5, 0x02, 0x00, 0x00,
+	// 544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x37, 0x6d, 0xb7, 0x74, 0x47, 0x41, 0x8c, 0xd5, 0xa6, 0xa1, 0x8d, 0x6b, 0x2a, 0x52,
+	0x16, 0x77, 0x62, 0x5b, 0x2c, 0x58, 0x04, 0x69, 0x44, 0xb0, 0x87, 0x05, 0xc9, 0x52, 0x0f, 0x5e,
+	0x24, 0x3f, 0x86, 0x49, 0xe8, 0x26, 0x13, 0x32, 0x93, 0xa5, 0x3d, 0x08, 0xe2, 0xd1, 0x93, 0x7f,
+	0x86, 0xc7, 0x3d, 0xf8, 0x47, 0xf4, 0x58, 0x3c, 0x79, 0x12, 0xd9, 0x3d, 0x2c, 0x08, 0xe2, 0xbf,
+	0x20, 0x99, 0x4c, 0x36, 0xed, 0x6e, 0x16, 0xd4, 0x4b, 0xc8, 0xcc, 0xfb, 0x7e, 0xde, 0x7b, 0xf3,
+	0x9d, 0x37, 0x60, 0xc3, 0x25, 0x34, 0x24, 0xd4, 0x70, 0x49, 0x44, 0x51, 0x44, 0x53, 0x6a, 0xf4,
+	0x77, 0x0c, 0x76, 0x0a, 0xe3, 0x84, 0x30, 0x22, 0xdf, 0xca, 0xa3, 0x70, 0x12, 0x85, 0xfd, 0x1d,
+	0xf5, 0xa6, 0x1d, 0x06, 0x11, 0x31, 0xf8, 0x37, 0xd7, 0xa9, 0xeb, 0xb9, 0xee, 0x2d, 0x5f, 0x19,
+	0x02, 0xca, 0x43, 0x6b, 0xa2, 0x40, 0x48, 0x71, 0x96, 0x3a, 0xa4, 0x58, 0x04, 0x36, 0x19, 0x8a,
+	0x3c, 0x94, 0x84, 0x41, 0xc4, 0x0c, 0x76, 0x16, 0x23, 0x6a, 0xc4, 0x76, 0x62, 0x87, 0x05, 0xb7,
+	0x8a, 0x09, 0x26, 0x79, 0xbe, 0xec, 0x4f, 0xec, 0x6e, 0x55, 0xb5, 0x5b, 0x76, 0xc7, 0x45, 0xfa,
+	0xcf, 0x05, 0x70, 0xa3, 0x43, 0xf1, 0x71, 0xec, 0xd9, 0x0c, 0xbd, 0xe2, 0x49, 0xe5, 0x7d, 0xd0,
+	0xb0, 0x53, 0xe6, 0x93, 0x24, 0x60, 0x67, 0x8a, 0xd4, 0x94, 0xb6, 0x1b, 0xa6, 0xf2, 0xf5, 0x4b,
+	0x7b, 0x55, 0xf4, 0x7a, 0xe8, 0x79, 0x09, 0xa2, 0xb4, 0xcb, 0x92, 0x20, 0xc2, 0x56, 0x29, 0x95,
+	0xf7, 0x40, 0xdd, 0xe9, 0x11, 0xf7, 0x44, 0x59, 0x68, 0x4a, 0xdb, 0xd7, 0x76, 0x37, 0x61, 0xd9,
+	0x35, 0xe4, 0x5d, 0x43, 0x33, 0x0b, 0xe7, 0x55, 0xac, 0x5c, 0x2b, 0x3f, 0x05, 0x2b, 0xa8, 0x1f,
+	0x78, 0x28, 0x72, 0x91, 0xb2, 0xc8, 0xb9, 0xe6, 0x2c, 0xf7, 0x42, 0x28, 0x04, 0x3a, 0x21, 0xe4,
+	0x67, 0xa0, 0xd1, 0xb7, 0x7b, 0x81, 0x67, 0x33, 0x92, 0x28, 0x4b, 0x1c, 0xbf, 0x37, 0x8b, 0xbf,
+	0x2e, 0x24, 0x82, 0x2f, 0x19, 0xf9, 0x11, 0x58, 0xb2, 0x1d, 0x37, 0x50, 0xea, 0x9c, 0xdd, 0x98,
+	0x65, 0x0f, 0xcd, 0xe7, 0x47, 0x02, 0xe3, 0xca, 0x83, 0x27, 0x1f, 0xc6, 0x83, 0x56, 0x79, 0xea,
+	0x8f, 0xe3, 0x41, 0xeb, 0x41, 0xee, 0x4c, 0x9b, 0x7a, 0x27, 0xc6, 0xe9, 0x25, 0xbf, 0xa7, 0x8c,
+	0xd5, 0xd7, 0xc1, 0xda, 0xd4, 0x96, 0x85, 0x68, 0x9c, 0xc9, 0xf5, 0x5f, 0x12, 0x50, 0x3a, 0x14,
+	0x77, 0x5d, 0x1f, 0x79, 0x69, 0x0f, 0x75, 0x19, 0x49, 0xd0, 0x71, 0x8c, 0x13, 0xdb, 0x43, 0xff,
+	0x7f, 0x21, 0x77, 0xc0, 0xb2, 0x8f, 0x02, 0xec, 0x33, 0x7e, 0x23, 0x8b, 0x96, 0x58, 0xc9, 0x47,
+	0x60, 0x25, 0x15, 0xb9, 0x85, 0xe7, 0x3a, 0xac, 0x98, 0x5e, 0x78, 0xa5, 0x0b, 0xb3, 0x71, 0xfe,
+	0xfd, 0x6e, 0xed, 0xf3, 0x78, 0xd0, 0x92, 0xac, 0x09, 0x7e, 0xb0, 0x3f, 0xeb, 0xc6, 0xd6, 0x25,
+	0x37, 0xe6, 0x1d, 0x49, 0xd7, 0x41, 0x73, 0x5e, 0xac, 0xf0, 0x64, 0xf7, 0xb7, 0x04, 0x16, 0x3b,
+	0x14, 0xcb, 0x0e, 0xb8, 0x7e, 0x65, 0x3e, 0xef, 0x57, 0x36, 0x3b, 0xe5, 0xac, 0xfa, 0xf0, 0x6f,
+	0x54, 0x45, 0x2d, 0xf9, 0x1d, 0xb8, 0x5d, 0xed, 0x7d, 0x7b, 0x5e, 0x9a, 0x4a, 0xb9, 0xfa, 0xf8,
+	0x9f, 0xe4, 0x45, 0x79, 0xb5, 0xfe, 0x3e, 0xf3, 0xd5, 0x7c, 0x79, 0x3e, 0xd4, 0xa4, 0x8b, 0xa1,
+	0x26, 0xfd, 0x18, 0x6a, 0xd2, 0xa7, 0x91, 0x56, 0xbb, 0x18, 0x69, 0xb5, 0x6f, 0x23, 0xad, 0xf6,
+	0x06, 0xe2, 0x80, 0xf9, 0xa9, 0x03, 0x5d, 0x12, 0x1a, 0x93, 0x77, 0x5d, 0x39, 0x74, 0x7c, 0x6c,
+	0x9d, 0x65, 0xfe, 0xbc, 0xf7, 0xfe, 0x0c, 0x00, 0x27, 0x64, 0xe5, 0x5a, 0xb4, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -208,6 +320,10 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ScheduleStoreUpgrades defines a governance operation for scheduling the
+	// renames and deletions of module stores at a height, applied online.
+	// Scheduling empty upgrades cancels the upgrades of the height.
+	ScheduleStoreUpgrades(ctx context.Context, in *MsgScheduleStoreUpgrades, opts ...grpc.CallOption) (*MsgScheduleStoreUpgradesResponse, error)
 }
 
 type msgClient struct {
@@ -227,6 +343,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ScheduleStoreUpgrades(ctx context.Context, in *MsgScheduleStoreUpgrades, opts ...grpc.CallOption) (*MsgScheduleStoreUpgradesResponse, error) {
+	out := new(MsgScheduleStoreUpgradesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.consensus.v1.Msg/ScheduleStoreUpgrades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/consensus module parameters.
@@ -234,6 +359,10 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ScheduleStoreUpgrades defines a governance operation for scheduling the
+	// renames and deletions of module stores at a height, applied online.
+	// Scheduling empty upgrades cancels the upgrades of the height.
+	ScheduleStoreUpgrades(context.Context, *MsgScheduleStoreUpgrades) (*MsgScheduleStoreUpgradesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -243,6 +372,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleStoreUpgrades(ctx context.Context, req *MsgScheduleStoreUpgrades) (*MsgScheduleStoreUpgradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleStoreUpgrades not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -266,6 +398,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleStoreUpgrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleStoreUpgrades)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleStoreUpgrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.consensus.v1.Msg/ScheduleStoreUpgrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleStoreUpgrades(ctx, req.(*MsgScheduleStoreUpgrades))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.consensus.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -274,6 +424,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ScheduleStoreUpgrades",
+			Handler:    _Msg_ScheduleStoreUpgrades_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/consensus/v1/tx.proto",
@@ -380,6 +534,74 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgScheduleStoreUpgrades) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleStoreUpgrades) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleStoreUpgrades) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Upgrades.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleStoreUpgradesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleStoreUpgradesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleStoreUpgradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -429,6 +651,33 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgScheduleStoreUpgrades) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = m.Upgrades.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleStoreUpgradesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -711,6 +960,190 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgScheduleStoreUpgrades) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleStoreUpgrades: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleStoreUpgrades: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Upgrades.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleStoreUpgradesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleStoreUpgradesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleStoreUpgradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -37,6 +37,10 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 
 	if totalPreviousPower == 0 {
 		feePool.CommunityPool = feePool.CommunityPool.Add(feesCollected...)
+		if err := k.sendCommunityPoolToExternalPool(ctx, &feePool); err != nil {
+			return err
+		}
+
 		return k.FeePool.Set(ctx, feePool)
 	}
 
@@ -77,6 +81,10 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 
 	// allocate community funding
 	feePool.CommunityPool = feePool.CommunityPool.Add(remaining...)
+	if err := k.sendCommunityPoolToExternalPool(ctx, &feePool); err != nil {
+		return err
+	}
+
 	return k.FeePool.Set(ctx, feePool)
 }
 
@@ -339,3 +339,82 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, val2OutstandingRewards.Rewards.IsValid())
 }
+
+func TestAllocateTokensToExternalCommunityPool(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockExternalCommunityPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+	poolKeeper.EXPECT().GetCommunityPoolModule().Return("protocolpool_escrow").AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+		keeper.WithExternalCommunityPool(poolKeeper),
+	)
+	require.True(t, distrKeeper.HasExternalCommunityPool())
+
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val, nil).AnyTimes()
+
+	votes := []abci.VoteInfo{{Validator: abci.Validator{Address: valConsPk0.Address(), Power: 100}}}
+
+	// the integral part of the 2% community tax is sent to the external
+	// community pool, and the decimal change remains in the fee pool
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(101)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), disttypes.ModuleName, "protocolpool_escrow", sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(2))))
+	require.NoError(t, distrKeeper.AllocateTokens(ctx, 100, votes))
+
+	feePool, err := distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDecWithPrec(2, 2)}}, feePool.CommunityPool)
+
+	// the decimal change is sent once it adds up to a whole coin
+	fees = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(49)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), disttypes.ModuleName, "protocolpool_escrow", sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1))))
+	require.NoError(t, distrKeeper.AllocateTokens(ctx, 100, votes))
+
+	feePool, err = distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, feePool.CommunityPool.IsZero())
+
+	// the community pool is funded through the external community pool
+	funder := sdk.AccAddress(valConsAddr1)
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10)))
+	poolKeeper.EXPECT().FundCommunityPool(gomock.Any(), amount, funder).Return(nil)
+	require.NoError(t, distrKeeper.FundCommunityPool(ctx, amount, funder))
+
+	// the community pool messages and query of x/distribution are disabled
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+	_, err = msgServer.FundCommunityPool(ctx, &disttypes.MsgFundCommunityPool{Amount: amount, Depositor: funder.String()})
+	require.Error(t, err)
+
+	_, err = keeper.NewQuerier(distrKeeper).CommunityPool(ctx, &disttypes.QueryCommunityPoolRequest{})
+	require.Error(t, err)
+}
@@ -8,8 +8,13 @@ import (
 )
 
 // DistributeFromFeePool distributes funds from the distribution module account to
-// a receiver address while updating the community pool
+// a receiver address while updating the community pool. The amount is
+// distributed from the external community pool instead, if any.
 func (k Keeper) DistributeFromFeePool(ctx context.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error {
+	if k.HasExternalCommunityPool() {
+		return k.externalCommunityPool.DistributeFromCommunityPool(ctx, amount, receiveAddr)
+	}
+
 	feePool, err := k.FeePool.Get(ctx)
 	if err != nil {
 		return err
@@ -32,3 +37,24 @@ func (k Keeper) DistributeFromFeePool(ctx context.Context, amount sdk.Coins, rec
 
 	return k.FeePool.Set(ctx, feePool)
 }
+
+// sendCommunityPoolToExternalPool sends the integral part of the community
+// pool to the external community pool, if any. The decimal change remains in
+// the fee pool until it adds up to a whole coin.
+func (k Keeper) sendCommunityPoolToExternalPool(ctx context.Context, feePool *types.FeePool) error {
+	if !k.HasExternalCommunityPool() {
+		return nil
+	}
+
+	amount, change := feePool.CommunityPool.TruncateDecimal()
+	if amount.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.externalCommunityPool.GetCommunityPoolModule(), amount); err != nil {
+		return err
+	}
+
+	feePool.CommunityPool = change
+	return nil
+}
@@ -363,6 +363,10 @@ func (k Querier) DelegatorWithdrawAddress(ctx context.Context, req *types.QueryD
 
 // CommunityPool queries the community pool coins
 func (k Querier) CommunityPool(ctx context.Context, req *types.QueryCommunityPoolRequest) (*types.QueryCommunityPoolResponse, error) {
+	if k.HasExternalCommunityPool() {
+		return nil, status.Error(codes.Unimplemented, "the community pool is held by an external module, use its CommunityPool query instead")
+	}
+
 	pool, err := k.FeePool.Get(ctx)
 	if err != nil {
 		return nil, err
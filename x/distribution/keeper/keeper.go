@@ -22,6 +22,8 @@ type Keeper struct {
 	authKeeper    types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
+	// the module holding the community pool in place of x/distribution, if any
+	externalCommunityPool types.ExternalCommunityPoolKeeper
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -33,11 +35,25 @@ type Keeper struct {
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
 
+// InitOption is an option of the distribution Keeper.
+type InitOption func(*Keeper)
+
+// WithExternalCommunityPool makes the distribution Keeper hand the community
+// pool over to an external module, such as x/protocolpool. The community pool
+// share of the collected fees and rewards is then sent to the external module
+// every block, and the community pool messages and query of x/distribution are
+// disabled.
+func WithExternalCommunityPool(ecp types.ExternalCommunityPoolKeeper) InitOption {
+	return func(k *Keeper) {
+		k.externalCommunityPool = ecp
+	}
+}
+
 // NewKeeper creates a new distribution Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, storeService store.KVStoreService,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper,
-	feeCollectorName, authority string,
+	feeCollectorName, authority string, opts ...InitOption,
 ) Keeper {
 	// ensure distribution module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -57,6 +73,10 @@ func NewKeeper(
 		FeePool:          collections.NewItem(sb, types.FeePoolKey, "fee_pool", codec.CollValue[types.FeePool](cdc)),
 	}
 
+	for _, opt := range opts {
+		opt(&k)
+	}
+
 	schema, err := sb.Build()
 	if err != nil {
 		panic(err)
@@ -65,6 +85,12 @@ func NewKeeper(
 	return k
 }
 
+// HasExternalCommunityPool returns whether the community pool is held by an
+// external module.
+func (k Keeper) HasExternalCommunityPool() bool {
+	return k.externalCommunityPool != nil
+}
+
 // GetAuthority returns the x/distribution module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -202,8 +228,13 @@ func (k Keeper) GetTotalRewards(ctx context.Context) (totalRewards sdk.DecCoins)
 // FundCommunityPool allows an account to directly fund the community fund pool.
 // The amount is first added to the distribution module account and then directly
 // added to the pool. An error is returned if the amount cannot be sent to the
-// module account.
+// module account. The amount is sent to the external community pool instead, if
+// any.
 func (k Keeper) FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	if k.HasExternalCommunityPool() {
+		return k.externalCommunityPool.FundCommunityPool(ctx, amount, sender)
+	}
+
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount); err != nil {
 		return err
 	}
@@ -103,6 +103,10 @@ func (k msgServer) WithdrawValidatorCommission(ctx context.Context, msg *types.M
 }
 
 func (k msgServer) FundCommunityPool(ctx context.Context, msg *types.MsgFundCommunityPool) (*types.MsgFundCommunityPoolResponse, error) {
+	if k.HasExternalCommunityPool() {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "the community pool is held by an external module, use its FundCommunityPool message instead")
+	}
+
 	depositor, err := k.authKeeper.AddressCodec().StringToBytes(msg.Depositor)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid depositor address: %s", err)
@@ -145,6 +149,10 @@ func (k msgServer) CommunityPoolSpend(ctx context.Context, msg *types.MsgCommuni
 		return nil, err
 	}
 
+	if k.HasExternalCommunityPool() {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "the community pool is held by an external module, use its CommunityPoolSpend message instead")
+	}
+
 	if err := validateAmount(msg.Amount); err != nil {
 		return nil, err
 	}
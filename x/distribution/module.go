@@ -217,6 +217,10 @@ type ModuleInputs struct {
 	BankKeeper    types.BankKeeper
 	StakingKeeper types.StakingKeeper
 
+	// ExternalPoolKeeper holds the community pool in place of x/distribution,
+	// when an external community pool module such as x/protocolpool is wired
+	ExternalPoolKeeper types.ExternalCommunityPoolKeeper `optional:"true"`
+
 	// LegacySubspace is used solely for migration of x/params managed parameters
 	LegacySubspace exported.Subspace `optional:"true"`
 }
@@ -241,6 +245,11 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
 	}
 
+	var opts []keeper.InitOption
+	if in.ExternalPoolKeeper != nil {
+		opts = append(opts, keeper.WithExternalCommunityPool(in.ExternalPoolKeeper))
+	}
+
 	k := keeper.NewKeeper(
 		in.Cdc,
 		in.StoreService,
@@ -249,6 +258,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.StakingKeeper,
 		feeCollectorName,
 		authority.String(),
+		opts...,
 	)
 
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.StakingKeeper, in.LegacySubspace)
@@ -238,6 +238,10 @@ func SimulateMsgFundCommunityPool(txConfig client.TxConfig, ak types.AccountKeep
 	return func(
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if k.HasExternalCommunityPool() {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgFundCommunityPool{}), "community pool is held by an external module"), nil, nil
+		}
+
 		funder, _ := simtypes.RandomAcc(r, accs)
 
 		account := ak.GetAccount(ctx, funder.Address)
@@ -55,6 +55,16 @@ type StakingKeeper interface {
 	GetAllDelegatorDelegations(ctx context.Context, delegator sdk.AccAddress) ([]stakingtypes.Delegation, error)
 }
 
+// ExternalCommunityPoolKeeper defines the expected interface of a module holding
+// the community pool in place of x/distribution, such as x/protocolpool (noalias)
+type ExternalCommunityPoolKeeper interface {
+	// GetCommunityPoolModule returns the name of the module account receiving
+	// the community pool share of the collected fees and rewards
+	GetCommunityPoolModule() string
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	DistributeFromCommunityPool(ctx context.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
+}
+
 // StakingHooks event hooks for staking validator object (noalias)
 type StakingHooks interface {
 	AfterValidatorCreated(ctx context.Context, valAddr sdk.ValAddress) error // Must be called when a validator is created
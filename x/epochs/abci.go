@@ -0,0 +1,80 @@
+package epochs
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// BeginBlocker starts the epochs whose start time is reached, and the next
+// epochs of the epochs whose duration elapsed. An epoch advances by at most one
+// epoch per block, so that the epochs missed during a chain halt are caught up
+// block by block.
+func BeginBlocker(ctx context.Context, k *keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyBeginBlocker)
+
+	epochs, err := k.AllEpochInfos(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+	for _, epoch := range epochs {
+		// the first epoch starts once the start time is reached
+		shouldInitialEpochStart := !epoch.EpochCountingStarted && !epoch.StartTime.After(blockTime)
+
+		epochEndTime := epoch.CurrentEpochStartTime.Add(epoch.Duration)
+		shouldEpochStart := epoch.EpochCountingStarted && !blockTime.Before(epochEndTime)
+
+		if !shouldInitialEpochStart && !shouldEpochStart {
+			continue
+		}
+
+		if shouldInitialEpochStart {
+			epoch.EpochCountingStarted = true
+			epoch.CurrentEpoch = 1
+			epoch.CurrentEpochStartTime = epoch.StartTime
+		} else {
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeEpochEnd,
+					sdk.NewAttribute(types.AttributeKeyIdentifier, epoch.Identifier),
+					sdk.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatInt(epoch.CurrentEpoch, 10)),
+				),
+			)
+
+			if err := k.Hooks().AfterEpochEnd(ctx, epoch.Identifier, epoch.CurrentEpoch); err != nil {
+				return err
+			}
+
+			// epochs remain aligned on the start time
+			epoch.CurrentEpoch++
+			epoch.CurrentEpochStartTime = epochEndTime
+		}
+
+		epoch.CurrentEpochStartHeight = sdkCtx.BlockHeight()
+		if err := k.EpochInfo.Set(ctx, epoch.Identifier, epoch); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEpochStart,
+				sdk.NewAttribute(types.AttributeKeyIdentifier, epoch.Identifier),
+				sdk.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatInt(epoch.CurrentEpoch, 10)),
+				sdk.NewAttribute(types.AttributeKeyEpochStartTime, strconv.FormatInt(epoch.CurrentEpochStartTime.Unix(), 10)),
+			),
+		)
+
+		if err := k.Hooks().BeforeEpochStart(ctx, epoch.Identifier, epoch.CurrentEpoch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package epochs_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+	"github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type hookCall struct {
+	end         bool
+	identifier  string
+	epochNumber int64
+}
+
+// recordingHooks records the calls to the epochs hooks.
+type recordingHooks struct {
+	calls *[]hookCall
+}
+
+func (h recordingHooks) AfterEpochEnd(_ context.Context, identifier string, epochNumber int64) error {
+	*h.calls = append(*h.calls, hookCall{end: true, identifier: identifier, epochNumber: epochNumber})
+	return nil
+}
+
+func (h recordingHooks) BeforeEpochStart(_ context.Context, identifier string, epochNumber int64) error {
+	*h.calls = append(*h.calls, hookCall{identifier: identifier, epochNumber: epochNumber})
+	return nil
+}
+
+func TestBeginBlocker(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(epochs.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	var calls []hookCall
+	k.SetHooks(types.NewMultiEpochHooks(recordingHooks{calls: &calls}))
+
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1, Time: startTime.Add(-time.Second)})
+	epoch := types.NewGenesisEpochInfo("hour", time.Hour)
+	epoch.StartTime = startTime
+	require.NoError(t, k.AddEpochInfo(ctx, epoch))
+
+	// the first epoch does not start before the start time
+	require.NoError(t, epochs.BeginBlocker(ctx, k))
+	require.Empty(t, calls)
+
+	// the first epoch starts at the start time
+	ctx = ctx.WithBlockHeader(cmtproto.Header{Height: 2, Time: startTime.Add(time.Minute)})
+	require.NoError(t, epochs.BeginBlocker(ctx, k))
+	require.Equal(t, []hookCall{{identifier: "hour", epochNumber: 1}}, calls)
+
+	epoch, err := k.GetEpochInfo(ctx, "hour")
+	require.NoError(t, err)
+	require.True(t, epoch.EpochCountingStarted)
+	require.Equal(t, int64(1), epoch.CurrentEpoch)
+	require.Equal(t, startTime, epoch.CurrentEpochStartTime)
+	require.Equal(t, int64(2), epoch.CurrentEpochStartHeight)
+
+	// the epoch ends once its duration elapsed
+	calls = nil
+	ctx = ctx.WithBlockHeader(cmtproto.Header{Height: 3, Time: startTime.Add(time.Hour - time.Second)})
+	require.NoError(t, epochs.BeginBlocker(ctx, k))
+	require.Empty(t, calls)
+
+	ctx = ctx.WithBlockHeader(cmtproto.Header{Height: 4, Time: startTime.Add(3 * time.Hour)})
+	require.NoError(t, epochs.BeginBlocker(ctx, k))
+	require.Equal(t, []hookCall{{end: true, identifier: "hour", epochNumber: 1}, {identifier: "hour", epochNumber: 2}}, calls)
+
+	// missed epochs are caught up block by block, aligned on the start time
+	epoch, err = k.GetEpochInfo(ctx, "hour")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), epoch.CurrentEpoch)
+	require.Equal(t, startTime.Add(time.Hour), epoch.CurrentEpochStartTime)
+
+	ctx = ctx.WithBlockHeader(cmtproto.Header{Height: 5, Time: startTime.Add(3 * time.Hour)})
+	require.NoError(t, epochs.BeginBlocker(ctx, k))
+	epoch, err = k.GetEpochInfo(ctx, "hour")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), epoch.CurrentEpoch)
+	require.Equal(t, int64(5), epoch.CurrentEpochStartHeight)
+
+	var started []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeEpochStart {
+			started = append(started, event)
+		}
+	}
+	require.Len(t, started, 3)
+}
@@ -0,0 +1,40 @@
+package epochs
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "cosmos.epochs.v1beta1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "EpochInfos",
+					Use:       "epoch-infos",
+					Short:     "Query the running epochs",
+				},
+				{
+					RpcMethod:      "CurrentEpoch",
+					Use:            "current-epoch [identifier]",
+					Short:          "Query the current epoch number of an epoch",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "identifier"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "cosmos.epochs.v1beta1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "CreateEpoch",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "DeleteEpoch",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
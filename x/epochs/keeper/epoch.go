@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// GetEpochInfo returns the epoch with the given identifier.
+func (k *Keeper) GetEpochInfo(ctx context.Context, identifier string) (types.EpochInfo, error) {
+	epoch, err := k.EpochInfo.Get(ctx, identifier)
+	if errors.Is(err, collections.ErrNotFound) {
+		return epoch, errorsmod.Wrapf(types.ErrEpochNotFound, "epoch identifier %s", identifier)
+	}
+
+	return epoch, err
+}
+
+// AddEpochInfo adds a new epoch. The first epoch starts at the current block
+// when no start time is set.
+func (k *Keeper) AddEpochInfo(ctx context.Context, epoch types.EpochInfo) error {
+	if err := epoch.Validate(); err != nil {
+		return err
+	}
+
+	has, err := k.EpochInfo.Has(ctx, epoch.Identifier)
+	if err != nil {
+		return err
+	}
+
+	if has {
+		return errorsmod.Wrapf(types.ErrDuplicateEpoch, "epoch identifier %s", epoch.Identifier)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if epoch.StartTime.IsZero() {
+		epoch.StartTime = sdkCtx.BlockTime()
+	}
+
+	if !epoch.EpochCountingStarted {
+		epoch.CurrentEpochStartHeight = sdkCtx.BlockHeight()
+	}
+
+	return k.EpochInfo.Set(ctx, epoch.Identifier, epoch)
+}
+
+// DeleteEpochInfo deletes the epoch with the given identifier.
+func (k *Keeper) DeleteEpochInfo(ctx context.Context, identifier string) error {
+	has, err := k.EpochInfo.Has(ctx, identifier)
+	if err != nil {
+		return err
+	}
+
+	if !has {
+		return errorsmod.Wrapf(types.ErrEpochNotFound, "epoch identifier %s", identifier)
+	}
+
+	return k.EpochInfo.Remove(ctx, identifier)
+}
+
+// AllEpochInfos returns all the epochs, ordered by identifier.
+func (k *Keeper) AllEpochInfos(ctx context.Context) ([]types.EpochInfo, error) {
+	var epochs []types.EpochInfo
+	err := k.EpochInfo.Walk(ctx, nil, func(_ string, epoch types.EpochInfo) (bool, error) {
+		epochs = append(epochs, epoch)
+		return false, nil
+	})
+
+	return epochs, err
+}
+
+// NumBlocksSinceEpochStart returns the number of blocks since the start of the
+// current epoch with the given identifier.
+func (k *Keeper) NumBlocksSinceEpochStart(ctx context.Context, identifier string) (int64, error) {
+	epoch, err := k.GetEpochInfo(ctx, identifier)
+	if err != nil {
+		return 0, err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).BlockHeight() - epoch.CurrentEpochStartHeight, nil
+}
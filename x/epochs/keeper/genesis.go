@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// InitGenesis sets the epochs of the genesis state.
+func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	for _, epoch := range data.Epochs {
+		if err := k.AddEpochInfo(ctx, epoch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	epochs, err := k.AllEpochInfos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(epochs), nil
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/epochs QueryServer interface.
+func NewQueryServerImpl(k *Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k *Keeper
+}
+
+// EpochInfos returns all the epochs.
+func (q queryServer) EpochInfos(ctx context.Context, _ *types.QueryEpochInfosRequest) (*types.QueryEpochInfosResponse, error) {
+	epochs, err := q.k.AllEpochInfos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEpochInfosResponse{Epochs: epochs}, nil
+}
+
+// CurrentEpoch returns the current epoch number of the given epoch.
+func (q queryServer) CurrentEpoch(ctx context.Context, req *types.QueryCurrentEpochRequest) (*types.QueryCurrentEpochResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.Identifier) == "" {
+		return nil, status.Error(codes.InvalidArgument, "epoch identifier cannot be empty")
+	}
+
+	epoch, err := q.k.GetEpochInfo(ctx, req.Identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCurrentEpochResponse{CurrentEpoch: epoch.CurrentEpoch}, nil
+}
@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+func (s *KeeperTestSuite) TestQueryEpochInfos() {
+	ctx, k, queryServer := s.ctx, s.epochsKeeper, s.queryServer
+	require := s.Require()
+
+	require.NoError(k.InitGenesis(ctx, types.DefaultGenesis()))
+
+	res, err := queryServer.EpochInfos(ctx, &types.QueryEpochInfosRequest{})
+	require.NoError(err)
+	require.Len(res.Epochs, 4)
+	require.Equal("day", res.Epochs[0].Identifier)
+	require.Equal(24*time.Hour, res.Epochs[0].Duration)
+}
+
+func (s *KeeperTestSuite) TestQueryCurrentEpoch() {
+	ctx, k, queryServer := s.ctx, s.epochsKeeper, s.queryServer
+	require := s.Require()
+
+	epoch := types.NewGenesisEpochInfo("day", 24*time.Hour)
+	epoch.CurrentEpoch = 3
+	epoch.EpochCountingStarted = true
+	require.NoError(k.AddEpochInfo(ctx, epoch))
+
+	testCases := []struct {
+		name      string
+		req       *types.QueryCurrentEpochRequest
+		expErrMsg string
+		expEpoch  int64
+	}{
+		{
+			name:      "empty request",
+			expErrMsg: "empty request",
+		},
+		{
+			name:      "empty identifier",
+			req:       &types.QueryCurrentEpochRequest{},
+			expErrMsg: "epoch identifier cannot be empty",
+		},
+		{
+			name:      "unknown identifier",
+			req:       &types.QueryCurrentEpochRequest{Identifier: "week"},
+			expErrMsg: types.ErrEpochNotFound.Error(),
+		},
+		{
+			name:     "success",
+			req:      &types.QueryCurrentEpochRequest{Identifier: "day"},
+			expEpoch: 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := queryServer.CurrentEpoch(ctx, tc.req)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(err, tc.expErrMsg)
+				return
+			}
+
+			require.NoError(err)
+			require.Equal(tc.expEpoch, res.CurrentEpoch)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// Keeper of the epochs store
+type Keeper struct {
+	storeService storetypes.KVStoreService
+	cdc          codec.BinaryCodec
+	hooks        types.EpochHooks
+
+	// the address capable of executing a MsgCreateEpoch or a MsgDeleteEpoch
+	// message. Typically, this should be the x/gov module account.
+	authority string
+
+	Schema    collections.Schema
+	EpochInfo collections.Map[string, types.EpochInfo]
+}
+
+// NewKeeper creates a new epochs Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	authority string,
+) *Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := &Keeper{
+		storeService: storeService,
+		cdc:          cdc,
+		authority:    authority,
+		EpochInfo:    collections.NewMap(sb, types.EpochInfoKey, "epoch_info", collections.StringKey, codec.CollValue[types.EpochInfo](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// SetHooks sets the epochs hooks.
+func (k *Keeper) SetHooks(eh types.EpochHooks) {
+	if k.hooks != nil {
+		panic("cannot set epochs hooks twice")
+	}
+
+	k.hooks = eh
+}
+
+// Hooks gets the hooks for epochs keeper.
+func (k *Keeper) Hooks() types.EpochHooks {
+	if k.hooks == nil {
+		// return a no-op implementation if no hooks are set
+		return types.MultiEpochHooks{}
+	}
+
+	return k.hooks
+}
+
+// GetAuthority returns the x/epochs module's authority.
+func (k *Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k *Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+	"github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx          sdk.Context
+	epochsKeeper *keeper.Keeper
+	queryServer  types.QueryServer
+	msgServer    types.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(epochs.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 10, Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	s.epochsKeeper = keeper.NewKeeper(encCfg.Codec, storeService, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	s.queryServer = keeper.NewQueryServerImpl(s.epochsKeeper)
+	s.msgServer = keeper.NewMsgServerImpl(s.epochsKeeper)
+}
+
+func (s *KeeperTestSuite) TestEpochInfo() {
+	ctx, k := s.ctx, s.epochsKeeper
+	require := s.Require()
+
+	// the start time defaults to the current block time
+	require.NoError(k.AddEpochInfo(ctx, types.NewGenesisEpochInfo("day", 24*time.Hour)))
+	epoch, err := k.GetEpochInfo(ctx, "day")
+	require.NoError(err)
+	require.Equal(ctx.BlockTime(), epoch.StartTime)
+	require.Equal(ctx.BlockHeight(), epoch.CurrentEpochStartHeight)
+	require.False(epoch.EpochCountingStarted)
+
+	err = k.AddEpochInfo(ctx, types.NewGenesisEpochInfo("day", time.Hour))
+	require.ErrorIs(err, types.ErrDuplicateEpoch)
+
+	err = k.AddEpochInfo(ctx, types.NewGenesisEpochInfo("hour", 0))
+	require.ErrorIs(err, types.ErrInvalidEpoch)
+
+	require.NoError(k.AddEpochInfo(ctx, types.NewGenesisEpochInfo("hour", time.Hour)))
+	epochs, err := k.AllEpochInfos(ctx)
+	require.NoError(err)
+	require.Len(epochs, 2)
+	require.Equal("day", epochs[0].Identifier)
+	require.Equal("hour", epochs[1].Identifier)
+
+	require.NoError(k.DeleteEpochInfo(ctx, "day"))
+	_, err = k.GetEpochInfo(ctx, "day")
+	require.ErrorIs(err, types.ErrEpochNotFound)
+	require.ErrorIs(k.DeleteEpochInfo(ctx, "day"), types.ErrEpochNotFound)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	ctx, k := s.ctx, s.epochsKeeper
+	require := s.Require()
+
+	genesis := types.DefaultGenesis()
+	require.NoError(k.InitGenesis(ctx, genesis))
+
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Len(exported.Epochs, len(genesis.Epochs))
+	for _, epoch := range exported.Epochs {
+		require.Equal(ctx.BlockTime(), epoch.StartTime)
+		require.False(epoch.EpochCountingStarted)
+	}
+
+	// a started epoch is imported as is
+	started := types.EpochInfo{
+		Identifier:              "started",
+		StartTime:               ctx.BlockTime().Add(-time.Hour),
+		Duration:                time.Hour,
+		CurrentEpoch:            2,
+		CurrentEpochStartTime:   ctx.BlockTime(),
+		EpochCountingStarted:    true,
+		CurrentEpochStartHeight: 5,
+	}
+	require.NoError(k.InitGenesis(ctx, types.NewGenesisState([]types.EpochInfo{started})))
+	epoch, err := k.GetEpochInfo(ctx, "started")
+	require.NoError(err)
+	require.Equal(started, epoch)
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/epochs MsgServer interface.
+func NewMsgServerImpl(k *Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// CreateEpoch creates a new epoch.
+func (ms msgServer) CreateEpoch(ctx context.Context, msg *types.MsgCreateEpoch) (*types.MsgCreateEpochResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	epoch := types.EpochInfo{
+		Identifier: msg.Identifier,
+		StartTime:  msg.StartTime,
+		Duration:   msg.Duration,
+	}
+	if err := ms.AddEpochInfo(ctx, epoch); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateEpochResponse{}, nil
+}
+
+// DeleteEpoch deletes an epoch.
+func (ms msgServer) DeleteEpoch(ctx context.Context, msg *types.MsgDeleteEpoch) (*types.MsgDeleteEpochResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := ms.DeleteEpochInfo(ctx, msg.Identifier); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteEpochResponse{}, nil
+}
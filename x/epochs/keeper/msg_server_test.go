@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+func (s *KeeperTestSuite) TestCreateAndDeleteEpoch() {
+	ctx, k, msgServer := s.ctx, s.epochsKeeper, s.msgServer
+	require := s.Require()
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCreateEpoch
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			msg:       &types.MsgCreateEpoch{Authority: "invalid", Identifier: "day", Duration: 24 * time.Hour},
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:      "blank identifier",
+			msg:       &types.MsgCreateEpoch{Authority: k.GetAuthority(), Identifier: " ", Duration: 24 * time.Hour},
+			expErrMsg: "epoch identifier cannot be blank",
+		},
+		{
+			name:      "non positive duration",
+			msg:       &types.MsgCreateEpoch{Authority: k.GetAuthority(), Identifier: "day"},
+			expErrMsg: "duration must be positive",
+		},
+		{
+			name: "success",
+			msg:  &types.MsgCreateEpoch{Authority: k.GetAuthority(), Identifier: "day", Duration: 24 * time.Hour},
+		},
+		{
+			name:      "duplicate identifier",
+			msg:       &types.MsgCreateEpoch{Authority: k.GetAuthority(), Identifier: "day", Duration: time.Hour},
+			expErrMsg: types.ErrDuplicateEpoch.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := msgServer.CreateEpoch(ctx, tc.msg)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(err, tc.expErrMsg)
+				return
+			}
+
+			require.NoError(err)
+		})
+	}
+
+	epoch, err := k.GetEpochInfo(ctx, "day")
+	require.NoError(err)
+	require.Equal(24*time.Hour, epoch.Duration)
+
+	_, err = msgServer.DeleteEpoch(ctx, &types.MsgDeleteEpoch{Authority: "invalid", Identifier: "day"})
+	require.ErrorContains(err, "invalid authority")
+
+	_, err = msgServer.DeleteEpoch(ctx, &types.MsgDeleteEpoch{Authority: k.GetAuthority(), Identifier: "day"})
+	require.NoError(err)
+
+	_, err = msgServer.DeleteEpoch(ctx, &types.MsgDeleteEpoch{Authority: k.GetAuthority(), Identifier: "day"})
+	require.ErrorIs(err, types.ErrEpochNotFound)
+}
@@ -0,0 +1,30 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// Simulation parameter constants
+const (
+	EpochDuration = "epoch_duration"
+)
+
+// GenEpochDuration randomized epoch duration, between one minute and a day
+func GenEpochDuration(r *rand.Rand) time.Duration {
+	return time.Duration(1+r.Intn(24*60)) * time.Minute
+}
+
+// RandomizedGenState generates a random GenesisState for epochs
+func RandomizedGenState(simState *module.SimulationState) {
+	var duration time.Duration
+	simState.AppParams.GetOrGenerate(EpochDuration, &duration, simState.Rand, func(r *rand.Rand) { duration = GenEpochDuration(r) })
+
+	genesis := types.DefaultGenesis()
+	genesis.Epochs = append(genesis.Epochs, types.NewGenesisEpochInfo("simulation", duration))
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/epochs module sentinel errors
+var (
+	ErrInvalidEpoch   = errors.Register(ModuleName, 2, "invalid epoch")
+	ErrDuplicateEpoch = errors.Register(ModuleName, 3, "duplicate epoch")
+	ErrEpochNotFound  = errors.Register(ModuleName, 4, "epoch not found")
+)
@@ -0,0 +1,11 @@
+package types
+
+// epochs module event types
+const (
+	EventTypeEpochEnd   = "epoch_end"
+	EventTypeEpochStart = "epoch_start"
+
+	AttributeKeyIdentifier     = "identifier"
+	AttributeKeyEpochNumber    = "epoch_number"
+	AttributeKeyEpochStartTime = "start_time"
+)
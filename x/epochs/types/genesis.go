@@ -0,0 +1,73 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewGenesisState creates a new genesis state for the epochs module.
+func NewGenesisState(epochs []EpochInfo) *GenesisState {
+	return &GenesisState{Epochs: epochs}
+}
+
+// DefaultGenesis returns the default genesis state, with a minute, an hour, a
+// day and a week epochs.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState([]EpochInfo{
+		NewGenesisEpochInfo("minute", time.Minute),
+		NewGenesisEpochInfo("hour", time.Hour),
+		NewGenesisEpochInfo("day", 24*time.Hour),
+		NewGenesisEpochInfo("week", 7*24*time.Hour),
+	})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	identifiers := make(map[string]bool, len(gs.Epochs))
+	for _, epoch := range gs.Epochs {
+		if identifiers[epoch.Identifier] {
+			return errorsmod.Wrapf(ErrDuplicateEpoch, "epoch identifier %s", epoch.Identifier)
+		}
+
+		if err := epoch.Validate(); err != nil {
+			return err
+		}
+
+		identifiers[epoch.Identifier] = true
+	}
+
+	return nil
+}
+
+// NewGenesisEpochInfo returns an epoch starting at genesis, with the given
+// identifier and duration.
+func NewGenesisEpochInfo(identifier string, duration time.Duration) EpochInfo {
+	return EpochInfo{
+		Identifier: identifier,
+		Duration:   duration,
+	}
+}
+
+// Validate performs basic validation of an epoch.
+func (epoch EpochInfo) Validate() error {
+	if strings.TrimSpace(epoch.Identifier) == "" {
+		return errorsmod.Wrap(ErrInvalidEpoch, "epoch identifier cannot be blank")
+	}
+
+	if epoch.Duration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidEpoch, "epoch %s duration must be positive", epoch.Identifier)
+	}
+
+	if epoch.CurrentEpoch < 0 {
+		return errorsmod.Wrapf(ErrInvalidEpoch, "epoch %s current epoch cannot be negative", epoch.Identifier)
+	}
+
+	if epoch.CurrentEpochStartHeight < 0 {
+		return errorsmod.Wrapf(ErrInvalidEpoch, "epoch %s current epoch start height cannot be negative", epoch.Identifier)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genesis  *types.GenesisState
+		expError error
+	}{
+		{
+			name:    "default genesis",
+			genesis: types.DefaultGenesis(),
+		},
+		{
+			name:    "empty genesis",
+			genesis: types.NewGenesisState(nil),
+		},
+		{
+			name: "duplicate identifier",
+			genesis: types.NewGenesisState([]types.EpochInfo{
+				types.NewGenesisEpochInfo("day", 24*time.Hour),
+				types.NewGenesisEpochInfo("day", time.Hour),
+			}),
+			expError: types.ErrDuplicateEpoch,
+		},
+		{
+			name:     "blank identifier",
+			genesis:  types.NewGenesisState([]types.EpochInfo{types.NewGenesisEpochInfo("", time.Hour)}),
+			expError: types.ErrInvalidEpoch,
+		},
+		{
+			name:     "negative duration",
+			genesis:  types.NewGenesisState([]types.EpochInfo{types.NewGenesisEpochInfo("day", -time.Hour)}),
+			expError: types.ErrInvalidEpoch,
+		},
+		{
+			name: "negative current epoch",
+			genesis: types.NewGenesisState([]types.EpochInfo{{
+				Identifier:   "day",
+				Duration:     24 * time.Hour,
+				CurrentEpoch: -1,
+			}}),
+			expError: types.ErrInvalidEpoch,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.expError != nil {
+				require.ErrorIs(t, err, tc.expError)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package types
+
+import "context"
+
+// EpochHooks event hooks for the epochs module
+type EpochHooks interface {
+	// AfterEpochEnd is called when an epoch ends, with the number of the ending epoch
+	AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error
+	// BeforeEpochStart is called when an epoch starts, with the number of the starting epoch
+	BeforeEpochStart(ctx context.Context, epochIdentifier string, epochNumber int64) error
+}
+
+// EpochHooksWrapper is a wrapper for modules to inject EpochHooks using depinject.
+type EpochHooksWrapper struct{ EpochHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (EpochHooksWrapper) IsOnePerModuleType() {}
+
+// combine multiple epoch hooks, all hook functions are run in array sequence
+var _ EpochHooks = MultiEpochHooks{}
+
+type MultiEpochHooks []EpochHooks
+
+func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
+	return hooks
+}
+
+func (h MultiEpochHooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	for i := range h {
+		if err := h[i].AfterEpochEnd(ctx, epochIdentifier, epochNumber); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiEpochHooks) BeforeEpochStart(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	for i := range h {
+		if err := h[i].BeforeEpochStart(ctx, epochIdentifier, epochNumber); err != nil {
+			return err
+		}
+	}
+	return nil
+}
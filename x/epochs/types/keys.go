@@ -0,0 +1,14 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "epochs"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+// EpochInfoKey is the prefix of the epochs, by identifier
+var EpochInfoKey = collections.NewPrefix(1)
@@ -0,0 +1,1097 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/epochs/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgCreateEpoch is the Msg/CreateEpoch request type.
+type MsgCreateEpoch struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// identifier is the unique name of the epoch.
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// start_time is the time at which the first epoch starts. The first epoch
+	// starts at the next block when it is not set.
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// duration is the time between the starts of two epochs.
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration"`
+}
+
+func (m *MsgCreateEpoch) Reset()         { *m = MsgCreateEpoch{} }
+func (m *MsgCreateEpoch) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateEpoch) ProtoMessage()    {}
+func (*MsgCreateEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9df40940234702e, []int{0}
+}
+func (m *MsgCreateEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateEpoch.Merge(m, src)
+}
+func (m *MsgCreateEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateEpoch proto.InternalMessageInfo
+
+func (m *MsgCreateEpoch) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCreateEpoch) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *MsgCreateEpoch) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgCreateEpoch) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// MsgCreateEpochResponse defines the response structure for executing a
+// MsgCreateEpoch message.
+type MsgCreateEpochResponse struct {
+}
+
+func (m *MsgCreateEpochResponse) Reset()         { *m = MsgCreateEpochResponse{} }
+func (m *MsgCreateEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateEpochResponse) ProtoMessage()    {}
+func (*MsgCreateEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9df40940234702e, []int{1}
+}
+func (m *MsgCreateEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateEpochResponse.Merge(m, src)
+}
+func (m *MsgCreateEpochResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateEpochResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateEpochResponse proto.InternalMessageInfo
+
+// MsgDeleteEpoch is the Msg/DeleteEpoch request type.
+type MsgDeleteEpoch struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// identifier is the unique name of the epoch.
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *MsgDeleteEpoch) Reset()         { *m = MsgDeleteEpoch{} }
+func (m *MsgDeleteEpoch) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteEpoch) ProtoMessage()    {}
+func (*MsgDeleteEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9df40940234702e, []int{2}
+}
+func (m *MsgDeleteEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteEpoch.Merge(m, src)
+}
+func (m *MsgDeleteEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteEpoch proto.InternalMessageInfo
+
+func (m *MsgDeleteEpoch) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgDeleteEpoch) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+// MsgDeleteEpochResponse defines the response structure for executing a
+// MsgDeleteEpoch message.
+type MsgDeleteEpochResponse struct {
+}
+
+func (m *MsgDeleteEpochResponse) Reset()         { *m = MsgDeleteEpochResponse{} }
+func (m *MsgDeleteEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteEpochResponse) ProtoMessage()    {}
+func (*MsgDeleteEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9df40940234702e, []int{3}
+}
+func (m *MsgDeleteEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteEpochResponse.Merge(m, src)
+}
+func (m *MsgDeleteEpochResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteEpochResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteEpochResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateEpoch)(nil), "cosmos.epochs.v1beta1.MsgCreateEpoch")
+	proto.RegisterType((*MsgCreateEpochResponse)(nil), "cosmos.epochs.v1beta1.MsgCreateEpochResponse")
+	proto.RegisterType((*MsgDeleteEpoch)(nil), "cosmos.epochs.v1beta1.MsgDeleteEpoch")
+	proto.RegisterType((*MsgDeleteEpochResponse)(nil), "cosmos.epochs.v1beta1.MsgDeleteEpochResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/epochs/v1beta1/tx.proto", fileDescriptor_a9df40940234702e) }
+
+var fileDescriptor_a9df40940234702e = []byte{
+	// 474 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xbf, 0x6f, 0x13, 0x31,
+	0x14, 0xc7, 0xe3, 0x16, 0x10, 0x71, 0x05, 0x12, 0xa7, 0x02, 0xd7, 0x1b, 0x9c, 0x2a, 0x12, 0x52,
+	0x15, 0x14, 0x5b, 0x29, 0x82, 0x81, 0x8d, 0x90, 0x4a, 0x2c, 0x5d, 0x0e, 0x26, 0x96, 0xea, 0x7e,
+	0xb8, 0x8e, 0x45, 0xef, 0x7c, 0xb2, 0x7d, 0x55, 0xbb, 0x21, 0x46, 0xa6, 0x8e, 0x6c, 0xac, 0x6c,
+	0x64, 0xe0, 0x8f, 0xe8, 0x58, 0x31, 0x20, 0x26, 0x40, 0xc9, 0x90, 0x7f, 0x03, 0xf9, 0xec, 0x6b,
+	0x2f, 0x50, 0xa1, 0x30, 0x74, 0x49, 0xee, 0xde, 0xf7, 0xfb, 0xde, 0xfb, 0xf8, 0xf9, 0x1d, 0x44,
+	0x89, 0x50, 0x99, 0x50, 0x84, 0x16, 0x22, 0x19, 0x2b, 0x72, 0x38, 0x88, 0xa9, 0x8e, 0x06, 0x44,
+	0x1f, 0xe1, 0x42, 0x0a, 0x2d, 0xbc, 0xbb, 0x56, 0xc7, 0x56, 0xc7, 0x4e, 0x0f, 0xd6, 0x99, 0x60,
+	0xa2, 0x72, 0x10, 0xf3, 0x64, 0xcd, 0xc1, 0x9d, 0x28, 0xe3, 0xb9, 0x20, 0xd5, 0xaf, 0x0b, 0x6d,
+	0xd8, 0xfc, 0x3d, 0xeb, 0x75, 0xc5, 0xac, 0x74, 0xdf, 0xb5, 0xce, 0x14, 0x23, 0x87, 0x03, 0xf3,
+	0xe7, 0x04, 0xc4, 0x84, 0x60, 0x07, 0x94, 0x54, 0x6f, 0x71, 0xb9, 0x4f, 0xd2, 0x52, 0x46, 0x9a,
+	0x8b, 0xdc, 0xe9, 0x9d, 0x3f, 0x75, 0xcd, 0x33, 0xaa, 0x74, 0x94, 0x15, 0xd6, 0xd0, 0xfd, 0xbc,
+	0x02, 0x6f, 0xef, 0x2a, 0xf6, 0x5c, 0xd2, 0x48, 0xd3, 0x1d, 0x43, 0xee, 0x3d, 0x81, 0xed, 0xa8,
+	0xd4, 0x63, 0x21, 0xb9, 0x3e, 0xf6, 0xc1, 0x26, 0xd8, 0x6a, 0x0f, 0xfd, 0xaf, 0x5f, 0xfa, 0xeb,
+	0x8e, 0xe8, 0x59, 0x9a, 0x4a, 0xaa, 0xd4, 0x4b, 0x2d, 0x79, 0xce, 0xc2, 0x0b, 0xab, 0x87, 0x20,
+	0xe4, 0x29, 0xcd, 0x35, 0xdf, 0xe7, 0x54, 0xfa, 0x2b, 0x26, 0x31, 0x6c, 0x44, 0xbc, 0x17, 0x10,
+	0x2a, 0x1d, 0x49, 0xbd, 0x67, 0x18, 0xfc, 0xd5, 0x4d, 0xb0, 0xb5, 0xb6, 0x1d, 0x60, 0x0b, 0x88,
+	0x6b, 0x40, 0xfc, 0xaa, 0x06, 0x1c, 0xde, 0x3a, 0xfd, 0xd1, 0x69, 0x9d, 0xfc, 0xec, 0x80, 0x4f,
+	0xf3, 0x49, 0x0f, 0x84, 0xed, 0x2a, 0xd9, 0xc8, 0xde, 0x08, 0xde, 0xac, 0xcf, 0xe9, 0x5f, 0xab,
+	0xea, 0x6c, 0xfc, 0x55, 0x67, 0xe4, 0x0c, 0xb6, 0xcc, 0x87, 0xf3, 0x32, 0xe7, 0x99, 0x4f, 0x1f,
+	0xbf, 0x9b, 0x4f, 0x7a, 0x17, 0xfc, 0xef, 0xe7, 0x93, 0x5e, 0xd7, 0x9e, 0xb1, 0xaf, 0xd2, 0x37,
+	0xe4, 0xa8, 0xbe, 0xe8, 0xc5, 0xf1, 0x74, 0x7d, 0x78, 0x6f, 0x31, 0x12, 0x52, 0x55, 0x88, 0x5c,
+	0xd1, 0xee, 0x47, 0x50, 0xcd, 0x72, 0x44, 0x0f, 0xe8, 0x15, 0xcf, 0xf2, 0x7f, 0xd8, 0x1b, 0x38,
+	0x8e, 0xbd, 0x11, 0xa9, 0xd9, 0xb7, 0xbf, 0x01, 0xb8, 0xba, 0xab, 0x98, 0x97, 0xc0, 0xb5, 0xe6,
+	0x2e, 0x3c, 0xc0, 0x97, 0x2e, 0x35, 0x5e, 0x9c, 0x40, 0xd0, 0x5f, 0xca, 0x56, 0x37, 0x33, 0x4d,
+	0x9a, 0x43, 0xfa, 0x47, 0x93, 0x86, 0x2d, 0xe8, 0x2f, 0x65, 0xab, 0x9b, 0x04, 0xd7, 0xdf, 0x9a,
+	0xfb, 0x1e, 0xee, 0x9c, 0x4e, 0x11, 0x38, 0x9b, 0x22, 0xf0, 0x6b, 0x8a, 0xc0, 0xc9, 0x0c, 0xb5,
+	0xce, 0x66, 0xa8, 0xf5, 0x7d, 0x86, 0x5a, 0xaf, 0x1f, 0x32, 0xae, 0xc7, 0x65, 0x8c, 0x13, 0x91,
+	0xb9, 0xaf, 0x8d, 0x5c, 0x36, 0x42, 0x7d, 0x5c, 0x50, 0x15, 0xdf, 0xa8, 0x16, 0xeb, 0xd1, 0xef,
+	0x01, 0x00, 0x97, 0xb5, 0x96, 0x91, 0x05, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateEpoch defines a governance operation for creating a new epoch.
+	// The authority is defined in the keeper.
+	CreateEpoch(ctx context.Context, in *MsgCreateEpoch, opts ...grpc.CallOption) (*MsgCreateEpochResponse, error)
+	// DeleteEpoch defines a governance operation for deleting an epoch.
+	// The authority is defined in the keeper.
+	DeleteEpoch(ctx context.Context, in *MsgDeleteEpoch, opts ...grpc.CallOption) (*MsgDeleteEpochResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateEpoch(ctx context.Context, in *MsgCreateEpoch, opts ...grpc.CallOption) (*MsgCreateEpochResponse, error) {
+	out := new(MsgCreateEpochResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.epochs.v1beta1.Msg/CreateEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteEpoch(ctx context.Context, in *MsgDeleteEpoch, opts ...grpc.CallOption) (*MsgDeleteEpochResponse, error) {
+	out := new(MsgDeleteEpochResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.epochs.v1beta1.Msg/DeleteEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateEpoch defines a governance operation for creating a new epoch.
+	// The authority is defined in the keeper.
+	CreateEpoch(context.Context, *MsgCreateEpoch) (*MsgCreateEpochResponse, error)
+	// DeleteEpoch defines a governance operation for deleting an epoch.
+	// The authority is defined in the keeper.
+	DeleteEpoch(context.Context, *MsgDeleteEpoch) (*MsgDeleteEpochResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateEpoch(ctx context.Context, req *MsgCreateEpoch) (*MsgCreateEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEpoch not implemented")
+}
+func (*UnimplementedMsgServer) DeleteEpoch(ctx context.Context, req *MsgDeleteEpoch) (*MsgDeleteEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEpoch not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.epochs.v1beta1.Msg/CreateEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateEpoch(ctx, req.(*MsgCreateEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.epochs.v1beta1.Msg/DeleteEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteEpoch(ctx, req.(*MsgDeleteEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.epochs.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateEpoch",
+			Handler:    _Msg_CreateEpoch_Handler,
+		},
+		{
+			MethodName: "DeleteEpoch",
+			Handler:    _Msg_DeleteEpoch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/epochs/v1beta1/tx.proto",
+}
+
+func (m *MsgCreateEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -53,6 +53,14 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 			logger.Error(fmt.Sprintf("ignore evidence; expected public key for validator %s not found", consAddr))
 			return nil
 		}
+
+		// the validator may have rotated its consensus key since the
+		// infraction, its signing info is tracked by its current one
+		valConsAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		consAddr = valConsAddr
 	}
 
 	// calculate the age of the evidence
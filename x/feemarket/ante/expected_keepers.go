@@ -0,0 +1,18 @@
+package ante
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// FeeMarketKeeper defines the expected x/feemarket keeper, providing the base
+// fee of the current block.
+type FeeMarketKeeper interface {
+	GetParams(ctx context.Context) (types.Params, error)
+	GetBaseFee(ctx context.Context) (math.LegacyDec, error)
+	DeductBaseFee(ctx context.Context, feePayer sdk.AccAddress, fee sdk.Coins) error
+}
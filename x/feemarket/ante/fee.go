@@ -0,0 +1,185 @@
+package ante
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// DeductFeeDecorator replaces the x/auth DeductFeeDecorator when the fee market
+// is enabled. It requires the fee to cover the base fee of the current block
+// times the gas limit of the transaction, in the fee denom. The base fee part
+// of the fee is burned, or sent to the fee recipient of the fee market, and the
+// rest is a tip sent to the fee collector. The priority of the transaction is
+// its tip per unit of gas.
+// When the fee market is disabled, the fees are deducted by the x/auth
+// DeductFeeDecorator.
+// CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
+type DeductFeeDecorator struct {
+	accountKeeper   authante.AccountKeeper
+	bankKeeper      authtypes.BankKeeper
+	feegrantKeeper  authante.FeegrantKeeper
+	feemarketKeeper FeeMarketKeeper
+
+	fallback authante.DeductFeeDecorator
+}
+
+// NewDeductFeeDecorator returns a new DeductFeeDecorator. The TxFeeChecker is
+// used by the x/auth DeductFeeDecorator when the fee market is disabled.
+func NewDeductFeeDecorator(ak authante.AccountKeeper, bk authtypes.BankKeeper, fk authante.FeegrantKeeper, fmk FeeMarketKeeper, tfc authante.TxFeeChecker) DeductFeeDecorator {
+	return DeductFeeDecorator{
+		accountKeeper:   ak,
+		bankKeeper:      bk,
+		feegrantKeeper:  fk,
+		feemarketKeeper: fmk,
+		fallback:        authante.NewDeductFeeDecorator(ak, bk, fk, tfc),
+	}
+}
+
+func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	params, err := dfd.feemarketKeeper.GetParams(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	if !params.Enabled {
+		return dfd.fallback.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if !simulate && ctx.BlockHeight() > 0 && feeTx.GetGas() == 0 {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidGasLimit, "must provide positive gas")
+	}
+
+	baseFee, err := dfd.feemarketKeeper.GetBaseFee(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	fee := feeTx.GetFee()
+	gas := feeTx.GetGas()
+	paid := fee.AmountOf(params.FeeDenom)
+	required := baseFee.MulInt(sdkmath.NewIntFromUint64(gas)).Ceil().TruncateInt()
+
+	if !simulate {
+		if !fee.Sub(sdk.NewCoin(params.FeeDenom, paid)).IsZero() {
+			return ctx, errorsmod.Wrapf(types.ErrInvalidFeeDenom, "fees must be paid in %s; got: %s", params.FeeDenom, fee)
+		}
+
+		if paid.LT(required) {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", fee, sdk.NewCoin(params.FeeDenom, required))
+		}
+
+		// the validator minimum gas price still applies to its own mempool
+		if ctx.IsCheckTx() {
+			minGasPrice := ctx.MinGasPrices().AmountOf(params.FeeDenom)
+			minFee := minGasPrice.MulInt(sdkmath.NewIntFromUint64(gas)).Ceil().TruncateInt()
+			if paid.LT(minFee) {
+				return ctx, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", fee, sdk.NewCoin(params.FeeDenom, minFee))
+			}
+		}
+	}
+
+	// the simulated fee may not cover the base fee
+	baseFeeAmount := sdkmath.MinInt(paid, required)
+	tip := fee.Sub(sdk.NewCoin(params.FeeDenom, baseFeeAmount))
+
+	if err := dfd.deductFees(ctx, tx, fee, sdk.NewCoins(sdk.NewCoin(params.FeeDenom, baseFeeAmount)), tip); err != nil {
+		return ctx, err
+	}
+
+	var priority int64
+	if !simulate {
+		priority = getTxPriority(tip.AmountOf(params.FeeDenom), gas)
+	}
+
+	newCtx := ctx.WithPriority(priority)
+
+	return next(newCtx, tx, simulate)
+}
+
+func (dfd DeductFeeDecorator) deductFees(ctx sdk.Context, sdkTx sdk.Tx, fee, baseFee, tip sdk.Coins) error {
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if addr := dfd.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName); addr == nil {
+		return fmt.Errorf("fee collector module account (%s) has not been set", authtypes.FeeCollectorName)
+	}
+
+	feePayer := feeTx.FeePayer()
+	feeGranter := feeTx.FeeGranter()
+	deductFeesFrom := feePayer
+
+	// if feegranter set deduct fee from feegranter account.
+	// this works with only when feegrant enabled.
+	if feeGranter != nil {
+		feeGranterAddr := sdk.AccAddress(feeGranter)
+
+		if dfd.feegrantKeeper == nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
+		} else if !bytes.Equal(feeGranterAddr, feePayer) {
+			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranterAddr, feePayer, fee, sdkTx.GetMsgs())
+			if err != nil {
+				return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
+			}
+		}
+
+		deductFeesFrom = feeGranterAddr
+	}
+
+	deductFeesFromAcc := dfd.accountKeeper.GetAccount(ctx, deductFeesFrom)
+	if deductFeesFromAcc == nil {
+		return sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
+	}
+
+	if err := dfd.feemarketKeeper.DeductBaseFee(ctx, deductFeesFromAcc.GetAddress(), baseFee); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	if !tip.IsZero() {
+		if err := authante.DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, tip); err != nil {
+			return err
+		}
+	}
+
+	events := sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, sdk.AccAddress(deductFeesFrom).String()),
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+		),
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return nil
+}
+
+// getTxPriority returns the tip per unit of gas of a transaction.
+func getTxPriority(tip sdkmath.Int, gas uint64) int64 {
+	if gas == 0 {
+		return 0
+	}
+
+	gasPrice := tip.Quo(sdkmath.NewIntFromUint64(gas))
+	if !gasPrice.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return gasPrice.Int64()
+}
@@ -0,0 +1,145 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	_ "cosmossdk.io/api/cosmos/bank/v1beta1"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	_ "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtestutil "github.com/cosmos/cosmos-sdk/x/auth/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/ante"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	feemarkettestutil "github.com/cosmos/cosmos-sdk/x/feemarket/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+type fixture struct {
+	ctx             sdk.Context
+	encCfg          moduletestutil.TestEncodingConfig
+	accountKeeper   authkeeper.AccountKeeper
+	bankKeeper      *authtestutil.MockBankKeeper
+	feemarketBank   *feemarkettestutil.MockBankKeeper
+	feemarketKeeper keeper.Keeper
+	anteHandler     sdk.AnteHandler
+	payer           sdk.AccAddress
+}
+
+func initFixture(t *testing.T, enabled bool) *fixture {
+	t.Helper()
+	f := &fixture{}
+	ctrl := gomock.NewController(t)
+	f.bankKeeper = authtestutil.NewMockBankKeeper(ctrl)
+	f.feemarketBank = feemarkettestutil.NewMockBankKeeper(ctrl)
+
+	keys := storetypes.NewKVStoreKeys(authtypes.StoreKey, types.StoreKey)
+	testCtx := testutil.DefaultContextWithKeys(keys, nil, nil)
+	f.ctx = testCtx.WithBlockHeight(1)
+	f.encCfg = moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, feemarket.AppModuleBasic{})
+	testdata.RegisterInterfaces(f.encCfg.InterfaceRegistry)
+
+	maccPerms := map[string][]string{
+		authtypes.FeeCollectorName: nil,
+		types.ModuleName:           {authtypes.Burner},
+	}
+	f.accountKeeper = authkeeper.NewAccountKeeper(
+		f.encCfg.Codec, runtime.NewKVStoreService(keys[authtypes.StoreKey]), authtypes.ProtoBaseAccount, maccPerms,
+		authcodec.NewBech32Codec("cosmos"), sdk.Bech32MainPrefix, authtypes.NewModuleAddress("gov").String(),
+	)
+	require.NoError(t, f.accountKeeper.Params.Set(f.ctx, authtypes.DefaultParams()))
+	f.accountKeeper.GetModuleAccount(f.ctx, authtypes.FeeCollectorName)
+
+	f.feemarketKeeper = keeper.NewKeeper(
+		f.encCfg.Codec, runtime.NewKVStoreService(keys[types.StoreKey]), f.accountKeeper, f.feemarketBank, "",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	params := types.DefaultParams()
+	params.Enabled = enabled
+	state := types.NewState(math.LegacyNewDec(2), 0)
+	require.NoError(t, f.feemarketKeeper.InitGenesis(f.ctx, f.accountKeeper, types.NewGenesisState(params, state)))
+
+	f.payer = sdk.AccAddress("payer")
+	f.accountKeeper.SetAccount(f.ctx, f.accountKeeper.NewAccountWithAddress(f.ctx, f.payer))
+
+	dfd := ante.NewDeductFeeDecorator(f.accountKeeper, f.bankKeeper, nil, f.feemarketKeeper, nil)
+	f.anteHandler = sdk.ChainAnteDecorators(dfd)
+
+	return f
+}
+
+func (f *fixture) newTx(t *testing.T, fee sdk.Coins, gasLimit uint64) sdk.Tx {
+	t.Helper()
+	txBuilder := f.encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(f.payer)))
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(gasLimit)
+	return txBuilder.GetTx()
+}
+
+func TestDeductFeeDecorator(t *testing.T) {
+	f := initFixture(t, true)
+
+	// the base fee is 2stake per unit of gas
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	_, err := f.anteHandler(f.ctx, f.newTx(t, fee, 100), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// the fees must be paid in the fee denom
+	fee = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200), sdk.NewInt64Coin("atom", 10))
+	_, err = f.anteHandler(f.ctx, f.newTx(t, fee, 100), false)
+	require.ErrorIs(t, err, types.ErrInvalidFeeDenom)
+
+	// the base fee is burned and the tip is sent to the fee collector
+	fee = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+	baseFee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200))
+	tip := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))
+	f.feemarketBank.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), f.payer, types.ModuleName, baseFee).Return(nil)
+	f.feemarketBank.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, baseFee).Return(nil)
+	f.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), f.payer, authtypes.FeeCollectorName, tip).Return(nil)
+
+	newCtx, err := f.anteHandler(f.ctx, f.newTx(t, fee, 100), false)
+	require.NoError(t, err)
+	// the priority is the tip per unit of gas
+	require.Equal(t, int64(3), newCtx.Priority())
+
+	// the validator minimum gas price applies in CheckTx
+	ctx := f.ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 10)))
+	_, err = f.anteHandler(ctx, f.newTx(t, fee, 100), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+}
+
+func TestDeductFeeDecoratorSimulate(t *testing.T) {
+	f := initFixture(t, true)
+
+	// a simulation without fees is accepted
+	newCtx, err := f.anteHandler(f.ctx, f.newTx(t, nil, 0), true)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), newCtx.Priority())
+}
+
+func TestDeductFeeDecoratorDisabled(t *testing.T) {
+	f := initFixture(t, false)
+
+	// the fees are deducted by the x/auth decorator
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	f.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), f.payer, authtypes.FeeCollectorName, fee).Return(nil)
+
+	newCtx, err := f.anteHandler(f.ctx, f.newTx(t, fee, 100), false)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), newCtx.Priority())
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// InitGenesis initializes the feemarket module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx context.Context, ak types.AccountKeeper, data *types.GenesisState) error {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	if err := k.State.Set(ctx, data.State); err != nil {
+		return err
+	}
+
+	// ensure the module account exists to burn the base fees
+	ak.GetModuleAccount(ctx, types.ModuleName)
+	return nil
+}
+
+// ExportGenesis returns the feemarket module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := k.State.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, state), nil
+}
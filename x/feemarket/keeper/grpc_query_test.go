@@ -0,0 +1,21 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestQueryBaseFee() {
+	ctx := s.ctx
+	require := s.Require()
+
+	res, err := s.queryServer.BaseFee(ctx, &types.QueryBaseFeeRequest{})
+	require.NoError(err)
+	require.Equal(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(1)), res.BaseFee)
+
+	stateRes, err := s.queryServer.State(ctx, &types.QueryStateRequest{})
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(1), stateRes.State.BaseFee)
+}
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService storetypes.KVStoreService
+	bankKeeper   types.BankKeeper
+
+	// the name of the module account receiving the base fees. The base fees
+	// are burned if it is empty.
+	feeRecipient string
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	State  collections.Item[types.State]
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	feeRecipient string,
+	authority string,
+) Keeper {
+	// ensure feemarket module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the x/%s module account has not been set", types.ModuleName))
+	}
+
+	if feeRecipient != "" {
+		if addr := ak.GetModuleAddress(feeRecipient); addr == nil {
+			panic(fmt.Sprintf("the base fee recipient module account %s has not been set", feeRecipient))
+		}
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		bankKeeper:   bk,
+		feeRecipient: feeRecipient,
+		authority:    authority,
+		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		State:        collections.NewItem(sb, types.StateKey, "state", codec.CollValue[types.State](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/feemarket module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With(log.ModuleKey, "x/"+types.ModuleName)
+}
+
+// GetParams returns the x/feemarket params.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	return k.Params.Get(ctx)
+}
+
+// GetBaseFee returns the base fee per unit of gas of the current block.
+func (k Keeper) GetBaseFee(ctx context.Context) (math.LegacyDec, error) {
+	state, err := k.State.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return state.BaseFee, nil
+}
+
+// DeductBaseFee deducts the base fee of a transaction from the fee payer, and
+// burns it or sends it to the fee recipient module account.
+func (k Keeper) DeductBaseFee(ctx context.Context, feePayer sdk.AccAddress, fee sdk.Coins) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, feePayer, types.ModuleName, fee); err != nil {
+		return err
+	}
+
+	if k.feeRecipient == "" {
+		return k.bankKeeper.BurnCoins(ctx, types.ModuleName, fee)
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeRecipient, fee)
+}
+
+// UpdateBaseFee sets the base fee of the next block from the gas used by the
+// current block. The base fee is not updated when the fee market is disabled.
+func (k Keeper) UpdateBaseFee(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.Enabled {
+		return nil
+	}
+
+	state, err := k.State.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	gasUsed := sdk.UnwrapSDKContext(ctx).BlockGasMeter().GasConsumed()
+	state = types.NewState(state.NextBaseFee(params, gasUsed), gasUsed)
+	if err := k.State.Set(ctx, state); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBaseFee,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, state.BaseFee.String()),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+		),
+	)
+
+	return nil
+}
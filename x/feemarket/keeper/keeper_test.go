@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	feemarkettestutil "github.com/cosmos/cosmos-sdk/x/feemarket/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const feeRecipient = "fee_recipient"
+
+var feemarketAcc = authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Burner)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx             sdk.Context
+	storeService    corestore.KVStoreService
+	cdc             moduletestutil.TestEncodingConfig
+	feemarketKeeper keeper.Keeper
+	authKeeper      *feemarkettestutil.MockAccountKeeper
+	bankKeeper      *feemarkettestutil.MockBankKeeper
+	queryServer     types.QueryServer
+	msgServer       types.MsgServer
+	authority       string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.cdc = moduletestutil.MakeTestEncodingConfig(feemarket.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	s.storeService = storeService
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithBlockHeight(10)
+
+	ctrl := gomock.NewController(s.T())
+	s.authKeeper = feemarkettestutil.NewMockAccountKeeper(ctrl)
+	s.authKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(feemarketAcc.GetAddress()).AnyTimes()
+	s.authKeeper.EXPECT().GetModuleAddress(feeRecipient).Return(authtypes.NewModuleAddress(feeRecipient)).AnyTimes()
+	s.authKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(feemarketAcc).AnyTimes()
+	s.bankKeeper = feemarkettestutil.NewMockBankKeeper(ctrl)
+
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.feemarketKeeper = keeper.NewKeeper(s.cdc.Codec, storeService, s.authKeeper, s.bankKeeper, "", s.authority)
+	s.queryServer = keeper.NewQueryServerImpl(s.feemarketKeeper)
+	s.msgServer = keeper.NewMsgServerImpl(s.feemarketKeeper)
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	params.TargetBlockGas = 1000
+	s.Require().NoError(s.feemarketKeeper.InitGenesis(s.ctx, s.authKeeper, types.NewGenesisState(params, types.NewState(math.LegacyNewDec(1), 0))))
+}
+
+func (s *KeeperTestSuite) TestUpdateBaseFee() {
+	ctx, k := s.ctx, s.feemarketKeeper
+	require := s.Require()
+
+	// a full block increases the base fee by 1/8
+	ctx = ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx.BlockGasMeter().ConsumeGas(2000, "test")
+	require.NoError(k.UpdateBaseFee(ctx))
+
+	state, err := k.State.Get(ctx)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(1125, 3), state.BaseFee)
+	require.Equal(uint64(2000), state.LastBlockGasUsed)
+
+	// an empty block decreases it by 1/8
+	ctx = ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+	require.NoError(k.UpdateBaseFee(ctx))
+
+	baseFee, err := k.GetBaseFee(ctx)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(984375, 6), baseFee)
+
+	// the base fee is not updated when the fee market is disabled
+	params, err := k.Params.Get(ctx)
+	require.NoError(err)
+	params.Enabled = false
+	require.NoError(k.Params.Set(ctx, params))
+
+	require.NoError(k.UpdateBaseFee(ctx))
+	state, err = k.State.Get(ctx)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(984375, 6), state.BaseFee)
+	require.Equal(uint64(0), state.LastBlockGasUsed)
+}
+
+func (s *KeeperTestSuite) TestDeductBaseFee() {
+	ctx := s.ctx
+	require := s.Require()
+
+	payer := sdk.AccAddress("payer")
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	// the base fee is burned by default
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, fee).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(ctx, types.ModuleName, fee).Return(nil)
+	require.NoError(s.feemarketKeeper.DeductBaseFee(ctx, payer, fee))
+
+	// or sent to the fee recipient
+	k := keeper.NewKeeper(s.cdc.Codec, s.storeService, s.authKeeper, s.bankKeeper, feeRecipient, s.authority)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, fee).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, types.ModuleName, feeRecipient, fee).Return(nil)
+	require.NoError(k.DeductBaseFee(ctx, payer, fee))
+
+	// nothing is deducted for a zero base fee
+	require.NoError(k.DeductBaseFee(ctx, payer, sdk.NewCoins()))
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	ctx, k := s.ctx, s.feemarketKeeper
+	require := s.Require()
+
+	genesis, err := k.ExportGenesis(ctx)
+	require.NoError(err)
+	require.True(genesis.Params.Enabled)
+	require.Equal(math.LegacyNewDec(1), genesis.State.BaseFee)
+}
@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	ctx := s.ctx
+	require := s.Require()
+
+	params := types.DefaultParams()
+	params.MinBaseFee = math.LegacyNewDecWithPrec(1, 2)
+
+	_, err := s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: "invalid", Params: params})
+	require.ErrorContains(err, "invalid authority")
+
+	invalid := params
+	invalid.BaseFeeChangeDenominator = 0
+	_, err = s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: s.authority, Params: invalid})
+	require.ErrorContains(err, "base fee change denominator must be positive")
+
+	_, err = s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	require.NoError(err)
+
+	res, err := s.queryServer.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(err)
+	require.Equal(params, res.Params)
+}
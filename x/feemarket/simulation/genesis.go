@@ -0,0 +1,44 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// Simulation parameter constants
+const (
+	TargetBlockGas = "target_block_gas"
+	MinBaseFee     = "min_base_fee"
+)
+
+// GenTargetBlockGas randomized target block gas, between 1M and 50M
+func GenTargetBlockGas(r *rand.Rand) uint64 {
+	return uint64(1_000_000 + r.Intn(49_000_000))
+}
+
+// GenMinBaseFee randomized min base fee, between 0.0001 and 0.01
+func GenMinBaseFee(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(1+r.Intn(100)), 4)
+}
+
+// RandomizedGenState generates a random GenesisState for feemarket. The fee
+// market stays disabled, as the simulation operations do not pay the base fee.
+func RandomizedGenState(simState *module.SimulationState) {
+	var targetBlockGas uint64
+	simState.AppParams.GetOrGenerate(TargetBlockGas, &targetBlockGas, simState.Rand, func(r *rand.Rand) { targetBlockGas = GenTargetBlockGas(r) })
+
+	var minBaseFee math.LegacyDec
+	simState.AppParams.GetOrGenerate(MinBaseFee, &minBaseFee, simState.Rand, func(r *rand.Rand) { minBaseFee = GenMinBaseFee(r) })
+
+	params := types.DefaultParams()
+	params.FeeDenom = simState.BondDenom
+	params.TargetBlockGas = targetBlockGas
+	params.MinBaseFee = minBaseFee
+
+	genesis := types.NewGenesisState(params, types.NewState(minBaseFee, 0))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
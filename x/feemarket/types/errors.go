@@ -0,0 +1,8 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/feemarket module sentinel errors
+var (
+	ErrInvalidFeeDenom = errors.Register(ModuleName, 2, "invalid fee denom")
+)
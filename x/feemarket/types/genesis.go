@@ -0,0 +1,25 @@
+package types
+
+// NewGenesisState creates a new genesis state for the feemarket module.
+func NewGenesisState(params Params, state State) *GenesisState {
+	return &GenesisState{
+		Params: params,
+		State:  state,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the feemarket
+// module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), DefaultState())
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	return gs.State.Validate()
+}
@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	require.NoError(t, types.DefaultGenesisState().Validate())
+
+	tests := []struct {
+		name     string
+		malleate func(gs *types.GenesisState)
+		expErr   string
+	}{
+		{
+			name:     "invalid fee denom",
+			malleate: func(gs *types.GenesisState) { gs.Params.FeeDenom = "" },
+			expErr:   "invalid fee denom",
+		},
+		{
+			name:     "zero min base fee",
+			malleate: func(gs *types.GenesisState) { gs.Params.MinBaseFee = math.LegacyZeroDec() },
+			expErr:   "min base fee must be positive",
+		},
+		{
+			name:     "zero target block gas",
+			malleate: func(gs *types.GenesisState) { gs.Params.TargetBlockGas = 0 },
+			expErr:   "target block gas must be positive",
+		},
+		{
+			name:     "zero base fee change denominator",
+			malleate: func(gs *types.GenesisState) { gs.Params.BaseFeeChangeDenominator = 0 },
+			expErr:   "base fee change denominator must be positive",
+		},
+		{
+			name:     "negative base fee",
+			malleate: func(gs *types.GenesisState) { gs.State.BaseFee = math.LegacyNewDec(-1) },
+			expErr:   "base fee cannot be negative",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.DefaultGenesisState()
+			tc.malleate(gs)
+			require.ErrorContains(t, gs.Validate(), tc.expErr)
+		})
+	}
+}
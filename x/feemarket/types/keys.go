@@ -0,0 +1,16 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feemarket"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	ParamsKey = collections.NewPrefix(0) // key for the feemarket params
+	StateKey  = collections.NewPrefix(1) // key for the fee market state
+)
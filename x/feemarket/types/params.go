@@ -0,0 +1,54 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams returns Params instance with the given values.
+func NewParams(enabled bool, feeDenom string, minBaseFee math.LegacyDec, targetBlockGas, baseFeeChangeDenominator uint64) Params {
+	return Params{
+		Enabled:                  enabled,
+		FeeDenom:                 feeDenom,
+		MinBaseFee:               minBaseFee,
+		TargetBlockGas:           targetBlockGas,
+		BaseFeeChangeDenominator: baseFeeChangeDenominator,
+	}
+}
+
+// DefaultParams returns default x/feemarket module parameters. The base fee is
+// not enforced by default.
+func DefaultParams() Params {
+	return Params{
+		Enabled:                  false,
+		FeeDenom:                 sdk.DefaultBondDenom,
+		MinBaseFee:               math.LegacyNewDecWithPrec(25, 4),
+		TargetBlockGas:           15_000_000,
+		BaseFeeChangeDenominator: 8,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if err := sdk.ValidateDenom(p.FeeDenom); err != nil {
+		return fmt.Errorf("invalid fee denom: %w", err)
+	}
+
+	if p.MinBaseFee.IsNil() || !p.MinBaseFee.IsPositive() {
+		return fmt.Errorf("min base fee must be positive: %s", p.MinBaseFee)
+	}
+
+	if p.TargetBlockGas == 0 {
+		return errors.New("target block gas must be positive")
+	}
+
+	if p.BaseFeeChangeDenominator == 0 {
+		return errors.New("base fee change denominator must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// NewState returns a fee market state with the given base fee.
+func NewState(baseFee math.LegacyDec, lastBlockGasUsed uint64) State {
+	return State{
+		BaseFee:          baseFee,
+		LastBlockGasUsed: lastBlockGasUsed,
+	}
+}
+
+// DefaultState returns the default fee market state, starting at the default
+// minimum base fee.
+func DefaultState() State {
+	return NewState(DefaultParams().MinBaseFee, 0)
+}
+
+// Validate does the sanity check on the state.
+func (s State) Validate() error {
+	if s.BaseFee.IsNil() || s.BaseFee.IsNegative() {
+		return fmt.Errorf("base fee cannot be negative: %s", s.BaseFee)
+	}
+
+	return nil
+}
+
+// NextBaseFee returns the base fee of the next block, given the gas used by the
+// current block, following EIP-1559. The base fee increases when the gas used
+// is above the target and decreases when it is below, by at most
+// 1/BaseFeeChangeDenominator of the base fee. It never goes below the minimum
+// base fee.
+func (s State) NextBaseFee(params Params, gasUsed uint64) math.LegacyDec {
+	target := params.TargetBlockGas
+
+	var delta uint64
+	if gasUsed > target {
+		delta = gasUsed - target
+	} else {
+		delta = target - gasUsed
+	}
+
+	// blocks above twice the target change the base fee as much as a block at
+	// twice the target
+	delta = min(delta, target)
+
+	change := s.BaseFee.
+		MulInt(math.NewIntFromUint64(delta)).
+		QuoInt(math.NewIntFromUint64(target)).
+		QuoInt(math.NewIntFromUint64(params.BaseFeeChangeDenominator))
+
+	baseFee := s.BaseFee.Add(change)
+	if gasUsed < target {
+		baseFee = s.BaseFee.Sub(change)
+	}
+
+	if baseFee.LT(params.MinBaseFee) {
+		return params.MinBaseFee
+	}
+
+	return baseFee
+}
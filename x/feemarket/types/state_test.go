@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.DefaultParams()
+	params.MinBaseFee = math.LegacyNewDecWithPrec(1, 2)
+	params.TargetBlockGas = 1000
+	params.BaseFeeChangeDenominator = 8
+
+	state := types.NewState(math.LegacyNewDec(1), 0)
+
+	tests := []struct {
+		name     string
+		state    types.State
+		gasUsed  uint64
+		expected math.LegacyDec
+	}{
+		{
+			name:     "at target",
+			state:    state,
+			gasUsed:  1000,
+			expected: math.LegacyNewDec(1),
+		},
+		{
+			name:     "twice the target",
+			state:    state,
+			gasUsed:  2000,
+			expected: math.LegacyNewDecWithPrec(1125, 3),
+		},
+		{
+			name:     "above twice the target",
+			state:    state,
+			gasUsed:  10_000,
+			expected: math.LegacyNewDecWithPrec(1125, 3),
+		},
+		{
+			name:     "half the target",
+			state:    state,
+			gasUsed:  500,
+			expected: math.LegacyNewDecWithPrec(9375, 4),
+		},
+		{
+			name:     "empty block",
+			state:    state,
+			gasUsed:  0,
+			expected: math.LegacyNewDecWithPrec(875, 3),
+		},
+		{
+			name:     "bounded by the min base fee",
+			state:    types.NewState(math.LegacyNewDecWithPrec(11, 3), 0),
+			gasUsed:  0,
+			expected: math.LegacyNewDecWithPrec(1, 2),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.state.NextBaseFee(params, tc.gasUsed))
+		})
+	}
+}
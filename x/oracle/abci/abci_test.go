@@ -0,0 +1,271 @@
+package abci_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtsecp256k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle"
+	oracleabci "github.com/cosmos/cosmos-sdk/x/oracle/abci"
+	"github.com/cosmos/cosmos-sdk/x/oracle/keeper"
+	"github.com/cosmos/cosmos-sdk/x/oracle/provider"
+	oracletestutil "github.com/cosmos/cosmos-sdk/x/oracle/testutil"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+const chainID = "chain-id"
+
+type ABCITestSuite struct {
+	suite.Suite
+
+	ctx      sdk.Context
+	keeper   keeper.Keeper
+	valStore *mock.MockValidatorStore
+	privKeys []cmtsecp256k1.PrivKey
+}
+
+func TestABCITestSuite(t *testing.T) {
+	suite.Run(t, new(ABCITestSuite))
+}
+
+func (s *ABCITestSuite) SetupTest() {
+	cdc := moduletestutil.MakeTestEncodingConfig(oracle.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+
+	// the vote extensions of height 2 are available from height 3
+	s.ctx = testCtx.Ctx.
+		WithConsensusParams(cmtproto.ConsensusParams{Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 2}}).
+		WithBlockHeight(3).
+		WithBlockTime(time.Unix(1000, 0).UTC()).
+		WithHeaderInfo(header.Info{Height: 3, ChainID: chainID}).
+		WithLogger(log.NewTestLogger(s.T()))
+
+	ctrl := gomock.NewController(s.T())
+	s.keeper = keeper.NewKeeper(cdc.Codec, runtime.NewKVStoreService(key), oracletestutil.NewMockStakingKeeper(ctrl), authtypes.NewModuleAddress(govtypes.ModuleName).String())
+
+	params := types.DefaultParams()
+	params.Pairs = []string{"ATOM/USD"}
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, types.NewGenesisState(params, nil, nil)))
+
+	s.valStore = mock.NewMockValidatorStore(ctrl)
+	s.privKeys = nil
+	for i := 0; i < 3; i++ {
+		privKey := cmtsecp256k1.GenPrivKey()
+		s.privKeys = append(s.privKeys, privKey)
+		pk := cmtprotocrypto.PublicKey{Sum: &cmtprotocrypto.PublicKey_Secp256K1{Secp256K1: privKey.PubKey().Bytes()}}
+		s.valStore.EXPECT().GetPubKeyByConsAddr(gomock.Any(), sdk.ConsAddress(privKey.PubKey().Address())).Return(pk, nil).AnyTimes()
+	}
+}
+
+// extendedCommit returns the extended commit of the validators reporting the
+// given prices of ATOM/USD, signed at height 2, and sets the matching last
+// commit in the context.
+func (s *ABCITestSuite) extendedCommit(prices ...int64) abci.ExtendedCommitInfo {
+	commit := abci.ExtendedCommitInfo{}
+	lastCommit := abci.CommitInfo{}
+	for i, privKey := range s.privKeys {
+		ext, err := types.EncodeVoteExtension(map[string]math.LegacyDec{"ATOM/USD": math.LegacyNewDec(prices[i])})
+		s.Require().NoError(err)
+
+		var buf bytes.Buffer
+		cve := cmtproto.CanonicalVoteExtension{Extension: ext, Height: 2, ChainId: chainID}
+		s.Require().NoError(protoio.NewDelimitedWriter(&buf).WriteMsg(&cve))
+		sig, err := privKey.Sign(buf.Bytes())
+		s.Require().NoError(err)
+
+		validator := abci.Validator{Address: privKey.PubKey().Address(), Power: 10}
+		commit.Votes = append(commit.Votes, abci.ExtendedVoteInfo{
+			Validator:          validator,
+			VoteExtension:      ext,
+			ExtensionSignature: sig,
+			BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+		})
+	}
+
+	// the votes are sorted by address at equal powers
+	slices.SortFunc(commit.Votes, func(a, b abci.ExtendedVoteInfo) int {
+		return bytes.Compare(a.Validator.Address, b.Validator.Address)
+	})
+	for _, vote := range commit.Votes {
+		lastCommit.Votes = append(lastCommit.Votes, abci.VoteInfo{Validator: vote.Validator})
+	}
+	s.ctx = s.ctx.WithCometInfo(baseapp.NewBlockInfo(nil, nil, nil, lastCommit))
+
+	return commit
+}
+
+func (s *ABCITestSuite) TestVoteExtensionHandler() {
+	require := s.Require()
+
+	prices := provider.NewMockProvider(map[string]math.LegacyDec{
+		"ATOM/USD": math.LegacyNewDec(10),
+		"BTC/USD":  math.LegacyNewDec(60000),
+	})
+	handler := oracleabci.NewVoteExtensionHandler(log.NewNopLogger(), s.keeper, prices, oracleabci.DefaultProviderTimeout)
+	extendVote, verifyVoteExt := handler.ExtendVoteHandler(), handler.VerifyVoteExtensionHandler()
+
+	// only the prices of the pairs of the params are reported
+	res, err := extendVote(s.ctx, &abci.RequestExtendVote{Height: 3})
+	require.NoError(err)
+	reported, err := types.DecodeVoteExtension(res.VoteExtension, types.Params{Pairs: []string{"ATOM/USD"}})
+	require.NoError(err)
+	require.Equal(map[string]math.LegacyDec{"ATOM/USD": math.LegacyNewDec(10)}, reported)
+
+	verifyRes, err := verifyVoteExt(s.ctx, &abci.RequestVerifyVoteExtension{Height: 3, VoteExtension: res.VoteExtension})
+	require.NoError(err)
+	require.Equal(abci.ResponseVerifyVoteExtension_ACCEPT, verifyRes.Status)
+
+	// no price is reported when the provider fails
+	prices.SetError(errors.New("unavailable"))
+	res, err = extendVote(s.ctx, &abci.RequestExtendVote{Height: 3})
+	require.NoError(err)
+	require.Empty(res.VoteExtension)
+
+	verifyRes, err = verifyVoteExt(s.ctx, &abci.RequestVerifyVoteExtension{Height: 3, VoteExtension: res.VoteExtension})
+	require.NoError(err)
+	require.Equal(abci.ResponseVerifyVoteExtension_ACCEPT, verifyRes.Status)
+
+	// the prices of unknown pairs are rejected
+	bz, err := types.EncodeVoteExtension(map[string]math.LegacyDec{"BTC/USD": math.LegacyNewDec(60000)})
+	require.NoError(err)
+	verifyRes, err = verifyVoteExt(s.ctx, &abci.RequestVerifyVoteExtension{Height: 3, VoteExtension: bz})
+	require.NoError(err)
+	require.Equal(abci.ResponseVerifyVoteExtension_REJECT, verifyRes.Status)
+
+	// the prices above the max price are rejected
+	bz, err = types.EncodeVoteExtension(map[string]math.LegacyDec{"ATOM/USD": types.MaxPrice.MulInt64(2)})
+	require.NoError(err)
+	verifyRes, err = verifyVoteExt(s.ctx, &abci.RequestVerifyVoteExtension{Height: 3, VoteExtension: bz})
+	require.NoError(err)
+	require.Equal(abci.ResponseVerifyVoteExtension_REJECT, verifyRes.Status)
+}
+
+func (s *ABCITestSuite) TestProposalHandler() {
+	require := s.Require()
+
+	var wrappedTxs [][]byte
+	prepare := func(_ sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		require.Less(req.MaxTxBytes, int64(1000))
+		return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
+	}
+	process := func(_ sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		wrappedTxs = req.Txs
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
+	handler := oracleabci.NewProposalHandler(log.NewNopLogger(), s.valStore, prepare, process)
+	prepareProposal, processProposal := handler.PrepareProposalHandler(), handler.ProcessProposalHandler()
+
+	// the extended commit is injected at the start of the proposal
+	commit := s.extendedCommit(10, 11, 12)
+	prepareRes, err := prepareProposal(s.ctx, &abci.RequestPrepareProposal{
+		Height:          3,
+		MaxTxBytes:      1000,
+		Txs:             [][]byte{[]byte("tx")},
+		LocalLastCommit: commit,
+	})
+	require.NoError(err)
+	require.Len(prepareRes.Txs, 2)
+	injected, err := types.DecodeInjectedVotes(prepareRes.Txs[0])
+	require.NoError(err)
+	require.Equal(commit, injected)
+
+	// and verified before the other transactions
+	processRes, err := processProposal(s.ctx, &abci.RequestProcessProposal{Height: 3, Txs: prepareRes.Txs})
+	require.NoError(err)
+	require.Equal(abci.ResponseProcessProposal_ACCEPT, processRes.Status)
+	require.Equal([][]byte{[]byte("tx")}, wrappedTxs)
+
+	// the proposals without injected commit are rejected
+	processRes, err = processProposal(s.ctx, &abci.RequestProcessProposal{Height: 3, Txs: [][]byte{[]byte("tx")}})
+	require.NoError(err)
+	require.Equal(abci.ResponseProcessProposal_REJECT, processRes.Status)
+
+	// as well as the ones with forged vote extensions
+	forged := commit
+	forged.Votes = slices.Clone(commit.Votes)
+	forged.Votes[0].VoteExtension, err = types.EncodeVoteExtension(map[string]math.LegacyDec{"ATOM/USD": math.LegacyNewDec(1000)})
+	require.NoError(err)
+	tx, err := types.EncodeInjectedVotes(forged)
+	require.NoError(err)
+	processRes, err = processProposal(s.ctx, &abci.RequestProcessProposal{Height: 3, Txs: [][]byte{tx}})
+	require.NoError(err)
+	require.Equal(abci.ResponseProcessProposal_REJECT, processRes.Status)
+
+	// nothing is injected before the vote extensions are available, and
+	// injected commits are rejected
+	disabledCtx := s.ctx.WithHeaderInfo(header.Info{Height: 2, ChainID: chainID})
+	prepareRes, err = prepareProposal(disabledCtx, &abci.RequestPrepareProposal{Height: 2, MaxTxBytes: 100, Txs: [][]byte{[]byte("tx")}})
+	require.NoError(err)
+	require.Equal([][]byte{[]byte("tx")}, prepareRes.Txs)
+
+	processRes, err = processProposal(disabledCtx, &abci.RequestProcessProposal{Height: 2, Txs: prepareRes.Txs})
+	require.NoError(err)
+	require.Equal(abci.ResponseProcessProposal_ACCEPT, processRes.Status)
+	processRes, err = processProposal(disabledCtx, &abci.RequestProcessProposal{Height: 2, Txs: [][]byte{tx}})
+	require.NoError(err)
+	require.Equal(abci.ResponseProcessProposal_REJECT, processRes.Status)
+}
+
+func (s *ABCITestSuite) TestPreBlocker() {
+	require := s.Require()
+
+	called := false
+	next := func(sdk.Context, *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		called = true
+		return &sdk.ResponsePreBlock{}, nil
+	}
+	preBlocker := oracleabci.NewPreBlockHandler(s.keeper, types.StakeWeightedMedian()).PreBlocker(next)
+
+	tx, err := types.EncodeInjectedVotes(s.extendedCommit(10, 11, 12))
+	require.NoError(err)
+	_, err = preBlocker(s.ctx, &abci.RequestFinalizeBlock{Height: 3, Txs: [][]byte{tx}})
+	require.NoError(err)
+	require.True(called)
+
+	price, err := s.keeper.GetPrice(s.ctx, "ATOM/USD")
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(11), price.Price)
+	require.Equal(int64(3), price.BlockHeight)
+}
+
+var _ types.PriceProvider = slowProvider{}
+
+// slowProvider is a provider returning once the context is done.
+type slowProvider struct{}
+
+func (slowProvider) GetPrices(ctx context.Context, _ []string) (map[string]math.LegacyDec, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (s *ABCITestSuite) TestVoteExtensionHandlerTimeout() {
+	handler := oracleabci.NewVoteExtensionHandler(log.NewNopLogger(), s.keeper, slowProvider{}, 10*time.Millisecond)
+	res, err := handler.ExtendVoteHandler()(s.ctx, &abci.RequestExtendVote{Height: 3})
+	s.Require().NoError(err)
+	s.Require().Empty(res.VoteExtension)
+}
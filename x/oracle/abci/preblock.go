@@ -0,0 +1,42 @@
+package abci
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle/keeper"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+// PreBlockHandler writes the prices aggregated from the extended commit
+// injected in a block before its execution.
+type PreBlockHandler struct {
+	keeper    keeper.Keeper
+	aggregate types.AggregateFn
+}
+
+// NewPreBlockHandler returns a handler aggregating the prices with the given
+// function.
+func NewPreBlockHandler(k keeper.Keeper, aggregate types.AggregateFn) *PreBlockHandler {
+	return &PreBlockHandler{keeper: k, aggregate: aggregate}
+}
+
+// PreBlocker returns a PreBlocker applying the votes injected in the block
+// before calling next. The injected extended commit was verified by
+// ProcessProposal.
+func (h *PreBlockHandler) PreBlocker(next sdk.PreBlocker) sdk.PreBlocker {
+	return func(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		if types.VoteExtensionsEnabled(ctx) && len(req.Txs) > 0 && types.IsInjectedVotes(req.Txs[0]) {
+			commit, err := types.DecodeInjectedVotes(req.Txs[0])
+			if err != nil {
+				return nil, err
+			}
+
+			if err := h.keeper.ApplyVotes(ctx, commit, h.aggregate); err != nil {
+				return nil, err
+			}
+		}
+
+		return next(ctx, req)
+	}
+}
@@ -0,0 +1,122 @@
+package abci
+
+import (
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+// ProposalHandler wraps the PrepareProposal and ProcessProposal handlers of an
+// application, to inject the extended commit of the previous block at the
+// start of the proposals once the vote extensions are enabled, and to verify
+// it.
+type ProposalHandler struct {
+	logger          log.Logger
+	valStore        baseapp.ValidatorStore
+	prepareProposal sdk.PrepareProposalHandler
+	processProposal sdk.ProcessProposalHandler
+}
+
+// NewProposalHandler returns a proposal handler wrapping the given handlers,
+// verifying the vote extension signatures with the public keys of the
+// validator store.
+func NewProposalHandler(
+	logger log.Logger,
+	valStore baseapp.ValidatorStore,
+	prepareProposal sdk.PrepareProposalHandler,
+	processProposal sdk.ProcessProposalHandler,
+) *ProposalHandler {
+	return &ProposalHandler{
+		logger:          logger.With(log.ModuleKey, "x/"+types.ModuleName),
+		valStore:        valStore,
+		prepareProposal: prepareProposal,
+		processProposal: processProposal,
+	}
+}
+
+// PrepareProposalHandler returns the handler injecting the extended commit of
+// the previous block as the first transaction of the proposal, the other ones
+// being selected by the wrapped handler within the remaining block space.
+func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		if !types.VoteExtensionsEnabled(ctx) {
+			return h.prepareProposal(ctx, req)
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), req.LocalLastCommit); err != nil {
+			return nil, fmt.Errorf("invalid vote extensions: %w", err)
+		}
+
+		injected, err := types.EncodeInjectedVotes(req.LocalLastCommit)
+		if err != nil {
+			return nil, err
+		}
+
+		wrappedReq := *req
+		wrappedReq.MaxTxBytes -= int64(len(injected))
+		if wrappedReq.MaxTxBytes < 0 {
+			return nil, errors.New("the injected oracle votes exceed the max block size")
+		}
+
+		resp, err := h.prepareProposal(ctx, &wrappedReq)
+		if err != nil {
+			return nil, err
+		}
+
+		return &abci.ResponsePrepareProposal{Txs: append([][]byte{injected}, resp.Txs...)}, nil
+	}
+}
+
+// ProcessProposalHandler returns the handler rejecting the proposals whose
+// first transaction is not an extended commit with valid vote extension
+// signatures of at least 2/3 of the voting power, once the vote extensions are
+// enabled, the other transactions being verified by the wrapped handler.
+func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	reject := &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if !types.VoteExtensionsEnabled(ctx) {
+			for _, tx := range req.Txs {
+				if types.IsInjectedVotes(tx) {
+					h.logger.Error("rejecting proposal with oracle votes while vote extensions are disabled", "height", req.Height)
+					return reject, nil
+				}
+			}
+			return h.processProposal(ctx, req)
+		}
+
+		if len(req.Txs) == 0 {
+			h.logger.Error("rejecting proposal without oracle votes", "height", req.Height)
+			return reject, nil
+		}
+
+		commit, err := types.DecodeInjectedVotes(req.Txs[0])
+		if err != nil {
+			h.logger.Error("rejecting proposal with invalid oracle votes", "height", req.Height, "err", err)
+			return reject, nil
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), commit); err != nil {
+			h.logger.Error("rejecting proposal with invalid vote extensions", "height", req.Height, "err", err)
+			return reject, nil
+		}
+
+		for _, tx := range req.Txs[1:] {
+			if types.IsInjectedVotes(tx) {
+				h.logger.Error("rejecting proposal with several oracle votes", "height", req.Height)
+				return reject, nil
+			}
+		}
+
+		wrappedReq := *req
+		wrappedReq.Txs = req.Txs[1:]
+		return h.processProposal(ctx, &wrappedReq)
+	}
+}
@@ -0,0 +1,96 @@
+package abci
+
+import (
+	"context"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle/keeper"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+// DefaultProviderTimeout is the default maximum time spent fetching the prices
+// when extending a vote.
+const DefaultProviderTimeout = 500 * time.Millisecond
+
+// VoteExtensionHandler extends the votes of the validator with the prices of
+// its provider, and verifies the prices of the votes of the other validators.
+type VoteExtensionHandler struct {
+	logger   log.Logger
+	keeper   keeper.Keeper
+	provider types.PriceProvider
+	timeout  time.Duration
+}
+
+// NewVoteExtensionHandler returns a vote extension handler reporting the
+// prices of the provider, fetched within the given timeout.
+func NewVoteExtensionHandler(logger log.Logger, k keeper.Keeper, provider types.PriceProvider, timeout time.Duration) *VoteExtensionHandler {
+	return &VoteExtensionHandler{
+		logger:   logger.With(log.ModuleKey, "x/"+types.ModuleName),
+		keeper:   k,
+		provider: provider,
+		timeout:  timeout,
+	}
+}
+
+// ExtendVoteHandler returns the handler extending the votes with the prices of
+// the pairs of the params. No price is reported when the provider fails, so
+// that the vote is never withheld.
+func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		params, err := h.keeper.GetParams(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var prices map[string]math.LegacyDec
+		if len(params.Pairs) > 0 {
+			fetchCtx, cancel := context.WithTimeout(ctx, h.timeout)
+			prices, err = h.provider.GetPrices(fetchCtx, params.Pairs)
+			cancel()
+			if err != nil {
+				h.logger.Error("failed to fetch the oracle prices", "height", req.Height, "err", err)
+				prices = nil
+			}
+		}
+
+		// only the positive prices of the pairs, up to the max price, are
+		// reported, as the others would invalidate the vote extension
+		reported := make(map[string]math.LegacyDec, len(params.Pairs))
+		for _, pair := range params.Pairs {
+			if price, ok := prices[pair]; ok && !price.IsNil() && price.IsPositive() && price.LTE(types.MaxPrice) {
+				reported[pair] = price
+			}
+		}
+
+		bz, err := types.EncodeVoteExtension(reported)
+		if err != nil {
+			return nil, err
+		}
+
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns the handler rejecting the vote extensions
+// which do not decode as valid prices of the pairs of the params.
+func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		params, err := h.keeper.GetParams(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := types.DecodeVoteExtension(req.VoteExtension, params); err != nil {
+			h.logger.Debug("rejecting oracle vote extension", "height", req.Height, "validator", sdk.ConsAddress(req.ValidatorAddress), "err", err)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
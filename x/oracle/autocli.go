@@ -0,0 +1,48 @@
+package oracle
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "cosmos.oracle.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current oracle parameters",
+				},
+				{
+					RpcMethod:      "Price",
+					Use:            "price [pair]",
+					Short:          "Query the last aggregated price of a currency pair",
+					Example:        "price ATOM/USD",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pair"}},
+				},
+				{
+					RpcMethod: "Prices",
+					Use:       "prices",
+					Short:     "Query the last aggregated prices of all the currency pairs",
+				},
+				{
+					RpcMethod:      "MissCounter",
+					Use:            "miss-counter [validator-cons-address]",
+					Short:          "Query the misses of a validator in the current slash window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "cosmos.oracle.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
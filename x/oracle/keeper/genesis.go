@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+// InitGenesis initializes the oracle module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	for _, price := range data.Prices {
+		if err := k.Prices.Set(ctx, price.Pair, price); err != nil {
+			return err
+		}
+	}
+
+	for _, counter := range data.MissCounters {
+		consAddr, err := sdk.ConsAddressFromBech32(counter.Validator)
+		if err != nil {
+			return err
+		}
+		if err := k.MissCounters.Set(ctx, consAddr, counter.Misses); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the oracle module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var prices []types.Price
+	err = k.Prices.Walk(ctx, nil, func(_ string, price types.Price) (bool, error) {
+		prices = append(prices, price)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var missCounters []types.MissCounter
+	err = k.MissCounters.Walk(ctx, nil, func(consAddr sdk.ConsAddress, misses uint64) (bool, error) {
+		missCounters = append(missCounters, types.MissCounter{Validator: consAddr.String(), Misses: misses})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, prices, missCounters), nil
+}
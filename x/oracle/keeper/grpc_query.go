@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/oracle QueryServer
+// interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Params returns params of the oracle module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Price returns the last aggregated price of a currency pair.
+func (q queryServer) Price(ctx context.Context, req *types.QueryPriceRequest) (*types.QueryPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	price, err := q.k.Prices.Get(ctx, req.Pair)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no price for pair %s", req.Pair)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPriceResponse{Price: price}, nil
+}
+
+// Prices returns the last aggregated prices of all the currency pairs.
+func (q queryServer) Prices(ctx context.Context, req *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	prices, pageRes, err := query.CollectionPaginate(ctx, q.k.Prices, req.Pagination, func(_ string, price types.Price) (types.Price, error) {
+		return price, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPricesResponse{Prices: prices, Pagination: pageRes}, nil
+}
+
+// MissCounter returns the misses of a validator in the current slash window.
+func (q queryServer) MissCounter(ctx context.Context, req *types.QueryMissCounterRequest) (*types.QueryMissCounterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %s", err)
+	}
+
+	misses, err := q.k.MissCounters.Get(ctx, consAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	return &types.QueryMissCounterResponse{Misses: misses}, nil
+}
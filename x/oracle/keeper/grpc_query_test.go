@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestQueryPrices() {
+	ctx, k := s.ctx, s.oracleKeeper
+	require := s.Require()
+
+	_, err := s.queryServer.Price(ctx, &types.QueryPriceRequest{Pair: "ATOM/USD"})
+	require.ErrorContains(err, "no price for pair ATOM/USD")
+
+	atom := types.Price{Pair: "ATOM/USD", Price: math.LegacyNewDec(10), BlockHeight: 5, BlockTime: time.Unix(500, 0).UTC()}
+	eth := types.Price{Pair: "ETH/USD", Price: math.LegacyNewDec(3000), BlockHeight: 6, BlockTime: time.Unix(600, 0).UTC()}
+	require.NoError(k.Prices.Set(ctx, atom.Pair, atom))
+	require.NoError(k.Prices.Set(ctx, eth.Pair, eth))
+
+	res, err := s.queryServer.Price(ctx, &types.QueryPriceRequest{Pair: "ATOM/USD"})
+	require.NoError(err)
+	require.Equal(atom, res.Price)
+
+	pricesRes, err := s.queryServer.Prices(ctx, &types.QueryPricesRequest{})
+	require.NoError(err)
+	require.Equal([]types.Price{atom, eth}, pricesRes.Prices)
+
+	_, err = s.queryServer.MissCounter(ctx, &types.QueryMissCounterRequest{Validator: "invalid"})
+	require.ErrorContains(err, "invalid validator address")
+}
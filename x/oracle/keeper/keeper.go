@@ -0,0 +1,241 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+// Keeper of the oracle store
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	storeService  storetypes.KVStoreService
+	stakingKeeper types.StakingKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema       collections.Schema
+	Params       collections.Item[types.Params]
+	Prices       collections.Map[string, types.Price]
+	MissCounters collections.Map[sdk.ConsAddress, uint64]
+}
+
+// NewKeeper creates a new oracle Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	sk types.StakingKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:           cdc,
+		storeService:  storeService,
+		stakingKeeper: sk,
+		authority:     authority,
+		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Prices:        collections.NewMap(sb, types.PricesKey, "prices", collections.StringKey, codec.CollValue[types.Price](cdc)),
+		MissCounters:  collections.NewMap(sb, types.MissCountersKey, "miss_counters", sdk.ConsAddressKey, collections.Uint64Value),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/oracle module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With(log.ModuleKey, "x/"+types.ModuleName)
+}
+
+// GetParams returns the x/oracle params.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	return k.Params.Get(ctx)
+}
+
+// GetPrice returns the last aggregated price of a currency pair.
+func (k Keeper) GetPrice(ctx context.Context, pair string) (types.Price, error) {
+	return k.Prices.Get(ctx, pair)
+}
+
+// ApplyVotes aggregates the prices reported in the vote extensions of an
+// extended commit and stores them. The validators of the commit which omitted
+// or misreported the price of an aggregated pair get a miss, and the ones which
+// missed too many blocks are slashed and jailed at the end of each slash
+// window.
+//
+// The vote extensions of the validators which did not sign the block, already
+// penalised by x/slashing, are ignored. The undecodable vote extensions report
+// no price.
+func (k Keeper) ApplyVotes(ctx context.Context, commit abci.ExtendedCommitInfo, aggregate types.AggregateFn) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	var (
+		votes      []types.Vote
+		totalPower int64
+	)
+	for _, vote := range commit.Votes {
+		totalPower += vote.Validator.Power
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			continue
+		}
+
+		prices, err := types.DecodeVoteExtension(vote.VoteExtension, params)
+		if err != nil {
+			k.Logger(ctx).Debug("ignoring invalid oracle vote extension", "validator", sdk.ConsAddress(vote.Validator.Address), "err", err)
+			prices = nil
+		}
+		votes = append(votes, types.Vote{Validator: vote.Validator.Address, Power: vote.Validator.Power, Prices: prices})
+	}
+
+	minPower := params.VoteThreshold.MulInt64(totalPower).Ceil().TruncateInt64()
+	prices := aggregate(params.Pairs, votes, minPower)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, pair := range params.Pairs {
+		price, ok := prices[pair]
+		if !ok {
+			continue
+		}
+
+		err := k.Prices.Set(ctx, pair, types.Price{
+			Pair:        pair,
+			Price:       price,
+			BlockHeight: sdkCtx.BlockHeight(),
+			BlockTime:   sdkCtx.BlockTime(),
+		})
+		if err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePrice,
+				sdk.NewAttribute(types.AttributeKeyPair, pair),
+				sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+			),
+		)
+	}
+
+	for _, vote := range votes {
+		if !missed(vote, prices, params.MaxDeviation) {
+			continue
+		}
+
+		misses, err := k.MissCounters.Get(ctx, vote.Validator)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		if err := k.MissCounters.Set(ctx, vote.Validator, misses+1); err != nil {
+			return err
+		}
+	}
+
+	if uint64(sdkCtx.BlockHeight())%params.SlashWindow == 0 {
+		return k.endSlashWindow(ctx, params)
+	}
+
+	return nil
+}
+
+// missed returns whether a vote omitted the price of an aggregated pair, or
+// reported a price deviating from it by more than maxDeviation. The deviation is
+// not divided by the price, which could overflow for a tiny price.
+func missed(vote types.Vote, prices map[string]math.LegacyDec, maxDeviation math.LegacyDec) bool {
+	for pair, price := range prices {
+		reported, ok := vote.Prices[pair]
+		if !ok || reported.Sub(price).Abs().GT(price.Mul(maxDeviation)) {
+			return true
+		}
+	}
+	return false
+}
+
+// endSlashWindow slashes and jails the validators which missed more blocks of
+// the slash window than allowed, and resets the misses of all the validators.
+func (k Keeper) endSlashWindow(ctx context.Context, params types.Params) error {
+	maxMisses := params.MaxMisses()
+
+	var (
+		validators []sdk.ConsAddress
+		misses     []uint64
+	)
+	err := k.MissCounters.Walk(ctx, nil, func(validator sdk.ConsAddress, count uint64) (bool, error) {
+		if count > maxMisses {
+			validators = append(validators, validator)
+			misses = append(misses, count)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if params.SlashFraction.IsPositive() {
+		for i, consAddr := range validators {
+			if err := k.slash(ctx, consAddr, misses[i], params.SlashFraction); err != nil {
+				return err
+			}
+		}
+	}
+
+	return k.MissCounters.Clear(ctx, nil)
+}
+
+// slash slashes and jails a validator, unless it is not found or already
+// jailed.
+func (k Keeper) slash(ctx context.Context, consAddr sdk.ConsAddress, misses uint64, fraction math.LegacyDec) error {
+	validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil || validator == nil || validator.IsJailed() {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	// the stake distribution which signed the block is the one before the
+	// validator updates, as for the downtime slashes of x/slashing
+	distributionHeight := sdkCtx.BlockHeight() - sdk.ValidatorUpdateDelay - 1
+	power := validator.GetConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+	if _, err := k.stakingKeeper.Slash(ctx, consAddr, distributionHeight, power, fraction); err != nil {
+		return err
+	}
+	if err := k.stakingKeeper.Jail(ctx, consAddr); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("slashed and jailed validator for missing oracle votes", "validator", consAddr, "misses", misses)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyValidator, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyMisses, strconv.FormatUint(misses, 10)),
+		),
+	)
+
+	return nil
+}
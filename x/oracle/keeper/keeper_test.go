@@ -0,0 +1,207 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle"
+	"github.com/cosmos/cosmos-sdk/x/oracle/keeper"
+	oracletestutil "github.com/cosmos/cosmos-sdk/x/oracle/testutil"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	val1 = sdk.ConsAddress("val1________________")
+	val2 = sdk.ConsAddress("val2________________")
+	val3 = sdk.ConsAddress("val3________________")
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx           sdk.Context
+	oracleKeeper  keeper.Keeper
+	stakingKeeper *oracletestutil.MockStakingKeeper
+	queryServer   types.QueryServer
+	msgServer     types.MsgServer
+	authority     string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	cdc := moduletestutil.MakeTestEncodingConfig(oracle.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithBlockHeight(9).WithBlockTime(time.Unix(1000, 0).UTC())
+
+	ctrl := gomock.NewController(s.T())
+	s.stakingKeeper = oracletestutil.NewMockStakingKeeper(ctrl)
+
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.oracleKeeper = keeper.NewKeeper(cdc.Codec, runtime.NewKVStoreService(key), s.stakingKeeper, s.authority)
+	s.queryServer = keeper.NewQueryServerImpl(s.oracleKeeper)
+	s.msgServer = keeper.NewMsgServerImpl(s.oracleKeeper)
+
+	params := types.DefaultParams()
+	params.Pairs = []string{"ATOM/USD", "ETH/USD"}
+	params.SlashWindow = 10
+	params.MinValidPerWindow = math.LegacyNewDecWithPrec(8, 1)
+	params.SlashFraction = math.LegacyNewDecWithPrec(1, 2)
+	s.Require().NoError(s.oracleKeeper.InitGenesis(s.ctx, types.NewGenesisState(params, nil, nil)))
+}
+
+// vote returns the vote of a validator reporting the given prices.
+func (s *KeeperTestSuite) vote(validator sdk.ConsAddress, power int64, flag cmtproto.BlockIDFlag, prices map[string]math.LegacyDec) abci.ExtendedVoteInfo {
+	var ext []byte
+	if prices != nil {
+		var err error
+		ext, err = types.EncodeVoteExtension(prices)
+		s.Require().NoError(err)
+	}
+
+	return abci.ExtendedVoteInfo{
+		Validator:     abci.Validator{Address: validator, Power: power},
+		VoteExtension: ext,
+		BlockIdFlag:   flag,
+	}
+}
+
+func (s *KeeperTestSuite) TestApplyVotes() {
+	ctx, k := s.ctx, s.oracleKeeper
+	require := s.Require()
+
+	commit := abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{
+		s.vote(val1, 40, cmtproto.BlockIDFlagCommit, map[string]math.LegacyDec{"ATOM/USD": math.LegacyNewDec(10), "ETH/USD": math.LegacyNewDec(3000)}),
+		// misreports the price of ETH/USD
+		s.vote(val2, 35, cmtproto.BlockIDFlagCommit, map[string]math.LegacyDec{"ATOM/USD": math.LegacyNewDec(11), "ETH/USD": math.LegacyNewDec(1000)}),
+		// omits the price of ETH/USD
+		s.vote(val3, 25, cmtproto.BlockIDFlagCommit, map[string]math.LegacyDec{"ATOM/USD": math.LegacyNewDec(12)}),
+	}}
+	require.NoError(k.ApplyVotes(ctx, commit, types.StakeWeightedMedian()))
+
+	price, err := k.GetPrice(ctx, "ATOM/USD")
+	require.NoError(err)
+	require.Equal(types.Price{Pair: "ATOM/USD", Price: math.LegacyNewDec(11), BlockHeight: 9, BlockTime: ctx.BlockTime()}, price)
+	price, err = k.GetPrice(ctx, "ETH/USD")
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(3000), price.Price)
+
+	// val2 deviates from the ETH/USD price and val3 omits it
+	for validator, expMisses := range map[string]uint64{val1.String(): 0, val2.String(): 1, val3.String(): 1} {
+		res, err := s.queryServer.MissCounter(ctx, &types.QueryMissCounterRequest{Validator: validator})
+		require.NoError(err)
+		require.Equal(expMisses, res.Misses, validator)
+	}
+
+	// the prices are not updated below the vote threshold, and the validators
+	// which did not sign the block get no miss
+	commit = abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{
+		s.vote(val1, 40, cmtproto.BlockIDFlagAbsent, nil),
+		s.vote(val2, 35, cmtproto.BlockIDFlagCommit, map[string]math.LegacyDec{"ATOM/USD": math.LegacyNewDec(20)}),
+		s.vote(val3, 25, cmtproto.BlockIDFlagCommit, map[string]math.LegacyDec{"ATOM/USD": math.LegacyNewDec(20), "ETH/USD": math.LegacyNewDec(2000)}),
+	}}
+	require.NoError(k.ApplyVotes(ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager()), commit, types.StakeWeightedMedian()))
+
+	price, err = k.GetPrice(ctx, "ATOM/USD")
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(20), price.Price)
+	require.Equal(int64(10), price.BlockHeight)
+	price, err = k.GetPrice(ctx, "ETH/USD")
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(3000), price.Price)
+
+	// the misses are reset at the end of the slash window, none of the
+	// validators missing more than the 2 allowed blocks
+	genesis, err := k.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Empty(genesis.MissCounters)
+	require.Len(genesis.Prices, 2)
+}
+
+func (s *KeeperTestSuite) TestApplyVotesExtremeOutliers() {
+	ctx, k := s.ctx, s.oracleKeeper
+	require := s.Require()
+
+	tiny := math.LegacyNewDecWithPrec(1, 9)
+	commit := abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{
+		s.vote(val1, 40, cmtproto.BlockIDFlagCommit, map[string]math.LegacyDec{"ATOM/USD": tiny}),
+		// reports the max price, deviating from the tiny aggregated price
+		s.vote(val2, 35, cmtproto.BlockIDFlagCommit, map[string]math.LegacyDec{"ATOM/USD": types.MaxPrice}),
+		// reports a price above the max price, ignored
+		s.vote(val3, 25, cmtproto.BlockIDFlagCommit, map[string]math.LegacyDec{"ATOM/USD": math.LegacyNewDecFromInt(math.NewIntWithDecimal(1, 70))}),
+	}}
+	require.NoError(k.ApplyVotes(ctx, commit, types.StakeWeightedMedian()))
+
+	price, err := k.GetPrice(ctx, "ATOM/USD")
+	require.NoError(err)
+	require.Equal(tiny, price.Price)
+
+	for validator, expMisses := range map[string]uint64{val1.String(): 0, val2.String(): 1, val3.String(): 1} {
+		res, err := s.queryServer.MissCounter(ctx, &types.QueryMissCounterRequest{Validator: validator})
+		require.NoError(err)
+		require.Equal(expMisses, res.Misses, validator)
+	}
+}
+
+func (s *KeeperTestSuite) TestSlashWindow() {
+	ctx, k := s.ctx.WithBlockHeight(10), s.oracleKeeper
+	require := s.Require()
+
+	require.NoError(k.MissCounters.Set(ctx, val1, 3))
+	require.NoError(k.MissCounters.Set(ctx, val2, 3))
+	require.NoError(k.MissCounters.Set(ctx, val3, 2))
+
+	// val1 and val2 missed one more block than allowed, val2 being jailed
+	// already
+	validator := stakingtypes.Validator{Tokens: sdk.TokensFromConsensusPower(40, sdk.DefaultPowerReduction), Status: stakingtypes.Bonded}
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(ctx, val1).Return(validator, nil)
+	s.stakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.DefaultPowerReduction)
+	s.stakingKeeper.EXPECT().Slash(ctx, val1, int64(9-sdk.ValidatorUpdateDelay), int64(40), math.LegacyNewDecWithPrec(1, 2)).Return(math.NewInt(1), nil)
+	s.stakingKeeper.EXPECT().Jail(ctx, val1).Return(nil)
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(ctx, val2).Return(stakingtypes.Validator{Jailed: true}, nil)
+
+	commit := abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{
+		s.vote(val1, 40, cmtproto.BlockIDFlagCommit, nil),
+	}}
+	require.NoError(k.ApplyVotes(ctx, commit, types.StakeWeightedMedian()))
+
+	has, err := k.MissCounters.Has(ctx, val3)
+	require.NoError(err)
+	require.False(has)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	ctx, k := s.ctx, s.oracleKeeper
+	require := s.Require()
+
+	params, err := k.GetParams(ctx)
+	require.NoError(err)
+	genesis := types.NewGenesisState(params,
+		[]types.Price{{Pair: "ATOM/USD", Price: math.LegacyNewDec(10), BlockHeight: 5, BlockTime: time.Unix(500, 0).UTC()}},
+		[]types.MissCounter{{Validator: val1.String(), Misses: 3}},
+	)
+	require.NoError(genesis.Validate())
+	require.NoError(k.InitGenesis(ctx, genesis))
+
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Equal(genesis, exported)
+}
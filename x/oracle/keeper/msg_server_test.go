@@ -0,0 +1,28 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	ctx := s.ctx
+	require := s.Require()
+
+	params := types.DefaultParams()
+	params.Pairs = []string{"BTC/USD"}
+
+	_, err := s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: "invalid", Params: params})
+	require.ErrorContains(err, "invalid authority")
+
+	invalid := params
+	invalid.SlashWindow = 0
+	_, err = s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: s.authority, Params: invalid})
+	require.ErrorContains(err, "slash window must be positive")
+
+	_, err = s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	require.NoError(err)
+
+	res, err := s.queryServer.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(err)
+	require.Equal(params, res.Params)
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"sync"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+var _ types.PriceProvider = (*MockProvider)(nil)
+
+// MockProvider is a price provider returning prices set locally, for testing
+// the oracle on local networks.
+//
+// NOTE: DO NOT use in a production application!
+type MockProvider struct {
+	mtx    sync.RWMutex
+	prices map[string]math.LegacyDec
+	err    error
+}
+
+// NewMockProvider returns a mock provider returning the given prices, by
+// pair.
+func NewMockProvider(prices map[string]math.LegacyDec) *MockProvider {
+	p := &MockProvider{prices: make(map[string]math.LegacyDec, len(prices))}
+	for pair, price := range prices {
+		p.prices[pair] = price
+	}
+	return p
+}
+
+// SetPrice sets the price of a pair.
+func (p *MockProvider) SetPrice(pair string, price math.LegacyDec) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.prices[pair] = price
+}
+
+// RemovePrice removes the price of a pair, which is then omitted.
+func (p *MockProvider) RemovePrice(pair string) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	delete(p.prices, pair)
+}
+
+// SetError sets the error returned instead of the prices, if not nil.
+func (p *MockProvider) SetError(err error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.err = err
+}
+
+// GetPrices returns the prices of the given pairs which are set.
+func (p *MockProvider) GetPrices(_ context.Context, pairs []string) (map[string]math.LegacyDec, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	prices := make(map[string]math.LegacyDec, len(pairs))
+	for _, pair := range pairs {
+		if price, ok := p.prices[pair]; ok {
+			prices[pair] = price
+		}
+	}
+	return prices, nil
+}
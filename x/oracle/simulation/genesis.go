@@ -0,0 +1,53 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+// Simulation parameter constants
+const (
+	VoteThreshold = "vote_threshold"
+	MaxDeviation  = "max_deviation"
+	SlashWindow   = "slash_window"
+)
+
+// GenVoteThreshold randomized vote threshold, between 0.34 and 1
+func GenVoteThreshold(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(34+r.Intn(67)), 2)
+}
+
+// GenMaxDeviation randomized max deviation, between 0.01 and 0.5
+func GenMaxDeviation(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(1+r.Intn(50)), 2)
+}
+
+// GenSlashWindow randomized slash window, between 10 and 10,000 blocks
+func GenSlashWindow(r *rand.Rand) uint64 {
+	return uint64(10 + r.Intn(9_991))
+}
+
+// RandomizedGenState generates a random GenesisState for oracle. No pair is
+// reported, as the simulations do not extend the votes.
+func RandomizedGenState(simState *module.SimulationState) {
+	var voteThreshold math.LegacyDec
+	simState.AppParams.GetOrGenerate(VoteThreshold, &voteThreshold, simState.Rand, func(r *rand.Rand) { voteThreshold = GenVoteThreshold(r) })
+
+	var maxDeviation math.LegacyDec
+	simState.AppParams.GetOrGenerate(MaxDeviation, &maxDeviation, simState.Rand, func(r *rand.Rand) { maxDeviation = GenMaxDeviation(r) })
+
+	var slashWindow uint64
+	simState.AppParams.GetOrGenerate(SlashWindow, &slashWindow, simState.Rand, func(r *rand.Rand) { slashWindow = GenSlashWindow(r) })
+
+	params := types.DefaultParams()
+	params.VoteThreshold = voteThreshold
+	params.MaxDeviation = maxDeviation
+	params.SlashWindow = slashWindow
+
+	genesis := types.NewGenesisState(params, nil, nil)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
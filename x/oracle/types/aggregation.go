@@ -0,0 +1,112 @@
+package types
+
+import (
+	"bytes"
+	"sort"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Vote is the prices reported by a validator in its vote extension.
+type Vote struct {
+	// Validator is the consensus address of the validator.
+	Validator sdk.ConsAddress
+	// Power is the voting power of the validator.
+	Power int64
+	// Prices are the reported prices, by pair.
+	Prices map[string]math.LegacyDec
+}
+
+// AggregateFn aggregates the prices of the pairs reported by the votes. The
+// price of a pair is only aggregated when reported by votes of a total power of
+// at least minPower. It must be deterministic, as it is computed by all the
+// nodes.
+type AggregateFn func(pairs []string, votes []Vote, minPower int64) map[string]math.LegacyDec
+
+// reportedPrice is a price of a pair reported by a vote.
+type reportedPrice struct {
+	validator sdk.ConsAddress
+	power     int64
+	price     math.LegacyDec
+}
+
+// aggregate calls fn with the prices of each pair reported by votes of a total
+// power of at least minPower, sorted by price and then by validator.
+func aggregate(pairs []string, votes []Vote, minPower int64, fn func([]reportedPrice, int64) math.LegacyDec) map[string]math.LegacyDec {
+	prices := make(map[string]math.LegacyDec)
+	for _, pair := range pairs {
+		var (
+			reported []reportedPrice
+			power    int64
+		)
+		for _, vote := range votes {
+			if price, ok := vote.Prices[pair]; ok && vote.Power > 0 {
+				reported = append(reported, reportedPrice{validator: vote.Validator, power: vote.Power, price: price})
+				power += vote.Power
+			}
+		}
+		if len(reported) == 0 || power < minPower {
+			continue
+		}
+
+		sort.Slice(reported, func(i, j int) bool {
+			if !reported[i].price.Equal(reported[j].price) {
+				return reported[i].price.LT(reported[j].price)
+			}
+			return bytes.Compare(reported[i].validator, reported[j].validator) < 0
+		})
+		prices[pair] = fn(reported, power)
+	}
+
+	return prices
+}
+
+// StakeWeightedMedian returns an AggregateFn computing the stake-weighted
+// median of the reported prices, the lowest price reported by votes of at least
+// half of the reporting power when sorted in increasing order.
+func StakeWeightedMedian() AggregateFn {
+	return func(pairs []string, votes []Vote, minPower int64) map[string]math.LegacyDec {
+		return aggregate(pairs, votes, minPower, func(reported []reportedPrice, power int64) math.LegacyDec {
+			var cumulated int64
+			for _, r := range reported {
+				cumulated += r.power
+				if cumulated*2 >= power {
+					return r.price
+				}
+			}
+			return reported[len(reported)-1].price
+		})
+	}
+}
+
+// StakeWeightedMean returns an AggregateFn computing the mean of the reported
+// prices weighted by the power of the votes, truncated to the precision of the
+// prices.
+func StakeWeightedMean() AggregateFn {
+	return func(pairs []string, votes []Vote, minPower int64) map[string]math.LegacyDec {
+		return aggregate(pairs, votes, minPower, func(reported []reportedPrice, power int64) math.LegacyDec {
+			sum := math.LegacyZeroDec()
+			for _, r := range reported {
+				sum = sum.Add(r.price.MulInt64(r.power))
+			}
+			return sum.QuoInt64(power)
+		})
+	}
+}
+
+// Median returns an AggregateFn computing the median of the reported prices,
+// all the votes having the same weight. The median of an even number of prices
+// is the mean of the two middle ones.
+func Median() AggregateFn {
+	return func(pairs []string, votes []Vote, minPower int64) map[string]math.LegacyDec {
+		return aggregate(pairs, votes, minPower, func(reported []reportedPrice, _ int64) math.LegacyDec {
+			mid := len(reported) / 2
+			if len(reported)%2 == 1 {
+				return reported[mid].price
+			}
+			return reported[mid-1].price.Add(reported[mid].price).QuoInt64(2)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+func TestAggregate(t *testing.T) {
+	pairs := []string{"ATOM/USD", "ETH/USD"}
+	votes := []types.Vote{
+		{Validator: sdk.ConsAddress("val1"), Power: 10, Prices: map[string]math.LegacyDec{"ATOM/USD": math.LegacyNewDec(12), "ETH/USD": math.LegacyNewDec(3000)}},
+		{Validator: sdk.ConsAddress("val2"), Power: 50, Prices: map[string]math.LegacyDec{"ATOM/USD": math.LegacyNewDec(10)}},
+		{Validator: sdk.ConsAddress("val3"), Power: 20, Prices: map[string]math.LegacyDec{"ATOM/USD": math.LegacyNewDec(11)}},
+		{Validator: sdk.ConsAddress("val4"), Power: 20, Prices: map[string]math.LegacyDec{"ATOM/USD": math.LegacyNewDec(14)}},
+	}
+
+	tests := []struct {
+		name      string
+		aggregate types.AggregateFn
+		minPower  int64
+		exp       map[string]math.LegacyDec
+	}{
+		{
+			name:      "stake-weighted median",
+			aggregate: types.StakeWeightedMedian(),
+			exp:       map[string]math.LegacyDec{"ATOM/USD": math.LegacyNewDec(10), "ETH/USD": math.LegacyNewDec(3000)},
+		},
+		{
+			name:      "stake-weighted mean",
+			aggregate: types.StakeWeightedMean(),
+			exp:       map[string]math.LegacyDec{"ATOM/USD": math.LegacyNewDecWithPrec(1120, 2), "ETH/USD": math.LegacyNewDec(3000)},
+		},
+		{
+			name:      "median",
+			aggregate: types.Median(),
+			exp:       map[string]math.LegacyDec{"ATOM/USD": math.LegacyNewDecWithPrec(115, 1), "ETH/USD": math.LegacyNewDec(3000)},
+		},
+		{
+			name:      "pairs below the min power",
+			aggregate: types.StakeWeightedMedian(),
+			minPower:  11,
+			exp:       map[string]math.LegacyDec{"ATOM/USD": math.LegacyNewDec(10)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, tc.aggregate(pairs, votes, tc.minPower))
+		})
+	}
+}
+
+func TestStakeWeightedMedianDeterministic(t *testing.T) {
+	// equal prices are ordered by validator, whatever the order of the votes
+	votes := []types.Vote{
+		{Validator: sdk.ConsAddress("b"), Power: 1, Prices: map[string]math.LegacyDec{"ATOM/USD": math.LegacyNewDec(2)}},
+		{Validator: sdk.ConsAddress("a"), Power: 1, Prices: map[string]math.LegacyDec{"ATOM/USD": math.LegacyNewDec(1)}},
+		{Validator: sdk.ConsAddress("c"), Power: 2, Prices: map[string]math.LegacyDec{"ATOM/USD": math.LegacyNewDec(2)}},
+	}
+	reversed := []types.Vote{votes[2], votes[1], votes[0]}
+
+	aggregate := types.StakeWeightedMedian()
+	require.Equal(t, aggregate([]string{"ATOM/USD"}, votes, 0), aggregate([]string{"ATOM/USD"}, reversed, 0))
+	require.Equal(t, math.LegacyNewDec(2), aggregate([]string{"ATOM/USD"}, votes, 0)["ATOM/USD"])
+}
@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/oracle module sentinel errors
+var (
+	ErrUnknownPair          = errors.Register(ModuleName, 2, "unknown currency pair")
+	ErrInvalidVoteExtension = errors.Register(ModuleName, 3, "invalid oracle vote extension")
+	ErrInvalidInjectedVotes = errors.Register(ModuleName, 4, "invalid injected oracle votes")
+)
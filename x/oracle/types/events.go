@@ -0,0 +1,12 @@
+package types
+
+// oracle module event types
+const (
+	EventTypePrice = "oracle_price"
+	EventTypeSlash = "oracle_slash"
+
+	AttributeKeyPair      = "pair"
+	AttributeKeyPrice     = "price"
+	AttributeKeyValidator = "validator"
+	AttributeKeyMisses    = "misses"
+)
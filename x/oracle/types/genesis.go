@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state for the oracle module.
+func NewGenesisState(params Params, prices []Price, missCounters []MissCounter) *GenesisState {
+	return &GenesisState{
+		Params:       params,
+		Prices:       prices,
+		MissCounters: missCounters,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the oracle module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil, nil)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	pairs := make(map[string]bool, len(gs.Prices))
+	for _, price := range gs.Prices {
+		if pairs[price.Pair] {
+			return fmt.Errorf("duplicate price for pair %s", price.Pair)
+		}
+		pairs[price.Pair] = true
+
+		if err := ValidatePair(price.Pair); err != nil {
+			return err
+		}
+		if price.Price.IsNil() || !price.Price.IsPositive() {
+			return fmt.Errorf("price of pair %s must be positive: %s", price.Pair, price.Price)
+		}
+	}
+
+	validators := make(map[string]bool, len(gs.MissCounters))
+	for _, counter := range gs.MissCounters {
+		if validators[counter.Validator] {
+			return fmt.Errorf("duplicate miss counter for validator %s", counter.Validator)
+		}
+		validators[counter.Validator] = true
+
+		if _, err := sdk.ConsAddressFromBech32(counter.Validator); err != nil {
+			return fmt.Errorf("invalid miss counter validator %s: %w", counter.Validator, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	require.NoError(t, types.DefaultGenesisState().Validate())
+
+	tests := []struct {
+		name     string
+		malleate func(gs *types.GenesisState)
+		expErr   string
+	}{
+		{
+			name:     "invalid pair",
+			malleate: func(gs *types.GenesisState) { gs.Params.Pairs = []string{"ATOM"} },
+			expErr:   "invalid pair",
+		},
+		{
+			name:     "duplicate pair",
+			malleate: func(gs *types.GenesisState) { gs.Params.Pairs = []string{"ATOM/USD", "ATOM/USD"} },
+			expErr:   "duplicate pair",
+		},
+		{
+			name:     "zero vote threshold",
+			malleate: func(gs *types.GenesisState) { gs.Params.VoteThreshold = math.LegacyZeroDec() },
+			expErr:   "vote threshold must be greater than 0 and at most 1",
+		},
+		{
+			name:     "zero slash window",
+			malleate: func(gs *types.GenesisState) { gs.Params.SlashWindow = 0 },
+			expErr:   "slash window must be positive",
+		},
+		{
+			name:     "slash fraction above 1",
+			malleate: func(gs *types.GenesisState) { gs.Params.SlashFraction = math.LegacyNewDec(2) },
+			expErr:   "slash fraction must be between 0 and 1",
+		},
+		{
+			name: "zero price",
+			malleate: func(gs *types.GenesisState) {
+				gs.Prices = []types.Price{{Pair: "ATOM/USD", Price: math.LegacyZeroDec()}}
+			},
+			expErr: "price of pair ATOM/USD must be positive",
+		},
+		{
+			name: "duplicate miss counter",
+			malleate: func(gs *types.GenesisState) {
+				validator := sdk.ConsAddress("validator").String()
+				gs.MissCounters = []types.MissCounter{{Validator: validator}, {Validator: validator}}
+			},
+			expErr: "duplicate miss counter",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.DefaultGenesisState()
+			tc.malleate(gs)
+			require.ErrorContains(t, gs.Validate(), tc.expErr)
+		})
+	}
+}
+
+func TestParamsMaxMisses(t *testing.T) {
+	params := types.DefaultParams()
+	require.Equal(t, uint64(9500), params.MaxMisses())
+
+	params.SlashWindow = 10
+	params.MinValidPerWindow = math.LegacyNewDecWithPrec(75, 2)
+	require.Equal(t, uint64(2), params.MaxMisses())
+}
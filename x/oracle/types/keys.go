@@ -0,0 +1,17 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "oracle"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	ParamsKey       = collections.NewPrefix(0) // key for the oracle params
+	PricesKey       = collections.NewPrefix(1) // prefix for the aggregated prices, by pair
+	MissCountersKey = collections.NewPrefix(2) // prefix for the misses of the validators, by consensus address
+)
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultParams returns default x/oracle module parameters. No pair is
+// reported by default, and the validators are not slashed.
+func DefaultParams() Params {
+	return Params{
+		Pairs:             nil,
+		VoteThreshold:     math.LegacyNewDecWithPrec(5, 1),
+		MaxDeviation:      math.LegacyNewDecWithPrec(1, 1),
+		SlashWindow:       10_000,
+		MinValidPerWindow: math.LegacyNewDecWithPrec(5, 2),
+		SlashFraction:     math.LegacyZeroDec(),
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	pairs := make(map[string]bool, len(p.Pairs))
+	for _, pair := range p.Pairs {
+		if err := ValidatePair(pair); err != nil {
+			return err
+		}
+		if pairs[pair] {
+			return fmt.Errorf("duplicate pair %s", pair)
+		}
+		pairs[pair] = true
+	}
+
+	if p.VoteThreshold.IsNil() || !p.VoteThreshold.IsPositive() || p.VoteThreshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("vote threshold must be greater than 0 and at most 1: %s", p.VoteThreshold)
+	}
+
+	if p.MaxDeviation.IsNil() || !p.MaxDeviation.IsPositive() {
+		return fmt.Errorf("max deviation must be positive: %s", p.MaxDeviation)
+	}
+
+	if p.SlashWindow == 0 {
+		return errors.New("slash window must be positive")
+	}
+
+	if p.MinValidPerWindow.IsNil() || p.MinValidPerWindow.IsNegative() || p.MinValidPerWindow.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("min valid per window must be between 0 and 1: %s", p.MinValidPerWindow)
+	}
+
+	if p.SlashFraction.IsNil() || p.SlashFraction.IsNegative() || p.SlashFraction.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("slash fraction must be between 0 and 1: %s", p.SlashFraction)
+	}
+
+	return nil
+}
+
+// MaxMisses returns the number of blocks of a slash window a validator can
+// miss without being slashed.
+func (p Params) MaxMisses() uint64 {
+	minValid := math.LegacyNewDecFromInt(math.NewIntFromUint64(p.SlashWindow)).Mul(p.MinValidPerWindow).Ceil().TruncateInt().Uint64()
+	return p.SlashWindow - minValid
+}
+
+// ValidatePair checks that a currency pair is made of a base and a quote
+// currency separated by a slash, such as ATOM/USD.
+func ValidatePair(pair string) error {
+	base, quote, ok := strings.Cut(pair, "/")
+	if !ok || base == "" || quote == "" || strings.Contains(quote, "/") || strings.TrimSpace(pair) != pair {
+		return fmt.Errorf("invalid pair %q, expected <base>/<quote>", pair)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+)
+
+// PriceProvider provides the prices reported by a validator in its vote
+// extensions, typically fetched from exchanges or from a price feed service.
+type PriceProvider interface {
+	// GetPrices returns the prices of the given pairs, of the base currency in
+	// the quote currency. The pairs whose price is unknown are omitted.
+	GetPrices(ctx context.Context, pairs []string) (map[string]math.LegacyDec, error)
+}
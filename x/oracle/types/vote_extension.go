@@ -0,0 +1,108 @@
+package types
+
+import (
+	"bytes"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InjectedVotesPrefix prefixes the pseudo-transaction injected by the proposer
+// at the start of a block, holding the extended commit of the previous block.
+// A protobuf message cannot start with a 0 byte, so the pseudo-transaction
+// never decodes as a transaction.
+var InjectedVotesPrefix = []byte("\x00oracle")
+
+// MaxPrice is the highest price a validator can report, 10^30, so that the
+// aggregation of the prices and the deviations from them cannot overflow.
+var MaxPrice = math.LegacyNewDecFromInt(math.NewIntWithDecimal(1, 30))
+
+// VoteExtensionsEnabled returns whether the vote extensions of the previous
+// block are available in the current one.
+func VoteExtensionsEnabled(ctx sdk.Context) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight != 0 && ctx.HeaderInfo().Height > cp.Abci.VoteExtensionsEnableHeight
+}
+
+// EncodeVoteExtension encodes the prices reported by a validator, sorted by
+// pair.
+func EncodeVoteExtension(prices map[string]math.LegacyDec) ([]byte, error) {
+	ve := OracleVoteExtension{Prices: make([]ReportedPrice, 0, len(prices))}
+	for pair, price := range prices {
+		ve.Prices = append(ve.Prices, ReportedPrice{Pair: pair, Price: price})
+	}
+	sort.Slice(ve.Prices, func(i, j int) bool { return ve.Prices[i].Pair < ve.Prices[j].Pair })
+
+	return ve.Marshal()
+}
+
+// DecodeVoteExtension decodes the prices reported by a validator, checking that
+// they are positive prices of the pairs of the params, at most one per pair and
+// at most MaxPrice. An empty vote extension reports no price.
+func DecodeVoteExtension(bz []byte, params Params) (map[string]math.LegacyDec, error) {
+	var ve OracleVoteExtension
+	if err := ve.Unmarshal(bz); err != nil {
+		return nil, errors.Wrap(ErrInvalidVoteExtension, err.Error())
+	}
+
+	pairs := make(map[string]bool, len(params.Pairs))
+	for _, pair := range params.Pairs {
+		pairs[pair] = true
+	}
+
+	prices := make(map[string]math.LegacyDec, len(ve.Prices))
+	for _, price := range ve.Prices {
+		if !pairs[price.Pair] {
+			return nil, errors.Wrapf(ErrUnknownPair, "pair %s", price.Pair)
+		}
+		if _, ok := prices[price.Pair]; ok {
+			return nil, errors.Wrapf(ErrInvalidVoteExtension, "duplicate price for pair %s", price.Pair)
+		}
+		if price.Price.IsNil() || !price.Price.IsPositive() {
+			return nil, errors.Wrapf(ErrInvalidVoteExtension, "price of pair %s must be positive", price.Pair)
+		}
+		if price.Price.GT(MaxPrice) {
+			return nil, errors.Wrapf(ErrInvalidVoteExtension, "price of pair %s must be at most %s", price.Pair, MaxPrice)
+		}
+		prices[price.Pair] = price.Price
+	}
+
+	return prices, nil
+}
+
+// EncodeInjectedVotes encodes the pseudo-transaction holding the extended
+// commit of the previous block.
+func EncodeInjectedVotes(commit abci.ExtendedCommitInfo) ([]byte, error) {
+	bz, err := commit.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(bytes.Clone(InjectedVotesPrefix), bz...), nil
+}
+
+// IsInjectedVotes returns whether a transaction of a block is the
+// pseudo-transaction holding the extended commit of the previous block.
+func IsInjectedVotes(tx []byte) bool {
+	return bytes.HasPrefix(tx, InjectedVotesPrefix)
+}
+
+// DecodeInjectedVotes decodes the pseudo-transaction holding the extended
+// commit of the previous block.
+func DecodeInjectedVotes(tx []byte) (abci.ExtendedCommitInfo, error) {
+	if !IsInjectedVotes(tx) {
+		return abci.ExtendedCommitInfo{}, errors.Wrap(ErrInvalidInjectedVotes, "missing prefix")
+	}
+
+	var commit abci.ExtendedCommitInfo
+	if err := commit.Unmarshal(tx[len(InjectedVotesPrefix):]); err != nil {
+		return abci.ExtendedCommitInfo{}, errors.Wrap(ErrInvalidInjectedVotes, err.Error())
+	}
+
+	return commit, nil
+}
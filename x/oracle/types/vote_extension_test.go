@@ -0,0 +1,101 @@
+package types_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+func TestVoteExtension(t *testing.T) {
+	params := types.DefaultParams()
+	params.Pairs = []string{"ATOM/USD", "ETH/USD"}
+
+	prices := map[string]math.LegacyDec{"ETH/USD": math.LegacyNewDec(3000), "ATOM/USD": math.LegacyNewDecWithPrec(105, 1)}
+	bz, err := types.EncodeVoteExtension(prices)
+	require.NoError(t, err)
+
+	decoded, err := types.DecodeVoteExtension(bz, params)
+	require.NoError(t, err)
+	require.Equal(t, prices, decoded)
+
+	// the max price can be reported
+	bz, err = types.EncodeVoteExtension(map[string]math.LegacyDec{"ATOM/USD": types.MaxPrice})
+	require.NoError(t, err)
+	_, err = types.DecodeVoteExtension(bz, params)
+	require.NoError(t, err)
+
+	// an empty vote extension reports no price
+	decoded, err = types.DecodeVoteExtension(nil, params)
+	require.NoError(t, err)
+	require.Empty(t, decoded)
+
+	tests := []struct {
+		name   string
+		ve     types.OracleVoteExtension
+		expErr string
+	}{
+		{
+			name:   "unknown pair",
+			ve:     types.OracleVoteExtension{Prices: []types.ReportedPrice{{Pair: "BTC/USD", Price: math.LegacyNewDec(1)}}},
+			expErr: "unknown currency pair",
+		},
+		{
+			name: "duplicate pair",
+			ve: types.OracleVoteExtension{Prices: []types.ReportedPrice{
+				{Pair: "ATOM/USD", Price: math.LegacyNewDec(1)},
+				{Pair: "ATOM/USD", Price: math.LegacyNewDec(2)},
+			}},
+			expErr: "duplicate price for pair ATOM/USD",
+		},
+		{
+			name:   "negative price",
+			ve:     types.OracleVoteExtension{Prices: []types.ReportedPrice{{Pair: "ATOM/USD", Price: math.LegacyNewDec(-1)}}},
+			expErr: "price of pair ATOM/USD must be positive",
+		},
+		{
+			name:   "price above max",
+			ve:     types.OracleVoteExtension{Prices: []types.ReportedPrice{{Pair: "ATOM/USD", Price: types.MaxPrice.Add(math.LegacyNewDec(1))}}},
+			expErr: "price of pair ATOM/USD must be at most",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := tc.ve.Marshal()
+			require.NoError(t, err)
+			_, err = types.DecodeVoteExtension(bz, params)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+
+	_, err = types.DecodeVoteExtension([]byte("invalid"), params)
+	require.ErrorIs(t, err, types.ErrInvalidVoteExtension)
+}
+
+func TestInjectedVotes(t *testing.T) {
+	commit := abci.ExtendedCommitInfo{Round: 1, Votes: []abci.ExtendedVoteInfo{{
+		Validator:          abci.Validator{Address: []byte("validator"), Power: 10},
+		VoteExtension:      []byte("extension"),
+		ExtensionSignature: []byte("signature"),
+		BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+	}}}
+
+	tx, err := types.EncodeInjectedVotes(commit)
+	require.NoError(t, err)
+	require.True(t, types.IsInjectedVotes(tx))
+
+	decoded, err := types.DecodeInjectedVotes(tx)
+	require.NoError(t, err)
+	require.Equal(t, commit, decoded)
+
+	_, err = types.DecodeInjectedVotes([]byte("tx"))
+	require.ErrorIs(t, err, types.ErrInvalidInjectedVotes)
+	_, err = types.DecodeInjectedVotes(append(types.InjectedVotesPrefix, 0xff))
+	require.ErrorIs(t, err, types.ErrInvalidInjectedVotes)
+}
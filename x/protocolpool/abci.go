@@ -0,0 +1,17 @@
+package protocolpool
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/keeper"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+)
+
+// BeginBlocker streams the community pool inflows of the previous block to the
+// continuous funds.
+func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyBeginBlocker)
+
+	return k.DistributeFunds(ctx)
+}
@@ -0,0 +1,76 @@
+package protocolpool
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "cosmos.protocolpool.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "CommunityPool",
+					Use:       "community-pool",
+					Short:     "Query the amount of coins in the community pool",
+				},
+				{
+					RpcMethod:      "Budget",
+					Use:            "budget [recipient]",
+					Short:          "Query the budget of a recipient and its claimable amount",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient_address"}},
+				},
+				{
+					RpcMethod: "Budgets",
+					Use:       "budgets",
+					Short:     "Query all the budgets",
+				},
+				{
+					RpcMethod:      "ContinuousFund",
+					Use:            "continuous-fund [recipient]",
+					Short:          "Query the continuous fund of a recipient",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}},
+				},
+				{
+					RpcMethod: "ContinuousFunds",
+					Use:       "continuous-funds",
+					Short:     "Query all the continuous funds",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "cosmos.protocolpool.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "FundCommunityPool",
+					Use:            "fund-community-pool [amount]",
+					Short:          "Funds the community pool with the specified amount",
+					Example:        `$ <appd> tx protocolpool fund-community-pool 100uatom --from mykey`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount", Varargs: true}},
+				},
+				{
+					RpcMethod: "ClaimBudget",
+					Use:       "claim-budget",
+					Short:     "Claims the vested part of the budget of the sender",
+				},
+				{
+					RpcMethod: "CommunityPoolSpend",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "SubmitBudgetProposal",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "CreateContinuousFund",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "CancelContinuousFund",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
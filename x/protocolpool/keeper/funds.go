@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+)
+
+// validateRecipient returns an error if the recipient is not allowed to
+// receive funds from the community pool.
+func (k Keeper) validateRecipient(recipient sdk.AccAddress) error {
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", recipient)
+	}
+
+	return nil
+}
+
+// AddContinuousFund adds a continuous fund, streaming a percentage of the
+// community pool inflows to its recipient every block. The total percentage of
+// the continuous funds cannot exceed one.
+func (k Keeper) AddContinuousFund(ctx context.Context, fund types.ContinuousFund) error {
+	if err := fund.Validate(); err != nil {
+		return err
+	}
+
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(fund.Recipient)
+	if err != nil {
+		return err
+	}
+
+	if err := k.validateRecipient(recipient); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if fund.IsExpired(sdkCtx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrInvalidContinuousFund, "expiry %s must be after the block time", fund.Expiry)
+	}
+
+	has, err := k.ContinuousFunds.Has(ctx, recipient)
+	if err != nil {
+		return err
+	}
+
+	if has {
+		return errorsmod.Wrapf(types.ErrDuplicateContinuousFund, "recipient %s", fund.Recipient)
+	}
+
+	totalPercentage := fund.Percentage
+	err = k.ContinuousFunds.Walk(ctx, nil, func(_ sdk.AccAddress, fund types.ContinuousFund) (bool, error) {
+		totalPercentage = totalPercentage.Add(fund.Percentage)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if totalPercentage.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(types.ErrInvalidContinuousFund, "total percentage of the continuous funds cannot exceed one: %s", totalPercentage)
+	}
+
+	return k.ContinuousFunds.Set(ctx, recipient, fund)
+}
+
+// RemoveContinuousFund removes the continuous fund of a recipient.
+func (k Keeper) RemoveContinuousFund(ctx context.Context, recipient sdk.AccAddress) error {
+	has, err := k.ContinuousFunds.Has(ctx, recipient)
+	if err != nil {
+		return err
+	}
+
+	if !has {
+		return errorsmod.Wrapf(types.ErrContinuousFundNotFound, "recipient %s", recipient)
+	}
+
+	return k.ContinuousFunds.Remove(ctx, recipient)
+}
+
+// DistributeFunds streams the community pool inflows received since the last
+// block to the continuous funds, according to their percentage, and adds the
+// rest to the community pool. The expired continuous funds are removed.
+func (k Keeper) DistributeFunds(ctx context.Context) error {
+	escrowAddr := k.authKeeper.GetModuleAddress(types.ProtocolPoolEscrowAccount)
+	inflows := k.bankKeeper.GetAllBalances(ctx, escrowAddr)
+
+	var (
+		blockTime = sdk.UnwrapSDKContext(ctx).BlockTime()
+		expired   []sdk.AccAddress
+		funds     []types.ContinuousFund
+	)
+	err := k.ContinuousFunds.Walk(ctx, nil, func(recipient sdk.AccAddress, fund types.ContinuousFund) (bool, error) {
+		if fund.IsExpired(blockTime) {
+			expired = append(expired, recipient)
+		} else {
+			funds = append(funds, fund)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, recipient := range expired {
+		if err := k.ContinuousFunds.Remove(ctx, recipient); err != nil {
+			return err
+		}
+	}
+
+	if inflows.IsZero() {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	remaining := inflows
+	for _, fund := range funds {
+		amount, _ := sdk.NewDecCoinsFromCoins(inflows...).MulDecTruncate(fund.Percentage).TruncateDecimal()
+		if amount.IsZero() {
+			continue
+		}
+
+		recipient, err := k.authKeeper.AddressCodec().StringToBytes(fund.Recipient)
+		if err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ProtocolPoolEscrowAccount, recipient, amount); err != nil {
+			return err
+		}
+
+		remaining = remaining.Sub(amount...)
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDistributeContinuousFund,
+				sdk.NewAttribute(types.AttributeKeyRecipient, fund.Recipient),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			),
+		)
+	}
+
+	if remaining.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ProtocolPoolEscrowAccount, types.ModuleName, remaining)
+}
+
+// AddBudget adds a budget granted to a recipient out of the community pool. A
+// recipient can only have one budget at a time.
+func (k Keeper) AddBudget(ctx context.Context, budget types.Budget) error {
+	if err := budget.Validate(); err != nil {
+		return err
+	}
+
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(budget.RecipientAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.validateRecipient(recipient); err != nil {
+		return err
+	}
+
+	has, err := k.Budgets.Has(ctx, recipient)
+	if err != nil {
+		return err
+	}
+
+	if has {
+		return errorsmod.Wrapf(types.ErrDuplicateBudget, "recipient %s", budget.RecipientAddress)
+	}
+
+	return k.Budgets.Set(ctx, recipient, budget)
+}
+
+// ClaimBudget pays the vested part of the budget of a recipient which was not
+// claimed yet out of the community pool, and returns it. The budget is removed
+// once fully claimed.
+func (k Keeper) ClaimBudget(ctx context.Context, recipient sdk.AccAddress) (sdk.Coin, error) {
+	budget, err := k.Budgets.Get(ctx, recipient)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.Coin{}, errorsmod.Wrapf(types.ErrBudgetNotFound, "recipient %s", recipient)
+		}
+		return sdk.Coin{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+	amount := budget.ClaimableAmount(blockTime)
+	if amount.IsZero() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrNoClaimableBudget, "next tranche vests at %s", nextTrancheTime(budget, blockTime))
+	}
+
+	if err := k.DistributeFromCommunityPool(ctx, sdk.NewCoins(amount), recipient); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	budget.ClaimedAmount = budget.ClaimedAmount.Add(amount)
+	if budget.ClaimedAmount.IsGTE(budget.TotalBudget) {
+		err = k.Budgets.Remove(ctx, recipient)
+	} else {
+		err = k.Budgets.Set(ctx, recipient, budget)
+	}
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimBudget,
+			sdk.NewAttribute(types.AttributeKeyRecipient, budget.RecipientAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return amount, nil
+}
+
+// nextTrancheTime returns the time at which the next tranche of the budget
+// vests after the given time.
+func nextTrancheTime(budget types.Budget, blockTime time.Time) time.Time {
+	if blockTime.Before(budget.StartTime) {
+		return budget.StartTime.Add(budget.Period)
+	}
+
+	periods := blockTime.Sub(budget.StartTime) / budget.Period
+	return budget.StartTime.Add((periods + 1) * budget.Period)
+}
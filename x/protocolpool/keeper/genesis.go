@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+)
+
+// InitGenesis sets the continuous funds and budgets of the genesis state, and
+// ensures the protocolpool module accounts exist.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	for _, name := range []string{types.ModuleName, types.ProtocolPoolEscrowAccount} {
+		k.authKeeper.GetModuleAccount(ctx, name)
+	}
+
+	for _, fund := range data.ContinuousFunds {
+		recipient, err := k.authKeeper.AddressCodec().StringToBytes(fund.Recipient)
+		if err != nil {
+			return err
+		}
+
+		if err := k.ContinuousFunds.Set(ctx, recipient, fund); err != nil {
+			return err
+		}
+	}
+
+	for _, budget := range data.Budgets {
+		recipient, err := k.authKeeper.AddressCodec().StringToBytes(budget.RecipientAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.Budgets.Set(ctx, recipient, budget); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	funds, err := k.GetAllContinuousFunds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	budgets, err := k.GetAllBudgets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(funds, budgets), nil
+}
+
+// GetAllContinuousFunds returns all the continuous funds, ordered by
+// recipient.
+func (k Keeper) GetAllContinuousFunds(ctx context.Context) ([]types.ContinuousFund, error) {
+	funds := []types.ContinuousFund{}
+	err := k.ContinuousFunds.Walk(ctx, nil, func(_ sdk.AccAddress, fund types.ContinuousFund) (bool, error) {
+		funds = append(funds, fund)
+		return false, nil
+	})
+
+	return funds, err
+}
+
+// GetAllBudgets returns all the budgets, ordered by recipient.
+func (k Keeper) GetAllBudgets(ctx context.Context) ([]types.Budget, error) {
+	budgets := []types.Budget{}
+	err := k.Budgets.Walk(ctx, nil, func(_ sdk.AccAddress, budget types.Budget) (bool, error) {
+		budgets = append(budgets, budget)
+		return false, nil
+	})
+
+	return budgets, err
+}
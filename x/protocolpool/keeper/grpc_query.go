@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/protocolpool QueryServer interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// CommunityPool queries the community pool coins.
+func (q queryServer) CommunityPool(ctx context.Context, _ *types.QueryCommunityPoolRequest) (*types.QueryCommunityPoolResponse, error) {
+	pool, err := q.k.GetCommunityPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
+}
+
+// Budget queries the budget of a recipient.
+func (q queryServer) Budget(ctx context.Context, req *types.QueryBudgetRequest) (*types.QueryBudgetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	recipient, err := q.k.authKeeper.AddressCodec().StringToBytes(req.RecipientAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipient address: %s", err)
+	}
+
+	budget, err := q.k.Budgets.Get(ctx, recipient)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no budget found for recipient %s", req.RecipientAddress)
+		}
+		return nil, err
+	}
+
+	return &types.QueryBudgetResponse{
+		Budget:          budget,
+		ClaimableAmount: budget.ClaimableAmount(sdk.UnwrapSDKContext(ctx).BlockTime()),
+	}, nil
+}
+
+// Budgets queries all the budgets.
+func (q queryServer) Budgets(ctx context.Context, _ *types.QueryBudgetsRequest) (*types.QueryBudgetsResponse, error) {
+	budgets, err := q.k.GetAllBudgets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBudgetsResponse{Budgets: budgets}, nil
+}
+
+// ContinuousFund queries the continuous fund of a recipient.
+func (q queryServer) ContinuousFund(ctx context.Context, req *types.QueryContinuousFundRequest) (*types.QueryContinuousFundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	recipient, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Recipient)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipient address: %s", err)
+	}
+
+	fund, err := q.k.ContinuousFunds.Get(ctx, recipient)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no continuous fund found for recipient %s", req.Recipient)
+		}
+		return nil, err
+	}
+
+	return &types.QueryContinuousFundResponse{ContinuousFund: fund}, nil
+}
+
+// ContinuousFunds queries all the continuous funds.
+func (q queryServer) ContinuousFunds(ctx context.Context, _ *types.QueryContinuousFundsRequest) (*types.QueryContinuousFundsResponse, error) {
+	funds, err := q.k.GetAllContinuousFunds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContinuousFundsResponse{ContinuousFunds: funds}, nil
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+)
+
+// Keeper of the protocolpool store
+type Keeper struct {
+	storeService storetypes.KVStoreService
+	cdc          codec.BinaryCodec
+	authKeeper   types.AccountKeeper
+	bankKeeper   types.BankKeeper
+
+	// the address capable of spending from the community pool, and creating
+	// budgets and continuous funds. Typically, this should be the x/gov module
+	// account.
+	authority string
+
+	Schema          collections.Schema
+	ContinuousFunds collections.Map[sdk.AccAddress, types.ContinuousFund]
+	Budgets         collections.Map[sdk.AccAddress, types.Budget]
+}
+
+// NewKeeper creates a new protocolpool Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	authority string,
+) Keeper {
+	// ensure the protocolpool module accounts are set
+	for _, name := range []string{types.ModuleName, types.ProtocolPoolEscrowAccount} {
+		if addr := ak.GetModuleAddress(name); addr == nil {
+			panic(fmt.Sprintf("%s module account has not been set", name))
+		}
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		storeService:    storeService,
+		cdc:             cdc,
+		authKeeper:      ak,
+		bankKeeper:      bk,
+		authority:       authority,
+		ContinuousFunds: collections.NewMap(sb, types.ContinuousFundsKey, "continuous_funds", sdk.AccAddressKey, codec.CollValue[types.ContinuousFund](cdc)),
+		Budgets:         collections.NewMap(sb, types.BudgetsKey, "budgets", sdk.AccAddressKey, codec.CollValue[types.Budget](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/protocolpool module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With(log.ModuleKey, "x/"+types.ModuleName)
+}
+
+// GetCommunityPoolModule returns the name of the module account receiving the
+// community pool inflows from x/distribution, which are streamed to the
+// continuous funds before being added to the community pool.
+func (k Keeper) GetCommunityPoolModule() string {
+	return types.ProtocolPoolEscrowAccount
+}
+
+// FundCommunityPool allows an account to directly fund the community pool.
+func (k Keeper) FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount)
+}
+
+// DistributeFromCommunityPool distributes funds from the community pool to a
+// receiver address.
+func (k Keeper) DistributeFromCommunityPool(ctx context.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error {
+	pool, err := k.GetCommunityPool(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !pool.IsAllGTE(amount) {
+		return types.ErrInsufficientCommunityPool.Wrapf("%s is smaller than %s", pool, amount)
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiveAddr, amount)
+}
+
+// GetCommunityPool returns the coins of the community pool.
+func (k Keeper) GetCommunityPool(ctx context.Context) (sdk.Coins, error) {
+	moduleAccount := k.authKeeper.GetModuleAccount(ctx, types.ModuleName)
+	if moduleAccount == nil {
+		return nil, fmt.Errorf("%s module account has not been set", types.ModuleName)
+	}
+
+	return k.bankKeeper.GetAllBalances(ctx, moduleAccount.GetAddress()), nil
+}
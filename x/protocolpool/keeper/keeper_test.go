@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/keeper"
+	pooltestutil "github.com/cosmos/cosmos-sdk/x/protocolpool/testutil"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+)
+
+var (
+	poolAcc   = authtypes.NewEmptyModuleAccount(types.ModuleName)
+	escrowAcc = authtypes.NewEmptyModuleAccount(types.ProtocolPoolEscrowAccount)
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	poolKeeper  keeper.Keeper
+	authKeeper  *pooltestutil.MockAccountKeeper
+	bankKeeper  *pooltestutil.MockBankKeeper
+	queryServer types.QueryServer
+	msgServer   types.MsgServer
+	authority   string
+	addrs       []sdk.AccAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(protocolpool.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 10, Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	ctrl := gomock.NewController(s.T())
+	s.authKeeper = pooltestutil.NewMockAccountKeeper(ctrl)
+	s.authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	s.authKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(poolAcc.GetAddress()).AnyTimes()
+	s.authKeeper.EXPECT().GetModuleAddress(types.ProtocolPoolEscrowAccount).Return(escrowAcc.GetAddress()).AnyTimes()
+	s.authKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(poolAcc).AnyTimes()
+	s.authKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ProtocolPoolEscrowAccount).Return(escrowAcc).AnyTimes()
+
+	s.bankKeeper = pooltestutil.NewMockBankKeeper(ctrl)
+	s.bankKeeper.EXPECT().BlockedAddr(poolAcc.GetAddress()).Return(true).AnyTimes()
+	s.bankKeeper.EXPECT().BlockedAddr(gomock.Any()).Return(false).AnyTimes()
+
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.poolKeeper = keeper.NewKeeper(encCfg.Codec, storeService, s.authKeeper, s.bankKeeper, s.authority)
+	s.queryServer = keeper.NewQueryServerImpl(s.poolKeeper)
+	s.msgServer = keeper.NewMsgServerImpl(s.poolKeeper)
+	s.addrs = simtestutil.CreateIncrementalAccounts(3)
+}
+
+func (s *KeeperTestSuite) TestDistributeFunds() {
+	ctx, k := s.ctx, s.poolKeeper
+	require := s.Require()
+
+	expiry := ctx.BlockTime().Add(time.Hour)
+	require.NoError(k.AddContinuousFund(ctx, types.ContinuousFund{
+		Recipient:  s.addrs[0].String(),
+		Percentage: math.LegacyNewDecWithPrec(3, 1),
+	}))
+	require.NoError(k.AddContinuousFund(ctx, types.ContinuousFund{
+		Recipient:  s.addrs[1].String(),
+		Percentage: math.LegacyNewDecWithPrec(2, 1),
+		Expiry:     &expiry,
+	}))
+
+	// the inflows are streamed to the continuous funds, and the rest is added
+	// to the community pool
+	inflows := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1005))
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), escrowAcc.GetAddress()).Return(inflows)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ProtocolPoolEscrowAccount, s.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 301))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ProtocolPoolEscrowAccount, s.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 201))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ProtocolPoolEscrowAccount, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 503))).Return(nil)
+	require.NoError(k.DistributeFunds(ctx))
+
+	// the expired continuous funds are removed
+	ctx = ctx.WithBlockTime(expiry)
+	inflows = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), escrowAcc.GetAddress()).Return(inflows)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ProtocolPoolEscrowAccount, s.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ProtocolPoolEscrowAccount, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 7))).Return(nil)
+	require.NoError(k.DistributeFunds(ctx))
+
+	funds, err := k.GetAllContinuousFunds(ctx)
+	require.NoError(err)
+	require.Len(funds, 1)
+	require.Equal(s.addrs[0].String(), funds[0].Recipient)
+
+	// nothing is sent without inflows
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), escrowAcc.GetAddress()).Return(sdk.NewCoins())
+	require.NoError(k.DistributeFunds(ctx))
+}
+
+func (s *KeeperTestSuite) TestClaimBudget() {
+	ctx, k := s.ctx, s.poolKeeper
+	require := s.Require()
+
+	recipient := s.addrs[0]
+	totalBudget := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	require.NoError(k.AddBudget(ctx, types.NewBudget(recipient.String(), totalBudget, ctx.BlockTime(), 3, time.Hour)))
+
+	err := k.AddBudget(ctx, types.NewBudget(recipient.String(), totalBudget, ctx.BlockTime(), 3, time.Hour))
+	require.ErrorIs(err, types.ErrDuplicateBudget)
+
+	// nothing is vested before the end of the first period
+	_, err = k.ClaimBudget(ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute)), recipient)
+	require.ErrorIs(err, types.ErrNoClaimableBudget)
+
+	pool := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), poolAcc.GetAddress()).Return(pool).AnyTimes()
+
+	// two tranches out of three are vested
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2*time.Hour + time.Minute))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipient, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 66))).Return(nil)
+	amount, err := k.ClaimBudget(ctx, recipient)
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 66), amount)
+
+	_, err = k.ClaimBudget(ctx, recipient)
+	require.ErrorIs(err, types.ErrNoClaimableBudget)
+
+	// the remainder is paid with the last tranche, and the budget is removed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipient, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 34))).Return(nil)
+	amount, err = k.ClaimBudget(ctx, recipient)
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 34), amount)
+
+	_, err = k.ClaimBudget(ctx, recipient)
+	require.ErrorIs(err, types.ErrBudgetNotFound)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	ctx, k := s.ctx, s.poolKeeper
+	require := s.Require()
+
+	genesis := types.NewGenesisState(
+		[]types.ContinuousFund{{Recipient: s.addrs[0].String(), Percentage: math.LegacyNewDecWithPrec(1, 1)}},
+		[]types.Budget{types.NewBudget(s.addrs[1].String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), ctx.BlockTime(), 10, time.Hour)},
+	)
+	require.NoError(genesis.Validate())
+	require.NoError(k.InitGenesis(ctx, genesis))
+
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Equal(genesis, exported)
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/protocolpool MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// FundCommunityPool funds the community pool from the depositor account.
+func (k msgServer) FundCommunityPool(ctx context.Context, msg *types.MsgFundCommunityPool) (*types.MsgFundCommunityPoolResponse, error) {
+	depositor, err := k.authKeeper.AddressCodec().StringToBytes(msg.Depositor)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid depositor address: %s", err)
+	}
+
+	if err := validateAmount(msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.FundCommunityPool(ctx, msg.Amount, depositor); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundCommunityPoolResponse{}, nil
+}
+
+// CommunityPoolSpend sends coins from the community pool to a recipient.
+func (k msgServer) CommunityPoolSpend(ctx context.Context, msg *types.MsgCommunityPoolSpend) (*types.MsgCommunityPoolSpendResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := validateAmount(msg.Amount); err != nil {
+		return nil, err
+	}
+
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if err := k.validateRecipient(recipient); err != nil {
+		return nil, err
+	}
+
+	if err := k.DistributeFromCommunityPool(ctx, msg.Amount, recipient); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("transferred from the community pool to recipient", "amount", msg.Amount.String(), "recipient", msg.Recipient)
+
+	return &types.MsgCommunityPoolSpendResponse{}, nil
+}
+
+// SubmitBudgetProposal grants a budget to a recipient out of the community
+// pool.
+func (k msgServer) SubmitBudgetProposal(ctx context.Context, msg *types.MsgSubmitBudgetProposal) (*types.MsgSubmitBudgetProposalResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	startTime := blockTime
+	if msg.StartTime != nil {
+		if msg.StartTime.Before(blockTime) {
+			return nil, errors.Wrapf(types.ErrInvalidBudget, "start time %s cannot be before the block time", msg.StartTime)
+		}
+		startTime = *msg.StartTime
+	}
+
+	budget := types.NewBudget(msg.RecipientAddress, msg.TotalBudget, startTime, msg.Tranches, msg.Period)
+	if err := k.AddBudget(ctx, budget); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitBudgetProposalResponse{}, nil
+}
+
+// ClaimBudget pays the vested part of a budget to its recipient.
+func (k msgServer) ClaimBudget(ctx context.Context, msg *types.MsgClaimBudget) (*types.MsgClaimBudgetResponse, error) {
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(msg.RecipientAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	amount, err := k.Keeper.ClaimBudget(ctx, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimBudgetResponse{Amount: amount}, nil
+}
+
+// CreateContinuousFund creates a continuous fund streaming a percentage of the
+// community pool inflows to a recipient.
+func (k msgServer) CreateContinuousFund(ctx context.Context, msg *types.MsgCreateContinuousFund) (*types.MsgCreateContinuousFundResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	fund := types.ContinuousFund{
+		Recipient:  msg.Recipient,
+		Percentage: msg.Percentage,
+		Expiry:     msg.Expiry,
+	}
+	if err := k.AddContinuousFund(ctx, fund); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateContinuousFundResponse{}, nil
+}
+
+// CancelContinuousFund cancels the continuous fund of a recipient.
+func (k msgServer) CancelContinuousFund(ctx context.Context, msg *types.MsgCancelContinuousFund) (*types.MsgCancelContinuousFundResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if err := k.RemoveContinuousFund(ctx, recipient); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelContinuousFundResponse{CanceledHeight: sdk.UnwrapSDKContext(ctx).BlockHeight()}, nil
+}
+
+func (k msgServer) validateAuthority(authority string) error {
+	if k.authority != authority {
+		return errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+
+	return nil
+}
+
+func validateAmount(amount sdk.Coins) error {
+	if amount == nil {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, "amount cannot be nil")
+	}
+
+	if err := amount.Validate(); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+
+	return nil
+}
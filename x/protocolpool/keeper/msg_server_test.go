@@ -0,0 +1,192 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+)
+
+func (s *KeeperTestSuite) TestMsgCommunityPoolSpend() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), poolAcc.GetAddress()).Return(amount).AnyTimes()
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgCommunityPoolSpend
+		malleate func()
+		expErr   error
+	}{
+		{
+			name:   "invalid authority",
+			msg:    &types.MsgCommunityPoolSpend{Authority: s.addrs[0].String(), Recipient: s.addrs[1].String(), Amount: amount},
+			expErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name:   "blocked recipient",
+			msg:    &types.MsgCommunityPoolSpend{Authority: s.authority, Recipient: poolAcc.GetAddress().String(), Amount: amount},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:   "insufficient community pool",
+			msg:    &types.MsgCommunityPoolSpend{Authority: s.authority, Recipient: s.addrs[1].String(), Amount: amount.Add(amount...)},
+			expErr: types.ErrInsufficientCommunityPool,
+		},
+		{
+			name: "success",
+			msg:  &types.MsgCommunityPoolSpend{Authority: s.authority, Recipient: s.addrs[1].String(), Amount: amount},
+			malleate: func() {
+				s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, s.addrs[1], amount).Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			_, err := msgServer.CommunityPoolSpend(ctx, tc.msg)
+			if tc.expErr != nil {
+				require.ErrorIs(err, tc.expErr)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgSubmitBudgetProposal() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	budget := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	past := ctx.BlockTime().Add(-time.Hour)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSubmitBudgetProposal
+		expErr error
+	}{
+		{
+			name:   "invalid authority",
+			msg:    &types.MsgSubmitBudgetProposal{Authority: s.addrs[0].String(), RecipientAddress: s.addrs[1].String(), TotalBudget: budget, Tranches: 2, Period: time.Hour},
+			expErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name:   "start time in the past",
+			msg:    &types.MsgSubmitBudgetProposal{Authority: s.authority, RecipientAddress: s.addrs[1].String(), TotalBudget: budget, StartTime: &past, Tranches: 2, Period: time.Hour},
+			expErr: types.ErrInvalidBudget,
+		},
+		{
+			name:   "no tranches",
+			msg:    &types.MsgSubmitBudgetProposal{Authority: s.authority, RecipientAddress: s.addrs[1].String(), TotalBudget: budget, Period: time.Hour},
+			expErr: types.ErrInvalidBudget,
+		},
+		{
+			name:   "zero budget",
+			msg:    &types.MsgSubmitBudgetProposal{Authority: s.authority, RecipientAddress: s.addrs[1].String(), TotalBudget: sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), Tranches: 2, Period: time.Hour},
+			expErr: types.ErrInvalidBudget,
+		},
+		{
+			name: "success",
+			msg:  &types.MsgSubmitBudgetProposal{Authority: s.authority, RecipientAddress: s.addrs[1].String(), TotalBudget: budget, Tranches: 2, Period: time.Hour},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := msgServer.SubmitBudgetProposal(ctx, tc.msg)
+			if tc.expErr != nil {
+				require.ErrorIs(err, tc.expErr)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+
+	// the budget starts vesting at the block time
+	res, err := s.queryServer.Budget(ctx, &types.QueryBudgetRequest{RecipientAddress: s.addrs[1].String()})
+	require.NoError(err)
+	require.Equal(ctx.BlockTime(), res.Budget.StartTime)
+	require.True(res.ClaimableAmount.IsZero())
+
+	res, err = s.queryServer.Budget(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)), &types.QueryBudgetRequest{RecipientAddress: s.addrs[1].String()})
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), res.ClaimableAmount)
+}
+
+func (s *KeeperTestSuite) TestMsgContinuousFund() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	expired := ctx.BlockTime()
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgCreateContinuousFund
+		expErr error
+	}{
+		{
+			name:   "invalid authority",
+			msg:    &types.MsgCreateContinuousFund{Authority: s.addrs[0].String(), Recipient: s.addrs[0].String(), Percentage: math.LegacyNewDecWithPrec(5, 1)},
+			expErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name:   "percentage above one",
+			msg:    &types.MsgCreateContinuousFund{Authority: s.authority, Recipient: s.addrs[0].String(), Percentage: math.LegacyNewDecWithPrec(11, 1)},
+			expErr: types.ErrInvalidContinuousFund,
+		},
+		{
+			name:   "expired",
+			msg:    &types.MsgCreateContinuousFund{Authority: s.authority, Recipient: s.addrs[0].String(), Percentage: math.LegacyNewDecWithPrec(5, 1), Expiry: &expired},
+			expErr: types.ErrInvalidContinuousFund,
+		},
+		{
+			name: "success",
+			msg:  &types.MsgCreateContinuousFund{Authority: s.authority, Recipient: s.addrs[0].String(), Percentage: math.LegacyNewDecWithPrec(6, 1)},
+		},
+		{
+			name:   "duplicate recipient",
+			msg:    &types.MsgCreateContinuousFund{Authority: s.authority, Recipient: s.addrs[0].String(), Percentage: math.LegacyNewDecWithPrec(1, 1)},
+			expErr: types.ErrDuplicateContinuousFund,
+		},
+		{
+			name:   "total percentage above one",
+			msg:    &types.MsgCreateContinuousFund{Authority: s.authority, Recipient: s.addrs[1].String(), Percentage: math.LegacyNewDecWithPrec(5, 1)},
+			expErr: types.ErrInvalidContinuousFund,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := msgServer.CreateContinuousFund(ctx, tc.msg)
+			if tc.expErr != nil {
+				require.ErrorIs(err, tc.expErr)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+
+	_, err := msgServer.CancelContinuousFund(ctx, &types.MsgCancelContinuousFund{Authority: s.authority, Recipient: s.addrs[1].String()})
+	require.ErrorIs(err, types.ErrContinuousFundNotFound)
+
+	res, err := msgServer.CancelContinuousFund(ctx, &types.MsgCancelContinuousFund{Authority: s.authority, Recipient: s.addrs[0].String()})
+	require.NoError(err)
+	require.Equal(ctx.BlockHeight(), res.CanceledHeight)
+
+	funds, err := s.queryServer.ContinuousFunds(ctx, &types.QueryContinuousFundsRequest{})
+	require.NoError(err)
+	require.Empty(funds.ContinuousFunds)
+}
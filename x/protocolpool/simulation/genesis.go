@@ -0,0 +1,39 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+)
+
+// Simulation parameter constants
+const (
+	ContinuousFundPercentage = "continuous_fund_percentage"
+)
+
+// GenContinuousFundPercentage randomized continuous fund percentage, between
+// 1% and 50%
+func GenContinuousFundPercentage(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(1+r.Intn(50)), 2)
+}
+
+// RandomizedGenState generates a random GenesisState for protocolpool, with a
+// continuous fund streaming to one of the simulation accounts
+func RandomizedGenState(simState *module.SimulationState) {
+	var percentage math.LegacyDec
+	simState.AppParams.GetOrGenerate(ContinuousFundPercentage, &percentage, simState.Rand, func(r *rand.Rand) { percentage = GenContinuousFundPercentage(r) })
+
+	genesis := types.DefaultGenesis()
+	if len(simState.Accounts) > 0 {
+		recipient := simState.Accounts[simState.Rand.Intn(len(simState.Accounts))]
+		genesis.ContinuousFunds = append(genesis.ContinuousFunds, types.ContinuousFund{
+			Recipient:  recipient.Address.String(),
+			Percentage: percentage,
+		})
+	}
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewBudget creates a new budget, vesting the total budget in the given number
+// of tranches, one every period from the start time.
+func NewBudget(recipient string, totalBudget sdk.Coin, startTime time.Time, tranches uint64, period time.Duration) Budget {
+	return Budget{
+		RecipientAddress: recipient,
+		TotalBudget:      totalBudget,
+		ClaimedAmount:    sdk.NewCoin(totalBudget.Denom, math.ZeroInt()),
+		StartTime:        startTime,
+		Tranches:         tranches,
+		Period:           period,
+	}
+}
+
+// Validate performs basic validation of a budget.
+func (b Budget) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(b.RecipientAddress); err != nil {
+		return errorsmod.Wrapf(ErrInvalidBudget, "invalid recipient address: %s", err)
+	}
+
+	if !b.TotalBudget.IsValid() || !b.TotalBudget.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidBudget, "total budget must be positive: %s", b.TotalBudget)
+	}
+
+	if !b.ClaimedAmount.IsValid() || b.ClaimedAmount.Denom != b.TotalBudget.Denom {
+		return errorsmod.Wrapf(ErrInvalidBudget, "invalid claimed amount: %s", b.ClaimedAmount)
+	}
+
+	if b.ClaimedAmount.Amount.GTE(b.TotalBudget.Amount) {
+		return errorsmod.Wrapf(ErrInvalidBudget, "claimed amount %s must be lower than the total budget %s", b.ClaimedAmount, b.TotalBudget)
+	}
+
+	if b.Tranches == 0 {
+		return errorsmod.Wrap(ErrInvalidBudget, "tranches must be positive")
+	}
+
+	if b.Period <= 0 {
+		return errorsmod.Wrap(ErrInvalidBudget, "period must be positive")
+	}
+
+	return nil
+}
+
+// VestedAmount returns the part of the budget vested at the given time. A
+// tranche vests at the end of each period from the start time.
+func (b Budget) VestedAmount(blockTime time.Time) sdk.Coin {
+	if b.Period <= 0 || b.Tranches == 0 || !blockTime.After(b.StartTime) {
+		return sdk.NewCoin(b.TotalBudget.Denom, math.ZeroInt())
+	}
+
+	vestedTranches := uint64(blockTime.Sub(b.StartTime) / b.Period)
+	if vestedTranches >= b.Tranches {
+		return b.TotalBudget
+	}
+
+	vested := b.TotalBudget.Amount.Mul(math.NewIntFromUint64(vestedTranches)).Quo(math.NewIntFromUint64(b.Tranches))
+	return sdk.NewCoin(b.TotalBudget.Denom, vested)
+}
+
+// ClaimableAmount returns the part of the budget vested at the given time
+// which was not claimed yet.
+func (b Budget) ClaimableAmount(blockTime time.Time) sdk.Coin {
+	return b.VestedAmount(blockTime).Sub(b.ClaimedAmount)
+}
+
+// Validate performs basic validation of a continuous fund.
+func (cf ContinuousFund) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(cf.Recipient); err != nil {
+		return errorsmod.Wrapf(ErrInvalidContinuousFund, "invalid recipient address: %s", err)
+	}
+
+	if cf.Percentage.IsNil() || !cf.Percentage.IsPositive() || cf.Percentage.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidContinuousFund, "percentage must be positive and at most one: %s", cf.Percentage)
+	}
+
+	return nil
+}
+
+// IsExpired returns whether the continuous fund is expired at the given time.
+func (cf ContinuousFund) IsExpired(blockTime time.Time) bool {
+	return cf.Expiry != nil && !blockTime.Before(*cf.Expiry)
+}
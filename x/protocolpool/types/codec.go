@@ -0,0 +1,35 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/protocolpool interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgFundCommunityPool{}, "protocolpool/MsgFundCommunityPool")
+	legacy.RegisterAminoMsg(cdc, &MsgCommunityPoolSpend{}, "protocolpool/MsgCommunityPoolSpend")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitBudgetProposal{}, "protocolpool/MsgSubmitBudgetProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimBudget{}, "protocolpool/MsgClaimBudget")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateContinuousFund{}, "protocolpool/MsgCreateContinuousFund")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelContinuousFund{}, "protocolpool/MsgCancelContinuousFund")
+}
+
+// RegisterInterfaces registers the x/protocolpool interfaces types with the interface registry
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgFundCommunityPool{},
+		&MsgCommunityPoolSpend{},
+		&MsgSubmitBudgetProposal{},
+		&MsgClaimBudget{},
+		&MsgCreateContinuousFund{},
+		&MsgCancelContinuousFund{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,15 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/protocolpool module sentinel errors
+var (
+	ErrInvalidBudget             = errors.Register(ModuleName, 2, "invalid budget")
+	ErrBudgetNotFound            = errors.Register(ModuleName, 3, "budget not found")
+	ErrDuplicateBudget           = errors.Register(ModuleName, 4, "budget already exists")
+	ErrNoClaimableBudget         = errors.Register(ModuleName, 5, "no claimable budget")
+	ErrInvalidContinuousFund     = errors.Register(ModuleName, 6, "invalid continuous fund")
+	ErrContinuousFundNotFound    = errors.Register(ModuleName, 7, "continuous fund not found")
+	ErrDuplicateContinuousFund   = errors.Register(ModuleName, 8, "continuous fund already exists")
+	ErrInsufficientCommunityPool = errors.Register(ModuleName, 9, "community pool does not have sufficient coins to distribute")
+)
@@ -0,0 +1,9 @@
+package types
+
+// protocolpool module event types
+const (
+	EventTypeClaimBudget              = "claim_budget"
+	EventTypeDistributeContinuousFund = "distribute_continuous_fund"
+
+	AttributeKeyRecipient = "recipient"
+)
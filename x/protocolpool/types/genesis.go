@@ -0,0 +1,57 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// NewGenesisState creates a new genesis state for the protocolpool module.
+func NewGenesisState(continuousFunds []ContinuousFund, budgets []Budget) *GenesisState {
+	return &GenesisState{
+		ContinuousFunds: continuousFunds,
+		Budgets:         budgets,
+	}
+}
+
+// DefaultGenesis returns the default genesis state, without any continuous
+// fund or budget.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState([]ContinuousFund{}, []Budget{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	totalPercentage := math.LegacyZeroDec()
+	recipients := make(map[string]bool, len(gs.ContinuousFunds))
+	for _, fund := range gs.ContinuousFunds {
+		if err := fund.Validate(); err != nil {
+			return err
+		}
+
+		if recipients[fund.Recipient] {
+			return errorsmod.Wrapf(ErrDuplicateContinuousFund, "recipient %s", fund.Recipient)
+		}
+		recipients[fund.Recipient] = true
+
+		totalPercentage = totalPercentage.Add(fund.Percentage)
+	}
+
+	if totalPercentage.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidContinuousFund, "total percentage of the continuous funds cannot exceed one: %s", totalPercentage)
+	}
+
+	recipients = make(map[string]bool, len(gs.Budgets))
+	for _, budget := range gs.Budgets {
+		if err := budget.Validate(); err != nil {
+			return err
+		}
+
+		if recipients[budget.RecipientAddress] {
+			return errorsmod.Wrapf(ErrDuplicateBudget, "recipient %s", budget.RecipientAddress)
+		}
+		recipients[budget.RecipientAddress] = true
+	}
+
+	return nil
+}
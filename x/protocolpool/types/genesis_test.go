@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	addrs := []string{
+		sdk.AccAddress("recipient1__________").String(),
+		sdk.AccAddress("recipient2__________").String(),
+	}
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	budget := types.NewBudget(addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), startTime, 4, time.Hour)
+
+	testCases := []struct {
+		name    string
+		genesis *types.GenesisState
+		expErr  error
+	}{
+		{
+			name:    "default genesis",
+			genesis: types.DefaultGenesis(),
+		},
+		{
+			name: "valid genesis",
+			genesis: types.NewGenesisState(
+				[]types.ContinuousFund{
+					{Recipient: addrs[0], Percentage: math.LegacyNewDecWithPrec(5, 1)},
+					{Recipient: addrs[1], Percentage: math.LegacyNewDecWithPrec(5, 1)},
+				},
+				[]types.Budget{budget},
+			),
+		},
+		{
+			name: "total percentage above one",
+			genesis: types.NewGenesisState(
+				[]types.ContinuousFund{
+					{Recipient: addrs[0], Percentage: math.LegacyNewDecWithPrec(5, 1)},
+					{Recipient: addrs[1], Percentage: math.LegacyNewDecWithPrec(6, 1)},
+				},
+				nil,
+			),
+			expErr: types.ErrInvalidContinuousFund,
+		},
+		{
+			name: "duplicate continuous fund",
+			genesis: types.NewGenesisState(
+				[]types.ContinuousFund{
+					{Recipient: addrs[0], Percentage: math.LegacyNewDecWithPrec(1, 1)},
+					{Recipient: addrs[0], Percentage: math.LegacyNewDecWithPrec(1, 1)},
+				},
+				nil,
+			),
+			expErr: types.ErrDuplicateContinuousFund,
+		},
+		{
+			name:    "duplicate budget",
+			genesis: types.NewGenesisState(nil, []types.Budget{budget, budget}),
+			expErr:  types.ErrDuplicateBudget,
+		},
+		{
+			name: "fully claimed budget",
+			genesis: types.NewGenesisState(nil, []types.Budget{func() types.Budget {
+				b := budget
+				b.ClaimedAmount = b.TotalBudget
+				return b
+			}()}),
+			expErr: types.ErrInvalidBudget,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBudgetVestedAmount(t *testing.T) {
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	budget := types.NewBudget(sdk.AccAddress("recipient___________").String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), startTime, 3, time.Hour)
+
+	require.True(t, budget.VestedAmount(startTime.Add(-time.Hour)).IsZero())
+	require.True(t, budget.VestedAmount(startTime.Add(59*time.Minute)).IsZero())
+	require.Equal(t, int64(33), budget.VestedAmount(startTime.Add(time.Hour)).Amount.Int64())
+	require.Equal(t, int64(66), budget.VestedAmount(startTime.Add(2*time.Hour)).Amount.Int64())
+	require.Equal(t, int64(100), budget.VestedAmount(startTime.Add(3*time.Hour)).Amount.Int64())
+	require.Equal(t, int64(100), budget.VestedAmount(startTime.Add(30*time.Hour)).Amount.Int64())
+
+	budget.ClaimedAmount = sdk.NewInt64Coin(sdk.DefaultBondDenom, 33)
+	require.Equal(t, int64(33), budget.ClaimableAmount(startTime.Add(2*time.Hour)).Amount.Int64())
+}
@@ -0,0 +1,20 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "protocolpool"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// ProtocolPoolEscrowAccount is the name of the module account receiving the
+	// community pool inflows, before they are streamed to the continuous funds
+	ProtocolPoolEscrowAccount = "protocolpool_escrow"
+)
+
+var (
+	ContinuousFundsKey = collections.NewPrefix(1) // key for the continuous funds, by recipient
+	BudgetsKey         = collections.NewPrefix(2) // key for the budgets, by recipient
+)
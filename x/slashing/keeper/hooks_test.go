@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -41,3 +42,39 @@ func (s *KeeperTestSuite) TestAfterValidatorCreatedOrRemoved() {
 	_, err = keeper.GetPubkey(ctx, addr.Bytes())
 	require.Error(err)
 }
+
+func (s *KeeperTestSuite) TestAfterConsensusPubKeyUpdate() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	_, oldPubKey, oldAddr := testdata.KeyTestPubAddr()
+	_, newPubKey, newAddr := testdata.KeyTestPubAddr()
+	oldConsAddr, newConsAddr := sdk.ConsAddress(oldAddr), sdk.ConsAddress(newAddr)
+
+	require.NoError(keeper.AddPubkey(ctx, oldPubKey))
+	require.NoError(keeper.Hooks().AfterValidatorBonded(ctx, oldConsAddr, sdk.ValAddress(oldAddr)))
+	require.NoError(keeper.SetMissedBlockBitmapValue(ctx, oldConsAddr, 3, true))
+
+	require.NoError(keeper.Hooks().AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey))
+
+	// the signing info and the missed blocks are moved to the new address
+	_, err := keeper.GetValidatorSigningInfo(ctx, oldConsAddr)
+	require.ErrorIs(err, slashingtypes.ErrNoSigningInfoFound)
+	info, err := keeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.NoError(err)
+	require.Equal(newConsAddr.String(), info.Address)
+
+	missed, err := keeper.GetMissedBlockBitmapValue(ctx, newConsAddr, 3)
+	require.NoError(err)
+	require.True(missed)
+	missed, err = keeper.GetMissedBlockBitmapValue(ctx, oldConsAddr, 3)
+	require.NoError(err)
+	require.False(missed)
+
+	// both pubkeys can be resolved for evidence handling
+	pk, err := keeper.GetPubkey(ctx, newAddr.Bytes())
+	require.NoError(err)
+	require.Equal(newPubKey, pk)
+	_, err = keeper.GetPubkey(ctx, oldAddr.Bytes())
+	require.NoError(err)
+}
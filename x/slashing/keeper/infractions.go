@@ -33,6 +33,19 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 		return nil
 	}
 
+	// the validator may have rotated its consensus key, in which case the
+	// block may still be signed with the old key
+	validator, err := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+
+	valConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+	consAddr = valConsAddr
+
 	// fetch signing info
 	signInfo, err := k.GetValidatorSigningInfo(ctx, consAddr)
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -269,3 +270,66 @@ func (k Keeper) GetValidatorMissedBlocks(ctx context.Context, addr sdk.ConsAddre
 
 	return missedBlocks, err
 }
+
+// performConsensusPubKeyUpdate moves the signing info and the missed blocks
+// bitmap of a validator from its old consensus address to the new one, and
+// stores the address-pubkey relation of the new consensus pubkey. The relation
+// of the old pubkey is kept so that evidence of infractions committed with it
+// can still be handled.
+func (k Keeper) performConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	if err := k.AddPubkey(ctx, newPubKey); err != nil {
+		return err
+	}
+
+	oldConsAddr := sdk.ConsAddress(oldPubKey.Address())
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+
+	signingInfo, err := k.GetValidatorSigningInfo(ctx, oldConsAddr)
+	if err != nil {
+		// the validator was never bonded
+		if errors.IsOf(err, types.ErrNoSigningInfoFound) {
+			return nil
+		}
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.ValidatorSigningInfoKey(oldConsAddr)); err != nil {
+		return err
+	}
+
+	signingInfo.Address = newConsAddr.String()
+	if err := k.SetValidatorSigningInfo(ctx, newConsAddr, signingInfo); err != nil {
+		return err
+	}
+
+	oldPrefix := types.ValidatorMissedBlockBitmapPrefixKey(oldConsAddr)
+	newPrefix := types.ValidatorMissedBlockBitmapPrefixKey(newConsAddr)
+	iter, err := store.Iterator(oldPrefix, storetypes.PrefixEndBytes(oldPrefix))
+	if err != nil {
+		return err
+	}
+
+	var keys, values [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+		values = append(values, iter.Value())
+	}
+
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for i, key := range keys {
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+
+		chunkKey := append(append([]byte{}, newPrefix...), key[len(oldPrefix):]...)
+		if err := store.Set(chunkKey, values[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
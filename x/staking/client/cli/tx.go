@@ -50,6 +50,11 @@ func NewTxCmd(valAddrCodec, ac address.Codec) *cobra.Command {
 		NewRedelegateCmd(valAddrCodec, ac),
 		NewUnbondCmd(valAddrCodec, ac),
 		NewCancelUnbondingDelegation(valAddrCodec, ac),
+		NewRotateConsPubKeyCmd(valAddrCodec),
+		NewTokenizeSharesCmd(valAddrCodec, ac),
+		NewRedeemTokensCmd(ac),
+		NewTransferTokenizeShareRecordCmd(ac),
+		NewValidatorBondCmd(valAddrCodec, ac),
 	)
 
 	return stakingTxCmd
@@ -379,6 +384,231 @@ $ %s tx staking cancel-unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake
 	return cmd
 }
 
+// NewRotateConsPubKeyCmd returns a CLI command handler for creating a MsgRotateConsPubKey transaction.
+func NewRotateConsPubKeyCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-cons-pubkey [pubkey]",
+		Short: "rotate the consensus pubkey of a validator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			`Rotate the consensus pubkey of the validator operated by the sender. The key rotation fee is
+burned from the operator account, and a validator can only rotate its key once per unbonding period.`,
+		),
+		Example: fmt.Sprintf(`$ %s tx staking rotate-cons-pubkey '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"oWg2ISpLF405Jcm2vXV+2v4fnjodh6aafuIdeoW+rUw="}' --from mykey
+
+where we can get the pubkey using "%s tendermint show-validator"`,
+			version.AppName, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			valAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgRotateConsPubKey(valAddr, pk)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewTokenizeSharesCmd returns a CLI command handler for creating a MsgTokenizeShares transaction.
+func NewTokenizeSharesCmd(valAddrCodec, ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share [validator-addr] [amount] [rewards-owner]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Tokenize a delegation into share tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Tokenize an amount of a delegation to a validator into transferable share tokens.
+The rewards of the tokenized delegation accrue to the rewards owner.
+
+Example:
+$ %s tx staking tokenize-share cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			delAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if _, err := valAddrCodec.StringToBytes(args[0]); err != nil {
+				return err
+			}
+
+			if _, err := ac.StringToBytes(args[2]); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTokenizeShares(delAddr, args[0], amount, args[2])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRedeemTokensCmd returns a CLI command handler for creating a MsgRedeemTokensForShares transaction.
+func NewRedeemTokensCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem-tokens [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Redeem share tokens for a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Redeem an amount of share tokens for a delegation to the validator of the tokenized shares.
+
+Example:
+$ %s tx staking redeem-tokens 100cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			delAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeemTokensForShares(delAddr, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewTransferTokenizeShareRecordCmd returns a CLI command handler for creating a MsgTransferTokenizeShareRecord transaction.
+func NewTransferTokenizeShareRecordCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-tokenize-share-record [record-id] [new-owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Transfer the ownership of a tokenize share record",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer the ownership of a tokenize share record, and thereby of the rewards of the tokenized delegation.
+
+Example:
+$ %s tx staking transfer-tokenize-share-record 1 cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recordID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(err, "invalid record id")
+			}
+
+			sender, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if _, err := ac.StringToBytes(args[1]); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferTokenizeShareRecord(recordID, sender, args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewValidatorBondCmd returns a CLI command handler for creating a MsgValidatorBond transaction.
+func NewValidatorBondCmd(valAddrCodec, ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-bond [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Flag a delegation as a validator bond",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Flag the delegation of the sender to a validator as a validator bond, allowing the validator
+to receive tokenized delegations when the validator bond factor is enabled.
+
+Example:
+$ %s tx staking validator-bond cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if _, err := valAddrCodec.StringToBytes(args[0]); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgValidatorBond(delAddr, args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet, val validator, valAc address.Codec) (tx.Factory, *types.MsgCreateValidator, error) {
 	valAddr := clientCtx.GetFromAddress()
 
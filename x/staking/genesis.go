@@ -5,6 +5,7 @@ import (
 
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -48,9 +49,27 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisEpochMsgs(data.EpochMsgs); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+// validateGenesisEpochMsgs checks that only delegations, undelegations and
+// redelegations are queued until the end of the epoch.
+func validateGenesisEpochMsgs(msgs []*codectypes.Any) error {
+	for _, msgAny := range msgs {
+		switch msgAny.GetCachedValue().(type) {
+		case *types.MsgDelegate, *types.MsgUndelegate, *types.MsgBeginRedelegate:
+		default:
+			return types.ErrInvalidEpochMsg.Wrapf("unexpected message type %s", msgAny.TypeUrl)
+		}
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
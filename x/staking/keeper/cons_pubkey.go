@@ -0,0 +1,347 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetConsPubKeyRotationHistory stores the given consensus pubkey rotation of a
+// validator. Until the unbonding period of the rotation elapses, the rotation
+// is also indexed by the consensus address the validator rotated from, so that
+// infractions committed with the old consensus key can still be attributed to
+// the validator, and it prevents the validator from rotating its key again.
+func (k Keeper) SetConsPubKeyRotationHistory(ctx context.Context, history types.ConsPubKeyRotationHistory) error {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(history.OperatorAddress)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&history)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetConsPubKeyRotationHistoryKey(valAddr, history.Height), bz); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !history.CompletionTime.After(sdkCtx.BlockHeader().Time) {
+		return nil
+	}
+
+	oldPk, err := history.OldConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	if err := store.Set(types.GetValidatorConsPubKeyRotationKey(valAddr), bz); err != nil {
+		return err
+	}
+
+	if err := store.Set(types.GetRotatedConsAddrKey(sdk.ConsAddress(oldPk.Address())), valAddr); err != nil {
+		return err
+	}
+
+	return store.Set(types.GetConsPubKeyRotationQueueKey(history.CompletionTime, valAddr), []byte{})
+}
+
+// GetConsPubKeyRotationHistory returns the consensus pubkey rotations of a
+// validator, ordered by height.
+func (k Keeper) GetConsPubKeyRotationHistory(ctx context.Context, valAddr sdk.ValAddress) ([]types.ConsPubKeyRotationHistory, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	prefix := types.GetConsPubKeyRotationHistoriesKey(valAddr)
+	iterator, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var histories []types.ConsPubKeyRotationHistory
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ConsPubKeyRotationHistory
+		if err := k.cdc.Unmarshal(iterator.Value(), &history); err != nil {
+			return nil, err
+		}
+		histories = append(histories, history)
+	}
+
+	return histories, nil
+}
+
+// GetAllConsPubKeyRotationHistory returns the consensus pubkey rotations of all
+// the validators.
+func (k Keeper) GetAllConsPubKeyRotationHistory(ctx context.Context) ([]types.ConsPubKeyRotationHistory, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.ConsPubKeyRotationHistoryKey, storetypes.PrefixEndBytes(types.ConsPubKeyRotationHistoryKey))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var histories []types.ConsPubKeyRotationHistory
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ConsPubKeyRotationHistory
+		if err := k.cdc.Unmarshal(iterator.Value(), &history); err != nil {
+			return nil, err
+		}
+		histories = append(histories, history)
+	}
+
+	return histories, nil
+}
+
+// GetValidatorConsPubKeyRotation returns the last consensus pubkey rotation of
+// a validator, as long as its unbonding period did not elapse.
+func (k Keeper) GetValidatorConsPubKeyRotation(ctx context.Context, valAddr sdk.ValAddress) (history types.ConsPubKeyRotationHistory, found bool, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetValidatorConsPubKeyRotationKey(valAddr))
+	if err != nil || bz == nil {
+		return history, false, err
+	}
+
+	if err := k.cdc.Unmarshal(bz, &history); err != nil {
+		return history, false, err
+	}
+
+	return history, true, nil
+}
+
+// getRotatedConsAddrValidator returns the operator address of the validator
+// which rotated its consensus key from the given consensus address, within the
+// unbonding period.
+func (k Keeper) getRotatedConsAddrValidator(ctx context.Context, consAddr sdk.ConsAddress) (sdk.ValAddress, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	opAddr, err := store.Get(types.GetRotatedConsAddrKey(consAddr))
+	if err != nil {
+		return nil, err
+	}
+
+	if opAddr == nil {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	return opAddr, nil
+}
+
+// RotateConsPubKey replaces the consensus pubkey of a validator, charging the
+// key rotation fee to its operator. The validator set updates replacing the
+// old key with the new one are returned to CometBFT at the end of the block.
+func (k Keeper) RotateConsPubKey(ctx context.Context, validator types.Validator, newPubKey cryptotypes.PubKey) error {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+	if _, err := k.GetValidatorByConsAddr(ctx, newConsAddr); err == nil {
+		return types.ErrConsensusPubKeyAlreadyUsed
+	} else if !errors.Is(err, types.ErrNoValidatorFound) {
+		return err
+	}
+
+	// a validator can only rotate its consensus key once per unbonding period
+	if _, found, err := k.GetValidatorConsPubKeyRotation(ctx, valAddr); err != nil {
+		return err
+	} else if found {
+		return types.ErrExceedingMaxConsPubKeyRotations
+	}
+
+	oldPubKey, err := validator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the fee is burned
+	fee := params.KeyRotationFee
+	if fee.IsValid() && fee.IsPositive() {
+		coins := sdk.NewCoins(fee)
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(valAddr), types.NotBondedPoolName, coins); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, types.NotBondedPoolName, coins); err != nil {
+			return err
+		}
+	} else {
+		fee = sdk.Coin{}
+	}
+
+	newPkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return err
+	}
+
+	oldPkAny := validator.ConsensusPubkey
+	validator.ConsensusPubkey = newPkAny
+
+	if err := k.SetValidator(ctx, validator); err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetValidatorByConsAddrKey(sdk.ConsAddress(oldPubKey.Address()))); err != nil {
+		return err
+	}
+
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	history := types.ConsPubKeyRotationHistory{
+		OperatorAddress: validator.GetOperator(),
+		OldConsPubkey:   oldPkAny,
+		NewConsPubkey:   validator.ConsensusPubkey,
+		Height:          sdkCtx.BlockHeight(),
+		CompletionTime:  sdkCtx.BlockHeader().Time.Add(params.UnbondingTime),
+		Fee:             fee,
+	}
+
+	if err := k.SetConsPubKeyRotationHistory(ctx, history); err != nil {
+		return err
+	}
+
+	// CometBFT only knows about the key the validator first rotated from in
+	// this block
+	blockKey := types.GetBlockConsPubKeyRotationKey(valAddr)
+	rotated, err := store.Has(blockKey)
+	if err != nil {
+		return err
+	}
+
+	if !rotated {
+		bz, err := k.cdc.Marshal(&history)
+		if err != nil {
+			return err
+		}
+
+		if err := store.Set(blockKey, bz); err != nil {
+			return err
+		}
+	}
+
+	return k.Hooks().AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey)
+}
+
+// getBlockConsPubKeyRotations returns the consensus pubkeys the validators
+// rotated from in the current block, by operator address, and clears them.
+func (k Keeper) getBlockConsPubKeyRotations(ctx context.Context) (map[string]cryptotypes.PubKey, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.BlockConsPubKeyRotationKey, storetypes.PrefixEndBytes(types.BlockConsPubKeyRotationKey))
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		keys       [][]byte
+		oldPubKeys = make(map[string]cryptotypes.PubKey)
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ConsPubKeyRotationHistory
+		if err := k.cdc.Unmarshal(iterator.Value(), &history); err != nil {
+			iterator.Close()
+			return nil, err
+		}
+
+		oldPk, err := history.OldConsPubKey()
+		if err != nil {
+			iterator.Close()
+			return nil, err
+		}
+
+		oldPubKeys[history.OperatorAddress] = oldPk
+		keys = append(keys, iterator.Key())
+	}
+
+	if err := iterator.Close(); err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		if err := store.Delete(key); err != nil {
+			return nil, err
+		}
+	}
+
+	return oldPubKeys, nil
+}
+
+// PruneMatureConsPubKeyRotations stops tracking the consensus pubkeys rotated
+// from once the unbonding period of their rotation elapsed.
+func (k Keeper) PruneMatureConsPubKeyRotations(ctx context.Context, currTime time.Time) error {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.ConsPubKeyRotationQueueKey, storetypes.PrefixEndBytes(types.GetConsPubKeyRotationTimeKey(currTime)))
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		valAddr := types.ParseConsPubKeyRotationQueueKey(key)
+		history, found, err := k.GetValidatorConsPubKeyRotation(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		if found {
+			oldPk, err := history.OldConsPubKey()
+			if err != nil {
+				return err
+			}
+
+			if err := store.Delete(types.GetRotatedConsAddrKey(sdk.ConsAddress(oldPk.Address()))); err != nil {
+				return err
+			}
+
+			if err := store.Delete(types.GetValidatorConsPubKeyRotationKey(valAddr)); err != nil {
+				return err
+			}
+		}
+
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// consPubKeyUpdateZero returns the validator update removing the given
+// consensus pubkey from the CometBFT validator set.
+func consPubKeyUpdateZero(pk cryptotypes.PubKey) (abci.ValidatorUpdate, error) {
+	cmtPk, err := cryptocodec.ToCmtProtoPublicKey(pk)
+	if err != nil {
+		return abci.ValidatorUpdate{}, err
+	}
+
+	return abci.ValidatorUpdate{
+		PubKey: cmtPk,
+		Power:  0,
+	}, nil
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestMsgRotateConsPubKey() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	var validators [2]stakingtypes.Validator
+	for i := range validators {
+		validators[i] = testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validators[i], _ = validators[i].AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+		require.NoError(keeper.SetValidatorByConsAddr(ctx, validators[i]))
+
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+		validators[i] = stakingkeeper.TestingUpdateValidator(keeper, ctx, validators[i], true)
+	}
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	fee := sdk.NewCoins(params.KeyRotationFee)
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	oldConsAddr := sdk.ConsAddress(PKs[0].Address())
+	newConsAddr := sdk.ConsAddress(PKs[2].Address())
+
+	testCases := []struct {
+		name      string
+		pubKey    int
+		malleate  func()
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "pubkey used by another validator",
+			pubKey:    1,
+			expErr:    true,
+			expErrMsg: stakingtypes.ErrConsensusPubKeyAlreadyUsed.Error(),
+		},
+		{
+			name:   "success",
+			pubKey: 2,
+			malleate: func() {
+				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(valAddr), stakingtypes.NotBondedPoolName, fee).Return(nil)
+				s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.NotBondedPoolName, fee).Return(nil)
+			},
+		},
+		{
+			name:      "pubkey rotated from by the validator",
+			pubKey:    0,
+			expErr:    true,
+			expErrMsg: stakingtypes.ErrConsensusPubKeyAlreadyUsed.Error(),
+		},
+		{
+			name:      "second rotation within the unbonding period",
+			pubKey:    3,
+			expErr:    true,
+			expErrMsg: stakingtypes.ErrExceedingMaxConsPubKeyRotations.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			msg, err := stakingtypes.NewMsgRotateConsPubKey(validators[0].GetOperator(), PKs[tc.pubKey])
+			require.NoError(err)
+
+			_, err = msgServer.RotateConsPubKey(ctx, msg)
+			if tc.expErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+
+	// both consensus addresses map to the validator
+	validator, err := keeper.GetValidatorByConsAddr(ctx, newConsAddr)
+	require.NoError(err)
+	require.Equal(validators[0].GetOperator(), validator.GetOperator())
+	validator, err = keeper.GetValidatorByConsAddr(ctx, oldConsAddr)
+	require.NoError(err)
+	require.Equal(validators[0].GetOperator(), validator.GetOperator())
+
+	// the old key is replaced in the CometBFT validator set
+	updates := s.applyValidatorSetUpdates(ctx, keeper, 2)
+	require.Equal(validator.ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[0])
+	require.Equal(validators[0].ABCIValidatorUpdateZero(), updates[1])
+	s.applyValidatorSetUpdates(ctx, keeper, 0)
+
+	history, err := keeper.GetConsPubKeyRotationHistory(ctx, valAddr)
+	require.NoError(err)
+	require.Len(history, 1)
+	require.Equal(params.KeyRotationFee, history[0].Fee)
+	require.Equal(ctx.BlockTime().Add(params.UnbondingTime), history[0].CompletionTime)
+
+	// the old consensus address is released after the unbonding period
+	ctx = ctx.WithBlockTime(history[0].CompletionTime)
+	require.NoError(keeper.PruneMatureConsPubKeyRotations(ctx, ctx.BlockTime()))
+	_, err = keeper.GetValidatorByConsAddr(ctx, oldConsAddr)
+	require.ErrorIs(err, stakingtypes.ErrNoValidatorFound)
+
+	_, found, err := keeper.GetValidatorConsPubKeyRotation(ctx, valAddr)
+	require.NoError(err)
+	require.False(found)
+
+	history, err = keeper.GetAllConsPubKeyRotationHistory(ctx)
+	require.NoError(err)
+	require.Len(history, 1)
+}
+
+func (s *KeeperTestSuite) TestRotateConsPubKeyUnbondingValidator() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	require.NoError(keeper.SetParams(ctx, func() stakingtypes.Params {
+		params := stakingtypes.DefaultParams()
+		params.KeyRotationFee = sdk.NewCoin(params.BondDenom, math.ZeroInt())
+		params.MaxValidators = 1
+		return params
+	}()))
+
+	validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	validator = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+
+	// the validator rotates its key, and is kicked out of the active set in
+	// the same block
+	require.NoError(keeper.RotateConsPubKey(ctx, validator, PKs[1]))
+
+	other := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[2].Address().Bytes()), PKs[2])
+	other, _ = other.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 20))
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, other))
+	require.NoError(keeper.SetValidator(ctx, other))
+	require.NoError(keeper.SetValidatorByPowerIndex(ctx, other))
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any()).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, gomock.Any()).AnyTimes()
+	updates := s.applyValidatorSetUpdates(ctx, keeper, 2)
+	other, err := keeper.GetValidator(ctx, sdk.ValAddress(PKs[2].Address().Bytes()))
+	require.NoError(err)
+	require.Equal(other.ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[0])
+
+	// only the key known to CometBFT is removed
+	require.Equal(validator.ABCIValidatorUpdateZero(), updates[1])
+}
@@ -950,11 +950,19 @@ func (k Keeper) Delegate(
 		}
 	}
 
-	_, newShares, err = k.AddValidatorTokensAndShares(ctx, validator, bondAmt)
+	validator, newShares, err = k.AddValidatorTokensAndShares(ctx, validator, bondAmt)
 	if err != nil {
 		return newShares, err
 	}
 
+	// the shares added to a validator bond delegation increase the validator bond
+	if delegation.ValidatorBond {
+		validator.ValidatorBondShares = validator.ValidatorBondShares.Add(newShares)
+		if err = k.SetValidator(ctx, validator); err != nil {
+			return newShares, err
+		}
+	}
+
 	// Update delegation
 	delegation.Shares = delegation.Shares.Add(newShares)
 	if err = k.SetDelegation(ctx, delegation); err != nil {
@@ -1023,6 +1031,12 @@ func (k Keeper) Unbond(
 		validator = k.mustGetValidator(ctx, valbz)
 	}
 
+	// the shares removed from a validator bond delegation decrease the validator
+	// bond, which is persisted along with the removed tokens and shares below
+	if delegation.ValidatorBond {
+		validator.ValidatorBondShares = validator.ValidatorBondShares.Sub(math.LegacyMinDec(shares, validator.ValidatorBondShares))
+	}
+
 	if delegation.Shares.IsZero() {
 		err = k.RemoveDelegation(ctx, delegation)
 	} else {
@@ -1103,13 +1117,23 @@ func (k Keeper) getBeginInfo(
 // processed during the staking EndBlocker.
 func (k Keeper) Undelegate(
 	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount math.LegacyDec,
+) (time.Time, math.Int, error) {
+	return k.UndelegateTo(ctx, delAddr, delAddr, valAddr, sharesAmount)
+}
+
+// UndelegateTo unbonds an amount of delegator shares from a given validator
+// like Undelegate, but the created unbonding delegation belongs to receiver,
+// which gets the unbonded tokens once the unbonding period is over. It is used
+// to claw back the unvested tokens delegated by a vesting account.
+func (k Keeper) UndelegateTo(
+	ctx context.Context, delAddr, receiver sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount math.LegacyDec,
 ) (time.Time, math.Int, error) {
 	validator, err := k.GetValidator(ctx, valAddr)
 	if err != nil {
 		return time.Time{}, math.Int{}, err
 	}
 
-	hasMaxEntries, err := k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr)
+	hasMaxEntries, err := k.HasMaxUnbondingDelegationEntries(ctx, receiver, valAddr)
 	if err != nil {
 		return time.Time{}, math.Int{}, err
 	}
@@ -1138,7 +1162,7 @@ func (k Keeper) Undelegate(
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	completionTime := sdkCtx.BlockHeader().Time.Add(unbondingTime)
-	ubd, err := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, sdkCtx.BlockHeight(), completionTime, returnAmount)
+	ubd, err := k.SetUnbondingDelegationEntry(ctx, receiver, valAddr, sdkCtx.BlockHeight(), completionTime, returnAmount)
 	if err != nil {
 		return time.Time{}, math.Int{}, err
 	}
@@ -396,6 +396,42 @@ func (s *KeeperTestSuite) TestUnbondDelegation() {
 	require.Equal(remainingTokens, validator.BondedTokens())
 }
 
+func (s *KeeperTestSuite) TestUndelegateTo() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(2)
+
+	startTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	_ = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+
+	delegation := stakingtypes.NewDelegation(delAddrs[0].String(), valAddrs[0].String(), issuedShares)
+	require.NoError(keeper.SetDelegation(ctx, delegation))
+
+	// the unbonding delegation belongs to the receiver
+	bondTokens := keeper.TokensFromConsensusPower(ctx, 6)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, gomock.Any())
+	_, amount, err := keeper.UndelegateTo(ctx, delAddrs[0], delAddrs[1], valAddrs[0], math.LegacyNewDecFromInt(bondTokens))
+	require.NoError(err)
+	require.Equal(bondTokens, amount)
+
+	delegation, err = keeper.GetDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.NoError(err)
+	require.Equal(startTokens.Sub(bondTokens), delegation.Shares.RoundInt())
+
+	_, err = keeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.ErrorIs(err, stakingtypes.ErrNoUnbondingDelegation)
+
+	ubd, err := keeper.GetUnbondingDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.NoError(err)
+	require.Len(ubd.Entries, 1)
+	require.Equal(bondTokens, ubd.Entries[0].Balance)
+}
+
 // // test undelegating self delegation from a validator pushing it below MinSelfDelegation
 // // shift it from the bonded to unbonding state and jailed
 func (s *KeeperTestSuite) TestUndelegateSelfDelegationBelowMinSelfDelegation() {
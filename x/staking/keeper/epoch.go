@@ -0,0 +1,243 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"strconv"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// IsEpochEnd returns whether the current block ends a staking epoch. It is
+// always false when epoched staking is disabled.
+func (k Keeper) IsEpochEnd(ctx context.Context) (bool, error) {
+	epochLength, err := k.EpochLength(ctx)
+	if err != nil || epochLength <= 0 {
+		return false, err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).BlockHeight()%epochLength == 0, nil
+}
+
+// incrementEpochMsgSequence increments and returns the sequence of the next
+// queued staking message
+func (k Keeper) incrementEpochMsgSequence(ctx context.Context) (sequence uint64, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.EpochMsgSequenceKey)
+	if err != nil {
+		return 0, err
+	}
+
+	if bz != nil {
+		sequence = binary.BigEndian.Uint64(bz)
+	}
+
+	sequence++
+
+	bz = make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, sequence)
+
+	if err = store.Set(types.EpochMsgSequenceKey, bz); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
+
+// QueueEpochMsg queues a staking message until the end of the epoch. The
+// tokens of a MsgDelegate must already be escrowed in the not bonded pool.
+func (k Keeper) QueueEpochMsg(ctx context.Context, msg sdk.Msg) (uint64, error) {
+	sequence, err := k.incrementEpochMsgSequence(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	bz, err := k.cdc.MarshalInterface(msg)
+	if err != nil {
+		return 0, err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetEpochMsgQueueKey(sequence), bz); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
+
+// IterateEpochMsgs iterates through the queued staking messages, in the order
+// they were queued. Iteration stops when the handler returns true.
+func (k Keeper) IterateEpochMsgs(ctx context.Context, handler func(sequence uint64, msg sdk.Msg) (stop bool, err error)) error {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.EpochMsgQueueKey, storetypes.PrefixEndBytes(types.EpochMsgQueueKey))
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var msg sdk.Msg
+		if err := k.cdc.UnmarshalInterface(iterator.Value(), &msg); err != nil {
+			return err
+		}
+
+		sequence := binary.BigEndian.Uint64(iterator.Key()[len(types.EpochMsgQueueKey):])
+		stop, err := handler(sequence, msg)
+		if err != nil {
+			return err
+		}
+
+		if stop {
+			break
+		}
+	}
+
+	return nil
+}
+
+// GetEpochMsgs returns the staking messages queued until the end of the
+// epoch, in the order they were queued.
+func (k Keeper) GetEpochMsgs(ctx context.Context) (msgs []sdk.Msg, err error) {
+	err = k.IterateEpochMsgs(ctx, func(_ uint64, msg sdk.Msg) (bool, error) {
+		msgs = append(msgs, msg)
+		return false, nil
+	})
+
+	return msgs, err
+}
+
+// GetEpochEscrowedTokens returns the amount of tokens escrowed in the not
+// bonded pool by the queued delegations.
+func (k Keeper) GetEpochEscrowedTokens(ctx context.Context) (math.Int, error) {
+	escrowed := math.ZeroInt()
+	err := k.IterateEpochMsgs(ctx, func(_ uint64, msg sdk.Msg) (bool, error) {
+		if msg, ok := msg.(*types.MsgDelegate); ok {
+			escrowed = escrowed.Add(msg.Amount.Amount)
+		}
+		return false, nil
+	})
+
+	return escrowed, err
+}
+
+// ApplyEpochMsgs executes the staking messages queued during the epoch, in the
+// order they were queued, and clears the queue. A message which fails is
+// skipped without affecting the others, and the tokens escrowed by a failing
+// delegation are returned to the delegator.
+func (k Keeper) ApplyEpochMsgs(ctx context.Context) error {
+	var (
+		sequences []uint64
+		msgs      []sdk.Msg
+	)
+	err := k.IterateEpochMsgs(ctx, func(sequence uint64, msg sdk.Msg) (bool, error) {
+		sequences = append(sequences, sequence)
+		msgs = append(msgs, msg)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := k.storeService.OpenKVStore(ctx)
+	srv := msgServer{Keeper: &k, applyingEpoch: true}
+	for i, msg := range msgs {
+		if err := store.Delete(types.GetEpochMsgQueueKey(sequences[i])); err != nil {
+			return err
+		}
+
+		// the escrowed tokens are returned to the delegator before the
+		// delegation is executed, so that they remain with the delegator
+		// when it fails
+		if msg, ok := msg.(*types.MsgDelegate); ok {
+			delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+			if err != nil {
+				return err
+			}
+
+			coins := sdk.NewCoins(msg.Amount)
+			if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delegatorAddress, coins); err != nil {
+				return err
+			}
+		}
+
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := srv.executeEpochMsg(cacheCtx, msg); err != nil {
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeEpochMsgFailed,
+					sdk.NewAttribute(types.AttributeKeyEpochMsgSequence, strconv.FormatUint(sequences[i], 10)),
+					sdk.NewAttribute(types.AttributeKeyEpochMsgType, sdk.MsgTypeURL(msg)),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+			continue
+		}
+
+		write()
+	}
+
+	return nil
+}
+
+// executeEpochMsg executes a queued staking message through the msg server.
+func (k msgServer) executeEpochMsg(ctx context.Context, msg sdk.Msg) (err error) {
+	switch msg := msg.(type) {
+	case *types.MsgDelegate:
+		_, err = k.Delegate(ctx, msg)
+	case *types.MsgUndelegate:
+		_, err = k.Undelegate(ctx, msg)
+	case *types.MsgBeginRedelegate:
+		_, err = k.BeginRedelegate(ctx, msg)
+	default:
+		err = types.ErrInvalidEpochMsg.Wrapf("unexpected message type %s", sdk.MsgTypeURL(msg))
+	}
+
+	return err
+}
+
+// queueEpochMsg queues the message until the end of the epoch when epoched
+// staking is enabled, and returns whether it was queued. The tokens of a
+// MsgDelegate are escrowed in the not bonded pool.
+func (k msgServer) queueEpochMsg(ctx context.Context, msg sdk.Msg) (bool, error) {
+	if k.applyingEpoch {
+		return false, nil
+	}
+
+	epochLength, err := k.EpochLength(ctx)
+	if err != nil || epochLength <= 0 {
+		return false, err
+	}
+
+	if msg, ok := msg.(*types.MsgDelegate); ok {
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+		if err != nil {
+			return false, err
+		}
+
+		coins := sdk.NewCoins(msg.Amount)
+		if err := k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, delegatorAddress, types.NotBondedPoolName, coins); err != nil {
+			return false, err
+		}
+	}
+
+	sequence, err := k.QueueEpochMsg(ctx, msg)
+	if err != nil {
+		return false, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueueEpochMsg,
+			sdk.NewAttribute(types.AttributeKeyEpochMsgSequence, strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyEpochMsgType, sdk.MsgTypeURL(msg)),
+		),
+	)
+
+	return true, nil
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestEpochedStaking() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	comm := stakingtypes.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	createMsg, err := stakingtypes.NewMsgCreateValidator(ValAddr.String(), ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), stakingtypes.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, createMsg)
+	require.NoError(err)
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	params.EpochLength = 10
+	require.NoError(keeper.SetParams(ctx, params))
+
+	ctx = ctx.WithBlockHeight(9)
+	epochEnd, err := keeper.IsEpochEnd(ctx)
+	require.NoError(err)
+	require.False(epochEnd)
+
+	// the delegation is escrowed and queued
+	delegateMsg := stakingtypes.NewMsgDelegate(Addr.String(), ValAddr.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	_, err = msgServer.Delegate(ctx, delegateMsg)
+	require.NoError(err)
+
+	validator, err := keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.NewInt(10), validator.Tokens)
+
+	escrowed, err := keeper.GetEpochEscrowedTokens(ctx)
+	require.NoError(err)
+	require.Equal(math.NewInt(100), escrowed)
+
+	// the undelegation is validated before being queued
+	_, err = msgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(Addr.String(), ValAddr.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)))
+	require.Error(err)
+
+	msgs, err := keeper.GetEpochMsgs(ctx)
+	require.NoError(err)
+	require.Len(msgs, 1)
+
+	// the queued messages are executed at the end of the epoch
+	ctx = ctx.WithBlockHeight(10)
+	epochEnd, err = keeper.IsEpochEnd(ctx)
+	require.NoError(err)
+	require.True(epochEnd)
+
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, Addr, sdk.NewCoins(delegateMsg.Amount)).Return(nil)
+	require.NoError(keeper.ApplyEpochMsgs(ctx))
+
+	validator, err = keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.NewInt(110), validator.Tokens)
+
+	msgs, err = keeper.GetEpochMsgs(ctx)
+	require.NoError(err)
+	require.Empty(msgs)
+
+	// a failing delegation does not prevent the other messages from being
+	// executed, and its tokens are returned to the delegator
+	_, err = msgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(Addr.String(), ValAddr.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 110)))
+	require.NoError(err)
+	delegateMsg = stakingtypes.NewMsgDelegate(Addr.String(), ValAddr.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+	_, err = msgServer.Delegate(ctx, delegateMsg)
+	require.NoError(err)
+
+	ctx = ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, Addr, sdk.NewCoins(delegateMsg.Amount)).Return(nil)
+	require.NoError(keeper.ApplyEpochMsgs(ctx))
+
+	_, err = keeper.GetValidator(ctx, ValAddr)
+	require.ErrorIs(err, stakingtypes.ErrNoValidatorFound)
+
+	var failed []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == stakingtypes.EventTypeEpochMsgFailed {
+			failed = append(failed, event)
+		}
+	}
+	require.Len(failed, 1)
+
+	msgs, err = keeper.GetEpochMsgs(ctx)
+	require.NoError(err)
+	require.Empty(msgs)
+}
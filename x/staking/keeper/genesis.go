@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/math"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -137,6 +138,44 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) (res
 		}
 	}
 
+	for _, history := range data.RotationHistory {
+		if err := k.SetConsPubKeyRotationHistory(ctx, history); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, msgAny := range data.EpochMsgs {
+		msg, ok := msgAny.GetCachedValue().(sdk.Msg)
+		if !ok {
+			panic(fmt.Sprintf("invalid epoch message %s", msgAny.TypeUrl))
+		}
+
+		if _, err := k.QueueEpochMsg(ctx, msg); err != nil {
+			panic(err)
+		}
+
+		// the tokens of the queued delegations are escrowed
+		if msg, ok := msg.(*types.MsgDelegate); ok {
+			notBondedTokens = notBondedTokens.Add(msg.Amount.Amount)
+		}
+	}
+
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId); err != nil {
+		panic(err)
+	}
+
+	if !data.TotalLiquidStakedTokens.IsNil() {
+		if err := k.SetTotalLiquidStakedTokens(ctx, data.TotalLiquidStakedTokens); err != nil {
+			panic(err)
+		}
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -266,6 +305,39 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	rotationHistory, err := k.GetAllConsPubKeyRotationHistory(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	epochMsgs, err := k.GetEpochMsgs(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	tokenizeShareRecords, err := k.GetAllTokenizeShareRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	lastTokenizeShareRecordID, err := k.GetLastTokenizeShareRecordID(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	totalLiquidStakedTokens, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	epochMsgAnys := make([]*codectypes.Any, len(epochMsgs))
+	for i, msg := range epochMsgs {
+		epochMsgAnys[i], err = codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	return &types.GenesisState{
 		Params:               params,
 		LastTotalPower:       totalPower,
@@ -275,5 +347,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+		RotationHistory:      rotationHistory,
+		EpochMsgs:            epochMsgAnys,
+
+		TokenizeShareRecords:      tokenizeShareRecords,
+		LastTokenizeShareRecordId: lastTokenizeShareRecordID,
+		TotalLiquidStakedTokens:   totalLiquidStakedTokens,
 	}
 }
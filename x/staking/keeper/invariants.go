@@ -82,6 +82,14 @@ func ModuleAccountInvariants(k *Keeper) sdk.Invariant {
 			panic(err)
 		}
 
+		// the tokens of the delegations queued until the end of the epoch are
+		// escrowed in the not bonded pool
+		escrowed, err := k.GetEpochEscrowedTokens(ctx)
+		if err != nil {
+			panic(err)
+		}
+		notBonded = notBonded.Add(escrowed)
+
 		poolBonded := k.bankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom)
 		poolNotBonded := k.bankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom)
 		broken := !poolBonded.Amount.Equal(bonded) || !poolNotBonded.Amount.Equal(notBonded)
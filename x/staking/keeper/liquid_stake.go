@@ -0,0 +1,640 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetTotalLiquidStakedTokens returns the total amount of liquid staked tokens,
+// i.e. of tokens of tokenized delegations.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx context.Context) (math.Int, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.TotalLiquidStakedTokensKey)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	if bz == nil {
+		return math.ZeroInt(), nil
+	}
+
+	ip := sdk.IntProto{}
+	if err := k.cdc.Unmarshal(bz, &ip); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	return ip.Int, nil
+}
+
+// SetTotalLiquidStakedTokens sets the total amount of liquid staked tokens.
+func (k Keeper) SetTotalLiquidStakedTokens(ctx context.Context, tokens math.Int) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&sdk.IntProto{Int: tokens})
+	if err != nil {
+		return err
+	}
+	return store.Set(types.TotalLiquidStakedTokensKey, bz)
+}
+
+// CheckExceedsGlobalLiquidStakingCap returns whether liquid staking the given
+// amount of tokens would exceed the global liquid staking cap. If the tokens
+// are not bonded yet, they are added to the total bonded tokens.
+func (k Keeper) CheckExceedsGlobalLiquidStakingCap(ctx context.Context, tokens math.Int, sharesAlreadyBonded bool) (bool, error) {
+	liquidStakingCap, err := k.GlobalLiquidStakingCap(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	liquidStakedAmount, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	totalStakedAmount, err := k.TotalBondedTokens(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if !sharesAlreadyBonded {
+		totalStakedAmount = totalStakedAmount.Add(tokens)
+	}
+
+	updatedLiquidStaked := liquidStakedAmount.Add(tokens)
+	if !totalStakedAmount.IsPositive() {
+		return updatedLiquidStaked.IsPositive() && liquidStakingCap.LT(math.LegacyOneDec()), nil
+	}
+
+	liquidStakePercent := math.LegacyNewDecFromInt(updatedLiquidStaked).QuoInt(totalStakedAmount)
+	return liquidStakePercent.GT(liquidStakingCap), nil
+}
+
+// CheckExceedsValidatorLiquidStakingCap returns whether liquid staking the
+// given shares of an existing delegation to the validator would exceed the
+// validator liquid staking cap.
+func (k Keeper) CheckExceedsValidatorLiquidStakingCap(ctx context.Context, validator types.Validator, shares math.LegacyDec) (bool, error) {
+	liquidStakingCap, err := k.ValidatorLiquidStakingCap(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if !validator.DelegatorShares.IsPositive() {
+		return false, nil
+	}
+
+	updatedLiquidShares := validator.LiquidShares.Add(shares)
+	liquidStakePercent := updatedLiquidShares.Quo(validator.DelegatorShares)
+	return liquidStakePercent.GT(liquidStakingCap), nil
+}
+
+// CheckExceedsValidatorBondCap returns whether liquid staking the given
+// shares would exceed the liquid shares the validator bond of the validator
+// allows for. It is always false when the validator bond factor is disabled.
+func (k Keeper) CheckExceedsValidatorBondCap(ctx context.Context, validator types.Validator, shares math.LegacyDec) (bool, error) {
+	validatorBondFactor, err := k.ValidatorBondFactor(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if validatorBondFactor.IsNegative() {
+		return false, nil
+	}
+
+	maxValLiquidShares := validator.ValidatorBondShares.Mul(validatorBondFactor)
+	return validator.LiquidShares.Add(shares).GT(maxValLiquidShares), nil
+}
+
+// SafelyIncreaseTotalLiquidStakedTokens increases the total amount of liquid
+// staked tokens, failing if the global liquid staking cap would be exceeded.
+func (k Keeper) SafelyIncreaseTotalLiquidStakedTokens(ctx context.Context, amount math.Int, sharesAlreadyBonded bool) error {
+	exceeded, err := k.CheckExceedsGlobalLiquidStakingCap(ctx, amount, sharesAlreadyBonded)
+	if err != nil {
+		return err
+	}
+
+	if exceeded {
+		return types.ErrGlobalLiquidStakingCapExceeded
+	}
+
+	totalLiquidStaked, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.SetTotalLiquidStakedTokens(ctx, totalLiquidStaked.Add(amount))
+}
+
+// DecreaseTotalLiquidStakedTokens decreases the total amount of liquid staked
+// tokens, down to zero.
+func (k Keeper) DecreaseTotalLiquidStakedTokens(ctx context.Context, amount math.Int) error {
+	totalLiquidStaked, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	totalLiquidStaked = totalLiquidStaked.Sub(math.MinInt(amount, totalLiquidStaked))
+	return k.SetTotalLiquidStakedTokens(ctx, totalLiquidStaked)
+}
+
+// SafelyIncreaseValidatorLiquidShares increases the liquid shares of a
+// validator, failing if either the validator liquid staking cap or the
+// validator bond cap would be exceeded.
+func (k Keeper) SafelyIncreaseValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec) (types.Validator, error) {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return validator, err
+	}
+
+	exceeded, err := k.CheckExceedsValidatorBondCap(ctx, validator, shares)
+	if err != nil {
+		return validator, err
+	}
+
+	if exceeded {
+		return validator, types.ErrInsufficientValidatorBondShares
+	}
+
+	exceeded, err = k.CheckExceedsValidatorLiquidStakingCap(ctx, validator, shares)
+	if err != nil {
+		return validator, err
+	}
+
+	if exceeded {
+		return validator, types.ErrValidatorLiquidStakingCapExceeded
+	}
+
+	validator.LiquidShares = validator.LiquidShares.Add(shares)
+	return validator, k.SetValidator(ctx, validator)
+}
+
+// DecreaseValidatorLiquidShares decreases the liquid shares of a validator,
+// down to zero.
+func (k Keeper) DecreaseValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec) (types.Validator, error) {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return validator, err
+	}
+
+	validator.LiquidShares = validator.LiquidShares.Sub(math.LegacyMinDec(shares, validator.LiquidShares))
+	return validator, k.SetValidator(ctx, validator)
+}
+
+// ValidateValidatorBondDecrease returns an error if removing the given shares
+// from the validator bond of the validator would leave it with more liquid
+// shares than its validator bond allows for.
+func (k Keeper) ValidateValidatorBondDecrease(ctx context.Context, validator types.Validator, shares math.LegacyDec) error {
+	validatorBondFactor, err := k.ValidatorBondFactor(ctx)
+	if err != nil {
+		return err
+	}
+
+	if validatorBondFactor.IsNegative() {
+		return nil
+	}
+
+	validatorBondShares := validator.ValidatorBondShares.Sub(math.LegacyMinDec(shares, validator.ValidatorBondShares))
+	if validator.LiquidShares.GT(validatorBondShares.Mul(validatorBondFactor)) {
+		return types.ErrInsufficientValidatorBondShares
+	}
+
+	return nil
+}
+
+// validateValidatorBondUnbond returns an error if the delegation is a validator
+// bond and unbonding the given shares would leave the validator with more
+// liquid shares than its validator bond allows for.
+func (k Keeper) validateValidatorBondUnbond(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	delegation, err := k.GetDelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if !delegation.ValidatorBond {
+		return nil
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.ValidateValidatorBondDecrease(ctx, validator, shares)
+}
+
+// TokenizeShares converts the given amount of a delegation into share tokens
+// sent to the delegator. The delegation is moved to the module account of a new
+// tokenize share record owned by the given owner, which is entitled to its
+// rewards, so that it keeps the same slashing exposure.
+func (k Keeper) TokenizeShares(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int, owner sdk.AccAddress,
+) (sdk.Coin, error) {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	delegation, err := k.GetDelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if delegation.ValidatorBond {
+		return sdk.Coin{}, types.ErrValidatorBondNotAllowedForTokenizeShare
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// vesting accounts can only tokenize their free delegations, as the share
+	// tokens are not subject to vesting
+	if acc, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestexported.VestingAccount); ok {
+		if acc.GetDelegatedFree().AmountOf(bondDenom).LT(amount) {
+			return sdk.Coin{}, types.ErrExceedingFreeVestingDelegations
+		}
+	}
+
+	tokens := validator.TokensFromShares(shares).TruncateInt()
+	if err := k.SafelyIncreaseTotalLiquidStakedTokens(ctx, tokens, validator.IsBonded()); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if _, err := k.SafelyIncreaseValidatorLiquidShares(ctx, valAddr, shares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	recordID, err := k.GetLastTokenizeShareRecordID(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	recordID++
+	if err := k.SetLastTokenizeShareRecordID(ctx, recordID); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ownerStr, err := k.authKeeper.AddressCodec().BytesToString(owner)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	record := types.NewTokenizeShareRecord(recordID, ownerStr, validator.GetOperator())
+	if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		if err := k.bondedTokensToNotBonded(ctx, returnAmount); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	returnCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, returnCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), shares.TruncateInt())
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// delegate the tokens back from the module account of the record, which
+	// also creates the account
+	if err := k.bankKeeper.SendCoins(ctx, delAddr, record.GetModuleAddress(), returnCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if _, err := k.Delegate(ctx, record.GetModuleAddress(), returnAmount, types.Unbonded, validator, true); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return shareToken, nil
+}
+
+// RedeemTokensForShares burns the given share tokens and converts them back
+// into a delegation of the redeemer. The rewards accrued by the tokenized
+// delegation are paid out to the owner of the tokenize share record, and the
+// record is removed once all its share tokens are redeemed.
+func (k Keeper) RedeemTokensForShares(ctx context.Context, delAddr sdk.AccAddress, shareToken sdk.Coin) (sdk.Coin, error) {
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	moduleAddr := record.GetModuleAddress()
+	delegation, err := k.GetDelegation(ctx, moduleAddr, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// share tokens are minted for the truncated shares, so that the remaining
+	// fraction of a share is redeemed along with the last share tokens
+	shares := math.LegacyNewDecFromInt(shareToken.Amount)
+	if shareToken.Amount.Equal(k.bankKeeper.GetSupply(ctx, shareToken.Denom).Amount) {
+		shares = delegation.Shares
+	}
+
+	if shares.GT(delegation.Shares) {
+		return sdk.Coin{}, types.ErrNotEnoughDelegationShares
+	}
+
+	tokens := validator.TokensFromShares(shares).TruncateInt()
+	if err := k.DecreaseTotalLiquidStakedTokens(ctx, tokens); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if _, err := k.DecreaseValidatorLiquidShares(ctx, valAddr, shares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, moduleAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		if err := k.bondedTokensToNotBonded(ctx, returnAmount); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	// the module account only holds the rewards withdrawn when its delegation
+	// is modified, which belong to the record owner
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if rewards := k.bankKeeper.GetAllBalances(ctx, moduleAddr); !rewards.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, moduleAddr, owner, rewards); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if _, err := k.GetDelegation(ctx, moduleAddr, valAddr); errors.Is(err, types.ErrNoDelegation) {
+		if err := k.DeleteTokenizeShareRecord(ctx, record.Id); err != nil {
+			return sdk.Coin{}, err
+		}
+	} else if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.NotBondedPoolName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.NotBondedPoolName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the redeemed tokens are delegated straight from the not bonded pool
+	if _, err := k.Delegate(ctx, delAddr, returnAmount, types.Unbonded, validator, false); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(bondDenom, returnAmount), nil
+}
+
+// GetLastTokenizeShareRecordID returns the id of the last created tokenize
+// share record.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx context.Context) (uint64, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.LastTokenizeShareRecordIDKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last created tokenize share
+// record.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx context.Context, id uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return store.Set(types.LastTokenizeShareRecordIDKey, bz)
+}
+
+// GetTokenizeShareRecord returns the tokenize share record with the given id.
+func (k Keeper) GetTokenizeShareRecord(ctx context.Context, id uint64) (record types.TokenizeShareRecord, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetTokenizeShareRecordKey(id))
+	if err != nil {
+		return record, err
+	}
+
+	if bz == nil {
+		return record, types.ErrTokenizeShareRecordNotExists
+	}
+
+	err = k.cdc.Unmarshal(bz, &record)
+	return record, err
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record of the given
+// share token denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx context.Context, denom string) (types.TokenizeShareRecord, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
+	if err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+
+	if bz == nil {
+		return types.TokenizeShareRecord{}, types.ErrTokenizeShareRecordNotExists
+	}
+
+	return k.GetTokenizeShareRecord(ctx, binary.BigEndian.Uint64(bz))
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records owned by
+// the given address.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx context.Context, owner sdk.AccAddress) ([]types.TokenizeShareRecord, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	prefix := types.GetTokenizeShareRecordIDsByOwnerPrefix(owner)
+	iterator, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var records []types.TokenizeShareRecord
+	for ; iterator.Valid(); iterator.Next() {
+		record, err := k.GetTokenizeShareRecord(ctx, binary.BigEndian.Uint64(iterator.Value()))
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx context.Context) ([]types.TokenizeShareRecord, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.TokenizeShareRecordKey, storetypes.PrefixEndBytes(types.TokenizeShareRecordKey))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var records []types.TokenizeShareRecord
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		if err := k.cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// AddTokenizeShareRecord stores a new tokenize share record along with its
+// owner and denom indexes.
+func (k Keeper) AddTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	_, err := k.GetTokenizeShareRecord(ctx, record.Id)
+	if err == nil {
+		return types.ErrTokenizeShareRecordAlreadyExists
+	} else if !errors.Is(err, types.ErrTokenizeShareRecordNotExists) {
+		return err
+	}
+
+	if err := k.setTokenizeShareRecord(ctx, record); err != nil {
+		return err
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, record.Id)
+
+	if err := store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, record.Id), bz); err != nil {
+		return err
+	}
+
+	return store.Set(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()), bz)
+}
+
+// DeleteTokenizeShareRecord removes a tokenize share record along with its
+// owner and denom indexes.
+func (k Keeper) DeleteTokenizeShareRecord(ctx context.Context, id uint64) error {
+	record, err := k.GetTokenizeShareRecord(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetTokenizeShareRecordKey(id)); err != nil {
+		return err
+	}
+
+	if err := store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, id)); err != nil {
+		return err
+	}
+
+	return store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
+}
+
+// TransferTokenizeShareRecord transfers the ownership of a tokenize share
+// record to a new owner.
+func (k Keeper) TransferTokenizeShareRecord(ctx context.Context, id uint64, newOwner sdk.AccAddress) error {
+	record, err := k.GetTokenizeShareRecord(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	oldOwner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	record.Owner, err = k.authKeeper.AddressCodec().BytesToString(newOwner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.setTokenizeShareRecord(ctx, record); err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(oldOwner, id)); err != nil {
+		return err
+	}
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(newOwner, id), bz)
+}
+
+func (k Keeper) setTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&record)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.GetTokenizeShareRecordKey(record.Id), bz)
+}
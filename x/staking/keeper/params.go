@@ -56,6 +56,34 @@ func (k Keeper) MinCommissionRate(ctx context.Context) (math.LegacyDec, error) {
 	return params.MinCommissionRate, err
 }
 
+// EpochLength - Number of blocks of a staking epoch, epoched staking is
+// disabled when zero
+func (k Keeper) EpochLength(ctx context.Context) (int64, error) {
+	params, err := k.GetParams(ctx)
+	return params.EpochLength, err
+}
+
+// GlobalLiquidStakingCap - Maximum fraction of the total bonded tokens that
+// can be liquid staked
+func (k Keeper) GlobalLiquidStakingCap(ctx context.Context) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	return params.GlobalLiquidStakingCap, err
+}
+
+// ValidatorLiquidStakingCap - Maximum fraction of the delegator shares of a
+// validator that can be liquid staked
+func (k Keeper) ValidatorLiquidStakingCap(ctx context.Context) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	return params.ValidatorLiquidStakingCap, err
+}
+
+// ValidatorBondFactor - Number of liquid shares a validator can receive per
+// validator bond share, disabled when negative
+func (k Keeper) ValidatorBondFactor(ctx context.Context) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	return params.ValidatorBondFactor, err
+}
+
 // SetParams sets the x/staking module parameters.
 // CONTRACT: This method performs no validation of the parameters.
 func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
@@ -172,6 +172,14 @@ func (k Keeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionH
 		}
 	}
 
+	// the liquid staked tokens are slashed along with the validator tokens
+	if !validator.LiquidShares.IsNil() && validator.LiquidShares.IsPositive() && validator.DelegatorShares.IsPositive() {
+		liquidTokensSlashed := math.LegacyNewDecFromInt(tokensToBurn).Mul(validator.LiquidShares).Quo(validator.DelegatorShares).TruncateInt()
+		if err := k.DecreaseTotalLiquidStakedTokens(ctx, liquidTokensSlashed); err != nil {
+			return math.NewInt(0), err
+		}
+	}
+
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
 	validator, err = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
@@ -19,6 +19,21 @@ import (
 // BlockValidatorUpdates calculates the ValidatorUpdates for the current block
 // Called in each EndBlock
 func (k Keeper) BlockValidatorUpdates(ctx context.Context) ([]abci.ValidatorUpdate, error) {
+	// In epoched staking, the delegations, undelegations and redelegations
+	// queued during the epoch are executed at its end, before the validator
+	// set changes are calculated. Slashing, jailing and unbonding completions
+	// are not epoched.
+	epochEnd, err := k.IsEpochEnd(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if epochEnd {
+		if err := k.ApplyEpochMsgs(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	// Calculate validator set changes.
 	//
 	// NOTE: ApplyAndReturnValidatorSetUpdates has to come before
@@ -40,6 +55,12 @@ func (k Keeper) BlockValidatorUpdates(ctx context.Context) ([]abci.ValidatorUpda
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	// Stop tracking the consensus pubkeys rotated from once their unbonding
+	// period elapsed.
+	if err := k.PruneMatureConsPubKeyRotations(ctx, sdkCtx.BlockHeader().Time); err != nil {
+		return nil, err
+	}
+
 	// Remove all mature unbonding delegations from the ubd queue.
 	matureUnbonds, err := k.DequeueAllMatureUBDQueue(ctx, sdkCtx.BlockHeader().Time)
 	if err != nil {
@@ -145,6 +166,13 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 		return nil, err
 	}
 
+	// Retrieve the consensus pubkeys rotated from in this block, which have to
+	// be removed from the CometBFT validator set.
+	rotatedPubKeys, err := k.getBlockConsPubKeyRotations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Iterate over validators, highest power to lowest.
 	iterator, err := k.ValidatorsPowerStoreIterator(ctx)
 	if err != nil {
@@ -206,6 +234,20 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 			}
 		}
 
+		// replace the rotated consensus pubkey of a validator already known to
+		// CometBFT
+		if oldPk, rotated := rotatedPubKeys[valAddrStr]; rotated && found {
+			if bytes.Equal(oldPowerBytes, newPowerBytes) {
+				updates = append(updates, validator.ABCIValidatorUpdate(powerReduction))
+			}
+
+			update, err := consPubKeyUpdateZero(oldPk)
+			if err != nil {
+				return nil, err
+			}
+			updates = append(updates, update)
+		}
+
 		delete(last, valAddrStr)
 		count++
 
@@ -232,6 +274,17 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 			return nil, err
 		}
 
+		// CometBFT only knows about the consensus pubkey the validator
+		// rotated from
+		if oldPk, rotated := rotatedPubKeys[validator.GetOperator()]; rotated {
+			update, err := consPubKeyUpdateZero(oldPk)
+			if err != nil {
+				return nil, err
+			}
+			updates = append(updates, update)
+			continue
+		}
+
 		updates = append(updates, validator.ABCIValidatorUpdateZero())
 	}
 
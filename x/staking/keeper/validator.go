@@ -15,6 +15,7 @@ import (
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -52,7 +53,12 @@ func (k Keeper) GetValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAdd
 	}
 
 	if opAddr == nil {
-		return validator, types.ErrNoValidatorFound
+		// the validator may have rotated its consensus key within the
+		// unbonding period
+		opAddr, err = k.getRotatedConsAddrValidator(ctx, consAddr)
+		if err != nil {
+			return validator, err
+		}
 	}
 
 	return k.GetValidator(ctx, opAddr)
@@ -637,6 +643,36 @@ func (k Keeper) GetPubKeyByConsAddr(ctx context.Context, addr sdk.ConsAddress) (
 		return cmtprotocrypto.PublicKey{}, err
 	}
 
+	consAddr, err := v.GetConsAddr()
+	if err != nil {
+		return cmtprotocrypto.PublicKey{}, err
+	}
+
+	// the validator rotated its consensus key from the given address, which
+	// may still be in use until the rotation is applied by CometBFT
+	if !addr.Equals(sdk.ConsAddress(consAddr)) {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(v.GetOperator())
+		if err != nil {
+			return cmtprotocrypto.PublicKey{}, err
+		}
+
+		history, found, err := k.GetValidatorConsPubKeyRotation(ctx, valAddr)
+		if err != nil {
+			return cmtprotocrypto.PublicKey{}, err
+		}
+
+		if found {
+			oldPk, err := history.OldConsPubKey()
+			if err != nil {
+				return cmtprotocrypto.PublicKey{}, err
+			}
+
+			if addr.Equals(sdk.ConsAddress(oldPk.Address())) {
+				return cryptocodec.ToCmtProtoPublicKey(oldPk)
+			}
+		}
+	}
+
 	pubkey, err := v.CmtConsPublicKey()
 	if err != nil {
 		return cmtprotocrypto.PublicKey{}, err
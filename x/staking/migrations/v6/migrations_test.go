@@ -0,0 +1,43 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v6 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v6"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	params := types.DefaultParams()
+	params.BondDenom = "uatom"
+	params.KeyRotationFee = sdk.Coin{}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	require.NoError(t, v6.MigrateStore(ctx, store, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ParamsKey), &res))
+	require.Equal(t, sdk.NewCoin("uatom", types.DefaultKeyRotationFee.Amount), res.KeyRotationFee)
+
+	// an already set fee is kept
+	res.KeyRotationFee = sdk.NewInt64Coin("uatom", 5)
+	store.Set(types.ParamsKey, cdc.MustMarshal(&res))
+
+	require.NoError(t, v6.MigrateStore(ctx, store, cdc))
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ParamsKey), &res))
+	require.Equal(t, sdk.NewInt64Coin("uatom", 5), res.KeyRotationFee)
+}
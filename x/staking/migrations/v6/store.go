@@ -0,0 +1,47 @@
+package v6
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6. The migration
+// includes:
+//
+// - Setting the default key rotation fee, in the bond denom, in the params.
+func MigrateStore(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	return migrateParams(store, cdc)
+}
+
+func migrateParams(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return nil
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	if params.KeyRotationFee.Denom == "" {
+		params.KeyRotationFee = sdk.NewCoin(params.BondDenom, types.DefaultKeyRotationFee.Amount)
+	}
+
+	// only the migrated param is validated, as params added in later versions
+	// are not set yet
+	if err := params.KeyRotationFee.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
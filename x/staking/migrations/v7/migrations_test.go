@@ -0,0 +1,51 @@
+package v7_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v7 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v7"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	params := types.DefaultParams()
+	params.GlobalLiquidStakingCap = math.LegacyDec{}
+	params.ValidatorLiquidStakingCap = math.LegacyDec{}
+	params.ValidatorBondFactor = math.LegacyDec{}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	valAddr := sdk.ValAddress("val")
+	validator := types.Validator{
+		OperatorAddress: valAddr.String(),
+		Tokens:          math.NewInt(10),
+		DelegatorShares: math.LegacyNewDec(10),
+	}
+	store.Set(types.GetValidatorKey(valAddr), cdc.MustMarshal(&validator))
+
+	require.NoError(t, v7.MigrateStore(ctx, store, cdc))
+
+	var resParams types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ParamsKey), &resParams))
+	require.Equal(t, types.DefaultParams(), resParams)
+
+	var resValidator types.Validator
+	require.NoError(t, cdc.Unmarshal(store.Get(types.GetValidatorKey(valAddr)), &resValidator))
+	require.True(t, resValidator.ValidatorBondShares.IsZero())
+	require.True(t, resValidator.LiquidShares.IsZero())
+	require.Equal(t, math.LegacyNewDec(10), resValidator.DelegatorShares)
+}
@@ -0,0 +1,75 @@
+package v7
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v6 to v7. The migration
+// includes:
+//
+// - Setting the default liquid staking caps and validator bond factor in the params.
+// - Setting the validator bond shares and liquid shares of the validators to zero.
+func MigrateStore(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	if err := migrateParams(store, cdc); err != nil {
+		return err
+	}
+
+	return migrateValidators(store, cdc)
+}
+
+func migrateParams(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return nil
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	params.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	params.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+	params.ValidatorBondFactor = types.DefaultValidatorBondFactor
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
+
+func migrateValidators(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	iterator := storetypes.KVStorePrefixIterator(store, types.ValidatorsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var validator types.Validator
+		if err := cdc.Unmarshal(iterator.Value(), &validator); err != nil {
+			return err
+		}
+
+		validator.ValidatorBondShares = math.LegacyZeroDec()
+		validator.LiquidShares = math.LegacyZeroDec()
+
+		bz, err := cdc.Marshal(&validator)
+		if err != nil {
+			return err
+		}
+
+		store.Set(iterator.Key(), bz)
+	}
+
+	return nil
+}
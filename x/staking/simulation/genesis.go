@@ -55,7 +55,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, simState.BondDenom, minCommissionRate)
+	keyRotationFee := sdk.NewCoin(simState.BondDenom, types.DefaultKeyRotationFee.Amount)
+	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, simState.BondDenom, minCommissionRate, keyRotationFee, types.DefaultEpochLength,
+		types.DefaultGlobalLiquidStakingCap, types.DefaultValidatorLiquidStakingCap, types.DefaultValidatorBondFactor)
 
 	// validators & delegations
 	var (
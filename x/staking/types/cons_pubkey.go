@@ -0,0 +1,45 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = ConsPubKeyRotationHistory{}
+
+// OldConsPubKey returns the consensus public key of the validator before the
+// rotation.
+func (h ConsPubKeyRotationHistory) OldConsPubKey() (cryptotypes.PubKey, error) {
+	return cachedPubKey(h.OldConsPubkey)
+}
+
+// NewConsPubKey returns the consensus public key of the validator after the
+// rotation.
+func (h ConsPubKeyRotationHistory) NewConsPubKey() (cryptotypes.PubKey, error) {
+	return cachedPubKey(h.NewConsPubkey)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (h ConsPubKeyRotationHistory) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var oldPk, newPk cryptotypes.PubKey
+	if err := unpacker.UnpackAny(h.OldConsPubkey, &oldPk); err != nil {
+		return err
+	}
+	return unpacker.UnpackAny(h.NewConsPubkey, &newPk)
+}
+
+func cachedPubKey(pkAny *codectypes.Any) (cryptotypes.PubKey, error) {
+	if pkAny == nil {
+		return nil, ErrEmptyValidatorPubKey
+	}
+
+	pk, ok := pkAny.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	return pk, nil
+}
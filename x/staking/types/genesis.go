@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState instanc e
@@ -42,5 +43,16 @@ func (g GenesisState) UnpackInterfaces(c codectypes.AnyUnpacker) error {
 			return err
 		}
 	}
+	for i := range g.RotationHistory {
+		if err := g.RotationHistory[i].UnpackInterfaces(c); err != nil {
+			return err
+		}
+	}
+	for _, msgAny := range g.EpochMsgs {
+		var msg sdk.Msg
+		if err := c.UnpackAny(msgAny, &msg); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -46,6 +47,16 @@ type GenesisState struct {
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	// exported defines a bool to identify whether the chain dealing with exported or initialized genesis.
 	Exported bool `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// rotation_history defines the consensus public key rotations of the validators.
+	RotationHistory []ConsPubKeyRotationHistory `protobuf:"bytes,9,rep,name=rotation_history,json=rotationHistory,proto3" json:"rotation_history"`
+	// epoch_msgs defines the staking messages queued until the end of the current epoch, in execution order.
+	EpochMsgs []*types.Any `protobuf:"bytes,10,rep,name=epoch_msgs,json=epochMsgs,proto3" json:"epoch_msgs,omitempty"`
+	// tokenize_share_records defines the tokenized delegations.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,11,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last created tokenize share record.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,12,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// total_liquid_staked_tokens is the total amount of liquid staked tokens.
+	TotalLiquidStakedTokens cosmossdk_io_math.Int `protobuf:"bytes,13,opt,name=total_liquid_staked_tokens,json=totalLiquidStakedTokens,proto3,customtype=cosmossdk.io/math.Int" json:"total_liquid_staked_tokens"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -130,6 +141,34 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetRotationHistory() []ConsPubKeyRotationHistory {
+	if m != nil {
+		return m.RotationHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochMsgs() []*types.Any {
+	if m != nil {
+		return m.EpochMsgs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -181,40 +220,52 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 513 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0x86, 0x6d, 0x4a, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x09, 0x32, 0x19, 0x9c, 0x10, 0x75, 0x88,
-	0x0a, 0xb1, 0x69, 0xd8, 0xd8, 0x1a, 0x21, 0x50, 0xa5, 0x4a, 0x54, 0x2e, 0x65, 0xe8, 0x12, 0x5d,
-	0xea, 0x93, 0x73, 0x8a, 0x7d, 0x67, 0xf9, 0xbe, 0x94, 0xf2, 0x0f, 0x18, 0xd9, 0x58, 0x3b, 0x32,
-	0x32, 0xf4, 0x47, 0x74, 0xac, 0x3a, 0x21, 0x86, 0x0a, 0x25, 0x03, 0xfc, 0x0c, 0xe4, 0x3b, 0xc7,
-	0x18, 0xa5, 0x5e, 0x92, 0x38, 0xdf, 0xf3, 0x3e, 0xef, 0x27, 0xf9, 0x0e, 0x6d, 0x9d, 0x08, 0x19,
-	0x09, 0xe9, 0x4a, 0x20, 0x53, 0xc6, 0x03, 0xf7, 0x74, 0x67, 0x4c, 0x81, 0xec, 0xb8, 0x01, 0xe5,
-	0x54, 0x32, 0xe9, 0xc4, 0x89, 0x00, 0x81, 0x1f, 0x6b, 0xca, 0xc9, 0x28, 0x27, 0xa3, 0x5a, 0x8d,
-	0x40, 0x04, 0x42, 0x21, 0x6e, 0xfa, 0x4b, 0xd3, 0xad, 0x32, 0xe7, 0x32, 0xad, 0xa9, 0x27, 0x9a,
-	0x1a, 0xe9, 0x78, 0x56, 0xa0, 0x47, 0x0f, 0x49, 0xc4, 0xb8, 0x70, 0xd5, 0xa7, 0xfe, 0xab, 0xfb,
-	0xb5, 0x82, 0x36, 0xdf, 0xea, 0x9d, 0x0e, 0x81, 0x00, 0xc5, 0xbb, 0xa8, 0x1a, 0x93, 0x84, 0x44,
-	0xd2, 0x32, 0x3b, 0x66, 0xaf, 0x3e, 0xb0, 0x9d, 0xdb, 0x77, 0x74, 0x0e, 0x14, 0x35, 0xdc, 0xb8,
-	0xbc, 0x69, 0x1b, 0xdf, 0x7e, 0x7f, 0xdf, 0x36, 0xbd, 0x2c, 0x88, 0x8f, 0xd1, 0x83, 0x90, 0x48,
-	0x18, 0x81, 0x00, 0x12, 0x8e, 0x62, 0xf1, 0x91, 0x26, 0xd6, 0x9d, 0x8e, 0xd9, 0xdb, 0x1c, 0xbe,
-	0x48, 0xe1, 0x9f, 0x37, 0xed, 0xa6, 0x76, 0x4a, 0x7f, 0xea, 0x30, 0xe1, 0x46, 0x04, 0x26, 0xce,
-	0x1e, 0x87, 0xeb, 0x8b, 0x3e, 0xca, 0xca, 0xf6, 0x38, 0x68, 0xe7, 0xfd, 0xd4, 0xf4, 0x3e, 0x15,
-	0x1d, 0xa4, 0x1e, 0xcc, 0x50, 0x53, 0xb9, 0x4f, 0x49, 0xc8, 0x7c, 0x02, 0x22, 0xd1, 0x7e, 0x69,
-	0xad, 0x75, 0xd6, 0x7a, 0xf5, 0xc1, 0x76, 0xd9, 0xb6, 0xfb, 0x44, 0xc2, 0x87, 0x65, 0x46, 0xa9,
-	0x8a, 0x9b, 0x3f, 0x0a, 0x57, 0xc6, 0x12, 0xef, 0x23, 0x94, 0xb7, 0x48, 0xeb, 0xae, 0xf2, 0x3f,
-	0x2d, 0xf3, 0xe7, 0xe1, 0xa2, 0xb6, 0x90, 0xc7, 0xef, 0x50, 0xdd, 0xa7, 0x21, 0x0d, 0x08, 0x30,
-	0xc1, 0xa5, 0x55, 0x51, 0xba, 0x6e, 0x99, 0xee, 0x75, 0x8e, 0x16, 0x7d, 0x45, 0x03, 0x9e, 0xa2,
-	0xe6, 0x8c, 0x8f, 0x05, 0xf7, 0x19, 0x0f, 0x46, 0x45, 0x75, 0x55, 0xa9, 0x9f, 0x95, 0xa9, 0x8f,
-	0x96, 0xa1, 0xdb, 0x3b, 0x1a, 0xb3, 0xd5, 0xb9, 0xc4, 0x47, 0xe8, 0x5e, 0x42, 0x8b, 0x25, 0xeb,
-	0xaa, 0x64, 0xab, 0xac, 0xc4, 0x2b, 0xc0, 0x45, 0xfb, 0xff, 0x16, 0xdc, 0x42, 0x35, 0x7a, 0x16,
-	0x8b, 0x04, 0xa8, 0x6f, 0xd5, 0x3a, 0x66, 0xaf, 0xe6, 0xe5, 0xcf, 0xdd, 0x09, 0xc2, 0xab, 0x2f,
-	0x0d, 0x0f, 0xd0, 0x3a, 0xf1, 0xfd, 0x84, 0x4a, 0x7d, 0x3e, 0x37, 0x86, 0xd6, 0xf5, 0x45, 0xbf,
-	0x91, 0x6d, 0xb1, 0xab, 0x27, 0x87, 0x90, 0x30, 0x1e, 0x78, 0x4b, 0x10, 0x37, 0x50, 0xe5, 0xdf,
-	0x21, 0x5c, 0xf3, 0xf4, 0xc3, 0xab, 0xda, 0xe7, 0xf3, 0xb6, 0xf1, 0xe7, 0xbc, 0x6d, 0x0c, 0xdf,
-	0x5c, 0xce, 0x6d, 0xf3, 0x6a, 0x6e, 0x9b, 0xbf, 0xe6, 0xb6, 0xf9, 0x65, 0x61, 0x1b, 0x57, 0x0b,
-	0xdb, 0xf8, 0xb1, 0xb0, 0x8d, 0xe3, 0xe7, 0x01, 0x83, 0xc9, 0x6c, 0xec, 0x9c, 0x88, 0x28, 0xbb,
-	0x49, 0xd9, 0x57, 0x5f, 0xfa, 0x53, 0xf7, 0x2c, 0xbf, 0x89, 0xf0, 0x29, 0xa6, 0x72, 0x5c, 0x55,
-	0x57, 0xea, 0xe5, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x57, 0x29, 0x7a, 0x5e, 0xfc, 0x03, 0x00,
-	0x00,
+	// 707 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xbf, 0x6e, 0x13, 0x4b,
+	0x14, 0xc6, 0xbd, 0x37, 0xff, 0xec, 0x71, 0x72, 0x6f, 0xee, 0x5c, 0x27, 0xd9, 0xf8, 0x0a, 0xdb,
+	0x44, 0x29, 0xac, 0x80, 0x77, 0x49, 0xe8, 0xa8, 0x88, 0x41, 0x40, 0x44, 0x22, 0xac, 0x75, 0x42,
+	0x91, 0x66, 0x35, 0xf6, 0x0e, 0xeb, 0x91, 0xd7, 0x3b, 0x66, 0xcf, 0x38, 0xc4, 0x3c, 0x01, 0x25,
+	0x8f, 0x90, 0x92, 0x92, 0x22, 0x0f, 0x11, 0x51, 0x45, 0xa9, 0x10, 0x45, 0x84, 0x92, 0x02, 0x5a,
+	0xde, 0x00, 0x79, 0x66, 0x6c, 0x36, 0xb2, 0x97, 0x82, 0xc6, 0xde, 0xd9, 0xf3, 0x7d, 0xbf, 0xef,
+	0x48, 0xb3, 0xe7, 0xa0, 0xf5, 0x26, 0x87, 0x0e, 0x07, 0x1b, 0x04, 0x69, 0xb3, 0xd0, 0xb7, 0x8f,
+	0x36, 0x1b, 0x54, 0x90, 0x4d, 0xdb, 0xa7, 0x21, 0x05, 0x06, 0x56, 0x37, 0xe2, 0x82, 0xe3, 0x65,
+	0xa5, 0xb2, 0xb4, 0xca, 0xd2, 0xaa, 0x7c, 0xce, 0xe7, 0x3e, 0x97, 0x12, 0x7b, 0xf0, 0xa4, 0xd4,
+	0xf9, 0x55, 0x9f, 0x73, 0x3f, 0xa0, 0xb6, 0x3c, 0x35, 0x7a, 0xaf, 0x6c, 0x12, 0xf6, 0x75, 0x29,
+	0x29, 0x6e, 0x08, 0xd6, 0x00, 0xa5, 0x72, 0x15, 0x59, 0x67, 0xab, 0xd2, 0xbf, 0xa4, 0xc3, 0x42,
+	0x6e, 0xcb, 0x5f, 0xf5, 0x6a, 0xed, 0x47, 0x1a, 0xcd, 0x3f, 0x55, 0xed, 0xd6, 0x05, 0x11, 0x14,
+	0x6f, 0xa3, 0xd9, 0x2e, 0x89, 0x48, 0x07, 0x4c, 0xa3, 0x64, 0x94, 0xb3, 0x5b, 0x05, 0x6b, 0x72,
+	0xfb, 0x56, 0x4d, 0xaa, 0xaa, 0x99, 0xb3, 0xcb, 0x62, 0xea, 0xc3, 0xb7, 0x8f, 0x1b, 0x86, 0xa3,
+	0x8d, 0xf8, 0x10, 0x2d, 0x06, 0x04, 0x84, 0x2b, 0xb8, 0x20, 0x81, 0xdb, 0xe5, 0x6f, 0x68, 0x64,
+	0xfe, 0x55, 0x32, 0xca, 0xf3, 0xd5, 0x7b, 0x03, 0xf1, 0x97, 0xcb, 0xe2, 0x92, 0x62, 0x82, 0xd7,
+	0xb6, 0x18, 0xb7, 0x3b, 0x44, 0xb4, 0xac, 0x9d, 0x50, 0x5c, 0x9c, 0x56, 0x90, 0x0e, 0xdb, 0x09,
+	0x85, 0x62, 0xfe, 0x3d, 0x20, 0xed, 0x0f, 0x40, 0xb5, 0x01, 0x07, 0x33, 0xb4, 0x24, 0xd9, 0x47,
+	0x24, 0x60, 0x1e, 0x11, 0x3c, 0x52, 0x7c, 0x30, 0xa7, 0x4a, 0x53, 0xe5, 0xec, 0xd6, 0x46, 0x52,
+	0xb7, 0xbb, 0x04, 0xc4, 0xcb, 0xa1, 0x47, 0xa2, 0xe2, 0x9d, 0xff, 0x17, 0x8c, 0x95, 0x01, 0xef,
+	0x22, 0x34, 0x4a, 0x01, 0x73, 0x5a, 0xf2, 0x6f, 0x27, 0xf1, 0x47, 0xe6, 0x38, 0x36, 0xe6, 0xc7,
+	0x2f, 0x50, 0xd6, 0xa3, 0x01, 0xf5, 0x89, 0x60, 0x3c, 0x04, 0x73, 0x46, 0xe2, 0xd6, 0x92, 0x70,
+	0x8f, 0x47, 0xd2, 0x38, 0x2f, 0x4e, 0xc0, 0x6d, 0xb4, 0xd4, 0x0b, 0x1b, 0x3c, 0xf4, 0x58, 0xe8,
+	0xbb, 0x71, 0xf4, 0xac, 0x44, 0xdf, 0x49, 0x42, 0x1f, 0x0c, 0x4d, 0x93, 0x33, 0x72, 0xbd, 0xf1,
+	0x3a, 0xe0, 0x03, 0xb4, 0x10, 0xd1, 0x78, 0xc8, 0x9c, 0x0c, 0x59, 0x4f, 0x0a, 0x71, 0xa8, 0x37,
+	0x91, 0x7e, 0x93, 0x82, 0xf3, 0x28, 0x4d, 0x8f, 0xbb, 0x3c, 0x12, 0xd4, 0x33, 0xd3, 0x25, 0xa3,
+	0x9c, 0x76, 0x46, 0x67, 0xec, 0xa3, 0xc5, 0x88, 0x0b, 0x29, 0x74, 0x5b, 0x0c, 0x04, 0x8f, 0xfa,
+	0x66, 0x46, 0xa6, 0x6e, 0x26, 0xa5, 0x3e, 0xe2, 0x21, 0xd4, 0x7a, 0x8d, 0xe7, 0xb4, 0xef, 0x68,
+	0xe7, 0x33, 0x65, 0x8c, 0xb7, 0xf0, 0x4f, 0x74, 0xb3, 0x86, 0x6b, 0x08, 0xd1, 0x2e, 0x6f, 0xb6,
+	0xdc, 0x0e, 0xf8, 0x60, 0x22, 0x19, 0x91, 0xb3, 0xd4, 0x18, 0x5a, 0xc3, 0x31, 0xb4, 0xb6, 0xc3,
+	0x7e, 0xf5, 0xff, 0x4f, 0xa7, 0x95, 0x15, 0x9d, 0xdd, 0x20, 0x40, 0x47, 0xc1, 0x7b, 0xe0, 0x3b,
+	0x19, 0x09, 0xd9, 0x03, 0x1f, 0x70, 0x80, 0x96, 0x05, 0x6f, 0xd3, 0x90, 0xbd, 0xa5, 0x2e, 0xb4,
+	0x48, 0x44, 0xdd, 0x88, 0x36, 0x79, 0xe4, 0x81, 0x99, 0xfd, 0xfd, 0xdd, 0xec, 0x6b, 0x57, 0x7d,
+	0x60, 0x72, 0xa4, 0xe7, 0xc6, 0xdd, 0x88, 0xf1, 0x3a, 0xe0, 0x87, 0xe8, 0x96, 0x1e, 0xb7, 0x09,
+	0x91, 0x2e, 0xf3, 0xcc, 0xf9, 0x92, 0x51, 0x9e, 0x76, 0x56, 0xd5, 0x24, 0x8d, 0x01, 0x76, 0x3c,
+	0xdc, 0x41, 0x79, 0x35, 0xab, 0x01, 0x7b, 0xdd, 0x63, 0x9e, 0x3b, 0x68, 0x8b, 0x7a, 0x0a, 0x08,
+	0xe6, 0xc2, 0x1f, 0x8e, 0xee, 0x8a, 0x64, 0xee, 0x4a, 0x64, 0x5d, 0x12, 0x65, 0x3a, 0xac, 0xb5,
+	0x10, 0x1e, 0x1f, 0x47, 0xbc, 0x85, 0xe6, 0x88, 0xe7, 0x45, 0x14, 0xd4, 0xe6, 0xc9, 0x54, 0xcd,
+	0x8b, 0xd3, 0x4a, 0x4e, 0x43, 0xb7, 0x55, 0xa5, 0x2e, 0x22, 0x16, 0xfa, 0xce, 0x50, 0x88, 0x73,
+	0x68, 0xe6, 0xd7, 0x7a, 0x99, 0x72, 0xd4, 0xe1, 0x41, 0xfa, 0xdd, 0x49, 0x31, 0xf5, 0xfd, 0xa4,
+	0x98, 0xaa, 0x3e, 0x39, 0xbb, 0x2a, 0x18, 0xe7, 0x57, 0x05, 0xe3, 0xeb, 0x55, 0xc1, 0x78, 0x7f,
+	0x5d, 0x48, 0x9d, 0x5f, 0x17, 0x52, 0x9f, 0xaf, 0x0b, 0xa9, 0xc3, 0xbb, 0x3e, 0x13, 0xad, 0x5e,
+	0xc3, 0x6a, 0xf2, 0x8e, 0xde, 0x91, 0xfa, 0xaf, 0x02, 0x5e, 0xdb, 0x3e, 0x1e, 0xed, 0x58, 0xd1,
+	0xef, 0x52, 0x68, 0xcc, 0xca, 0xcf, 0xe0, 0xfe, 0xcf, 0x01, 0x00, 0x2f, 0x93, 0x06, 0x30, 0xf1,
+	0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -237,6 +288,63 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.TotalLiquidStakedTokens.Size()
+		i -= size
+		if _, err := m.TotalLiquidStakedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.EpochMsgs) > 0 {
+		for iNdEx := len(m.EpochMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochMsgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RotationHistory) > 0 {
+		for iNdEx := len(m.RotationHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RotationHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -429,6 +537,29 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.RotationHistory) > 0 {
+		for _, e := range m.RotationHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochMsgs) > 0 {
+		for _, e := range m.EpochMsgs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	l = m.TotalLiquidStakedTokens.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -739,6 +870,160 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotationHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RotationHistory = append(m.RotationHistory, ConsPubKeyRotationHistory{})
+			if err := m.RotationHistory[len(m.RotationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMsgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochMsgs = append(m.EpochMsgs, &types.Any{})
+			if err := m.EpochMsgs[len(m.EpochMsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidStakedTokens", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLiquidStakedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
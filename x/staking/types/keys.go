@@ -56,6 +56,21 @@ var (
 	ParamsKey = []byte{0x51} // prefix for parameters for module x/staking
 
 	DelegationByValIndexKey = []byte{0x71} // key for delegations by a validator
+
+	ConsPubKeyRotationHistoryKey   = []byte{0x81} // prefix for the consensus pubkey rotation history of a validator
+	ValidatorConsPubKeyRotationKey = []byte{0x82} // prefix for the last consensus pubkey rotation of a validator, until its unbonding period has elapsed
+	RotatedConsAddrKey             = []byte{0x83} // prefix for each key to a validator index, by consensus address it rotated from
+	ConsPubKeyRotationQueueKey     = []byte{0x84} // prefix for the timestamps in consensus pubkey rotations queue
+	BlockConsPubKeyRotationKey     = []byte{0x85} // prefix for the consensus pubkey rotations of the current block
+
+	EpochMsgQueueKey    = []byte{0x86} // prefix for the staking messages queued until the end of the epoch
+	EpochMsgSequenceKey = []byte{0x87} // key for the sequence of the next queued staking message
+
+	TokenizeShareRecordKey          = []byte{0x88} // prefix for the tokenize share records
+	TokenizeShareRecordIDByOwnerKey = []byte{0x89} // prefix for each key to a tokenize share record id, by owner
+	TokenizeShareRecordIDByDenomKey = []byte{0x8a} // prefix for each key to a tokenize share record id, by share token denom
+	LastTokenizeShareRecordIDKey    = []byte{0x8b} // key for the id of the last tokenize share record
+	TotalLiquidStakedTokensKey      = []byte{0x8c} // key for the total amount of liquid staked tokens
 )
 
 // UnbondingType defines the type of unbonding operation
@@ -423,3 +438,101 @@ func GetHistoricalInfoKey(height int64) []byte {
 	binary.BigEndian.PutUint64(heightBytes, uint64(height))
 	return append(HistoricalInfoKey, heightBytes...)
 }
+
+// GetConsPubKeyRotationHistoryKey creates the key for the consensus pubkey
+// rotation of a validator at the given height.
+// VALUE: staking/ConsPubKeyRotationHistory
+func GetConsPubKeyRotationHistoryKey(valAddr sdk.ValAddress, height int64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height))
+	return append(GetConsPubKeyRotationHistoriesKey(valAddr), heightBytes...)
+}
+
+// GetConsPubKeyRotationHistoriesKey creates the prefix for all the consensus
+// pubkey rotations of a validator.
+func GetConsPubKeyRotationHistoriesKey(valAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationHistoryKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetValidatorConsPubKeyRotationKey creates the key for the last consensus
+// pubkey rotation of a validator, which is kept until the unbonding period
+// elapsed.
+// VALUE: staking/ConsPubKeyRotationHistory
+func GetValidatorConsPubKeyRotationKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorConsPubKeyRotationKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetRotatedConsAddrKey creates the key for the validator with the consensus
+// address it rotated from.
+// VALUE: validator operator address ([]byte)
+func GetRotatedConsAddrKey(addr sdk.ConsAddress) []byte {
+	return append(RotatedConsAddrKey, address.MustLengthPrefix(addr)...)
+}
+
+// GetConsPubKeyRotationTimeKey creates the prefix for all the consensus pubkey
+// rotations whose unbonding period elapses at the given time.
+func GetConsPubKeyRotationTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(ConsPubKeyRotationQueueKey, bz...)
+}
+
+// GetConsPubKeyRotationQueueKey creates the key for the consensus pubkey
+// rotation of a validator whose unbonding period elapses at the given time.
+// VALUE: none (key rearrangement used)
+func GetConsPubKeyRotationQueueKey(timestamp time.Time, valAddr sdk.ValAddress) []byte {
+	return append(GetConsPubKeyRotationTimeKey(timestamp), address.MustLengthPrefix(valAddr)...)
+}
+
+// ParseConsPubKeyRotationQueueKey returns the validator operator address from a
+// key created from GetConsPubKeyRotationQueueKey.
+func ParseConsPubKeyRotationQueueKey(bz []byte) sdk.ValAddress {
+	timeBzL := len(sdk.FormatTimeBytes(time.Time{}))
+	kv.AssertKeyAtLeastLength(bz, len(ConsPubKeyRotationQueueKey)+timeBzL+2)
+	return bz[len(ConsPubKeyRotationQueueKey)+timeBzL+1:]
+}
+
+// GetBlockConsPubKeyRotationKey creates the key for the consensus pubkey
+// rotation of a validator in the current block.
+// VALUE: none (key rearrangement used)
+func GetBlockConsPubKeyRotationKey(valAddr sdk.ValAddress) []byte {
+	return append(BlockConsPubKeyRotationKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetEpochMsgQueueKey creates the key for a staking message queued until the
+// end of the epoch, ordered by sequence.
+func GetEpochMsgQueueKey(sequence uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, sequence)
+	return append(EpochMsgQueueKey, bz...)
+}
+
+// GetTokenizeShareRecordKey creates the key for the tokenize share record with
+// the given id.
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordKey(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(TokenizeShareRecordKey, bz...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerPrefix creates the prefix for the ids of the
+// tokenize share records owned by an address.
+func GetTokenizeShareRecordIDsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerKey, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordIDByOwnerAndIDKey creates the index-key for a tokenize
+// share record, stored by owner.
+// VALUE: tokenize share record id (uint64)
+func GetTokenizeShareRecordIDByOwnerAndIDKey(owner sdk.AccAddress, id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(GetTokenizeShareRecordIDsByOwnerPrefix(owner), bz...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey creates the index-key for a tokenize share
+// record, stored by share token denom.
+// VALUE: tokenize share record id (uint64)
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomKey, []byte(denom)...)
+}
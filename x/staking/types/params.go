@@ -29,13 +29,37 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultEpochLength is 0, which disables epoched staking: staking
+	// messages are applied as soon as they are delivered.
+	DefaultEpochLength int64 = 0
 )
 
-// DefaultMinCommissionRate is set to 0%
-var DefaultMinCommissionRate = math.LegacyZeroDec()
+var (
+	// DefaultMinCommissionRate is set to 0%
+	DefaultMinCommissionRate = math.LegacyZeroDec()
+
+	// DefaultKeyRotationFee is the default fee charged for rotating the
+	// consensus public key of a validator
+	DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+
+	// DefaultGlobalLiquidStakingCap is set to 100%, i.e. no cap
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%, i.e. no cap
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorBondFactor is set to -1, which disables the validator
+	// bond requirement
+	DefaultValidatorBondFactor = math.LegacyNewDec(-1)
+)
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate math.LegacyDec) Params {
+func NewParams(
+	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string,
+	minCommissionRate math.LegacyDec, keyRotationFee sdk.Coin, epochLength int64,
+	globalLiquidStakingCap, validatorLiquidStakingCap, validatorBondFactor math.LegacyDec,
+) Params {
 	return Params{
 		UnbondingTime:     unbondingTime,
 		MaxValidators:     maxValidators,
@@ -43,6 +67,12 @@ func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historica
 		HistoricalEntries: historicalEntries,
 		BondDenom:         bondDenom,
 		MinCommissionRate: minCommissionRate,
+		KeyRotationFee:    keyRotationFee,
+		EpochLength:       epochLength,
+
+		GlobalLiquidStakingCap:    globalLiquidStakingCap,
+		ValidatorLiquidStakingCap: validatorLiquidStakingCap,
+		ValidatorBondFactor:       validatorBondFactor,
 	}
 }
 
@@ -55,6 +85,11 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
+		DefaultKeyRotationFee,
+		DefaultEpochLength,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
+		DefaultValidatorBondFactor,
 	)
 }
 
@@ -104,6 +139,26 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateKeyRotationFee(p.KeyRotationFee); err != nil {
+		return err
+	}
+
+	if err := validateEpochLength(p.EpochLength); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return fmt.Errorf("global %w", err)
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return fmt.Errorf("validator %w", err)
+	}
+
+	if err := validateValidatorBondFactor(p.ValidatorBondFactor); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -155,6 +210,19 @@ func validateHistoricalEntries(i interface{}) error {
 	return nil
 }
 
+func validateEpochLength(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("epoch length cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
 func validateBondDenom(i interface{}) error {
 	v, ok := i.(string)
 	if !ok {
@@ -203,3 +271,56 @@ func validateMinCommissionRate(i interface{}) error {
 
 	return nil
 }
+
+func validateKeyRotationFee(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an unset fee, e.g. in params migrated from x/params, disables the fee
+	if v.Denom == "" && v.Amount.IsNil() {
+		return nil
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid key rotation fee: %w", err)
+	}
+
+	return nil
+}
+
+func validateLiquidStakingCap(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquid staking cap cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateValidatorBondFactor(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("validator bond factor cannot be nil: %s", v)
+	}
+	if v.IsNegative() && !v.Equal(math.LegacyNewDec(-1)) {
+		return fmt.Errorf("invalid validator bond factor, must be -1 to disable it or non-negative: %s", v)
+	}
+
+	return nil
+}
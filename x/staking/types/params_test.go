@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -36,4 +37,41 @@ func TestValidateParams(t *testing.T) {
 
 	params.MinCommissionRate = math.LegacyNewDec(2)
 	require.Error(t, params.Validate())
+
+	// validate key rotation fee
+	params = types.DefaultParams()
+	params.KeyRotationFee = sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}
+	require.Error(t, params.Validate())
+
+	// unset key rotation fee is valid
+	params.KeyRotationFee = sdk.Coin{}
+	require.NoError(t, params.Validate())
+
+	// validate epoch length
+	params = types.DefaultParams()
+	params.EpochLength = -1
+	require.Error(t, params.Validate())
+
+	params.EpochLength = 10
+	require.NoError(t, params.Validate())
+
+	// validate liquid staking caps
+	params = types.DefaultParams()
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.ValidatorLiquidStakingCap = math.LegacyNewDec(-1)
+	require.Error(t, params.Validate())
+
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, params.Validate())
+
+	// validate validator bond factor, which is disabled by -1 only
+	params = types.DefaultParams()
+	params.ValidatorBondFactor = math.LegacyNewDec(-2)
+	require.Error(t, params.Validate())
+
+	params.ValidatorBondFactor = math.LegacyNewDec(250)
+	require.NoError(t, params.Validate())
 }
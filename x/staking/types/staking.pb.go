@@ -375,6 +375,10 @@ type Validator struct {
 	UnbondingOnHoldRefCount int64 `protobuf:"varint,12,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
 	// list of unbonding ids, each uniquely identifing an unbonding of this validator
 	UnbondingIds []uint64 `protobuf:"varint,13,rep,packed,name=unbonding_ids,json=unbondingIds,proto3" json:"unbonding_ids,omitempty"`
+	// validator_bond_shares is the number of shares of the delegations to the validator flagged as validator bond.
+	ValidatorBondShares cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=validator_bond_shares,json=validatorBondShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_bond_shares"`
+	// liquid_shares is the number of shares of the validator that have been tokenized.
+	LiquidShares cosmossdk_io_math.LegacyDec `protobuf:"bytes,15,opt,name=liquid_shares,json=liquidShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquid_shares"`
 }
 
 func (m *Validator) Reset()         { *m = Validator{} }
@@ -639,6 +643,9 @@ type Delegation struct {
 	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	// shares define the delegation shares received.
 	Shares cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
+	// validator_bond is true if the delegation is flagged as a validator bond, allowing the validator to receive
+	// tokenized delegations.
+	ValidatorBond bool `protobuf:"varint,4,opt,name=validator_bond,json=validatorBond,proto3" json:"validator_bond,omitempty"`
 }
 
 func (m *Delegation) Reset()         { *m = Delegation{} }
@@ -932,6 +939,20 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// key_rotation_fee is the fee charged to a validator's operator for rotating its consensus public key.
+	KeyRotationFee types2.Coin `protobuf:"bytes,7,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// epoch_length is the number of blocks of a staking epoch. When non-zero, delegations, undelegations and
+	// redelegations are queued and applied in bulk at the end of the epoch.
+	EpochLength int64 `protobuf:"varint,8,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// global_liquid_staking_cap is the maximum fraction of the total bonded tokens that can be liquid staked,
+	// i.e. tokenized into share tokens.
+	GlobalLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of the delegator shares of a validator that can be
+	// liquid staked.
+	ValidatorLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_liquid_staking_cap"`
+	// validator_bond_factor is the number of liquid shares a validator can receive per validator bond share.
+	// A negative value disables the validator bond requirement.
+	ValidatorBondFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=validator_bond_factor,json=validatorBondFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_bond_factor"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1002,6 +1023,20 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetKeyRotationFee() types2.Coin {
+	if m != nil {
+		return m.KeyRotationFee
+	}
+	return types2.Coin{}
+}
+
+func (m *Params) GetEpochLength() int64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1245,6 +1280,131 @@ func (m *ValidatorUpdates) GetUpdates() []types3.ValidatorUpdate {
 	return nil
 }
 
+// ConsPubKeyRotationHistory defines a rotation of the consensus public key of
+// a validator.
+type ConsPubKeyRotationHistory struct {
+	// operator_address defines the address of the validator's operator; bech encoded in JSON.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// old_cons_pubkey is the consensus public key of the validator before the rotation.
+	OldConsPubkey *types1.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty"`
+	// new_cons_pubkey is the consensus public key of the validator after the rotation.
+	NewConsPubkey *types1.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty"`
+	// height defines the block height at which the rotation took place.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// completion_time defines the unix time at which the old consensus public key
+	// stops being tracked, i.e. once the unbonding period has elapsed.
+	CompletionTime time.Time `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	// fee defines the rotation fee paid by the validator's operator.
+	Fee types2.Coin `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *ConsPubKeyRotationHistory) Reset()         { *m = ConsPubKeyRotationHistory{} }
+func (m *ConsPubKeyRotationHistory) String() string { return proto.CompactTextString(m) }
+func (*ConsPubKeyRotationHistory) ProtoMessage()    {}
+func (*ConsPubKeyRotationHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsPubKeyRotationHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotationHistory.Merge(m, src)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsPubKeyRotationHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotationHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsPubKeyRotationHistory proto.InternalMessageInfo
+
+// TokenizeShareRecord represents a tokenized delegation. The delegation is held by a dedicated module account and
+// represented by share tokens of denom <validator_address>/<id> in x/bank.
+type TokenizeShareRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address entitled to the rewards accrued by the tokenized delegation.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account holding the tokenized delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the address of the validator the tokenized delegation is bonded to.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
@@ -1269,6 +1429,8 @@ func init() {
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
 	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
+	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
 }
 
 func init() {
@@ -1276,126 +1438,147 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1901 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4d, 0x6c, 0x63, 0x47,
-	0x1d, 0xcf, 0x8b, 0x53, 0x27, 0xf9, 0x3b, 0x89, 0x9d, 0xd9, 0x2f, 0xaf, 0x97, 0xc6, 0x5e, 0x77,
-	0xa1, 0xe9, 0xd2, 0xd8, 0x6c, 0x90, 0x7a, 0x08, 0x08, 0x14, 0xc7, 0xde, 0xae, 0x4b, 0x9b, 0x04,
-	0x3b, 0x09, 0x94, 0xaf, 0xa7, 0xf1, 0x7b, 0x13, 0x67, 0x88, 0x3d, 0xcf, 0xbc, 0x19, 0x2f, 0xf1,
-	0x9d, 0x43, 0x15, 0x84, 0x54, 0x09, 0x09, 0x21, 0xc1, 0x8a, 0x95, 0xb8, 0x94, 0x5b, 0x0f, 0x2b,
-	0xee, 0xdc, 0x0a, 0x12, 0xd2, 0x6a, 0x4f, 0x08, 0x89, 0x80, 0x76, 0x0f, 0xad, 0xe0, 0x82, 0x38,
-	0x71, 0x44, 0xf3, 0xf1, 0x3e, 0x1c, 0x27, 0x9b, 0xcd, 0xb6, 0x42, 0x15, 0x5c, 0x9e, 0xde, 0xcc,
-	0xfc, 0xff, 0xbf, 0x99, 0xff, 0xef, 0xff, 0x31, 0x1f, 0x70, 0xc3, 0xf1, 0x78, 0xd7, 0xe3, 0x65,
-	0x2e, 0xf0, 0x3e, 0x65, 0xed, 0xf2, 0xdd, 0x5b, 0x2d, 0x22, 0xf0, 0xad, 0xa0, 0x5d, 0xea, 0xf9,
-	0x9e, 0xf0, 0xd0, 0x65, 0x2d, 0x55, 0x0a, 0x7a, 0x8d, 0x54, 0xee, 0x62, 0xdb, 0x6b, 0x7b, 0x4a,
-	0xa4, 0x2c, 0xff, 0xb4, 0x74, 0xee, 0x6a, 0xdb, 0xf3, 0xda, 0x1d, 0x52, 0x56, 0xad, 0x56, 0x7f,
-	0xb7, 0x8c, 0xd9, 0xc0, 0x0c, 0x2d, 0x1c, 0x1f, 0x72, 0xfb, 0x3e, 0x16, 0xd4, 0x63, 0x66, 0x3c,
-	0x7f, 0x7c, 0x5c, 0xd0, 0x2e, 0xe1, 0x02, 0x77, 0x7b, 0x01, 0xb6, 0x5e, 0x89, 0xad, 0x27, 0x35,
-	0xcb, 0x32, 0xd8, 0xc6, 0x94, 0x16, 0xe6, 0x24, 0xb4, 0xc3, 0xf1, 0x68, 0x80, 0x3d, 0x8f, 0xbb,
-	0x94, 0x79, 0x65, 0xf5, 0x35, 0x5d, 0x9f, 0x11, 0x84, 0xb9, 0xc4, 0xef, 0x52, 0x26, 0xca, 0x62,
-	0xd0, 0x23, 0x5c, 0x7f, 0xcd, 0xe8, 0xb5, 0xd8, 0x28, 0x6e, 0x39, 0x34, 0x3e, 0x58, 0xfc, 0xa9,
-	0x05, 0x73, 0x77, 0x28, 0x17, 0x9e, 0x4f, 0x1d, 0xdc, 0xa9, 0xb3, 0x5d, 0x0f, 0x7d, 0x09, 0x92,
-	0x7b, 0x04, 0xbb, 0xc4, 0xcf, 0x5a, 0x05, 0x6b, 0x31, 0xb5, 0x9c, 0x2d, 0x45, 0x00, 0x25, 0xad,
-	0x7b, 0x47, 0x8d, 0x57, 0xa6, 0x3f, 0x38, 0xca, 0x8f, 0xbd, 0xf7, 0xe1, 0xfb, 0x37, 0xad, 0x86,
-	0x51, 0x41, 0x55, 0x48, 0xde, 0xc5, 0x1d, 0x4e, 0x44, 0x76, 0xbc, 0x90, 0x58, 0x4c, 0x2d, 0x5f,
-	0x2f, 0x9d, 0xcc, 0x79, 0x69, 0x07, 0x77, 0xa8, 0x8b, 0x85, 0x37, 0x8c, 0xa2, 0x75, 0x8b, 0x3f,
-	0x1b, 0x87, 0xf4, 0x9a, 0xd7, 0xed, 0x52, 0xce, 0xa9, 0xc7, 0x1a, 0x58, 0x10, 0x8e, 0xde, 0x80,
-	0x09, 0x1f, 0x0b, 0xa2, 0x16, 0x35, 0x5d, 0x79, 0x4d, 0x2a, 0xfd, 0xf9, 0x28, 0x7f, 0x4d, 0xc3,
-	0x73, 0x77, 0xbf, 0x44, 0xbd, 0x72, 0x17, 0x8b, 0xbd, 0xd2, 0x9b, 0xa4, 0x8d, 0x9d, 0x41, 0x95,
-	0x38, 0x8f, 0x1e, 0x2c, 0x81, 0x99, 0xbd, 0x4a, 0x1c, 0x3d, 0x83, 0xc2, 0x40, 0x5f, 0x87, 0xa9,
-	0x2e, 0x3e, 0xb0, 0x15, 0xde, 0xf8, 0xc7, 0xc2, 0x9b, 0xec, 0xe2, 0x03, 0xb9, 0x3e, 0xf4, 0x3d,
-	0x48, 0x4b, 0x48, 0x67, 0x0f, 0xb3, 0x36, 0xd1, 0xc8, 0x89, 0x8f, 0x85, 0x3c, 0xdb, 0xc5, 0x07,
-	0x6b, 0x0a, 0x4d, 0xe2, 0xaf, 0x4c, 0x7c, 0x74, 0x3f, 0x6f, 0x15, 0x7f, 0x67, 0x01, 0x44, 0xc4,
-	0x20, 0x0c, 0x19, 0x27, 0x6c, 0xa9, 0x49, 0xb9, 0x71, 0xda, 0xcb, 0xa7, 0xf1, 0x7e, 0x8c, 0xd6,
-	0xca, 0xac, 0x5c, 0xde, 0xc3, 0xa3, 0xbc, 0xa5, 0x67, 0x4d, 0x3b, 0x23, 0xb4, 0xa7, 0xfa, 0x3d,
-	0x17, 0x0b, 0x62, 0xcb, 0x18, 0x56, 0x6c, 0xa5, 0x96, 0x73, 0x25, 0x1d, 0xe0, 0xa5, 0x20, 0xc0,
-	0x4b, 0x5b, 0x41, 0x80, 0x6b, 0xc0, 0x77, 0xff, 0x1a, 0x00, 0x82, 0xd6, 0x96, 0xe3, 0xc6, 0x86,
-	0xf7, 0x2c, 0x48, 0x55, 0x09, 0x77, 0x7c, 0xda, 0x93, 0x29, 0x83, 0xb2, 0x30, 0xd9, 0xf5, 0x18,
-	0xdd, 0x37, 0x01, 0x37, 0xdd, 0x08, 0x9a, 0x28, 0x07, 0x53, 0xd4, 0x25, 0x4c, 0x50, 0x31, 0xd0,
-	0x6e, 0x6a, 0x84, 0x6d, 0xa9, 0xf5, 0x43, 0xd2, 0xe2, 0x34, 0xe0, 0xb9, 0x11, 0x34, 0xd1, 0x2b,
-	0x90, 0xe1, 0xc4, 0xe9, 0xfb, 0x54, 0x0c, 0x6c, 0xc7, 0x63, 0x02, 0x3b, 0x22, 0x3b, 0xa1, 0x44,
-	0xd2, 0x41, 0xff, 0x9a, 0xee, 0x96, 0x20, 0x2e, 0x11, 0x98, 0x76, 0x78, 0xf6, 0x05, 0x0d, 0x62,
-	0x9a, 0x66, 0xa9, 0x87, 0x93, 0x30, 0x1d, 0x06, 0x2a, 0x5a, 0x83, 0x8c, 0xd7, 0x23, 0xbe, 0xfc,
-	0xb7, 0xb1, 0xeb, 0xfa, 0x84, 0x73, 0x13, 0x8d, 0xd9, 0x47, 0x0f, 0x96, 0x2e, 0x1a, 0xc2, 0x57,
-	0xf5, 0x48, 0x53, 0xf8, 0x94, 0xb5, 0x1b, 0xe9, 0x40, 0xc3, 0x74, 0xa3, 0xb7, 0xa5, 0xcb, 0x18,
-	0x27, 0x8c, 0xf7, 0xb9, 0xdd, 0xeb, 0xb7, 0xf6, 0xc9, 0xc0, 0x90, 0x7a, 0x71, 0x84, 0xd4, 0x55,
-	0x36, 0xa8, 0x64, 0xff, 0x10, 0x41, 0x3b, 0xfe, 0xa0, 0x27, 0xbc, 0xd2, 0x66, 0xbf, 0xf5, 0x35,
-	0x32, 0x90, 0xae, 0x32, 0x38, 0x9b, 0x0a, 0x06, 0x5d, 0x86, 0xe4, 0xf7, 0x31, 0xed, 0x10, 0x57,
-	0x31, 0x32, 0xd5, 0x30, 0x2d, 0xb4, 0x02, 0x49, 0x2e, 0xb0, 0xe8, 0x73, 0x45, 0xc3, 0xdc, 0x72,
-	0xf1, 0xb4, 0xd8, 0xa8, 0x78, 0xcc, 0x6d, 0x2a, 0xc9, 0x86, 0xd1, 0x40, 0x6b, 0x90, 0x14, 0xde,
-	0x3e, 0x61, 0x86, 0xa0, 0xca, 0xe7, 0x4d, 0x34, 0x5f, 0x1a, 0x8d, 0xe6, 0x3a, 0x13, 0xb1, 0x38,
-	0xae, 0x33, 0xd1, 0x30, 0xaa, 0xe8, 0x3b, 0x90, 0x71, 0x49, 0x87, 0xb4, 0x15, 0x73, 0x7c, 0x0f,
-	0xfb, 0x84, 0x67, 0x93, 0x0a, 0xee, 0xd6, 0xb9, 0x93, 0xa3, 0x91, 0x0e, 0xa1, 0x9a, 0x0a, 0x09,
-	0x6d, 0x42, 0xca, 0x8d, 0xc2, 0x29, 0x3b, 0xa9, 0xc8, 0x7c, 0xe9, 0x34, 0x1b, 0x63, 0x91, 0x17,
-	0xaf, 0x3c, 0x71, 0x08, 0x19, 0x41, 0x7d, 0xd6, 0xf2, 0x98, 0x4b, 0x59, 0xdb, 0xde, 0x23, 0xb4,
-	0xbd, 0x27, 0xb2, 0x53, 0x05, 0x6b, 0x31, 0xd1, 0x48, 0x87, 0xfd, 0x77, 0x54, 0x37, 0xda, 0x84,
-	0xb9, 0x48, 0x54, 0x65, 0xc8, 0xf4, 0x79, 0x33, 0x64, 0x36, 0x04, 0x90, 0x22, 0xe8, 0x2d, 0x80,
-	0x28, 0x07, 0xb3, 0xa0, 0xd0, 0x8a, 0x67, 0x67, 0x73, 0xdc, 0x98, 0x18, 0x00, 0xfa, 0x36, 0x5c,
-	0xe8, 0x52, 0x66, 0x73, 0xd2, 0xd9, 0xb5, 0x0d, 0x73, 0x12, 0x37, 0x75, 0x7e, 0x6f, 0xce, 0x77,
-	0x29, 0x6b, 0x92, 0xce, 0x6e, 0x35, 0x44, 0x41, 0x5f, 0x86, 0x6b, 0x91, 0xf5, 0x1e, 0xb3, 0xf7,
-	0xbc, 0x8e, 0x6b, 0xfb, 0x64, 0xd7, 0x76, 0xbc, 0x3e, 0x13, 0xd9, 0x19, 0xc5, 0xd9, 0x95, 0x50,
-	0x64, 0x83, 0xdd, 0xf1, 0x3a, 0x6e, 0x83, 0xec, 0xae, 0xc9, 0x61, 0xf4, 0x12, 0x44, 0xa6, 0xdb,
-	0xd4, 0xe5, 0xd9, 0xd9, 0x42, 0x62, 0x71, 0xa2, 0x31, 0x13, 0x76, 0xd6, 0x5d, 0xbe, 0x32, 0xf5,
-	0xce, 0xfd, 0xfc, 0xd8, 0x47, 0xf7, 0xf3, 0x63, 0xc5, 0xdb, 0x30, 0xb3, 0x83, 0x3b, 0x26, 0x8f,
-	0x08, 0x47, 0xaf, 0xc1, 0x34, 0x0e, 0x1a, 0x59, 0xab, 0x90, 0x78, 0x6a, 0x1e, 0x46, 0xa2, 0xc5,
-	0xdf, 0x58, 0x90, 0xac, 0xee, 0x6c, 0x62, 0xea, 0xa3, 0x1a, 0xcc, 0x47, 0x81, 0xf9, 0xac, 0x29,
-	0x1d, 0xc5, 0x72, 0x90, 0xd3, 0xeb, 0x30, 0x7f, 0x37, 0xa8, 0x12, 0x21, 0x8c, 0xde, 0x57, 0xae,
-	0x3f, 0x7a, 0xb0, 0xf4, 0xa2, 0x81, 0x09, 0x2b, 0xc9, 0x31, 0xbc, 0xbb, 0xc7, 0xfa, 0x63, 0x36,
-	0xbf, 0x01, 0x93, 0x7a, 0xa9, 0x1c, 0x7d, 0x15, 0x5e, 0xe8, 0xc9, 0x1f, 0x65, 0x6a, 0x6a, 0x79,
-	0xe1, 0xd4, 0x00, 0x57, 0xf2, 0xf1, 0x70, 0xd0, 0x7a, 0xc5, 0x1f, 0x8f, 0x03, 0x54, 0x77, 0x76,
-	0xb6, 0x7c, 0xda, 0xeb, 0x10, 0xf1, 0x49, 0xd9, 0xbe, 0x0d, 0x97, 0x22, 0xdb, 0xb9, 0xef, 0x9c,
-	0xdf, 0xfe, 0x0b, 0xa1, 0x7e, 0xd3, 0x77, 0x4e, 0x84, 0x75, 0xb9, 0x08, 0x61, 0x13, 0xe7, 0x87,
-	0xad, 0x72, 0x31, 0xca, 0xec, 0x37, 0x21, 0x15, 0x91, 0xc1, 0x51, 0x1d, 0xa6, 0x84, 0xf9, 0x37,
-	0x04, 0x17, 0x4f, 0x27, 0x38, 0x50, 0x8b, 0x93, 0x1c, 0xaa, 0x17, 0xff, 0x6d, 0x01, 0xc4, 0x72,
-	0xe4, 0xd3, 0x19, 0x63, 0xa8, 0x0e, 0x49, 0x53, 0x89, 0x13, 0xcf, 0x5b, 0x89, 0x0d, 0x40, 0x8c,
-	0xd4, 0x9f, 0x8c, 0xc3, 0x85, 0xed, 0x20, 0x7b, 0x3f, 0xfd, 0x1c, 0x6c, 0xc3, 0x24, 0x61, 0xc2,
-	0xa7, 0x8a, 0x04, 0xe9, 0xf3, 0x2f, 0x9c, 0xe6, 0xf3, 0x13, 0x8c, 0xaa, 0x31, 0xe1, 0x0f, 0xe2,
-	0x11, 0x10, 0x60, 0xc5, 0xf8, 0xf8, 0x45, 0x02, 0xb2, 0xa7, 0xa9, 0xa2, 0x97, 0x21, 0xed, 0xf8,
-	0x44, 0x75, 0x04, 0x9b, 0x8c, 0xa5, 0x0a, 0xe6, 0x5c, 0xd0, 0x6d, 0xf6, 0x98, 0x06, 0xc8, 0x53,
-	0x99, 0x0c, 0x2e, 0x29, 0xfa, 0x7c, 0xc7, 0xb0, 0xb9, 0x08, 0x41, 0xed, 0x32, 0x5b, 0x90, 0xa6,
-	0x8c, 0x0a, 0x8a, 0x3b, 0x76, 0x0b, 0x77, 0x30, 0x73, 0x82, 0xe3, 0xea, 0xb9, 0xb6, 0x84, 0x39,
-	0x83, 0x51, 0xd1, 0x10, 0xa8, 0x06, 0x93, 0x01, 0xda, 0xc4, 0xf9, 0xd1, 0x02, 0x5d, 0x74, 0x1d,
-	0x66, 0xe2, 0x1b, 0x83, 0x3a, 0x7a, 0x4c, 0x34, 0x52, 0xb1, 0x7d, 0xe1, 0xac, 0x9d, 0x27, 0xf9,
-	0xd4, 0x9d, 0xc7, 0x9c, 0xee, 0x7e, 0x95, 0x80, 0xf9, 0x06, 0x71, 0xff, 0xf7, 0xdd, 0xb2, 0x09,
-	0xa0, 0x53, 0x55, 0x56, 0x52, 0xe3, 0x99, 0xe7, 0xc8, 0xf7, 0x69, 0x0d, 0x52, 0xe5, 0xe2, 0xbf,
-	0xe5, 0xa1, 0xbf, 0x8c, 0xc3, 0x4c, 0xdc, 0x43, 0xff, 0x97, 0x9b, 0x16, 0x5a, 0x8f, 0xca, 0xd4,
-	0x84, 0x2a, 0x53, 0xaf, 0x9c, 0x56, 0xa6, 0x46, 0xa2, 0xf9, 0x8c, 0xfa, 0xf4, 0xcb, 0x04, 0x24,
-	0x37, 0xb1, 0x8f, 0xbb, 0x1c, 0x6d, 0x8c, 0x1c, 0x64, 0xf5, 0x45, 0xf2, 0xea, 0x48, 0x30, 0x57,
-	0xcd, 0x5b, 0x87, 0x8e, 0xe5, 0x9f, 0x9f, 0x76, 0x8e, 0xfd, 0x2c, 0xcc, 0xc9, 0x0b, 0x71, 0x68,
-	0x90, 0x26, 0x77, 0x56, 0xdd, 0x6b, 0x43, 0xeb, 0x39, 0xca, 0x43, 0x4a, 0x8a, 0x45, 0x75, 0x58,
-	0xca, 0x40, 0x17, 0x1f, 0xd4, 0x74, 0x0f, 0x5a, 0x02, 0xb4, 0x17, 0x3e, 0x50, 0xd8, 0x11, 0x11,
-	0x52, 0x6e, 0x3e, 0x1a, 0x09, 0xc4, 0x5f, 0x04, 0x90, 0xab, 0xb0, 0x5d, 0xc2, 0xbc, 0xae, 0xb9,
-	0xd5, 0x4d, 0xcb, 0x9e, 0xaa, 0xec, 0x40, 0x3f, 0xb2, 0xf4, 0x79, 0xf8, 0xd8, 0xb5, 0xd9, 0x5c,
-	0x47, 0xb6, 0x9e, 0x21, 0x29, 0xfe, 0x75, 0x94, 0xcf, 0x0d, 0x70, 0xb7, 0xb3, 0x52, 0x3c, 0x01,
-	0xa7, 0x78, 0xd2, 0x4d, 0x5e, 0x1e, 0x9c, 0x87, 0xaf, 0xdd, 0x2b, 0x37, 0x64, 0x78, 0x1f, 0x7e,
-	0xf8, 0xfe, 0x4d, 0x33, 0xd3, 0x12, 0x77, 0xf7, 0xcb, 0x07, 0xe1, 0xf3, 0x95, 0xf6, 0x89, 0x3c,
-	0xa9, 0xa2, 0x68, 0xd7, 0x68, 0x10, 0xde, 0x93, 0x37, 0x3e, 0x79, 0x43, 0x88, 0x9d, 0xe4, 0xad,
-	0xa7, 0xdf, 0x10, 0x22, 0xfd, 0xa1, 0x1b, 0x42, 0x2c, 0xa7, 0xbe, 0x12, 0x15, 0xed, 0x71, 0xe3,
-	0x72, 0x83, 0xd5, 0xc2, 0x9c, 0xc4, 0xae, 0x1a, 0x74, 0x08, 0x22, 0x50, 0x52, 0xa9, 0x3a, 0x56,
-	0xfc, 0xa3, 0x05, 0x57, 0x47, 0xc2, 0x2f, 0x5c, 0xb2, 0x03, 0xc8, 0x8f, 0x0d, 0x2a, 0x37, 0x0e,
-	0xcc, 0xd2, 0x9f, 0x2f, 0x9a, 0xe7, 0xfd, 0x91, 0xca, 0xfd, 0xc9, 0xec, 0x3e, 0xa6, 0xf4, 0xfc,
-	0xde, 0x82, 0x8b, 0xf1, 0x05, 0x84, 0xa6, 0x34, 0x61, 0x26, 0x3e, 0xb5, 0x31, 0xe2, 0xc6, 0xb3,
-	0x18, 0x11, 0x5f, 0xff, 0x10, 0x08, 0xda, 0x89, 0x52, 0x5c, 0xbf, 0x9b, 0xdd, 0x7a, 0x66, 0x52,
-	0x82, 0x85, 0x9d, 0x98, 0xea, 0xda, 0x37, 0xff, 0xb0, 0x60, 0x62, 0xd3, 0xf3, 0x3a, 0xe8, 0x07,
-	0x30, 0xcf, 0x3c, 0x61, 0xcb, 0x74, 0x20, 0xae, 0x6d, 0x2e, 0xf6, 0xba, 0x7c, 0xd6, 0x9e, 0xca,
-	0xd5, 0xdf, 0x8f, 0xf2, 0xa3, 0x9a, 0xc3, 0x04, 0x9a, 0xf7, 0x23, 0xe6, 0x89, 0x8a, 0x12, 0xda,
-	0xd2, 0x77, 0xff, 0x5d, 0x98, 0x1d, 0x9e, 0x4e, 0x97, 0xd8, 0xd5, 0xb3, 0xa6, 0x9b, 0x3d, 0x73,
-	0xaa, 0x99, 0x56, 0x6c, 0x9e, 0x95, 0x29, 0xe9, 0xb5, 0x7f, 0x4a, 0xcf, 0xbd, 0x0d, 0x99, 0xb0,
-	0xbe, 0x6c, 0xab, 0xc7, 0x27, 0x2e, 0x43, 0x43, 0xbf, 0x43, 0x05, 0xa7, 0xfb, 0x42, 0xfc, 0x51,
-	0x13, 0xb7, 0x1c, 0x5a, 0x3a, 0xa6, 0x33, 0x44, 0xa7, 0xd1, 0xbd, 0xf9, 0x5b, 0x0b, 0x20, 0x7a,
-	0x24, 0x41, 0xaf, 0xc2, 0x95, 0xca, 0xc6, 0x7a, 0xd5, 0x6e, 0x6e, 0xad, 0x6e, 0x6d, 0x37, 0xed,
-	0xed, 0xf5, 0xe6, 0x66, 0x6d, 0xad, 0x7e, 0xbb, 0x5e, 0xab, 0x66, 0xc6, 0x72, 0xe9, 0xc3, 0x7b,
-	0x85, 0xd4, 0x36, 0xe3, 0x3d, 0xe2, 0xd0, 0x5d, 0x4a, 0x5c, 0xf4, 0x39, 0xb8, 0x38, 0x2c, 0x2d,
-	0x5b, 0xb5, 0x6a, 0xc6, 0xca, 0xcd, 0x1c, 0xde, 0x2b, 0x4c, 0xe9, 0x73, 0x22, 0x71, 0xd1, 0x22,
-	0x5c, 0x1a, 0x95, 0xab, 0xaf, 0xbf, 0x9e, 0x19, 0xcf, 0xcd, 0x1e, 0xde, 0x2b, 0x4c, 0x87, 0x07,
-	0x4a, 0x54, 0x04, 0x14, 0x97, 0x34, 0x78, 0x89, 0x1c, 0x1c, 0xde, 0x2b, 0x24, 0xb5, 0x17, 0x72,
-	0x13, 0xef, 0xfc, 0x7a, 0x61, 0xec, 0xe6, 0x77, 0x01, 0xea, 0x6c, 0xd7, 0xc7, 0x8e, 0x8a, 0xb6,
-	0x1c, 0x5c, 0xae, 0xaf, 0xdf, 0x6e, 0xac, 0xae, 0x6d, 0xd5, 0x37, 0xd6, 0x87, 0x97, 0x7d, 0x6c,
-	0xac, 0xba, 0xb1, 0x5d, 0x79, 0xb3, 0x66, 0x37, 0xeb, 0xaf, 0xaf, 0x67, 0x2c, 0x74, 0x05, 0x2e,
-	0x0c, 0x8d, 0x7d, 0x63, 0x7d, 0xab, 0xfe, 0x56, 0x2d, 0x33, 0x5e, 0xb9, 0xfd, 0xc1, 0xe3, 0x05,
-	0xeb, 0xe1, 0xe3, 0x05, 0xeb, 0x6f, 0x8f, 0x17, 0xac, 0x77, 0x9f, 0x2c, 0x8c, 0x3d, 0x7c, 0xb2,
-	0x30, 0xf6, 0xa7, 0x27, 0x0b, 0x63, 0xdf, 0x7a, 0xb5, 0x4d, 0xc5, 0x5e, 0xbf, 0x55, 0x72, 0xbc,
-	0xae, 0x79, 0xe6, 0x2e, 0x9f, 0x58, 0xf1, 0xd4, 0xbb, 0x72, 0x2b, 0xa9, 0x36, 0x99, 0x2f, 0xfe,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x6b, 0x77, 0xd8, 0xcf, 0x17, 0x00, 0x00,
+	// 2226 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x92, 0x34, 0x25, 0x3d, 0x8a, 0xa2, 0x34, 0x76, 0x1c, 0x4a, 0x49, 0x24, 0x99, 0x71,
+	0x1a, 0xc7, 0x8d, 0xa9, 0xda, 0x05, 0x7c, 0x50, 0x8b, 0x06, 0xa2, 0x28, 0xc5, 0x4c, 0x1d, 0x59,
+	0x5d, 0x7d, 0xb4, 0xe9, 0xd7, 0x62, 0xb8, 0x3b, 0x24, 0x37, 0x5a, 0xee, 0xd0, 0x3b, 0x43, 0xdb,
+	0xec, 0xb9, 0x87, 0xc0, 0x45, 0x81, 0x00, 0x05, 0x8a, 0x02, 0x85, 0x11, 0x03, 0xbd, 0xa4, 0xb7,
+	0x1c, 0x8c, 0xde, 0x8b, 0x1e, 0x9a, 0x16, 0x28, 0x60, 0xf8, 0x54, 0x14, 0xa8, 0x5b, 0xd8, 0x07,
+	0x07, 0xed, 0xa5, 0xe8, 0x5f, 0x50, 0xcc, 0xc7, 0x7e, 0x50, 0x14, 0xad, 0xaf, 0xa0, 0x08, 0xda,
+	0x0b, 0xb1, 0xf3, 0xe6, 0xbd, 0xdf, 0xcc, 0xbc, 0x79, 0xf3, 0xbe, 0x08, 0xe7, 0x6d, 0xca, 0xda,
+	0x94, 0x2d, 0x32, 0x8e, 0x77, 0x5d, 0xbf, 0xb9, 0x78, 0xeb, 0x72, 0x9d, 0x70, 0x7c, 0x39, 0x1c,
+	0x97, 0x3b, 0x01, 0xe5, 0x14, 0x9d, 0x55, 0x5c, 0xe5, 0x90, 0xaa, 0xb9, 0x66, 0xcf, 0x34, 0x69,
+	0x93, 0x4a, 0x96, 0x45, 0xf1, 0xa5, 0xb8, 0x67, 0x67, 0x9a, 0x94, 0x36, 0x3d, 0xb2, 0x28, 0x47,
+	0xf5, 0x6e, 0x63, 0x11, 0xfb, 0x3d, 0x3d, 0x35, 0xb7, 0x77, 0xca, 0xe9, 0x06, 0x98, 0xbb, 0xd4,
+	0xd7, 0xf3, 0xf3, 0x7b, 0xe7, 0xb9, 0xdb, 0x26, 0x8c, 0xe3, 0x76, 0x27, 0xc4, 0x56, 0x3b, 0xb1,
+	0xd4, 0xa2, 0x7a, 0x5b, 0x1a, 0x5b, 0x1f, 0xa5, 0x8e, 0x19, 0x89, 0xce, 0x61, 0x53, 0x37, 0xc4,
+	0x9e, 0xc6, 0x6d, 0xd7, 0xa7, 0x8b, 0xf2, 0x57, 0x93, 0x5e, 0xe6, 0xc4, 0x77, 0x48, 0xd0, 0x76,
+	0x7d, 0xbe, 0xc8, 0x7b, 0x1d, 0xc2, 0xd4, 0xaf, 0x9e, 0x7d, 0x29, 0x31, 0x8b, 0xeb, 0xb6, 0x9b,
+	0x9c, 0x2c, 0xfd, 0xcc, 0x80, 0xc9, 0x6b, 0x2e, 0xe3, 0x34, 0x70, 0x6d, 0xec, 0xd5, 0xfc, 0x06,
+	0x45, 0x5f, 0x83, 0x6c, 0x8b, 0x60, 0x87, 0x04, 0x45, 0x63, 0xc1, 0xb8, 0x90, 0xbb, 0x52, 0x2c,
+	0xc7, 0x00, 0x65, 0x25, 0x7b, 0x4d, 0xce, 0x57, 0xc6, 0x3f, 0x7d, 0x3c, 0x3f, 0xf2, 0xf1, 0xb3,
+	0x4f, 0x2e, 0x1a, 0xa6, 0x16, 0x41, 0x55, 0xc8, 0xde, 0xc2, 0x1e, 0x23, 0xbc, 0x98, 0x5a, 0x48,
+	0x5f, 0xc8, 0x5d, 0x39, 0x57, 0xde, 0x5f, 0xe7, 0xe5, 0x1d, 0xec, 0xb9, 0x0e, 0xe6, 0xb4, 0x1f,
+	0x45, 0xc9, 0x96, 0x7e, 0x9e, 0x82, 0xc2, 0x0a, 0x6d, 0xb7, 0x5d, 0xc6, 0x5c, 0xea, 0x9b, 0x98,
+	0x13, 0x86, 0xde, 0x81, 0x4c, 0x80, 0x39, 0x91, 0x9b, 0x1a, 0xaf, 0x5c, 0x15, 0x42, 0x7f, 0x79,
+	0x3c, 0xff, 0x92, 0x82, 0x67, 0xce, 0x6e, 0xd9, 0xa5, 0x8b, 0x6d, 0xcc, 0x5b, 0xe5, 0xeb, 0xa4,
+	0x89, 0xed, 0x5e, 0x95, 0xd8, 0x8f, 0x1e, 0x5c, 0x02, 0xbd, 0x7a, 0x95, 0xd8, 0x6a, 0x05, 0x89,
+	0x81, 0xbe, 0x05, 0x63, 0x6d, 0x7c, 0xc7, 0x92, 0x78, 0xa9, 0x13, 0xe1, 0x8d, 0xb6, 0xf1, 0x1d,
+	0xb1, 0x3f, 0xf4, 0x43, 0x28, 0x08, 0x48, 0xbb, 0x85, 0xfd, 0x26, 0x51, 0xc8, 0xe9, 0x13, 0x21,
+	0xe7, 0xdb, 0xf8, 0xce, 0x8a, 0x44, 0x13, 0xf8, 0x4b, 0x99, 0xcf, 0xee, 0xcf, 0x1b, 0xa5, 0xdf,
+	0x1a, 0x00, 0xb1, 0x62, 0x10, 0x86, 0x29, 0x3b, 0x1a, 0xc9, 0x45, 0x99, 0xbe, 0xb4, 0xd7, 0x87,
+	0xe9, 0x7d, 0x8f, 0x5a, 0x2b, 0x79, 0xb1, 0xbd, 0x87, 0x8f, 0xe7, 0x0d, 0xb5, 0x6a, 0xc1, 0x1e,
+	0x50, 0x7b, 0xae, 0xdb, 0x71, 0x30, 0x27, 0x96, 0xb0, 0x61, 0xa9, 0xad, 0xdc, 0x95, 0xd9, 0xb2,
+	0x32, 0xf0, 0x72, 0x68, 0xe0, 0xe5, 0xad, 0xd0, 0xc0, 0x15, 0xe0, 0x87, 0x7f, 0x0b, 0x01, 0x41,
+	0x49, 0x8b, 0x79, 0x7d, 0x86, 0x8f, 0x0d, 0xc8, 0x55, 0x09, 0xb3, 0x03, 0xb7, 0x23, 0x9e, 0x0c,
+	0x2a, 0xc2, 0x68, 0x9b, 0xfa, 0xee, 0xae, 0x36, 0xb8, 0x71, 0x33, 0x1c, 0xa2, 0x59, 0x18, 0x73,
+	0x1d, 0xe2, 0x73, 0x97, 0xf7, 0xd4, 0x35, 0x99, 0xd1, 0x58, 0x48, 0xdd, 0x26, 0x75, 0xe6, 0x86,
+	0x7a, 0x36, 0xc3, 0x21, 0x7a, 0x03, 0xa6, 0x18, 0xb1, 0xbb, 0x81, 0xcb, 0x7b, 0x96, 0x4d, 0x7d,
+	0x8e, 0x6d, 0x5e, 0xcc, 0x48, 0x96, 0x42, 0x48, 0x5f, 0x51, 0x64, 0x01, 0xe2, 0x10, 0x8e, 0x5d,
+	0x8f, 0x15, 0x4f, 0x29, 0x10, 0x3d, 0xd4, 0x5b, 0xfd, 0xc3, 0x18, 0x8c, 0x47, 0x86, 0x8a, 0x56,
+	0x60, 0x8a, 0x76, 0x48, 0x20, 0xbe, 0x2d, 0xec, 0x38, 0x01, 0x61, 0x4c, 0x5b, 0x63, 0xf1, 0xd1,
+	0x83, 0x4b, 0x67, 0xb4, 0xc2, 0x97, 0xd5, 0xcc, 0x26, 0x0f, 0x5c, 0xbf, 0x69, 0x16, 0x42, 0x09,
+	0x4d, 0x46, 0xef, 0x89, 0x2b, 0xf3, 0x19, 0xf1, 0x59, 0x97, 0x59, 0x9d, 0x6e, 0x7d, 0x97, 0xf4,
+	0xb4, 0x52, 0xcf, 0x0c, 0x28, 0x75, 0xd9, 0xef, 0x55, 0x8a, 0x7f, 0x8c, 0xa1, 0xed, 0xa0, 0xd7,
+	0xe1, 0xb4, 0xbc, 0xd1, 0xad, 0x7f, 0x93, 0xf4, 0xcc, 0x42, 0x84, 0xb3, 0x21, 0x61, 0xd0, 0x59,
+	0xc8, 0xbe, 0x8f, 0x5d, 0x8f, 0x38, 0x52, 0x23, 0x63, 0xa6, 0x1e, 0xa1, 0x25, 0xc8, 0x32, 0x8e,
+	0x79, 0x97, 0x49, 0x35, 0x4c, 0x5e, 0x29, 0x0d, 0xb3, 0x8d, 0x0a, 0xf5, 0x9d, 0x4d, 0xc9, 0x69,
+	0x6a, 0x09, 0xb4, 0x02, 0x59, 0x4e, 0x77, 0x89, 0xaf, 0x15, 0x54, 0xf9, 0xb2, 0xb6, 0xe6, 0x17,
+	0x06, 0xad, 0xb9, 0xe6, 0xf3, 0x84, 0x1d, 0xd7, 0x7c, 0x6e, 0x6a, 0x51, 0xf4, 0x7d, 0x98, 0x72,
+	0x88, 0x47, 0x9a, 0x52, 0x73, 0xac, 0x85, 0x03, 0xc2, 0x8a, 0x59, 0x09, 0x77, 0xf9, 0xc8, 0x8f,
+	0xc3, 0x2c, 0x44, 0x50, 0x9b, 0x12, 0x09, 0x6d, 0x40, 0xce, 0x89, 0xcd, 0xa9, 0x38, 0x2a, 0x95,
+	0xf9, 0xea, 0xb0, 0x33, 0x26, 0x2c, 0x2f, 0xe9, 0x79, 0x92, 0x10, 0xc2, 0x82, 0xba, 0x7e, 0x9d,
+	0xfa, 0x8e, 0xeb, 0x37, 0xad, 0x16, 0x71, 0x9b, 0x2d, 0x5e, 0x1c, 0x5b, 0x30, 0x2e, 0xa4, 0xcd,
+	0x42, 0x44, 0xbf, 0x26, 0xc9, 0x68, 0x03, 0x26, 0x63, 0x56, 0xf9, 0x42, 0xc6, 0x8f, 0xfa, 0x42,
+	0xf2, 0x11, 0x80, 0x60, 0x41, 0xef, 0x02, 0xc4, 0x6f, 0xb0, 0x08, 0x12, 0xad, 0x74, 0xf0, 0x6b,
+	0x4e, 0x1e, 0x26, 0x01, 0x80, 0xbe, 0x07, 0xa7, 0xdb, 0xae, 0x6f, 0x31, 0xe2, 0x35, 0x2c, 0xad,
+	0x39, 0x81, 0x9b, 0x3b, 0xfa, 0x6d, 0x4e, 0xb7, 0x5d, 0x7f, 0x93, 0x78, 0x8d, 0x6a, 0x84, 0x82,
+	0xbe, 0x0e, 0x2f, 0xc5, 0xa7, 0xa7, 0xbe, 0xd5, 0xa2, 0x9e, 0x63, 0x05, 0xa4, 0x61, 0xd9, 0xb4,
+	0xeb, 0xf3, 0xe2, 0x84, 0xd4, 0xd9, 0x8b, 0x11, 0xcb, 0x0d, 0xff, 0x1a, 0xf5, 0x1c, 0x93, 0x34,
+	0x56, 0xc4, 0x34, 0x7a, 0x15, 0xe2, 0xa3, 0x5b, 0xae, 0xc3, 0x8a, 0xf9, 0x85, 0xf4, 0x85, 0x8c,
+	0x39, 0x11, 0x11, 0x6b, 0x0e, 0x43, 0x04, 0x5e, 0xb8, 0x15, 0xbe, 0x40, 0x4b, 0xd0, 0x43, 0x03,
+	0x9a, 0x3c, 0xae, 0x01, 0x9d, 0x8e, 0xf0, 0xa4, 0xbd, 0x2b, 0x23, 0xda, 0x81, 0xbc, 0xe7, 0xde,
+	0xec, 0xba, 0x11, 0x7c, 0xe1, 0xb8, 0xf0, 0x13, 0x0a, 0x47, 0xe1, 0x2e, 0x8d, 0x7d, 0x70, 0x7f,
+	0x7e, 0xe4, 0xb3, 0xfb, 0xf3, 0x23, 0xa5, 0x35, 0x98, 0xd8, 0xc1, 0x9e, 0x76, 0x03, 0x84, 0xa1,
+	0xab, 0x30, 0x8e, 0xc3, 0x41, 0xd1, 0x58, 0x48, 0x3f, 0xd7, 0x8d, 0xc4, 0xac, 0xa5, 0x5f, 0x1b,
+	0x90, 0xad, 0xee, 0x6c, 0x60, 0x37, 0x40, 0xab, 0x30, 0x1d, 0xbf, 0xab, 0xc3, 0x7a, 0xa4, 0xf8,
+	0x29, 0x6a, 0x3a, 0x5a, 0x87, 0xe9, 0x58, 0xc5, 0x21, 0x8c, 0x0a, 0x8b, 0xe7, 0x1e, 0x3d, 0xb8,
+	0xf4, 0x8a, 0x86, 0x89, 0x1c, 0xe1, 0x1e, 0xbc, 0x5b, 0x7b, 0xe8, 0x89, 0x33, 0xbf, 0x03, 0xa3,
+	0x6a, 0xab, 0x0c, 0xbd, 0x05, 0xa7, 0x3a, 0xe2, 0x43, 0x1e, 0x35, 0x77, 0x65, 0x6e, 0xe8, 0xfb,
+	0x94, 0xfc, 0x49, 0x6b, 0x56, 0x72, 0xa5, 0x9f, 0xa4, 0x00, 0xaa, 0x3b, 0x3b, 0x5b, 0x81, 0xdb,
+	0xf1, 0x08, 0xff, 0xbc, 0xce, 0xbe, 0x9d, 0x34, 0x2f, 0x16, 0xd8, 0x47, 0x3f, 0x7f, 0x6c, 0x4e,
+	0x9b, 0x81, 0xbd, 0x2f, 0xac, 0xc3, 0x78, 0x04, 0x9b, 0x3e, 0x3a, 0x6c, 0x95, 0xf1, 0x41, 0xcd,
+	0x7e, 0x07, 0x72, 0xb1, 0x32, 0x18, 0xaa, 0xc1, 0x18, 0xd7, 0xdf, 0x5a, 0xc1, 0xa5, 0xe1, 0x0a,
+	0x0e, 0xc5, 0x92, 0x4a, 0x8e, 0xc4, 0x4b, 0x1f, 0x09, 0x3d, 0xc7, 0x4f, 0xfc, 0x8b, 0x69, 0x63,
+	0xa8, 0x06, 0x59, 0xfd, 0x50, 0xd3, 0xc7, 0x7d, 0xa8, 0x1a, 0x00, 0xbd, 0x06, 0x93, 0xfd, 0x1e,
+	0x46, 0x86, 0xc9, 0x31, 0x33, 0xdf, 0xe7, 0x27, 0x12, 0xba, 0xff, 0x69, 0x0a, 0x4e, 0x6f, 0x87,
+	0x3e, 0xea, 0x8b, 0xaf, 0xaa, 0x6d, 0x18, 0x25, 0x3e, 0x0f, 0x5c, 0xa9, 0x2b, 0x61, 0x1a, 0x5f,
+	0x19, 0x66, 0x1a, 0xfb, 0x1c, 0x6a, 0xd5, 0xe7, 0x41, 0x2f, 0x69, 0x28, 0x21, 0x56, 0x42, 0x1f,
+	0xbf, 0x4c, 0x43, 0x71, 0x98, 0x28, 0x7a, 0x1d, 0x0a, 0x76, 0x40, 0x24, 0x21, 0x0c, 0xa5, 0x86,
+	0x0c, 0x0b, 0x93, 0x21, 0x59, 0x47, 0x52, 0x13, 0x44, 0xee, 0x29, 0x6c, 0x50, 0xb0, 0x1e, 0x2f,
+	0xd9, 0x9c, 0x8c, 0x11, 0x64, 0x2c, 0xdd, 0x82, 0x82, 0xeb, 0xbb, 0xdc, 0xc5, 0x9e, 0x55, 0xc7,
+	0x1e, 0xf6, 0xed, 0x30, 0x29, 0x3f, 0x52, 0xe0, 0x9b, 0xd4, 0x18, 0x15, 0x05, 0x81, 0x56, 0x61,
+	0x34, 0x44, 0xcb, 0x1c, 0x1d, 0x2d, 0x94, 0x45, 0xe7, 0x60, 0x22, 0x19, 0xfe, 0x64, 0x82, 0x95,
+	0x31, 0x73, 0x89, 0xe8, 0x77, 0x50, 0x7c, 0xcd, 0x3e, 0x37, 0xbe, 0xea, 0x1c, 0xf6, 0xa3, 0x34,
+	0x4c, 0x9b, 0xc4, 0xf9, 0xdf, 0xbf, 0x96, 0x0d, 0x00, 0xf5, 0xa2, 0x85, 0xc3, 0x2d, 0x66, 0x8e,
+	0xeb, 0x16, 0xc6, 0x15, 0x48, 0x95, 0xf1, 0xff, 0xd6, 0x0d, 0xfd, 0x35, 0x05, 0x13, 0xc9, 0x1b,
+	0xfa, 0xbf, 0x8c, 0x6d, 0x68, 0x3d, 0x76, 0x53, 0x19, 0xe9, 0xa6, 0xde, 0x18, 0xe6, 0xa6, 0x06,
+	0xac, 0xf9, 0x00, 0xff, 0xf4, 0x2c, 0x0b, 0xd9, 0x0d, 0x1c, 0xe0, 0x36, 0x43, 0x37, 0x06, 0xd2,
+	0x75, 0x55, 0x2e, 0xcf, 0x0c, 0x18, 0x73, 0x55, 0x77, 0x74, 0x94, 0x2d, 0xff, 0x62, 0x58, 0xb6,
+	0xfe, 0x1a, 0x4c, 0x8a, 0xb2, 0x3f, 0x3a, 0x90, 0x52, 0x6e, 0x5e, 0x56, 0xef, 0xd1, 0xe9, 0x19,
+	0x9a, 0x87, 0x9c, 0x60, 0x8b, 0xfd, 0xb0, 0xe0, 0x81, 0x36, 0xbe, 0xb3, 0xaa, 0x28, 0xe8, 0x12,
+	0xa0, 0x56, 0xd4, 0x86, 0xb1, 0x62, 0x45, 0x08, 0xbe, 0xe9, 0x78, 0x26, 0x64, 0x7f, 0x05, 0x40,
+	0xe6, 0xc2, 0x0e, 0xf1, 0x69, 0x5b, 0xd7, 0xae, 0xe3, 0x82, 0x52, 0x15, 0x04, 0xf4, 0x63, 0x43,
+	0x65, 0xfd, 0x7b, 0x9a, 0x03, 0xba, 0xe8, 0xda, 0x3a, 0xc4, 0xa3, 0xf8, 0xf7, 0xe3, 0xf9, 0xd9,
+	0x1e, 0x6e, 0x7b, 0x4b, 0xa5, 0x7d, 0x70, 0x4a, 0xfb, 0xf5, 0x2b, 0x44, 0x79, 0xd0, 0xdf, 0x5c,
+	0x40, 0xeb, 0x30, 0xb5, 0x4b, 0x7a, 0x56, 0x40, 0xb9, 0x72, 0x34, 0x0d, 0x42, 0x74, 0x79, 0x36,
+	0x13, 0xde, 0xad, 0xe8, 0x72, 0x25, 0xaa, 0x19, 0xb7, 0xaf, 0x8e, 0x99, 0xdc, 0x25, 0x3d, 0x53,
+	0x0b, 0xaf, 0x11, 0xe9, 0x31, 0x49, 0x87, 0xda, 0x2d, 0xcb, 0x23, 0x7e, 0x93, 0xb7, 0x74, 0x4d,
+	0x96, 0x93, 0xb4, 0xeb, 0x92, 0x84, 0x6e, 0xc2, 0x4c, 0xd3, 0xa3, 0x75, 0xec, 0x59, 0x61, 0x3a,
+	0xaf, 0x8c, 0xc7, 0xb2, 0x71, 0xa7, 0x38, 0x7e, 0xa2, 0x86, 0xcc, 0x59, 0x05, 0x7c, 0x5d, 0xa5,
+	0xf7, 0x0a, 0x76, 0x05, 0x77, 0xd0, 0x6d, 0x78, 0x39, 0x7e, 0x0f, 0xfb, 0xac, 0x0a, 0x27, 0x5a,
+	0x75, 0x26, 0xc2, 0x1e, 0x58, 0xf8, 0xfd, 0x81, 0xd2, 0xa8, 0x81, 0x6d, 0x4e, 0x83, 0x62, 0xee,
+	0x44, 0x2b, 0xf6, 0xd7, 0x47, 0x6b, 0x12, 0x72, 0xe9, 0xbc, 0xf0, 0x54, 0x77, 0x9f, 0x7d, 0x72,
+	0x51, 0xa3, 0x5d, 0x62, 0xce, 0xee, 0xe2, 0x9d, 0xa8, 0xdf, 0xaa, 0x9e, 0x97, 0xa8, 0x4d, 0x50,
+	0x9c, 0x00, 0x98, 0x84, 0x75, 0xa8, 0xcf, 0x64, 0x49, 0x9b, 0x28, 0x3d, 0x8d, 0xe7, 0x97, 0xb4,
+	0xb1, 0x7c, 0x5f, 0x49, 0x9b, 0x70, 0x8f, 0xdf, 0x88, 0xe3, 0x6f, 0xea, 0x08, 0xd6, 0x14, 0x0a,
+	0x49, 0xaf, 0x3b, 0x52, 0xfa, 0x93, 0x01, 0x33, 0x03, 0x9e, 0x24, 0xda, 0xb2, 0x0d, 0x28, 0x48,
+	0x4c, 0xca, 0x17, 0xd9, 0xd3, 0x5b, 0x3f, 0x9e, 0x63, 0x9a, 0x0e, 0xf6, 0xce, 0x7e, 0x4e, 0x89,
+	0x44, 0xd8, 0xab, 0x32, 0xe0, 0x4c, 0x72, 0x03, 0xd1, 0x51, 0x36, 0x61, 0x22, 0xb9, 0xb4, 0x3e,
+	0xc4, 0xf9, 0xc3, 0x1c, 0x22, 0xb9, 0xff, 0x3e, 0x10, 0xb4, 0x13, 0x7b, 0x6b, 0xd5, 0xe8, 0xbd,
+	0x7c, 0x68, 0xa5, 0x84, 0x1b, 0xdb, 0xd7, 0x6b, 0xab, 0xbb, 0xf9, 0xa7, 0x01, 0x99, 0x0d, 0x4a,
+	0x3d, 0x74, 0x13, 0xa6, 0x7d, 0xca, 0xa5, 0x71, 0x13, 0xc7, 0xd2, 0x9d, 0x28, 0x15, 0x09, 0x57,
+	0x9f, 0xab, 0xab, 0x7f, 0x3c, 0x9e, 0x1f, 0x94, 0xec, 0x57, 0xa0, 0x6e, 0x78, 0xfa, 0x94, 0x57,
+	0x24, 0xd3, 0x96, 0xe4, 0x41, 0x0d, 0xc8, 0xf7, 0x2f, 0xa7, 0xa2, 0xe5, 0xf2, 0x41, 0xcb, 0xe5,
+	0x0f, 0x5c, 0x6a, 0xa2, 0x9e, 0x58, 0x67, 0x69, 0x4c, 0xdc, 0xda, 0xbf, 0xc4, 0xcd, 0xbd, 0x07,
+	0x53, 0x51, 0xa8, 0xd8, 0x96, 0xdd, 0x52, 0x26, 0x4c, 0x43, 0x35, 0x4e, 0xc3, 0x7a, 0x6e, 0x21,
+	0xd9, 0x85, 0x17, 0x6d, 0xfc, 0xf2, 0x1e, 0x99, 0x3e, 0x75, 0x6a, 0xd9, 0xd2, 0xef, 0xd3, 0x30,
+	0xb3, 0x42, 0x7d, 0xa6, 0x5b, 0x86, 0xda, 0x97, 0xaa, 0x86, 0x7f, 0x0f, 0x5d, 0x1f, 0xda, 0xd0,
+	0x3c, 0x44, 0x10, 0x1f, 0xe8, 0x6c, 0xee, 0x40, 0x41, 0x24, 0x3f, 0x36, 0xf5, 0x4f, 0xd8, 0xd8,
+	0xcc, 0x53, 0xcf, 0xd1, 0x9b, 0x16, 0x6d, 0xcd, 0x1d, 0x28, 0xf8, 0xe4, 0x76, 0x1f, 0x6e, 0xfa,
+	0x78, 0xb8, 0x3e, 0xb9, 0x9d, 0xc0, 0x3d, 0x2b, 0xfe, 0xe7, 0x90, 0x99, 0x6f, 0x46, 0xc6, 0x91,
+	0x6c, 0x6b, 0x68, 0xc6, 0x7b, 0xea, 0xa4, 0x19, 0xef, 0x55, 0x48, 0x37, 0x88, 0x8a, 0xbf, 0x87,
+	0x75, 0x57, 0x42, 0x20, 0x91, 0xc4, 0xfc, 0xce, 0x80, 0xd3, 0xd2, 0x72, 0xdc, 0x1f, 0x11, 0xd9,
+	0x5b, 0x32, 0x89, 0x4d, 0x03, 0x07, 0x4d, 0x42, 0xca, 0x75, 0xe4, 0xad, 0x65, 0xcc, 0x94, 0xeb,
+	0xa0, 0x32, 0x9c, 0xa2, 0xb7, 0x7d, 0x12, 0x14, 0x53, 0x07, 0xe4, 0x8b, 0x8a, 0x4d, 0x26, 0x30,
+	0xd4, 0xe9, 0x7a, 0xc4, 0xc2, 0xb6, 0xca, 0x59, 0x55, 0x3b, 0x3d, 0xaf, 0xa8, 0xcb, 0x8a, 0x88,
+	0xde, 0x82, 0xf1, 0x28, 0x2c, 0x14, 0x33, 0x87, 0xb5, 0x91, 0x58, 0x46, 0x39, 0xa9, 0x8b, 0xbf,
+	0x31, 0x00, 0xe2, 0x2e, 0x33, 0x7a, 0x13, 0x5e, 0xac, 0xdc, 0x58, 0xaf, 0x5a, 0x9b, 0x5b, 0xcb,
+	0x5b, 0xdb, 0x9b, 0xd6, 0xf6, 0xfa, 0xe6, 0xc6, 0xea, 0x4a, 0x6d, 0xad, 0xb6, 0x5a, 0x9d, 0x1a,
+	0x99, 0x2d, 0xdc, 0xbd, 0xb7, 0x90, 0xdb, 0xf6, 0x59, 0x87, 0xd8, 0x6e, 0xc3, 0x25, 0x0e, 0xfa,
+	0x12, 0x9c, 0xe9, 0xe7, 0x16, 0xa3, 0xd5, 0xea, 0x94, 0x31, 0x3b, 0x71, 0xf7, 0xde, 0xc2, 0x98,
+	0x2a, 0x41, 0x89, 0x83, 0x2e, 0xc0, 0x0b, 0x83, 0x7c, 0xb5, 0xf5, 0xb7, 0xa7, 0x52, 0xb3, 0xf9,
+	0xbb, 0xf7, 0x16, 0xc6, 0xa3, 0x5a, 0x15, 0x95, 0x00, 0x25, 0x39, 0x35, 0x5e, 0x7a, 0x16, 0xee,
+	0xde, 0x5b, 0xc8, 0x2a, 0xaf, 0x30, 0x9b, 0xf9, 0xe0, 0x57, 0x73, 0x23, 0x17, 0x7f, 0x00, 0x50,
+	0xf3, 0x1b, 0x01, 0xb6, 0xa5, 0xf7, 0x9b, 0x85, 0xb3, 0xb5, 0xf5, 0x35, 0x73, 0x79, 0x65, 0xab,
+	0x76, 0x63, 0xbd, 0x7f, 0xdb, 0x7b, 0xe6, 0xaa, 0x37, 0xb6, 0x2b, 0xd7, 0x57, 0xad, 0xcd, 0xda,
+	0xdb, 0xeb, 0x53, 0x06, 0x7a, 0x11, 0x4e, 0xf7, 0xcd, 0x7d, 0x7b, 0x7d, 0xab, 0xf6, 0xee, 0xea,
+	0x54, 0xaa, 0xb2, 0xf6, 0xe9, 0x93, 0x39, 0xe3, 0xe1, 0x93, 0x39, 0xe3, 0xef, 0x4f, 0xe6, 0x8c,
+	0x0f, 0x9f, 0xce, 0x8d, 0x3c, 0x7c, 0x3a, 0x37, 0xf2, 0xe7, 0xa7, 0x73, 0x23, 0xdf, 0x7d, 0xb3,
+	0xe9, 0xf2, 0x56, 0xb7, 0x5e, 0xb6, 0x69, 0x5b, 0xff, 0x4f, 0xb8, 0xb8, 0x6f, 0x04, 0x96, 0x7f,
+	0xcc, 0xd5, 0xb3, 0xd2, 0x34, 0xbf, 0xfa, 0x9f, 0x01, 0x00, 0xf1, 0xcb, 0x26, 0xe0, 0x10, 0x1d,
+	0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1404,707 +1587,800 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 11200 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x69, 0x90, 0x1c, 0xd7,
-		0x79, 0xd8, 0xce, 0x3d, 0xf3, 0xcd, 0xd5, 0xfb, 0x76, 0x01, 0x2c, 0x06, 0xe4, 0xee, 0xa2, 0x29,
-		0x12, 0x20, 0x48, 0x2e, 0x48, 0x90, 0x00, 0xc9, 0x85, 0x28, 0x66, 0x66, 0x76, 0xb0, 0x18, 0x70,
-		0x2f, 0xf6, 0xcc, 0x42, 0x24, 0x6d, 0xab, 0xdd, 0xdb, 0xf3, 0x76, 0xb7, 0x89, 0x99, 0xee, 0x51,
-		0x77, 0x0f, 0xb0, 0xcb, 0x4a, 0xa5, 0xe4, 0xc8, 0x4e, 0x64, 0xf8, 0x88, 0x1c, 0xbb, 0x6c, 0xd9,
-		0x12, 0x6c, 0xc9, 0x8e, 0x6d, 0xd9, 0xb9, 0x7c, 0x28, 0x3e, 0xe2, 0x8a, 0x63, 0xe7, 0xb4, 0x5d,
-		0x39, 0x14, 0xff, 0x48, 0x9c, 0x54, 0x99, 0xb1, 0x29, 0x57, 0xac, 0xc8, 0x72, 0xe2, 0x83, 0x4e,
-		0x9c, 0x52, 0x25, 0x95, 0x7a, 0x57, 0x1f, 0x73, 0xec, 0xcc, 0x42, 0xa4, 0xac, 0xd8, 0xf9, 0x03,
-		0x4c, 0x7f, 0xef, 0xfb, 0xbe, 0xf7, 0xde, 0xf7, 0xbe, 0xf7, 0xbd, 0xef, 0xfb, 0xde, 0xb1, 0xf0,
-		0xdb, 0x15, 0x58, 0xdc, 0xb3, 0xac, 0xbd, 0x36, 0xbe, 0xd8, 0xb5, 0x2d, 0xd7, 0xda, 0xe9, 0xed,
-		0x5e, 0x6c, 0x61, 0x47, 0xb7, 0x8d, 0xae, 0x6b, 0xd9, 0x4b, 0x14, 0x86, 0x8a, 0x0c, 0x63, 0x49,
-		0x60, 0xc8, 0xeb, 0x30, 0x7d, 0xcd, 0x68, 0xe3, 0x15, 0x0f, 0xb1, 0x81, 0x5d, 0xf4, 0x1c, 0xc4,
-		0x77, 0x8d, 0x36, 0x9e, 0x8b, 0x2c, 0xc6, 0xce, 0x67, 0x2f, 0xbd, 0x67, 0xa9, 0x8f, 0x68, 0x29,
-		0x4c, 0xb1, 0x45, 0xc0, 0x0a, 0xa5, 0x90, 0xff, 0x4f, 0x1c, 0x66, 0x86, 0x94, 0x22, 0x04, 0x71,
-		0x53, 0xeb, 0x10, 0x8e, 0x91, 0xf3, 0x19, 0x85, 0xfe, 0x46, 0x73, 0x90, 0xea, 0x6a, 0xfa, 0x2d,
-		0x6d, 0x0f, 0xcf, 0x45, 0x29, 0x58, 0x7c, 0xa2, 0x79, 0x80, 0x16, 0xee, 0x62, 0xb3, 0x85, 0x4d,
-		0xfd, 0x70, 0x2e, 0xb6, 0x18, 0x3b, 0x9f, 0x51, 0x02, 0x10, 0xf4, 0x18, 0x4c, 0x77, 0x7b, 0x3b,
-		0x6d, 0x43, 0x57, 0x03, 0x68, 0xb0, 0x18, 0x3b, 0x9f, 0x50, 0x24, 0x56, 0xb0, 0xe2, 0x23, 0x9f,
-		0x83, 0xe2, 0x1d, 0xac, 0xdd, 0x0a, 0xa2, 0x66, 0x29, 0x6a, 0x81, 0x80, 0x03, 0x88, 0x55, 0xc8,
-		0x75, 0xb0, 0xe3, 0x68, 0x7b, 0x58, 0x75, 0x0f, 0xbb, 0x78, 0x2e, 0x4e, 0x7b, 0xbf, 0x38, 0xd0,
-		0xfb, 0xfe, 0x9e, 0x67, 0x39, 0x55, 0xf3, 0xb0, 0x8b, 0x51, 0x19, 0x32, 0xd8, 0xec, 0x75, 0x18,
-		0x87, 0xc4, 0x08, 0xf9, 0xd5, 0xcc, 0x5e, 0xa7, 0x9f, 0x4b, 0x9a, 0x90, 0x71, 0x16, 0x29, 0x07,
-		0xdb, 0xb7, 0x0d, 0x1d, 0xcf, 0x25, 0x29, 0x83, 0x73, 0x03, 0x0c, 0x1a, 0xac, 0xbc, 0x9f, 0x87,
-		0xa0, 0x43, 0x55, 0xc8, 0xe0, 0x03, 0x17, 0x9b, 0x8e, 0x61, 0x99, 0x73, 0x29, 0xca, 0xe4, 0xe1,
-		0x21, 0xa3, 0x88, 0xdb, 0xad, 0x7e, 0x16, 0x3e, 0x1d, 0xba, 0x02, 0x29, 0xab, 0xeb, 0x1a, 0x96,
-		0xe9, 0xcc, 0xa5, 0x17, 0x23, 0xe7, 0xb3, 0x97, 0x1e, 0x18, 0xaa, 0x08, 0x9b, 0x0c, 0x47, 0x11,
-		0xc8, 0xa8, 0x0e, 0x92, 0x63, 0xf5, 0x6c, 0x1d, 0xab, 0xba, 0xd5, 0xc2, 0xaa, 0x61, 0xee, 0x5a,
-		0x73, 0x19, 0xca, 0x60, 0x61, 0xb0, 0x23, 0x14, 0xb1, 0x6a, 0xb5, 0x70, 0xdd, 0xdc, 0xb5, 0x94,
-		0x82, 0x13, 0xfa, 0x46, 0x27, 0x21, 0xe9, 0x1c, 0x9a, 0xae, 0x76, 0x30, 0x97, 0xa3, 0x1a, 0xc2,
-		0xbf, 0x88, 0xea, 0xe0, 0x96, 0x41, 0xaa, 0x9b, 0xcb, 0x33, 0xd5, 0xe1, 0x9f, 0xf2, 0xcf, 0x27,
-		0xa1, 0x38, 0x89, 0xf2, 0x5d, 0x85, 0xc4, 0x2e, 0xe9, 0xff, 0x5c, 0xf4, 0x38, 0xd2, 0x61, 0x34,
-		0x61, 0xf1, 0x26, 0xef, 0x53, 0xbc, 0x65, 0xc8, 0x9a, 0xd8, 0x71, 0x71, 0x8b, 0xe9, 0x4a, 0x6c,
-		0x42, 0x6d, 0x03, 0x46, 0x34, 0xa8, 0x6c, 0xf1, 0xfb, 0x52, 0xb6, 0x57, 0xa0, 0xe8, 0x35, 0x49,
-		0xb5, 0x35, 0x73, 0x4f, 0x68, 0xed, 0xc5, 0x71, 0x2d, 0x59, 0xaa, 0x09, 0x3a, 0x85, 0x90, 0x29,
-		0x05, 0x1c, 0xfa, 0x46, 0x2b, 0x00, 0x96, 0x89, 0xad, 0x5d, 0xb5, 0x85, 0xf5, 0xf6, 0x5c, 0x7a,
-		0x84, 0x94, 0x36, 0x09, 0xca, 0x80, 0x94, 0x2c, 0x06, 0xd5, 0xdb, 0xe8, 0x79, 0x5f, 0x09, 0x53,
-		0x23, 0x74, 0x68, 0x9d, 0x4d, 0xbf, 0x01, 0x3d, 0xdc, 0x86, 0x82, 0x8d, 0xc9, 0x8c, 0xc0, 0x2d,
-		0xde, 0xb3, 0x0c, 0x6d, 0xc4, 0xd2, 0xd8, 0x9e, 0x29, 0x9c, 0x8c, 0x75, 0x2c, 0x6f, 0x07, 0x3f,
-		0xd1, 0x43, 0xe0, 0x01, 0x54, 0xaa, 0x56, 0x40, 0xed, 0x53, 0x4e, 0x00, 0x37, 0xb4, 0x0e, 0x2e,
-		0xbd, 0x01, 0x85, 0xb0, 0x78, 0xd0, 0x2c, 0x24, 0x1c, 0x57, 0xb3, 0x5d, 0xaa, 0x85, 0x09, 0x85,
-		0x7d, 0x20, 0x09, 0x62, 0xd8, 0x6c, 0x51, 0xfb, 0x97, 0x50, 0xc8, 0x4f, 0xf4, 0x97, 0xfc, 0x0e,
-		0xc7, 0x68, 0x87, 0x1f, 0x19, 0x1c, 0xd1, 0x10, 0xe7, 0xfe, 0x7e, 0x97, 0x9e, 0x85, 0x7c, 0xa8,
-		0x03, 0x93, 0x56, 0x2d, 0xff, 0x65, 0x38, 0x31, 0x94, 0x35, 0x7a, 0x05, 0x66, 0x7b, 0xa6, 0x61,
-		0xba, 0xd8, 0xee, 0xda, 0x98, 0x68, 0x2c, 0xab, 0x6a, 0xee, 0x77, 0x53, 0x23, 0x74, 0x6e, 0x3b,
-		0x88, 0xcd, 0xb8, 0x28, 0x33, 0xbd, 0x41, 0xe0, 0x85, 0x4c, 0xfa, 0xf3, 0x29, 0xe9, 0x43, 0x1f,
-		0xfa, 0xd0, 0x87, 0xa2, 0xf2, 0x2f, 0x27, 0x61, 0x76, 0xd8, 0x9c, 0x19, 0x3a, 0x7d, 0x4f, 0x42,
-		0xd2, 0xec, 0x75, 0x76, 0xb0, 0x4d, 0x85, 0x94, 0x50, 0xf8, 0x17, 0x2a, 0x43, 0xa2, 0xad, 0xed,
-		0xe0, 0xf6, 0x5c, 0x7c, 0x31, 0x72, 0xbe, 0x70, 0xe9, 0xb1, 0x89, 0x66, 0xe5, 0xd2, 0x1a, 0x21,
-		0x51, 0x18, 0x25, 0x7a, 0x1f, 0xc4, 0xb9, 0xf1, 0x26, 0x1c, 0x2e, 0x4c, 0xc6, 0x81, 0xcc, 0x25,
-		0x85, 0xd2, 0xa1, 0x33, 0x90, 0x21, 0xff, 0x33, 0xdd, 0x48, 0xd2, 0x36, 0xa7, 0x09, 0x80, 0xe8,
-		0x05, 0x2a, 0x41, 0x9a, 0x4e, 0x93, 0x16, 0x16, 0x8b, 0x9e, 0xf7, 0x4d, 0x14, 0xab, 0x85, 0x77,
-		0xb5, 0x5e, 0xdb, 0x55, 0x6f, 0x6b, 0xed, 0x1e, 0xa6, 0x0a, 0x9f, 0x51, 0x72, 0x1c, 0x78, 0x93,
-		0xc0, 0xd0, 0x02, 0x64, 0xd9, 0xac, 0x32, 0xcc, 0x16, 0x3e, 0xa0, 0x76, 0x35, 0xa1, 0xb0, 0x89,
-		0x56, 0x27, 0x10, 0x52, 0xfd, 0xeb, 0x8e, 0x65, 0x0a, 0xd5, 0xa4, 0x55, 0x10, 0x00, 0xad, 0xfe,
-		0xd9, 0x7e, 0x93, 0xfe, 0xe0, 0xf0, 0xee, 0x0d, 0xcc, 0xa5, 0x73, 0x50, 0xa4, 0x18, 0x4f, 0xf3,
-		0xa1, 0xd7, 0xda, 0x73, 0xd3, 0x8b, 0x91, 0xf3, 0x69, 0xa5, 0xc0, 0xc0, 0x9b, 0x1c, 0x2a, 0xff,
-		0x4c, 0x14, 0xe2, 0xd4, 0xb0, 0x14, 0x21, 0xdb, 0x7c, 0x75, 0xab, 0xa6, 0xae, 0x6c, 0x6e, 0x57,
-		0xd6, 0x6a, 0x52, 0x04, 0x15, 0x00, 0x28, 0xe0, 0xda, 0xda, 0x66, 0xb9, 0x29, 0x45, 0xbd, 0xef,
-		0xfa, 0x46, 0xf3, 0xca, 0x33, 0x52, 0xcc, 0x23, 0xd8, 0x66, 0x80, 0x78, 0x10, 0xe1, 0xe9, 0x4b,
-		0x52, 0x02, 0x49, 0x90, 0x63, 0x0c, 0xea, 0xaf, 0xd4, 0x56, 0xae, 0x3c, 0x23, 0x25, 0xc3, 0x90,
-		0xa7, 0x2f, 0x49, 0x29, 0x94, 0x87, 0x0c, 0x85, 0x54, 0x36, 0x37, 0xd7, 0xa4, 0xb4, 0xc7, 0xb3,
-		0xd1, 0x54, 0xea, 0x1b, 0xab, 0x52, 0xc6, 0xe3, 0xb9, 0xaa, 0x6c, 0x6e, 0x6f, 0x49, 0xe0, 0x71,
-		0x58, 0xaf, 0x35, 0x1a, 0xe5, 0xd5, 0x9a, 0x94, 0xf5, 0x30, 0x2a, 0xaf, 0x36, 0x6b, 0x0d, 0x29,
-		0x17, 0x6a, 0xd6, 0xd3, 0x97, 0xa4, 0xbc, 0x57, 0x45, 0x6d, 0x63, 0x7b, 0x5d, 0x2a, 0xa0, 0x69,
-		0xc8, 0xb3, 0x2a, 0x44, 0x23, 0x8a, 0x7d, 0xa0, 0x2b, 0xcf, 0x48, 0x92, 0xdf, 0x10, 0xc6, 0x65,
-		0x3a, 0x04, 0xb8, 0xf2, 0x8c, 0x84, 0xe4, 0x2a, 0x24, 0xa8, 0x1a, 0x22, 0x04, 0x85, 0xb5, 0x72,
-		0xa5, 0xb6, 0xa6, 0x6e, 0x6e, 0x35, 0xeb, 0x9b, 0x1b, 0xe5, 0x35, 0x29, 0xe2, 0xc3, 0x94, 0xda,
-		0xcb, 0xdb, 0x75, 0xa5, 0xb6, 0x22, 0x45, 0x83, 0xb0, 0xad, 0x5a, 0xb9, 0x59, 0x5b, 0x91, 0x62,
-		0xb2, 0x0e, 0xb3, 0xc3, 0x0c, 0xea, 0xd0, 0x29, 0x14, 0xd0, 0x85, 0xe8, 0x08, 0x5d, 0xa0, 0xbc,
-		0xfa, 0x75, 0x41, 0xfe, 0x5c, 0x14, 0x66, 0x86, 0x2c, 0x2a, 0x43, 0x2b, 0x79, 0x11, 0x12, 0x4c,
-		0x97, 0xd9, 0x32, 0xfb, 0xe8, 0xd0, 0xd5, 0x89, 0x6a, 0xf6, 0xc0, 0x52, 0x4b, 0xe9, 0x82, 0x4e,
-		0x48, 0x6c, 0x84, 0x13, 0x42, 0x58, 0x0c, 0x28, 0xec, 0xd7, 0x0d, 0x18, 0x7f, 0xb6, 0x3e, 0x5e,
-		0x99, 0x64, 0x7d, 0xa4, 0xb0, 0xe3, 0x2d, 0x02, 0x89, 0x21, 0x8b, 0xc0, 0x55, 0x98, 0x1e, 0x60,
-		0x34, 0xb1, 0x31, 0xfe, 0x70, 0x04, 0xe6, 0x46, 0x09, 0x67, 0x8c, 0x49, 0x8c, 0x86, 0x4c, 0xe2,
-		0xd5, 0x7e, 0x09, 0x9e, 0x1d, 0x3d, 0x08, 0x03, 0x63, 0xfd, 0xa3, 0x11, 0x38, 0x39, 0xdc, 0xd9,
-		0x1c, 0xda, 0x86, 0xf7, 0x41, 0xb2, 0x83, 0xdd, 0x7d, 0x4b, 0xb8, 0x55, 0x8f, 0x0c, 0x59, 0xac,
-		0x49, 0x71, 0xff, 0x60, 0x73, 0xaa, 0xe0, 0x6a, 0x1f, 0x1b, 0xe5, 0x31, 0xb2, 0xd6, 0x0c, 0xb4,
-		0xf4, 0x9b, 0xa3, 0x70, 0x62, 0x28, 0xf3, 0xa1, 0x0d, 0x7d, 0x10, 0xc0, 0x30, 0xbb, 0x3d, 0x97,
-		0xb9, 0x4e, 0xcc, 0x12, 0x67, 0x28, 0x84, 0x1a, 0x2f, 0x62, 0x65, 0x7b, 0xae, 0x57, 0x1e, 0xa3,
-		0xe5, 0xc0, 0x40, 0x14, 0xe1, 0x39, 0xbf, 0xa1, 0x71, 0xda, 0xd0, 0xf9, 0x11, 0x3d, 0x1d, 0x50,
-		0xcc, 0x27, 0x41, 0xd2, 0xdb, 0x06, 0x36, 0x5d, 0xd5, 0x71, 0x6d, 0xac, 0x75, 0x0c, 0x73, 0x8f,
-		0x2e, 0x35, 0xe9, 0xe5, 0xc4, 0xae, 0xd6, 0x76, 0xb0, 0x52, 0x64, 0xc5, 0x0d, 0x51, 0x4a, 0x28,
-		0xa8, 0x02, 0xd9, 0x01, 0x8a, 0x64, 0x88, 0x82, 0x15, 0x7b, 0x14, 0xf2, 0x77, 0x64, 0x20, 0x1b,
-		0x70, 0xcd, 0xd1, 0x59, 0xc8, 0xbd, 0xae, 0xdd, 0xd6, 0x54, 0x11, 0x6e, 0x31, 0x49, 0x64, 0x09,
-		0x6c, 0x8b, 0x87, 0x5c, 0x4f, 0xc2, 0x2c, 0x45, 0xb1, 0x7a, 0x2e, 0xb6, 0x55, 0xbd, 0xad, 0x39,
-		0x0e, 0x15, 0x5a, 0x9a, 0xa2, 0x22, 0x52, 0xb6, 0x49, 0x8a, 0xaa, 0xa2, 0x04, 0x5d, 0x86, 0x19,
-		0x4a, 0xd1, 0xe9, 0xb5, 0x5d, 0xa3, 0xdb, 0xc6, 0x2a, 0x09, 0x00, 0x1d, 0xba, 0xe4, 0x78, 0x2d,
-		0x9b, 0x26, 0x18, 0xeb, 0x1c, 0x81, 0xb4, 0xc8, 0x41, 0x2b, 0xf0, 0x20, 0x25, 0xdb, 0xc3, 0x26,
-		0xb6, 0x35, 0x17, 0xab, 0xf8, 0x83, 0x3d, 0xad, 0xed, 0xa8, 0x9a, 0xd9, 0x52, 0xf7, 0x35, 0x67,
-		0x7f, 0x6e, 0x96, 0x30, 0xa8, 0x44, 0xe7, 0x22, 0xca, 0x69, 0x82, 0xb8, 0xca, 0xf1, 0x6a, 0x14,
-		0xad, 0x6c, 0xb6, 0xae, 0x6b, 0xce, 0x3e, 0x5a, 0x86, 0x93, 0x94, 0x8b, 0xe3, 0xda, 0x86, 0xb9,
-		0xa7, 0xea, 0xfb, 0x58, 0xbf, 0xa5, 0xf6, 0xdc, 0xdd, 0xe7, 0xe6, 0xce, 0x04, 0xeb, 0xa7, 0x2d,
-		0x6c, 0x50, 0x9c, 0x2a, 0x41, 0xd9, 0x76, 0x77, 0x9f, 0x43, 0x0d, 0xc8, 0x91, 0xc1, 0xe8, 0x18,
-		0x6f, 0x60, 0x75, 0xd7, 0xb2, 0xe9, 0x1a, 0x5a, 0x18, 0x62, 0x9a, 0x02, 0x12, 0x5c, 0xda, 0xe4,
-		0x04, 0xeb, 0x56, 0x0b, 0x2f, 0x27, 0x1a, 0x5b, 0xb5, 0xda, 0x8a, 0x92, 0x15, 0x5c, 0xae, 0x59,
-		0x36, 0x51, 0xa8, 0x3d, 0xcb, 0x13, 0x70, 0x96, 0x29, 0xd4, 0x9e, 0x25, 0xc4, 0x7b, 0x19, 0x66,
-		0x74, 0x9d, 0xf5, 0xd9, 0xd0, 0x55, 0x1e, 0xa6, 0x39, 0x73, 0x52, 0x48, 0x58, 0xba, 0xbe, 0xca,
-		0x10, 0xb8, 0x8e, 0x3b, 0xe8, 0x79, 0x38, 0xe1, 0x0b, 0x2b, 0x48, 0x38, 0x3d, 0xd0, 0xcb, 0x7e,
-		0xd2, 0xcb, 0x30, 0xd3, 0x3d, 0x1c, 0x24, 0x44, 0xa1, 0x1a, 0xbb, 0x87, 0xfd, 0x64, 0xcf, 0xc2,
-		0x6c, 0x77, 0xbf, 0x3b, 0x48, 0x77, 0x21, 0x48, 0x87, 0xba, 0xfb, 0xdd, 0x7e, 0xc2, 0x87, 0x69,
-		0xcc, 0x6e, 0x63, 0x5d, 0x73, 0x71, 0x6b, 0xee, 0x54, 0x10, 0x3d, 0x50, 0x80, 0x96, 0x40, 0xd2,
-		0x75, 0x15, 0x9b, 0xda, 0x4e, 0x1b, 0xab, 0x9a, 0x8d, 0x4d, 0xcd, 0x99, 0x5b, 0xa0, 0xc8, 0x71,
-		0xd7, 0xee, 0x61, 0xa5, 0xa0, 0xeb, 0x35, 0x5a, 0x58, 0xa6, 0x65, 0xe8, 0x02, 0x4c, 0x5b, 0x3b,
-		0xaf, 0xeb, 0x4c, 0x23, 0xd5, 0xae, 0x8d, 0x77, 0x8d, 0x83, 0xb9, 0xf7, 0x50, 0xf1, 0x16, 0x49,
-		0x01, 0xd5, 0xc7, 0x2d, 0x0a, 0x46, 0x8f, 0x82, 0xa4, 0x3b, 0xfb, 0x9a, 0xdd, 0xa5, 0x26, 0xd9,
-		0xe9, 0x6a, 0x3a, 0x9e, 0x7b, 0x98, 0xa1, 0x32, 0xf8, 0x86, 0x00, 0x93, 0x19, 0xe1, 0xdc, 0x31,
-		0x76, 0x5d, 0xc1, 0xf1, 0x1c, 0x9b, 0x11, 0x14, 0xc6, 0xb9, 0x9d, 0x07, 0x89, 0x48, 0x22, 0x54,
-		0xf1, 0x79, 0x8a, 0x56, 0xe8, 0xee, 0x77, 0x83, 0xf5, 0x3e, 0x04, 0x79, 0x82, 0xe9, 0x57, 0xfa,
-		0x28, 0x73, 0xdc, 0xba, 0xfb, 0x81, 0x1a, 0x9f, 0x81, 0x93, 0x04, 0xa9, 0x83, 0x5d, 0xad, 0xa5,
-		0xb9, 0x5a, 0x00, 0xfb, 0x71, 0x8a, 0x4d, 0xc4, 0xbe, 0xce, 0x0b, 0x43, 0xed, 0xb4, 0x7b, 0x3b,
-		0x87, 0x9e, 0x62, 0x3d, 0xc1, 0xda, 0x49, 0x60, 0x42, 0xb5, 0xde, 0x35, 0xe7, 0x5c, 0x5e, 0x86,
-		0x5c, 0x50, 0xef, 0x51, 0x06, 0x98, 0xe6, 0x4b, 0x11, 0xe2, 0x04, 0x55, 0x37, 0x57, 0x88, 0xfb,
-		0xf2, 0x5a, 0x4d, 0x8a, 0x12, 0x37, 0x6a, 0xad, 0xde, 0xac, 0xa9, 0xca, 0xf6, 0x46, 0xb3, 0xbe,
-		0x5e, 0x93, 0x62, 0x01, 0xc7, 0xfe, 0x46, 0x3c, 0xfd, 0x88, 0x74, 0x4e, 0xfe, 0x85, 0x18, 0x14,
-		0xc2, 0x91, 0x1a, 0x7a, 0x2f, 0x9c, 0x12, 0x09, 0x17, 0x07, 0xbb, 0xea, 0x1d, 0xc3, 0xa6, 0x13,
-		0xb2, 0xa3, 0xb1, 0xc5, 0xd1, 0xd3, 0x9f, 0x59, 0x8e, 0xd5, 0xc0, 0xee, 0xfb, 0x0d, 0x9b, 0x4c,
-		0xb7, 0x8e, 0xe6, 0xa2, 0x35, 0x58, 0x30, 0x2d, 0xd5, 0x71, 0x35, 0xb3, 0xa5, 0xd9, 0x2d, 0xd5,
-		0x4f, 0x75, 0xa9, 0x9a, 0xae, 0x63, 0xc7, 0xb1, 0xd8, 0x42, 0xe8, 0x71, 0x79, 0xc0, 0xb4, 0x1a,
-		0x1c, 0xd9, 0x5f, 0x21, 0xca, 0x1c, 0xb5, 0x4f, 0x7d, 0x63, 0xa3, 0xd4, 0xf7, 0x0c, 0x64, 0x3a,
-		0x5a, 0x57, 0xc5, 0xa6, 0x6b, 0x1f, 0x52, 0xff, 0x3c, 0xad, 0xa4, 0x3b, 0x5a, 0xb7, 0x46, 0xbe,
-		0xd1, 0x4d, 0x78, 0xc4, 0x47, 0x55, 0xdb, 0x78, 0x4f, 0xd3, 0x0f, 0x55, 0xea, 0x8c, 0xd3, 0xb4,
-		0x81, 0xaa, 0x5b, 0xe6, 0x6e, 0xdb, 0xd0, 0x5d, 0x87, 0xda, 0x07, 0x66, 0xe3, 0x64, 0x9f, 0x62,
-		0x8d, 0x12, 0xdc, 0x70, 0x2c, 0x93, 0xfa, 0xe0, 0x55, 0x81, 0xfd, 0x15, 0x09, 0xbf, 0x6e, 0xc4,
-		0xd3, 0x71, 0x29, 0x71, 0x23, 0x9e, 0x4e, 0x48, 0xc9, 0x1b, 0xf1, 0x74, 0x52, 0x4a, 0xdd, 0x88,
-		0xa7, 0xd3, 0x52, 0xe6, 0x46, 0x3c, 0x9d, 0x91, 0x40, 0xfe, 0xd9, 0x34, 0xe4, 0x82, 0x91, 0x01,
-		0x09, 0xb4, 0x74, 0xba, 0x36, 0x46, 0xa8, 0xf5, 0x7c, 0xe8, 0xc8, 0x38, 0x62, 0xa9, 0x4a, 0x16,
-		0xcd, 0xe5, 0x24, 0x73, 0xc3, 0x15, 0x46, 0x49, 0x1c, 0x16, 0xa2, 0xd6, 0x98, 0xb9, 0x3d, 0x69,
-		0x85, 0x7f, 0xa1, 0x55, 0x48, 0xbe, 0xee, 0x50, 0xde, 0x49, 0xca, 0xfb, 0x3d, 0x47, 0xf3, 0xbe,
-		0xd1, 0xa0, 0xcc, 0x33, 0x37, 0x1a, 0xea, 0xc6, 0xa6, 0xb2, 0x5e, 0x5e, 0x53, 0x38, 0x39, 0x3a,
-		0x0d, 0xf1, 0xb6, 0xf6, 0xc6, 0x61, 0x78, 0x79, 0xa5, 0x20, 0xb4, 0x04, 0xc5, 0x9e, 0x79, 0x1b,
-		0xdb, 0xc6, 0xae, 0x41, 0x86, 0x8a, 0x60, 0x15, 0x83, 0x58, 0x05, 0xbf, 0x74, 0x8d, 0xe0, 0x4f,
-		0xa8, 0x1e, 0xa7, 0x21, 0x7e, 0x07, 0x6b, 0xb7, 0xc2, 0x8b, 0x20, 0x05, 0xa1, 0xf3, 0x90, 0x6b,
-		0xe1, 0x9d, 0xde, 0x9e, 0x6a, 0xe3, 0x96, 0xa6, 0xbb, 0x61, 0xd3, 0x9f, 0xa5, 0x45, 0x0a, 0x2d,
-		0x41, 0x2f, 0x41, 0x86, 0x8c, 0x91, 0x49, 0xc7, 0x78, 0x9a, 0x8a, 0xe0, 0x89, 0xa3, 0x45, 0xc0,
-		0x87, 0x58, 0x10, 0x29, 0x3e, 0x3d, 0xba, 0x06, 0x49, 0x57, 0xb3, 0xf7, 0xb0, 0x4b, 0x2d, 0x7f,
-		0x61, 0x48, 0xf2, 0x63, 0x08, 0xa7, 0x26, 0xa5, 0xa0, 0x31, 0x2d, 0xa7, 0x7e, 0x17, 0xad, 0xcc,
-		0x45, 0x48, 0x50, 0xf5, 0x40, 0x00, 0x5c, 0x41, 0xa4, 0x29, 0x94, 0x86, 0x78, 0x75, 0x53, 0x21,
-		0x96, 0x46, 0x82, 0x1c, 0x83, 0xaa, 0x5b, 0xf5, 0x5a, 0xb5, 0x26, 0x45, 0xe5, 0xcb, 0x90, 0x64,
-		0x63, 0x4e, 0xac, 0x90, 0x37, 0xea, 0xd2, 0x14, 0xff, 0xe4, 0x3c, 0x22, 0xa2, 0x74, 0x7b, 0xbd,
-		0x52, 0x53, 0xa4, 0xa8, 0xbc, 0x0d, 0xc5, 0x3e, 0x39, 0xa1, 0x13, 0x30, 0xad, 0xd4, 0x9a, 0xb5,
-		0x0d, 0x12, 0x67, 0xa9, 0xdb, 0x1b, 0x2f, 0x6d, 0x6c, 0xbe, 0x7f, 0x43, 0x9a, 0x0a, 0x83, 0x85,
-		0x49, 0x8b, 0xa0, 0x59, 0x90, 0x7c, 0x70, 0x63, 0x73, 0x5b, 0xa1, 0xad, 0xf9, 0xd6, 0x28, 0x48,
-		0xfd, 0x52, 0x43, 0xa7, 0x60, 0xa6, 0x59, 0x56, 0x56, 0x6b, 0x4d, 0x95, 0xc5, 0x8e, 0x1e, 0xeb,
-		0x59, 0x90, 0x82, 0x05, 0xd7, 0xea, 0x34, 0x34, 0x5e, 0x80, 0x33, 0x41, 0x68, 0xed, 0x95, 0x66,
-		0x6d, 0xa3, 0x41, 0x2b, 0x2f, 0x6f, 0xac, 0x12, 0xfb, 0xda, 0xc7, 0x4f, 0x44, 0xab, 0x31, 0xd2,
-		0xd4, 0x30, 0xbf, 0xda, 0xda, 0x8a, 0x14, 0xef, 0x07, 0x6f, 0x6e, 0xd4, 0x36, 0xaf, 0x49, 0x89,
-		0xfe, 0xda, 0x69, 0x04, 0x9b, 0x44, 0x25, 0x38, 0xd9, 0x0f, 0x55, 0x6b, 0x1b, 0x4d, 0xe5, 0x55,
-		0x29, 0xd5, 0x5f, 0x71, 0xa3, 0xa6, 0xdc, 0xac, 0x57, 0x6b, 0x52, 0x1a, 0x9d, 0x04, 0x14, 0x6e,
-		0x51, 0xf3, 0xfa, 0xe6, 0x8a, 0x94, 0x19, 0xb0, 0x28, 0xb2, 0x03, 0xb9, 0x60, 0x18, 0xf9, 0x95,
-		0xc9, 0x25, 0x7d, 0x2c, 0x0a, 0xd9, 0x40, 0x58, 0x48, 0xfc, 0x79, 0xad, 0xdd, 0xb6, 0xee, 0xa8,
-		0x5a, 0xdb, 0xd0, 0x1c, 0x6e, 0x6f, 0x80, 0x82, 0xca, 0x04, 0x32, 0xe9, 0xfc, 0x9e, 0xdc, 0xc2,
-		0x27, 0xbf, 0x1a, 0x2d, 0x7c, 0x42, 0x4a, 0xca, 0xdf, 0x1f, 0x01, 0xa9, 0x3f, 0xde, 0xeb, 0xeb,
-		0x7e, 0x64, 0x54, 0xf7, 0xbf, 0x22, 0x63, 0xf7, 0x89, 0x08, 0x14, 0xc2, 0x41, 0x5e, 0x5f, 0xf3,
-		0xce, 0xfe, 0x99, 0x36, 0xef, 0xb7, 0xa2, 0x90, 0x0f, 0x85, 0x76, 0x93, 0xb6, 0xee, 0x83, 0x30,
-		0x6d, 0xb4, 0x70, 0xa7, 0x6b, 0xb9, 0xd8, 0xd4, 0x0f, 0xd5, 0x36, 0xbe, 0x8d, 0xdb, 0x73, 0x32,
-		0x35, 0xca, 0x17, 0x8f, 0x0e, 0x1e, 0x97, 0xea, 0x3e, 0xdd, 0x1a, 0x21, 0x5b, 0x9e, 0xa9, 0xaf,
-		0xd4, 0xd6, 0xb7, 0x36, 0x9b, 0xb5, 0x8d, 0xea, 0xab, 0xc2, 0xba, 0x28, 0x92, 0xd1, 0x87, 0xf6,
-		0x2e, 0x1a, 0xed, 0x2d, 0x90, 0xfa, 0x1b, 0x45, 0x6c, 0xc5, 0x90, 0x66, 0x49, 0x53, 0x68, 0x06,
-		0x8a, 0x1b, 0x9b, 0x6a, 0xa3, 0xbe, 0x52, 0x53, 0x6b, 0xd7, 0xae, 0xd5, 0xaa, 0xcd, 0x06, 0x4b,
-		0x07, 0x7a, 0xd8, 0x4d, 0x29, 0x1a, 0x14, 0xf1, 0xf7, 0xc5, 0x60, 0x66, 0x48, 0x4b, 0x50, 0x99,
-		0x07, 0xf2, 0x2c, 0xb7, 0xf0, 0xc4, 0x24, 0xad, 0x5f, 0x22, 0xae, 0xf4, 0x96, 0x66, 0xbb, 0x3c,
-		0xee, 0x7f, 0x14, 0x88, 0x94, 0x4c, 0x97, 0xac, 0xec, 0x36, 0x4f, 0xb3, 0xb2, 0xe8, 0xbe, 0xe8,
-		0xc3, 0x59, 0xa6, 0xf5, 0x71, 0x40, 0x5d, 0xcb, 0x31, 0x5c, 0xe3, 0x36, 0x56, 0x0d, 0x53, 0xe4,
-		0x64, 0x49, 0xb4, 0x1f, 0x57, 0x24, 0x51, 0x52, 0x37, 0x5d, 0x0f, 0xdb, 0xc4, 0x7b, 0x5a, 0x1f,
-		0x36, 0xf1, 0x3c, 0x62, 0x8a, 0x24, 0x4a, 0x3c, 0xec, 0xb3, 0x90, 0x6b, 0x59, 0x3d, 0x12, 0x02,
-		0x31, 0x3c, 0x62, 0x2d, 0x22, 0x4a, 0x96, 0xc1, 0x3c, 0x14, 0x1e, 0xdc, 0xfa, 0xc9, 0xe0, 0x9c,
-		0x92, 0x65, 0x30, 0x86, 0x72, 0x0e, 0x8a, 0xda, 0xde, 0x9e, 0x4d, 0x98, 0x0b, 0x46, 0x2c, 0x5c,
-		0x2f, 0x78, 0x60, 0x8a, 0x58, 0xba, 0x01, 0x69, 0x21, 0x07, 0xe2, 0xc1, 0x12, 0x49, 0xa8, 0x5d,
-		0x96, 0x83, 0x8a, 0x9e, 0xcf, 0x28, 0x69, 0x53, 0x14, 0x9e, 0x85, 0x9c, 0xe1, 0xa8, 0xfe, 0xde,
-		0x56, 0x74, 0x31, 0x7a, 0x3e, 0xad, 0x64, 0x0d, 0xc7, 0xdb, 0x17, 0x90, 0x7f, 0x34, 0x0a, 0x85,
-		0xf0, 0xae, 0x1d, 0x5a, 0x81, 0x74, 0xdb, 0xd2, 0x35, 0xaa, 0x5a, 0x6c, 0xcb, 0xf8, 0xfc, 0x98,
-		0x8d, 0xbe, 0xa5, 0x35, 0x8e, 0xaf, 0x78, 0x94, 0xa5, 0x7f, 0x13, 0x81, 0xb4, 0x00, 0xa3, 0x93,
-		0x10, 0xef, 0x6a, 0xee, 0x3e, 0x65, 0x97, 0xa8, 0x44, 0xa5, 0x88, 0x42, 0xbf, 0x09, 0xdc, 0xe9,
-		0x6a, 0x26, 0x55, 0x01, 0x0e, 0x27, 0xdf, 0x64, 0x5c, 0xdb, 0x58, 0x6b, 0xd1, 0x5c, 0x80, 0xd5,
-		0xe9, 0x60, 0xd3, 0x75, 0xc4, 0xb8, 0x72, 0x78, 0x95, 0x83, 0xd1, 0x63, 0x30, 0xed, 0xda, 0x9a,
-		0xd1, 0x0e, 0xe1, 0xc6, 0x29, 0xae, 0x24, 0x0a, 0x3c, 0xe4, 0x65, 0x38, 0x2d, 0xf8, 0xb6, 0xb0,
-		0xab, 0xe9, 0xfb, 0xb8, 0xe5, 0x13, 0x25, 0x69, 0xce, 0xef, 0x14, 0x47, 0x58, 0xe1, 0xe5, 0x82,
-		0x56, 0xfe, 0x6c, 0x14, 0xa6, 0x45, 0xf6, 0xa2, 0xe5, 0x09, 0x6b, 0x1d, 0x40, 0x33, 0x4d, 0xcb,
-		0x0d, 0x8a, 0x6b, 0x50, 0x95, 0x07, 0xe8, 0x96, 0xca, 0x1e, 0x91, 0x12, 0x60, 0x50, 0xfa, 0xbd,
-		0x08, 0x80, 0x5f, 0x34, 0x52, 0x6e, 0x0b, 0x90, 0xe5, 0x7b, 0xb2, 0x74, 0x63, 0x9f, 0x25, 0xbc,
-		0x80, 0x81, 0xae, 0x19, 0x6d, 0x9a, 0x96, 0xdc, 0xc1, 0x7b, 0x86, 0xc9, 0xf7, 0x53, 0xd8, 0x87,
-		0x48, 0x4b, 0xc6, 0xfd, 0xed, 0x29, 0x05, 0xd2, 0x0e, 0xee, 0x68, 0xa6, 0x6b, 0xe8, 0x7c, 0x87,
-		0xe4, 0xca, 0xb1, 0x1a, 0xbf, 0xd4, 0xe0, 0xd4, 0x8a, 0xc7, 0x47, 0x3e, 0x0f, 0x69, 0x01, 0x25,
-		0x8e, 0xdf, 0xc6, 0xe6, 0x46, 0x4d, 0x9a, 0x42, 0x29, 0x88, 0x35, 0x6a, 0x4d, 0x29, 0x42, 0xc2,
-		0xce, 0xf2, 0x5a, 0xbd, 0xdc, 0x90, 0xa2, 0x95, 0xbf, 0x02, 0x33, 0xba, 0xd5, 0xe9, 0xaf, 0xb0,
-		0x22, 0xf5, 0xa5, 0xfc, 0x9c, 0xeb, 0x91, 0xd7, 0x9e, 0xe0, 0x48, 0x7b, 0x56, 0x5b, 0x33, 0xf7,
-		0x96, 0x2c, 0x7b, 0xcf, 0x3f, 0x16, 0x41, 0xa2, 0x03, 0x27, 0x70, 0x38, 0xa2, 0xbb, 0xf3, 0xa7,
-		0x91, 0xc8, 0x0f, 0x46, 0x63, 0xab, 0x5b, 0x95, 0x1f, 0x8f, 0x96, 0x56, 0x19, 0xe1, 0x96, 0xe8,
-		0x8e, 0x82, 0x77, 0xdb, 0x58, 0x27, 0x8d, 0x87, 0xdf, 0x7f, 0x0c, 0x66, 0xf7, 0xac, 0x3d, 0x8b,
-		0x72, 0xba, 0x48, 0x7e, 0xf1, 0x73, 0x15, 0x19, 0x0f, 0x5a, 0x1a, 0x7b, 0x08, 0x63, 0x79, 0x03,
-		0x66, 0x38, 0xb2, 0x4a, 0xb7, 0x6f, 0x59, 0x72, 0x01, 0x1d, 0x99, 0xd9, 0x9e, 0xfb, 0xc9, 0xdf,
-		0xa1, 0x5e, 0x89, 0x32, 0xcd, 0x49, 0x49, 0x19, 0xcb, 0x3f, 0x2c, 0x2b, 0x70, 0x22, 0xc4, 0x8f,
-		0xd9, 0x08, 0x6c, 0x8f, 0xe1, 0xf8, 0xcf, 0x39, 0xc7, 0x99, 0x00, 0xc7, 0x06, 0x27, 0x5d, 0xae,
-		0x42, 0xfe, 0x38, 0xbc, 0xfe, 0x05, 0xe7, 0x95, 0xc3, 0x41, 0x26, 0xab, 0x50, 0xa4, 0x4c, 0xf4,
-		0x9e, 0xe3, 0x5a, 0x1d, 0x6a, 0x80, 0x8f, 0x66, 0xf3, 0x2f, 0x7f, 0x87, 0x4d, 0xda, 0x02, 0x21,
-		0xab, 0x7a, 0x54, 0xcb, 0xcb, 0x40, 0x77, 0xac, 0x5b, 0x58, 0x6f, 0x8f, 0xe1, 0xf0, 0x2b, 0xbc,
-		0x21, 0x1e, 0xfe, 0xf2, 0x4d, 0x98, 0x25, 0xbf, 0xa9, 0x7d, 0x0c, 0xb6, 0x64, 0x7c, 0x1a, 0x7c,
-		0xee, 0xdf, 0x7d, 0x98, 0xd9, 0x85, 0x19, 0x8f, 0x41, 0xa0, 0x4d, 0x81, 0x51, 0xdc, 0xc3, 0xae,
-		0x8b, 0x6d, 0x47, 0xd5, 0xda, 0xc3, 0x9a, 0x17, 0xc8, 0x23, 0xce, 0x7d, 0xef, 0x17, 0xc3, 0xa3,
-		0xb8, 0xca, 0x28, 0xcb, 0xed, 0xf6, 0xf2, 0x36, 0x9c, 0x1a, 0xa2, 0x15, 0x13, 0xf0, 0xfc, 0x3e,
-		0xce, 0x73, 0x76, 0x40, 0x33, 0x08, 0xdb, 0x2d, 0x10, 0x70, 0x6f, 0x2c, 0x27, 0xe0, 0xf9, 0x71,
-		0xce, 0x13, 0x71, 0x5a, 0x31, 0xa4, 0x84, 0xe3, 0x0d, 0x98, 0xbe, 0x8d, 0xed, 0x1d, 0xcb, 0xe1,
-		0xb9, 0xdb, 0x09, 0xd8, 0x7d, 0x82, 0xb3, 0x2b, 0x72, 0x42, 0x9a, 0xcc, 0x25, 0xbc, 0x9e, 0x87,
-		0xf4, 0xae, 0xa6, 0xe3, 0x09, 0x58, 0xdc, 0xe3, 0x2c, 0x52, 0x04, 0x9f, 0x90, 0x96, 0x21, 0xb7,
-		0x67, 0xf1, 0x25, 0x72, 0x3c, 0xf9, 0xf7, 0x73, 0xf2, 0xac, 0xa0, 0xe1, 0x2c, 0xba, 0x56, 0xb7,
-		0xd7, 0x26, 0xeb, 0xe7, 0x78, 0x16, 0x3f, 0x20, 0x58, 0x08, 0x1a, 0xce, 0xe2, 0x18, 0x62, 0xfd,
-		0xa4, 0x60, 0xe1, 0x04, 0xe4, 0xf9, 0x22, 0x64, 0x2d, 0xb3, 0x7d, 0x68, 0x99, 0x93, 0x34, 0xe2,
-		0x53, 0x9c, 0x03, 0x70, 0x12, 0xc2, 0xe0, 0x2a, 0x64, 0x26, 0x1d, 0x88, 0x1f, 0xfe, 0xa2, 0x98,
-		0x1e, 0x62, 0x04, 0x56, 0xa1, 0x28, 0x0c, 0x94, 0x61, 0x99, 0x13, 0xb0, 0xf8, 0x11, 0xce, 0xa2,
-		0x10, 0x20, 0xe3, 0xdd, 0x70, 0xb1, 0xe3, 0xee, 0xe1, 0x49, 0x98, 0xfc, 0xa8, 0xe8, 0x06, 0x27,
-		0xe1, 0xa2, 0xdc, 0xc1, 0xa6, 0xbe, 0x3f, 0x19, 0x87, 0x4f, 0x0b, 0x51, 0x0a, 0x1a, 0xc2, 0xa2,
-		0x0a, 0xf9, 0x8e, 0x66, 0x3b, 0xfb, 0x5a, 0x7b, 0xa2, 0xe1, 0xf8, 0x31, 0xce, 0x23, 0xe7, 0x11,
-		0x71, 0x89, 0xf4, 0xcc, 0xe3, 0xb0, 0xf9, 0x71, 0x21, 0x91, 0x00, 0x19, 0x9f, 0x7a, 0x8e, 0x4b,
-		0x13, 0xdd, 0xc7, 0xe1, 0xf6, 0xb7, 0xc5, 0xd4, 0x63, 0xb4, 0xeb, 0x41, 0x8e, 0x57, 0x21, 0xe3,
-		0x18, 0x6f, 0x4c, 0xc4, 0xe6, 0xef, 0x88, 0x91, 0xa6, 0x04, 0x84, 0xf8, 0x55, 0x38, 0x3d, 0x74,
-		0x99, 0x98, 0x80, 0xd9, 0xdf, 0xe5, 0xcc, 0x4e, 0x0e, 0x59, 0x2a, 0xb8, 0x49, 0x38, 0x2e, 0xcb,
-		0xbf, 0x27, 0x4c, 0x02, 0xee, 0xe3, 0xb5, 0x45, 0x82, 0x16, 0x47, 0xdb, 0x3d, 0x9e, 0xd4, 0xfe,
-		0xbe, 0x90, 0x1a, 0xa3, 0x0d, 0x49, 0xad, 0x09, 0x27, 0x39, 0xc7, 0xe3, 0x8d, 0xeb, 0x4f, 0x08,
-		0xc3, 0xca, 0xa8, 0xb7, 0xc3, 0xa3, 0xfb, 0x35, 0x50, 0xf2, 0xc4, 0x29, 0xbc, 0x63, 0x47, 0xed,
-		0x68, 0xdd, 0x09, 0x38, 0xff, 0x24, 0xe7, 0x2c, 0x2c, 0xbe, 0xe7, 0x5e, 0x3b, 0xeb, 0x5a, 0x97,
-		0x30, 0x7f, 0x05, 0xe6, 0x04, 0xf3, 0x9e, 0x69, 0x63, 0xdd, 0xda, 0x33, 0x8d, 0x37, 0x70, 0x6b,
-		0x02, 0xd6, 0x3f, 0xd5, 0x37, 0x54, 0xdb, 0x01, 0x72, 0xc2, 0xb9, 0x0e, 0x92, 0xe7, 0xab, 0xa8,
-		0x46, 0xa7, 0x6b, 0xd9, 0xee, 0x18, 0x8e, 0x3f, 0x2d, 0x46, 0xca, 0xa3, 0xab, 0x53, 0xb2, 0xe5,
-		0x1a, 0xb0, 0xd3, 0x1f, 0x93, 0xaa, 0xe4, 0x67, 0x38, 0xa3, 0xbc, 0x4f, 0xc5, 0x0d, 0x87, 0x6e,
-		0x75, 0xba, 0x9a, 0x3d, 0x89, 0xfd, 0xfb, 0x07, 0xc2, 0x70, 0x70, 0x12, 0x6e, 0x38, 0x88, 0x47,
-		0x47, 0x56, 0xfb, 0x09, 0x38, 0xfc, 0x8c, 0x30, 0x1c, 0x82, 0x86, 0xb3, 0x10, 0x0e, 0xc3, 0x04,
-		0x2c, 0x7e, 0x56, 0xb0, 0x10, 0x34, 0x84, 0xc5, 0xcb, 0xfe, 0x42, 0x6b, 0xe3, 0x3d, 0xc3, 0x71,
-		0x6d, 0xe6, 0x92, 0x1f, 0xcd, 0xea, 0xe7, 0xbe, 0x18, 0x76, 0xc2, 0x94, 0x00, 0x29, 0xb1, 0x44,
-		0x7c, 0xeb, 0x83, 0x86, 0x6c, 0xe3, 0x1b, 0xf6, 0xf3, 0xc2, 0x12, 0x05, 0xc8, 0x48, 0xdb, 0x02,
-		0x1e, 0x22, 0x11, 0xbb, 0x4e, 0x02, 0x95, 0x09, 0xd8, 0xfd, 0xc3, 0xbe, 0xc6, 0x35, 0x04, 0x2d,
-		0xe1, 0x19, 0xf0, 0x7f, 0x7a, 0xe6, 0x2d, 0x7c, 0x38, 0x91, 0x76, 0xfe, 0x42, 0x9f, 0xff, 0xb3,
-		0xcd, 0x28, 0x99, 0x0d, 0x29, 0xf6, 0xf9, 0x53, 0x68, 0xdc, 0x59, 0xbf, 0xb9, 0x6f, 0x78, 0x9b,
-		0xf7, 0x37, 0xec, 0x4e, 0x2d, 0xaf, 0x11, 0x25, 0x0f, 0x3b, 0x3d, 0xe3, 0x99, 0x7d, 0xf8, 0x6d,
-		0x4f, 0xcf, 0x43, 0x3e, 0xcf, 0xf2, 0x35, 0xc8, 0x87, 0x1c, 0x9e, 0xf1, 0xac, 0xbe, 0x91, 0xb3,
-		0xca, 0x05, 0xfd, 0x9d, 0xe5, 0xcb, 0x10, 0x27, 0xce, 0xcb, 0x78, 0xf2, 0x6f, 0xe2, 0xe4, 0x14,
-		0x7d, 0xf9, 0x05, 0x48, 0x0b, 0xa7, 0x65, 0x3c, 0xe9, 0x5f, 0xe3, 0xa4, 0x1e, 0x09, 0x21, 0x17,
-		0x0e, 0xcb, 0x78, 0xf2, 0xbf, 0x2e, 0xc8, 0x05, 0x09, 0x21, 0x9f, 0x5c, 0x84, 0xbf, 0xf4, 0x2d,
-		0x71, 0xbe, 0xe8, 0x08, 0xd9, 0x5d, 0x85, 0x14, 0xf7, 0x54, 0xc6, 0x53, 0x7f, 0x33, 0xaf, 0x5c,
-		0x50, 0x2c, 0x3f, 0x0b, 0x89, 0x09, 0x05, 0xfe, 0x6d, 0x9c, 0x94, 0xe1, 0x2f, 0x57, 0x21, 0x1b,
-		0xf0, 0x4e, 0xc6, 0x93, 0x7f, 0x3b, 0x27, 0x0f, 0x52, 0x91, 0xa6, 0x73, 0xef, 0x64, 0x3c, 0x83,
-		0xbf, 0x21, 0x9a, 0xce, 0x29, 0x88, 0xd8, 0x84, 0x63, 0x32, 0x9e, 0xfa, 0xa3, 0x42, 0xea, 0x82,
-		0x64, 0xf9, 0x45, 0xc8, 0x78, 0x8b, 0xcd, 0x78, 0xfa, 0xef, 0xe0, 0xf4, 0x3e, 0x0d, 0x91, 0x40,
-		0x60, 0xb1, 0x1b, 0xcf, 0xe2, 0x6f, 0x0a, 0x09, 0x04, 0xa8, 0xc8, 0x34, 0xea, 0x77, 0x60, 0xc6,
-		0x73, 0xfa, 0x4e, 0x31, 0x8d, 0xfa, 0xfc, 0x17, 0x32, 0x9a, 0xd4, 0xe6, 0x8f, 0x67, 0xf1, 0x5d,
-		0x62, 0x34, 0x29, 0x3e, 0x69, 0x46, 0xbf, 0x47, 0x30, 0x9e, 0xc7, 0xf7, 0x88, 0x66, 0xf4, 0x39,
-		0x04, 0xcb, 0x5b, 0x80, 0x06, 0xbd, 0x81, 0xf1, 0xfc, 0x3e, 0xc6, 0xf9, 0x4d, 0x0f, 0x38, 0x03,
-		0xcb, 0xef, 0x87, 0x93, 0xc3, 0x3d, 0x81, 0xf1, 0x5c, 0xbf, 0xf7, 0xed, 0xbe, 0xd8, 0x2d, 0xe8,
-		0x08, 0x2c, 0x37, 0xfd, 0x25, 0x25, 0xe8, 0x05, 0x8c, 0x67, 0xfb, 0x7d, 0x6f, 0x87, 0x0d, 0x77,
-		0xd0, 0x09, 0x58, 0x2e, 0x03, 0xf8, 0x0b, 0xf0, 0x78, 0x5e, 0x9f, 0xe0, 0xbc, 0x02, 0x44, 0x64,
-		0x6a, 0xf0, 0xf5, 0x77, 0x3c, 0xfd, 0x3d, 0x31, 0x35, 0x38, 0x05, 0x99, 0x1a, 0x62, 0xe9, 0x1d,
-		0x4f, 0xfd, 0xfd, 0x62, 0x6a, 0x08, 0x12, 0xa2, 0xd9, 0x81, 0xd5, 0x6d, 0x3c, 0x87, 0x4f, 0x09,
-		0xcd, 0x0e, 0x50, 0x2d, 0x6f, 0xc0, 0xf4, 0xc0, 0x82, 0x38, 0x9e, 0xd5, 0x0f, 0x72, 0x56, 0x52,
-		0xff, 0x7a, 0x18, 0x5c, 0xbc, 0xf8, 0x62, 0x38, 0x9e, 0xdb, 0x0f, 0xf5, 0x2d, 0x5e, 0x7c, 0x2d,
-		0x5c, 0xbe, 0x0a, 0x69, 0xb3, 0xd7, 0x6e, 0x93, 0xc9, 0x83, 0x8e, 0x3e, 0x9f, 0x3b, 0xf7, 0x5f,
-		0xbf, 0xc4, 0xa5, 0x23, 0x08, 0x96, 0x2f, 0x43, 0x02, 0x77, 0x76, 0x70, 0x6b, 0x1c, 0xe5, 0x17,
-		0xbe, 0x24, 0x0c, 0x26, 0xc1, 0x5e, 0x7e, 0x11, 0x80, 0xa5, 0x46, 0xe8, 0xc6, 0xf9, 0x18, 0xda,
-		0xdf, 0xfb, 0x12, 0x3f, 0x10, 0xe7, 0x93, 0xf8, 0x0c, 0xd8, 0xf1, 0xba, 0xa3, 0x19, 0x7c, 0x31,
-		0xcc, 0x80, 0x8e, 0xc8, 0xf3, 0x90, 0x7a, 0xdd, 0xb1, 0x4c, 0x57, 0xdb, 0x1b, 0x47, 0xfd, 0xfb,
-		0x9c, 0x5a, 0xe0, 0x13, 0x81, 0x75, 0x2c, 0x1b, 0xbb, 0xda, 0x9e, 0x33, 0x8e, 0xf6, 0xbf, 0x71,
-		0x5a, 0x8f, 0x80, 0x10, 0xeb, 0x9a, 0xe3, 0x4e, 0xd2, 0xef, 0xff, 0x2e, 0x88, 0x05, 0x01, 0x69,
-		0x34, 0xf9, 0x7d, 0x0b, 0x1f, 0x8e, 0xa3, 0xfd, 0x03, 0xd1, 0x68, 0x8e, 0xbf, 0xfc, 0x02, 0x64,
-		0xc8, 0x4f, 0x76, 0xca, 0x75, 0x0c, 0xf1, 0x1f, 0x72, 0x62, 0x9f, 0x82, 0xd4, 0xec, 0xb8, 0x2d,
-		0xd7, 0x18, 0x2f, 0xec, 0x3f, 0xe2, 0x23, 0x2d, 0xf0, 0x97, 0xcb, 0x90, 0x75, 0xdc, 0x56, 0xab,
-		0xc7, 0xfd, 0xd3, 0x31, 0xe4, 0x7f, 0xfc, 0x25, 0x2f, 0x65, 0xe1, 0xd1, 0x90, 0xd1, 0xbe, 0x73,
-		0xcb, 0xed, 0x5a, 0x74, 0xbf, 0x65, 0x1c, 0x87, 0xb7, 0x39, 0x87, 0x00, 0xc9, 0x72, 0x15, 0x72,
-		0xa4, 0x2f, 0x36, 0xee, 0x62, 0xba, 0x39, 0x36, 0x86, 0xc5, 0x9f, 0x70, 0x01, 0x84, 0x88, 0x2a,
-		0x5f, 0xff, 0x2b, 0x6f, 0xcd, 0x47, 0x3e, 0xfb, 0xd6, 0x7c, 0xe4, 0xb7, 0xde, 0x9a, 0x8f, 0x7c,
-		0xf4, 0x73, 0xf3, 0x53, 0x9f, 0xfd, 0xdc, 0xfc, 0xd4, 0x6f, 0x7c, 0x6e, 0x7e, 0x6a, 0x78, 0x96,
-		0x18, 0x56, 0xad, 0x55, 0x8b, 0xe5, 0x87, 0x5f, 0x7b, 0x78, 0xcf, 0x70, 0xf7, 0x7b, 0x3b, 0x4b,
-		0xba, 0xd5, 0xb9, 0xa8, 0x5b, 0x4e, 0xc7, 0x72, 0x2e, 0x86, 0xf3, 0xba, 0xf4, 0x17, 0xfc, 0xef,
-		0x08, 0x89, 0x99, 0xc3, 0xe9, 0x5c, 0xcd, 0x3c, 0x1c, 0x75, 0x99, 0xee, 0x0a, 0xc4, 0xca, 0xe6,
-		0x21, 0x3a, 0xcd, 0x0c, 0x9c, 0xda, 0xb3, 0xdb, 0xfc, 0xa8, 0x65, 0x8a, 0x7c, 0x6f, 0xdb, 0x6d,
-		0x34, 0xeb, 0x9f, 0x87, 0x8e, 0x9c, 0xcf, 0xf1, 0x43, 0xce, 0x95, 0x6f, 0x8f, 0x1c, 0xaf, 0x27,
-		0xe9, 0xb2, 0x79, 0x48, 0x3b, 0xb2, 0x15, 0x79, 0xed, 0xf1, 0xb1, 0x79, 0xee, 0x5b, 0xa6, 0x75,
-		0xc7, 0x24, 0xcd, 0xee, 0xee, 0x88, 0x1c, 0xf7, 0x7c, 0x7f, 0x8e, 0xfb, 0xfd, 0xb8, 0xdd, 0x7e,
-		0x89, 0xe0, 0x35, 0x09, 0xc9, 0x4e, 0x92, 0x9d, 0xea, 0x87, 0xef, 0x8c, 0xc2, 0xfc, 0x40, 0x3a,
-		0x9b, 0x2b, 0xc1, 0x28, 0x21, 0x2c, 0x43, 0x7a, 0x45, 0xe8, 0xd6, 0x1c, 0xa4, 0x1c, 0xac, 0x5b,
-		0x66, 0xcb, 0xa1, 0x82, 0x88, 0x29, 0xe2, 0x93, 0x08, 0xc2, 0xd4, 0x4c, 0xcb, 0xe1, 0x87, 0x95,
-		0xd9, 0x47, 0xe5, 0xe3, 0xc7, 0x14, 0x44, 0x5e, 0xd4, 0x24, 0xa4, 0xf1, 0xd4, 0x84, 0xd2, 0x10,
-		0x9d, 0x08, 0x65, 0xfe, 0x27, 0x95, 0xca, 0xf7, 0x44, 0x61, 0xa1, 0x5f, 0x2a, 0x64, 0x66, 0x39,
-		0xae, 0xd6, 0xe9, 0x8e, 0x12, 0xcb, 0x55, 0xc8, 0x34, 0x05, 0xce, 0xb1, 0xe5, 0x72, 0xef, 0x98,
-		0x72, 0x29, 0x78, 0x55, 0x09, 0xc1, 0x5c, 0x9a, 0x50, 0x30, 0x5e, 0x3f, 0xee, 0x4b, 0x32, 0xff,
-		0x2b, 0x09, 0xa7, 0xd9, 0x74, 0x52, 0xd9, 0x54, 0x62, 0x1f, 0x5c, 0x26, 0xb9, 0x60, 0xd1, 0xf8,
-		0x7d, 0x12, 0xf9, 0x25, 0x98, 0xa9, 0x13, 0x6b, 0x41, 0xa2, 0x20, 0x7f, 0x87, 0x67, 0xe8, 0x79,
-		0xee, 0xc5, 0x90, 0xc3, 0xcf, 0xf7, 0xb7, 0x82, 0x20, 0xf9, 0x1b, 0x22, 0x20, 0x35, 0x74, 0xad,
-		0xad, 0xd9, 0x5f, 0x2e, 0x2b, 0xf4, 0x2c, 0x00, 0x3b, 0xee, 0xe1, 0x5d, 0xdc, 0x2b, 0x5c, 0x9a,
-		0x5b, 0x0a, 0x76, 0x6e, 0x89, 0xd5, 0x44, 0x4f, 0x50, 0x65, 0x28, 0x2e, 0xf9, 0x79, 0xe1, 0x15,
-		0x00, 0xbf, 0x00, 0x9d, 0x81, 0x53, 0x8d, 0x6a, 0x79, 0xad, 0xac, 0x88, 0x43, 0x42, 0x8d, 0xad,
-		0x5a, 0xb5, 0x7e, 0xad, 0x5e, 0x5b, 0x91, 0xa6, 0xd0, 0x49, 0x40, 0xc1, 0x42, 0xef, 0x50, 0xd3,
-		0x09, 0x98, 0x0e, 0xc2, 0xd9, 0x2d, 0x95, 0x28, 0xf1, 0x14, 0x8d, 0x4e, 0xb7, 0x8d, 0xe9, 0xce,
-		0xa3, 0x6a, 0x08, 0xa9, 0x8d, 0x77, 0x42, 0x7e, 0xf5, 0xdf, 0xb3, 0x9b, 0x0b, 0x33, 0x3e, 0xb9,
-		0x27, 0xf3, 0xe5, 0x35, 0x98, 0xd6, 0x74, 0x1d, 0x77, 0x43, 0x2c, 0xc7, 0x98, 0x6a, 0xc2, 0x90,
-		0xee, 0xa5, 0x72, 0x4a, 0x9f, 0xdb, 0xb3, 0x90, 0x74, 0x68, 0xef, 0xc7, 0xb1, 0xf8, 0x35, 0xce,
-		0x82, 0xa3, 0x2f, 0x9b, 0x30, 0x4d, 0x3c, 0x3f, 0xcd, 0xc6, 0x81, 0x66, 0x1c, 0x9d, 0x67, 0xf8,
-		0x47, 0x3f, 0xfd, 0x24, 0xdd, 0x59, 0x3d, 0x1b, 0x1e, 0x96, 0x21, 0xea, 0xa4, 0x48, 0x9c, 0xb7,
-		0xdf, 0x50, 0x0c, 0x05, 0x51, 0x1f, 0x6f, 0xf0, 0xd1, 0x95, 0xfd, 0x22, 0xaf, 0x6c, 0x7e, 0x98,
-		0x0e, 0x04, 0x6a, 0xca, 0x73, 0xae, 0xac, 0xa0, 0x52, 0x1b, 0x35, 0xa7, 0x5f, 0x7b, 0x6c, 0x70,
-		0x75, 0x62, 0xff, 0x3d, 0x41, 0x39, 0x5f, 0x0d, 0x56, 0xe3, 0xcd, 0xbd, 0x8f, 0xc7, 0x61, 0x5a,
-		0xeb, 0x18, 0xa6, 0x75, 0x91, 0xfe, 0xcb, 0xe7, 0x5c, 0x82, 0x7e, 0x4c, 0xb0, 0x29, 0x79, 0x85,
-		0x4d, 0x85, 0xf1, 0x1a, 0xf3, 0x87, 0xdf, 0xfa, 0x23, 0x09, 0x7f, 0xba, 0x2c, 0xaf, 0x83, 0x24,
-		0x0e, 0xf1, 0x62, 0x53, 0xb7, 0x5a, 0x13, 0x65, 0x29, 0xfe, 0x48, 0xf0, 0x10, 0xf9, 0xad, 0x1a,
-		0x27, 0x5d, 0x7e, 0x2f, 0xa4, 0x3d, 0x36, 0xe3, 0x3c, 0x13, 0xc1, 0xc4, 0xa3, 0x20, 0x7e, 0x09,
-		0x9b, 0x99, 0x93, 0x78, 0xa1, 0x6f, 0x0b, 0x7a, 0x36, 0x43, 0x37, 0x48, 0x6f, 0x56, 0xa1, 0xd0,
-		0xb2, 0x4c, 0x57, 0xb5, 0x3a, 0x86, 0x8b, 0x3b, 0x5d, 0x77, 0xac, 0x5f, 0xf7, 0x27, 0x8c, 0x49,
-		0x5a, 0xc9, 0x13, 0xba, 0x4d, 0x41, 0x46, 0x5a, 0xc2, 0xee, 0xe9, 0x4d, 0xd2, 0x92, 0xff, 0xe1,
-		0xb5, 0x84, 0xd2, 0x90, 0x96, 0xdc, 0x97, 0x76, 0x38, 0xad, 0x5b, 0xdc, 0x98, 0xbb, 0x07, 0x4c,
-		0x0b, 0x3c, 0xed, 0xf8, 0xa7, 0x71, 0x98, 0xe7, 0xc8, 0x3b, 0x9a, 0x83, 0x2f, 0xde, 0x7e, 0x6a,
-		0x07, 0xbb, 0xda, 0x53, 0x17, 0x75, 0xcb, 0x10, 0x2b, 0xf9, 0x0c, 0x37, 0xd6, 0xa4, 0x7c, 0x89,
-		0x97, 0x97, 0x86, 0x6e, 0x77, 0x97, 0x46, 0x1b, 0xf9, 0xd2, 0xa0, 0x0e, 0xca, 0x6d, 0x88, 0x57,
-		0x2d, 0xc3, 0x24, 0x6b, 0x5b, 0x0b, 0x9b, 0x56, 0x87, 0x9b, 0x5b, 0xf6, 0x81, 0xae, 0x43, 0x52,
-		0xeb, 0x58, 0x3d, 0xd3, 0x65, 0xa6, 0xb6, 0xf2, 0xe4, 0xaf, 0xbc, 0xb9, 0x30, 0xf5, 0x9f, 0xde,
-		0x5c, 0x38, 0xc1, 0xd8, 0x3a, 0xad, 0x5b, 0x4b, 0x86, 0x75, 0xb1, 0xa3, 0xb9, 0xfb, 0x64, 0xfa,
-		0xfe, 0xfa, 0x67, 0x9e, 0x00, 0x5e, 0x5f, 0xdd, 0x74, 0x3f, 0xfd, 0xbb, 0x3f, 0x71, 0x21, 0xa2,
-		0x70, 0xfa, 0xe5, 0xf8, 0xe7, 0x3f, 0xb9, 0x10, 0x91, 0xbb, 0x90, 0x5a, 0xc1, 0xfa, 0x11, 0x15,
-		0xd6, 0xfb, 0x2a, 0x7c, 0x8a, 0x57, 0x78, 0x66, 0xb0, 0x42, 0x76, 0x60, 0x6f, 0x05, 0xeb, 0x81,
-		0x6a, 0x57, 0xb0, 0xde, 0x57, 0x63, 0x1d, 0xd2, 0x75, 0xd3, 0x65, 0x97, 0x8d, 0x5e, 0x80, 0x98,
-		0x61, 0xb2, 0xf3, 0xeb, 0x99, 0xca, 0x63, 0xc7, 0xe8, 0x8a, 0x42, 0xe8, 0xe4, 0x4d, 0x48, 0xaf,
-		0x60, 0x9d, 0xb1, 0xaa, 0x42, 0xac, 0x85, 0x75, 0xce, 0xea, 0x3e, 0x1a, 0x49, 0xa8, 0x2b, 0x2b,
-		0xbf, 0xf1, 0xdb, 0xf3, 0x53, 0x1f, 0x7a, 0x6b, 0x7e, 0x6a, 0xa4, 0x3a, 0xc9, 0xe3, 0xd5, 0xc9,
-		0xd3, 0xa2, 0x1f, 0x89, 0xc3, 0x83, 0xf4, 0xb6, 0xaa, 0xdd, 0x31, 0x4c, 0xf7, 0xa2, 0x6e, 0x1f,
-		0x76, 0x5d, 0x8b, 0x18, 0x15, 0x6b, 0x97, 0x2b, 0xd1, 0xb4, 0x5f, 0xbc, 0xc4, 0x8a, 0x87, 0xab,
-		0x90, 0xbc, 0x0b, 0x89, 0x2d, 0x42, 0x47, 0x06, 0xc9, 0xb5, 0x5c, 0xad, 0xcd, 0x3d, 0x21, 0xf6,
-		0x41, 0xa0, 0xec, 0x86, 0x6b, 0x94, 0x41, 0x0d, 0x71, 0xb9, 0xb5, 0x8d, 0xb5, 0x5d, 0x76, 0x51,
-		0x28, 0x46, 0x5d, 0xe8, 0x34, 0x01, 0xd0, 0x3b, 0x41, 0xb3, 0x90, 0xd0, 0x7a, 0xec, 0x30, 0x4f,
-		0x8c, 0xf8, 0xd6, 0xf4, 0x43, 0x7e, 0x09, 0x52, 0x7c, 0x4f, 0x1f, 0x49, 0x10, 0xbb, 0x85, 0x0f,
-		0x69, 0x3d, 0x39, 0x85, 0xfc, 0x44, 0x4b, 0x90, 0xa0, 0x8d, 0xe7, 0x37, 0x20, 0xe7, 0x96, 0x06,
-		0x5a, 0xbf, 0x44, 0x1b, 0xa9, 0x30, 0x34, 0xf9, 0x06, 0xa4, 0x57, 0x2c, 0xa2, 0xd9, 0x61, 0x6e,
-		0x19, 0xc6, 0x8d, 0xb6, 0xb9, 0xdb, 0xe3, 0x7a, 0xa5, 0xb0, 0x0f, 0x74, 0x12, 0x92, 0xec, 0xe2,
-		0x18, 0x3f, 0x90, 0xc4, 0xbf, 0xe4, 0x2a, 0xa4, 0x28, 0xef, 0xcd, 0x2e, 0x71, 0x43, 0xbc, 0xb3,
-		0xf4, 0x19, 0x7e, 0x8d, 0x98, 0xb3, 0x8f, 0xfa, 0x8d, 0x45, 0x10, 0x6f, 0x69, 0xae, 0xc6, 0xfb,
-		0x4d, 0x7f, 0xcb, 0xef, 0x83, 0x34, 0x67, 0xe2, 0xa0, 0x4b, 0x10, 0xb3, 0xba, 0x0e, 0x3f, 0x52,
-		0x54, 0x1a, 0xd5, 0x95, 0xcd, 0x6e, 0x25, 0x4e, 0x74, 0x49, 0x21, 0xc8, 0x95, 0x8d, 0x91, 0x6a,
-		0xf1, 0x4c, 0x48, 0x2d, 0x3a, 0xd8, 0xdd, 0xd9, 0x75, 0xfd, 0x1f, 0x6c, 0x38, 0x07, 0x54, 0xc1,
-		0x53, 0x94, 0x7b, 0x51, 0x98, 0x0f, 0x94, 0xde, 0xc6, 0xb6, 0x63, 0x58, 0x26, 0xd3, 0x26, 0xae,
-		0x29, 0x28, 0xd0, 0x40, 0x5e, 0x3e, 0x42, 0x55, 0x5e, 0x80, 0x58, 0xb9, 0xdb, 0x45, 0x25, 0x48,
-		0xd3, 0x6f, 0xdd, 0x62, 0xba, 0x12, 0x57, 0xbc, 0x6f, 0x52, 0xe6, 0x58, 0xbb, 0xee, 0x1d, 0xcd,
-		0xf6, 0xee, 0x55, 0x8b, 0x6f, 0xf9, 0x79, 0xc8, 0x54, 0x2d, 0xd3, 0xc1, 0xa6, 0xd3, 0xa3, 0xfe,
-		0xf5, 0x4e, 0xdb, 0xd2, 0x6f, 0x71, 0x0e, 0xec, 0x83, 0x08, 0x5b, 0xeb, 0x76, 0x29, 0x65, 0x5c,
-		0x21, 0x3f, 0xd9, 0xcc, 0xae, 0x6c, 0x8e, 0x14, 0xcf, 0xe5, 0xe3, 0x89, 0x87, 0x77, 0xd0, 0x77,
-		0x94, 0x23, 0xf0, 0xc0, 0xe0, 0x44, 0xba, 0x85, 0x0f, 0x9d, 0xe3, 0xce, 0xa3, 0x57, 0x20, 0xb3,
-		0x45, 0x9f, 0x3c, 0x79, 0x09, 0x1f, 0xa2, 0x12, 0xa4, 0x70, 0xeb, 0xd2, 0xe5, 0xcb, 0x4f, 0x3d,
-		0xcf, 0xb4, 0xfc, 0xfa, 0x94, 0x22, 0x00, 0x68, 0x1e, 0x32, 0x0e, 0xd6, 0xbb, 0x97, 0x2e, 0x5f,
-		0xb9, 0xf5, 0x14, 0x53, 0xab, 0xeb, 0x53, 0x8a, 0x0f, 0x5a, 0x4e, 0x93, 0x1e, 0x7f, 0xfe, 0x53,
-		0x0b, 0x91, 0x4a, 0x02, 0x62, 0x4e, 0xaf, 0xf3, 0xae, 0xe9, 0xc6, 0x1f, 0x24, 0x61, 0x31, 0x50,
-		0xca, 0x16, 0xac, 0xdb, 0x5a, 0xdb, 0x68, 0x69, 0xfe, 0x43, 0x35, 0x52, 0xa0, 0xff, 0x14, 0x63,
-		0xc4, 0x4a, 0x74, 0xa4, 0x14, 0xe5, 0x9f, 0x8a, 0x40, 0xee, 0xa6, 0xe0, 0xdc, 0xc0, 0x2e, 0xba,
-		0x0a, 0xe0, 0xd5, 0x24, 0xa6, 0xca, 0x99, 0xa5, 0xfe, 0xba, 0x96, 0x3c, 0x1a, 0x25, 0x80, 0x8e,
-		0x9e, 0xa5, 0x0a, 0xd8, 0xb5, 0x1c, 0x7e, 0xc7, 0x76, 0x0c, 0xa9, 0x87, 0x8c, 0x1e, 0x07, 0x44,
-		0xad, 0x9a, 0x7a, 0xdb, 0x72, 0x0d, 0x73, 0x4f, 0xed, 0x5a, 0x77, 0xf8, 0xcb, 0x05, 0x31, 0x45,
-		0xa2, 0x25, 0x37, 0x69, 0xc1, 0x16, 0x81, 0x93, 0x46, 0x67, 0x3c, 0x2e, 0x24, 0x54, 0xd4, 0x5a,
-		0x2d, 0x1b, 0x3b, 0x0e, 0x37, 0x5c, 0xe2, 0x13, 0x5d, 0x85, 0x54, 0xb7, 0xb7, 0xa3, 0x0a, 0x2b,
-		0x91, 0xbd, 0xf4, 0xc0, 0xb0, 0x39, 0x2f, 0x74, 0x83, 0xcf, 0xfa, 0x64, 0xb7, 0xb7, 0x43, 0x34,
-		0xe5, 0x2c, 0xe4, 0x86, 0x34, 0x26, 0x7b, 0xdb, 0x6f, 0x07, 0x7d, 0x65, 0x87, 0xf7, 0x40, 0xed,
-		0xda, 0x86, 0x65, 0x1b, 0xee, 0x21, 0x3d, 0x0a, 0x18, 0x53, 0x24, 0x51, 0xb0, 0xc5, 0xe1, 0xf2,
-		0x2d, 0x28, 0x36, 0x68, 0x08, 0xe1, 0xb7, 0xfc, 0xb2, 0xdf, 0xbe, 0xc8, 0xf8, 0xf6, 0x8d, 0x6c,
-		0x59, 0x74, 0xa0, 0x65, 0x17, 0xfe, 0x43, 0x04, 0xb2, 0x15, 0x32, 0x71, 0xeb, 0x2b, 0xd7, 0xda,
-		0xda, 0x1e, 0x7a, 0x0a, 0x4e, 0x54, 0xd6, 0x36, 0xab, 0x2f, 0xa9, 0xf5, 0x15, 0xf5, 0xda, 0x5a,
-		0x79, 0xd5, 0x3f, 0x7b, 0x5c, 0x3a, 0x79, 0xf7, 0xde, 0x22, 0x0a, 0xe0, 0x6e, 0x9b, 0x34, 0xce,
-		0x45, 0x17, 0x61, 0x36, 0x4c, 0x52, 0xae, 0x34, 0x6a, 0x1b, 0x4d, 0x29, 0x52, 0x3a, 0x71, 0xf7,
-		0xde, 0xe2, 0x74, 0x80, 0xa2, 0xbc, 0xe3, 0x60, 0xd3, 0x1d, 0x24, 0xa8, 0x6e, 0xae, 0xaf, 0xd7,
-		0x9b, 0x52, 0x74, 0x80, 0xa0, 0x6a, 0x75, 0x3a, 0x86, 0x8b, 0x1e, 0x85, 0xe9, 0x30, 0xc1, 0x46,
-		0x7d, 0x4d, 0x8a, 0x95, 0xd0, 0xdd, 0x7b, 0x8b, 0x85, 0x00, 0xf6, 0x86, 0xd1, 0x2e, 0xa5, 0x3f,
-		0xf2, 0x43, 0xf3, 0x53, 0x9f, 0xfe, 0x5b, 0xf3, 0x91, 0xca, 0xfa, 0xc8, 0x39, 0xf7, 0xf4, 0xf1,
-		0xe6, 0x5c, 0x78, 0xdd, 0xfe, 0xd2, 0x03, 0x21, 0x73, 0xc3, 0x7d, 0xc4, 0x80, 0x31, 0x9e, 0x74,
-		0xba, 0x8d, 0xcb, 0x7b, 0x94, 0x8e, 0x76, 0x0f, 0x4a, 0x63, 0x16, 0x85, 0xd2, 0x58, 0xc3, 0x20,
-		0x3f, 0x0f, 0xf9, 0x2d, 0xcd, 0x76, 0x1b, 0xd8, 0xbd, 0x8e, 0xb5, 0x16, 0xb6, 0xc3, 0xfe, 0x43,
-		0x5e, 0xf8, 0x0f, 0x08, 0xe2, 0xd4, 0x49, 0x60, 0xeb, 0x27, 0xfd, 0x2d, 0xef, 0x43, 0x9c, 0x9e,
-		0x72, 0xf6, 0x7c, 0x0b, 0x4e, 0xc1, 0x7c, 0x0b, 0xb2, 0x32, 0x1c, 0xba, 0xd8, 0x11, 0xa9, 0x39,
-		0xfa, 0x81, 0x9e, 0x11, 0x1e, 0x42, 0xec, 0x68, 0x0f, 0x81, 0x4f, 0x2f, 0xee, 0x27, 0xb4, 0x21,
-		0xc5, 0x87, 0xd8, 0x6b, 0x48, 0xc4, 0x6f, 0x08, 0x5a, 0x87, 0x62, 0x57, 0xb3, 0x5d, 0x7a, 0xeb,
-		0x71, 0x9f, 0xf6, 0x82, 0xcf, 0xe0, 0x85, 0x41, 0x7b, 0x12, 0xea, 0x2c, 0xaf, 0x25, 0xdf, 0x0d,
-		0x02, 0xe5, 0xff, 0x12, 0x87, 0x24, 0x17, 0xc6, 0x0b, 0x90, 0xe2, 0x62, 0xe5, 0x73, 0xee, 0xc1,
-		0xa5, 0xc1, 0x65, 0x76, 0xc9, 0x5b, 0x0e, 0x39, 0x3f, 0x41, 0x83, 0x1e, 0x81, 0xb4, 0xbe, 0xaf,
-		0x19, 0xa6, 0x6a, 0xb4, 0xb8, 0x73, 0x9c, 0x7d, 0xeb, 0xcd, 0x85, 0x54, 0x95, 0xc0, 0xea, 0x2b,
-		0x4a, 0x8a, 0x16, 0xd6, 0x5b, 0xc4, 0xa7, 0xd9, 0xc7, 0xc6, 0xde, 0xbe, 0xcb, 0xed, 0x06, 0xff,
-		0x42, 0xcf, 0x41, 0x9c, 0x28, 0x04, 0xbf, 0x13, 0x5f, 0x1a, 0x88, 0x7a, 0xbc, 0xb4, 0x54, 0x25,
-		0x4d, 0x2a, 0xfe, 0xe8, 0x7f, 0x5e, 0x88, 0x28, 0x94, 0x02, 0x55, 0x21, 0xdf, 0xd6, 0x1c, 0x57,
-		0xa5, 0xeb, 0x31, 0xa9, 0x3e, 0x41, 0x59, 0x9c, 0x1e, 0x14, 0x08, 0x17, 0x2c, 0x6f, 0x7a, 0x96,
-		0x50, 0x31, 0x50, 0x0b, 0x9d, 0x07, 0x89, 0x32, 0xd1, 0xe9, 0x0c, 0x64, 0x5e, 0x62, 0x92, 0xca,
-		0xbd, 0x40, 0xe0, 0x6c, 0x62, 0x52, 0x5f, 0xf1, 0x0c, 0x64, 0xe8, 0x2d, 0x5c, 0x8a, 0xc2, 0x8e,
-		0xd6, 0xa7, 0x09, 0x80, 0x16, 0x9e, 0x83, 0xa2, 0x6f, 0xf5, 0x19, 0x4a, 0x9a, 0x71, 0xf1, 0xc1,
-		0x14, 0xf1, 0x49, 0x98, 0x35, 0xf1, 0x01, 0x3d, 0xec, 0x1f, 0xc2, 0xce, 0x50, 0x6c, 0x44, 0xca,
-		0x6e, 0x86, 0x29, 0x1e, 0x86, 0x82, 0x2e, 0x84, 0xcf, 0x70, 0x81, 0xe2, 0xe6, 0x3d, 0x28, 0x45,
-		0x3b, 0x0d, 0x69, 0xad, 0xdb, 0x65, 0x08, 0x59, 0x6e, 0xf5, 0xbb, 0x5d, 0x5a, 0x74, 0x01, 0xa6,
-		0x69, 0x1f, 0x6d, 0xec, 0xf4, 0xda, 0x2e, 0x67, 0x92, 0xa3, 0x38, 0x45, 0x52, 0xa0, 0x30, 0x38,
-		0xc5, 0x7d, 0x08, 0xf2, 0xf8, 0xb6, 0xd1, 0xc2, 0xa6, 0x8e, 0x19, 0x5e, 0x9e, 0xe2, 0xe5, 0x04,
-		0x90, 0x22, 0x3d, 0x0a, 0x9e, 0x35, 0x57, 0xc5, 0x4a, 0x53, 0x60, 0xfc, 0x04, 0xbc, 0xcc, 0xc0,
-		0xf2, 0x1c, 0xc4, 0x57, 0x34, 0x57, 0x23, 0xee, 0x92, 0x7b, 0xc0, 0x96, 0xcf, 0x9c, 0x42, 0x7e,
-		0xca, 0x3f, 0x1b, 0x83, 0xf8, 0x4d, 0xcb, 0xc5, 0xe8, 0xe9, 0x80, 0x2b, 0x5b, 0x18, 0xa6, 0xcf,
-		0x0d, 0x63, 0xcf, 0xc4, 0xad, 0x75, 0x67, 0x2f, 0xf0, 0x64, 0x8e, 0xaf, 0x4e, 0xd1, 0x90, 0x3a,
-		0xcd, 0x42, 0xc2, 0xb6, 0x7a, 0x66, 0x4b, 0x1c, 0x4a, 0xa7, 0x1f, 0xa8, 0x06, 0x69, 0x4f, 0x4b,
-		0xe2, 0xe3, 0xb4, 0xa4, 0x48, 0xb4, 0x84, 0xe8, 0x30, 0x07, 0x28, 0xa9, 0x1d, 0xae, 0x2c, 0x15,
-		0xc8, 0x78, 0xc6, 0x8b, 0x6b, 0xdb, 0x64, 0x0a, 0xeb, 0x93, 0x91, 0x25, 0xd2, 0x1b, 0x7b, 0x4f,
-		0x78, 0x4c, 0xe3, 0x24, 0xaf, 0x80, 0x4b, 0x2f, 0xa4, 0x56, 0xfc, 0xf9, 0x9e, 0x14, 0xed, 0x97,
-		0xaf, 0x56, 0xec, 0x09, 0x9f, 0x07, 0x20, 0xe3, 0x18, 0x7b, 0xa6, 0xe6, 0xf6, 0x6c, 0xcc, 0x35,
-		0xcf, 0x07, 0x90, 0x52, 0xff, 0x82, 0x06, 0xd3, 0xb4, 0xc0, 0xab, 0x62, 0x17, 0x61, 0xc6, 0x7f,
-		0xcf, 0xcb, 0xe7, 0xc2, 0xb4, 0x0c, 0x79, 0x45, 0x0d, 0x51, 0x22, 0xff, 0x52, 0x04, 0x92, 0x7c,
-		0xc5, 0xf2, 0x87, 0x21, 0x32, 0x7c, 0x18, 0xa2, 0xa3, 0x86, 0x21, 0x76, 0xff, 0xc3, 0x50, 0x06,
-		0xf0, 0x9a, 0xe9, 0xf0, 0x47, 0x5a, 0x86, 0xb8, 0x55, 0xac, 0x89, 0x0d, 0x63, 0x8f, 0xcf, 0xfb,
-		0x00, 0x91, 0xfc, 0x9b, 0x11, 0xe2, 0xe1, 0xf3, 0x72, 0x54, 0x86, 0xbc, 0x68, 0x97, 0xba, 0xdb,
-		0xd6, 0xf6, 0xb8, 0x2a, 0x3e, 0x38, 0xb2, 0x71, 0x64, 0x15, 0x56, 0xb2, 0xbc, 0x3d, 0xd4, 0x9f,
-		0x18, 0x3a, 0xac, 0xd1, 0x11, 0xc3, 0x1a, 0xd2, 0xa3, 0xd8, 0xfd, 0xe9, 0x51, 0x68, 0xc4, 0xe3,
-		0x7d, 0x23, 0x2e, 0xff, 0x76, 0x84, 0xbf, 0x26, 0xd6, 0x62, 0xb7, 0x4b, 0xfe, 0xac, 0x86, 0xea,
-		0x35, 0xae, 0x5b, 0x2d, 0xdc, 0x52, 0x07, 0xc6, 0xec, 0xa1, 0x41, 0x8e, 0xe1, 0x36, 0xfb, 0x63,
-		0x87, 0x04, 0x97, 0x86, 0x3f, 0x86, 0x9f, 0x89, 0xc2, 0xf4, 0x00, 0xfe, 0x9f, 0xbf, 0xb1, 0x0c,
-		0xcf, 0xde, 0xc4, 0x84, 0xb3, 0x37, 0x39, 0x72, 0xf6, 0xfe, 0x74, 0x94, 0x26, 0x00, 0xba, 0x96,
-		0xa3, 0xb5, 0xbf, 0x12, 0xb6, 0xf7, 0x0c, 0x64, 0xba, 0x56, 0x5b, 0x65, 0x25, 0xec, 0x5a, 0x50,
-		0xba, 0x6b, 0xb5, 0x95, 0x01, 0x35, 0x4b, 0xbc, 0x43, 0x86, 0x39, 0xf9, 0x0e, 0x0c, 0x42, 0xaa,
-		0x7f, 0x42, 0xd9, 0x90, 0x63, 0xa2, 0xe0, 0x5e, 0xd3, 0x93, 0x44, 0x06, 0xd4, 0x0d, 0x8b, 0x0c,
-		0x7a, 0x79, 0xac, 0xd9, 0x0c, 0x53, 0xe1, 0x78, 0x84, 0x82, 0x39, 0x19, 0xc3, 0x32, 0x47, 0x41,
-		0x8b, 0xa5, 0x70, 0x3c, 0xf9, 0xbb, 0x23, 0x00, 0x6b, 0x44, 0xb2, 0xb4, 0xbf, 0xc4, 0xdf, 0x71,
-		0x68, 0x13, 0xd4, 0x50, 0xcd, 0xf3, 0xa3, 0x06, 0x8d, 0xd7, 0x9f, 0x73, 0x82, 0xed, 0xae, 0x42,
-		0xde, 0xd7, 0x6d, 0x07, 0x8b, 0xc6, 0xcc, 0x1f, 0x11, 0x95, 0x36, 0xb0, 0xab, 0xe4, 0x6e, 0x07,
-		0xbe, 0xe4, 0x7f, 0x12, 0x81, 0x0c, 0x6d, 0xd3, 0x3a, 0x76, 0xb5, 0xd0, 0x18, 0x46, 0xee, 0x7f,
-		0x0c, 0x1f, 0x04, 0x60, 0x6c, 0x1c, 0xe3, 0x0d, 0xcc, 0x35, 0x2b, 0x43, 0x21, 0x0d, 0xe3, 0x0d,
-		0x8c, 0xae, 0x78, 0x02, 0x8f, 0x1d, 0x2d, 0x70, 0x11, 0xb5, 0x72, 0xb1, 0x9f, 0x82, 0x14, 0x7d,
-		0xef, 0xf2, 0xc0, 0xe1, 0x81, 0x68, 0xd2, 0xec, 0x75, 0x9a, 0x07, 0x8e, 0xfc, 0x3a, 0xa4, 0x9a,
-		0x07, 0x2c, 0x9f, 0x78, 0x06, 0x32, 0xb6, 0x65, 0x71, 0xef, 0x8f, 0x79, 0xdd, 0x69, 0x02, 0xa0,
-		0xce, 0x8e, 0xc8, 0xa1, 0x45, 0xfd, 0x1c, 0x9a, 0x9f, 0x04, 0x8c, 0x4d, 0x94, 0x04, 0x24, 0xd1,
-		0x67, 0x3e, 0x34, 0x93, 0xd0, 0xe3, 0x70, 0xaa, 0x51, 0x5f, 0xdd, 0xa8, 0xad, 0xa8, 0xeb, 0x8d,
-		0xd5, 0xbe, 0x2b, 0xff, 0xa5, 0xe2, 0xdd, 0x7b, 0x8b, 0x59, 0x1e, 0x76, 0x8e, 0xc2, 0xde, 0x52,
-		0x6a, 0x37, 0x37, 0x9b, 0x35, 0x29, 0xc2, 0xb0, 0xb7, 0x6c, 0x7c, 0xdb, 0x72, 0xd9, 0x83, 0xb2,
-		0x4f, 0xc2, 0xe9, 0x21, 0xd8, 0x5e, 0xf0, 0x39, 0x7d, 0xf7, 0xde, 0x62, 0x7e, 0xcb, 0xc6, 0x4c,
-		0xcb, 0x28, 0xc5, 0x12, 0xcc, 0x0d, 0x52, 0x6c, 0x6e, 0x6d, 0x36, 0xca, 0x6b, 0xd2, 0x62, 0x49,
-		0xba, 0x7b, 0x6f, 0x31, 0x27, 0x4c, 0x06, 0xc1, 0x7f, 0xf7, 0xa3, 0xcf, 0x6f, 0x4e, 0x87, 0xb2,
-		0xc6, 0x2c, 0xae, 0xeb, 0x6a, 0xb6, 0xd6, 0x39, 0x6e, 0xf8, 0x39, 0xe6, 0x30, 0x82, 0xfc, 0x53,
-		0x51, 0x28, 0x7a, 0xc1, 0xcd, 0x16, 0xad, 0x01, 0x3d, 0x1d, 0xcc, 0xf8, 0x65, 0x47, 0xae, 0x1d,
-		0x0c, 0x5b, 0x24, 0x04, 0xdf, 0x0b, 0x69, 0xe1, 0x24, 0xf3, 0x49, 0xb5, 0x38, 0x64, 0x7d, 0xe3,
-		0x18, 0x9c, 0xd4, 0xa3, 0x40, 0x2f, 0x42, 0xc6, 0x9b, 0x62, 0xde, 0xa3, 0x6b, 0xa3, 0xe7, 0x24,
-		0xa7, 0xf7, 0x69, 0xd0, 0xf3, 0x7e, 0x18, 0x17, 0x1f, 0x15, 0x18, 0xde, 0x64, 0x08, 0x9c, 0xd8,
-		0x0b, 0xe1, 0x9e, 0x84, 0xb8, 0xb6, 0xa3, 0x1b, 0xdc, 0x00, 0x3f, 0x30, 0x48, 0x57, 0xae, 0x54,
-		0xeb, 0x9c, 0x88, 0x62, 0xca, 0x75, 0x9e, 0x4c, 0xe1, 0xf2, 0xa2, 0x4f, 0xdc, 0x1c, 0xa8, 0x2c,
-		0x16, 0x66, 0xde, 0x45, 0xba, 0xa3, 0x1d, 0x54, 0x68, 0x38, 0x7c, 0x0a, 0x52, 0xa4, 0x70, 0x8f,
-		0x3f, 0xa2, 0x10, 0x53, 0x92, 0x1d, 0xed, 0x60, 0x55, 0x73, 0x6e, 0xc4, 0xd3, 0x31, 0x29, 0x2e,
-		0xff, 0x18, 0xf1, 0x54, 0x42, 0x52, 0x41, 0x8f, 0x01, 0x22, 0x14, 0xda, 0x1e, 0x56, 0xc9, 0xd4,
-		0xa5, 0xe2, 0x15, 0x7c, 0x8b, 0x1d, 0xed, 0xa0, 0xbc, 0x87, 0x37, 0x7a, 0x1d, 0xda, 0x00, 0x07,
-		0xad, 0x83, 0x24, 0x90, 0xc5, 0xc8, 0x72, 0xf1, 0x9f, 0x1e, 0x7c, 0xb4, 0x95, 0x23, 0xb0, 0x05,
-		0xe0, 0x63, 0x64, 0x01, 0x28, 0x30, 0x7e, 0xde, 0xf1, 0x93, 0x50, 0x57, 0x62, 0xe1, 0xae, 0xc8,
-		0x2f, 0x42, 0xb1, 0x6f, 0x04, 0x90, 0x0c, 0x79, 0x9e, 0xb1, 0xa2, 0x5b, 0xfb, 0x2c, 0xc2, 0xc9,
-		0x28, 0x59, 0x96, 0x99, 0xa2, 0x47, 0x1d, 0x96, 0xd3, 0x3f, 0xf7, 0xc9, 0x85, 0x08, 0xdd, 0xf6,
-		0x79, 0x0c, 0xf2, 0xa1, 0x31, 0x10, 0x59, 0xe4, 0x88, 0x9f, 0x45, 0xf6, 0x91, 0x5f, 0x83, 0x1c,
-		0x31, 0x40, 0xb8, 0xc5, 0x71, 0x1f, 0x81, 0x22, 0x33, 0x90, 0xfd, 0xb2, 0x66, 0x0e, 0xcf, 0xba,
-		0x10, 0xb8, 0x2c, 0x3c, 0xa0, 0xb0, 0xd8, 0xb3, 0x02, 0x6b, 0x55, 0x73, 0xe4, 0x4d, 0x00, 0x7f,
-		0x50, 0x51, 0x19, 0x1e, 0x24, 0xc6, 0x23, 0x78, 0x14, 0x95, 0x3f, 0xb7, 0x15, 0xf2, 0x18, 0x4b,
-		0x04, 0xc9, 0x3f, 0x6e, 0xca, 0x1e, 0xdd, 0xba, 0x4e, 0x31, 0x2a, 0x2f, 0x7f, 0xfa, 0xad, 0xf9,
-		0xc8, 0xbb, 0x63, 0x0b, 0xfe, 0xe3, 0xab, 0x70, 0x26, 0x50, 0x48, 0x14, 0x30, 0x94, 0x88, 0x2a,
-		0x06, 0xf4, 0x95, 0x14, 0x8e, 0x4b, 0x28, 0x1d, 0x99, 0xff, 0x2d, 0x1d, 0x6d, 0x76, 0xc6, 0x67,
-		0x9b, 0xc6, 0xe7, 0xbb, 0x86, 0x27, 0xe5, 0xff, 0x71, 0x06, 0x52, 0x0a, 0xfe, 0x60, 0x0f, 0x3b,
-		0x2e, 0xba, 0x04, 0x71, 0xac, 0xef, 0x5b, 0xc3, 0x72, 0xa0, 0xa4, 0x83, 0x4b, 0x1c, 0xaf, 0xa6,
-		0xef, 0x5b, 0xd7, 0xa7, 0x14, 0x8a, 0x8b, 0x2e, 0x43, 0x62, 0xb7, 0xdd, 0xe3, 0xe9, 0xab, 0x3e,
-		0x9b, 0x15, 0x24, 0xba, 0x46, 0x90, 0xae, 0x4f, 0x29, 0x0c, 0x9b, 0x54, 0x45, 0x5f, 0xdb, 0x8e,
-		0x1d, 0x5d, 0x55, 0xdd, 0xdc, 0xa5, 0x55, 0x11, 0x5c, 0x54, 0x01, 0x30, 0x4c, 0xc3, 0x55, 0x69,
-		0x6a, 0x87, 0x5b, 0x8d, 0xb3, 0xa3, 0x29, 0x0d, 0x97, 0x26, 0x83, 0xae, 0x4f, 0x29, 0x19, 0x43,
-		0x7c, 0x90, 0xe6, 0x7e, 0xb0, 0x87, 0xed, 0x43, 0xee, 0xad, 0x8d, 0x6c, 0xee, 0xcb, 0x04, 0x89,
-		0x34, 0x97, 0x62, 0x13, 0x23, 0xcb, 0x1e, 0xee, 0x73, 0x0f, 0xf8, 0x73, 0xb4, 0x0b, 0xa3, 0x28,
-		0xe9, 0xeb, 0x7d, 0xcd, 0x83, 0xeb, 0x53, 0x4a, 0x4a, 0x67, 0x3f, 0xd1, 0x73, 0x9e, 0x0b, 0x96,
-		0x1d, 0xf4, 0x7a, 0x42, 0xb4, 0x2c, 0xed, 0x33, 0x25, 0x5c, 0x31, 0xb4, 0x01, 0x85, 0xb6, 0xe1,
-		0xb8, 0xaa, 0x63, 0x6a, 0x5d, 0x67, 0xdf, 0x72, 0x1d, 0x9a, 0x3f, 0xc9, 0x5e, 0x7a, 0x78, 0x14,
-		0x87, 0x35, 0xc3, 0x71, 0x1b, 0x02, 0xf9, 0xfa, 0x94, 0x92, 0x6f, 0x07, 0x01, 0x84, 0x9f, 0xb5,
-		0xbb, 0x8b, 0x6d, 0x8f, 0x21, 0xcd, 0xb3, 0x1c, 0xc1, 0x6f, 0x93, 0x60, 0x0b, 0x7a, 0xc2, 0xcf,
-		0x0a, 0x02, 0xd0, 0xd7, 0xc0, 0x4c, 0xdb, 0xd2, 0x5a, 0x1e, 0x3b, 0x55, 0xdf, 0xef, 0x99, 0xb7,
-		0x68, 0x52, 0x26, 0x7b, 0xe9, 0xd1, 0x91, 0x8d, 0xb4, 0xb4, 0x96, 0x60, 0x51, 0x25, 0x04, 0xd7,
-		0xa7, 0x94, 0xe9, 0x76, 0x3f, 0x10, 0x7d, 0x00, 0x66, 0xb5, 0x6e, 0xb7, 0x7d, 0xd8, 0xcf, 0xbd,
-		0x48, 0xb9, 0x5f, 0x18, 0xc5, 0xbd, 0x4c, 0x68, 0xfa, 0xd9, 0x23, 0x6d, 0x00, 0x8a, 0x9a, 0x20,
-		0x75, 0x6d, 0x4c, 0x6f, 0x73, 0x75, 0xb9, 0x97, 0x41, 0xdf, 0xba, 0xca, 0x5e, 0x3a, 0x37, 0x8a,
-		0xf7, 0x16, 0xc3, 0x17, 0x4e, 0xc9, 0xf5, 0x29, 0xa5, 0xd8, 0x0d, 0x83, 0x18, 0x57, 0x4b, 0xc7,
-		0xf4, 0x29, 0x3e, 0xce, 0x75, 0x7a, 0x1c, 0x57, 0x8a, 0x1f, 0xe6, 0x1a, 0x02, 0xa1, 0x1a, 0x64,
-		0x59, 0x28, 0xaa, 0x12, 0x63, 0x48, 0x5f, 0xc8, 0xca, 0x5e, 0x92, 0x47, 0xce, 0x50, 0x8a, 0x7a,
-		0xd3, 0x72, 0xf1, 0xf5, 0x29, 0x05, 0xb0, 0xf7, 0x85, 0x34, 0x38, 0x41, 0x1f, 0x0b, 0x3b, 0x54,
-		0xc3, 0x86, 0x77, 0x6e, 0x86, 0x32, 0x7c, 0x6c, 0x14, 0xc3, 0x9b, 0x94, 0xe8, 0x66, 0xd0, 0x0e,
-		0x5f, 0x9f, 0x52, 0x66, 0x6e, 0x0f, 0x82, 0x89, 0x8a, 0xed, 0x1a, 0xa6, 0xd6, 0x36, 0xde, 0xc0,
-		0x6c, 0x09, 0xa5, 0xcf, 0x64, 0x1e, 0xa1, 0x62, 0xd7, 0x38, 0x36, 0x5d, 0x58, 0x89, 0x8a, 0xed,
-		0x06, 0x01, 0x95, 0x14, 0x3f, 0x87, 0xea, 0x3d, 0xfb, 0x96, 0x92, 0xd2, 0xec, 0xa9, 0xb7, 0x1b,
-		0xf1, 0x34, 0x48, 0x59, 0xf9, 0x1c, 0x64, 0x03, 0x86, 0x09, 0xcd, 0x41, 0x8a, 0x9f, 0xd4, 0x11,
-		0x27, 0x5a, 0xf9, 0xa7, 0x5c, 0x80, 0x5c, 0xd0, 0x18, 0xc9, 0x1f, 0x8d, 0x78, 0x94, 0xf4, 0xbd,
-		0x8b, 0xb9, 0x70, 0x46, 0x3a, 0xe3, 0x7b, 0x2a, 0x0f, 0x89, 0xa5, 0x4d, 0x94, 0xb3, 0xed, 0xd7,
-		0x1c, 0x05, 0xf2, 0x95, 0x15, 0x2d, 0x40, 0xb6, 0x7b, 0xa9, 0xeb, 0xa1, 0xc4, 0x28, 0x0a, 0x74,
-		0x2f, 0x75, 0x05, 0xc2, 0x59, 0xc8, 0x91, 0x7e, 0xab, 0x41, 0x7f, 0x29, 0xa3, 0x64, 0x09, 0x8c,
-		0xa3, 0xc8, 0xff, 0x2a, 0x0a, 0x52, 0xbf, 0x01, 0xf3, 0x52, 0xd5, 0x91, 0x63, 0xa7, 0xaa, 0x4f,
-		0xf7, 0x27, 0xc9, 0xfd, 0xbc, 0xf8, 0x1a, 0x48, 0x7e, 0x7a, 0x97, 0x2d, 0x35, 0xa3, 0xfd, 0xbf,
-		0x3e, 0x47, 0x55, 0x29, 0xea, 0x7d, 0x9e, 0xeb, 0xb5, 0xd0, 0x66, 0xa5, 0xf8, 0x73, 0x14, 0xfd,
-		0x03, 0xee, 0x39, 0x31, 0xdb, 0xdd, 0x96, 0xe6, 0x62, 0x91, 0x1f, 0x0b, 0xec, 0x5b, 0x3e, 0x02,
-		0x45, 0xad, 0xdb, 0x55, 0x1d, 0x57, 0x73, 0x31, 0xf7, 0x35, 0x58, 0xe6, 0x21, 0xaf, 0x75, 0xbb,
-		0x0d, 0x02, 0x65, 0xbe, 0xc6, 0xc3, 0x50, 0x20, 0x36, 0xdd, 0xd0, 0xda, 0xc2, 0x55, 0x48, 0x32,
-		0x97, 0x84, 0x43, 0x99, 0x77, 0x20, 0xb7, 0xbc, 0x11, 0xa7, 0xf6, 0xdc, 0x8b, 0xa9, 0x22, 0x81,
-		0x98, 0x0a, 0xf1, 0x77, 0x48, 0x98, 0x7c, 0xc4, 0xdb, 0x2d, 0xc3, 0x37, 0x0d, 0x66, 0x69, 0xfc,
-		0x75, 0x9b, 0x25, 0x4b, 0xd2, 0x0a, 0xfb, 0x90, 0x15, 0x28, 0x84, 0x6d, 0x3f, 0x2a, 0x40, 0xd4,
-		0x3d, 0xe0, 0xb5, 0x44, 0xdd, 0x03, 0xe2, 0xe9, 0x7a, 0xaf, 0xf7, 0x16, 0x86, 0xac, 0x76, 0x9c,
-		0xce, 0xcf, 0x75, 0xc8, 0x45, 0xc8, 0x87, 0xd6, 0x04, 0xf9, 0x24, 0xcc, 0x0e, 0x33, 0xf1, 0xf2,
-		0xbe, 0x07, 0x0f, 0x99, 0x6a, 0x74, 0x19, 0xd2, 0x9e, 0x8d, 0x1f, 0x12, 0x1d, 0xd3, 0x6a, 0x05,
-		0xb2, 0xe2, 0xa1, 0x86, 0xd2, 0xf9, 0xd1, 0x50, 0x3a, 0x5f, 0xfe, 0x7a, 0x98, 0x1b, 0x65, 0xbf,
-		0xfb, 0x92, 0x7c, 0x71, 0x4f, 0x60, 0x27, 0x21, 0xc9, 0x9f, 0xc9, 0x8c, 0xd2, 0x0d, 0x2c, 0xfe,
-		0x45, 0x04, 0xc9, 0x6c, 0x79, 0x8c, 0xed, 0x6b, 0xd1, 0x0f, 0x59, 0x85, 0xd3, 0x23, 0x6d, 0xf8,
-		0xe8, 0xad, 0x30, 0xc6, 0x88, 0x6f, 0x85, 0xe9, 0xa2, 0x39, 0x0e, 0xed, 0xab, 0x38, 0xc8, 0xc2,
-		0xbe, 0xe4, 0x8f, 0xc5, 0xe0, 0xe4, 0x70, 0x4b, 0x8e, 0x16, 0x21, 0x47, 0xfc, 0x56, 0x37, 0xec,
-		0xe2, 0x42, 0x47, 0x3b, 0x68, 0x72, 0xff, 0x96, 0x6f, 0x25, 0x44, 0xbd, 0xad, 0x04, 0xb4, 0x0d,
-		0xd3, 0x6d, 0x4b, 0xd7, 0xda, 0x6a, 0x60, 0x2b, 0x87, 0x4f, 0xa2, 0x87, 0x06, 0x84, 0x1d, 0x4e,
-		0x19, 0x12, 0x83, 0xc3, 0xf5, 0xbf, 0x48, 0x79, 0xac, 0x79, 0xbb, 0x3e, 0x68, 0x05, 0xb2, 0x1d,
-		0xc3, 0xd9, 0xc1, 0xfb, 0xda, 0x6d, 0xc3, 0xb2, 0xf9, 0x6c, 0x1a, 0x54, 0x9a, 0x75, 0x1f, 0x47,
-		0xec, 0x30, 0x05, 0xc8, 0x02, 0x43, 0x92, 0x18, 0xba, 0xf1, 0x95, 0x3c, 0xb6, 0x35, 0x19, 0xb5,
-		0x87, 0x94, 0x1a, 0xb9, 0x87, 0x34, 0x6c, 0xc3, 0x26, 0x3d, 0x7c, 0xc3, 0xe6, 0x23, 0xc1, 0xa1,
-		0x09, 0xaf, 0x7d, 0x03, 0x7b, 0x38, 0xa8, 0x01, 0xb3, 0x9c, 0xbe, 0x15, 0x92, 0xfd, 0x90, 0xa3,
-		0x0e, 0x6c, 0x7e, 0xf5, 0xcb, 0x1c, 0x09, 0xf2, 0xd1, 0x62, 0x8f, 0xdd, 0x9f, 0xd8, 0xc5, 0x26,
-		0x6a, 0x3c, 0xb0, 0x89, 0xfa, 0xff, 0xd8, 0x50, 0x7c, 0x38, 0x06, 0xd3, 0x03, 0x8e, 0xc4, 0xd0,
-		0xdd, 0xe1, 0x51, 0x19, 0x59, 0xd1, 0xb1, 0xd8, 0xb1, 0x3b, 0xc6, 0xc7, 0x3a, 0x3e, 0x7e, 0xac,
-		0x13, 0xef, 0xe0, 0x58, 0x27, 0xef, 0x6f, 0xac, 0xdf, 0xd5, 0x51, 0xf8, 0x44, 0x04, 0x4a, 0xa3,
-		0xbd, 0xaf, 0xa1, 0xc3, 0x71, 0xac, 0x0d, 0x84, 0x51, 0x6b, 0xdc, 0xc3, 0x50, 0xe8, 0xf3, 0x0d,
-		0x99, 0x2a, 0xe7, 0x43, 0x51, 0xb8, 0xfc, 0x8d, 0x31, 0x6f, 0xe1, 0x09, 0x39, 0x70, 0x43, 0x66,
-		0xeb, 0xcb, 0x30, 0xd3, 0xc2, 0xba, 0xd1, 0xba, 0xdf, 0xc9, 0x3a, 0xcd, 0xa9, 0xff, 0xff, 0x5c,
-		0x1d, 0xd4, 0x92, 0xef, 0x02, 0x48, 0x2b, 0xd8, 0xe9, 0x12, 0x7f, 0x0c, 0x55, 0x20, 0x83, 0x0f,
-		0x74, 0xdc, 0x75, 0xfd, 0x43, 0x15, 0xc3, 0x42, 0x04, 0x86, 0x5d, 0x13, 0x98, 0x24, 0x40, 0xf6,
-		0xc8, 0xd0, 0xd3, 0x3c, 0x07, 0x30, 0x3a, 0x9c, 0xe7, 0xe4, 0xc1, 0x24, 0xc0, 0x15, 0x91, 0x04,
-		0x88, 0x8d, 0x8c, 0x6f, 0x19, 0x55, 0x5f, 0x16, 0xe0, 0x69, 0x9e, 0x05, 0x88, 0x8f, 0xa9, 0x2c,
-		0x94, 0x06, 0xa8, 0x86, 0xd2, 0x00, 0xc9, 0x31, 0xdd, 0x1c, 0x91, 0x07, 0xb8, 0x22, 0xf2, 0x00,
-		0xa9, 0x31, 0x2d, 0xee, 0x4b, 0x04, 0xbc, 0x10, 0x48, 0x04, 0x64, 0x06, 0xb3, 0xad, 0x21, 0xd2,
-		0x21, 0x99, 0x80, 0xe7, 0xbd, 0x4c, 0x40, 0x6e, 0x64, 0x16, 0x81, 0x13, 0xf7, 0xa7, 0x02, 0x36,
-		0x07, 0x52, 0x01, 0x79, 0xfe, 0x47, 0x97, 0x46, 0xb1, 0x18, 0x93, 0x0b, 0xd8, 0x1c, 0xc8, 0x05,
-		0x14, 0xc6, 0x30, 0x1c, 0x93, 0x0c, 0xf8, 0xda, 0xe1, 0xc9, 0x80, 0xd1, 0xe1, 0x3a, 0x6f, 0xe6,
-		0x64, 0xd9, 0x00, 0x75, 0x44, 0x36, 0x40, 0x1a, 0x19, 0xb9, 0x32, 0xf6, 0x13, 0xa7, 0x03, 0xb6,
-		0x87, 0xa4, 0x03, 0x58, 0xe0, 0x7e, 0x7e, 0x24, 0xf3, 0x09, 0xf2, 0x01, 0xdb, 0x43, 0xf2, 0x01,
-		0x68, 0x2c, 0xdb, 0xb1, 0x09, 0x81, 0x6b, 0xe1, 0x84, 0xc0, 0xcc, 0x08, 0xaf, 0xd3, 0x9f, 0xed,
-		0x23, 0x32, 0x02, 0x3b, 0xa3, 0x32, 0x02, 0x2c, 0x6a, 0x7f, 0x7c, 0x24, 0xc7, 0x63, 0xa4, 0x04,
-		0x36, 0x07, 0x52, 0x02, 0x27, 0xc6, 0x68, 0xda, 0xe4, 0x39, 0x01, 0xf6, 0x08, 0x3c, 0x7b, 0xfe,
-		0x1d, 0xa4, 0xec, 0x8d, 0x78, 0x3a, 0x2b, 0xe5, 0xe4, 0x47, 0x89, 0x07, 0xd3, 0x67, 0xe7, 0x48,
-		0xac, 0x80, 0x6d, 0xdb, 0xb2, 0xc5, 0x1d, 0x0b, 0xfa, 0x21, 0x9f, 0x27, 0x31, 0xa2, 0x6f, 0xd3,
-		0x8e, 0xc8, 0x1f, 0xd0, 0x98, 0x2c, 0x60, 0xc7, 0xe4, 0x9f, 0x8b, 0xf8, 0xb4, 0x34, 0x83, 0x10,
-		0x8c, 0x2f, 0x33, 0x3c, 0xbe, 0x0c, 0x64, 0x15, 0xa2, 0xe1, 0xac, 0xc2, 0x02, 0x64, 0x49, 0xac,
-		0xd5, 0x97, 0x30, 0xd0, 0xba, 0x5e, 0xc2, 0x40, 0x1c, 0xa0, 0x62, 0xb9, 0x07, 0xbe, 0x2c, 0xb1,
-		0xdd, 0xc4, 0xa2, 0x77, 0x98, 0x8c, 0x85, 0xba, 0xe8, 0x09, 0x98, 0x09, 0xe0, 0x7a, 0x31, 0x1c,
-		0x8b, 0x9e, 0x25, 0x0f, 0xbb, 0xcc, 0x83, 0xb9, 0x7f, 0x16, 0xf1, 0x25, 0xe4, 0x67, 0x1a, 0x86,
-		0x25, 0x05, 0x22, 0xef, 0x50, 0x52, 0x20, 0x7a, 0xdf, 0x49, 0x81, 0x60, 0x4c, 0x1a, 0x0b, 0xc7,
-		0xa4, 0xff, 0x33, 0xe2, 0x8f, 0x89, 0x17, 0xe2, 0xeb, 0x56, 0x0b, 0xf3, 0x28, 0x91, 0xfe, 0x26,
-		0x2e, 0x49, 0xdb, 0xda, 0xe3, 0xb1, 0x20, 0xf9, 0x49, 0xb0, 0xbc, 0x85, 0x27, 0xc3, 0xd7, 0x15,
-		0x2f, 0xc0, 0x4c, 0x04, 0xef, 0x71, 0xf0, 0xcb, 0x0d, 0x49, 0xff, 0x72, 0x83, 0x77, 0x31, 0x3a,
-		0x15, 0xb8, 0x18, 0x8d, 0x9e, 0x83, 0x0c, 0x4d, 0xf6, 0xab, 0x56, 0x57, 0xfc, 0xc5, 0xb2, 0x33,
-		0xa3, 0x2f, 0x36, 0x38, 0xf4, 0xc8, 0x35, 0xbb, 0x0c, 0xe1, 0x7b, 0x1c, 0x99, 0x90, 0xc7, 0xf1,
-		0x00, 0x64, 0x48, 0xeb, 0xd9, 0x5f, 0xde, 0x00, 0x7e, 0xab, 0x5e, 0x00, 0xe4, 0x1f, 0x8f, 0x42,
-		0xb1, 0x6f, 0xa1, 0x19, 0xda, 0xf7, 0x61, 0xdb, 0xc8, 0x93, 0xc9, 0x63, 0x1e, 0x60, 0x4f, 0x73,
-		0xd4, 0x3b, 0x9a, 0xe9, 0xe2, 0x16, 0x17, 0x4a, 0x00, 0x82, 0x4a, 0x90, 0x26, 0x5f, 0x3d, 0x07,
-		0xb7, 0x78, 0xf6, 0xc5, 0xfb, 0x46, 0xd7, 0x21, 0x89, 0x6f, 0xd3, 0x97, 0x67, 0xd9, 0xfb, 0xcd,
-		0x27, 0x07, 0xc3, 0x61, 0x52, 0x5c, 0x99, 0x23, 0x83, 0xfd, 0x85, 0x37, 0x17, 0x24, 0x86, 0xfd,
-		0xb8, 0x77, 0x99, 0x4d, 0xe1, 0xf4, 0x61, 0x29, 0xa4, 0xfb, 0xa4, 0x40, 0xf3, 0x80, 0x39, 0x11,
-		0xde, 0x13, 0x99, 0xb2, 0x33, 0xde, 0x4a, 0xbe, 0x83, 0x3b, 0x5d, 0xcb, 0x6a, 0xab, 0x6c, 0x8e,
-		0x97, 0xa1, 0x10, 0x5e, 0x57, 0xd9, 0x5f, 0xc5, 0x72, 0x35, 0xc3, 0x54, 0x43, 0x4e, 0x70, 0x8e,
-		0x01, 0xd9, 0x9c, 0xba, 0x11, 0x4f, 0x47, 0xa4, 0xe8, 0x8d, 0x78, 0x3a, 0x2a, 0xc5, 0xe4, 0x2d,
-		0x38, 0x31, 0x74, 0x5d, 0x45, 0xcf, 0x42, 0xc6, 0x5f, 0x92, 0xd9, 0x31, 0xfd, 0x23, 0x32, 0x2d,
-		0x3e, 0xae, 0xfc, 0x8b, 0x11, 0x9f, 0x65, 0x38, 0x77, 0x53, 0x83, 0x24, 0x3b, 0x33, 0xc9, 0xcf,
-		0xc7, 0x3c, 0x31, 0xd9, 0x8a, 0xbc, 0xc4, 0x0e, 0x54, 0x2a, 0x9c, 0x58, 0xfe, 0x00, 0x24, 0x19,
-		0x04, 0x65, 0x21, 0xe5, 0xbf, 0x71, 0x0d, 0x90, 0x2c, 0x57, 0xab, 0xb5, 0x2d, 0xf1, 0x60, 0x6d,
-		0x65, 0x53, 0x69, 0x4a, 0x51, 0x02, 0x56, 0x6a, 0x37, 0x6a, 0xd5, 0xa6, 0x14, 0x43, 0xd3, 0x90,
-		0x67, 0xbf, 0xd5, 0x6b, 0x9b, 0xca, 0x7a, 0xb9, 0x29, 0xc5, 0x03, 0xa0, 0x46, 0x6d, 0x63, 0xa5,
-		0xa6, 0x48, 0x09, 0xf9, 0x29, 0x38, 0x3d, 0x72, 0x0d, 0xf7, 0x13, 0x33, 0x91, 0x40, 0x62, 0x46,
-		0xfe, 0x58, 0x94, 0x04, 0x35, 0xa3, 0x16, 0x66, 0x74, 0xa3, 0xaf, 0xe3, 0x97, 0x8e, 0xb1, 0xaa,
-		0xf7, 0xf5, 0x9e, 0xc4, 0x31, 0x36, 0xde, 0xc5, 0xae, 0xbe, 0xcf, 0x1c, 0x05, 0x66, 0x81, 0xf2,
-		0x4a, 0x9e, 0x43, 0x29, 0x91, 0xc3, 0xd0, 0x5e, 0xc7, 0xba, 0xab, 0x32, 0x25, 0x72, 0xf8, 0x1f,
-		0xf1, 0xcd, 0x33, 0x68, 0x83, 0x01, 0xe5, 0xaf, 0x3f, 0x96, 0x2c, 0x33, 0x90, 0x50, 0x6a, 0x4d,
-		0xe5, 0x55, 0x29, 0x86, 0x10, 0x14, 0xe8, 0x4f, 0xb5, 0xb1, 0x51, 0xde, 0x6a, 0x5c, 0xdf, 0x24,
-		0xb2, 0x9c, 0x81, 0xa2, 0x90, 0xa5, 0x00, 0x26, 0xe4, 0xc7, 0xe0, 0xd4, 0x08, 0xaf, 0x62, 0xc8,
-		0x21, 0xd6, 0x4f, 0x45, 0x82, 0xd8, 0x61, 0xcf, 0x60, 0x13, 0x92, 0x8e, 0xab, 0xb9, 0x3d, 0x87,
-		0x0b, 0xf1, 0xd9, 0x49, 0xdd, 0x8c, 0x25, 0xf1, 0xa3, 0x41, 0xc9, 0x15, 0xce, 0x46, 0xbe, 0x0c,
-		0x85, 0x70, 0xc9, 0x68, 0x19, 0xf8, 0x4a, 0x14, 0x95, 0xaf, 0x02, 0x1a, 0xf4, 0x3e, 0x86, 0x84,
-		0x97, 0x91, 0x61, 0xe1, 0xe5, 0x0f, 0x47, 0xe0, 0xcc, 0x11, 0x9e, 0x06, 0x7a, 0xb9, 0xaf, 0x93,
-		0xcf, 0x1f, 0xc7, 0x4f, 0x59, 0x62, 0xb0, 0xbe, 0x6e, 0x3e, 0x0d, 0xb9, 0x20, 0x7c, 0xb2, 0x4e,
-		0x7e, 0x21, 0xea, 0x4f, 0xe2, 0x70, 0x1c, 0xec, 0x9b, 0xc0, 0xc8, 0x97, 0x69, 0x02, 0xdf, 0x0b,
-		0xe0, 0x1e, 0x88, 0x53, 0xd4, 0x7c, 0x1d, 0x7d, 0x70, 0x48, 0x7e, 0x11, 0xeb, 0xcd, 0x03, 0x3e,
-		0x09, 0x32, 0x2e, 0xff, 0xe5, 0xa0, 0x46, 0x30, 0x29, 0xd0, 0xa3, 0x6b, 0xac, 0xe3, 0xfd, 0x09,
-		0xdf, 0xc9, 0x16, 0x63, 0x3f, 0x79, 0xc0, 0xc0, 0x0e, 0x7a, 0x15, 0x4e, 0xf5, 0x39, 0x0a, 0x1e,
-		0xeb, 0xf8, 0xa4, 0xfe, 0xc2, 0x89, 0xb0, 0xbf, 0x20, 0x58, 0x07, 0x57, 0xfb, 0x44, 0x78, 0xb5,
-		0x7f, 0x15, 0xc0, 0x4f, 0x0e, 0xf8, 0xc7, 0xfe, 0x22, 0xc1, 0x63, 0x7f, 0x97, 0x21, 0x41, 0x34,
-		0x49, 0xc8, 0x69, 0xd0, 0x14, 0x13, 0x4d, 0x08, 0x24, 0x17, 0x18, 0xb6, 0x6c, 0x00, 0x1a, 0x4c,
-		0xd0, 0x8e, 0xa8, 0xe2, 0x85, 0x70, 0x15, 0x67, 0x47, 0xa6, 0x7a, 0x87, 0x57, 0xf5, 0x06, 0x24,
-		0xe8, 0xc8, 0x0f, 0xbd, 0x4b, 0xf9, 0x75, 0x00, 0x9a, 0xeb, 0xda, 0xc6, 0x4e, 0xcf, 0xaf, 0x60,
-		0x61, 0xb8, 0xe6, 0x94, 0x05, 0x5e, 0xe5, 0x01, 0xae, 0x42, 0xb3, 0x3e, 0x69, 0x40, 0x8d, 0x02,
-		0x0c, 0xe5, 0x0d, 0x28, 0x84, 0x69, 0x87, 0xdf, 0x0d, 0xf5, 0x1f, 0x7e, 0xc9, 0x08, 0xff, 0xc6,
-		0xf3, 0x8e, 0xf8, 0x63, 0x4c, 0xf4, 0x43, 0xfe, 0x50, 0x14, 0x72, 0x41, 0xc5, 0xfb, 0x8b, 0xe7,
-		0x82, 0xc8, 0xdf, 0x14, 0x81, 0xb4, 0xd7, 0xfd, 0x23, 0x0e, 0x3b, 0xfb, 0x77, 0x84, 0xbd, 0xcd,
-		0x0b, 0xb6, 0x4d, 0x14, 0xf3, 0xb6, 0x89, 0xae, 0x7a, 0xcb, 0xdf, 0xa8, 0x84, 0x48, 0x50, 0xd6,
-		0xe2, 0xc0, 0x21, 0x5f, 0xed, 0xaf, 0x4e, 0x76, 0x15, 0x6f, 0x16, 0x12, 0xc1, 0x6b, 0x74, 0xec,
-		0x43, 0x6e, 0x05, 0x4e, 0x18, 0xb1, 0x89, 0x18, 0xbc, 0xb3, 0x17, 0x39, 0xf6, 0x9d, 0x3d, 0xaf,
-		0x96, 0x68, 0xb0, 0x96, 0xef, 0x8c, 0x40, 0x5a, 0xcc, 0x09, 0xf4, 0xbe, 0xe0, 0xc9, 0x33, 0xb1,
-		0xb5, 0x39, 0xd2, 0x1e, 0x71, 0xfe, 0x81, 0x83, 0x67, 0x03, 0x07, 0xae, 0x63, 0xc7, 0x3d, 0x70,
-		0xcd, 0x3d, 0xbb, 0x3f, 0x8e, 0x80, 0xd4, 0x3f, 0x63, 0xbf, 0xec, 0xd6, 0x0d, 0x2e, 0x73, 0xb1,
-		0x21, 0xcb, 0xdc, 0xa8, 0x53, 0xd4, 0xf1, 0x51, 0xa7, 0xa8, 0x07, 0x7b, 0x9d, 0xb8, 0xcf, 0x5e,
-		0x7f, 0x38, 0x0a, 0xd9, 0x40, 0x7a, 0x14, 0x3d, 0x13, 0x3a, 0x91, 0xbd, 0x78, 0x54, 0x2a, 0x35,
-		0x70, 0x24, 0x3b, 0x24, 0xa6, 0xe8, 0xf1, 0xc5, 0xf4, 0xce, 0xdf, 0xce, 0x1a, 0x7e, 0x81, 0x35,
-		0x31, 0xe2, 0x02, 0xeb, 0x5f, 0x8d, 0x40, 0xda, 0x73, 0xbb, 0x8f, 0xbb, 0x89, 0x79, 0x12, 0x92,
-		0xdc, 0xb3, 0x64, 0xbb, 0x98, 0xfc, 0x6b, 0x68, 0x5a, 0xb9, 0x04, 0x69, 0xf1, 0xf7, 0x14, 0xf9,
-		0xaa, 0xe6, 0x7d, 0x5f, 0x78, 0x1e, 0xb2, 0x81, 0x0d, 0x60, 0x62, 0x1a, 0x37, 0x6a, 0xef, 0x97,
-		0xa6, 0x4a, 0xa9, 0xbb, 0xf7, 0x16, 0x63, 0x1b, 0xf8, 0x0e, 0x99, 0xcd, 0x4a, 0xad, 0x7a, 0xbd,
-		0x56, 0x7d, 0x49, 0x8a, 0x94, 0xb2, 0x77, 0xef, 0x2d, 0xa6, 0x14, 0x4c, 0x33, 0x8a, 0x17, 0x5e,
-		0x82, 0x62, 0xdf, 0xc0, 0x84, 0xdd, 0x16, 0x04, 0x85, 0x95, 0xed, 0xad, 0xb5, 0x7a, 0xb5, 0xdc,
-		0xac, 0xa9, 0xec, 0xdc, 0x2e, 0x3a, 0x05, 0x33, 0x6b, 0xf5, 0xd5, 0xeb, 0x4d, 0xb5, 0xba, 0x56,
-		0xaf, 0x6d, 0x34, 0xd5, 0x72, 0xb3, 0x59, 0xae, 0xbe, 0x24, 0x45, 0x2f, 0xdd, 0xcb, 0x42, 0xbc,
-		0x5c, 0xa9, 0xd6, 0x51, 0x15, 0xe2, 0x34, 0x15, 0x72, 0xe4, 0x09, 0xb0, 0xd2, 0xd1, 0xb9, 0x61,
-		0x74, 0x0d, 0x12, 0x34, 0x4b, 0x82, 0x8e, 0x3e, 0x12, 0x56, 0x1a, 0x93, 0x2c, 0x26, 0x8d, 0xa1,
-		0x33, 0xf2, 0xc8, 0x33, 0x62, 0xa5, 0xa3, 0x73, 0xc7, 0x68, 0x0d, 0x52, 0x22, 0x48, 0x1e, 0x77,
-		0x70, 0xab, 0x34, 0x36, 0xa1, 0x4b, 0xba, 0xc6, 0x92, 0x0d, 0x47, 0x1f, 0x1f, 0x2b, 0x8d, 0xc9,
-		0x2a, 0xa3, 0xba, 0x77, 0x9f, 0x69, 0xcc, 0x89, 0xb0, 0xd2, 0xb8, 0x3c, 0x31, 0x52, 0x20, 0xe3,
-		0xa7, 0x71, 0xc6, 0x1f, 0x8a, 0x2b, 0x4d, 0x90, 0x30, 0x47, 0x1f, 0x80, 0x7c, 0x38, 0xd4, 0x9d,
-		0xec, 0xd4, 0x59, 0x69, 0xc2, 0x8c, 0x34, 0xe1, 0x1f, 0x8e, 0x7b, 0x27, 0x3b, 0x85, 0x56, 0x9a,
-		0x30, 0x41, 0x8d, 0x5e, 0x87, 0xe9, 0xc1, 0xb8, 0x74, 0xf2, 0x43, 0x69, 0xa5, 0x63, 0xa4, 0xac,
-		0x51, 0x07, 0xd0, 0x90, 0x78, 0xf6, 0x18, 0x67, 0xd4, 0x4a, 0xc7, 0xc9, 0x60, 0xa3, 0x16, 0x14,
-		0xfb, 0x83, 0xc4, 0x49, 0xcf, 0xac, 0x95, 0x26, 0xce, 0x66, 0xb3, 0x5a, 0xc2, 0xc1, 0xe5, 0xa4,
-		0x67, 0xd8, 0x4a, 0x13, 0x27, 0xb7, 0xd1, 0x36, 0x40, 0x20, 0x3e, 0x9c, 0xe0, 0x4c, 0x5b, 0x69,
-		0x92, 0x34, 0x37, 0xea, 0xc2, 0xcc, 0xb0, 0xc0, 0xf1, 0x38, 0x47, 0xdc, 0x4a, 0xc7, 0xca, 0x7e,
-		0x13, 0x7d, 0x0e, 0x87, 0x80, 0x93, 0x1d, 0x79, 0x2b, 0x4d, 0x98, 0x06, 0xaf, 0x94, 0x47, 0x9e,
-		0x73, 0x3e, 0x77, 0xe4, 0x39, 0x67, 0xff, 0xe4, 0xb2, 0x77, 0xb6, 0xf9, 0xdf, 0x5e, 0x84, 0xf7,
-		0xf0, 0x17, 0x74, 0x1c, 0x57, 0xbb, 0x65, 0x98, 0x7b, 0xde, 0x33, 0x4b, 0xfc, 0x9b, 0x1f, 0x72,
-		0x3e, 0xc9, 0x5f, 0xe9, 0x11, 0xd0, 0x31, 0x8f, 0x2d, 0x8d, 0x7c, 0x81, 0x72, 0xdc, 0x7d, 0x88,
-		0xf1, 0xc7, 0x97, 0x8f, 0x78, 0xc8, 0x69, 0xcc, 0x73, 0x51, 0x43, 0x1e, 0x7a, 0x2a, 0x1d, 0xf9,
-		0xc6, 0x40, 0xe9, 0xa8, 0x73, 0xdf, 0xc4, 0x8b, 0x2d, 0x5c, 0x37, 0x1c, 0xd7, 0xb2, 0x0d, 0x5d,
-		0x6b, 0xd3, 0xe5, 0xe5, 0xea, 0xa4, 0xb7, 0xb2, 0x2a, 0x19, 0xe2, 0xae, 0xf0, 0xb7, 0xa0, 0xf8,
-		0x4d, 0xa1, 0x15, 0x48, 0xde, 0xd6, 0xda, 0xec, 0x4e, 0x54, 0xf0, 0x21, 0xb8, 0x7e, 0x99, 0x07,
-		0xfc, 0xa8, 0x20, 0x17, 0x46, 0x2b, 0x7f, 0x37, 0xbd, 0x4f, 0xd2, 0xe9, 0x18, 0x0e, 0xd1, 0x45,
-		0x85, 0x86, 0xd2, 0x37, 0x20, 0x6e, 0x6b, 0x2e, 0x0f, 0x30, 0x2b, 0x57, 0x8e, 0xfd, 0x2e, 0x13,
-		0xab, 0x81, 0xf2, 0x40, 0x2f, 0x43, 0xba, 0xa3, 0x1d, 0xa8, 0x94, 0x5f, 0xf4, 0xcb, 0xe2, 0x97,
-		0xea, 0x68, 0x07, 0xa4, 0x7d, 0xe8, 0x03, 0x50, 0x24, 0x2c, 0xf5, 0x7d, 0xcd, 0xdc, 0xc3, 0x8c,
-		0x73, 0xec, 0xcb, 0xe2, 0x9c, 0xef, 0x68, 0x07, 0x55, 0xca, 0x8d, 0xf0, 0xe7, 0x4f, 0x5e, 0xfd,
-		0x72, 0x84, 0x67, 0x0d, 0xa8, 0x60, 0x90, 0x06, 0x92, 0xee, 0x7d, 0xd1, 0x4a, 0xc5, 0x16, 0xc7,
-		0xb9, 0x51, 0x72, 0xef, 0x13, 0x6b, 0x25, 0x4f, 0x9a, 0xf7, 0xd9, 0x37, 0x17, 0x22, 0xac, 0xd6,
-		0xa2, 0x3e, 0x20, 0xf6, 0x2c, 0x4b, 0x86, 0xa8, 0xd4, 0x87, 0x8d, 0x8e, 0xf5, 0x61, 0xf3, 0xc2,
-		0x87, 0x65, 0x0c, 0x81, 0x51, 0x93, 0x72, 0xde, 0x87, 0x4f, 0x47, 0x20, 0xbb, 0x12, 0x78, 0xd6,
-		0x71, 0x0e, 0x52, 0x1d, 0xcb, 0x34, 0x6e, 0x61, 0xdb, 0xdb, 0xa3, 0x62, 0x9f, 0xc4, 0xcf, 0x64,
-		0x7f, 0x1d, 0xd0, 0x3d, 0x14, 0xaf, 0x0b, 0x89, 0x6f, 0x42, 0x75, 0x07, 0xef, 0x38, 0x86, 0x90,
-		0xb3, 0x22, 0x3e, 0xd1, 0xa3, 0x20, 0x39, 0x58, 0xef, 0xd9, 0x86, 0x7b, 0xa8, 0xea, 0x96, 0xe9,
-		0x6a, 0xba, 0xcb, 0xe3, 0xf0, 0xa2, 0x80, 0x57, 0x19, 0x98, 0x30, 0x69, 0x61, 0x57, 0x33, 0xda,
-		0xec, 0x64, 0x66, 0x46, 0x11, 0x9f, 0xbc, 0xa9, 0x77, 0x53, 0xc1, 0x38, 0xb4, 0x0a, 0x92, 0xd5,
-		0xc5, 0x76, 0xe8, 0x40, 0x0a, 0xd3, 0xc6, 0xb9, 0x5f, 0xff, 0xcc, 0x13, 0xb3, 0x5c, 0xe0, 0xfc,
-		0x30, 0x03, 0xfb, 0x2b, 0x06, 0x4a, 0x51, 0x50, 0x88, 0x93, 0x2a, 0xaf, 0x86, 0x76, 0xa5, 0x7a,
-		0x3b, 0xfe, 0x33, 0x32, 0xb3, 0x03, 0x42, 0x2d, 0x9b, 0x87, 0x95, 0xb9, 0x5f, 0xf3, 0x59, 0xfb,
-		0x71, 0xea, 0x4b, 0xf8, 0x30, 0xb8, 0x45, 0x45, 0xd9, 0x10, 0x17, 0xfe, 0x75, 0xcd, 0x68, 0x8b,
-		0x3f, 0xa4, 0xaa, 0xf0, 0x2f, 0xb4, 0xec, 0xa5, 0x17, 0xe3, 0x34, 0x1e, 0x92, 0x47, 0xe9, 0x46,
-		0xc5, 0x32, 0x5b, 0xe1, 0x3c, 0x22, 0xaa, 0x42, 0xd2, 0xb5, 0x6e, 0x61, 0x93, 0x0b, 0xe8, 0x78,
-		0x4f, 0xab, 0x71, 0x52, 0xf4, 0xb5, 0x20, 0xb5, 0x70, 0x1b, 0xef, 0xb1, 0xfb, 0x92, 0xfb, 0x9a,
-		0x8d, 0xd9, 0x6d, 0xfd, 0xfb, 0x7a, 0x5e, 0xad, 0xe8, 0xb1, 0x6a, 0x50, 0x4e, 0x68, 0x2b, 0xfc,
-		0x70, 0x68, 0x8a, 0x6f, 0x1e, 0x8f, 0xe8, 0x63, 0x40, 0xf3, 0x82, 0x96, 0x27, 0xf4, 0xd0, 0xe8,
-		0xa3, 0x20, 0xf5, 0xcc, 0x1d, 0xcb, 0xa4, 0x7f, 0x7f, 0x90, 0x47, 0x51, 0x69, 0xb6, 0x53, 0xe9,
-		0xc1, 0xf9, 0x4e, 0xe5, 0x16, 0x14, 0x7c, 0x54, 0x3a, 0x43, 0x32, 0xc7, 0x9d, 0x21, 0x79, 0x8f,
-		0x01, 0x41, 0x41, 0xeb, 0x00, 0xfe, 0x1c, 0xa4, 0x5b, 0x65, 0xd9, 0xd1, 0x23, 0xe6, 0xcf, 0xe6,
-		0x60, 0x67, 0x02, 0x0c, 0xd0, 0xd7, 0xc0, 0x4c, 0xc7, 0x30, 0x55, 0x07, 0xb7, 0x77, 0x55, 0x2e,
-		0x39, 0xc2, 0x37, 0x7b, 0xfc, 0xd1, 0x9c, 0xee, 0x18, 0x66, 0x03, 0xb7, 0x77, 0x57, 0x3c, 0x2e,
-		0xe8, 0xbd, 0x70, 0xc6, 0xef, 0xbd, 0x65, 0xaa, 0xfb, 0x56, 0xbb, 0xa5, 0xda, 0x78, 0x57, 0xd5,
-		0xe9, 0x3b, 0x7f, 0x39, 0x2a, 0xb3, 0x53, 0x1e, 0xca, 0xa6, 0x79, 0xdd, 0x6a, 0xb7, 0x14, 0xbc,
-		0x5b, 0x25, 0xc5, 0xe8, 0x21, 0xf0, 0xbb, 0xae, 0x1a, 0x2d, 0x67, 0x2e, 0xbf, 0x18, 0x3b, 0x1f,
-		0x57, 0x72, 0x1e, 0xb0, 0xde, 0x72, 0x96, 0xd3, 0x1f, 0xf9, 0xe4, 0xc2, 0xd4, 0xe7, 0x3f, 0xb9,
-		0x30, 0x25, 0x5f, 0xa3, 0x6f, 0x4a, 0xf1, 0x79, 0x84, 0x1d, 0x74, 0x05, 0x32, 0x9a, 0xf8, 0x60,
-		0x37, 0xc6, 0x8e, 0x98, 0x87, 0x3e, 0xaa, 0xfc, 0x63, 0x11, 0x48, 0xae, 0xdc, 0xdc, 0xd2, 0x0c,
-		0x1b, 0xd5, 0x60, 0xda, 0x57, 0xcc, 0x49, 0xa7, 0xb4, 0xaf, 0xcb, 0x62, 0x4e, 0x6f, 0x8c, 0x3a,
-		0xaa, 0x96, 0xa9, 0x9c, 0xfd, 0xf5, 0xcf, 0x3c, 0xf1, 0x20, 0x67, 0x73, 0xb3, 0xef, 0xd4, 0x9a,
-		0xe0, 0xd7, 0x7f, 0x9a, 0x2d, 0xd0, 0xe7, 0x1b, 0x90, 0x62, 0x4d, 0x75, 0xd0, 0x8b, 0x90, 0xe8,
-		0x92, 0x1f, 0x3c, 0x03, 0x3f, 0x3f, 0x52, 0xc1, 0x29, 0x7e, 0x50, 0x1d, 0x18, 0x9d, 0xfc, 0x2d,
-		0x51, 0x80, 0x95, 0x9b, 0x37, 0x9b, 0xb6, 0xd1, 0x6d, 0x63, 0xf7, 0x9d, 0xea, 0xfb, 0x36, 0x9c,
-		0x08, 0xdc, 0x85, 0xb6, 0xf5, 0xe3, 0xf7, 0x7f, 0xc6, 0xbf, 0x16, 0x6d, 0xeb, 0x43, 0xd9, 0xb6,
-		0x1c, 0xd7, 0x63, 0x1b, 0x3b, 0x3e, 0xdb, 0x15, 0xc7, 0x1d, 0x94, 0xec, 0x2b, 0x90, 0xf5, 0x85,
-		0xe1, 0xa0, 0x3a, 0xa4, 0x5d, 0xfe, 0x9b, 0x0b, 0x58, 0x1e, 0x2d, 0x60, 0x41, 0x16, 0x14, 0xb2,
-		0x47, 0x2e, 0xff, 0x69, 0x04, 0x20, 0x30, 0x47, 0xbe, 0x3a, 0x75, 0x8c, 0x84, 0xeb, 0xdc, 0x12,
-		0xc7, 0xee, 0xfb, 0x35, 0x4e, 0xc6, 0x20, 0x20, 0xd4, 0x6f, 0x8b, 0xc2, 0xcc, 0xb6, 0x98, 0xbd,
-		0x5f, 0xfd, 0x32, 0xd8, 0x86, 0x14, 0x36, 0x5d, 0xdb, 0xf0, 0xf6, 0x90, 0x9e, 0x1c, 0x35, 0xe6,
-		0x43, 0x3a, 0x55, 0x33, 0x5d, 0xfb, 0x30, 0xa8, 0x01, 0x82, 0x57, 0x40, 0x1e, 0x1f, 0x8f, 0xc1,
-		0xdc, 0x28, 0x52, 0x74, 0x0e, 0x8a, 0xba, 0x8d, 0x29, 0x20, 0x7c, 0x45, 0xb4, 0x20, 0xc0, 0x7c,
-		0x8d, 0x51, 0x80, 0x78, 0x65, 0x44, 0xb9, 0x08, 0xea, 0xfd, 0xb9, 0x61, 0x05, 0x9f, 0x03, 0x5d,
-		0x65, 0x9a, 0x50, 0x14, 0x77, 0x4e, 0x76, 0xb4, 0xb6, 0x66, 0xea, 0xc2, 0x5d, 0x3d, 0xd6, 0x92,
-		0x20, 0xee, 0xad, 0x54, 0x18, 0x0b, 0x54, 0x83, 0x94, 0xe0, 0x16, 0x3f, 0x3e, 0x37, 0x41, 0x8b,
-		0xce, 0x42, 0x2e, 0xb8, 0x30, 0x50, 0xd7, 0x23, 0xae, 0x64, 0x03, 0xeb, 0xc2, 0xb8, 0x95, 0x27,
-		0x79, 0xe4, 0xca, 0xc3, 0xbd, 0xbb, 0x1f, 0xa0, 0x47, 0xc3, 0x5b, 0x7f, 0xfe, 0x87, 0x65, 0x0b,
-		0x80, 0x4d, 0x55, 0x62, 0x49, 0xf9, 0xc8, 0xdc, 0xc7, 0x7c, 0xcf, 0x30, 0x26, 0x2b, 0x8e, 0xfb,
-		0x95, 0x1a, 0xa1, 0xdf, 0x8c, 0x42, 0x2e, 0x38, 0x42, 0x7f, 0x21, 0x17, 0x2d, 0xb4, 0xe1, 0x9b,
-		0x29, 0x76, 0x7d, 0xe6, 0xd1, 0x51, 0x66, 0x6a, 0x40, 0x9b, 0xc7, 0xd8, 0xa7, 0x4f, 0xc4, 0x20,
-		0xc9, 0xcf, 0xb7, 0x6d, 0x0e, 0x38, 0xb2, 0x91, 0x71, 0x0f, 0x00, 0xe4, 0xc5, 0x03, 0x00, 0x43,
-		0xfd, 0xd8, 0x87, 0xa1, 0x40, 0x02, 0xe2, 0xd0, 0xa1, 0xb9, 0xc8, 0xf9, 0x3c, 0x8d, 0x6b, 0xfd,
-		0x23, 0xe2, 0x68, 0x01, 0xb2, 0x04, 0xcd, 0xb7, 0xc3, 0x04, 0x07, 0x3a, 0xda, 0x41, 0x8d, 0x41,
-		0xd0, 0x13, 0x80, 0xf6, 0xbd, 0x04, 0x85, 0xea, 0x0b, 0x82, 0xe0, 0x4d, 0xfb, 0x25, 0x02, 0xfd,
-		0x41, 0x00, 0xd2, 0x0a, 0x95, 0x3d, 0x40, 0xcd, 0x9f, 0xf7, 0x26, 0x90, 0x15, 0xfa, 0x08, 0xf5,
-		0x37, 0x46, 0x98, 0x3f, 0xdc, 0x17, 0x36, 0xf3, 0x70, 0xa4, 0x39, 0xc1, 0xa4, 0xf8, 0xa3, 0x37,
-		0x17, 0x4a, 0x87, 0x5a, 0xa7, 0xbd, 0x2c, 0x0f, 0xe1, 0x23, 0x0f, 0x8b, 0xe4, 0x89, 0xe3, 0x1c,
-		0x0e, 0xbb, 0x97, 0xdf, 0x43, 0xd4, 0xfb, 0xee, 0xef, 0xfe, 0xc4, 0x85, 0x33, 0x81, 0x37, 0x9f,
-		0x0f, 0xbc, 0xf4, 0x15, 0x1b, 0x13, 0xe2, 0xa9, 0x22, 0x7f, 0xd5, 0xf0, 0x4e, 0xc6, 0xaf, 0x03,
-		0x04, 0x3c, 0xf9, 0xc8, 0xd1, 0x11, 0x82, 0x4f, 0x1f, 0x8a, 0x10, 0x02, 0x73, 0xea, 0x7d, 0xbe,
-		0xd1, 0x16, 0x6f, 0x3e, 0x0c, 0x79, 0x91, 0x7c, 0xa9, 0x6a, 0x19, 0x21, 0x16, 0x82, 0x88, 0x4e,
-		0xd5, 0x29, 0xf9, 0x5f, 0x47, 0xe0, 0xf4, 0x80, 0xfa, 0x79, 0x4d, 0xd6, 0x01, 0xd9, 0x81, 0x42,
-		0x3a, 0x8c, 0x62, 0x2b, 0xf6, 0xfe, 0xb4, 0x79, 0xda, 0x1e, 0xb0, 0xdc, 0xef, 0xcc, 0xea, 0xc3,
-		0x4d, 0xcf, 0xaf, 0x46, 0x60, 0x36, 0xd8, 0x00, 0xaf, 0x2b, 0x0d, 0xc8, 0x05, 0xab, 0xe6, 0x9d,
-		0x78, 0xcf, 0x24, 0x9d, 0x08, 0xb6, 0x3f, 0xc4, 0x04, 0xdd, 0xf4, 0xa7, 0x38, 0xcb, 0x9b, 0x3d,
-		0x35, 0xb1, 0x50, 0x44, 0xc3, 0x86, 0x4e, 0x75, 0x36, 0x36, 0xbf, 0x17, 0x81, 0xf8, 0x96, 0x65,
-		0xb5, 0xd1, 0x07, 0x61, 0xda, 0xb4, 0x5c, 0x95, 0x4c, 0x07, 0xdc, 0x52, 0x79, 0x60, 0xcf, 0xcc,
-		0x67, 0xed, 0x48, 0x59, 0x7d, 0xe1, 0xcd, 0x85, 0x41, 0xca, 0x61, 0x6f, 0xc2, 0x17, 0x4d, 0xcb,
-		0xad, 0x50, 0xa4, 0x26, 0x8b, 0xfd, 0x77, 0x21, 0x1f, 0xae, 0x8e, 0x99, 0xd8, 0xf2, 0xb8, 0xea,
-		0xf2, 0x63, 0xab, 0xca, 0xed, 0x04, 0xea, 0x61, 0xcf, 0x28, 0xff, 0x01, 0x19, 0xb9, 0x57, 0x41,
-		0xba, 0xd9, 0x7f, 0xc4, 0xa7, 0x06, 0x29, 0x71, 0xa4, 0x27, 0x32, 0xe1, 0x69, 0xa1, 0xa0, 0x38,
-		0x39, 0xed, 0x85, 0x9f, 0x89, 0x00, 0xf8, 0x49, 0x12, 0xf4, 0x38, 0x9c, 0xaa, 0x6c, 0x6e, 0xac,
-		0xa8, 0x8d, 0x66, 0xb9, 0xb9, 0xdd, 0x08, 0xff, 0x25, 0x11, 0xf1, 0xfe, 0x90, 0xd3, 0xc5, 0xba,
-		0xb1, 0x6b, 0xe0, 0x16, 0x7a, 0x04, 0x66, 0xc3, 0xd8, 0xe4, 0xab, 0xb6, 0x22, 0x45, 0x4a, 0xb9,
-		0xbb, 0xf7, 0x16, 0xd3, 0xcc, 0x4f, 0xc4, 0x2d, 0x74, 0x1e, 0x4e, 0x0c, 0xe2, 0xd5, 0x37, 0x56,
-		0xa5, 0x68, 0x29, 0x7f, 0xf7, 0xde, 0x62, 0xc6, 0x73, 0x28, 0x91, 0x0c, 0x28, 0x88, 0xc9, 0xf9,
-		0xc5, 0x4a, 0x70, 0xf7, 0xde, 0x62, 0x92, 0x8d, 0x42, 0x29, 0xfe, 0x91, 0x1f, 0x9a, 0x9f, 0xba,
-		0xf0, 0x75, 0x00, 0x75, 0x73, 0xd7, 0xd6, 0xe8, 0x9f, 0xd3, 0x47, 0x25, 0x38, 0x59, 0xdf, 0xb8,
-		0xa6, 0x94, 0xab, 0xcd, 0xfa, 0xe6, 0x46, 0xdf, 0x1f, 0x40, 0x09, 0x97, 0xad, 0x6c, 0x6e, 0x57,
-		0xd6, 0x6a, 0x6a, 0xa3, 0xbe, 0xba, 0xc1, 0x36, 0x5b, 0x43, 0x65, 0xef, 0xdf, 0x68, 0xd6, 0xd7,
-		0x6b, 0x52, 0xb4, 0x72, 0x6d, 0x64, 0x32, 0xff, 0xf1, 0x23, 0x5f, 0xb9, 0xf7, 0x2d, 0x5e, 0x28,
-		0xa3, 0xff, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x39, 0x56, 0x48, 0x57, 0x54, 0xa1, 0x00, 0x00,
+		// 12676 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x94, 0x1c, 0xc7,
+		0x75, 0x18, 0xbc, 0x3d, 0xaf, 0x9d, 0xb9, 0xf3, 0xea, 0xad, 0x5d, 0x00, 0x83, 0x01, 0x09, 0x2c,
+		0x9b, 0x22, 0x09, 0x82, 0xe4, 0x82, 0x04, 0x09, 0x90, 0x58, 0x8a, 0xe2, 0x37, 0x2f, 0x00, 0x03,
+		0xee, 0xee, 0x2c, 0x7b, 0x76, 0x41, 0x82, 0xb6, 0xd5, 0xee, 0x9d, 0xe9, 0xdd, 0x1d, 0x62, 0xa6,
+		0x7b, 0x38, 0xdd, 0x03, 0x60, 0xf9, 0xc3, 0x47, 0xb6, 0xe4, 0xef, 0x93, 0x29, 0xcb, 0x1f, 0x1d,
+		0xfb, 0xd8, 0xb4, 0x65, 0x58, 0x92, 0x1d, 0x5b, 0xb2, 0x63, 0x27, 0xb6, 0xa4, 0xf8, 0x91, 0x9c,
+		0xf8, 0x11, 0x27, 0xf1, 0x23, 0x4e, 0x8e, 0xec, 0x73, 0x92, 0x38, 0x39, 0xc7, 0x4c, 0x2c, 0xf9,
+		0x88, 0xb4, 0x2c, 0xc7, 0x2f, 0x3a, 0x71, 0x8e, 0x4e, 0x8e, 0x72, 0x6e, 0x3d, 0xfa, 0x31, 0x8f,
+		0x9d, 0x59, 0x90, 0x72, 0x18, 0x3b, 0x7f, 0x76, 0xa7, 0xaa, 0xee, 0xbd, 0x55, 0x75, 0xeb, 0xd6,
+		0xad, 0x5b, 0xb7, 0x6e, 0x55, 0xc3, 0x57, 0x34, 0x58, 0xdc, 0xb1, 0xac, 0x9d, 0xb6, 0x71, 0xba,
+		0xdb, 0xb3, 0x1c, 0x6b, 0xab, 0xbf, 0x7d, 0xba, 0x69, 0xd8, 0x8d, 0x5e, 0xab, 0xeb, 0x58, 0xbd,
+		0x25, 0x9a, 0x47, 0xb2, 0x0c, 0x62, 0x49, 0x40, 0x28, 0xab, 0x30, 0x77, 0xa1, 0xd5, 0x36, 0xca,
+		0x2e, 0x60, 0xdd, 0x70, 0xc8, 0x13, 0x10, 0xd9, 0x6e, 0xb5, 0x8d, 0x9c, 0xb4, 0x18, 0x3e, 0x99,
+		0x3c, 0xf3, 0x9e, 0xa5, 0x01, 0xa4, 0xa5, 0x20, 0xc6, 0x3a, 0x66, 0xab, 0x14, 0x43, 0x79, 0x2d,
+		0x0a, 0xf3, 0x23, 0x4a, 0x09, 0x81, 0x88, 0xa9, 0x77, 0x90, 0xa2, 0x74, 0x32, 0xa1, 0xd2, 0xdf,
+		0x24, 0x07, 0xb3, 0x5d, 0xbd, 0x71, 0x4d, 0xdf, 0x31, 0x72, 0x21, 0x9a, 0x2d, 0x92, 0xe4, 0x38,
+		0x40, 0xd3, 0xe8, 0x1a, 0x66, 0xd3, 0x30, 0x1b, 0x7b, 0xb9, 0xf0, 0x62, 0xf8, 0x64, 0x42, 0xf5,
+		0xe5, 0x90, 0x07, 0x60, 0xae, 0xdb, 0xdf, 0x6a, 0xb7, 0x1a, 0x9a, 0x0f, 0x0c, 0x16, 0xc3, 0x27,
+		0xa3, 0xaa, 0xcc, 0x0a, 0xca, 0x1e, 0xf0, 0x7d, 0x90, 0xbd, 0x61, 0xe8, 0xd7, 0xfc, 0xa0, 0x49,
+		0x0a, 0x9a, 0xc1, 0x6c, 0x1f, 0x60, 0x09, 0x52, 0x1d, 0xc3, 0xb6, 0xf5, 0x1d, 0x43, 0x73, 0xf6,
+		0xba, 0x46, 0x2e, 0x42, 0x7b, 0xbf, 0x38, 0xd4, 0xfb, 0xc1, 0x9e, 0x27, 0x39, 0xd6, 0xc6, 0x5e,
+		0xd7, 0x20, 0x05, 0x48, 0x18, 0x66, 0xbf, 0xc3, 0x28, 0x44, 0xc7, 0xf0, 0xaf, 0x62, 0xf6, 0x3b,
+		0x83, 0x54, 0xe2, 0x88, 0xc6, 0x49, 0xcc, 0xda, 0x46, 0xef, 0x7a, 0xab, 0x61, 0xe4, 0x62, 0x94,
+		0xc0, 0x7d, 0x43, 0x04, 0xea, 0xac, 0x7c, 0x90, 0x86, 0xc0, 0x23, 0x25, 0x48, 0x18, 0x37, 0x1d,
+		0xc3, 0xb4, 0x5b, 0x96, 0x99, 0x9b, 0xa5, 0x44, 0xee, 0x19, 0x31, 0x8a, 0x46, 0xbb, 0x39, 0x48,
+		0xc2, 0xc3, 0x23, 0xe7, 0x60, 0xd6, 0xea, 0x3a, 0x2d, 0xcb, 0xb4, 0x73, 0xf1, 0x45, 0xe9, 0x64,
+		0xf2, 0xcc, 0x1d, 0x23, 0x05, 0xa1, 0xc6, 0x60, 0x54, 0x01, 0x4c, 0xaa, 0x20, 0xdb, 0x56, 0xbf,
+		0xd7, 0x30, 0xb4, 0x86, 0xd5, 0x34, 0xb4, 0x96, 0xb9, 0x6d, 0xe5, 0x12, 0x94, 0xc0, 0x89, 0xe1,
+		0x8e, 0x50, 0xc0, 0x92, 0xd5, 0x34, 0xaa, 0xe6, 0xb6, 0xa5, 0x66, 0xec, 0x40, 0x9a, 0x1c, 0x86,
+		0x98, 0xbd, 0x67, 0x3a, 0xfa, 0xcd, 0x5c, 0x8a, 0x4a, 0x08, 0x4f, 0x91, 0x33, 0x30, 0x6b, 0x34,
+		0x5b, 0x58, 0x5d, 0x2e, 0xb3, 0x28, 0x9d, 0xcc, 0x9c, 0xc9, 0x0d, 0xf3, 0x98, 0x95, 0xab, 0x02,
+		0x50, 0xf9, 0xc5, 0x18, 0x64, 0xa7, 0x11, 0xcb, 0x27, 0x21, 0xba, 0x8d, 0x9c, 0xc9, 0x85, 0x0e,
+		0xc2, 0x37, 0x86, 0x13, 0x64, 0x7c, 0xec, 0x36, 0x19, 0x5f, 0x80, 0xa4, 0x69, 0xd8, 0x8e, 0xd1,
+		0x64, 0x52, 0x14, 0x9e, 0x52, 0x0e, 0x81, 0x21, 0x0d, 0x8b, 0x61, 0xe4, 0xb6, 0xc4, 0xf0, 0x79,
+		0xc8, 0xba, 0x4d, 0xd2, 0x7a, 0xba, 0xb9, 0x23, 0xe4, 0xf9, 0xf4, 0xa4, 0x96, 0x2c, 0x55, 0x04,
+		0x9e, 0x8a, 0x68, 0x6a, 0xc6, 0x08, 0xa4, 0x49, 0x19, 0xc0, 0x32, 0x0d, 0x6b, 0x5b, 0x6b, 0x1a,
+		0x8d, 0x76, 0x2e, 0x3e, 0x86, 0x4b, 0x35, 0x04, 0x19, 0xe2, 0x92, 0xc5, 0x72, 0x1b, 0x6d, 0x72,
+		0xde, 0x13, 0xcf, 0xd9, 0x31, 0xd2, 0xb5, 0xca, 0x26, 0xe6, 0x90, 0x84, 0x6e, 0x42, 0xa6, 0x67,
+		0xe0, 0x5c, 0x31, 0x9a, 0xbc, 0x67, 0x09, 0xda, 0x88, 0xa5, 0x89, 0x3d, 0x53, 0x39, 0x1a, 0xeb,
+		0x58, 0xba, 0xe7, 0x4f, 0x92, 0xbb, 0xc1, 0xcd, 0xd0, 0xa8, 0x58, 0x01, 0xd5, 0x5c, 0x29, 0x91,
+		0xb9, 0xa6, 0x77, 0x8c, 0xfc, 0xcb, 0x90, 0x09, 0xb2, 0x87, 0x2c, 0x40, 0xd4, 0x76, 0xf4, 0x9e,
+		0x43, 0xa5, 0x30, 0xaa, 0xb2, 0x04, 0x91, 0x21, 0x6c, 0x98, 0x4d, 0xaa, 0x19, 0xa3, 0x2a, 0xfe,
+		0x24, 0xff, 0x8f, 0xd7, 0xe1, 0x30, 0xed, 0xf0, 0xbd, 0xc3, 0x23, 0x1a, 0xa0, 0x3c, 0xd8, 0xef,
+		0xfc, 0xe3, 0x90, 0x0e, 0x74, 0x60, 0xda, 0xaa, 0x95, 0xdf, 0x8e, 0xc0, 0xa1, 0x91, 0xb4, 0xc9,
+		0xf3, 0xb0, 0xd0, 0x37, 0x5b, 0xa6, 0x63, 0xf4, 0xba, 0x3d, 0x03, 0x45, 0x96, 0xd5, 0x95, 0x7b,
+		0x63, 0x76, 0x8c, 0xd0, 0x6d, 0xfa, 0xa1, 0x19, 0x15, 0x75, 0xbe, 0x3f, 0x9c, 0x49, 0xae, 0x42,
+		0x12, 0xe5, 0x43, 0xef, 0xe9, 0x94, 0x20, 0x9b, 0x8d, 0x67, 0xa6, 0xeb, 0xf2, 0x52, 0xd9, 0xc3,
+		0x2c, 0x86, 0x3f, 0x2c, 0x85, 0x54, 0x3f, 0x2d, 0xf2, 0x38, 0xc4, 0xb7, 0x0d, 0xdd, 0xe9, 0xf7,
+		0x0c, 0x3b, 0x77, 0x86, 0xb2, 0xf2, 0xd8, 0xf0, 0x24, 0x65, 0x00, 0x75, 0xc3, 0x51, 0x5d, 0x60,
+		0xd2, 0x81, 0xd4, 0x75, 0xa3, 0xd7, 0xda, 0x6e, 0x35, 0x58, 0xa3, 0xc2, 0x54, 0xf9, 0x3c, 0x31,
+		0x65, 0xa3, 0xae, 0xf8, 0x50, 0xeb, 0x8e, 0xee, 0x18, 0xcb, 0xb0, 0xb9, 0x76, 0xa5, 0xa2, 0x56,
+		0x2f, 0x54, 0x2b, 0x65, 0xd6, 0xcc, 0x00, 0xf9, 0xfc, 0xf7, 0x4a, 0x90, 0xf4, 0xf5, 0x04, 0xd5,
+		0xa1, 0xd9, 0xef, 0x6c, 0x19, 0x3d, 0x3e, 0x5e, 0x3c, 0x45, 0x8e, 0x41, 0x62, 0xbb, 0xdf, 0x6e,
+		0x33, 0xa1, 0x63, 0x6b, 0x69, 0x1c, 0x33, 0x50, 0xe0, 0x50, 0xc7, 0x71, 0x35, 0x42, 0x75, 0x1c,
+		0xfe, 0x26, 0x79, 0x88, 0x0b, 0xa1, 0xcc, 0x45, 0x17, 0xa5, 0x93, 0x71, 0xd5, 0x4d, 0xb3, 0xb2,
+		0xae, 0xa1, 0x3b, 0x46, 0x33, 0x17, 0x13, 0x65, 0x2c, 0x7d, 0x39, 0x12, 0x8f, 0xc8, 0x51, 0xe5,
+		0x31, 0x98, 0x1b, 0xea, 0x0a, 0xc9, 0x42, 0xb2, 0x5c, 0x29, 0xad, 0x14, 0xd4, 0xc2, 0x46, 0xb5,
+		0xb6, 0x26, 0xcf, 0x90, 0x0c, 0xf8, 0x7a, 0x27, 0x4b, 0xa7, 0x12, 0xf1, 0x37, 0x67, 0xe5, 0x0f,
+		0x7c, 0xe0, 0x03, 0x1f, 0x08, 0x29, 0xbf, 0x1a, 0x83, 0x85, 0x51, 0x4a, 0x70, 0xa4, 0x3e, 0xf6,
+		0x3a, 0x1d, 0x0e, 0x74, 0xba, 0x00, 0xd1, 0xb6, 0xbe, 0x65, 0xb4, 0x73, 0x11, 0x3a, 0x08, 0x0f,
+		0x4c, 0xa5, 0x66, 0x97, 0x56, 0x10, 0x45, 0x65, 0x98, 0xe4, 0x7d, 0x9c, 0x35, 0x51, 0x4a, 0xe1,
+		0xd4, 0x74, 0x14, 0x50, 0x39, 0x72, 0x36, 0x1e, 0x83, 0x04, 0xfe, 0x67, 0x7c, 0x8f, 0x31, 0xbe,
+		0x63, 0x06, 0xe5, 0x7b, 0x1e, 0xe2, 0x54, 0xef, 0x35, 0x0d, 0x77, 0x4c, 0x44, 0x1a, 0x35, 0x45,
+		0xd3, 0xd8, 0xd6, 0xfb, 0x6d, 0x47, 0xbb, 0xae, 0xb7, 0xfb, 0x06, 0xd5, 0x60, 0x09, 0x35, 0xc5,
+		0x33, 0xaf, 0x60, 0x1e, 0x39, 0x01, 0x49, 0xa6, 0x26, 0x5b, 0x66, 0xd3, 0xb8, 0x49, 0x97, 0xd0,
+		0xa8, 0xca, 0x34, 0x67, 0x15, 0x73, 0xb0, 0xfa, 0x17, 0x6d, 0xcb, 0x14, 0xba, 0x86, 0x56, 0x81,
+		0x19, 0xb4, 0xfa, 0xc7, 0x07, 0x57, 0xef, 0x3b, 0x47, 0x77, 0x6f, 0x48, 0x39, 0xde, 0x07, 0x59,
+		0x0a, 0xf1, 0x28, 0x9f, 0xca, 0x7a, 0x3b, 0x37, 0x47, 0xc5, 0x20, 0xc3, 0xb2, 0x6b, 0x3c, 0x57,
+		0xf9, 0xb9, 0x10, 0x44, 0xe8, 0x4a, 0x91, 0x85, 0xe4, 0xc6, 0xd5, 0xf5, 0x8a, 0x56, 0xae, 0x6d,
+		0x16, 0x57, 0x2a, 0xb2, 0x84, 0x43, 0x4f, 0x33, 0x2e, 0xac, 0xd4, 0x0a, 0x1b, 0x72, 0xc8, 0x4d,
+		0x57, 0xd7, 0x36, 0xce, 0x3d, 0x26, 0x87, 0x5d, 0x84, 0x4d, 0x96, 0x11, 0xf1, 0x03, 0x3c, 0x7a,
+		0x46, 0x8e, 0x12, 0x19, 0x52, 0x8c, 0x40, 0xf5, 0xf9, 0x4a, 0xf9, 0xdc, 0x63, 0x72, 0x2c, 0x98,
+		0xf3, 0xe8, 0x19, 0x79, 0x96, 0xa4, 0x21, 0x41, 0x73, 0x8a, 0xb5, 0xda, 0x8a, 0x1c, 0x77, 0x69,
+		0xd6, 0x37, 0xd4, 0xea, 0xda, 0x45, 0x39, 0xe1, 0xd2, 0xbc, 0xa8, 0xd6, 0x36, 0xd7, 0x65, 0x70,
+		0x29, 0xac, 0x56, 0xea, 0xf5, 0xc2, 0xc5, 0x8a, 0x9c, 0x74, 0x21, 0x8a, 0x57, 0x37, 0x2a, 0x75,
+		0x39, 0x15, 0x68, 0xd6, 0xa3, 0x67, 0xe4, 0xb4, 0x5b, 0x45, 0x65, 0x6d, 0x73, 0x55, 0xce, 0x90,
+		0x39, 0x48, 0xb3, 0x2a, 0x44, 0x23, 0xb2, 0x03, 0x59, 0xe7, 0x1e, 0x93, 0x65, 0xaf, 0x21, 0x8c,
+		0xca, 0x5c, 0x20, 0xe3, 0xdc, 0x63, 0x32, 0x51, 0x4a, 0x10, 0xa5, 0x62, 0x48, 0x08, 0x64, 0x56,
+		0x0a, 0xc5, 0xca, 0x8a, 0x56, 0x5b, 0xc7, 0x49, 0x53, 0x58, 0x91, 0x25, 0x2f, 0x4f, 0xad, 0xac,
+		0x57, 0x0a, 0x1b, 0x95, 0xb2, 0x1c, 0xf6, 0xe7, 0x3d, 0xbb, 0x59, 0x55, 0x2b, 0x65, 0x39, 0xa4,
+		0x34, 0x60, 0x61, 0xd4, 0x0a, 0x39, 0x72, 0x0a, 0xf9, 0x64, 0x21, 0x34, 0x46, 0x16, 0x28, 0xad,
+		0x41, 0x59, 0x50, 0xbe, 0x18, 0x82, 0xf9, 0x11, 0x56, 0xc2, 0xc8, 0x4a, 0x9e, 0x86, 0x28, 0x93,
+		0x65, 0xa6, 0xa9, 0xef, 0x1f, 0x69, 0x6e, 0x50, 0xc9, 0x1e, 0xb2, 0x9d, 0x28, 0x9e, 0xdf, 0xde,
+		0x0c, 0x8f, 0xb1, 0x37, 0x91, 0xc4, 0x90, 0xc0, 0x7e, 0xd3, 0xd0, 0x6a, 0xce, 0x0c, 0x9e, 0x73,
+		0xd3, 0x18, 0x3c, 0x34, 0xef, 0x60, 0xab, 0x7a, 0x74, 0xc4, 0xaa, 0xfe, 0x24, 0xcc, 0x0d, 0x11,
+		0x9a, 0x7a, 0x75, 0xfd, 0xa0, 0x04, 0xb9, 0x71, 0xcc, 0x99, 0xa0, 0x12, 0x43, 0x01, 0x95, 0xf8,
+		0xe4, 0x20, 0x07, 0xef, 0x1a, 0x3f, 0x08, 0x43, 0x63, 0xfd, 0x29, 0x09, 0x0e, 0x8f, 0xde, 0x57,
+		0x8c, 0x6c, 0xc3, 0xfb, 0x20, 0xd6, 0x31, 0x9c, 0x5d, 0x4b, 0xd8, 0xc9, 0xf7, 0x8e, 0xb0, 0xbe,
+		0xb0, 0x78, 0x70, 0xb0, 0x39, 0x16, 0x39, 0x3f, 0xd8, 0xd6, 0x13, 0xe3, 0x76, 0x39, 0x43, 0x2d,
+		0xfd, 0x8e, 0x10, 0x1c, 0x1a, 0x49, 0x7c, 0x64, 0x43, 0xef, 0x04, 0x68, 0x99, 0xdd, 0xbe, 0xc3,
+		0x6c, 0x61, 0xa6, 0x89, 0x13, 0x34, 0x87, 0x2a, 0x2f, 0xd4, 0xb2, 0x7d, 0xc7, 0x2d, 0x67, 0xab,
+		0x24, 0xb0, 0x2c, 0x0a, 0xf0, 0x84, 0xd7, 0xd0, 0x08, 0x6d, 0xe8, 0xf1, 0x31, 0x3d, 0x1d, 0x12,
+		0xcc, 0x87, 0x41, 0x6e, 0xb4, 0x5b, 0x86, 0xe9, 0x68, 0xb6, 0xd3, 0x33, 0xf4, 0x4e, 0xcb, 0xdc,
+		0x61, 0xab, 0xed, 0x72, 0x74, 0x5b, 0x6f, 0xdb, 0x86, 0x9a, 0x65, 0xc5, 0x75, 0x51, 0x8a, 0x18,
+		0x54, 0x80, 0x7a, 0x3e, 0x8c, 0x58, 0x00, 0x83, 0x15, 0xbb, 0x18, 0xca, 0x4f, 0x27, 0x20, 0xe9,
+		0xdb, 0x85, 0x91, 0xbb, 0x20, 0xf5, 0xa2, 0x7e, 0x5d, 0xd7, 0xc4, 0xce, 0x9a, 0x71, 0x22, 0x89,
+		0x79, 0xeb, 0x2c, 0x8b, 0x3c, 0x0c, 0x0b, 0x14, 0xc4, 0xea, 0x3b, 0x46, 0x4f, 0x6b, 0xb4, 0x75,
+		0xdb, 0xa6, 0x4c, 0x8b, 0x53, 0x50, 0x82, 0x65, 0x35, 0x2c, 0x2a, 0x89, 0x12, 0x72, 0x16, 0xe6,
+		0x29, 0x46, 0xa7, 0xdf, 0x76, 0x5a, 0xdd, 0xb6, 0xa1, 0xe1, 0x5e, 0xdf, 0xce, 0x81, 0xbf, 0x65,
+		0x73, 0x08, 0xb1, 0xca, 0x01, 0xb0, 0x45, 0x36, 0x29, 0xc3, 0x9d, 0x14, 0x6d, 0xc7, 0x30, 0x8d,
+		0x9e, 0xee, 0x18, 0x9a, 0xf1, 0x52, 0x5f, 0x6f, 0xdb, 0x9a, 0x6e, 0x36, 0xb5, 0x5d, 0xdd, 0xde,
+		0xcd, 0x2d, 0x20, 0x81, 0x62, 0x28, 0x27, 0xa9, 0x47, 0x11, 0xf0, 0x22, 0x87, 0xab, 0x50, 0xb0,
+		0x82, 0xd9, 0xbc, 0xa4, 0xdb, 0xbb, 0x64, 0x19, 0x0e, 0x53, 0x2a, 0xb6, 0xd3, 0x6b, 0x99, 0x3b,
+		0x5a, 0x63, 0xd7, 0x68, 0x5c, 0xd3, 0xfa, 0xce, 0xf6, 0x13, 0xb9, 0x63, 0xfe, 0xfa, 0x69, 0x0b,
+		0xeb, 0x14, 0xa6, 0x84, 0x20, 0x9b, 0xce, 0xf6, 0x13, 0xa4, 0x0e, 0x29, 0x1c, 0x8c, 0x4e, 0xeb,
+		0x65, 0x43, 0xdb, 0xb6, 0x7a, 0x74, 0x0d, 0xcd, 0x8c, 0x50, 0x4d, 0x3e, 0x0e, 0x2e, 0xd5, 0x38,
+		0xc2, 0xaa, 0xd5, 0x34, 0x96, 0xa3, 0xf5, 0xf5, 0x4a, 0xa5, 0xac, 0x26, 0x05, 0x95, 0x0b, 0x56,
+		0x0f, 0x05, 0x6a, 0xc7, 0x72, 0x19, 0x9c, 0x64, 0x02, 0xb5, 0x63, 0x09, 0xf6, 0x9e, 0x85, 0xf9,
+		0x46, 0x83, 0xf5, 0xb9, 0xd5, 0xd0, 0xf8, 0x8e, 0xdc, 0xce, 0xc9, 0x01, 0x66, 0x35, 0x1a, 0x17,
+		0x19, 0x00, 0x97, 0x71, 0x9b, 0x9c, 0x87, 0x43, 0x1e, 0xb3, 0xfc, 0x88, 0x73, 0x43, 0xbd, 0x1c,
+		0x44, 0x3d, 0x0b, 0xf3, 0xdd, 0xbd, 0x61, 0x44, 0x12, 0xa8, 0xb1, 0xbb, 0x37, 0x88, 0x76, 0x0f,
+		0xf5, 0xb2, 0xf4, 0x8c, 0x06, 0x35, 0xf5, 0x8e, 0xf8, 0xa1, 0x7d, 0x05, 0x64, 0x09, 0xe4, 0x46,
+		0x43, 0x33, 0x4c, 0x7d, 0xab, 0x6d, 0x68, 0x7a, 0xcf, 0x30, 0x75, 0x3b, 0x77, 0x82, 0x02, 0x47,
+		0x9c, 0x5e, 0xdf, 0x50, 0x33, 0x8d, 0x46, 0x85, 0x16, 0x16, 0x68, 0x19, 0x39, 0x05, 0x73, 0xd6,
+		0xd6, 0x8b, 0x0d, 0x26, 0x58, 0x5a, 0xb7, 0x67, 0x6c, 0xb7, 0x6e, 0xe6, 0xde, 0x43, 0xb9, 0x94,
+		0xc5, 0x02, 0x2a, 0x56, 0xeb, 0x34, 0x9b, 0xdc, 0x0f, 0x72, 0xc3, 0xde, 0xd5, 0x7b, 0x5d, 0xaa,
+		0x59, 0xed, 0xae, 0xde, 0x30, 0x72, 0xf7, 0x30, 0x50, 0x96, 0xbf, 0x26, 0xb2, 0x51, 0xb0, 0xed,
+		0x1b, 0xad, 0x6d, 0x47, 0x50, 0xbc, 0x8f, 0x09, 0x36, 0xcd, 0xe3, 0xd4, 0x4e, 0x82, 0xdc, 0xdd,
+		0xed, 0x06, 0x2b, 0x3e, 0x49, 0xc1, 0x32, 0xdd, 0xdd, 0xae, 0xbf, 0xde, 0xbb, 0x21, 0xdd, 0xdd,
+		0xf5, 0x57, 0x7a, 0x3f, 0xb3, 0xbf, 0xba, 0xbb, 0xbe, 0x1a, 0x1f, 0x83, 0xc3, 0x08, 0xd4, 0x31,
+		0x1c, 0xbd, 0xa9, 0x3b, 0xba, 0x0f, 0xfa, 0x41, 0x0a, 0xbd, 0xd0, 0xdd, 0xed, 0xae, 0xf2, 0xc2,
+		0x40, 0x3b, 0x7b, 0xfd, 0xad, 0x3d, 0x57, 0x3e, 0x1e, 0x62, 0xed, 0xc4, 0x3c, 0x21, 0x21, 0xb7,
+		0xbd, 0xfd, 0xf8, 0xba, 0x6d, 0xb6, 0x94, 0x65, 0x48, 0xf9, 0xe5, 0x9e, 0x24, 0x80, 0x49, 0xbe,
+		0x2c, 0xa1, 0x11, 0x54, 0xaa, 0x95, 0xd1, 0x7c, 0x79, 0xa1, 0x22, 0x87, 0xd0, 0x8c, 0x5a, 0xa9,
+		0x6e, 0x54, 0x34, 0x75, 0x73, 0x6d, 0xa3, 0xba, 0x5a, 0x91, 0xc3, 0x3e, 0xc3, 0xfe, 0x72, 0x24,
+		0x7e, 0x4a, 0x7e, 0xe0, 0x72, 0x24, 0x7e, 0xaf, 0x7c, 0x1f, 0x65, 0xcf, 0x90, 0x50, 0x2a, 0x6f,
+		0x85, 0x21, 0x13, 0xdc, 0x96, 0x93, 0xf7, 0xc2, 0x11, 0xe1, 0x77, 0xb3, 0x0d, 0x47, 0xbb, 0xd1,
+		0xea, 0xd1, 0xc9, 0xda, 0xd1, 0xd9, 0xc2, 0xe9, 0x0a, 0xe5, 0x02, 0x87, 0xaa, 0x1b, 0xce, 0x73,
+		0xad, 0x1e, 0x4e, 0xc5, 0x8e, 0xee, 0x90, 0x15, 0x38, 0x61, 0x5a, 0x9a, 0xed, 0xe8, 0x66, 0x53,
+		0xef, 0x35, 0x35, 0xcf, 0xe3, 0xa9, 0xe9, 0x8d, 0x86, 0x61, 0xdb, 0x16, 0x5b, 0x24, 0x5d, 0x2a,
+		0x77, 0x98, 0x56, 0x9d, 0x03, 0x7b, 0xab, 0x47, 0x81, 0x83, 0x0e, 0xcc, 0x89, 0xf0, 0xb8, 0x39,
+		0x71, 0x0c, 0x12, 0x1d, 0xbd, 0xab, 0x19, 0xa6, 0xd3, 0xdb, 0xa3, 0xb6, 0x7b, 0x5c, 0x8d, 0x77,
+		0xf4, 0x6e, 0x05, 0xd3, 0xe4, 0x0a, 0xdc, 0xeb, 0x81, 0x6a, 0x6d, 0x63, 0x47, 0x6f, 0xec, 0x69,
+		0xd4, 0x50, 0xa7, 0x3e, 0x22, 0xad, 0x61, 0x99, 0xdb, 0xed, 0x56, 0xc3, 0xb1, 0x73, 0x49, 0x57,
+		0xff, 0x29, 0x1e, 0xc6, 0x0a, 0x45, 0xb8, 0x6c, 0x5b, 0x26, 0xb5, 0xcf, 0x4b, 0x02, 0x3a, 0x20,
+		0x36, 0xa9, 0x77, 0x85, 0xd8, 0x04, 0x87, 0x3e, 0x22, 0x47, 0x2f, 0x47, 0xe2, 0x51, 0x39, 0x76,
+		0x39, 0x12, 0x8f, 0xc9, 0xb3, 0x97, 0x23, 0xf1, 0xb8, 0x9c, 0xb8, 0x1c, 0x89, 0x27, 0x64, 0x50,
+		0x6e, 0xa5, 0x21, 0xe5, 0xdf, 0x6e, 0xe0, 0xee, 0xad, 0x41, 0x17, 0x5c, 0x89, 0xaa, 0xe4, 0xbb,
+		0xf7, 0xdd, 0x9c, 0x2c, 0x95, 0x70, 0x25, 0x5e, 0x8e, 0x31, 0xdb, 0x5e, 0x65, 0x98, 0x68, 0x05,
+		0xe1, 0x24, 0x33, 0x98, 0x2d, 0x15, 0x57, 0x79, 0x8a, 0x5c, 0x84, 0xd8, 0x8b, 0x36, 0xa5, 0x1d,
+		0xa3, 0xb4, 0xdf, 0xb3, 0x3f, 0xed, 0xcb, 0x75, 0x4a, 0x3c, 0x71, 0xb9, 0xae, 0xad, 0xd5, 0xd4,
+		0xd5, 0xc2, 0x8a, 0xca, 0xd1, 0xc9, 0x51, 0x88, 0xb4, 0xf5, 0x97, 0xf7, 0x82, 0x6b, 0x36, 0xcd,
+		0x22, 0x4b, 0x90, 0xed, 0x9b, 0x6c, 0xaf, 0x8e, 0x63, 0x8c, 0x50, 0x59, 0x3f, 0x54, 0xc6, 0x2b,
+		0x5d, 0x41, 0xf8, 0x29, 0xe5, 0xea, 0x28, 0x44, 0xd0, 0x29, 0x1d, 0x5c, 0x59, 0x69, 0x16, 0x39,
+		0x09, 0xa9, 0xa6, 0xb1, 0xd5, 0xdf, 0xd1, 0x7a, 0x46, 0x53, 0x6f, 0x38, 0xc1, 0xf5, 0x24, 0x49,
+		0x8b, 0x54, 0x5a, 0x42, 0x9e, 0x81, 0x04, 0x8e, 0x91, 0x49, 0xc7, 0x78, 0x8e, 0xb2, 0xe0, 0xa1,
+		0xfd, 0x59, 0xc0, 0x87, 0x58, 0x20, 0xa9, 0x1e, 0x3e, 0xb9, 0x04, 0xb3, 0x8e, 0xde, 0xdb, 0x31,
+		0x1c, 0x3b, 0x37, 0xbf, 0x18, 0x3e, 0x99, 0x39, 0xb3, 0x34, 0x0d, 0xa9, 0x0d, 0x8a, 0x42, 0x77,
+		0xca, 0x02, 0x9d, 0x3c, 0x07, 0x32, 0x77, 0xc5, 0x6a, 0x7c, 0x9b, 0x6b, 0xe7, 0x16, 0xa8, 0x00,
+		0x3e, 0xb8, 0x3f, 0x49, 0xee, 0xc9, 0x2d, 0x33, 0x24, 0x35, 0x6b, 0x04, 0xd2, 0xc1, 0x79, 0x71,
+		0xe8, 0x20, 0xf3, 0x62, 0x13, 0xb2, 0xfc, 0xb7, 0x66, 0xf7, 0xbb, 0x5d, 0xab, 0xe7, 0xe4, 0x0e,
+		0x2f, 0x4a, 0x93, 0x1b, 0x24, 0x88, 0x31, 0x1c, 0x35, 0xb3, 0x1d, 0x48, 0x7f, 0xfd, 0xa6, 0x5b,
+		0xfe, 0x05, 0xc8, 0x04, 0x99, 0xe1, 0x77, 0x84, 0x87, 0xa7, 0x74, 0x84, 0xe3, 0xb6, 0x44, 0x6c,
+		0xd4, 0x70, 0x69, 0x62, 0x89, 0xfc, 0xf7, 0x85, 0x20, 0x13, 0xec, 0x18, 0xb9, 0x08, 0x44, 0x8c,
+		0x58, 0xcb, 0x74, 0x7a, 0x56, 0xb3, 0xdf, 0x30, 0x9a, 0x39, 0x69, 0x42, 0x3d, 0x73, 0x1c, 0xa7,
+		0xea, 0xa2, 0xf8, 0x09, 0xf9, 0x66, 0x41, 0x68, 0x4a, 0x42, 0x65, 0x6f, 0x7e, 0x9c, 0x86, 0x79,
+		0x41, 0x00, 0x89, 0xdd, 0xd0, 0x7b, 0x26, 0x9a, 0xc8, 0xcc, 0x68, 0x27, 0xbe, 0xa2, 0xe7, 0x58,
+		0x09, 0x29, 0x80, 0x10, 0x17, 0xad, 0x67, 0x74, 0x2c, 0xf4, 0x77, 0x45, 0x26, 0x54, 0x9b, 0xe1,
+		0x08, 0x2a, 0x83, 0x57, 0x4e, 0x43, 0x94, 0xaa, 0x1f, 0x02, 0xc0, 0x15, 0x90, 0x3c, 0x43, 0xe2,
+		0x10, 0x29, 0xd5, 0x54, 0x5c, 0x1e, 0x65, 0x48, 0xb1, 0x5c, 0x6d, 0xbd, 0x5a, 0x29, 0x55, 0xe4,
+		0x90, 0x72, 0x16, 0x62, 0x4c, 0xa7, 0xe0, 0xd2, 0xe9, 0x6a, 0x15, 0x79, 0x86, 0x27, 0x39, 0x0d,
+		0x49, 0x94, 0x6e, 0xae, 0x16, 0x2b, 0xaa, 0x1c, 0x52, 0x36, 0x21, 0x3b, 0x30, 0x0f, 0xc9, 0x21,
+		0x98, 0x53, 0x2b, 0x1b, 0x95, 0x35, 0x74, 0x0e, 0x68, 0x9b, 0x6b, 0xcf, 0xac, 0xd5, 0x9e, 0x43,
+		0xcf, 0x5a, 0x20, 0x5b, 0xac, 0xc3, 0x12, 0x59, 0x00, 0xd9, 0xcb, 0xae, 0xd7, 0x36, 0x55, 0xda,
+		0x9a, 0xef, 0x0c, 0x81, 0x3c, 0x38, 0x29, 0xc9, 0x11, 0x98, 0xdf, 0x28, 0xa8, 0x17, 0x2b, 0x1b,
+		0x1a, 0x73, 0x78, 0xb8, 0xa4, 0x17, 0x40, 0xf6, 0x17, 0x5c, 0xa8, 0x52, 0x7f, 0xce, 0x09, 0x38,
+		0xe6, 0xcf, 0xad, 0x3c, 0xbf, 0x51, 0x59, 0xab, 0xd3, 0xca, 0x0b, 0x6b, 0x17, 0xd1, 0x28, 0x18,
+		0xa0, 0x27, 0x5c, 0x2c, 0x61, 0x6c, 0x6a, 0x90, 0x5e, 0x65, 0xa5, 0x2c, 0x47, 0x06, 0xb3, 0x6b,
+		0x6b, 0x95, 0xda, 0x05, 0x39, 0x3a, 0x58, 0x3b, 0x75, 0xbb, 0xc4, 0x48, 0x1e, 0x0e, 0x0f, 0xe6,
+		0x6a, 0x95, 0xb5, 0x0d, 0xf5, 0xaa, 0x3c, 0x3b, 0x58, 0x71, 0xbd, 0xa2, 0x5e, 0xa9, 0x96, 0x2a,
+		0x72, 0x9c, 0x1c, 0x06, 0x12, 0x6c, 0xd1, 0xc6, 0xa5, 0x5a, 0x59, 0x4e, 0x8c, 0x5a, 0xb1, 0x88,
+		0x3c, 0xaf, 0xfc, 0x94, 0x04, 0x29, 0xbf, 0x0b, 0x24, 0xa0, 0x54, 0xa4, 0x77, 0xdb, 0x62, 0xab,
+		0xfc, 0x4e, 0x08, 0x92, 0x3e, 0x5f, 0x08, 0x6e, 0x62, 0xf5, 0x76, 0xdb, 0xba, 0xa1, 0xe9, 0xed,
+		0x96, 0x6e, 0xf3, 0xf5, 0x10, 0x68, 0x56, 0x01, 0x73, 0xa6, 0x5d, 0x7f, 0xa6, 0x37, 0x5d, 0x62,
+		0xb7, 0x6d, 0xba, 0xcc, 0xbe, 0x0b, 0x4d, 0x97, 0xa8, 0x1c, 0x53, 0x7e, 0x2f, 0x04, 0xf2, 0xa0,
+		0x77, 0x64, 0x80, 0x6f, 0xd2, 0x38, 0xbe, 0xf9, 0xfb, 0x17, 0x3a, 0x48, 0xff, 0x06, 0x57, 0xf5,
+		0xf0, 0xd8, 0x55, 0x7d, 0xc4, 0x62, 0x15, 0x79, 0x37, 0x2f, 0x56, 0x7e, 0x71, 0xfd, 0x77, 0x12,
+		0x64, 0x82, 0xce, 0x9c, 0x00, 0xc7, 0x94, 0x83, 0x70, 0x2c, 0x38, 0x22, 0x77, 0x8d, 0x1b, 0x91,
+		0xbf, 0x91, 0x7e, 0xfd, 0x40, 0x18, 0xd2, 0x01, 0xdf, 0xcf, 0xb4, 0xad, 0x7b, 0x09, 0xe6, 0x5a,
+		0x4d, 0xa3, 0xd3, 0xb5, 0x1c, 0x8c, 0x3c, 0xd0, 0xda, 0xc6, 0x75, 0xa3, 0x4d, 0xd9, 0x90, 0x19,
+		0x71, 0xba, 0x1a, 0xa8, 0x61, 0xa9, 0xea, 0xe1, 0xad, 0x20, 0xda, 0xf2, 0x7c, 0xb5, 0x5c, 0x59,
+		0x5d, 0xaf, 0x6d, 0x54, 0xd6, 0x4a, 0x57, 0x85, 0x26, 0x57, 0xe5, 0xd6, 0x00, 0x58, 0x80, 0xe1,
+		0x77, 0xbf, 0x3b, 0x36, 0x9d, 0xeb, 0x20, 0x0f, 0xf6, 0x06, 0x15, 0xfa, 0x88, 0xfe, 0xc8, 0x33,
+		0x64, 0x1e, 0xb2, 0x6b, 0x35, 0xad, 0x5e, 0x2d, 0x57, 0xb4, 0xca, 0x85, 0x0b, 0x95, 0xd2, 0x46,
+		0x9d, 0x1d, 0x34, 0xb8, 0xd0, 0x1b, 0x72, 0xc8, 0x3f, 0x36, 0x3f, 0x18, 0x86, 0xf9, 0x11, 0x2d,
+		0x21, 0x05, 0xee, 0x22, 0x64, 0x5e, 0xcb, 0x87, 0xa6, 0x69, 0xfd, 0x12, 0xee, 0xee, 0xd7, 0xf5,
+		0x9e, 0xc3, 0x3d, 0x8a, 0xf7, 0x03, 0xb2, 0xd7, 0x74, 0xd0, 0xbc, 0xef, 0xf1, 0x03, 0x1c, 0x66,
+		0x82, 0x64, 0xbd, 0x7c, 0x76, 0x86, 0xf3, 0x20, 0x90, 0xae, 0x65, 0xb7, 0x9c, 0xd6, 0x75, 0x0c,
+		0x84, 0x10, 0xa7, 0x3d, 0x38, 0x71, 0x23, 0xaa, 0x2c, 0x4a, 0xaa, 0xa6, 0xe3, 0x42, 0x9b, 0xc6,
+		0x8e, 0x3e, 0x00, 0x8d, 0xdb, 0x8f, 0xb0, 0x2a, 0x8b, 0x12, 0x17, 0xfa, 0x2e, 0x48, 0x35, 0xad,
+		0x3e, 0x7a, 0x65, 0x18, 0x1c, 0xaa, 0x64, 0x49, 0x4d, 0xb2, 0x3c, 0x17, 0x84, 0xbb, 0xcd, 0xbc,
+		0x63, 0xa6, 0x94, 0x9a, 0x64, 0x79, 0x0c, 0xe4, 0x3e, 0xc8, 0xea, 0x3b, 0x3b, 0x3d, 0x24, 0x2e,
+		0x08, 0x31, 0x47, 0x60, 0xc6, 0xcd, 0xa6, 0x80, 0xf9, 0xcb, 0x10, 0x17, 0x7c, 0xc0, 0xfd, 0x2f,
+		0x72, 0x42, 0xeb, 0x32, 0xef, 0x76, 0x08, 0x4f, 0x9e, 0x4c, 0x51, 0x78, 0x17, 0xa4, 0x5a, 0xb6,
+		0xe6, 0x85, 0x41, 0x84, 0x16, 0x43, 0x27, 0xe3, 0x6a, 0xb2, 0x65, 0xbb, 0xa7, 0xa2, 0xca, 0xa7,
+		0x00, 0xc0, 0x13, 0x36, 0xf2, 0xdd, 0x12, 0x64, 0xd8, 0x02, 0xd3, 0xed, 0x19, 0xb6, 0x61, 0x36,
+		0xc4, 0xb6, 0xf0, 0xfe, 0x7d, 0x44, 0x94, 0xa9, 0xb9, 0x75, 0x8e, 0x50, 0x7c, 0xfa, 0xc3, 0x92,
+		0xf4, 0x9a, 0x14, 0x79, 0x4d, 0x92, 0x7e, 0x44, 0x4a, 0x93, 0x78, 0xe5, 0xf9, 0xf5, 0x95, 0x6a,
+		0xa9, 0xba, 0x91, 0xfb, 0xd2, 0x2c, 0x4d, 0x57, 0x57, 0x79, 0xfa, 0x8d, 0xd9, 0x60, 0xf9, 0x9b,
+		0xb3, 0x9f, 0x91, 0xc2, 0xf1, 0x37, 0x67, 0xd5, 0xf4, 0xb6, 0x9f, 0x1e, 0x69, 0xfb, 0x23, 0x28,
+		0x42, 0xe3, 0x36, 0x92, 0x5e, 0x6b, 0x2a, 0x3c, 0x6e, 0xa2, 0x78, 0x3f, 0x6d, 0x48, 0x8c, 0x36,
+		0x24, 0x49, 0x62, 0xa5, 0x95, 0x5a, 0xbd, 0x52, 0xa6, 0xcd, 0x48, 0x90, 0x48, 0x6d, 0xbd, 0xb2,
+		0x96, 0x7b, 0x43, 0x54, 0xe9, 0x05, 0x5b, 0xbc, 0x26, 0xc1, 0x11, 0x71, 0xca, 0xca, 0xd7, 0x5a,
+		0xc3, 0x6c, 0x58, 0x4d, 0x61, 0xdd, 0x66, 0xce, 0x3c, 0xb2, 0x5f, 0xe5, 0x2a, 0x47, 0xa5, 0x2c,
+		0xa9, 0x70, 0xc4, 0xe2, 0x43, 0x43, 0x2c, 0x29, 0xac, 0x95, 0x79, 0x5b, 0x92, 0x24, 0xb6, 0x5e,
+		0x28, 0x3d, 0x53, 0x29, 0x7b, 0xad, 0x39, 0xd4, 0x1b, 0x45, 0x85, 0x7c, 0x0b, 0x64, 0xd1, 0xdb,
+		0x8a, 0xb2, 0xd1, 0x6a, 0xb2, 0x63, 0xef, 0xc8, 0xb8, 0xf3, 0x52, 0xaf, 0x45, 0xe8, 0x7e, 0xbd,
+		0xe2, 0x62, 0x14, 0xef, 0xf7, 0x35, 0x25, 0x41, 0x22, 0x6b, 0xb5, 0xb5, 0x8a, 0x68, 0x06, 0x3d,
+		0x22, 0xbe, 0xea, 0x35, 0x23, 0xd3, 0x0f, 0xa0, 0x92, 0x6f, 0x01, 0x59, 0xb8, 0x87, 0x5c, 0x96,
+		0x44, 0xc7, 0x1d, 0xf9, 0x7a, 0x0d, 0xe0, 0x4e, 0x26, 0x97, 0x19, 0xf7, 0xfa, 0x5a, 0xb0, 0x40,
+		0xb2, 0x2b, 0x95, 0xb5, 0x8b, 0x1b, 0x97, 0xb4, 0x75, 0xb5, 0x42, 0x4f, 0xee, 0x72, 0x5f, 0x12,
+		0xd5, 0x67, 0x3b, 0x41, 0x44, 0xf2, 0x6d, 0x12, 0x24, 0x99, 0x09, 0xc4, 0x7c, 0x52, 0xcc, 0xa9,
+		0x70, 0xef, 0x7e, 0x75, 0x53, 0x0b, 0x88, 0x42, 0x17, 0xcf, 0xd3, 0x6a, 0xc3, 0x42, 0x20, 0x8e,
+		0x10, 0xb2, 0x52, 0xb9, 0x58, 0x28, 0x5d, 0xd5, 0x8a, 0x95, 0xfa, 0x06, 0x6a, 0xb2, 0x9a, 0xca,
+		0x64, 0x14, 0x48, 0xb4, 0xb0, 0xb2, 0x52, 0x7b, 0xce, 0x63, 0x04, 0xbc, 0xe8, 0x92, 0x51, 0xbe,
+		0x11, 0xd2, 0x01, 0x71, 0x47, 0xa3, 0x98, 0x1a, 0xd3, 0xd8, 0x83, 0x7a, 0x65, 0xad, 0xe4, 0x37,
+		0xe2, 0x53, 0xe0, 0x8a, 0xb7, 0x2c, 0x61, 0x4a, 0x08, 0xbf, 0x1c, 0x42, 0x35, 0xca, 0x1b, 0xe0,
+		0x9e, 0x25, 0x86, 0x95, 0xc7, 0x21, 0x2e, 0xc4, 0x17, 0x4d, 0x73, 0x6a, 0x61, 0x0f, 0x6c, 0x0c,
+		0xe2, 0x40, 0x65, 0x57, 0x96, 0x70, 0x1b, 0xc4, 0x64, 0x5a, 0x0e, 0x29, 0x57, 0xe0, 0xd0, 0x48,
+		0xd1, 0x23, 0x77, 0xc3, 0x09, 0x71, 0x7e, 0xc9, 0x8c, 0x7e, 0xad, 0xb2, 0x56, 0xaa, 0x95, 0x71,
+		0x9b, 0xe4, 0xd1, 0x04, 0xe0, 0x32, 0xc8, 0x5a, 0x29, 0xe4, 0x53, 0x0e, 0x29, 0x55, 0xc8, 0x04,
+		0x05, 0x88, 0x1c, 0x83, 0x23, 0x9b, 0x1b, 0x17, 0x9e, 0xd0, 0xae, 0x14, 0x56, 0xaa, 0xe5, 0xc2,
+		0xc0, 0x86, 0x08, 0x80, 0x4b, 0x91, 0x1c, 0xc2, 0x86, 0xa2, 0x74, 0xc9, 0x61, 0x25, 0x12, 0x97,
+		0x64, 0x49, 0xa9, 0x43, 0x76, 0x40, 0x14, 0xc8, 0x1d, 0x90, 0xe3, 0x3b, 0x94, 0x51, 0xad, 0x9a,
+		0x87, 0x41, 0xe1, 0x60, 0x7b, 0xb5, 0x72, 0x65, 0xa5, 0xba, 0x5a, 0xdd, 0xa0, 0xed, 0xbb, 0x04,
+		0xe0, 0x8d, 0x31, 0xae, 0x59, 0x97, 0xeb, 0xb5, 0x35, 0xed, 0x02, 0x6e, 0xf4, 0x36, 0x7c, 0xa4,
+		0x12, 0xc0, 0xc6, 0x54, 0x96, 0x70, 0x3f, 0x32, 0x3c, 0xf0, 0x72, 0xe8, 0x54, 0x0c, 0x57, 0xac,
+		0x8f, 0xac, 0x9d, 0x8a, 0xc5, 0x3f, 0xb2, 0x26, 0xbf, 0x8a, 0xff, 0x5f, 0x5d, 0x93, 0xbf, 0x7b,
+		0xed, 0x72, 0x2c, 0xfe, 0xc6, 0xac, 0xfc, 0xe6, 0xac, 0xf2, 0xa7, 0x61, 0x20, 0x9e, 0x64, 0xb9,
+		0x3e, 0x8f, 0xe7, 0x21, 0xee, 0x3a, 0x51, 0x58, 0x94, 0xe6, 0x7b, 0xf7, 0x11, 0x48, 0x81, 0xe6,
+		0xcb, 0x1a, 0x70, 0xaa, 0xb8, 0xd4, 0x70, 0xc7, 0xdc, 0x69, 0x99, 0xad, 0x4e, 0xbf, 0xa3, 0x09,
+		0xcf, 0xc2, 0xc4, 0x1d, 0x33, 0x47, 0xe0, 0x69, 0x4a, 0x42, 0xbf, 0x19, 0x20, 0x11, 0x9d, 0x48,
+		0x82, 0x21, 0xf0, 0x74, 0xfe, 0xaf, 0x25, 0xc8, 0x8d, 0x6b, 0xec, 0x6d, 0x39, 0x3d, 0xd6, 0x60,
+		0xc1, 0xba, 0x6e, 0xf4, 0x7a, 0xad, 0x26, 0x3d, 0xc7, 0x70, 0x4d, 0xa1, 0xc8, 0x64, 0x53, 0x68,
+		0xde, 0x87, 0xc8, 0xb3, 0x6d, 0x52, 0xc4, 0x15, 0xeb, 0x26, 0x2a, 0x6b, 0x41, 0x29, 0x3a, 0x99,
+		0x52, 0x9a, 0xa2, 0x08, 0x1a, 0x97, 0x51, 0x40, 0x71, 0xf7, 0x11, 0x92, 0xc3, 0x9e, 0xbd, 0xa5,
+		0x7c, 0x2a, 0x04, 0x99, 0x60, 0x58, 0x24, 0x29, 0x43, 0xbc, 0x6d, 0xf1, 0x90, 0x23, 0x36, 0xda,
+		0x27, 0x27, 0x44, 0x52, 0x2e, 0xad, 0x70, 0x78, 0xd5, 0xc5, 0xcc, 0xff, 0x5b, 0x09, 0xe2, 0x22,
+		0x9b, 0x1c, 0x86, 0x48, 0x57, 0x77, 0x76, 0x29, 0xb9, 0x68, 0x31, 0x24, 0x4b, 0x2a, 0x4d, 0x63,
+		0xbe, 0xdd, 0xd5, 0x59, 0xb8, 0x15, 0xcf, 0xc7, 0x34, 0xda, 0x3c, 0x6d, 0x43, 0x6f, 0xd2, 0x13,
+		0x38, 0xab, 0xd3, 0x31, 0x4c, 0xc7, 0x16, 0x36, 0x0f, 0xcf, 0x2f, 0xf1, 0x6c, 0x8c, 0xce, 0x75,
+		0x7a, 0x7a, 0xab, 0x1d, 0x80, 0x8d, 0x50, 0x58, 0x59, 0x14, 0xb8, 0xc0, 0xcb, 0x70, 0x54, 0xd0,
+		0x6d, 0x1a, 0x8e, 0xde, 0xd8, 0x35, 0x9a, 0x1e, 0x52, 0x8c, 0x9e, 0xb4, 0x1f, 0xe1, 0x00, 0x65,
+		0x5e, 0x2e, 0x70, 0x95, 0xcf, 0x87, 0x60, 0x4e, 0x9c, 0x19, 0x36, 0x5d, 0x66, 0xad, 0x02, 0xe8,
+		0xa6, 0x69, 0x39, 0x7e, 0x76, 0x0d, 0x9b, 0x79, 0x43, 0x78, 0x4b, 0x05, 0x17, 0x49, 0xf5, 0x11,
+		0xc8, 0xff, 0xb1, 0x04, 0xe0, 0x15, 0x8d, 0xe5, 0xdb, 0x09, 0x48, 0xf2, 0xa0, 0x57, 0x1a, 0x39,
+		0xcd, 0x5c, 0x6b, 0xc0, 0xb2, 0xf0, 0x74, 0x11, 0xbd, 0x6e, 0x5b, 0xc6, 0x4e, 0xcb, 0xe4, 0x51,
+		0x4c, 0x2c, 0x21, 0x82, 0x01, 0x22, 0x5e, 0x94, 0x9f, 0x0a, 0x71, 0xdb, 0xe8, 0xe8, 0xa6, 0xd3,
+		0x6a, 0xf0, 0x59, 0x73, 0xee, 0x40, 0x8d, 0x5f, 0xaa, 0x73, 0x6c, 0xd5, 0xa5, 0xa3, 0x9c, 0x84,
+		0xb8, 0xc8, 0x75, 0xf5, 0xe3, 0x0c, 0x99, 0x85, 0x70, 0xbd, 0x82, 0x2b, 0x04, 0x55, 0x53, 0xd5,
+		0x42, 0x5d, 0x0e, 0x9d, 0xfa, 0x54, 0x08, 0x66, 0xc5, 0x34, 0x9e, 0x87, 0x6c, 0xa5, 0x5c, 0x1d,
+		0x50, 0xb5, 0xf3, 0x90, 0x11, 0x99, 0x4c, 0x9f, 0xc9, 0x1f, 0x9a, 0xf5, 0x67, 0xae, 0xab, 0xb5,
+		0x8d, 0xda, 0x19, 0xf9, 0x4b, 0xc3, 0x99, 0x8f, 0xca, 0x6f, 0xcc, 0x92, 0x39, 0x48, 0x89, 0xcc,
+		0x33, 0x0f, 0x9f, 0x79, 0x54, 0x7e, 0x73, 0x30, 0xeb, 0x31, 0xf9, 0x8f, 0xa8, 0x57, 0x47, 0x64,
+		0x3d, 0xa2, 0x6d, 0xa0, 0xbe, 0xac, 0xad, 0xad, 0x5c, 0x95, 0x25, 0x7f, 0xc1, 0x19, 0x5f, 0x41,
+		0x88, 0xdc, 0x09, 0x47, 0x44, 0xc1, 0xf9, 0xf3, 0xe7, 0xcf, 0x3f, 0xee, 0x2b, 0xbc, 0xf5, 0xd1,
+		0xd8, 0x60, 0xf1, 0x13, 0xbe, 0xe2, 0x1f, 0x1e, 0x2e, 0x3e, 0xef, 0x2b, 0xfe, 0xf8, 0x47, 0x63,
+		0x64, 0x1e, 0x92, 0xa2, 0x78, 0xb5, 0xf0, 0xbc, 0xfc, 0xb5, 0xaf, 0x7d, 0xed, 0x6b, 0xb3, 0xc5,
+		0x6f, 0x81, 0xf9, 0x86, 0xd5, 0x19, 0x1c, 0x9a, 0xa2, 0x3c, 0x10, 0x92, 0x60, 0x5f, 0x92, 0x5e,
+		0x78, 0x88, 0x03, 0xed, 0x58, 0x6d, 0xdd, 0xdc, 0x59, 0xb2, 0x7a, 0x3b, 0x5e, 0x84, 0x3e, 0x9a,
+		0x97, 0xb6, 0x2f, 0x4e, 0xbf, 0xbb, 0xf5, 0xd7, 0x92, 0xf4, 0x23, 0xa1, 0xf0, 0xc5, 0xf5, 0xe2,
+		0x4f, 0x86, 0xf2, 0x17, 0x19, 0xe2, 0xba, 0x18, 0x78, 0xd5, 0xd8, 0x6e, 0x1b, 0x0d, 0x1c, 0x1d,
+		0xf8, 0x93, 0x07, 0x60, 0x61, 0xc7, 0xda, 0xb1, 0x28, 0xa5, 0xd3, 0xf8, 0x8b, 0x35, 0x82, 0x24,
+		0xdc, 0xdc, 0xfc, 0xc4, 0xfb, 0x00, 0xcb, 0x6b, 0x30, 0xcf, 0x81, 0x35, 0x6a, 0xed, 0xb2, 0x53,
+		0x53, 0xb2, 0x6f, 0xe4, 0x4d, 0xee, 0x67, 0xff, 0x90, 0xba, 0x29, 0xd4, 0x39, 0x8e, 0x8a, 0x65,
+		0xec, 0x60, 0x75, 0x59, 0x85, 0x43, 0x01, 0x7a, 0x6c, 0xa7, 0x61, 0xf4, 0x26, 0x50, 0xfc, 0x97,
+		0x9c, 0xe2, 0xbc, 0x8f, 0x62, 0x9d, 0xa3, 0x2e, 0x97, 0x20, 0x7d, 0x10, 0x5a, 0xff, 0x8a, 0xd3,
+		0x4a, 0x19, 0x7e, 0x22, 0x17, 0x21, 0x4b, 0x89, 0x34, 0xfa, 0xb6, 0x63, 0x75, 0xe8, 0x36, 0x6e,
+		0x7f, 0x32, 0xbf, 0xfe, 0x87, 0x4c, 0xbd, 0x65, 0x10, 0xad, 0xe4, 0x62, 0x2d, 0x2f, 0x03, 0xb5,
+		0xda, 0x31, 0x9c, 0x74, 0x02, 0x85, 0xdf, 0xe0, 0x0d, 0x71, 0xe1, 0x97, 0xaf, 0xc0, 0x02, 0xfe,
+		0xa6, 0xbb, 0x2c, 0x7f, 0x4b, 0x26, 0x87, 0xe9, 0xe4, 0x7e, 0xe7, 0x83, 0x4c, 0x83, 0xce, 0xbb,
+		0x04, 0x7c, 0x6d, 0xf2, 0x8d, 0xe2, 0x8e, 0xe1, 0x38, 0x46, 0xcf, 0xd6, 0xf4, 0xf6, 0xa8, 0xe6,
+		0xf9, 0xe2, 0x1c, 0x72, 0x3f, 0xf0, 0x95, 0xe0, 0x28, 0x5e, 0x64, 0x98, 0x85, 0x76, 0x7b, 0x79,
+		0x13, 0x8e, 0x8c, 0x90, 0x8a, 0x29, 0x68, 0xfe, 0x20, 0xa7, 0xb9, 0x30, 0x24, 0x19, 0x48, 0x76,
+		0x1d, 0x44, 0xbe, 0x3b, 0x96, 0x53, 0xd0, 0xfc, 0x18, 0xa7, 0x49, 0x38, 0xae, 0x18, 0x52, 0xa4,
+		0x78, 0x19, 0xe6, 0xae, 0x1b, 0xbd, 0x2d, 0xcb, 0xe6, 0xb1, 0x25, 0x53, 0x90, 0xfb, 0x21, 0x4e,
+		0x2e, 0xcb, 0x11, 0x69, 0xb0, 0x09, 0xd2, 0x3a, 0x0f, 0xf1, 0x6d, 0xbd, 0x61, 0x4c, 0x41, 0xe2,
+		0x16, 0x27, 0x31, 0x8b, 0xf0, 0x88, 0x5a, 0x80, 0xd4, 0x8e, 0xc5, 0x37, 0xda, 0x93, 0xd1, 0x7f,
+		0x98, 0xa3, 0x27, 0x05, 0x0e, 0x27, 0xd1, 0xb5, 0xba, 0xfd, 0x36, 0xee, 0xc2, 0x27, 0x93, 0xf8,
+		0xb8, 0x20, 0x21, 0x70, 0x38, 0x89, 0x03, 0xb0, 0xf5, 0x13, 0x82, 0x84, 0xed, 0xe3, 0xe7, 0xd3,
+		0x18, 0x72, 0xda, 0xde, 0xb3, 0xcc, 0x69, 0x1a, 0xf1, 0x49, 0x4e, 0x01, 0x38, 0x0a, 0x12, 0x78,
+		0x12, 0x12, 0xd3, 0x0e, 0xc4, 0x8f, 0x7d, 0x45, 0x4c, 0x0f, 0x31, 0x02, 0x17, 0x21, 0x2b, 0x14,
+		0x14, 0x1e, 0xd8, 0x4c, 0x26, 0xf1, 0xe3, 0x9c, 0x44, 0xc6, 0x87, 0xc6, 0xbb, 0xe1, 0x18, 0xb6,
+		0xb3, 0x63, 0x4c, 0x43, 0xe4, 0x53, 0xa2, 0x1b, 0x1c, 0x85, 0xb3, 0x72, 0xcb, 0x30, 0x1b, 0xbb,
+		0xd3, 0x51, 0xf8, 0xb4, 0x60, 0xa5, 0xc0, 0x41, 0x12, 0x25, 0x48, 0x77, 0xf4, 0x9e, 0xbd, 0xab,
+		0xb7, 0xa7, 0x1a, 0x8e, 0x9f, 0xe0, 0x34, 0x52, 0x2e, 0x12, 0xe7, 0x48, 0xdf, 0x3c, 0x08, 0x99,
+		0x9f, 0x14, 0x1c, 0xe9, 0x9b, 0x01, 0x42, 0xeb, 0xb0, 0x60, 0x3b, 0xd4, 0xf2, 0x3d, 0x08, 0xb5,
+		0x7f, 0x20, 0xa6, 0x1e, 0xc3, 0x5d, 0xf5, 0x53, 0x7c, 0x12, 0x12, 0x76, 0xeb, 0xe5, 0xa9, 0xc8,
+		0xfc, 0x94, 0x18, 0x69, 0x8a, 0x80, 0xc8, 0x57, 0xe1, 0xe8, 0xc8, 0x65, 0x62, 0x0a, 0x62, 0x3f,
+		0xcd, 0x89, 0x1d, 0x1e, 0xb1, 0x54, 0x70, 0x95, 0x70, 0x50, 0x92, 0xff, 0x50, 0xa8, 0x04, 0x63,
+		0x80, 0xd6, 0x3a, 0xba, 0x3e, 0x6d, 0x7d, 0xfb, 0x60, 0x5c, 0xfb, 0x47, 0x82, 0x6b, 0x0c, 0x37,
+		0xc0, 0xb5, 0x0d, 0x38, 0xcc, 0x29, 0x1e, 0x6c, 0x5c, 0x7f, 0x46, 0x28, 0x56, 0x86, 0xbd, 0x19,
+		0x1c, 0xdd, 0x6f, 0x80, 0xbc, 0xcb, 0x4e, 0xe1, 0x63, 0xb3, 0x35, 0x8c, 0x50, 0x99, 0x4c, 0xf9,
+		0x67, 0x39, 0x65, 0xa1, 0xf1, 0x5d, 0x27, 0x9d, 0xbd, 0xaa, 0x77, 0x91, 0xf8, 0xf3, 0x90, 0x13,
+		0xc4, 0xfb, 0x66, 0xcf, 0x68, 0x58, 0x3b, 0x66, 0xeb, 0x65, 0xa3, 0x39, 0x05, 0xe9, 0xcf, 0x0c,
+		0x0c, 0xd5, 0xa6, 0x0f, 0x1d, 0x29, 0x57, 0x41, 0x76, 0x6d, 0x15, 0xad, 0xd5, 0xa1, 0xe7, 0x11,
+		0xfb, 0x53, 0xfc, 0xac, 0x18, 0x29, 0x17, 0xaf, 0x4a, 0xd1, 0x96, 0x2b, 0xc0, 0xa2, 0xd3, 0xa7,
+		0x15, 0xc9, 0xcf, 0x71, 0x42, 0x69, 0x0f, 0x8b, 0x2b, 0x8e, 0x86, 0xd5, 0xe9, 0xea, 0xbd, 0x69,
+		0xf4, 0xdf, 0x3f, 0x16, 0x8a, 0x83, 0xa3, 0x70, 0xc5, 0x81, 0x16, 0x1d, 0xae, 0xf6, 0x53, 0x50,
+		0xf8, 0x39, 0xa1, 0x38, 0x04, 0x0e, 0x27, 0x21, 0x0c, 0x86, 0x29, 0x48, 0xfc, 0xbc, 0x20, 0x21,
+		0x70, 0x90, 0xc4, 0xb3, 0xde, 0x42, 0xdb, 0x33, 0x76, 0x5a, 0xb6, 0xc3, 0xef, 0x8f, 0xec, 0x4f,
+		0xea, 0x17, 0xbe, 0x12, 0x34, 0xc2, 0x54, 0x1f, 0x2a, 0x6a, 0x22, 0xee, 0x18, 0xa3, 0x8e, 0xdf,
+		0xc9, 0x0d, 0xfb, 0x45, 0xa1, 0x89, 0x7c, 0x68, 0xd8, 0x36, 0x9f, 0x85, 0x88, 0x6c, 0x6f, 0xe0,
+		0x96, 0x6e, 0x0a, 0x72, 0xff, 0x64, 0xa0, 0x71, 0x75, 0x81, 0x8b, 0x34, 0x7d, 0xf6, 0x4f, 0xdf,
+		0xbc, 0x66, 0xec, 0x4d, 0x25, 0x9d, 0xff, 0x74, 0xc0, 0xfe, 0xd9, 0x64, 0x98, 0x4c, 0x87, 0x64,
+		0x07, 0xec, 0x29, 0x32, 0xe9, 0x72, 0x59, 0xee, 0x5b, 0xdf, 0xe2, 0xfd, 0x0d, 0x9a, 0x53, 0xcb,
+		0x2b, 0x20, 0xf3, 0x1c, 0xcf, 0x80, 0x9d, 0x48, 0xec, 0x83, 0x6f, 0xb9, 0x72, 0x1e, 0xb0, 0x79,
+		0x96, 0x2f, 0x40, 0x3a, 0x60, 0xf0, 0x4c, 0x26, 0xf5, 0x21, 0x4e, 0x2a, 0xe5, 0xb7, 0x77, 0x96,
+		0xcf, 0x42, 0x04, 0x8d, 0x97, 0xc9, 0xe8, 0xdf, 0xce, 0xd1, 0x29, 0xf8, 0xf2, 0x53, 0x10, 0x17,
+		0x46, 0xcb, 0x64, 0xd4, 0xff, 0x97, 0xa3, 0xba, 0x28, 0x88, 0x2e, 0x0c, 0x96, 0xc9, 0xe8, 0xff,
+		0x9f, 0x40, 0x17, 0x28, 0x88, 0x3e, 0x3d, 0x0b, 0x7f, 0xe5, 0x23, 0x11, 0x86, 0x2e, 0x50, 0x96,
+		0x31, 0x3a, 0x9e, 0x59, 0x2a, 0x93, 0xb1, 0xbf, 0x83, 0x57, 0x2e, 0x30, 0x96, 0x1f, 0x87, 0xe8,
+		0x94, 0x0c, 0xff, 0x28, 0x47, 0x65, 0xf0, 0xcb, 0x25, 0x48, 0xfa, 0xac, 0x93, 0xc9, 0xe8, 0xdf,
+		0xc5, 0xd1, 0xfd, 0x58, 0xd8, 0x74, 0x6e, 0x9d, 0x4c, 0x26, 0xf0, 0xff, 0x8b, 0xa6, 0x73, 0x0c,
+		0x64, 0x9b, 0x30, 0x4c, 0x26, 0x63, 0xbf, 0x2a, 0xb8, 0x2e, 0x50, 0x96, 0x9f, 0x86, 0x84, 0xbb,
+		0xd8, 0x4c, 0xc6, 0xff, 0x6e, 0x8e, 0xef, 0xe1, 0x20, 0x07, 0xfa, 0xe6, 0x01, 0x48, 0xfc, 0x3d,
+		0xc1, 0x01, 0x1f, 0x16, 0x4e, 0xa3, 0x41, 0x03, 0x66, 0x32, 0xa5, 0xef, 0x11, 0xd3, 0x68, 0xc0,
+		0x7e, 0xc1, 0xd1, 0xa4, 0x3a, 0x7f, 0x32, 0x89, 0xef, 0x15, 0xa3, 0x49, 0xe1, 0xb1, 0x19, 0x83,
+		0x16, 0xc1, 0x64, 0x1a, 0xdf, 0x2f, 0x9a, 0x31, 0x60, 0x10, 0x2c, 0xaf, 0x03, 0x19, 0xb6, 0x06,
+		0x26, 0xd3, 0x7b, 0x8d, 0xd3, 0x9b, 0x1b, 0x32, 0x06, 0x96, 0x9f, 0x83, 0xc3, 0xa3, 0x2d, 0x81,
+		0xc9, 0x54, 0x7f, 0xe0, 0xad, 0x81, 0xbd, 0x9b, 0xdf, 0x10, 0x58, 0xde, 0x80, 0x85, 0x51, 0x56,
+		0xc0, 0x64, 0xb2, 0x3f, 0xf8, 0x56, 0x50, 0x71, 0xfb, 0x8d, 0x80, 0xe5, 0x02, 0x80, 0xb7, 0x00,
+		0x4f, 0xa6, 0xf5, 0x43, 0x9c, 0x96, 0x0f, 0x09, 0xa7, 0x06, 0x5f, 0x7f, 0x27, 0xe3, 0xdf, 0x12,
+		0x53, 0x83, 0x63, 0xe0, 0xd4, 0x10, 0x4b, 0xef, 0x64, 0xec, 0x1f, 0x16, 0x53, 0x43, 0xa0, 0xa0,
+		0x64, 0xfb, 0x56, 0xb7, 0xc9, 0x14, 0x3e, 0x29, 0x24, 0xdb, 0x87, 0xb5, 0xbc, 0x06, 0x73, 0x43,
+		0x0b, 0xe2, 0x64, 0x52, 0x3f, 0xc2, 0x49, 0xc9, 0x83, 0xeb, 0xa1, 0x7f, 0xf1, 0xe2, 0x8b, 0xe1,
+		0x64, 0x6a, 0x3f, 0x3a, 0xb0, 0x78, 0xf1, 0xb5, 0x70, 0xf9, 0x49, 0x88, 0x9b, 0xfd, 0x76, 0x1b,
+		0x27, 0x0f, 0xd9, 0xff, 0xfe, 0x60, 0xee, 0x8f, 0xbe, 0xca, 0xb9, 0x23, 0x10, 0x96, 0xcf, 0x42,
+		0xd4, 0xe8, 0x6c, 0x19, 0xcd, 0x49, 0x98, 0x5f, 0xfe, 0xaa, 0x50, 0x98, 0x08, 0xbd, 0xfc, 0x34,
+		0x00, 0x73, 0x8d, 0xd0, 0x18, 0xdc, 0x09, 0xb8, 0x7f, 0xfc, 0x55, 0x7e, 0x61, 0xc7, 0x43, 0xf1,
+		0x08, 0xb0, 0xeb, 0x3f, 0xfb, 0x13, 0xf8, 0x4a, 0x90, 0x00, 0x1d, 0x91, 0xf3, 0x30, 0x8b, 0x47,
+		0x6f, 0x8e, 0xbe, 0x33, 0x09, 0xfb, 0x4f, 0x38, 0xb6, 0x80, 0x47, 0x86, 0x75, 0xac, 0x9e, 0xe1,
+		0xe8, 0x3b, 0xf6, 0x24, 0xdc, 0xff, 0xca, 0x71, 0x5d, 0x04, 0x44, 0x6e, 0xe8, 0xb6, 0x33, 0x4d,
+		0xbf, 0xff, 0x54, 0x20, 0x0b, 0x04, 0x6c, 0x34, 0xfe, 0xbe, 0x66, 0xec, 0x4d, 0xc2, 0xfd, 0x33,
+		0xd1, 0x68, 0x0e, 0xbf, 0xfc, 0x14, 0x24, 0xf0, 0x27, 0xbb, 0x85, 0x37, 0x01, 0xf9, 0xcf, 0x39,
+		0xb2, 0x87, 0x81, 0x35, 0xdb, 0x4e, 0xd3, 0x69, 0x4d, 0x66, 0xf6, 0x5f, 0xf0, 0x91, 0x16, 0xf0,
+		0xcb, 0x05, 0x48, 0xda, 0x4e, 0xb3, 0xd9, 0xe7, 0xf6, 0xe9, 0x04, 0xf4, 0xbf, 0xfc, 0xaa, 0xeb,
+		0xb2, 0x70, 0x71, 0x70, 0xb4, 0x6f, 0x5c, 0x73, 0xba, 0x16, 0x8d, 0xda, 0x98, 0x44, 0xe1, 0x2d,
+		0x4e, 0xc1, 0x87, 0xb2, 0x5c, 0x82, 0x14, 0xf6, 0x45, 0x1c, 0x7e, 0x4f, 0x22, 0xf1, 0x57, 0x9c,
+		0x01, 0x01, 0xa4, 0xe2, 0x37, 0xff, 0xc6, 0x17, 0x8e, 0x4b, 0x9f, 0xff, 0xc2, 0x71, 0xe9, 0xbf,
+		0x7c, 0xe1, 0xb8, 0xf4, 0xea, 0x17, 0x8f, 0xcf, 0x7c, 0xfe, 0x8b, 0xc7, 0x67, 0x7e, 0xef, 0x8b,
+		0xc7, 0x67, 0x46, 0x7b, 0x89, 0xe1, 0xa2, 0x75, 0xd1, 0x62, 0xfe, 0xe1, 0x17, 0xee, 0xd9, 0x69,
+		0x39, 0xbb, 0xfd, 0xad, 0xa5, 0x86, 0xd5, 0x39, 0xdd, 0xb0, 0xec, 0x8e, 0x65, 0x9f, 0x0e, 0xfa,
+		0x75, 0xe9, 0x2f, 0xf8, 0x9f, 0x12, 0x1c, 0x65, 0x64, 0x3c, 0x77, 0xae, 0x6e, 0xee, 0x8d, 0x7b,
+		0xd7, 0xe5, 0x1c, 0x84, 0x0b, 0xe6, 0x1e, 0x39, 0xca, 0x14, 0x9c, 0xd6, 0xef, 0xb5, 0xf9, 0x55,
+		0xb0, 0x59, 0x4c, 0x6f, 0xf6, 0xda, 0xc1, 0x30, 0xe0, 0x14, 0x0f, 0x03, 0x2e, 0x7e, 0x97, 0x74,
+		0xb0, 0x9e, 0xc4, 0x0b, 0xe6, 0x1e, 0xed, 0xc8, 0xba, 0xf4, 0xc2, 0x83, 0x13, 0xfd, 0xdc, 0xd7,
+		0x4c, 0xeb, 0x86, 0x89, 0xcd, 0xee, 0x6e, 0x09, 0x1f, 0xf7, 0xf1, 0x41, 0x1f, 0xf7, 0x73, 0x46,
+		0xbb, 0xfd, 0x0c, 0xc2, 0xe1, 0x71, 0xb4, 0xbd, 0x15, 0x63, 0xb7, 0x8e, 0xe1, 0x7b, 0x42, 0x70,
+		0x7c, 0xc8, 0x9d, 0xcd, 0x85, 0x60, 0x1c, 0x13, 0x96, 0x21, 0x5e, 0x16, 0xb2, 0x95, 0xc3, 0x57,
+		0x55, 0x1a, 0x96, 0xd9, 0x64, 0xf1, 0x9c, 0x61, 0x55, 0x24, 0x91, 0x11, 0xa6, 0x6e, 0x5a, 0x36,
+		0xbf, 0x4c, 0xc9, 0x12, 0xc5, 0x8f, 0x1d, 0x90, 0x11, 0x69, 0x51, 0x93, 0xe0, 0xc6, 0x23, 0x53,
+		0x72, 0x43, 0x74, 0x22, 0xe0, 0xf9, 0x9f, 0x96, 0x2b, 0xdf, 0x1f, 0x82, 0x13, 0x83, 0x5c, 0xc1,
+		0x99, 0x65, 0x3b, 0x7a, 0xa7, 0x3b, 0x8e, 0x2d, 0x4f, 0x42, 0x62, 0x43, 0xc0, 0x1c, 0x98, 0x2f,
+		0xb7, 0x0e, 0xc8, 0x97, 0x8c, 0x5b, 0x95, 0x60, 0xcc, 0x99, 0x29, 0x19, 0xe3, 0xf6, 0xe3, 0xb6,
+		0x38, 0xf3, 0xb1, 0x04, 0x1c, 0x65, 0xd3, 0x49, 0x63, 0x53, 0x89, 0x25, 0x38, 0x4f, 0x52, 0xfe,
+		0xa2, 0xc9, 0xe7, 0x24, 0xca, 0x33, 0x30, 0x5f, 0x45, 0x6d, 0x81, 0xbb, 0x20, 0xef, 0x84, 0x67,
+		0xe4, 0x7d, 0xd3, 0xc5, 0x80, 0xc1, 0xcf, 0x4f, 0x02, 0xfd, 0x59, 0xca, 0xb7, 0x4a, 0x20, 0xd7,
+		0x1b, 0x7a, 0x5b, 0xef, 0xbd, 0x5d, 0x52, 0xe4, 0x71, 0x00, 0x16, 0x2d, 0xe4, 0xbe, 0x14, 0x83,
+		0xa7, 0xe1, 0xfe, 0xce, 0x2d, 0xb1, 0x9a, 0xe8, 0x5d, 0x8c, 0x04, 0x85, 0xc5, 0x9f, 0xa7, 0x9e,
+		0x07, 0xf0, 0x0a, 0x30, 0xba, 0xa2, 0x5e, 0x2a, 0xac, 0x14, 0x54, 0x11, 0xf6, 0x51, 0x5f, 0xaf,
+		0x94, 0xd8, 0xc3, 0x0d, 0x33, 0x18, 0xba, 0xe0, 0x2f, 0x74, 0xe3, 0xd7, 0x0f, 0xc1, 0x9c, 0x3f,
+		0x9f, 0xdd, 0xa2, 0x0f, 0x2d, 0x5f, 0x42, 0x4f, 0x01, 0x46, 0x2c, 0x6a, 0x7a, 0xb3, 0x69, 0x34,
+		0xb5, 0x96, 0x49, 0x26, 0xdc, 0x98, 0xcd, 0xfd, 0xe6, 0xbf, 0x8f, 0xd2, 0xae, 0xa5, 0x19, 0x62,
+		0x01, 0xf1, 0xaa, 0x26, 0xda, 0x9c, 0xad, 0x4e, 0xb7, 0x6d, 0xd0, 0xd3, 0x5e, 0xad, 0x25, 0xf8,
+		0x3f, 0xd9, 0x9c, 0x41, 0x7a, 0x78, 0x72, 0x3c, 0xef, 0xa1, 0xbb, 0xa3, 0xb7, 0xfc, 0x8c, 0x17,
+		0x4f, 0xe4, 0x36, 0x70, 0x22, 0xc5, 0xdf, 0xe2, 0x2d, 0x14, 0xde, 0x0c, 0xd1, 0xc4, 0x15, 0x98,
+		0xc3, 0x6b, 0x66, 0xdd, 0x40, 0xfb, 0x26, 0xac, 0x20, 0xa2, 0xb7, 0x32, 0xc7, 0xf4, 0x9a, 0xf6,
+		0x38, 0xc4, 0x6c, 0x3a, 0x28, 0x93, 0x48, 0x88, 0xe6, 0x70, 0x70, 0x74, 0x7f, 0x31, 0x31, 0x70,
+		0x7b, 0x34, 0x81, 0xc0, 0xbf, 0xe6, 0x04, 0x52, 0x14, 0x4d, 0xf4, 0xc6, 0x84, 0x39, 0xf6, 0x4c,
+		0x8a, 0xe1, 0xeb, 0xcd, 0xfe, 0x5e, 0x94, 0x7f, 0xf6, 0xd9, 0x87, 0xe9, 0x09, 0xfb, 0x5d, 0x41,
+		0xa1, 0x1b, 0x31, 0x59, 0x54, 0x99, 0xd3, 0xf6, 0xfa, 0x6b, 0x40, 0x46, 0xd4, 0xc7, 0xfb, 0xbd,
+		0x7f, 0x65, 0xbf, 0xc4, 0x2b, 0x3b, 0x3e, 0x4a, 0xc2, 0x7d, 0x35, 0xa5, 0x39, 0x55, 0x56, 0xb0,
+		0x5c, 0x84, 0x34, 0x1e, 0xca, 0x7b, 0xcc, 0xd9, 0xbf, 0x96, 0x5f, 0xfe, 0xec, 0xc3, 0x6c, 0xa2,
+		0x21, 0x12, 0x67, 0x4d, 0xb1, 0x32, 0x4e, 0xeb, 0xbd, 0xf0, 0xc0, 0xf0, 0xfa, 0xcd, 0xfe, 0x3d,
+		0x44, 0xa9, 0x3f, 0xe9, 0x6f, 0xaa, 0xa7, 0x9d, 0x22, 0x30, 0x87, 0x17, 0xb8, 0xad, 0xd3, 0xf4,
+		0x2f, 0xd7, 0x4a, 0x51, 0x9a, 0x98, 0xe2, 0xd8, 0xf6, 0x1c, 0x53, 0x16, 0x93, 0xe5, 0xf6, 0xcf,
+		0xbf, 0xf3, 0xc7, 0xa3, 0x9e, 0x42, 0x59, 0x5e, 0x1d, 0x8e, 0xa5, 0x9b, 0x4c, 0xe3, 0x2f, 0x04,
+		0x8d, 0xc1, 0xd0, 0xb8, 0xe5, 0xf7, 0xe2, 0x59, 0x28, 0x27, 0x33, 0xc9, 0x76, 0x13, 0x44, 0x5c,
+		0x0c, 0xb4, 0xdc, 0x98, 0xd0, 0x4e, 0x63, 0xa7, 0xbf, 0x25, 0xf0, 0x99, 0x0e, 0xc3, 0x48, 0xd4,
+		0xe5, 0x8b, 0x90, 0x69, 0x5a, 0xa6, 0xa3, 0x59, 0x9d, 0x96, 0x63, 0x74, 0xba, 0xce, 0x44, 0xcb,
+		0xf7, 0xaf, 0x18, 0x91, 0xb8, 0x9a, 0x46, 0xbc, 0x9a, 0x40, 0xc3, 0x96, 0xb0, 0x97, 0x56, 0xa6,
+		0x69, 0xc9, 0x7f, 0x73, 0x5b, 0x42, 0x71, 0xb0, 0x25, 0xb7, 0x25, 0x1d, 0x76, 0xf3, 0x1a, 0x5f,
+		0xee, 0x9c, 0x9b, 0x4c, 0x0a, 0x5c, 0xe9, 0xf8, 0xb5, 0x08, 0x1c, 0xe7, 0xc0, 0x5b, 0xba, 0x6d,
+		0x9c, 0xbe, 0xfe, 0xc8, 0x96, 0xe1, 0xe8, 0x8f, 0x9c, 0x6e, 0x58, 0x2d, 0x61, 0xeb, 0xcc, 0xb3,
+		0xf2, 0x25, 0x2c, 0x5f, 0xe2, 0xe5, 0xf9, 0x91, 0x01, 0x01, 0xf9, 0xf1, 0xcb, 0x60, 0x7e, 0x58,
+		0x06, 0x95, 0x36, 0x44, 0x4a, 0x56, 0x8b, 0xde, 0x12, 0x6b, 0x1a, 0xa6, 0xd5, 0xe1, 0x0b, 0x12,
+		0x4b, 0x90, 0x4b, 0x10, 0xd3, 0x3b, 0x56, 0xdf, 0x74, 0xd8, 0x62, 0x54, 0x7c, 0xf8, 0x37, 0x5e,
+		0x3f, 0x31, 0xf3, 0x9f, 0x5e, 0x3f, 0x71, 0x88, 0x91, 0xb5, 0x9b, 0xd7, 0x96, 0x5a, 0xd6, 0xe9,
+		0x8e, 0xee, 0xec, 0xa2, 0x0a, 0xf8, 0xdd, 0xcf, 0x3d, 0x04, 0xbc, 0xbe, 0xaa, 0xe9, 0x7c, 0xfa,
+		0x8d, 0x9f, 0x39, 0x25, 0xa9, 0x1c, 0x7f, 0x39, 0xf2, 0xe6, 0x27, 0x4e, 0x48, 0x4a, 0x17, 0x66,
+		0xcb, 0x46, 0x63, 0x9f, 0x0a, 0xab, 0x03, 0x15, 0x3e, 0xc2, 0x2b, 0x3c, 0x36, 0x5c, 0x21, 0xbb,
+		0x7d, 0x52, 0x36, 0x1a, 0xbe, 0x6a, 0xcb, 0x46, 0x63, 0xa0, 0xc6, 0x2a, 0xc4, 0xab, 0xa6, 0xc3,
+		0x9e, 0x8b, 0x78, 0x0a, 0xc2, 0x2d, 0x93, 0xdd, 0x32, 0x4e, 0x14, 0x1f, 0x38, 0x40, 0x57, 0x54,
+		0xc4, 0x53, 0x6a, 0x10, 0x2f, 0x1b, 0x0d, 0x46, 0xaa, 0x04, 0xe1, 0xa6, 0xd1, 0xc8, 0x49, 0xb7,
+		0xdb, 0x48, 0xc4, 0x2e, 0x96, 0x7f, 0xef, 0x0f, 0x8e, 0xcf, 0x7c, 0xe0, 0x0b, 0xc7, 0x67, 0xc6,
+		0x8a, 0x93, 0x32, 0x59, 0x9c, 0x5c, 0x29, 0xfa, 0xf1, 0x08, 0xdc, 0x49, 0xdf, 0x1b, 0xea, 0x75,
+		0x5a, 0xa6, 0x73, 0xba, 0xd1, 0xdb, 0xeb, 0x3a, 0x16, 0x2a, 0x15, 0x6b, 0x9b, 0x0b, 0xd1, 0x9c,
+		0x57, 0xbc, 0xc4, 0x8a, 0x47, 0x8b, 0x90, 0xb2, 0x0d, 0xd1, 0x75, 0xc4, 0xc3, 0x41, 0x72, 0x2c,
+		0x47, 0x6f, 0x73, 0x5b, 0x91, 0x25, 0x30, 0x97, 0xbd, 0x51, 0x14, 0x62, 0xb9, 0x2d, 0xf1, 0x3c,
+		0x51, 0xdb, 0xd0, 0xb7, 0xd9, 0x53, 0x0f, 0x61, 0xba, 0xc9, 0x88, 0x63, 0x06, 0x7d, 0xd5, 0x61,
+		0x01, 0xa2, 0x7a, 0x9f, 0x05, 0x86, 0x85, 0x71, 0xf7, 0x41, 0x13, 0xca, 0x33, 0x30, 0xcb, 0xa3,
+		0x1e, 0x30, 0x32, 0xea, 0x9a, 0xb1, 0x47, 0xeb, 0x49, 0xa9, 0xf8, 0x93, 0x2c, 0x41, 0x94, 0x36,
+		0x9e, 0xdf, 0xb0, 0xc9, 0x2d, 0x0d, 0xb5, 0x7e, 0x89, 0x36, 0x52, 0x65, 0x60, 0xca, 0x65, 0x88,
+		0x97, 0x2d, 0x94, 0xec, 0x20, 0xb5, 0x04, 0xa3, 0x46, 0xdb, 0xdc, 0xed, 0x3b, 0xe2, 0x16, 0x24,
+		0x4d, 0xe0, 0x9d, 0x62, 0xf6, 0xf4, 0x07, 0x0f, 0x6e, 0xe3, 0x29, 0xa5, 0x04, 0xb3, 0x94, 0x76,
+		0xad, 0xeb, 0xbe, 0xa7, 0x25, 0xf9, 0xde, 0xd3, 0xe2, 0xe4, 0x43, 0x5e, 0x63, 0x09, 0x44, 0x9a,
+		0xba, 0xa3, 0xf3, 0x7e, 0xd3, 0xdf, 0xca, 0xfb, 0x20, 0xce, 0x89, 0xd8, 0xe4, 0x0c, 0x84, 0xad,
+		0xae, 0x88, 0xdd, 0xcc, 0x8f, 0xeb, 0x4a, 0xad, 0x5b, 0x8c, 0xa0, 0x2c, 0xa9, 0x08, 0x5c, 0x5c,
+		0x1b, 0x2b, 0x16, 0x8f, 0x05, 0xc4, 0xa2, 0x63, 0x38, 0x5b, 0xdb, 0x8e, 0xf7, 0x83, 0x0d, 0xe7,
+		0x90, 0x28, 0xb8, 0x82, 0x72, 0x2b, 0x04, 0xc7, 0x7d, 0xa5, 0xd7, 0x8d, 0x1e, 0xba, 0xfd, 0x98,
+		0x34, 0x71, 0x49, 0x21, 0xbe, 0x06, 0xf2, 0xf2, 0x31, 0xa2, 0xf2, 0x14, 0x84, 0x0b, 0xdd, 0x2e,
+		0xbe, 0x7e, 0x45, 0xd3, 0x0d, 0x8b, 0xc9, 0x4a, 0x44, 0x75, 0xd3, 0x58, 0x66, 0x5b, 0xdb, 0xce,
+		0x0d, 0xbd, 0xe7, 0xbe, 0x8c, 0x25, 0xd2, 0xca, 0x79, 0x48, 0x94, 0x2c, 0xd3, 0x36, 0x4c, 0xbb,
+		0x4f, 0x77, 0x20, 0x5b, 0x6d, 0xab, 0x71, 0x8d, 0x53, 0x60, 0x09, 0x64, 0xb6, 0xde, 0xed, 0x52,
+		0xcc, 0x88, 0x8a, 0x3f, 0xd9, 0xcc, 0x2e, 0xd6, 0xc6, 0xb2, 0xe7, 0xec, 0xc1, 0xd8, 0xc3, 0x3b,
+		0xe8, 0xf2, 0xe7, 0x7f, 0x48, 0x70, 0xc7, 0xf0, 0x44, 0xba, 0x66, 0xec, 0xd9, 0x07, 0x9d, 0x47,
+		0xcf, 0x43, 0x62, 0x9d, 0xbe, 0x4f, 0xfa, 0x8c, 0xb1, 0x47, 0xf2, 0x18, 0xc6, 0x7a, 0xe6, 0xec,
+		0xd9, 0x47, 0xce, 0x33, 0x29, 0xbf, 0x34, 0xa3, 0x8a, 0x0c, 0x72, 0x1c, 0x12, 0xb6, 0xd1, 0xe8,
+		0x9e, 0x39, 0x7b, 0xee, 0xda, 0x23, 0x4c, 0xac, 0x2e, 0xcd, 0xa8, 0x5e, 0xd6, 0x72, 0x1c, 0x7b,
+		0xfc, 0xe6, 0x27, 0x4f, 0x48, 0xc5, 0x28, 0x84, 0xed, 0x7e, 0xe7, 0xeb, 0x26, 0x1b, 0x7f, 0x16,
+		0x83, 0x45, 0x5f, 0x29, 0x5b, 0xb0, 0xf8, 0x55, 0x00, 0xf7, 0x55, 0x59, 0xd9, 0xd7, 0x7f, 0x0a,
+		0x31, 0x66, 0x25, 0xda, 0x97, 0x8b, 0xca, 0x67, 0x24, 0x48, 0x5d, 0x11, 0x94, 0xf1, 0x5a, 0xc8,
+		0x93, 0x00, 0x6e, 0x4d, 0x62, 0xaa, 0x1c, 0x5b, 0x1a, 0xac, 0x6b, 0xc9, 0xc5, 0x51, 0x7d, 0xe0,
+		0x78, 0xdf, 0xa9, 0xdb, 0xb3, 0xba, 0x96, 0xcd, 0x5f, 0x49, 0x9a, 0x80, 0xea, 0x02, 0xe3, 0x25,
+		0x1c, 0xaa, 0xd5, 0xb4, 0xeb, 0x96, 0x83, 0x01, 0x3d, 0x5d, 0xeb, 0x06, 0x7f, 0x7b, 0x2e, 0xac,
+		0xca, 0xb4, 0xe4, 0x0a, 0x2d, 0x58, 0xc7, 0x7c, 0x6c, 0x74, 0xc2, 0xa5, 0x82, 0x9b, 0x69, 0xbd,
+		0xd9, 0xec, 0x19, 0xb6, 0xcd, 0x15, 0x97, 0x48, 0xe2, 0xd3, 0x4c, 0xdd, 0xfe, 0x96, 0x26, 0xb4,
+		0x04, 0x3e, 0x6e, 0x35, 0x62, 0xce, 0x0b, 0xd9, 0xe0, 0xb3, 0x3e, 0xd6, 0xed, 0x6f, 0xa1, 0xa4,
+		0xdc, 0x05, 0xa9, 0x11, 0x8d, 0x49, 0x5e, 0xf7, 0xda, 0x41, 0x9f, 0xc4, 0xe5, 0x3d, 0xd0, 0xba,
+		0xbd, 0x96, 0xd5, 0x6b, 0x39, 0x7b, 0x34, 0xac, 0x34, 0xac, 0xca, 0xa2, 0x60, 0x9d, 0xe7, 0x2b,
+		0xd7, 0x20, 0x5b, 0xa7, 0x5b, 0x23, 0xaf, 0xe5, 0x67, 0xbd, 0xf6, 0x49, 0x93, 0xdb, 0x37, 0xb6,
+		0x65, 0xa1, 0xa1, 0x96, 0x9d, 0xfa, 0x0f, 0x12, 0x24, 0x8b, 0x38, 0x71, 0xab, 0xe5, 0x0b, 0x6d,
+		0x7d, 0x87, 0x3c, 0x02, 0x87, 0x8a, 0x2b, 0xb5, 0xd2, 0x33, 0x5a, 0xb5, 0xac, 0x5d, 0x58, 0x29,
+		0xf8, 0x42, 0xef, 0xf3, 0x87, 0x5f, 0xb9, 0xb5, 0x48, 0x7c, 0xb0, 0x9b, 0x26, 0xf5, 0x04, 0x90,
+		0xd3, 0xb0, 0x10, 0x44, 0x29, 0x14, 0xeb, 0x78, 0xe1, 0x4b, 0xca, 0x1f, 0x7a, 0xe5, 0xd6, 0xe2,
+		0x9c, 0x0f, 0xa3, 0xb0, 0x65, 0x1b, 0xa6, 0x33, 0x8c, 0x50, 0xaa, 0xad, 0xae, 0xe2, 0xfd, 0x87,
+		0x21, 0x04, 0x0c, 0x26, 0x6e, 0x39, 0xe4, 0x7e, 0x98, 0x0b, 0x22, 0xac, 0x55, 0x57, 0xe4, 0x70,
+		0x9e, 0xbc, 0x72, 0x6b, 0x31, 0xe3, 0x83, 0x5e, 0x6b, 0xb5, 0xf3, 0xf1, 0x0f, 0xff, 0xe8, 0xf1,
+		0x99, 0x4f, 0xff, 0xfd, 0xe3, 0x52, 0x71, 0x75, 0xec, 0x9c, 0x7b, 0xf4, 0x60, 0x73, 0x2e, 0xb8,
+		0x6e, 0x7f, 0xf5, 0x0e, 0xb8, 0x63, 0xb0, 0x30, 0xa0, 0x8c, 0xa7, 0x9d, 0x6e, 0x93, 0x3c, 0x43,
+		0xf9, 0xfd, 0xcd, 0x83, 0xfc, 0x84, 0x45, 0x21, 0x3f, 0x51, 0x31, 0x28, 0xe7, 0x21, 0x8d, 0x57,
+		0xc2, 0xea, 0x86, 0x73, 0xc9, 0xd0, 0x9b, 0x46, 0x2f, 0x68, 0x3f, 0xa4, 0x85, 0xfd, 0x40, 0x20,
+		0x42, 0x8d, 0x04, 0xb6, 0x7e, 0xd2, 0xdf, 0xca, 0x2e, 0x44, 0x10, 0xd5, 0xb3, 0x2d, 0x38, 0x06,
+		0x4d, 0x60, 0xee, 0xd6, 0x9e, 0xc3, 0x6f, 0xdb, 0xa6, 0x54, 0x96, 0x20, 0x8f, 0x09, 0x0b, 0x21,
+		0xbc, 0xbf, 0x85, 0xc0, 0xa7, 0x17, 0xb7, 0x13, 0xda, 0x30, 0xcb, 0x87, 0xd8, 0x6d, 0x88, 0xe4,
+		0x35, 0x84, 0xac, 0x42, 0xb6, 0xab, 0xf7, 0x1c, 0xfa, 0x36, 0xcd, 0x2e, 0xed, 0x05, 0x9f, 0xc1,
+		0x27, 0x86, 0xf5, 0x49, 0xa0, 0xb3, 0xbc, 0x96, 0x74, 0xd7, 0x9f, 0xa9, 0x7c, 0x29, 0x02, 0x31,
+		0xce, 0x8c, 0xa7, 0x60, 0x96, 0xb3, 0x95, 0xcf, 0xb9, 0x3b, 0x97, 0x86, 0x97, 0xd9, 0x25, 0x77,
+		0x39, 0xe4, 0xf4, 0x04, 0x0e, 0xb9, 0x17, 0xe2, 0x8d, 0x5d, 0xbd, 0x65, 0x6a, 0xad, 0x26, 0x37,
+		0x8e, 0x93, 0x5f, 0x78, 0xfd, 0xc4, 0x6c, 0x09, 0xf3, 0xaa, 0x65, 0x75, 0x96, 0x16, 0x56, 0x9b,
+		0x68, 0xd3, 0xec, 0x1a, 0xad, 0x9d, 0x5d, 0x87, 0xeb, 0x0d, 0x9e, 0xc2, 0x57, 0xbe, 0x51, 0x20,
+		0xf8, 0x15, 0x88, 0xfc, 0xd0, 0xae, 0xc7, 0x75, 0xdc, 0x15, 0xe3, 0x58, 0xf1, 0xab, 0xff, 0xf9,
+		0x84, 0xa4, 0x52, 0x0c, 0x52, 0x82, 0x74, 0x5b, 0xb7, 0x1d, 0x8d, 0xae, 0xc7, 0x58, 0x3d, 0xbb,
+		0xfb, 0x70, 0x74, 0x98, 0x21, 0x9c, 0xb1, 0xbc, 0xe9, 0x49, 0xc4, 0x62, 0x59, 0x4d, 0x7c, 0xad,
+		0x89, 0x12, 0x69, 0xd0, 0x19, 0xc8, 0xac, 0xc4, 0x18, 0xe5, 0x7b, 0x06, 0xf3, 0xd9, 0xc4, 0xa4,
+		0xb6, 0xe2, 0x31, 0x48, 0xd0, 0x07, 0x98, 0x28, 0x08, 0xbb, 0xc2, 0x18, 0xc7, 0x0c, 0x5a, 0x78,
+		0x1f, 0x64, 0x3d, 0xad, 0xcf, 0x40, 0xe2, 0x8c, 0x8a, 0x97, 0x4d, 0x01, 0x1f, 0x86, 0x05, 0xd3,
+		0xb8, 0xe9, 0x68, 0x83, 0xd0, 0x09, 0x0a, 0x4d, 0xb0, 0xec, 0x4a, 0x10, 0xe3, 0x1e, 0xc8, 0x34,
+		0x04, 0xf3, 0x19, 0x2c, 0x50, 0xd8, 0xb4, 0x9b, 0x4b, 0xc1, 0x8e, 0x42, 0x5c, 0xef, 0x76, 0x19,
+		0x40, 0x92, 0x6b, 0xfd, 0x6e, 0x97, 0x16, 0x9d, 0x82, 0x39, 0xda, 0xc7, 0x9e, 0x61, 0xe3, 0xe5,
+		0x1a, 0x06, 0x93, 0xa2, 0x30, 0x59, 0x2c, 0x50, 0x59, 0x3e, 0x85, 0xbd, 0x1b, 0xd2, 0xc6, 0x75,
+		0xbc, 0x3f, 0xda, 0x30, 0x18, 0x5c, 0x9a, 0xc2, 0xa5, 0x44, 0x26, 0x05, 0xba, 0x1f, 0x5c, 0x6d,
+		0xae, 0x89, 0x95, 0x26, 0xc3, 0xe8, 0x89, 0xfc, 0x02, 0xcb, 0x56, 0x72, 0x10, 0x29, 0xeb, 0x8e,
+		0x8e, 0xe6, 0x92, 0x73, 0x93, 0x2d, 0x9f, 0x29, 0x15, 0x7f, 0x2a, 0x3f, 0x1f, 0x86, 0xc8, 0x15,
+		0xcb, 0x31, 0xc8, 0xa3, 0x3e, 0x53, 0x36, 0x33, 0x4a, 0x9e, 0xeb, 0xad, 0x1d, 0xd3, 0x68, 0xae,
+		0xda, 0x3b, 0xbe, 0x47, 0x4f, 0x3d, 0x71, 0x0a, 0x05, 0xc4, 0x69, 0x01, 0xa2, 0x3d, 0xab, 0x6f,
+		0x36, 0xc5, 0x05, 0x07, 0x9a, 0x20, 0x15, 0x88, 0xbb, 0x52, 0x12, 0x99, 0x24, 0x25, 0x59, 0x94,
+		0x12, 0x94, 0x61, 0x9e, 0xa1, 0xce, 0x6e, 0x71, 0x61, 0x29, 0x42, 0xc2, 0x55, 0x5e, 0xb9, 0xe8,
+		0x01, 0x04, 0xd6, 0x43, 0xc3, 0x25, 0xd2, 0x1d, 0x7b, 0x97, 0x79, 0x4c, 0xe2, 0x64, 0xb7, 0x80,
+		0x73, 0x2f, 0x20, 0x56, 0xfc, 0x01, 0xd6, 0x59, 0xda, 0x2f, 0x4f, 0xac, 0xd8, 0x23, 0xac, 0x77,
+		0x60, 0x1c, 0xe4, 0x8e, 0x49, 0x2f, 0xef, 0x70, 0xc9, 0xf3, 0x32, 0xb0, 0xd4, 0xbb, 0x08, 0xcb,
+		0x24, 0xcd, 0xcb, 0xc0, 0xe7, 0x4c, 0xdc, 0x84, 0xe6, 0x51, 0x61, 0x52, 0x46, 0xdc, 0xa2, 0xba,
+		0x28, 0x51, 0x7e, 0x45, 0x82, 0x18, 0x5f, 0xb1, 0xbc, 0x61, 0x90, 0x46, 0x0f, 0x43, 0x68, 0xdc,
+		0x30, 0x84, 0x6f, 0x7f, 0x18, 0x0a, 0x00, 0x6e, 0x33, 0x6d, 0xfe, 0xcc, 0xe6, 0x08, 0xb3, 0x8a,
+		0x35, 0xb1, 0xde, 0xda, 0xe1, 0xf3, 0xde, 0x87, 0xa4, 0xfc, 0xbe, 0x04, 0x09, 0xb7, 0x9c, 0x14,
+		0x20, 0x2d, 0xda, 0xa5, 0x6d, 0xb7, 0xf5, 0x1d, 0x2e, 0x8a, 0x77, 0x8e, 0x6d, 0x1c, 0xae, 0xc2,
+		0x6a, 0x92, 0xb7, 0x07, 0x13, 0xa3, 0x87, 0x35, 0x34, 0x66, 0x58, 0x03, 0x72, 0x14, 0xbe, 0x3d,
+		0x39, 0x0a, 0x8c, 0x78, 0x64, 0x60, 0xc4, 0x95, 0x3f, 0x90, 0xf8, 0x03, 0xdf, 0x4d, 0xa3, 0xf9,
+		0xbf, 0x73, 0xa8, 0x5e, 0xe0, 0xb2, 0x85, 0x2e, 0xcf, 0xa1, 0x31, 0xbb, 0x7b, 0x98, 0x62, 0xb0,
+		0xcd, 0xde, 0xd8, 0x11, 0x41, 0xa5, 0xee, 0x8d, 0xe1, 0xe7, 0x42, 0x30, 0x37, 0x04, 0xff, 0xb7,
+		0x6f, 0x2c, 0x83, 0xb3, 0x37, 0x3a, 0xe5, 0xec, 0x8d, 0x8d, 0x9d, 0xbd, 0x9f, 0x0d, 0x51, 0x07,
+		0x40, 0xd7, 0xb2, 0xf5, 0xf6, 0xdf, 0x84, 0xee, 0x3d, 0x06, 0x89, 0xae, 0xd5, 0xd6, 0x58, 0x09,
+		0xbb, 0x62, 0x16, 0xef, 0x5a, 0x6d, 0x75, 0x48, 0xcc, 0xa2, 0xef, 0x90, 0x62, 0x8e, 0xbd, 0x03,
+		0x83, 0x30, 0x3b, 0x38, 0xa1, 0x7a, 0x90, 0x62, 0xac, 0xe0, 0x56, 0xd3, 0xc3, 0xc8, 0x03, 0xfc,
+		0x95, 0x93, 0x86, 0xad, 0x3c, 0xd6, 0x6c, 0x06, 0xa9, 0xc6, 0x76, 0x5d, 0x0c, 0x66, 0x64, 0xe4,
+		0x42, 0xe3, 0x30, 0x98, 0x14, 0xab, 0x1c, 0x4e, 0xf9, 0x3e, 0x09, 0x60, 0x05, 0x39, 0x4b, 0xfb,
+		0x8b, 0xf6, 0x8e, 0x4d, 0x9b, 0xa0, 0x05, 0x6a, 0x3e, 0x3e, 0x6e, 0xd0, 0x78, 0xfd, 0x29, 0xdb,
+		0xdf, 0xee, 0x12, 0xa4, 0x3d, 0xd9, 0xb6, 0x0d, 0xd1, 0x98, 0xe3, 0xfb, 0xec, 0x4a, 0xf1, 0xce,
+		0x68, 0xea, 0xba, 0x2f, 0xa5, 0xfc, 0x73, 0x09, 0x12, 0xb4, 0x4d, 0xf8, 0xf0, 0x64, 0x60, 0x0c,
+		0xa5, 0xdb, 0x1f, 0xc3, 0x3b, 0x01, 0x18, 0x19, 0x8c, 0x3f, 0xe2, 0x92, 0x95, 0xa0, 0x39, 0x18,
+		0x55, 0x44, 0xce, 0xb9, 0x0c, 0x0f, 0xef, 0xcf, 0x70, 0xb1, 0x6b, 0xe5, 0x6c, 0x3f, 0x02, 0xb3,
+		0xf4, 0x01, 0x85, 0x9b, 0x36, 0xdf, 0x88, 0xe2, 0x33, 0xc5, 0x1b, 0x37, 0x6d, 0xe5, 0x45, 0x98,
+		0xdd, 0xb8, 0xc9, 0xfc, 0x89, 0xc7, 0x20, 0xd1, 0xb3, 0x2c, 0x6e, 0xfd, 0x31, 0xab, 0x3b, 0x8e,
+		0x19, 0xd4, 0xd8, 0x11, 0x3e, 0xb4, 0x90, 0xe7, 0x43, 0xf3, 0x9c, 0x80, 0xe1, 0xa9, 0x9c, 0x80,
+		0xb8, 0xfb, 0x4c, 0x07, 0x66, 0x12, 0x79, 0x10, 0x8e, 0xd4, 0xab, 0x17, 0xd7, 0x2a, 0x65, 0x6d,
+		0xb5, 0x7e, 0x71, 0xe0, 0x9a, 0x7b, 0x3e, 0xfb, 0xca, 0xad, 0xc5, 0x24, 0xdf, 0x76, 0x8e, 0x83,
+		0x5e, 0x57, 0x2b, 0x57, 0x6a, 0x1b, 0x15, 0x59, 0x62, 0xd0, 0xeb, 0x3d, 0xe3, 0xba, 0xe5, 0xb0,
+		0xaf, 0xbf, 0x3c, 0x0c, 0x47, 0x47, 0x40, 0xbb, 0x9b, 0xcf, 0xb9, 0x57, 0x6e, 0x2d, 0xa6, 0xd7,
+		0x7b, 0x06, 0x93, 0x32, 0x8a, 0xb1, 0x04, 0xb9, 0x61, 0x8c, 0xda, 0x7a, 0xad, 0x5e, 0x58, 0x91,
+		0x17, 0xf3, 0xf2, 0x2b, 0xb7, 0x16, 0x53, 0x42, 0x65, 0x20, 0xfc, 0xd7, 0x7f, 0xf7, 0xf9, 0x1d,
+		0x71, 0xb8, 0x73, 0xb0, 0xf0, 0x74, 0x57, 0xef, 0xe9, 0x9d, 0x83, 0x6e, 0x3f, 0x27, 0x84, 0x6b,
+		0x28, 0x9f, 0x09, 0x41, 0xd6, 0xdd, 0xdc, 0xac, 0xd3, 0x1a, 0xc8, 0xa3, 0x7e, 0x8f, 0x5f, 0x72,
+		0xec, 0xda, 0xc1, 0xa0, 0x85, 0x43, 0x10, 0x8f, 0xa1, 0xb8, 0x91, 0xcc, 0x27, 0xd5, 0xe2, 0x88,
+		0xf5, 0x8d, 0x43, 0x70, 0x54, 0x17, 0x83, 0x3c, 0x0d, 0x09, 0x77, 0x8a, 0xb9, 0xcf, 0x66, 0x8f,
+		0x9f, 0x93, 0x1c, 0xdf, 0xc3, 0xc1, 0x97, 0xac, 0xc5, 0x36, 0x2e, 0x32, 0x6e, 0x63, 0x78, 0x85,
+		0x01, 0x70, 0x64, 0x01, 0x4f, 0x1e, 0x86, 0x88, 0xbe, 0xd5, 0x68, 0xe5, 0xa2, 0xc3, 0x2e, 0x17,
+		0x86, 0x57, 0x28, 0x96, 0xaa, 0x1c, 0x89, 0x42, 0x2a, 0x55, 0xee, 0x4c, 0xe1, 0xfc, 0xa2, 0x0f,
+		0x91, 0xde, 0xd4, 0xd8, 0x5e, 0x98, 0x59, 0x17, 0xf1, 0x8e, 0x7e, 0xb3, 0x88, 0x69, 0x9c, 0x80,
+		0x58, 0xb8, 0xc3, 0x5f, 0x04, 0x0b, 0xab, 0xb1, 0x8e, 0x7e, 0xf3, 0xa2, 0x8e, 0x17, 0xcf, 0xc3,
+		0x72, 0x44, 0xf9, 0x09, 0xb4, 0x54, 0x02, 0x5c, 0x21, 0x0f, 0x00, 0x41, 0x0c, 0x3c, 0x1f, 0xc4,
+		0xa9, 0x4b, 0xd9, 0x2b, 0xe8, 0xe2, 0x9d, 0xfe, 0xc2, 0x8e, 0xb1, 0xd6, 0xef, 0xd0, 0x06, 0xd8,
+		0x04, 0x0f, 0x13, 0x39, 0xb0, 0x18, 0x59, 0xce, 0xfe, 0xa3, 0xc3, 0xdf, 0x51, 0xe1, 0x00, 0x6c,
+		0x01, 0x78, 0x0d, 0x17, 0x80, 0x0c, 0xa3, 0x27, 0x4a, 0x82, 0x5d, 0x09, 0x07, 0xbb, 0xa2, 0x3c,
+		0x0d, 0xd9, 0x81, 0x11, 0x20, 0x0a, 0xa4, 0xb9, 0xc7, 0x8a, 0x06, 0x3f, 0xb0, 0x1d, 0x4e, 0x42,
+		0x4d, 0x32, 0xcf, 0x14, 0x0d, 0x06, 0x59, 0x8e, 0xff, 0x02, 0xba, 0x49, 0xf1, 0xd8, 0xe7, 0x01,
+		0x48, 0x07, 0xc6, 0x40, 0x78, 0x91, 0x25, 0xcf, 0x8b, 0xec, 0x01, 0xbf, 0x00, 0x29, 0x54, 0x40,
+		0x46, 0x93, 0xc3, 0xde, 0x0b, 0x59, 0xa6, 0x20, 0x07, 0x79, 0xcd, 0x0c, 0x9e, 0x55, 0xc1, 0x70,
+		0x05, 0xd2, 0x1e, 0x9c, 0xc7, 0xf6, 0xa4, 0x80, 0xba, 0xa8, 0xdb, 0x4a, 0x0d, 0xc0, 0x1b, 0x54,
+		0x52, 0x80, 0x3b, 0x51, 0x79, 0xf8, 0x83, 0x75, 0xf9, 0x4b, 0xcb, 0x01, 0x8b, 0x31, 0x8f, 0x40,
+		0x5e, 0x40, 0x2e, 0x7b, 0x6f, 0xf9, 0x12, 0x85, 0x28, 0x3e, 0xfb, 0xe9, 0x2f, 0x1c, 0x97, 0xbe,
+		0x3e, 0xba, 0xe0, 0x3f, 0x5e, 0x85, 0x63, 0xbe, 0x42, 0x14, 0xc0, 0x80, 0x23, 0x2a, 0xeb, 0x15,
+		0x2e, 0x61, 0xe1, 0x24, 0x87, 0xd2, 0xbe, 0xfe, 0xdf, 0xfc, 0xfe, 0x6a, 0x67, 0xb2, 0xb7, 0x69,
+		0xb2, 0xbf, 0x6b, 0xb4, 0x53, 0xfe, 0x97, 0x13, 0x30, 0xab, 0x1a, 0x2f, 0xf5, 0x0d, 0x1b, 0x9f,
+		0x96, 0x88, 0x18, 0x8d, 0x5d, 0x6b, 0x94, 0x0f, 0x14, 0x3b, 0xb8, 0xc4, 0xe1, 0x2a, 0x8d, 0x5d,
+		0xeb, 0xd2, 0x8c, 0x4a, 0x61, 0xc9, 0x59, 0x88, 0x6e, 0xb7, 0xfb, 0xdc, 0x7d, 0x35, 0xa0, 0xb3,
+		0xfc, 0x48, 0x17, 0x10, 0xe8, 0xd2, 0x8c, 0xca, 0xa0, 0xb1, 0x2a, 0xfa, 0x69, 0xac, 0xf0, 0xfe,
+		0x55, 0xe1, 0xf5, 0x7e, 0xac, 0x0a, 0x61, 0x49, 0x11, 0xa0, 0x65, 0xb6, 0x1c, 0x8d, 0xba, 0x76,
+		0x72, 0xd1, 0x61, 0x65, 0x15, 0xc4, 0x6c, 0x39, 0xd4, 0x19, 0x84, 0x47, 0x07, 0x2d, 0x91, 0xc0,
+		0xe6, 0xbe, 0xd4, 0x37, 0x7a, 0x7b, 0xb9, 0xd8, 0xfe, 0xcd, 0x7d, 0x16, 0x81, 0xb0, 0xb9, 0x14,
+		0x1a, 0x95, 0x2c, 0x7b, 0x7a, 0xdd, 0xb9, 0x99, 0x8b, 0x0f, 0xab, 0x39, 0x3f, 0x26, 0x7d, 0x7f,
+		0x7d, 0xe3, 0x26, 0x9e, 0x67, 0x34, 0xd8, 0x4f, 0xf2, 0x84, 0x6b, 0x82, 0x25, 0x87, 0xad, 0x9e,
+		0x00, 0x2e, 0x73, 0xfb, 0xcc, 0x08, 0x53, 0x8c, 0xac, 0x41, 0xa6, 0xdd, 0xb2, 0x1d, 0xcd, 0x36,
+		0xf5, 0xae, 0xbd, 0x6b, 0x39, 0xe2, 0xed, 0xe3, 0x7b, 0xc6, 0x51, 0x58, 0x69, 0xd9, 0x4e, 0x5d,
+		0x00, 0x5f, 0x9a, 0x51, 0xd3, 0x6d, 0x7f, 0x06, 0xd2, 0xb3, 0xb6, 0xb7, 0x8d, 0x9e, 0x4b, 0x30,
+		0x97, 0xde, 0x9f, 0x5e, 0x0d, 0xa1, 0x05, 0x3e, 0xd2, 0xb3, 0xfc, 0x19, 0xe4, 0x1b, 0x60, 0xbe,
+		0x6d, 0xe9, 0x4d, 0x97, 0x9c, 0xd6, 0xd8, 0xed, 0x9b, 0xd7, 0xa8, 0x53, 0x06, 0x3f, 0x82, 0x31,
+		0xae, 0x91, 0x96, 0xde, 0x14, 0x24, 0x4a, 0x88, 0x70, 0x69, 0x46, 0x9d, 0x6b, 0x0f, 0x66, 0x92,
+		0xf7, 0xc3, 0x82, 0xde, 0xed, 0xb6, 0xf7, 0x06, 0xa9, 0x67, 0x29, 0xf5, 0x53, 0xe3, 0xa8, 0x17,
+		0x10, 0x67, 0x90, 0x3c, 0xd1, 0x87, 0x72, 0xc9, 0x06, 0xba, 0x93, 0x0c, 0x7a, 0xdf, 0xad, 0xcb,
+		0xad, 0x0c, 0xfa, 0xb0, 0x30, 0x7e, 0x73, 0x6e, 0x0c, 0xed, 0x75, 0x06, 0x2f, 0x8c, 0x92, 0x4b,
+		0x33, 0xe8, 0x79, 0x0a, 0x64, 0x31, 0xaa, 0x56, 0xc3, 0xa0, 0xaf, 0xb0, 0x73, 0xaa, 0x73, 0x93,
+		0xa8, 0x52, 0xf8, 0x20, 0xd5, 0x40, 0x16, 0xa9, 0x40, 0x92, 0x6d, 0x45, 0xf1, 0x60, 0xc6, 0xa0,
+		0xaf, 0xdb, 0x27, 0xcf, 0x28, 0x63, 0x67, 0x28, 0x05, 0x45, 0x2f, 0xd7, 0xa5, 0x19, 0x15, 0x0c,
+		0x37, 0x45, 0x74, 0x38, 0x44, 0x5f, 0x66, 0xde, 0xd3, 0x82, 0x8a, 0x37, 0x37, 0x4f, 0x09, 0x3e,
+		0x30, 0x8e, 0x20, 0xfd, 0xe2, 0xd1, 0xde, 0x15, 0xbf, 0x1e, 0xbe, 0x34, 0xa3, 0xce, 0x5f, 0x1f,
+		0xce, 0x46, 0x11, 0xdb, 0x6e, 0x99, 0x7a, 0x1b, 0xbf, 0x3a, 0xc0, 0xac, 0x99, 0x85, 0xfd, 0x45,
+		0xec, 0x02, 0x87, 0xa6, 0x0b, 0x2b, 0x8a, 0xd8, 0xb6, 0x3f, 0xa3, 0x38, 0xcb, 0x23, 0x75, 0xdd,
+		0x17, 0x4b, 0x67, 0xe5, 0x38, 0x7b, 0x57, 0xfb, 0x72, 0x24, 0x0e, 0x72, 0x52, 0xb9, 0x0f, 0x92,
+		0x3e, 0xc5, 0x84, 0xa7, 0x50, 0x3c, 0x52, 0x47, 0xc4, 0xfc, 0xf2, 0xa4, 0x92, 0x81, 0x94, 0x5f,
+		0x19, 0x29, 0xaf, 0x4a, 0x90, 0xf4, 0xe9, 0x19, 0xc4, 0xf4, 0x7b, 0xa4, 0x13, 0x9e, 0xa5, 0x72,
+		0xb7, 0x58, 0xda, 0x44, 0x39, 0x3b, 0x7e, 0x4d, 0xd1, 0x4c, 0xbe, 0xb2, 0xe2, 0x23, 0x28, 0xdd,
+		0x33, 0x5d, 0x17, 0x24, 0x4c, 0x41, 0xa0, 0x7b, 0xa6, 0x2b, 0x00, 0xee, 0x82, 0x14, 0xf6, 0x5b,
+		0xf3, 0xdb, 0x4b, 0x09, 0x35, 0x89, 0x79, 0x1c, 0x44, 0xf9, 0xed, 0x10, 0xc8, 0x83, 0x0a, 0xcc,
+		0x75, 0x55, 0x4b, 0x07, 0x76, 0x55, 0x1f, 0x1d, 0x74, 0x92, 0x7b, 0x7e, 0xf1, 0x15, 0x90, 0x3d,
+		0xf7, 0x2e, 0x5b, 0x6a, 0xc6, 0xdb, 0x7f, 0x03, 0x86, 0xaa, 0x9a, 0x6d, 0x04, 0x33, 0xc8, 0x85,
+		0xc0, 0x61, 0xa5, 0xf8, 0x76, 0xe4, 0xe0, 0x80, 0xbb, 0x46, 0xcc, 0x66, 0xb7, 0xa9, 0x3b, 0x86,
+		0xf0, 0x8f, 0x79, 0x98, 0x68, 0x6b, 0xa0, 0x37, 0xd9, 0x76, 0x74, 0xc7, 0xe0, 0xb6, 0x06, 0xf3,
+		0x3c, 0xa4, 0xf5, 0x6e, 0x97, 0x7e, 0x6a, 0x8b, 0xd9, 0x1a, 0xf7, 0x40, 0x06, 0x75, 0x7a, 0x4b,
+		0x6f, 0x0b, 0x53, 0x21, 0xc6, 0x4c, 0x12, 0x9e, 0xcb, 0xac, 0x03, 0xa5, 0x09, 0x29, 0xbf, 0x3e,
+		0x77, 0xf7, 0x54, 0x92, 0x6f, 0x4f, 0x45, 0xf8, 0x9b, 0x36, 0x8c, 0x3f, 0xe2, 0x1d, 0xa0, 0xd1,
+		0x87, 0x06, 0x0b, 0x74, 0xff, 0x75, 0x9d, 0x39, 0x4b, 0xe2, 0x2a, 0x4b, 0x28, 0x2a, 0x64, 0x82,
+		0xba, 0x9f, 0x64, 0x20, 0xe4, 0xdc, 0xe4, 0xb5, 0x84, 0x9c, 0x9b, 0x68, 0xe9, 0xfa, 0x5e, 0xd2,
+		0x1b, 0x5e, 0xed, 0x38, 0x9e, 0xe7, 0xeb, 0x50, 0xb2, 0x90, 0x16, 0x34, 0xd9, 0xa6, 0xfc, 0x30,
+		0x2c, 0x8c, 0x52, 0xf1, 0xca, 0x2e, 0x2c, 0x8c, 0x52, 0xd5, 0xe4, 0x2c, 0xc4, 0x5d, 0x1d, 0x3f,
+		0x62, 0x77, 0x4c, 0xab, 0x15, 0xc0, 0xaa, 0x0b, 0x1a, 0x70, 0xe7, 0x87, 0x02, 0xee, 0x7c, 0xe5,
+		0x9b, 0x21, 0x37, 0x4e, 0x7f, 0x0f, 0x38, 0xf9, 0x22, 0x2e, 0xc3, 0x0e, 0x43, 0x8c, 0x3f, 0x1c,
+		0x17, 0xa2, 0x07, 0x58, 0x3c, 0x85, 0x8c, 0x64, 0xba, 0x3c, 0x4c, 0xb3, 0x59, 0x42, 0xd1, 0xe0,
+		0xe8, 0x58, 0x1d, 0x3e, 0xfe, 0x28, 0x8c, 0x11, 0xe2, 0x47, 0x61, 0x0d, 0xd1, 0x1c, 0x9b, 0xf6,
+		0x55, 0x04, 0xb2, 0xb0, 0x94, 0xf2, 0x5a, 0x18, 0x0e, 0x8f, 0xd6, 0xe4, 0x64, 0x11, 0x52, 0x68,
+		0xb7, 0x3a, 0x41, 0x13, 0x17, 0x3a, 0xfa, 0xcd, 0x0d, 0x6e, 0xdf, 0xf2, 0xa3, 0x84, 0x90, 0x7b,
+		0x94, 0x40, 0x36, 0x61, 0xae, 0x6d, 0x35, 0xf4, 0xb6, 0xe6, 0x3b, 0xca, 0xe1, 0x93, 0xe8, 0xee,
+		0x21, 0x66, 0x07, 0x5d, 0x86, 0xa8, 0x70, 0xb8, 0xfc, 0x67, 0x29, 0x8d, 0x15, 0xf7, 0xd4, 0x87,
+		0x94, 0x21, 0xd9, 0x69, 0xd9, 0x5b, 0xc6, 0xae, 0x7e, 0xbd, 0x65, 0xf5, 0xf8, 0x6c, 0x1a, 0x16,
+		0x9a, 0x55, 0x0f, 0x46, 0x9c, 0x30, 0xf9, 0xd0, 0x7c, 0x43, 0x12, 0x1d, 0x79, 0xf0, 0x15, 0x3b,
+		0xb0, 0x36, 0x19, 0x77, 0x86, 0x34, 0x3b, 0xf6, 0x0c, 0x69, 0xd4, 0x81, 0x4d, 0x7c, 0xf4, 0x81,
+		0xcd, 0x87, 0xfd, 0x43, 0x13, 0x5c, 0xfb, 0x86, 0xce, 0x70, 0x48, 0x1d, 0x16, 0x38, 0x7e, 0x33,
+		0xc0, 0xfb, 0x11, 0xa1, 0x0e, 0x6c, 0x7e, 0x0d, 0xf2, 0x9c, 0x08, 0xf4, 0xf1, 0x6c, 0x0f, 0xdf,
+		0x1e, 0xdb, 0xc5, 0x21, 0x6a, 0xc4, 0x77, 0x88, 0xfa, 0x7f, 0xd8, 0x50, 0x7c, 0x30, 0x0c, 0x73,
+		0x43, 0x86, 0xc4, 0xc8, 0xd3, 0xe1, 0x71, 0x1e, 0x59, 0xd1, 0xb1, 0xf0, 0x81, 0x3b, 0xc6, 0xc7,
+		0x3a, 0x32, 0x79, 0xac, 0xa3, 0xef, 0xe0, 0x58, 0xc7, 0x6e, 0x6f, 0xac, 0xbf, 0xae, 0xa3, 0xf0,
+		0x43, 0x12, 0xe4, 0xc7, 0x5b, 0x5f, 0x23, 0x87, 0xe3, 0x40, 0x07, 0x08, 0xe3, 0xd6, 0xb8, 0x7b,
+		0x20, 0x33, 0x60, 0x1b, 0x32, 0x51, 0x4e, 0x07, 0x76, 0xe1, 0xca, 0x87, 0xc2, 0xb0, 0x30, 0xca,
+		0x80, 0x1b, 0x31, 0x5b, 0x9f, 0xc5, 0xef, 0x16, 0x34, 0x5a, 0xcd, 0xdb, 0x9d, 0xac, 0x73, 0x1c,
+		0xfb, 0xff, 0xce, 0xd5, 0x61, 0x29, 0xf9, 0x5e, 0x80, 0xb8, 0x6a, 0xd8, 0x5d, 0xb4, 0xc7, 0xf0,
+		0x38, 0xc2, 0xb8, 0xd9, 0x30, 0xba, 0x8e, 0x17, 0x54, 0x31, 0x6a, 0x8b, 0xc0, 0xa0, 0x2b, 0x02,
+		0x12, 0x37, 0xc8, 0x2e, 0x1a, 0x9e, 0xcc, 0x50, 0x1f, 0xc0, 0xf8, 0xed, 0x3c, 0x47, 0xf7, 0x3b,
+		0x01, 0xce, 0x09, 0x27, 0x40, 0x78, 0xec, 0xfe, 0x96, 0x61, 0x0d, 0x78, 0x01, 0x1e, 0xe5, 0x5e,
+		0x80, 0xc8, 0x84, 0xca, 0x02, 0x6e, 0x80, 0x52, 0xc0, 0x0d, 0x10, 0x9b, 0xd0, 0xcd, 0x31, 0x7e,
+		0x80, 0x73, 0xc2, 0x0f, 0x30, 0x3b, 0xa1, 0xc5, 0x03, 0x8e, 0x80, 0xa7, 0x7c, 0x8e, 0x80, 0xc4,
+		0xb0, 0xb7, 0x35, 0x80, 0x3a, 0xc2, 0x13, 0x70, 0xde, 0xf5, 0x04, 0xa4, 0xc6, 0x7a, 0x11, 0x38,
+		0xf2, 0xa0, 0x2b, 0xa0, 0x36, 0xe4, 0x0a, 0x48, 0xf3, 0xef, 0x20, 0x8f, 0x23, 0x31, 0xc1, 0x17,
+		0x50, 0x1b, 0xf2, 0x05, 0x64, 0x26, 0x10, 0x9c, 0xe0, 0x0c, 0xf8, 0xc6, 0xd1, 0xce, 0x80, 0xf1,
+		0xdb, 0x75, 0xde, 0xcc, 0xe9, 0xbc, 0x01, 0xda, 0x18, 0x6f, 0x80, 0x3c, 0x76, 0xe7, 0xca, 0xc8,
+		0x4f, 0xed, 0x0e, 0xd8, 0x1c, 0xe1, 0x0e, 0x60, 0x1b, 0xf7, 0x93, 0x63, 0x89, 0x4f, 0xe1, 0x0f,
+		0xd8, 0x1c, 0xe1, 0x0f, 0x20, 0x13, 0xc9, 0x4e, 0x74, 0x08, 0x5c, 0x08, 0x3a, 0x04, 0xe6, 0xc7,
+		0x58, 0x9d, 0xde, 0x6c, 0x1f, 0xe3, 0x11, 0xd8, 0x1a, 0xe7, 0x11, 0x58, 0xe0, 0xdf, 0x57, 0x18,
+		0x47, 0xf1, 0x00, 0x2e, 0x81, 0xda, 0x90, 0x4b, 0xe0, 0xd0, 0x04, 0x49, 0x9b, 0xde, 0x27, 0xc0,
+		0xbe, 0xb8, 0xc5, 0xbe, 0xb5, 0x05, 0x72, 0xf2, 0x72, 0x24, 0x9e, 0x94, 0x53, 0xca, 0xfd, 0x30,
+		0x27, 0x48, 0xb9, 0x7a, 0x0e, 0xf7, 0x0a, 0x46, 0xaf, 0x67, 0xf5, 0xc4, 0x1d, 0x0b, 0x9a, 0x50,
+		0x4e, 0x42, 0xca, 0x05, 0xdd, 0xdf, 0x7f, 0x40, 0xf7, 0x64, 0x3e, 0x3d, 0xa6, 0xfc, 0x82, 0x04,
+		0x29, 0xbf, 0x8a, 0x0a, 0xec, 0x2f, 0x13, 0x7c, 0x7f, 0xe9, 0xf3, 0x2a, 0x84, 0x82, 0x5e, 0x05,
+		0xfc, 0x6e, 0x49, 0x77, 0xc8, 0x61, 0xa0, 0x77, 0x5d, 0x87, 0x81, 0x08, 0xa0, 0x62, 0xbe, 0x07,
+		0xbe, 0x2c, 0xb1, 0xd3, 0xc4, 0xac, 0x1b, 0x4c, 0xc6, 0xb6, 0xba, 0xe4, 0x21, 0x98, 0xf7, 0xc1,
+		0xba, 0x7b, 0x38, 0xb6, 0x7b, 0x96, 0x5d, 0xe8, 0x02, 0xdf, 0xcc, 0xfd, 0x0b, 0x09, 0xe6, 0x86,
+		0x54, 0xe4, 0x48, 0xa7, 0x80, 0xf4, 0x0e, 0x39, 0x05, 0x42, 0xb7, 0xed, 0x14, 0xf0, 0xef, 0x49,
+		0xc3, 0xc1, 0x3d, 0xe9, 0x7f, 0x97, 0x20, 0x1d, 0xd0, 0xd4, 0x38, 0x04, 0x0d, 0xab, 0x69, 0xf0,
+		0x5d, 0x22, 0xfd, 0x8d, 0x26, 0x49, 0xdb, 0x12, 0x1f, 0x4a, 0xc2, 0x9f, 0x08, 0xe5, 0x2e, 0x3c,
+		0x09, 0xbe, 0xae, 0xb8, 0x1b, 0xcc, 0xa8, 0xff, 0x1e, 0x07, 0xbf, 0xdc, 0x10, 0xf3, 0x2e, 0x37,
+		0xb8, 0x57, 0xc7, 0x67, 0x7d, 0x57, 0xc7, 0xc9, 0x13, 0x90, 0xa0, 0xce, 0x7e, 0xcd, 0xea, 0xb2,
+		0xc5, 0x7a, 0xc0, 0xb4, 0x09, 0x5e, 0x6c, 0xb0, 0x69, 0xc8, 0x35, 0xfd, 0xe5, 0xb3, 0x38, 0x12,
+		0x01, 0x8b, 0xe3, 0x0e, 0x48, 0x60, 0xeb, 0xd9, 0x47, 0x17, 0x81, 0xbf, 0x3b, 0x20, 0x32, 0x94,
+		0x9f, 0x0c, 0x41, 0x76, 0x60, 0xa1, 0x19, 0xd9, 0xf7, 0x51, 0xc7, 0xc8, 0xd3, 0xf1, 0xe3, 0x38,
+		0xc0, 0x8e, 0x6e, 0x6b, 0x37, 0x74, 0xd3, 0xe1, 0x1f, 0x4a, 0x0f, 0xab, 0xbe, 0x1c, 0xbc, 0xc8,
+		0x80, 0xa9, 0xbe, 0xcd, 0x3f, 0x95, 0x1e, 0x56, 0xdd, 0x34, 0xde, 0x94, 0x32, 0xae, 0xd3, 0x57,
+		0x8c, 0xd9, 0x77, 0x32, 0x0e, 0x0f, 0x6f, 0x87, 0xb1, 0xb8, 0x98, 0xc3, 0xc1, 0xfe, 0xf2, 0xeb,
+		0x27, 0x64, 0x06, 0xfd, 0xa0, 0x7b, 0x99, 0x4d, 0xe5, 0xf8, 0x41, 0x2e, 0xc4, 0x07, 0xb8, 0x40,
+		0xfd, 0x80, 0x29, 0xb1, 0xbd, 0x47, 0x9e, 0xb2, 0x18, 0x6f, 0xbc, 0x4b, 0xdb, 0xe9, 0x5a, 0x56,
+		0x5b, 0x63, 0x73, 0xbc, 0x00, 0x19, 0x97, 0x57, 0x6c, 0x35, 0xa5, 0xdf, 0x35, 0x76, 0xd0, 0x35,
+		0x16, 0x30, 0x82, 0x53, 0x2c, 0x93, 0xcd, 0x29, 0xff, 0x13, 0xd5, 0xca, 0x3a, 0x1c, 0x12, 0x24,
+		0x02, 0xeb, 0x2a, 0x79, 0x1c, 0x12, 0xde, 0x92, 0xcc, 0xc2, 0xf4, 0xf7, 0xf1, 0xb4, 0x78, 0xb0,
+		0xca, 0x2f, 0x49, 0x70, 0x68, 0xe4, 0xca, 0x4a, 0x2a, 0x10, 0x63, 0x31, 0x93, 0x3c, 0x3e, 0xe6,
+		0xa1, 0xe9, 0x56, 0xe4, 0x25, 0x16, 0x50, 0xa9, 0x72, 0x64, 0xe5, 0xfd, 0x10, 0x63, 0x39, 0x24,
+		0x09, 0xb3, 0x81, 0xf7, 0xe2, 0x0b, 0xa5, 0x52, 0x65, 0x5d, 0x3c, 0x7e, 0x5c, 0xa4, 0x6f, 0xb1,
+		0x63, 0xb6, 0x5a, 0xb9, 0x5c, 0x29, 0x6d, 0xc8, 0x61, 0xfc, 0xb0, 0x37, 0xfb, 0xcd, 0x5f, 0x75,
+		0x97, 0x23, 0xbe, 0xac, 0x7a, 0x65, 0xad, 0x5c, 0x51, 0xe5, 0xa8, 0xf2, 0x08, 0x1c, 0x15, 0xed,
+		0x18, 0xf6, 0x08, 0xb9, 0x8e, 0x19, 0xc9, 0xe7, 0x98, 0x51, 0x5e, 0x0b, 0x41, 0x5e, 0xe0, 0x8c,
+		0xf0, 0xf1, 0x5c, 0x1e, 0xe8, 0xf8, 0x99, 0x03, 0xac, 0xea, 0x03, 0xbd, 0xc7, 0x7d, 0x4c, 0xcf,
+		0xd8, 0x36, 0x9c, 0xc6, 0x2e, 0x33, 0x14, 0x98, 0x06, 0x4a, 0xab, 0x69, 0x9e, 0x4b, 0x91, 0x6c,
+		0x06, 0xf6, 0xa2, 0xd1, 0x70, 0x34, 0x26, 0x44, 0x36, 0xdd, 0x4c, 0x24, 0xd4, 0x34, 0xcb, 0xad,
+		0xb3, 0x4c, 0xe5, 0x9b, 0x0f, 0xc4, 0xcb, 0x04, 0x44, 0xd5, 0x0a, 0x7e, 0xa3, 0x8b, 0x7e, 0xbd,
+		0x9c, 0xfe, 0xd4, 0xea, 0x6b, 0x85, 0xf5, 0xfa, 0xa5, 0x1a, 0xf2, 0x72, 0x1e, 0xb2, 0x82, 0x97,
+		0x22, 0x33, 0xaa, 0x3c, 0x00, 0x47, 0xc6, 0x58, 0x15, 0x23, 0x82, 0x58, 0x3f, 0x29, 0xf9, 0xa1,
+		0x83, 0x96, 0x41, 0x0d, 0x62, 0xb6, 0xa3, 0x3b, 0x7d, 0x9b, 0x33, 0xf1, 0xf1, 0x69, 0xcd, 0x8c,
+		0x25, 0xf1, 0xa3, 0x4e, 0xd1, 0x55, 0x4e, 0x46, 0x39, 0x0b, 0x99, 0x60, 0xc9, 0x78, 0x1e, 0x78,
+		0x42, 0x14, 0x52, 0x9e, 0x04, 0x32, 0x6c, 0x7d, 0x8c, 0xd8, 0x5e, 0x4a, 0xa3, 0xb6, 0x97, 0x3f,
+		0x26, 0xc1, 0xb1, 0x7d, 0x2c, 0x0d, 0xf2, 0xec, 0x40, 0x27, 0xcf, 0x1f, 0xc4, 0x4e, 0x59, 0x62,
+		0x79, 0x03, 0xdd, 0x7c, 0x14, 0x52, 0xfe, 0xfc, 0xe9, 0x3a, 0xf9, 0xe5, 0x10, 0x1c, 0x1a, 0x69,
+		0xb4, 0xf8, 0x54, 0xa0, 0xf4, 0x36, 0x55, 0xe0, 0x7b, 0x01, 0x9c, 0x9b, 0x22, 0x8a, 0x9a, 0xaf,
+		0xa3, 0x77, 0x8e, 0xf0, 0x2f, 0x1a, 0x8d, 0x8d, 0x9b, 0x7c, 0x12, 0x24, 0x1c, 0xfe, 0x0b, 0xfd,
+		0x27, 0x3e, 0xa7, 0x40, 0x9f, 0xae, 0xb1, 0x76, 0x2e, 0x7c, 0xa0, 0xc5, 0x58, 0xbe, 0x1e, 0xcc,
+		0xb6, 0xc9, 0x55, 0x38, 0x32, 0x60, 0x28, 0xb8, 0xa4, 0x23, 0xd3, 0xda, 0x0b, 0x87, 0x82, 0xf6,
+		0x82, 0x20, 0xed, 0x5f, 0xed, 0xa3, 0xc1, 0xd5, 0xfe, 0x2a, 0x80, 0xe7, 0x1c, 0xf0, 0xc2, 0xfe,
+		0x24, 0x7f, 0xd8, 0xdf, 0x59, 0x88, 0xa2, 0x24, 0x09, 0x3e, 0x0d, 0xab, 0x62, 0x94, 0x04, 0x9f,
+		0x73, 0x81, 0x41, 0x2b, 0x2d, 0x20, 0xc3, 0x0e, 0xda, 0x31, 0x55, 0x3c, 0x15, 0xac, 0xe2, 0xae,
+		0xb1, 0xae, 0xde, 0xd1, 0x55, 0xbd, 0x0c, 0x51, 0x3a, 0xf2, 0x23, 0xef, 0x52, 0x7e, 0x13, 0x80,
+		0xee, 0x38, 0xbd, 0xd6, 0x56, 0xdf, 0xab, 0xe0, 0xc4, 0x68, 0xc9, 0x29, 0x08, 0xb8, 0xe2, 0x1d,
+		0x5c, 0x84, 0x16, 0x3c, 0x54, 0x9f, 0x18, 0xf9, 0x08, 0x2a, 0x6b, 0x90, 0x09, 0xe2, 0x8e, 0xbe,
+		0x1b, 0x3a, 0xfc, 0x85, 0x4c, 0xcf, 0x3a, 0xe2, 0xcf, 0x55, 0xd1, 0x84, 0xf2, 0x81, 0x10, 0xa4,
+		0xfc, 0x82, 0xf7, 0x77, 0xcf, 0x04, 0x51, 0xbe, 0x5d, 0x82, 0xb8, 0xdb, 0xfd, 0x7d, 0x82, 0x9d,
+		0xbd, 0x3b, 0xc2, 0xee, 0xe1, 0x05, 0x3b, 0x26, 0x0a, 0xbb, 0xc7, 0x44, 0x4f, 0xba, 0xcb, 0xdf,
+		0x38, 0x87, 0x88, 0x9f, 0xd7, 0x22, 0xe0, 0x90, 0xaf, 0xf6, 0x4f, 0x4e, 0x77, 0x15, 0x0f, 0x8f,
+		0xb0, 0x7c, 0xd7, 0xe8, 0x58, 0x42, 0x69, 0xfa, 0x22, 0x8c, 0xd8, 0x44, 0xf4, 0xdf, 0xd9, 0x93,
+		0x0e, 0x7c, 0x67, 0xcf, 0xad, 0x25, 0xe4, 0xaf, 0xe5, 0x7b, 0x24, 0x88, 0x8b, 0x39, 0x41, 0xde,
+		0xe7, 0x8f, 0x3c, 0x13, 0x47, 0x9b, 0x63, 0xf5, 0x11, 0xa7, 0xef, 0xa1, 0x0c, 0x07, 0x5c, 0x87,
+		0x0f, 0x1a, 0x70, 0xcd, 0x2d, 0xbb, 0xbf, 0x94, 0x40, 0x1e, 0x9c, 0xb1, 0x6f, 0xbb, 0x75, 0xc3,
+		0xcb, 0x5c, 0x78, 0xc4, 0x32, 0x37, 0x2e, 0x8a, 0x3a, 0x32, 0x2e, 0x8a, 0x7a, 0xb8, 0xd7, 0xd1,
+		0xdb, 0xec, 0xf5, 0x07, 0x43, 0x90, 0xf4, 0xb9, 0x47, 0xc9, 0x63, 0x81, 0x88, 0xec, 0xc5, 0xfd,
+		0x5c, 0xa9, 0xbe, 0x90, 0xec, 0x00, 0x9b, 0x42, 0x07, 0x67, 0xd3, 0x3b, 0x7f, 0x3b, 0x6b, 0xf4,
+		0x05, 0xd6, 0xe8, 0x98, 0x0b, 0xac, 0xdf, 0x26, 0x41, 0xdc, 0x35, 0xbb, 0x0f, 0x7a, 0x88, 0x79,
+		0x18, 0x62, 0xdc, 0xb2, 0x64, 0xa7, 0x98, 0x3c, 0x35, 0xd2, 0xad, 0x9c, 0x87, 0xb8, 0xf8, 0x94,
+		0x3e, 0x5f, 0xd5, 0xdc, 0xf4, 0xa9, 0xf3, 0x90, 0xf4, 0x1d, 0x00, 0xa3, 0x6a, 0x5c, 0xab, 0x3c,
+		0x27, 0xcf, 0xe4, 0x67, 0x5f, 0xb9, 0xb5, 0x18, 0x5e, 0x33, 0x6e, 0xe0, 0x6c, 0x56, 0x2b, 0xa5,
+		0x4b, 0x95, 0xd2, 0x33, 0xb2, 0x94, 0x4f, 0xbe, 0x72, 0x6b, 0x71, 0x56, 0x35, 0xa8, 0x47, 0xf1,
+		0xd4, 0x33, 0x90, 0x1d, 0x18, 0x98, 0xa0, 0xd9, 0x42, 0x20, 0x53, 0xde, 0xc4, 0xcf, 0x5f, 0x15,
+		0x36, 0x2a, 0x1a, 0x8b, 0xdb, 0xc5, 0xcf, 0x88, 0xac, 0x54, 0x2f, 0x5e, 0xda, 0xd0, 0x4a, 0x2b,
+		0xd5, 0xca, 0xda, 0x86, 0x56, 0xd8, 0xd8, 0x28, 0x94, 0x9e, 0x91, 0x43, 0x67, 0x6e, 0x25, 0x21,
+		0x82, 0xd1, 0x79, 0xa4, 0x04, 0x11, 0xea, 0x0a, 0xd9, 0x37, 0x02, 0x2c, 0xbf, 0xbf, 0x6f, 0x98,
+		0x5c, 0x80, 0x28, 0xf5, 0x92, 0x90, 0xfd, 0x43, 0xc2, 0xf2, 0x13, 0x9c, 0xc5, 0xd8, 0x18, 0x3a,
+		0x23, 0xf7, 0x8d, 0x11, 0xcb, 0xef, 0xef, 0x3b, 0x26, 0x2b, 0x30, 0x2b, 0x36, 0xc9, 0x93, 0x02,
+		0xb7, 0xf2, 0x13, 0x1d, 0xba, 0xd8, 0x35, 0xe6, 0x6c, 0xd8, 0x3f, 0x7c, 0x2c, 0x3f, 0xc1, 0xab,
+		0x8c, 0xcf, 0x7b, 0xf0, 0xed, 0xe8, 0x84, 0x88, 0xb0, 0xfc, 0x24, 0x3f, 0x31, 0x51, 0x21, 0xe1,
+		0xb9, 0x71, 0x26, 0x07, 0xc5, 0xe5, 0xa7, 0x70, 0x98, 0x93, 0xf7, 0x43, 0x3a, 0xb8, 0xd5, 0x9d,
+		0x2e, 0xea, 0x2c, 0x3f, 0xa5, 0x47, 0x1a, 0xe9, 0x07, 0xf7, 0xbd, 0xd3, 0x45, 0xa1, 0xe5, 0xa7,
+		0x74, 0x50, 0x93, 0x17, 0x61, 0x6e, 0x78, 0x5f, 0x3a, 0x7d, 0x50, 0x5a, 0xfe, 0x00, 0x2e, 0x6b,
+		0xd2, 0x01, 0x32, 0x62, 0x3f, 0x7b, 0x80, 0x18, 0xb5, 0xfc, 0x41, 0x3c, 0xd8, 0xa4, 0x09, 0xd9,
+		0xc1, 0x4d, 0xe2, 0xb4, 0x31, 0x6b, 0xf9, 0xa9, 0xbd, 0xd9, 0xac, 0x96, 0xe0, 0xe6, 0x72, 0xda,
+		0x18, 0xb6, 0xfc, 0xd4, 0xce, 0x6d, 0xb2, 0x09, 0xe0, 0xdb, 0x1f, 0x4e, 0x11, 0xd3, 0x96, 0x9f,
+		0xc6, 0xcd, 0x4d, 0xba, 0x30, 0x3f, 0x6a, 0xe3, 0x78, 0x90, 0x10, 0xb7, 0xfc, 0x81, 0xbc, 0xdf,
+		0x28, 0xcf, 0xc1, 0x2d, 0xe0, 0x74, 0x21, 0x6f, 0xf9, 0x29, 0xdd, 0xe0, 0xc5, 0xc2, 0xd8, 0x38,
+		0xe7, 0xfb, 0xf6, 0x8d, 0x73, 0xf6, 0x22, 0x97, 0xdd, 0xd8, 0xe6, 0x57, 0x97, 0xe1, 0x3d, 0xfc,
+		0x05, 0x1d, 0xdb, 0xd1, 0xaf, 0xb5, 0xcc, 0x1d, 0xf7, 0x99, 0x25, 0x9e, 0xe6, 0x41, 0xce, 0x87,
+		0x19, 0xd4, 0x92, 0xc8, 0x9d, 0xf0, 0xd8, 0xd2, 0xd8, 0x37, 0x3a, 0x27, 0xdd, 0x87, 0x98, 0x1c,
+		0xbe, 0xbc, 0xcf, 0x43, 0x4e, 0x13, 0x9e, 0x8b, 0x1a, 0xf1, 0xd0, 0x53, 0x7e, 0xdf, 0x37, 0x06,
+		0xf2, 0xfb, 0xc5, 0x7d, 0xa3, 0x15, 0x9b, 0xb9, 0xd4, 0xb2, 0x1d, 0xab, 0xd7, 0x6a, 0xe8, 0x6d,
+		0xba, 0xbc, 0x3c, 0x39, 0xed, 0xad, 0xac, 0x62, 0x02, 0xcd, 0x15, 0xfe, 0x16, 0x14, 0x43, 0x21,
+		0x65, 0x88, 0x5d, 0xd7, 0xdb, 0xec, 0x4e, 0x94, 0xff, 0x31, 0xb9, 0x41, 0x9e, 0xfb, 0xec, 0x28,
+		0x3f, 0x15, 0x86, 0xab, 0x7c, 0x1f, 0xbd, 0x4f, 0xd2, 0xe9, 0xb4, 0x6c, 0x94, 0x45, 0x95, 0x6e,
+		0xa5, 0x2f, 0x43, 0xa4, 0xa7, 0x3b, 0x7c, 0x83, 0x59, 0x3c, 0x77, 0xe0, 0x77, 0x99, 0x58, 0x0d,
+		0x94, 0x06, 0x79, 0x16, 0xf0, 0x3e, 0x82, 0x46, 0xe9, 0x85, 0xde, 0x16, 0x3d, 0xbc, 0x96, 0x81,
+		0xed, 0x23, 0xef, 0xa7, 0x5f, 0x4a, 0xc4, 0x73, 0x5c, 0x73, 0xc7, 0x60, 0x94, 0xc3, 0x6f, 0x8b,
+		0x72, 0xba, 0xa3, 0xdf, 0x2c, 0x51, 0x6a, 0x48, 0x9f, 0x3f, 0x79, 0xf5, 0xab, 0x12, 0x80, 0xc7,
+		0x18, 0xa2, 0xe3, 0x11, 0x87, 0x48, 0xd1, 0x4a, 0xc5, 0x11, 0xc7, 0x7d, 0xe3, 0xf8, 0x3e, 0xc0,
+		0xd6, 0x62, 0x1a, 0x9b, 0xf7, 0xf9, 0xd7, 0x4f, 0x48, 0xac, 0xd6, 0x6c, 0x63, 0x88, 0xed, 0x49,
+		0xe6, 0x0c, 0xd1, 0xa8, 0x0d, 0x1b, 0x9a, 0x68, 0xc3, 0xa6, 0x85, 0x0d, 0xcb, 0x08, 0x02, 0xc3,
+		0xc6, 0x72, 0xde, 0x87, 0x4f, 0x4b, 0x90, 0x2c, 0xfb, 0x1e, 0xbe, 0xc4, 0x33, 0x2a, 0xcb, 0x6c,
+		0x5d, 0x33, 0x7a, 0xee, 0x19, 0x15, 0x4b, 0xa2, 0x9d, 0xc9, 0xbe, 0xc2, 0xec, 0xec, 0x89, 0xd7,
+		0x85, 0x44, 0x1a, 0xb1, 0x6e, 0x18, 0x5b, 0x76, 0x4b, 0xf0, 0x59, 0x15, 0x49, 0x0c, 0x38, 0xb0,
+		0x8d, 0x46, 0x1f, 0x5d, 0xe7, 0xf8, 0x95, 0x7b, 0x07, 0x3f, 0xc6, 0xce, 0xf6, 0xe1, 0x59, 0x91,
+		0x5f, 0x62, 0xd9, 0x48, 0xa4, 0x69, 0x38, 0x7a, 0xab, 0xcd, 0x22, 0x33, 0x13, 0xaa, 0x48, 0xf2,
+		0xa6, 0xfe, 0x66, 0xdc, 0xbf, 0x0f, 0x2d, 0x81, 0x6c, 0x75, 0x8d, 0x5e, 0x20, 0x20, 0x85, 0x49,
+		0x63, 0xee, 0x77, 0x3f, 0xf7, 0xd0, 0x02, 0x67, 0x38, 0x0f, 0x66, 0x60, 0xdf, 0x79, 0x50, 0xb3,
+		0x02, 0x83, 0x67, 0x93, 0xab, 0x81, 0x53, 0xa9, 0xfe, 0x96, 0xf7, 0x8c, 0xcc, 0xc2, 0x10, 0x53,
+		0x0b, 0xe6, 0x5e, 0x31, 0xf7, 0x5b, 0x1e, 0x69, 0x6f, 0x9f, 0x8a, 0x0f, 0xb7, 0xf8, 0x8e, 0xa8,
+		0x28, 0x19, 0x34, 0xe1, 0x5f, 0xd4, 0x5b, 0x6d, 0xa3, 0xc9, 0xdd, 0x1a, 0x3c, 0x45, 0x96, 0x5d,
+		0xf7, 0x22, 0xfb, 0xfc, 0xa7, 0x32, 0x4e, 0x36, 0x8a, 0x96, 0xd9, 0x0c, 0xfa, 0x11, 0x49, 0x09,
+		0x62, 0x8e, 0x75, 0xcd, 0x30, 0x39, 0x83, 0x0e, 0xf6, 0xb4, 0x1a, 0x47, 0x25, 0xdf, 0x08, 0x72,
+		0xd3, 0x68, 0x1b, 0x3b, 0x94, 0x73, 0xf6, 0xae, 0x8e, 0xb7, 0x97, 0x63, 0xb7, 0xfb, 0xbc, 0x5a,
+		0xd6, 0x25, 0x55, 0xa7, 0x94, 0xc8, 0x7a, 0xf0, 0x69, 0xd5, 0x59, 0x7e, 0x78, 0x3c, 0xa6, 0x8f,
+		0x3e, 0xc9, 0xf3, 0x6b, 0x1e, 0x3f, 0x09, 0x94, 0xa0, 0xbe, 0xb9, 0x65, 0x99, 0xf4, 0x5b, 0x96,
+		0x7c, 0x17, 0x15, 0x67, 0x27, 0x95, 0x6e, 0x3e, 0x3f, 0xa9, 0x5c, 0x87, 0x8c, 0x07, 0x4a, 0x67,
+		0x48, 0xe2, 0xa0, 0x33, 0x24, 0xed, 0x12, 0x40, 0x10, 0xfc, 0xe8, 0xa5, 0x37, 0x07, 0x73, 0xc0,
+		0x23, 0x42, 0x26, 0xce, 0x66, 0x7f, 0x67, 0x7c, 0x04, 0xf0, 0x52, 0x43, 0xa7, 0x65, 0x6a, 0xb6,
+		0xd1, 0xde, 0xd6, 0x38, 0xe7, 0x90, 0x6e, 0xf2, 0xe0, 0xa3, 0x39, 0xd7, 0x69, 0x99, 0x75, 0xa3,
+		0xbd, 0x5d, 0x76, 0xa9, 0x90, 0xf7, 0xc2, 0x31, 0xaf, 0xf7, 0x96, 0xa9, 0xed, 0x5a, 0xed, 0xa6,
+		0xd6, 0x33, 0xb6, 0xb5, 0x06, 0x7d, 0xe7, 0x2f, 0x45, 0x79, 0x76, 0xc4, 0x05, 0xa9, 0x99, 0x97,
+		0xac, 0x76, 0x53, 0x35, 0xb6, 0x4b, 0x58, 0x8c, 0xc7, 0x56, 0x1e, 0x76, 0xab, 0x89, 0x31, 0x20,
+		0x61, 0x0c, 0x44, 0x77, 0x33, 0xab, 0x4d, 0x9b, 0x18, 0x70, 0xc8, 0xf3, 0xf8, 0x62, 0xbe, 0x10,
+		0xa0, 0xcc, 0xed, 0x0a, 0xd0, 0xbc, 0x4b, 0x8f, 0xca, 0x3b, 0x13, 0xa2, 0x2b, 0x90, 0x6e, 0xb7,
+		0x5e, 0xea, 0xb7, 0x5c, 0xf2, 0xd9, 0xdb, 0x25, 0x9f, 0x62, 0x74, 0x18, 0xdd, 0xe5, 0xf8, 0x87,
+		0x3f, 0x71, 0x62, 0xe6, 0xcd, 0x4f, 0x9c, 0x98, 0x51, 0x2e, 0xd0, 0x27, 0xb1, 0xb8, 0x1a, 0x30,
+		0x6c, 0x72, 0x0e, 0x12, 0xba, 0x48, 0xb0, 0x0b, 0x6f, 0xfb, 0xa8, 0x11, 0x0f, 0x14, 0xef, 0xfa,
+		0xc5, 0xca, 0x57, 0xd6, 0xf5, 0x56, 0x8f, 0x54, 0xf0, 0x91, 0x57, 0x31, 0xaf, 0xa6, 0xd5, 0x48,
+		0xde, 0x54, 0xe4, 0xf9, 0x64, 0x6d, 0x5c, 0xa4, 0x5d, 0xa2, 0x78, 0xd7, 0xef, 0x7e, 0xee, 0xa1,
+		0x3b, 0x39, 0x99, 0x2b, 0x03, 0x41, 0x77, 0x82, 0xde, 0x60, 0x30, 0x9e, 0xaf, 0xcf, 0x97, 0x61,
+		0x96, 0x35, 0xd5, 0x26, 0x4f, 0x43, 0xb4, 0x8b, 0x3f, 0x72, 0x52, 0xe0, 0xdd, 0xd7, 0xe1, 0xf9,
+		0x49, 0xe1, 0xfd, 0xd2, 0xcc, 0xf0, 0x94, 0x8f, 0x84, 0x00, 0xca, 0x57, 0xae, 0x6c, 0xf4, 0x5a,
+		0xdd, 0xb6, 0xe1, 0xbc, 0x53, 0x7d, 0xdf, 0xf4, 0x8b, 0x97, 0xdd, 0x6b, 0x1c, 0xbc, 0xff, 0x9e,
+		0x38, 0xd5, 0x7b, 0x8d, 0x91, 0x64, 0x9b, 0xb6, 0xe3, 0x92, 0x0d, 0x1f, 0x9c, 0x6c, 0xd9, 0x76,
+		0x86, 0x39, 0xfb, 0x3c, 0x24, 0x3d, 0x66, 0xd8, 0xa4, 0x0a, 0x71, 0x87, 0xff, 0xe6, 0x0c, 0x56,
+		0xc6, 0x33, 0x58, 0xa0, 0xf9, 0x99, 0xec, 0xa2, 0x2b, 0x1f, 0x47, 0x3e, 0x7b, 0x53, 0xfc, 0xdd,
+		0x29, 0x63, 0xe8, 0x6d, 0xe0, 0x13, 0x35, 0x7c, 0xdb, 0x8f, 0x89, 0x32, 0x02, 0xd4, 0xbb, 0x19,
+		0xd0, 0x30, 0xfc, 0x42, 0x44, 0x3a, 0xa0, 0x27, 0x7c, 0xbc, 0xff, 0x68, 0x08, 0xe6, 0x37, 0x85,
+		0x8e, 0x7a, 0xf7, 0xb3, 0x6a, 0x13, 0x66, 0x0d, 0xd3, 0xe9, 0xb5, 0xdc, 0x93, 0xb2, 0x87, 0xc7,
+		0x89, 0xc6, 0x88, 0x4e, 0x55, 0x4c, 0xa7, 0xb7, 0xe7, 0x17, 0x14, 0x41, 0xcb, 0xc7, 0x8f, 0x8f,
+		0x85, 0x21, 0x37, 0x0e, 0x15, 0x5f, 0xdf, 0x69, 0xf4, 0x0c, 0x9a, 0x11, 0xbc, 0x08, 0x9b, 0x11,
+		0xd9, 0x7c, 0x25, 0x55, 0x01, 0x6d, 0x4f, 0x94, 0x41, 0x04, 0xbd, 0x3d, 0x63, 0x33, 0xe3, 0x51,
+		0xa0, 0x6b, 0xe9, 0x06, 0x64, 0xc5, 0xcd, 0x9a, 0x2d, 0xbd, 0xad, 0xe3, 0xad, 0xf2, 0xf0, 0xc1,
+		0x17, 0x3e, 0x71, 0x3b, 0xa7, 0xc8, 0x48, 0x90, 0x0a, 0xcc, 0x0a, 0x6a, 0x91, 0x83, 0x53, 0x13,
+		0xb8, 0x78, 0x83, 0xca, 0xbf, 0xfc, 0x51, 0x03, 0x2b, 0xa2, 0x26, 0xdd, 0xbc, 0x6a, 0x73, 0xd2,
+		0xfa, 0x1a, 0xdb, 0x77, 0x7d, 0xe5, 0x36, 0xec, 0xc7, 0x69, 0x00, 0x7c, 0xf3, 0x6f, 0xff, 0xb0,
+		0xac, 0x03, 0xb0, 0x19, 0x8d, 0x0a, 0x37, 0x17, 0xb9, 0x5d, 0xb5, 0x90, 0x60, 0x44, 0xca, 0xb6,
+		0xf3, 0x37, 0x35, 0x42, 0xbf, 0x1f, 0x82, 0x94, 0x7f, 0x84, 0xfe, 0x4e, 0xae, 0x6d, 0x64, 0xcd,
+		0x53, 0x53, 0xec, 0x92, 0xd0, 0xfd, 0xe3, 0xd4, 0xd4, 0x90, 0x34, 0x4f, 0xd0, 0x4f, 0x6f, 0xc4,
+		0x20, 0xc6, 0xa3, 0xf8, 0x6a, 0x43, 0xe6, 0xba, 0x34, 0xe9, 0x99, 0x83, 0xb4, 0x78, 0xe6, 0x60,
+		0xa4, 0xb5, 0x7e, 0x0f, 0xe0, 0xe3, 0x07, 0x5a, 0x20, 0x34, 0x10, 0x8f, 0x4f, 0x70, 0xf7, 0xee,
+		0xf6, 0xde, 0xc6, 0xe8, 0x48, 0x04, 0xf3, 0xf4, 0x30, 0xc2, 0xe0, 0x7d, 0xac, 0x0a, 0xcb, 0x21,
+		0x0f, 0x01, 0xd9, 0x75, 0xdd, 0x30, 0x9a, 0xc7, 0x08, 0x84, 0x9b, 0xf3, 0x4a, 0x04, 0x38, 0xbe,
+		0xf3, 0x82, 0xb6, 0x30, 0x7b, 0x66, 0x9b, 0x3f, 0x62, 0x8e, 0x39, 0x65, 0xcc, 0x20, 0x1f, 0x92,
+		0x98, 0xd5, 0x3f, 0xe0, 0x1c, 0xe0, 0x9b, 0xae, 0x8d, 0x29, 0x26, 0xc5, 0x5f, 0xbc, 0x7e, 0x22,
+		0xbf, 0xa7, 0x77, 0xda, 0xcb, 0xca, 0x08, 0x3a, 0xca, 0x28, 0x7f, 0x05, 0x6e, 0x0f, 0x82, 0xce,
+		0x05, 0xb2, 0x06, 0x32, 0xbe, 0xe9, 0xd0, 0xe3, 0xdf, 0xb3, 0xd7, 0xb6, 0x0d, 0x83, 0x6f, 0xcf,
+		0x8e, 0x2e, 0x8d, 0x78, 0xf4, 0x7c, 0x09, 0x1f, 0x0f, 0xf7, 0x8f, 0x65, 0xe6, 0x9a, 0xb1, 0xa7,
+		0x72, 0xe4, 0x0b, 0x06, 0xd5, 0x98, 0x46, 0xd7, 0x6a, 0xec, 0x6a, 0x6d, 0xc3, 0xdc, 0x71, 0x76,
+		0xf9, 0x9e, 0x2c, 0x49, 0xf3, 0x56, 0x68, 0x16, 0x79, 0x09, 0x8e, 0xee, 0xb4, 0xad, 0x2d, 0xbc,
+		0xc6, 0xc6, 0xcd, 0x79, 0x26, 0x3c, 0x5a, 0x43, 0xef, 0xe6, 0x12, 0x6f, 0xcb, 0x21, 0x73, 0x98,
+		0x11, 0x5e, 0x61, 0xe6, 0x3d, 0x23, 0x5b, 0xd2, 0xbb, 0xe4, 0x06, 0xdc, 0xe1, 0xcd, 0x87, 0x11,
+		0xb5, 0xc2, 0xdb, 0xaa, 0xf5, 0xa8, 0x4b, 0x7b, 0xa8, 0xe2, 0x17, 0x87, 0xb6, 0x46, 0xdb, 0x7a,
+		0x03, 0xcf, 0x2e, 0x93, 0x6f, 0xab, 0xc6, 0xe0, 0xfe, 0xe8, 0x02, 0x25, 0xb9, 0xfc, 0x1e, 0xd4,
+		0x54, 0xaf, 0xbc, 0xf1, 0x33, 0xa7, 0x8e, 0xf9, 0x1e, 0x29, 0xbf, 0xe9, 0xfa, 0x5b, 0xd9, 0xf4,
+		0xc2, 0xbd, 0x09, 0xf1, 0x0c, 0x00, 0xf7, 0x2a, 0xc7, 0x2a, 0x80, 0x6f, 0xeb, 0x29, 0xed, 0xbf,
+		0xa5, 0xf5, 0xf0, 0x03, 0x5b, 0x5a, 0x9f, 0x7a, 0x7c, 0x9f, 0xb7, 0xfe, 0x86, 0x0e, 0x20, 0x4d,
+		0x02, 0x89, 0x6a, 0xdd, 0x19, 0xe5, 0xdf, 0x48, 0x18, 0xf1, 0x37, 0xa0, 0x49, 0xdc, 0x26, 0x37,
+		0x80, 0xf4, 0x7c, 0x85, 0x74, 0x46, 0x8a, 0xd8, 0x81, 0xdb, 0x53, 0x4c, 0x73, 0xbd, 0xc1, 0xd2,
+		0x77, 0xc8, 0x90, 0x10, 0xbe, 0x2a, 0x09, 0xef, 0x30, 0x35, 0x87, 0xb9, 0x5f, 0x87, 0x94, 0xbf,
+		0x6a, 0xde, 0x89, 0xf7, 0x4c, 0xd3, 0x09, 0x7f, 0xfb, 0x03, 0x44, 0xc8, 0x15, 0x4f, 0x5b, 0x33,
+		0x47, 0xef, 0x23, 0x53, 0x33, 0x45, 0x34, 0x6c, 0xa4, 0xd6, 0x66, 0x63, 0xf3, 0xc7, 0x12, 0x44,
+		0xd6, 0x2d, 0xab, 0x4d, 0x5e, 0x82, 0x39, 0xd3, 0x72, 0xa8, 0x70, 0x1b, 0x4d, 0x8d, 0x7b, 0xa2,
+		0xd8, 0x4a, 0x58, 0xd9, 0x97, 0x57, 0x5f, 0x7e, 0xfd, 0xc4, 0x30, 0xe6, 0xa8, 0x8f, 0x18, 0x64,
+		0x4d, 0xcb, 0x29, 0x52, 0xa0, 0x0d, 0x0a, 0x43, 0xb6, 0x21, 0x1d, 0xac, 0x8e, 0xad, 0x96, 0x85,
+		0x49, 0xd5, 0xa5, 0x27, 0x56, 0x95, 0xda, 0xf2, 0xd5, 0xc3, 0xde, 0xfd, 0xfe, 0x33, 0x1c, 0xb9,
+		0xab, 0x20, 0x5f, 0x19, 0x8c, 0x49, 0xab, 0xc0, 0xac, 0x88, 0x41, 0x93, 0xa6, 0x0c, 0x6f, 0xf3,
+		0xb3, 0x93, 0xe3, 0x2a, 0xbf, 0x1e, 0x86, 0xa3, 0x18, 0xaa, 0xc6, 0x5d, 0x86, 0x5c, 0x97, 0x32,
+		0x87, 0xff, 0x1e, 0x46, 0xc8, 0x8f, 0x71, 0x68, 0x4e, 0xb1, 0x88, 0x0f, 0x79, 0x36, 0xaf, 0x40,
+		0x16, 0x8d, 0x1f, 0xf4, 0x4a, 0xbe, 0x3d, 0xc7, 0x66, 0xda, 0x6a, 0x37, 0x79, 0xa3, 0xd1, 0xad,
+		0x79, 0x05, 0xb2, 0xa6, 0x71, 0x23, 0x40, 0x37, 0x7c, 0x7b, 0x74, 0x4d, 0xe3, 0x86, 0x8f, 0xae,
+		0x17, 0x21, 0x11, 0x09, 0x84, 0x6b, 0x8c, 0xb0, 0x78, 0xa3, 0x6f, 0xd7, 0xe2, 0x3d, 0x07, 0xe1,
+		0x6d, 0x83, 0xad, 0xbf, 0xd3, 0xaa, 0x2b, 0x44, 0xf0, 0x19, 0x31, 0xbf, 0x26, 0xc1, 0x3c, 0x95,
+		0x9c, 0xd6, 0xcb, 0x06, 0xf5, 0x2d, 0xa9, 0x46, 0xc3, 0xea, 0x35, 0x31, 0xec, 0x8a, 0x3f, 0x19,
+		0x17, 0x51, 0x43, 0xad, 0x26, 0xbe, 0xaa, 0x66, 0xdd, 0x30, 0x79, 0xb0, 0xd2, 0x7e, 0xf6, 0x22,
+		0x03, 0xa3, 0x06, 0x8c, 0xd5, 0xec, 0xb7, 0x0d, 0x4d, 0x6f, 0x30, 0x9b, 0x35, 0xcc, 0x3f, 0xe0,
+		0x44, 0x73, 0x0b, 0x2c, 0x33, 0xf8, 0xb4, 0x56, 0x64, 0x5a, 0x19, 0xf1, 0x70, 0x98, 0x92, 0x3a,
+		0xf5, 0x73, 0x12, 0x80, 0xe7, 0x65, 0xc6, 0x27, 0xd9, 0x8a, 0xb5, 0xb5, 0xb2, 0x56, 0xdf, 0x28,
+		0x6c, 0x6c, 0xd6, 0x83, 0x1f, 0xab, 0x12, 0x0f, 0xb8, 0xd9, 0x5d, 0xa3, 0xd1, 0xda, 0x6e, 0x19,
+		0x4d, 0x72, 0x2f, 0x2c, 0x04, 0xa1, 0x31, 0x55, 0x29, 0xcb, 0x52, 0x3e, 0xf5, 0xca, 0xad, 0xc5,
+		0x38, 0xdb, 0x82, 0x1a, 0xf8, 0x1c, 0xf1, 0xa1, 0x61, 0x38, 0xfc, 0xd0, 0x55, 0x28, 0x9f, 0x7e,
+		0xe5, 0xd6, 0x62, 0xc2, 0xdd, 0xab, 0x12, 0x05, 0x88, 0x1f, 0x92, 0xd3, 0x0b, 0xe7, 0xe1, 0x95,
+		0x5b, 0x8b, 0x31, 0xa6, 0x15, 0xf2, 0x11, 0x7c, 0xa6, 0xed, 0xd4, 0x37, 0x01, 0x54, 0xcd, 0xed,
+		0x9e, 0xde, 0xa0, 0xda, 0x2f, 0x0f, 0x87, 0xab, 0x6b, 0x17, 0xd4, 0x42, 0x69, 0xa3, 0x5a, 0x5b,
+		0x0b, 0x36, 0x7b, 0xa0, 0xac, 0x5c, 0xdb, 0x2c, 0xae, 0x54, 0x34, 0x7c, 0x18, 0x8e, 0x45, 0xab,
+		0x04, 0xca, 0x9e, 0x5b, 0xdb, 0xa8, 0xae, 0x56, 0xe4, 0x50, 0xf1, 0xc2, 0xd8, 0xd3, 0xd0, 0x07,
+		0xf7, 0xfd, 0x4c, 0x88, 0xb7, 0x02, 0x07, 0x8e, 0x44, 0xff, 0xd7, 0x00, 0xc7, 0x80, 0x0d, 0xdb,
+		0x42, 0xb6, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2328,6 +2604,21 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
+		return false
+	}
+	if this.EpochLength != that1.EpochLength {
+		return false
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorBondFactor.Equal(that1.ValidatorBondFactor) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2384,6 +2675,39 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	return true
+}
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2603,6 +2927,26 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.LiquidShares.Size()
+		i -= size
+		if _, err := m.LiquidShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.ValidatorBondShares.Size()
+		i -= size
+		if _, err := m.ValidatorBondShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
 	if len(m.UnbondingIds) > 0 {
 		dAtA5 := make([]byte, len(m.UnbondingIds)*10)
 		var j4 int
@@ -2933,6 +3277,16 @@ func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ValidatorBond {
+		i--
+		if m.ValidatorBond {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.Shares.Size()
 		i -= size
@@ -3221,6 +3575,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ValidatorBondFactor.Size()
+		i -= size
+		if _, err := m.ValidatorBondFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.EpochLength != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -3253,12 +3652,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err12 != nil {
-		return 0, err12
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n12
-	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i -= n13
+	i = encodeVarintStaking(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3477,83 +3876,209 @@ func (m *ValidatorUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *HistoricalInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Header.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Valset) > 0 {
-		for _, e := range m.Valset {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
-	}
-	return n
-}
-
-func (m *CommissionRates) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsPubKeyRotationHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxChangeRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Commission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CommissionRates.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime)
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+func (m *ConsPubKeyRotationHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Description) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ConsPubKeyRotationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err19 != nil {
+		return 0, err19
 	}
-	l = len(m.SecurityContact)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i -= n19
+	i = encodeVarintStaking(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewConsPubkey != nil {
+		{
+			size, err := m.NewConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldConsPubkey != nil {
+		{
+			size, err := m.OldConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HistoricalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Valset) > 0 {
+		for _, e := range m.Valset {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommissionRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *Commission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommissionRates.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *Description) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
 	}
 	l = len(m.Details)
 	if l > 0 {
@@ -3607,6 +4132,10 @@ func (m *Validator) Size() (n int) {
 		}
 		n += 1 + sovStaking(uint64(l)) + l
 	}
+	l = m.ValidatorBondShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.LiquidShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3709,6 +4238,9 @@ func (m *Delegation) Size() (n int) {
 	}
 	l = m.Shares.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.ValidatorBond {
+		n += 2
+	}
 	return n
 }
 
@@ -3833,6 +4365,17 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.KeyRotationFee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.EpochLength != 0 {
+		n += 1 + sovStaking(uint64(m.EpochLength))
+	}
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondFactor.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3907,6 +4450,58 @@ func (m *ValidatorUpdates) Size() (n int) {
 	return n
 }
 
+func (m *ConsPubKeyRotationHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.OldConsPubkey != nil {
+		l = m.OldConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.NewConsPubkey != nil {
+		l = m.NewConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4959,6 +5554,74 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
 			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5617,11 +6280,31 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBond", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidatorBond = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthStaking
@@ -6567,6 +7250,160 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeyRotationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -7140,6 +7977,410 @@ func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldConsPubkey == nil {
+				m.OldConsPubkey = &types1.Any{}
+			}
+			if err := m.OldConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewConsPubkey == nil {
+				m.NewConsPubkey = &types1.Any{}
+			}
+			if err := m.NewConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0